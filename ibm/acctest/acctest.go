@@ -6,11 +6,14 @@ package acctest
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
 )
 
@@ -26,6 +29,7 @@ var (
 	CisInstance                     string
 	CisResourceGroup                string
 	CloudShellAccountID             string
+	Db2InstanceCRN                  string
 	CosCRN                          string
 	BucketCRN                       string
 	BucketName                      string
@@ -66,6 +70,8 @@ var (
 	placementGroupName              string
 	CertCRN                         string
 	UpdatedCertCRN                  string
+	IngressDomainCertCRN            string
+	IngressDomain                   string
 	SecretCRN                       string
 	SecretCRN2                      string
 	InstanceCRN                     string
@@ -237,6 +243,36 @@ var (
 	SccInstanceID             string
 )
 
+// Cloud Logs
+var (
+	CloudLogsInstanceID     string
+	CloudLogsInstanceRegion string
+)
+
+// watsonx Assistant
+var (
+	AssistantInstanceID     string
+	AssistantInstanceRegion string
+)
+
+// Backup and Recovery
+var (
+	BackupRecoveryInstanceID     string
+	BackupRecoveryInstanceRegion string
+	BackupRecoveryTenantID       string
+	BackupRecoveryEndpoint       string
+	BackupRecoveryConnectionID   string
+)
+
+// Event Streams
+var EventStreamsEnterpriseInstanceCRN string
+
+// VMware Solutions
+var (
+	VMwareClassicVcenterID string
+	VMwareClassicClusterID string
+)
+
 // ROKS Cluster
 var ClusterName string
 
@@ -377,6 +413,16 @@ func init() {
 		fmt.Println("[WARN] Set the environment variable IBM_UPDATE_CERT_CRN for testing ibm_container_alb_cert or ibm_container_ingress_secret_tls resource else it is set to default value")
 	}
 
+	IngressDomainCertCRN = os.Getenv("IBM_INGRESS_DOMAIN_CERT_CRN")
+	if IngressDomainCertCRN == "" {
+		fmt.Println("[WARN] Set the environment variable IBM_INGRESS_DOMAIN_CERT_CRN with the CRN of a Secrets Manager certificate for testing ibm_container_ingress_domain_certificate resource")
+	}
+
+	IngressDomain = os.Getenv("IBM_INGRESS_DOMAIN")
+	if IngressDomain == "" {
+		fmt.Println("[WARN] Set the environment variable IBM_INGRESS_DOMAIN with the domain of the certificate for testing ibm_container_ingress_domain_certificate resource")
+	}
+
 	SecretCRN = os.Getenv("IBM_SECRET_CRN")
 	if SecretCRN == "" {
 		SecretCRN = "crn:v1:bluemix:public:secrets-manager:us-south:a/52b2e14f385aca5da781baa1b9c28e53:6efac0c2-b955-49ca-939d-d7bc0cb8132f:secret:e786b0ea2af8b5435603803ec2ff8118"
@@ -1252,6 +1298,11 @@ func init() {
 		fmt.Println("[INFO] Set the environment variable IBM_CLOUD_SHELL_ACCOUNT_ID for ibm-cloud-shell resource or datasource else tests will fail if this is not set correctly")
 	}
 
+	Db2InstanceCRN = os.Getenv("IBM_DB2_INSTANCE_CRN")
+	if Db2InstanceCRN == "" {
+		fmt.Println("[INFO] Set the environment variable IBM_DB2_INSTANCE_CRN for testing Db2 SaaS resources or datasources else tests will fail if this is not set correctly")
+	}
+
 	IksClusterVpcID = os.Getenv("IBM_CLUSTER_VPC_ID")
 	if IksClusterVpcID == "" {
 		fmt.Println("[WARN] Set the environment variable IBM_CLUSTER_VPC_ID for testing ibm_container_vpc_alb_create resources, ibm_container_vpc_alb_create tests will fail if this is not set")
@@ -1282,6 +1333,69 @@ func init() {
 		fmt.Println("[INFO] Set the environment variable SATELLITE_RESOURCE_INSTANCE_ID for ibm_cos_bucket satellite location resource or datasource else tests will fail if this is not set correctly")
 	}
 
+	CloudLogsInstanceID = os.Getenv("IBMCLOUD_LOGS_INSTANCE_ID")
+	if CloudLogsInstanceID == "" {
+		fmt.Println("[WARN] Set the environment variable IBMCLOUD_LOGS_INSTANCE_ID with the GUID of a Cloud Logs instance")
+	}
+
+	CloudLogsInstanceRegion = os.Getenv("IBMCLOUD_LOGS_INSTANCE_REGION")
+	if CloudLogsInstanceRegion == "" {
+		CloudLogsInstanceRegion = "us-south"
+		fmt.Println("[INFO] Set the environment variable IBMCLOUD_LOGS_INSTANCE_REGION for the region of the Cloud Logs instance else it is set to default value 'us-south'")
+	}
+
+	AssistantInstanceID = os.Getenv("IBMCLOUD_ASSISTANT_INSTANCE_ID")
+	if AssistantInstanceID == "" {
+		fmt.Println("[WARN] Set the environment variable IBMCLOUD_ASSISTANT_INSTANCE_ID with the GUID of a watsonx Assistant instance")
+	}
+
+	AssistantInstanceRegion = os.Getenv("IBMCLOUD_ASSISTANT_INSTANCE_REGION")
+	if AssistantInstanceRegion == "" {
+		AssistantInstanceRegion = "us-south"
+		fmt.Println("[INFO] Set the environment variable IBMCLOUD_ASSISTANT_INSTANCE_REGION for the region of the watsonx Assistant instance else it is set to default value 'us-south'")
+	}
+
+	BackupRecoveryInstanceID = os.Getenv("IBMCLOUD_BACKUP_RECOVERY_INSTANCE_ID")
+	if BackupRecoveryInstanceID == "" {
+		fmt.Println("[WARN] Set the environment variable IBMCLOUD_BACKUP_RECOVERY_INSTANCE_ID with the GUID of a Backup and Recovery instance")
+	}
+
+	BackupRecoveryInstanceRegion = os.Getenv("IBMCLOUD_BACKUP_RECOVERY_INSTANCE_REGION")
+	if BackupRecoveryInstanceRegion == "" {
+		BackupRecoveryInstanceRegion = "us-east"
+		fmt.Println("[INFO] Set the environment variable IBMCLOUD_BACKUP_RECOVERY_INSTANCE_REGION for the region of the Backup and Recovery instance else it is set to default value 'us-east'")
+	}
+
+	BackupRecoveryTenantID = os.Getenv("IBMCLOUD_BACKUP_RECOVERY_TENANT_ID")
+	if BackupRecoveryTenantID == "" {
+		fmt.Println("[WARN] Set the environment variable IBMCLOUD_BACKUP_RECOVERY_TENANT_ID with the tenant ID of the Backup and Recovery instance")
+	}
+
+	BackupRecoveryEndpoint = os.Getenv("IBMCLOUD_BACKUP_RECOVERY_SOURCE_ENDPOINT")
+	if BackupRecoveryEndpoint == "" {
+		fmt.Println("[WARN] Set the environment variable IBMCLOUD_BACKUP_RECOVERY_SOURCE_ENDPOINT with the IP address of a host with the Backup and Recovery agent")
+	}
+
+	BackupRecoveryConnectionID = os.Getenv("IBMCLOUD_BACKUP_RECOVERY_CONNECTION_ID")
+	if BackupRecoveryConnectionID == "" {
+		fmt.Println("[WARN] Set the environment variable IBMCLOUD_BACKUP_RECOVERY_CONNECTION_ID with the ID of the data source connection of the Backup and Recovery instance")
+	}
+
+	EventStreamsEnterpriseInstanceCRN = os.Getenv("IBMCLOUD_EVENT_STREAMS_ENTERPRISE_INSTANCE_CRN")
+	if EventStreamsEnterpriseInstanceCRN == "" {
+		fmt.Println("[WARN] Set the environment variable IBMCLOUD_EVENT_STREAMS_ENTERPRISE_INSTANCE_CRN with the CRN of an Event Streams Enterprise instance")
+	}
+
+	VMwareClassicVcenterID = os.Getenv("IBMCLOUD_VMWARE_CLASSIC_VCENTER_ID")
+	if VMwareClassicVcenterID == "" {
+		fmt.Println("[WARN] Set the environment variable IBMCLOUD_VMWARE_CLASSIC_VCENTER_ID with the ID of a classic vCenter Server instance")
+	}
+
+	VMwareClassicClusterID = os.Getenv("IBMCLOUD_VMWARE_CLASSIC_CLUSTER_ID")
+	if VMwareClassicClusterID == "" {
+		fmt.Println("[WARN] Set the environment variable IBMCLOUD_VMWARE_CLASSIC_CLUSTER_ID with the ID of a cluster of the classic vCenter Server instance")
+	}
+
 	SccInstanceID = os.Getenv("IBMCLOUD_SCC_INSTANCE_ID")
 	if SccInstanceID == "" {
 		fmt.Println("[WARN] Set the environment variable IBMCLOUD_SCC_INSTANCE_ID with a VALID SCC INSTANCE ID")
@@ -1491,7 +1605,29 @@ func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = provider.Provider()
 }
 
+// TestAccPreCheckVCR selects the cassette of the test in testdata/cassettes of the package when
+// IBMCLOUD_VCR_MODE is set. In replay mode the credentials are not needed, placeholders are set for
+// the provider configuration and the responses are read from the cassette recorded with a live account.
+// The tests that use cassettes can't run in parallel, run them with -parallel 1.
+func TestAccPreCheckVCR(t *testing.T) {
+	if conns.VCRMode() == "" {
+		return
+	}
+	cassette := filepath.Join("testdata", "cassettes", strings.ReplaceAll(t.Name(), "/", "_")+".json")
+	if err := conns.UseVCRCassette(t, cassette); err != nil {
+		t.Fatal(err)
+	}
+	if conns.VCRMode() == conns.VCRModeReplay {
+		for _, env := range []string{"IC_API_KEY", "IAAS_CLASSIC_API_KEY", "IAAS_CLASSIC_USERNAME"} {
+			if os.Getenv(env) == "" {
+				os.Setenv(env, "replay")
+			}
+		}
+	}
+}
+
 func TestAccPreCheck(t *testing.T) {
+	TestAccPreCheckVCR(t)
 	if v := os.Getenv("IC_API_KEY"); v == "" {
 		t.Fatal("IC_API_KEY must be set for acceptance tests")
 	}
@@ -1547,6 +1683,20 @@ func TestAccPreCheckCloudShell(t *testing.T) {
 	}
 }
 
+func TestAccPreCheckDb2(t *testing.T) {
+	TestAccPreCheck(t)
+	if Db2InstanceCRN == "" {
+		t.Fatal("IBM_DB2_INSTANCE_CRN must be set for acceptance tests")
+	}
+}
+
+func TestAccPreCheckNS1(t *testing.T) {
+	TestAccPreCheck(t)
+	if os.Getenv("IBMCLOUD_NS1_API_KEY") == "" && os.Getenv("NS1_APIKEY") == "" {
+		t.Fatal("IBMCLOUD_NS1_API_KEY must be set for acceptance tests")
+	}
+}
+
 func TestAccPreCheckHPCS(t *testing.T) {
 	TestAccPreCheck(t)
 	if HpcsAdmin1 == "" {
@@ -1616,6 +1766,54 @@ func TestAccPreCheckCodeEngine(t *testing.T) {
 	}
 }
 
+func TestAccPreCheckCloudLogs(t *testing.T) {
+	TestAccPreCheck(t)
+	if CloudLogsInstanceID == "" {
+		t.Fatal("IBMCLOUD_LOGS_INSTANCE_ID missing. Set the environment variable IBMCLOUD_LOGS_INSTANCE_ID with the GUID of a Cloud Logs instance")
+	}
+}
+
+func TestAccPreCheckAssistant(t *testing.T) {
+	TestAccPreCheck(t)
+	if AssistantInstanceID == "" {
+		t.Fatal("IBMCLOUD_ASSISTANT_INSTANCE_ID missing. Set the environment variable IBMCLOUD_ASSISTANT_INSTANCE_ID with the GUID of a watsonx Assistant instance")
+	}
+}
+
+func TestAccPreCheckBackupRecovery(t *testing.T) {
+	TestAccPreCheck(t)
+	if BackupRecoveryInstanceID == "" {
+		t.Fatal("IBMCLOUD_BACKUP_RECOVERY_INSTANCE_ID missing. Set the environment variable IBMCLOUD_BACKUP_RECOVERY_INSTANCE_ID with the GUID of a Backup and Recovery instance")
+	}
+	if BackupRecoveryTenantID == "" {
+		t.Fatal("IBMCLOUD_BACKUP_RECOVERY_TENANT_ID missing. Set the environment variable IBMCLOUD_BACKUP_RECOVERY_TENANT_ID with the tenant ID of the Backup and Recovery instance")
+	}
+	if BackupRecoveryEndpoint == "" || BackupRecoveryConnectionID == "" {
+		t.Fatal("IBMCLOUD_BACKUP_RECOVERY_SOURCE_ENDPOINT or IBMCLOUD_BACKUP_RECOVERY_CONNECTION_ID missing. Set the environment variables with the IP address of a host with the Backup and Recovery agent and the ID of the data source connection")
+	}
+}
+
+func TestAccPreCheckEventStreamsEnterprise(t *testing.T) {
+	TestAccPreCheck(t)
+	if EventStreamsEnterpriseInstanceCRN == "" {
+		t.Fatal("IBMCLOUD_EVENT_STREAMS_ENTERPRISE_INSTANCE_CRN missing. Set the environment variable with the CRN of an Event Streams Enterprise instance")
+	}
+}
+
+func TestAccPreCheckVMwareClassic(t *testing.T) {
+	TestAccPreCheck(t)
+	if VMwareClassicVcenterID == "" || VMwareClassicClusterID == "" {
+		t.Fatal("IBMCLOUD_VMWARE_CLASSIC_VCENTER_ID or IBMCLOUD_VMWARE_CLASSIC_CLUSTER_ID missing. Set the environment variables with the ID of a classic vCenter Server instance and the ID of one of its clusters")
+	}
+}
+
+func TestAccPreCheckIngressDomainCertificate(t *testing.T) {
+	TestAccPreCheck(t)
+	if IngressDomainCertCRN == "" || IngressDomain == "" {
+		t.Fatal("IBM_INGRESS_DOMAIN_CERT_CRN or IBM_INGRESS_DOMAIN missing. Set the environment variables with the CRN of a Secrets Manager certificate and its domain")
+	}
+}
+
 func TestAccPreCheckScc(t *testing.T) {
 	TestAccPreCheck(t)
 	if SccApiEndpoint == "" {
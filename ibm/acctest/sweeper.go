@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+)
+
+// defaultSweepPrefixes are the name prefixes of the resources created by the acceptance tests
+var defaultSweepPrefixes = []string{"tf-", "tf_", "tfacc", "terraform-test-"}
+
+// SharedClientSession returns a client session configured for the region, for the sweepers to
+// list and delete the resources left over by the acceptance tests.
+func SharedClientSession(region string) (conns.ClientSession, error) {
+	if os.Getenv("IC_API_KEY") == "" {
+		return nil, fmt.Errorf("[ERROR] IC_API_KEY must be set for the sweepers")
+	}
+	p := provider.Provider()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"region": region,
+	}))
+	if diags.HasError() {
+		return nil, fmt.Errorf("[ERROR] Error configuring the provider for the region %s: %v", region, diags)
+	}
+	return p.Meta().(conns.ClientSession), nil
+}
+
+// IsSweepable reports whether the resource was created by the acceptance tests. The name prefixes
+// can be overridden with the comma separated IBM_SWEEP_PREFIXES environment variable.
+func IsSweepable(name string) bool {
+	prefixes := defaultSweepPrefixes
+	if v := os.Getenv("IBM_SWEEP_PREFIXES"); v != "" {
+		prefixes = strings.Split(v, ",")
+	}
+	for _, prefix := range prefixes {
+		if prefix = strings.TrimSpace(prefix); prefix != "" && strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// SweepResourceInstances deletes the sweepable resource instances of the region that belong to a
+// service accepted by the filter, which receives the service name of the instance CRN.
+func SweepResourceInstances(region string, filter func(serviceName string) bool) error {
+	sess, err := SharedClientSession(region)
+	if err != nil {
+		return err
+	}
+	rsConClient, err := sess.ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+
+	options := &rc.ListResourceInstancesOptions{
+		Type: core.StringPtr("service_instance"),
+	}
+	var sweepErrs []string
+	for {
+		list, response, err := rsConClient.ListResourceInstances(options)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing the resource instances: %s\n%s", err, response)
+		}
+		for _, instance := range list.Resources {
+			if instance.CRN == nil || instance.Name == nil || !IsSweepable(*instance.Name) {
+				continue
+			}
+			// crn:v1:<cname>:<ctype>:<service-name>:<location>:...
+			crnParts := strings.Split(*instance.CRN, ":")
+			if len(crnParts) < 6 || crnParts[5] != region || !filter(crnParts[4]) {
+				continue
+			}
+			log.Printf("[INFO] Deleting the resource instance %s (%s)", *instance.Name, *instance.ID)
+			_, err := rsConClient.DeleteResourceInstance(&rc.DeleteResourceInstanceOptions{
+				ID:        instance.ID,
+				Recursive: core.BoolPtr(true),
+			})
+			if err != nil {
+				sweepErrs = append(sweepErrs, fmt.Sprintf("%s: %s", *instance.Name, err))
+			}
+		}
+		start := ""
+		if list.NextURL != nil {
+			if u, err := url.Parse(*list.NextURL); err == nil {
+				start = u.Query().Get("start")
+			}
+		}
+		if start == "" {
+			break
+		}
+		options.Start = &start
+	}
+	return SweepErrors(sweepErrs)
+}
+
+// SweepErrors returns one error listing the resources that could not be deleted, if any
+func SweepErrors(sweepErrs []string) error {
+	if len(sweepErrs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("[ERROR] Error sweeping %d resources:\n%s", len(sweepErrs), strings.Join(sweepErrs, "\n"))
+}
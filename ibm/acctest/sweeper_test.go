@@ -0,0 +1,34 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"testing"
+)
+
+func TestIsSweepable(t *testing.T) {
+	testCases := []struct {
+		name     string
+		prefixes string
+		expected bool
+	}{
+		{"tf-vpc-123", "", true},
+		{"tf_name_123", "", true},
+		{"tfacc-bucket", "", true},
+		{"terraform-test-cluster", "", true},
+		{"terraform-prod-cluster", "", false},
+		{"terraformstate", "", false},
+		{"my-vpc", "", false},
+		{"ci-vpc-123", "ci-, qa-", true},
+		{"tf-vpc-123", "ci-, qa-", false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("IBM_SWEEP_PREFIXES", tc.prefixes)
+			if actual := IsSweepable(tc.name); actual != tc.expected {
+				t.Errorf("expected %t for %s with the prefixes %q, got %t", tc.expected, tc.name, tc.prefixes, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	gohttp "net/http"
+	"net/url"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+const iamGrantTypeAssume = "urn:ibm:params:oauth:grant-type:assume"
+
+// AssumeAuthenticator requests the IAM access tokens of a trusted profile, which can be in another
+// account of the enterprise, by exchanging the access tokens of the credentials of the provider.
+type AssumeAuthenticator struct {
+	// Authenticator requests the access tokens of the credentials of the provider
+	Authenticator *core.IamAuthenticator
+
+	// ProfileID is the ID or the CRN of the trusted profile
+	ProfileID string
+
+	// ProfileName is the name of the trusted profile in the account AccountID
+	ProfileName string
+	AccountID   string
+
+	// URL is the IAM endpoint
+	URL    string
+	Client *gohttp.Client
+}
+
+// AuthenticationType returns the authentication type of the authenticator
+func (a *AssumeAuthenticator) AuthenticationType() string {
+	return core.AUTHTYPE_IAM
+}
+
+// Validate checks that the trusted profile is identified by its ID, or by its name and account
+func (a *AssumeAuthenticator) Validate() error {
+	if a.ProfileID == "" && (a.ProfileName == "" || a.AccountID == "") {
+		return fmt.Errorf("[ERROR] The trusted profile to assume must be set with its ID, or with its name and account ID")
+	}
+	if a.Authenticator != nil {
+		return a.Authenticator.Validate()
+	}
+	return nil
+}
+
+// RequestToken requests an access token of the credentials of the provider and exchanges it for
+// an access token of the trusted profile
+func (a *AssumeAuthenticator) RequestToken() (*core.IamTokenServerResponse, error) {
+	response, err := a.Authenticator.RequestToken()
+	if err != nil {
+		return nil, err
+	}
+	return a.Exchange(response.AccessToken)
+}
+
+// Exchange exchanges an access token of the credentials of the provider for an access token of the
+// trusted profile
+func (a *AssumeAuthenticator) Exchange(accessToken string) (*core.IamTokenServerResponse, error) {
+	form := url.Values{}
+	form.Set("grant_type", iamGrantTypeAssume)
+	form.Set("access_token", strings.TrimPrefix(accessToken, "Bearer "))
+	switch {
+	case strings.HasPrefix(a.ProfileID, "crn:"):
+		form.Set("profile_crn", a.ProfileID)
+	case a.ProfileID != "":
+		form.Set("profile_id", a.ProfileID)
+	default:
+		form.Set("profile_name", a.ProfileName)
+		form.Set("account", a.AccountID)
+	}
+
+	request, err := gohttp.NewRequest(gohttp.MethodPost, strings.TrimSuffix(a.URL, "/")+"/identity/token", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+
+	client := a.Client
+	if client == nil {
+		client = gohttp.DefaultClient
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error assuming the trusted profile: %s", err)
+	}
+	defer response.Body.Close()
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error assuming the trusted profile: %s", err)
+	}
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return nil, fmt.Errorf("[ERROR] Error assuming the trusted profile, IAM returned status code %d: %s", response.StatusCode, body)
+	}
+
+	token := &core.IamTokenServerResponse{}
+	if err := json.Unmarshal(body, token); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error assuming the trusted profile: %s", err)
+	}
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("[ERROR] Error assuming the trusted profile: IAM returned no access token")
+	}
+	return token, nil
+}
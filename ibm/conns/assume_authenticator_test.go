@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func TestAssumeAuthenticatorExchangesToken(t *testing.T) {
+	var forms []map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("parsing the form failed: %s", err)
+		}
+		form := map[string]string{}
+		for key := range r.PostForm {
+			form[key] = r.PostForm.Get(key)
+		}
+		forms = append(forms, form)
+		now := time.Now().Unix()
+		token := "base"
+		if form["grant_type"] == iamGrantTypeAssume {
+			token = "profile"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"%s","token_type":"Bearer","expires_in":3600,"expiration":%d}`, token, now+3600)
+	}))
+	defer server.Close()
+
+	authenticator := &AssumeAuthenticator{
+		Authenticator: &core.IamAuthenticator{ApiKey: "apikey", URL: server.URL},
+		ProfileName:   "admin",
+		AccountID:     "child",
+		URL:           server.URL,
+	}
+	if err := authenticator.Validate(); err != nil {
+		t.Fatalf("Validate failed: %s", err)
+	}
+
+	response, err := authenticator.RequestToken()
+	if err != nil {
+		t.Fatalf("RequestToken failed: %s", err)
+	}
+	if response.AccessToken != "profile" {
+		t.Fatalf("expected the token of the trusted profile, got %s", response.AccessToken)
+	}
+	if len(forms) != 2 {
+		t.Fatalf("expected 2 token requests, got %d", len(forms))
+	}
+	assume := forms[1]
+	if assume["access_token"] != "base" || assume["profile_name"] != "admin" || assume["account"] != "child" {
+		t.Fatalf("unexpected assume request %v", assume)
+	}
+}
+
+func TestAssumeAuthenticatorValidate(t *testing.T) {
+	authenticator := &AssumeAuthenticator{ProfileName: "admin"}
+	if err := authenticator.Validate(); err == nil {
+		t.Fatalf("expected an error when the account of the profile name is missing")
+	}
+	authenticator = &AssumeAuthenticator{ProfileID: "Profile-1234"}
+	if err := authenticator.Validate(); err != nil {
+		t.Fatalf("Validate failed: %s", err)
+	}
+}
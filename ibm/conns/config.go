@@ -12,6 +12,7 @@ import (
 	"log"
 	"net"
 	gohttp "net/http"
+	"net/url"
 	"os"
 	"strings"
 	"time"
@@ -26,6 +27,7 @@ import (
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
 	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
 	"github.com/IBM/container-registry-go-sdk/containerregistryv1"
+	"github.com/IBM/container-registry-go-sdk/vulnerabilityadvisorv4"
 	"github.com/IBM/go-sdk-core/v5/core"
 	cosconfig "github.com/IBM/ibm-cos-sdk-go-config/resourceconfigurationv1"
 	kp "github.com/IBM/keyprotect-go-client"
@@ -77,6 +79,7 @@ import (
 	"github.com/IBM/platform-services-go-sdk/metricsrouterv3"
 	resourcecontroller "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	resourcemanager "github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+	"github.com/IBM/platform-services-go-sdk/usagereportsv4"
 	project "github.com/IBM/project-go-sdk/projectv1"
 	"github.com/IBM/push-notifications-go-sdk/pushservicev1"
 	schematicsv1 "github.com/IBM/schematics-go-sdk/schematicsv1"
@@ -188,10 +191,25 @@ type Config struct {
 	// IAM Refresh Token
 	IAMRefreshToken string
 
+	// Trusted profile assumed with the credentials of the provider, set with its ID or CRN, or with
+	// its name and the ID of its account, which can be a child account of the enterprise
+	IAMAssumeProfileID   string
+	IAMAssumeProfileName string
+	IAMAssumeAccountID   string
+
 	// Zone
 	Zone          string
 	Visibility    string
 	EndpointsFile string
+
+	// Path of the file caching the IAM access tokens across runs, disabled when empty
+	IAMTokenCachePath string
+
+	// Tuning of the HTTP transport shared by the service clients
+	HTTPTransport HTTPTransportConfig
+
+	// Regions whose Key Protect endpoints are tried in order when the endpoint of the region of an instance fails
+	KMSFailoverRegions []string
 }
 
 // Session stores the information required for communication with the SoftLayer and Bluemix API
@@ -213,6 +231,7 @@ type ClientSession interface {
 	ContainerAPI() (containerv1.ContainerServiceAPI, error)
 	VpcContainerAPI() (containerv2.ContainerServiceAPI, error)
 	ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error)
+	VulnerabilityAdvisorV4() (*vulnerabilityadvisorv4.VulnerabilityAdvisorV4, error)
 	FunctionClient() (*whisk.Client, error)
 	GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error)
 	GlobalTaggingAPI() (globaltaggingv3.GlobalTaggingServiceAPI, error)
@@ -229,13 +248,16 @@ type ClientSession interface {
 	ResourceControllerAPIV2() (controllerv2.ResourceControllerAPIV2, error)
 	SoftLayerSession() *slsession.Session
 	IBMPISession() (*ibmpisession.IBMPISession, error)
+	IBMPISessionForZone(zone string) (*ibmpisession.IBMPISession, error)
 	UserManagementAPI() (usermanagementv2.UserManagementAPI, error)
 	PushServiceV1() (*pushservicev1.PushServiceV1, error)
 	EventNotificationsApiV1() (*eventnotificationsv1.EventNotificationsV1, error)
 	AppConfigurationV1() (*appconfigurationv1.AppConfigurationV1, error)
 	KeyProtectAPI() (*kp.Client, error)
 	KeyManagementAPI() (*kp.Client, error)
+	KMSFailoverRegions() []string
 	VpcV1API() (*vpc.VpcV1, error)
+	VpcV1APIForRegion(region string) (*vpc.VpcV1, error)
 	VpcV1BetaAPI() (*vpcbeta.VpcbetaV1, error)
 	APIGateway() (*apigateway.ApiGatewayControllerApiV1, error)
 	PrivateDNSClientSession() (*dns.DnsSvcsV1, error)
@@ -280,6 +302,11 @@ type ClientSession interface {
 	ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error)
 	CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error)
 	EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error)
+	UsageReportsV4() (*usagereportsv4.UsageReportsV4, error)
+	CloudLogsV1() (*core.BaseService, error)
+	AssistantV2() (*core.BaseService, error)
+	BackupRecoveryV1() (*core.BaseService, error)
+	VMwareSolutionsV1() (*core.BaseService, error)
 	ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error)
 	SecretsManagerV1() (*secretsmanagerv1.SecretsManagerV1, error)
 	SecretsManagerV2() (*secretsmanagerv2.SecretsManagerV2, error)
@@ -291,12 +318,15 @@ type ClientSession interface {
 	AtrackerV2() (*atrackerv2.AtrackerV2, error)
 	MetricsRouterV3() (*metricsrouterv3.MetricsRouterV3, error)
 	ESschemaRegistrySession() (*schemaregistryv1.SchemaregistryV1, error)
+	ESconnectSession() (*core.BaseService, error)
 	ContextBasedRestrictionsV1() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error)
 	SecurityAndComplianceCenterV3() (*scc.SecurityAndComplianceCenterApiV3, error)
 	CdToolchainV2() (*cdtoolchainv2.CdToolchainV2, error)
 	CdTektonPipelineV2() (*cdtektonpipelinev2.CdTektonPipelineV2, error)
 	CodeEngineV2() (*codeengine.CodeEngineV2, error)
 	ProjectV1() (*project.ProjectV1, error)
+	Db2SaasV1() (*core.BaseService, error)
+	NS1ConnectV1() (*core.BaseService, error)
 }
 
 type clientSession struct {
@@ -326,6 +356,9 @@ type clientSession struct {
 	containerRegistryClientErr error
 	containerRegistryClient    *containerregistryv1.ContainerRegistryV1
 
+	vulnerabilityAdvisorClientErr error
+	vulnerabilityAdvisorClient    *vulnerabilityadvisorv4.VulnerabilityAdvisorV4
+
 	cfConfigErr  error
 	cfServiceAPI mccpv2.MccpServiceAPI
 
@@ -377,8 +410,12 @@ type clientSession struct {
 	kpErr error
 	kpAPI *kp.API
 
-	kmsErr error
-	kmsAPI *kp.API
+	kmsErr             error
+	kmsAPI             *kp.API
+	kmsFailoverRegions []string
+
+	// Token manager of the assumed trusted profile, used by the clients that only take an access token
+	iamAssumeTokenManager *TokenManager
 
 	hpcsEndpointErr error
 	hpcsEndpointAPI hpcs.HPCSV2
@@ -533,6 +570,21 @@ type clientSession struct {
 	enterpriseManagementClient    *enterprisemanagementv1.EnterpriseManagementV1
 	enterpriseManagementClientErr error
 
+	usageReportsClient    *usagereportsv4.UsageReportsV4
+	usageReportsClientErr error
+
+	cloudLogsClient    *core.BaseService
+	cloudLogsClientErr error
+
+	assistantClient    *core.BaseService
+	assistantClientErr error
+
+	backupRecoveryClient    *core.BaseService
+	backupRecoveryClientErr error
+
+	vmwareSolutionsClient    *core.BaseService
+	vmwareSolutionsClientErr error
+
 	// Resource Controller Option
 	resourceControllerErr   error
 	resourceControllerAPI   *resourcecontroller.ResourceControllerV2
@@ -595,6 +647,9 @@ type clientSession struct {
 	esSchemaRegistryClient *schemaregistryv1.SchemaregistryV1
 	esSchemaRegistryErr    error
 
+	esConnectClient *core.BaseService
+	esConnectErr    error
+
 	// Security and Compliance Center (SCC)
 	securityAndComplianceCenterClient    *scc.SecurityAndComplianceCenterApiV3
 	securityAndComplianceCenterClientErr error
@@ -618,6 +673,14 @@ type clientSession struct {
 	// Project options
 	projectClient    *project.ProjectV1
 	projectClientErr error
+
+	// Db2 SaaS options
+	db2SaasClient    *core.BaseService
+	db2SaasClientErr error
+
+	// NS1 Connect options
+	ns1ConnectClient    *core.BaseService
+	ns1ConnectClientErr error
 }
 
 // AppIDAPI provides AppID Service APIs ...
@@ -664,6 +727,11 @@ func (session clientSession) ContainerRegistryV1() (*containerregistryv1.Contain
 	return session.containerRegistryClient, session.containerRegistryClientErr
 }
 
+// VulnerabilityAdvisorV4 provides Vulnerability Advisor Service APIs ...
+func (session clientSession) VulnerabilityAdvisorV4() (*vulnerabilityadvisorv4.VulnerabilityAdvisorV4, error) {
+	return session.vulnerabilityAdvisorClient, session.vulnerabilityAdvisorClientErr
+}
+
 // SchematicsAPI provides schematics Service APIs ...
 func (sess clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
 	if sess.schematicsClientErr != nil {
@@ -808,7 +876,7 @@ func (sess clientSession) KeyManagementAPI() (*kp.Client, error) {
 			}
 		}
 
-		kpClient, err := kp.New(*clientConfig, DefaultTransport())
+		kpClient, err := kp.New(*clientConfig, sess.kpTransport())
 		if err != nil {
 			sess.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
 		}
@@ -817,10 +885,47 @@ func (sess clientSession) KeyManagementAPI() (*kp.Client, error) {
 	return sess.kmsAPI, sess.kmsErr
 }
 
+// KMSFailoverRegions returns the regions whose Key Protect endpoints are tried when the endpoint of the region of an
+// instance fails
+func (sess clientSession) KMSFailoverRegions() []string {
+	return sess.kmsFailoverRegions
+}
+
 func (sess clientSession) VpcV1API() (*vpc.VpcV1, error) {
 	return sess.vpcAPI, sess.vpcErr
 }
 
+// VpcV1APIForRegion returns a VPC client for the region, which overrides the region of the provider.
+// The client of the provider is returned when the region is empty or is the region of the endpoint, and an error
+// when the endpoint is set in the environment or is not regional, as the region can't be honored.
+func (sess clientSession) VpcV1APIForRegion(region string) (*vpc.VpcV1, error) {
+	if sess.vpcErr != nil || region == "" {
+		return sess.vpcAPI, sess.vpcErr
+	}
+	serviceURL, err := url.Parse(sess.vpcAPI.GetServiceURL())
+	if err != nil {
+		return nil, err
+	}
+	// The host of the VPC endpoint is <region>.iaas... or <region>.private.iaas...
+	hostParts := strings.SplitN(serviceURL.Host, ".", 2)
+	if len(hostParts) == 2 && hostParts[0] == region {
+		return sess.vpcAPI, nil
+	}
+	// The resource would otherwise be created in the region of the endpoint
+	if os.Getenv("IBMCLOUD_IS_NG_API_ENDPOINT") != "" {
+		return nil, fmt.Errorf("[ERROR] The region %s can't be used, the VPC endpoint is set to %s by IBMCLOUD_IS_NG_API_ENDPOINT", region, sess.vpcAPI.GetServiceURL())
+	}
+	if len(hostParts) != 2 || !(strings.HasPrefix(hostParts[1], "iaas.") || strings.HasPrefix(hostParts[1], "private.iaas.")) {
+		return nil, fmt.Errorf("[ERROR] The region %s can't be used, the VPC endpoint %s is not a regional endpoint", region, sess.vpcAPI.GetServiceURL())
+	}
+	vpcClient := sess.vpcAPI.Clone()
+	serviceURL.Host = fmt.Sprintf("%s.%s", region, hostParts[1])
+	if err = vpcClient.SetServiceURL(serviceURL.String()); err != nil {
+		return nil, err
+	}
+	return vpcClient, nil
+}
+
 func (sess clientSession) VpcV1BetaAPI() (*vpcbeta.VpcbetaV1, error) {
 	return sess.vpcBetaAPI, sess.vpcbetaErr
 }
@@ -847,6 +952,20 @@ func (sess clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
 	return sess.ibmpiSession, sess.ibmpiConfigErr
 }
 
+// IBMPISessionForZone returns a Power Systems session for the zone, which overrides the zone of the provider.
+// The session of the provider is returned when the zone is empty.
+func (sess clientSession) IBMPISessionForZone(zone string) (*ibmpisession.IBMPISession, error) {
+	if sess.ibmpiConfigErr != nil || zone == "" || zone == sess.ibmpiSession.Options.Zone {
+		return sess.ibmpiSession, sess.ibmpiConfigErr
+	}
+	options := *sess.ibmpiSession.Options
+	options.Zone = zone
+	// The region and the endpoint are derived from the zone unless the endpoint is set in the environment
+	options.Region = ""
+	options.URL = os.Getenv("IBMCLOUD_PI_API_ENDPOINT")
+	return ibmpisession.NewIBMPISession(&options)
+}
+
 // Private DNS Service
 
 func (sess clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
@@ -1065,6 +1184,31 @@ func (session clientSession) EnterpriseManagementV1() (*enterprisemanagementv1.E
 	return session.enterpriseManagementClient, session.enterpriseManagementClientErr
 }
 
+// UsageReportsV4 provides Usage Reports Service APIs ...
+func (session clientSession) UsageReportsV4() (*usagereportsv4.UsageReportsV4, error) {
+	return session.usageReportsClient, session.usageReportsClientErr
+}
+
+// CloudLogsV1 provides the base service of the Cloud Logs APIs, the URL of the service is the one of an instance
+func (session clientSession) CloudLogsV1() (*core.BaseService, error) {
+	return session.cloudLogsClient, session.cloudLogsClientErr
+}
+
+// AssistantV2 provides the base service of the watsonx Assistant APIs, the URL of the service is the one of an instance
+func (session clientSession) AssistantV2() (*core.BaseService, error) {
+	return session.assistantClient, session.assistantClientErr
+}
+
+// BackupRecoveryV1 provides the base service of the Backup and Recovery APIs, the URL of the service is the one of an instance
+func (session clientSession) BackupRecoveryV1() (*core.BaseService, error) {
+	return session.backupRecoveryClient, session.backupRecoveryClientErr
+}
+
+// VMwareSolutionsV1 provides the base service of the IBM Cloud for VMware Solutions APIs of the classic instances
+func (session clientSession) VMwareSolutionsV1() (*core.BaseService, error) {
+	return session.vmwareSolutionsClient, session.vmwareSolutionsClientErr
+}
+
 // ResourceController Session
 func (sess clientSession) ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error) {
 	return sess.resourceControllerAPI, sess.resourceControllerErr
@@ -1162,6 +1306,11 @@ func (session clientSession) ESschemaRegistrySession() (*schemaregistryv1.Schema
 	return session.esSchemaRegistryClient, session.esSchemaRegistryErr
 }
 
+// ESconnectSession provides the base service of the Event Streams connectors API, the URL of the service is the one of an instance
+func (session clientSession) ESconnectSession() (*core.BaseService, error) {
+	return session.esConnectClient, session.esConnectErr
+}
+
 // Security and Compliance center Admin API
 func (session clientSession) SecurityAndComplianceCenterV3() (*scc.SecurityAndComplianceCenterApiV3, error) {
 	return session.securityAndComplianceCenterClient, session.securityAndComplianceCenterClientErr
@@ -1192,6 +1341,16 @@ func (session clientSession) ProjectV1() (*project.ProjectV1, error) {
 	return session.projectClient, session.projectClientErr
 }
 
+// Db2 SaaS API, the service URL depends on the region of the Db2 instance
+func (session clientSession) Db2SaasV1() (*core.BaseService, error) {
+	return session.db2SaasClient, session.db2SaasClientErr
+}
+
+// NS1 Connect API, authenticated with the NS1 API key of the account
+func (session clientSession) NS1ConnectV1() (*core.BaseService, error) {
+	return session.ns1ConnectClient, session.ns1ConnectClientErr
+}
+
 // ClientSession configures and returns a fully initialized ClientSession
 func (c *Config) ClientSession() (interface{}, error) {
 	sess, err := newSession(c)
@@ -1200,7 +1359,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	log.Printf("[INFO] Configured Region: %s\n", c.Region)
 	session := clientSession{
-		session: sess,
+		session:            sess,
+		kmsFailoverRegions: c.KMSFailoverRegions,
 	}
 
 	if sess.BluemixSession == nil {
@@ -1212,6 +1372,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.csConfigErr = errEmptyBluemixCredentials
 		session.csv2ConfigErr = errEmptyBluemixCredentials
 		session.containerRegistryClientErr = errEmptyBluemixCredentials
+		session.vulnerabilityAdvisorClientErr = errEmptyBluemixCredentials
 		session.kpErr = errEmptyBluemixCredentials
 		session.pushServiceClientErr = errEmptyBluemixCredentials
 		session.appConfigurationClientErr = errEmptyBluemixCredentials
@@ -1231,6 +1392,11 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.resourceControllerConfigErr = errEmptyBluemixCredentials
 		session.resourceControllerConfigErrv2 = errEmptyBluemixCredentials
 		session.enterpriseManagementClientErr = errEmptyBluemixCredentials
+		session.usageReportsClientErr = errEmptyBluemixCredentials
+		session.cloudLogsClientErr = errEmptyBluemixCredentials
+		session.assistantClientErr = errEmptyBluemixCredentials
+		session.backupRecoveryClientErr = errEmptyBluemixCredentials
+		session.vmwareSolutionsClientErr = errEmptyBluemixCredentials
 		session.resourceControllerErr = errEmptyBluemixCredentials
 		session.catalogManagementClientErr = errEmptyBluemixCredentials
 		session.ibmpiConfigErr = errEmptyBluemixCredentials
@@ -1279,12 +1445,15 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.iamPolicyManagementErr = errEmptyBluemixCredentials
 		session.satelliteLinkClientErr = errEmptyBluemixCredentials
 		session.esSchemaRegistryErr = errEmptyBluemixCredentials
+		session.esConnectErr = errEmptyBluemixCredentials
 		session.contextBasedRestrictionsClientErr = errEmptyBluemixCredentials
 		session.securityAndComplianceCenterClientErr = errEmptyBluemixCredentials
 		session.cdTektonPipelineClientErr = errEmptyBluemixCredentials
 		session.cdToolchainClientErr = errEmptyBluemixCredentials
 		session.codeEngineClientErr = errEmptyBluemixCredentials
 		session.projectClientErr = errEmptyBluemixCredentials
+		session.db2SaasClientErr = errEmptyBluemixCredentials
+		session.ns1ConnectClientErr = errEmptyBluemixCredentials
 
 		return session, nil
 	}
@@ -1338,21 +1507,6 @@ func (c *Config) ClientSession() (interface{}, error) {
 		}
 
 	}
-	userConfig, err := fetchUserDetails(sess.BluemixSession, c.RetryCount, c.RetryDelay)
-	if err != nil {
-		session.bmxUserFetchErr = fmt.Errorf("[ERROR] Error occured while fetching account user details: %q", err)
-	}
-	session.bmxUserDetails = userConfig
-
-	if sess.SoftLayerSession != nil && sess.SoftLayerSession.APIKey == "" {
-		log.Println("Configuring SoftLayer Session with token from IBM Cloud Session")
-		sess.SoftLayerSession.IAMToken = sess.BluemixSession.Config.IAMAccessToken
-		sess.SoftLayerSession.IAMRefreshToken = sess.BluemixSession.Config.IAMRefreshToken
-	}
-
-	session.functionClient, session.functionConfigErr = FunctionClient(sess.BluemixSession.Config)
-
-	BluemixRegion = sess.BluemixSession.Config.Region
 	var fileMap map[string]interface{}
 	if f := EnvFallBack([]string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH"}, c.EndpointsFile); f != "" {
 		jsonFile, err := os.Open(f)
@@ -1369,6 +1523,71 @@ func (c *Config) ClientSession() (interface{}, error) {
 			log.Fatalf("Unable to unmarshal Endpoints File %s", err)
 		}
 	}
+
+	iamURL := iamEndpoint(c, fileMap)
+
+	// All the service clients share one HTTP client to reuse the connections to the endpoints
+	transportConfig := c.HTTPTransport
+	if transportConfig.Timeout == 0 {
+		transportConfig.Timeout = c.BluemixTimeout
+	}
+	httpClient := NewHTTPClient(transportConfig)
+
+	// The service clients use the tokens of the trusted profile, the credentials of the provider are
+	// only used to request the tokens to exchange
+	iamRefreshToken := sess.BluemixSession.Config.IAMRefreshToken
+	var assumeTokenManager *TokenManager
+	if c.IAMAssumeProfileID != "" || c.IAMAssumeProfileName != "" {
+		assumeAuthenticator := &AssumeAuthenticator{
+			ProfileID:   c.IAMAssumeProfileID,
+			ProfileName: c.IAMAssumeProfileName,
+			AccountID:   c.IAMAssumeAccountID,
+			URL:         EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
+			Client:      httpClient,
+		}
+		token, err := assumeAuthenticator.Exchange(sess.BluemixSession.Config.IAMAccessToken)
+		if err != nil {
+			return nil, err
+		}
+		log.Println("Configuring IBM Cloud Session with the token of the assumed trusted profile")
+		sess.BluemixSession.Config.IAMAccessToken = "Bearer " + token.AccessToken
+		sess.BluemixSession.Config.IAMRefreshToken = token.RefreshToken
+		sess.BluemixSession.Config.BluemixAPIKey = ""
+
+		// The bluemix-go, Key Protect and SoftLayer clients only take an access token, their requests
+		// get the token of the token manager that exchanges a new one when it expires
+		if iamAuthenticator := newIAMAuthenticator(c.BluemixAPIKey, iamRefreshToken, iamURL, httpClient); iamAuthenticator != nil {
+			assumeAuthenticator.Authenticator = iamAuthenticator
+			assumeTokenManager = NewAssumeTokenManager(assumeAuthenticator, c.IAMTokenCachePath)
+			assumeTokenManager.SeedToken(token.AccessToken)
+			sess.BluemixSession.Config.HTTPClient = &gohttp.Client{
+				Transport: assumeTokenManager.Transport(httpClient.Transport),
+				Timeout:   sess.BluemixSession.Config.HTTPTimeout,
+			}
+			session.iamAssumeTokenManager = assumeTokenManager
+		}
+	}
+
+	userConfig, err := fetchUserDetails(sess.BluemixSession, c.RetryCount, c.RetryDelay)
+	if err != nil {
+		session.bmxUserFetchErr = fmt.Errorf("[ERROR] Error occured while fetching account user details: %q", err)
+	}
+	session.bmxUserDetails = userConfig
+
+	if sess.SoftLayerSession != nil && sess.SoftLayerSession.APIKey == "" {
+		log.Println("Configuring SoftLayer Session with token from IBM Cloud Session")
+		sess.SoftLayerSession.IAMToken = sess.BluemixSession.Config.IAMAccessToken
+		sess.SoftLayerSession.IAMRefreshToken = sess.BluemixSession.Config.IAMRefreshToken
+		if assumeTokenManager != nil {
+			sess.SoftLayerSession.HTTPClient = &gohttp.Client{
+				Transport: assumeTokenManager.Transport(httpClient.Transport),
+			}
+		}
+	}
+
+	session.functionClient, session.functionConfigErr = FunctionClient(sess.BluemixSession.Config)
+
+	BluemixRegion = sess.BluemixSession.Config.Region
 	accv1API, err := accountv1.New(sess.BluemixSession)
 	if err != nil {
 		session.accountV1ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Bluemix Accountv1 Service: %q", err)
@@ -1413,7 +1632,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		kpurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kpurl)
 	}
 	var options kp.ClientConfig
-	if c.BluemixAPIKey != "" {
+	if sess.BluemixSession.Config.BluemixAPIKey != "" {
 		options = kp.ClientConfig{
 			BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kpurl),
 			APIKey:  sess.BluemixSession.Config.BluemixAPIKey, // pragma: allowlist secret
@@ -1428,24 +1647,12 @@ func (c *Config) ClientSession() (interface{}, error) {
 			Verbose: kp.VerboseFailOnly,
 		}
 	}
-	kpAPIclient, err := kp.New(options, DefaultTransport())
+	kpAPIclient, err := kp.New(options, session.kpTransport())
 	if err != nil {
 		session.kpErr = fmt.Errorf("[ERROR] Error occured while configuring Key Protect Service: %q", err)
 	}
 	session.kpAPI = kpAPIclient
 
-	iamURL := iamidentity.DefaultServiceURL
-	if c.Visibility == "private" || c.Visibility == "public-and-private" {
-		if c.Region == "us-south" || c.Region == "us-east" {
-			iamURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
-		} else {
-			iamURL = ContructEndpoint("private.iam", cloudEndpoint)
-		}
-	}
-	if fileMap != nil && c.Visibility != "public-and-private" {
-		iamURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamURL)
-	}
-
 	// KEY MANAGEMENT Service
 	kmsurl := ContructEndpoint(fmt.Sprintf("%s.kms", c.Region), cloudEndpoint)
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
@@ -1455,7 +1662,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		kmsurl = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_KP_API_ENDPOINT", c.Region, kmsurl)
 	}
 	var kmsOptions kp.ClientConfig
-	if c.BluemixAPIKey != "" {
+	if sess.BluemixSession.Config.BluemixAPIKey != "" {
 		kmsOptions = kp.ClientConfig{
 			BaseURL: EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl),
 			APIKey:  sess.BluemixSession.Config.BluemixAPIKey, // pragma: allowlist secret
@@ -1472,7 +1679,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			TokenURL: EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL) + "/identity/token",
 		}
 	}
-	kmsAPIclient, err := kp.New(kmsOptions, DefaultTransport())
+	kmsAPIclient, err := kp.New(kmsOptions, session.kpTransport())
 	if err != nil {
 		session.kmsErr = fmt.Errorf("[ERROR] Error occured while configuring key Service: %q", err)
 	}
@@ -1480,21 +1687,13 @@ func (c *Config) ClientSession() (interface{}, error) {
 
 	var authenticator core.Authenticator
 
-	if c.BluemixAPIKey != "" || sess.BluemixSession.Config.IAMRefreshToken != "" {
-		if c.BluemixAPIKey != "" {
-			authenticator = &core.IamAuthenticator{
-				ApiKey: c.BluemixAPIKey,
-				URL:    EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
-			}
-		} else {
-			// Construct the IamAuthenticator with the IAM refresh token.
-			authenticator = &core.IamAuthenticator{
-				RefreshToken: sess.BluemixSession.Config.IAMRefreshToken,
-				ClientId:     "bx",
-				ClientSecret: "bx",
-				URL:          EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
-			}
-		}
+	if assumeTokenManager != nil {
+		authenticator = assumeTokenManager
+	} else if iamAuthenticator := newIAMAuthenticator(c.BluemixAPIKey, iamRefreshToken, iamURL, httpClient); iamAuthenticator != nil {
+		// Share the token of the session between all the service clients
+		tokenManager := NewTokenManager(iamAuthenticator, c.IAMTokenCachePath)
+		tokenManager.SeedToken(sess.BluemixSession.Config.IAMAccessToken)
+		authenticator = tokenManager
 	} else if strings.HasPrefix(sess.BluemixSession.Config.IAMAccessToken, "Bearer") {
 		authenticator = &core.BearerTokenAuthenticator{
 			BearerToken: sess.BluemixSession.Config.IAMAccessToken[7:],
@@ -1522,6 +1721,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	// Construct the service client.
 	session.projectClient, err = project.NewProjectV1(projectClientOptions)
 	if err == nil {
+		session.projectClient.Service.SetHTTPClient(httpClient)
 		// Enable retries for API calls
 		session.projectClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
@@ -1532,6 +1732,49 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.projectClientErr = fmt.Errorf("Error occurred while configuring Projects API Specification service: %q", err)
 	}
 
+	// Db2 SaaS Service
+	db2SaasEndpoint := fmt.Sprintf("https://%s.db2.saas.ibm.com/dbapi/v4", c.Region)
+	if fileMap != nil && c.Visibility != "public-and-private" {
+		db2SaasEndpoint = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_DB2_API_ENDPOINT", c.Region, db2SaasEndpoint)
+	}
+	session.db2SaasClient, err = core.NewBaseService(&core.ServiceOptions{
+		URL:           EnvFallBack([]string{"IBMCLOUD_DB2_API_ENDPOINT"}, db2SaasEndpoint),
+		Authenticator: authenticator,
+	})
+	if err == nil {
+		session.db2SaasClient.SetHTTPClient(httpClient)
+		// Enable retries for API calls
+		session.db2SaasClient.EnableRetries(c.RetryCount, c.RetryDelay)
+		// Add custom header for analytics
+		session.db2SaasClient.SetDefaultHeaders(gohttp.Header{
+			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+		})
+	} else {
+		session.db2SaasClientErr = fmt.Errorf("Error occurred while configuring Db2 SaaS service: %q", err)
+	}
+
+	// NS1 Connect Service
+	ns1APIKey := EnvFallBack([]string{"IBMCLOUD_NS1_API_KEY", "NS1_APIKEY"}, "")
+	session.ns1ConnectClient, err = core.NewBaseService(&core.ServiceOptions{
+		URL:           EnvFallBack([]string{"IBMCLOUD_NS1_API_ENDPOINT"}, "https://api.nsone.net/v1"),
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err == nil {
+		session.ns1ConnectClient.SetHTTPClient(httpClient)
+		// Enable retries for API calls
+		session.ns1ConnectClient.EnableRetries(c.RetryCount, c.RetryDelay)
+		// Add custom header for analytics
+		session.ns1ConnectClient.SetDefaultHeaders(gohttp.Header{
+			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+			"X-NSONE-Key":           {ns1APIKey},
+		})
+		if ns1APIKey == "" {
+			session.ns1ConnectClientErr = fmt.Errorf("IBMCLOUD_NS1_API_KEY must be set to configure NS1 Connect service")
+		}
+	} else {
+		session.ns1ConnectClientErr = fmt.Errorf("Error occurred while configuring NS1 Connect service: %q", err)
+	}
+
 	// Construct an "options" struct for creating the service client.
 	ukoClientOptions := &ukov4.UkoV4Options{
 		Authenticator: authenticator,
@@ -1540,6 +1783,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	// Construct the service client.
 	session.ukoClient, err = ukov4.NewUkoV4(ukoClientOptions)
 	if err == nil {
+		session.ukoClient.Service.SetHTTPClient(httpClient)
 		// Enable retries for API calls
 		session.ukoClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
@@ -1567,6 +1811,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.appidErr = fmt.Errorf("error occured while configuring AppID service: #{err}")
 	}
 	if appIDClient != nil && appIDClient.Service != nil {
+		appIDClient.Service.SetHTTPClient(httpClient)
 		appIDClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		appIDClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1594,6 +1839,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	// Construct the service client.
 	session.contextBasedRestrictionsClient, err = contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(contextBasedRestrictionsClientOptions)
 	if err == nil && session.contextBasedRestrictionsClient != nil {
+		session.contextBasedRestrictionsClient.Service.SetHTTPClient(httpClient)
 		// Enable retries for API calls
 		session.contextBasedRestrictionsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
@@ -1622,6 +1868,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.catalogManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Catalog Management API service: %q", err)
 	}
 	if session.catalogManagementClient != nil && session.catalogManagementClient.Service != nil {
+		session.catalogManagementClient.Service.SetHTTPClient(httpClient)
 		// Enable retries for API calls
 		session.catalogManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
@@ -1659,6 +1906,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	session.atrackerClientV2, err = atrackerv2.NewAtrackerV2(atrackerClientV2Options)
 	if err == nil {
+		session.atrackerClientV2.Service.SetHTTPClient(httpClient)
 		// Enable retries for API calls
 		session.atrackerClientV2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
@@ -1695,6 +1943,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	// Construct the service client.
 	session.metricsRouterClient, err = metricsrouterv3.NewMetricsRouterV3(metricsRouterClientOptions)
 	if err == nil {
+		session.metricsRouterClient.Service.SetHTTPClient(httpClient)
 		// Enable retries for API calls
 		session.metricsRouterClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
@@ -1719,6 +1968,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	// Construct the service client.
 	session.securityAndComplianceCenterClient, err = scc.NewSecurityAndComplianceCenterApiV3(sccApiClientOptions)
 	if err == nil {
+		session.securityAndComplianceCenterClient.Service.SetHTTPClient(httpClient)
 		// Enable retries for API calls
 		session.securityAndComplianceCenterClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
@@ -1749,6 +1999,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	// Enable retries for API calls
 	if schematicsClient != nil && schematicsClient.Service != nil {
+		schematicsClient.Service.SetHTTPClient(httpClient)
 		schematicsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		schematicsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1773,6 +2024,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.vpcErr = fmt.Errorf("[ERROR] Error occured while configuring vpc service: %q", err)
 	}
 	if vpcclient != nil && vpcclient.Service != nil {
+		vpcclient.Service.SetHTTPClient(httpClient)
 		vpcclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		vpcclient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1789,6 +2041,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.vpcbetaErr = fmt.Errorf("[ERROR] Error occured while configuring vpc beta service: %q", err)
 	}
 	if vpcbetaclient != nil && vpcbetaclient.Service != nil {
+		vpcbetaclient.Service.SetHTTPClient(httpClient)
 		vpcbetaclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		vpcbetaclient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1813,6 +2066,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.pushServiceClientErr = fmt.Errorf("[ERROR] Error occured while configuring Push Notifications service: %q", err)
 	}
 	if pnclient != nil && pnclient.Service != nil {
+		pnclient.Service.SetHTTPClient(httpClient)
 		// Enable retries for API calls
 		pnclient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		pnclient.SetDefaultHeaders(gohttp.Header{
@@ -1839,6 +2093,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.eventNotificationsApiClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Event Notifications service: %q", err)
 	}
 	if session.eventNotificationsApiClient != nil && session.eventNotificationsApiClient.Service != nil {
+		session.eventNotificationsApiClient.Service.SetHTTPClient(httpClient)
 		// Enable retries for API calls
 		session.eventNotificationsApiClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.eventNotificationsApiClient.SetDefaultHeaders(gohttp.Header{
@@ -1861,6 +2116,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 
 	appConfigClient, err := appconfigurationv1.NewAppConfigurationV1(appConfigurationClientOptions)
 	if appConfigClient != nil {
+		appConfigClient.Service.SetHTTPClient(httpClient)
 		// Enable retries for API calls
 		appConfigClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.appConfigurationClient = appConfigClient
@@ -1894,6 +2150,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.containerRegistryClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Container Registry API service: %q", err)
 	}
 	if session.containerRegistryClient != nil && session.containerRegistryClient.Service != nil {
+		session.containerRegistryClient.Service.SetHTTPClient(httpClient)
 		// Enable retries for API calls
 		session.containerRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
@@ -1902,6 +2159,27 @@ func (c *Config) ClientSession() (interface{}, error) {
 		})
 	}
 
+	// VULNERABILITY ADVISOR Service
+	// Vulnerability Advisor is served from the same registry endpoints as the Container Registry API
+	vulnerabilityAdvisorClientOptions := &vulnerabilityadvisorv4.VulnerabilityAdvisorV4Options{
+		Authenticator: authenticator,
+		URL:           EnvFallBack([]string{"IBMCLOUD_CR_API_ENDPOINT"}, containerRegistryClientURL),
+		Account:       core.StringPtr(userConfig.UserAccount),
+	}
+	session.vulnerabilityAdvisorClient, err = vulnerabilityadvisorv4.NewVulnerabilityAdvisorV4(vulnerabilityAdvisorClientOptions)
+	if err != nil {
+		session.vulnerabilityAdvisorClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Vulnerability Advisor API service: %q", err)
+	}
+	if session.vulnerabilityAdvisorClient != nil && session.vulnerabilityAdvisorClient.Service != nil {
+		session.vulnerabilityAdvisorClient.Service.SetHTTPClient(httpClient)
+		// Enable retries for API calls
+		session.vulnerabilityAdvisorClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		// Add custom header for analytics
+		session.vulnerabilityAdvisorClient.SetDefaultHeaders(gohttp.Header{
+			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+		})
+	}
+
 	// OBJECT STORAGE Service
 	cosconfigurl := "https://config.cloud-object-storage.cloud.ibm.com/v1"
 	if fileMap != nil && c.Visibility != "public-and-private" {
@@ -1953,6 +2231,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if globalTaggingAPIV1 != nil && globalTaggingAPIV1.Service != nil {
 		session.globalTaggingServiceAPIV1 = *globalTaggingAPIV1
+		session.globalTaggingServiceAPIV1.Service.SetHTTPClient(httpClient)
 		session.globalTaggingServiceAPIV1.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.globalTaggingServiceAPIV1.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -1982,6 +2261,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	if globalSearchAPIV2 != nil && globalSearchAPIV2.Service != nil {
 		session.globalSearchServiceAPIV2 = *globalSearchAPIV2
+		session.globalSearchServiceAPIV2.Service.SetHTTPClient(httpClient)
 		session.globalSearchServiceAPIV2.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.globalSearchServiceAPIV2.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2011,6 +2291,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	// Construct the service client.
 	session.cloudDatabasesClient, err = clouddatabasesv5.NewCloudDatabasesV5(cloudDatabasesClientOptions)
 	if err == nil {
+		session.cloudDatabasesClient.Service.SetHTTPClient(httpClient)
 		// Enable retries for API calls
 		session.cloudDatabasesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
@@ -2108,6 +2389,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.pDNSErr = fmt.Errorf("[ERROR] Error occured while configuring PrivateDNS Service: %s", session.pDNSErr)
 	}
 	if session.pDNSClient != nil && session.pDNSClient.Service != nil {
+		session.pDNSClient.Service.SetHTTPClient(httpClient)
 		session.pDNSClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.pDNSClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2133,6 +2415,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.directlinkErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Service: %s", session.directlinkErr)
 	}
 	if session.directlinkAPI != nil && session.directlinkAPI.Service != nil {
+		session.directlinkAPI.Service.SetHTTPClient(httpClient)
 		session.directlinkAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.directlinkAPI.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2157,6 +2440,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.dlProviderErr = fmt.Errorf("[ERROR] Error occured while configuring Direct Link Provider Service: %s", session.dlProviderErr)
 	}
 	if session.dlProviderAPI != nil && session.dlProviderAPI.Service != nil {
+		session.dlProviderAPI.Service.SetHTTPClient(httpClient)
 		session.dlProviderAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.dlProviderAPI.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2181,6 +2465,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.transitgatewayErr = fmt.Errorf("[ERROR] Error occured while configuring Transit Gateway Service: %s", session.transitgatewayErr)
 	}
 	if session.transitgatewayAPI != nil && session.transitgatewayAPI.Service != nil {
+		session.transitgatewayAPI.Service.SetHTTPClient(httpClient)
 		session.transitgatewayAPI.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// session.transitgatewayAPI.SetDefaultHeaders(gohttp.Header{
 		// 	"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2235,6 +2520,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisZonesErr)
 	}
 	if session.cisZonesV1Client != nil && session.cisZonesV1Client.Service != nil {
+		session.cisZonesV1Client.Service.SetHTTPClient(httpClient)
 		session.cisZonesV1Client.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisZonesV1Client.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2253,6 +2539,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.cisDNSErr = fmt.Errorf("[ERROR] Error occured while configuring CIS DNS Service: %s", session.cisDNSErr)
 	}
 	if session.cisDNSRecordsClient != nil && session.cisDNSRecordsClient.Service != nil {
+		session.cisDNSRecordsClient.Service.SetHTTPClient(httpClient)
 		session.cisDNSRecordsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisDNSRecordsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2273,6 +2560,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisDNSBulkErr)
 	}
 	if session.cisDNSRecordBulkClient != nil && session.cisDNSRecordBulkClient.Service != nil {
+		session.cisDNSRecordBulkClient.Service.SetHTTPClient(httpClient)
 		session.cisDNSRecordBulkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisDNSRecordBulkClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2291,6 +2579,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisGLBPoolErr)
 	}
 	if session.cisGLBPoolClient != nil && session.cisGLBPoolClient.Service != nil {
+		session.cisGLBPoolClient.Service.SetHTTPClient(httpClient)
 		session.cisGLBPoolClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisGLBPoolClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2310,6 +2599,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisGLBErr)
 	}
 	if session.cisGLBClient != nil && session.cisGLBClient.Service != nil {
+		session.cisGLBClient.Service.SetHTTPClient(httpClient)
 		session.cisGLBClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisGLBClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2328,6 +2618,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisGLBHealthCheckErr)
 	}
 	if session.cisGLBHealthCheckClient != nil && session.cisGLBHealthCheckClient.Service != nil {
+		session.cisGLBHealthCheckClient.Service.SetHTTPClient(httpClient)
 		session.cisGLBHealthCheckClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisGLBHealthCheckClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2345,6 +2636,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisIPErr)
 	}
 	if session.cisIPClient != nil && session.cisIPClient.Service != nil {
+		session.cisIPClient.Service.SetHTTPClient(httpClient)
 		session.cisIPClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisIPClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2365,6 +2657,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisRLErr)
 	}
 	if session.cisRLClient != nil && session.cisRLClient.Service != nil {
+		session.cisRLClient.Service.SetHTTPClient(httpClient)
 		session.cisRLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisRLClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2382,6 +2675,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisAlertsErr)
 	}
 	if session.cisAlertsClient != nil && session.cisAlertsClient.Service != nil {
+		session.cisAlertsClient.Service.SetHTTPClient(httpClient)
 		session.cisAlertsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisAlertsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2402,6 +2696,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisPageRuleErr)
 	}
 	if session.cisPageRuleClient != nil && session.cisPageRuleClient.Service != nil {
+		session.cisPageRuleClient.Service.SetHTTPClient(httpClient)
 		session.cisPageRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisPageRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2421,6 +2716,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisEdgeFunctionErr)
 	}
 	if session.cisEdgeFunctionClient != nil && session.cisEdgeFunctionClient.Service != nil {
+		session.cisEdgeFunctionClient.Service.SetHTTPClient(httpClient)
 		session.cisEdgeFunctionClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisEdgeFunctionClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2441,6 +2737,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisSSLErr)
 	}
 	if session.cisSSLClient != nil && session.cisSSLClient.Service != nil {
+		session.cisSSLClient.Service.SetHTTPClient(httpClient)
 		session.cisSSLClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisSSLClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2460,6 +2757,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisWAFPackageErr)
 	}
 	if session.cisWAFPackageClient != nil && session.cisWAFPackageClient.Service != nil {
+		session.cisWAFPackageClient.Service.SetHTTPClient(httpClient)
 		session.cisWAFPackageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisWAFPackageClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2479,6 +2777,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisDomainSettingsErr)
 	}
 	if session.cisDomainSettingsClient != nil && session.cisDomainSettingsClient.Service != nil {
+		session.cisDomainSettingsClient.Service.SetHTTPClient(httpClient)
 		session.cisDomainSettingsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisDomainSettingsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2498,6 +2797,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisRoutingErr)
 	}
 	if session.cisRoutingClient != nil && session.cisRoutingClient.Service != nil {
+		session.cisRoutingClient.Service.SetHTTPClient(httpClient)
 		session.cisRoutingClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisRoutingClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2517,6 +2817,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisWAFGroupErr)
 	}
 	if session.cisWAFGroupClient != nil && session.cisWAFGroupClient.Service != nil {
+		session.cisWAFGroupClient.Service.SetHTTPClient(httpClient)
 		session.cisWAFGroupClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisWAFGroupClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2536,6 +2837,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisCacheErr)
 	}
 	if session.cisCacheClient != nil && session.cisCacheClient.Service != nil {
+		session.cisCacheClient.Service.SetHTTPClient(httpClient)
 		session.cisCacheClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisCacheClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2556,6 +2858,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisCustomPageErr)
 	}
 	if session.cisCustomPageClient != nil && session.cisCustomPageClient.Service != nil {
+		session.cisCustomPageClient.Service.SetHTTPClient(httpClient)
 		session.cisCustomPageClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisCustomPageClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2575,6 +2878,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisAccessRuleErr)
 	}
 	if session.cisAccessRuleClient != nil && session.cisAccessRuleClient.Service != nil {
+		session.cisAccessRuleClient.Service.SetHTTPClient(httpClient)
 		session.cisAccessRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisAccessRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2594,6 +2898,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisUARuleErr)
 	}
 	if session.cisUARuleClient != nil && session.cisUARuleClient.Service != nil {
+		session.cisUARuleClient.Service.SetHTTPClient(httpClient)
 		session.cisUARuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisUARuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2613,6 +2918,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisLockdownErr)
 	}
 	if session.cisLockdownClient != nil && session.cisLockdownClient.Service != nil {
+		session.cisLockdownClient.Service.SetHTTPClient(httpClient)
 		session.cisLockdownClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisLockdownClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2632,6 +2938,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisRangeAppErr)
 	}
 	if session.cisRangeAppClient != nil && session.cisRangeAppClient.Service != nil {
+		session.cisRangeAppClient.Service.SetHTTPClient(httpClient)
 		session.cisRangeAppClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisRangeAppClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2652,6 +2959,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisWAFRuleErr)
 	}
 	if session.cisWAFRuleClient != nil && session.cisWAFRuleClient.Service != nil {
+		session.cisWAFRuleClient.Service.SetHTTPClient(httpClient)
 		session.cisWAFRuleClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisWAFRuleClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2672,6 +2980,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisLogpushJobsErr)
 	}
 	if session.cisLogpushJobsClient != nil && session.cisLogpushJobsClient.Service != nil {
+		session.cisLogpushJobsClient.Service.SetHTTPClient(httpClient)
 		session.cisLogpushJobsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisLogpushJobsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2690,6 +2999,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisMtlsErr)
 	}
 	if session.cisMtlsClient != nil && session.cisMtlsClient.Service != nil {
+		session.cisMtlsClient.Service.SetHTTPClient(httpClient)
 		session.cisMtlsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisMtlsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2709,6 +3019,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisBotManagementErr)
 	}
 	if session.cisBotManagementClient != nil && session.cisBotManagementClient.Service != nil {
+		session.cisBotManagementClient.Service.SetHTTPClient(httpClient)
 		session.cisBotManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisBotManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2728,6 +3039,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisBotAnalyticsErr)
 	}
 	if session.cisBotAnalyticsClient != nil && session.cisBotAnalyticsClient.Service != nil {
+		session.cisBotAnalyticsClient.Service.SetHTTPClient(httpClient)
 		session.cisBotAnalyticsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisBotAnalyticsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2746,6 +3058,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisWebhooksErr)
 	}
 	if session.cisWebhooksClient != nil && session.cisWebhooksClient.Service != nil {
+		session.cisWebhooksClient.Service.SetHTTPClient(httpClient)
 		session.cisWebhooksClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisWebhooksClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2762,6 +3075,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisFiltersErr)
 	}
 	if session.cisFiltersClient != nil && session.cisFiltersClient.Service != nil {
+		session.cisFiltersClient.Service.SetHTTPClient(httpClient)
 		session.cisFiltersClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisFiltersClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2779,6 +3093,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisFirewallRulesErr)
 	}
 	if session.cisFirewallRulesClient != nil && session.cisFirewallRulesClient.Service != nil {
+		session.cisFirewallRulesClient.Service.SetHTTPClient(httpClient)
 		session.cisFirewallRulesClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisFirewallRulesClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2800,6 +3115,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			session.cisOriginAuthPullErr)
 	}
 	if session.cisOriginAuthClient != nil && session.cisOriginAuthClient.Service != nil {
+		session.cisOriginAuthClient.Service.SetHTTPClient(httpClient)
 		session.cisOriginAuthClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.cisOriginAuthClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2828,6 +3144,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.iamIdentityErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Identity service: %q", err)
 	}
 	if iamIdentityClient != nil && iamIdentityClient.Service != nil {
+		iamIdentityClient.Service.SetHTTPClient(httpClient)
 		iamIdentityClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		iamIdentityClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2856,6 +3173,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.iamPolicyManagementErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Policy Management service: %q", err)
 	}
 	if iamPolicyManagementClient != nil && iamPolicyManagementClient.Service != nil {
+		iamPolicyManagementClient.Service.SetHTTPClient(httpClient)
 		iamPolicyManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		iamPolicyManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2884,6 +3202,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.iamAccessGroupsErr = fmt.Errorf("[ERROR] Error occured while configuring IAM Access Group service: %q", err)
 	}
 	if iamAccessGroupsClient != nil && iamAccessGroupsClient.Service != nil {
+		iamAccessGroupsClient.Service.SetHTTPClient(httpClient)
 		iamAccessGroupsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		iamAccessGroupsClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2920,6 +3239,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.resourceManagerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Manager service: %q", err)
 	}
 	if resourceManagerClient != nil && resourceManagerClient.Service != nil {
+		resourceManagerClient.Service.SetHTTPClient(httpClient)
 		resourceManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		resourceManagerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2941,6 +3261,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.ibmCloudShellClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Shell service: %q", err)
 	}
 	if session.ibmCloudShellClient != nil && session.ibmCloudShellClient.Service != nil {
+		session.ibmCloudShellClient.Service.SetHTTPClient(httpClient)
 		session.ibmCloudShellClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.ibmCloudShellClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2977,6 +3298,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.enterpriseManagementClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Enterprise Management API service: %q", err)
 	}
 	if enterpriseManagementClient != nil && enterpriseManagementClient.Service != nil {
+		enterpriseManagementClient.Service.SetHTTPClient(httpClient)
 		enterpriseManagementClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		enterpriseManagementClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -2984,6 +3306,97 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	session.enterpriseManagementClient = enterpriseManagementClient
 
+	// USAGE REPORTS Service
+	usageReportsURL := usagereportsv4.DefaultServiceURL
+	if fileMap != nil && c.Visibility != "public-and-private" {
+		usageReportsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_USAGE_REPORTS_API_ENDPOINT", c.Region, usageReportsURL)
+	}
+	usageReportsClientOptions := &usagereportsv4.UsageReportsV4Options{
+		Authenticator: authenticator,
+		URL:           EnvFallBack([]string{"IBMCLOUD_USAGE_REPORTS_API_ENDPOINT"}, usageReportsURL),
+	}
+	usageReportsClient, err := usagereportsv4.NewUsageReportsV4(usageReportsClientOptions)
+	if err != nil {
+		session.usageReportsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Usage Reports API service: %q", err)
+	}
+	if usageReportsClient != nil && usageReportsClient.Service != nil {
+		usageReportsClient.Service.SetHTTPClient(httpClient)
+		usageReportsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		usageReportsClient.SetDefaultHeaders(gohttp.Header{
+			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+		})
+	}
+	session.usageReportsClient = usageReportsClient
+
+	// CLOUD LOGS Service
+	// The URL depends on the instance, it is set by the resources on a clone of the service
+	cloudLogsClient, err := core.NewBaseService(&core.ServiceOptions{
+		Authenticator: authenticator,
+	})
+	if err != nil {
+		session.cloudLogsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Logs API service: %q", err)
+	}
+	if cloudLogsClient != nil {
+		cloudLogsClient.SetHTTPClient(httpClient)
+		cloudLogsClient.EnableRetries(c.RetryCount, c.RetryDelay)
+		cloudLogsClient.SetDefaultHeaders(gohttp.Header{
+			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+		})
+	}
+	session.cloudLogsClient = cloudLogsClient
+
+	// WATSONX ASSISTANT Service
+	// The URL depends on the instance, it is set by the resources on a clone of the service
+	assistantClient, err := core.NewBaseService(&core.ServiceOptions{
+		Authenticator: authenticator,
+	})
+	if err != nil {
+		session.assistantClientErr = fmt.Errorf("[ERROR] Error occurred while configuring watsonx Assistant API service: %q", err)
+	}
+	if assistantClient != nil {
+		assistantClient.SetHTTPClient(httpClient)
+		assistantClient.EnableRetries(c.RetryCount, c.RetryDelay)
+		assistantClient.SetDefaultHeaders(gohttp.Header{
+			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+		})
+	}
+	session.assistantClient = assistantClient
+
+	// BACKUP AND RECOVERY Service
+	// The URL depends on the instance, it is set by the resources on a clone of the service
+	backupRecoveryClient, err := core.NewBaseService(&core.ServiceOptions{
+		Authenticator: authenticator,
+	})
+	if err != nil {
+		session.backupRecoveryClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Backup and Recovery API service: %q", err)
+	}
+	if backupRecoveryClient != nil {
+		backupRecoveryClient.SetHTTPClient(httpClient)
+		backupRecoveryClient.EnableRetries(c.RetryCount, c.RetryDelay)
+		backupRecoveryClient.SetDefaultHeaders(gohttp.Header{
+			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+		})
+	}
+	session.backupRecoveryClient = backupRecoveryClient
+
+	// VMWARE SOLUTIONS Service
+	vmwareSolutionsURL := "https://api.vmware-solutions.cloud.ibm.com/v1"
+	vmwareSolutionsClient, err := core.NewBaseService(&core.ServiceOptions{
+		Authenticator: authenticator,
+		URL:           EnvFallBack([]string{"IBMCLOUD_VMWARE_SOLUTIONS_API_ENDPOINT"}, vmwareSolutionsURL),
+	})
+	if err != nil {
+		session.vmwareSolutionsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud for VMware Solutions API service: %q", err)
+	}
+	if vmwareSolutionsClient != nil {
+		vmwareSolutionsClient.SetHTTPClient(httpClient)
+		vmwareSolutionsClient.EnableRetries(c.RetryCount, c.RetryDelay)
+		vmwareSolutionsClient.SetDefaultHeaders(gohttp.Header{
+			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+		})
+	}
+	session.vmwareSolutionsClient = vmwareSolutionsClient
+
 	// RESOURCE CONTROLLER Service
 	rcURL := resourcecontroller.DefaultServiceURL
 	if c.Visibility == "private" {
@@ -3013,6 +3426,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.resourceControllerErr = fmt.Errorf("[ERROR] Error occured while configuring Resource Controller service: %q", err)
 	}
 	if resourceControllerClient != nil && resourceControllerClient.Service != nil {
+		resourceControllerClient.Service.SetHTTPClient(httpClient)
 		resourceControllerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		resourceControllerClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3030,6 +3444,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.secretsManagerClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Secrets Manager API service: %q", err)
 	}
 	if session.secretsManagerClient != nil && session.secretsManagerClient.Service != nil {
+		session.secretsManagerClient.Service.SetHTTPClient(httpClient)
 		// Enable retries for API calls
 		session.secretsManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
@@ -3055,6 +3470,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	// Construct the service client.
 	session.secretsManagerClient, err = secretsmanagerv2.NewSecretsManagerV2UsingExternalConfig(secretsManagerClientOptionsV2)
 	if err == nil {
+		session.secretsManagerClient.Service.SetHTTPClient(httpClient)
 		// Enable retries for API calls
 		session.secretsManagerClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
@@ -3084,6 +3500,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 
 	// Enable retries for API calls
 	if session.satelliteClient != nil && session.satelliteClient.Service != nil {
+		session.satelliteClient.Service.SetHTTPClient(httpClient)
 		session.satelliteClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.satelliteClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
@@ -3108,6 +3525,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.satelliteLinkClientErr = fmt.Errorf("[ERROR] Error occurred while configuring Satellite Link service: %q", err)
 	}
 	if session.satelliteLinkClient != nil && session.satelliteLinkClient.Service != nil {
+		session.satelliteLinkClient.Service.SetHTTPClient(httpClient)
 		// Enable retries for API calls
 		session.satelliteLinkClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
@@ -3124,12 +3542,28 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.esSchemaRegistryErr = fmt.Errorf("[ERROR] Error occured while configuring Event Streams schema registry: %q", err)
 	}
 	if session.esSchemaRegistryClient != nil && session.esSchemaRegistryClient.Service != nil {
+		session.esSchemaRegistryClient.Service.SetHTTPClient(httpClient)
 		session.esSchemaRegistryClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		session.esSchemaRegistryClient.SetDefaultHeaders(gohttp.Header{
 			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
 		})
 	}
 
+	// The URL depends on the instance, it is set by the resources on a clone of the service
+	session.esConnectClient, err = core.NewBaseService(&core.ServiceOptions{
+		Authenticator: authenticator,
+	})
+	if err != nil {
+		session.esConnectErr = fmt.Errorf("[ERROR] Error occured while configuring Event Streams connectors API: %q", err)
+	}
+	if session.esConnectClient != nil {
+		session.esConnectClient.SetHTTPClient(httpClient)
+		session.esConnectClient.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.esConnectClient.SetDefaultHeaders(gohttp.Header{
+			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+		})
+	}
+
 	// Construct an "options" struct for creating the service client.
 	var cdToolchainClientURL string
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
@@ -3154,6 +3588,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	// Construct the service client.
 	session.cdToolchainClient, err = cdtoolchainv2.NewCdToolchainV2(cdToolchainClientOptions)
 	if err == nil {
+		session.cdToolchainClient.Service.SetHTTPClient(httpClient)
 		// Enable retries for API calls
 		session.cdToolchainClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
@@ -3187,6 +3622,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	// Construct the service client.
 	session.cdTektonPipelineClient, err = cdtektonpipelinev2.NewCdTektonPipelineV2(cdTektonPipelineClientOptions)
 	if err == nil {
+		session.cdTektonPipelineClient.Service.SetHTTPClient(httpClient)
 		// Enable retries for API calls
 		session.cdTektonPipelineClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
@@ -3213,6 +3649,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 	// Construct the service client.
 	session.codeEngineClient, err = codeengine.NewCodeEngineV2(codeEngineClientOptions)
 	if err == nil {
+		session.codeEngineClient.Service.SetHTTPClient(httpClient)
 		// Enable retries for API calls
 		session.codeEngineClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
 		// Add custom header for analytics
@@ -3272,6 +3709,12 @@ func newSession(c *Config) (*Session, error) {
 	if c.IAMTrustedProfileID != "" && c.IAMToken == "" {
 		return nil, fmt.Errorf("iam_token and iam_profile_id must be provided")
 	}
+	if c.IAMAssumeProfileName != "" && c.IAMAssumeAccountID == "" {
+		return nil, fmt.Errorf("iam_assume_account_id must be provided with iam_assume_profile_name")
+	}
+	if c.IAMAssumeProfileID != "" && c.IAMAssumeProfileName != "" {
+		return nil, fmt.Errorf("only one of iam_assume_profile_id and iam_assume_profile_name can be provided")
+	}
 
 	if c.IAMToken != "" {
 		log.Println("Configuring IBM Cloud Session with token")
@@ -3289,6 +3732,7 @@ func newSession(c *Config) (*Session, error) {
 			Visibility:    c.Visibility,
 			EndpointsFile: c.EndpointsFile,
 			UserAgent:     fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
+			HTTPClient:    vcrHTTPClient(),
 		}
 		sess, err := bxsession.New(bmxConfig)
 		if err != nil {
@@ -3312,6 +3756,7 @@ func newSession(c *Config) (*Session, error) {
 			Visibility:    c.Visibility,
 			EndpointsFile: c.EndpointsFile,
 			UserAgent:     fmt.Sprintf("terraform-provider-ibm/%s", version.Version),
+			HTTPClient:    vcrHTTPClient(),
 		}
 		sess, err := bxsession.New(bmxConfig)
 		if err != nil {
@@ -3410,6 +3855,22 @@ func RefreshToken(sess *bxsession.Session) error {
 	return err
 }
 
+// iamEndpoint returns the IAM endpoint for the visibility and the region of the provider
+func iamEndpoint(c *Config, fileMap map[string]interface{}) string {
+	iamURL := iamidentity.DefaultServiceURL
+	if c.Visibility == "private" || c.Visibility == "public-and-private" {
+		if c.Region == "us-south" || c.Region == "us-east" {
+			iamURL = ContructEndpoint(fmt.Sprintf("private.%s.iam", c.Region), cloudEndpoint)
+		} else {
+			iamURL = ContructEndpoint("private.iam", cloudEndpoint)
+		}
+	}
+	if fileMap != nil && c.Visibility != "public-and-private" {
+		iamURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IAM_API_ENDPOINT", c.Region, iamURL)
+	}
+	return iamURL
+}
+
 func EnvFallBack(envs []string, defaultValue string) string {
 	for _, k := range envs {
 		if v := os.Getenv(k); v != "" {
@@ -3430,6 +3891,39 @@ func fileFallBack(fileMap map[string]interface{}, visibility, key, region, defau
 	return defaultValue
 }
 
+// newIAMAuthenticator returns the IAM authenticator of the API key, or else of the refresh token,
+// of the provider. It returns nil when neither is set.
+func newIAMAuthenticator(apiKey, refreshToken, iamURL string, client *gohttp.Client) *core.IamAuthenticator {
+	var iamAuthenticator *core.IamAuthenticator
+	if apiKey != "" {
+		iamAuthenticator = &core.IamAuthenticator{
+			ApiKey: apiKey,
+			URL:    EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
+		}
+	} else if refreshToken != "" {
+		// Construct the IamAuthenticator with the IAM refresh token.
+		iamAuthenticator = &core.IamAuthenticator{
+			RefreshToken: refreshToken,
+			ClientId:     "bx",
+			ClientSecret: "bx",
+			URL:          EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, iamURL),
+		}
+	} else {
+		return nil
+	}
+	iamAuthenticator.Client = client
+	return iamAuthenticator
+}
+
+// kpTransport returns the transport of the Key Protect clients, which use the tokens of the
+// assumed trusted profile when one is configured
+func (sess clientSession) kpTransport() gohttp.RoundTripper {
+	if sess.iamAssumeTokenManager != nil {
+		return sess.iamAssumeTokenManager.Transport(DefaultTransport())
+	}
+	return DefaultTransport()
+}
+
 // DefaultTransport ...
 func DefaultTransport() gohttp.RoundTripper {
 	transport := &gohttp.Transport{
@@ -3440,7 +3934,7 @@ func DefaultTransport() gohttp.RoundTripper {
 			InsecureSkipVerify: false,
 		},
 	}
-	return transport
+	return WrapVCRTransport(transport)
 }
 
 func isRetryable(err error) bool {
@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"crypto/tls"
+	"net"
+	gohttp "net/http"
+	"time"
+)
+
+const (
+	// DefaultMaxIdleConnsPerHost is the number of idle connections kept open to each service endpoint
+	DefaultMaxIdleConnsPerHost = 32
+	// DefaultIdleConnTimeout is the time an idle connection is kept open
+	DefaultIdleConnTimeout = 90 * time.Second
+	// DefaultKeepAlive is the interval of the TCP keep-alive probes
+	DefaultKeepAlive = 30 * time.Second
+	// DefaultTLSSessionCacheSize is the number of TLS sessions cached to resume the handshakes
+	DefaultTLSSessionCacheSize = 64
+	// DefaultHTTPTimeout is the time limit of a request, the default of ibmcloud_timeout
+	DefaultHTTPTimeout = 60 * time.Second
+)
+
+// HTTPTransportConfig holds the tuning of the HTTP transport shared by the service clients
+type HTTPTransportConfig struct {
+	// Maximum number of idle connections kept to each host
+	MaxIdleConnsPerHost int
+	// Time after which an idle connection is closed
+	IdleConnTimeout time.Duration
+	// Interval of the TCP keep-alive probes, the default is used when zero and the probes are disabled when negative
+	KeepAlive time.Duration
+	// Number of TLS sessions cached for resumption, the cache is disabled when zero
+	TLSSessionCacheSize int
+	// Time limit of a request, including the connection and the read of the response body
+	Timeout time.Duration
+}
+
+// DefaultHTTPTransportConfig returns the transport tuning used when the provider does not set it
+func DefaultHTTPTransportConfig() HTTPTransportConfig {
+	return HTTPTransportConfig{
+		MaxIdleConnsPerHost: DefaultMaxIdleConnsPerHost,
+		IdleConnTimeout:     DefaultIdleConnTimeout,
+		KeepAlive:           DefaultKeepAlive,
+		TLSSessionCacheSize: DefaultTLSSessionCacheSize,
+		Timeout:             DefaultHTTPTimeout,
+	}
+}
+
+// NewHTTPClient returns the HTTP client shared by all the service clients of a session, so that the
+// connections to the service endpoints are pooled and reused instead of being opened by each client.
+// The settings that are zero use their default. The requests are recorded or replayed when
+// IBMCLOUD_VCR_MODE is set.
+func NewHTTPClient(config HTTPTransportConfig) *gohttp.Client {
+	if config.MaxIdleConnsPerHost <= 0 {
+		config.MaxIdleConnsPerHost = DefaultMaxIdleConnsPerHost
+	}
+	if config.IdleConnTimeout <= 0 {
+		config.IdleConnTimeout = DefaultIdleConnTimeout
+	}
+	if config.KeepAlive == 0 {
+		config.KeepAlive = DefaultKeepAlive
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = DefaultHTTPTimeout
+	}
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	if config.TLSSessionCacheSize > 0 {
+		tlsConfig.ClientSessionCache = tls.NewLRUClientSessionCache(config.TLSSessionCacheSize)
+	}
+	// A negative interval disables the TCP keep-alive probes, the idle connections are still reused
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: config.KeepAlive,
+	}
+	transport := &gohttp.Transport{
+		Proxy:                 gohttp.ProxyFromEnvironment,
+		DialContext:           dialer.DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConnsPerHost:   config.MaxIdleConnsPerHost,
+		IdleConnTimeout:       config.IdleConnTimeout,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+		TLSClientConfig:       tlsConfig,
+	}
+	return &gohttp.Client{
+		Transport: WrapVCRTransport(transport),
+		Timeout:   config.Timeout,
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestNewHTTPClient(t *testing.T) {
+	client := NewHTTPClient(DefaultHTTPTransportConfig())
+	transport := client.Transport.(*http.Transport)
+	if transport.MaxIdleConnsPerHost != DefaultMaxIdleConnsPerHost {
+		t.Fatalf("expected %d idle connections per host, got %d", DefaultMaxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+	}
+	if transport.IdleConnTimeout != DefaultIdleConnTimeout {
+		t.Fatalf("expected an idle connection timeout of %s, got %s", DefaultIdleConnTimeout, transport.IdleConnTimeout)
+	}
+	if transport.DisableKeepAlives {
+		t.Fatalf("expected keep-alives to be enabled")
+	}
+	if transport.TLSClientConfig.ClientSessionCache == nil {
+		t.Fatalf("expected a TLS session cache")
+	}
+	if client.Timeout != DefaultHTTPTimeout {
+		t.Fatalf("expected a timeout of %s, got %s", DefaultHTTPTimeout, client.Timeout)
+	}
+}
+
+func TestNewHTTPClientDefaults(t *testing.T) {
+	client := NewHTTPClient(HTTPTransportConfig{})
+	transport := client.Transport.(*http.Transport)
+	if client.Timeout != DefaultHTTPTimeout {
+		t.Fatalf("expected a timeout of %s, got %s", DefaultHTTPTimeout, client.Timeout)
+	}
+	if transport.MaxIdleConnsPerHost != DefaultMaxIdleConnsPerHost {
+		t.Fatalf("expected %d idle connections per host, got %d", DefaultMaxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+	}
+	if transport.IdleConnTimeout != DefaultIdleConnTimeout {
+		t.Fatalf("expected an idle connection timeout of %s, got %s", DefaultIdleConnTimeout, transport.IdleConnTimeout)
+	}
+	if transport.DisableKeepAlives {
+		t.Fatalf("expected keep-alives to be enabled")
+	}
+	if transport.TLSClientConfig.ClientSessionCache != nil {
+		t.Fatalf("expected no TLS session cache")
+	}
+}
+
+func TestNewHTTPClientTimeout(t *testing.T) {
+	client := NewHTTPClient(HTTPTransportConfig{Timeout: 5 * time.Minute})
+	if client.Timeout != 5*time.Minute {
+		t.Fatalf("expected a timeout of %s, got %s", 5*time.Minute, client.Timeout)
+	}
+}
+
+func TestNewHTTPClientKeepAliveProbesDisabled(t *testing.T) {
+	client := NewHTTPClient(HTTPTransportConfig{KeepAlive: -1})
+	transport := client.Transport.(*http.Transport)
+	// Disabling the probes still reuses the idle connections
+	if transport.DisableKeepAlives {
+		t.Fatalf("expected keep-alives to be enabled")
+	}
+}
@@ -0,0 +1,306 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	gohttp "net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// TokenManager is the authenticator shared by the service clients of a session. It caches the IAM
+// access token in memory and, when a cache path is configured, on disk so that the next runs of the
+// provider reuse it. Concurrent callers that need a new token share a single token request.
+type TokenManager struct {
+	authenticator tokenRequester
+	cachePath     string
+	cacheKey      string
+
+	mutex    sync.Mutex
+	token    *cachedToken
+	inflight *tokenRequest
+}
+
+// tokenRequester requests new IAM access tokens, like the IAM authenticator
+type tokenRequester interface {
+	AuthenticationType() string
+	Validate() error
+	RequestToken() (*core.IamTokenServerResponse, error)
+}
+
+type cachedToken struct {
+	AccessToken string `json:"access_token"`
+	Expiration  int64  `json:"expiration"`
+	RefreshTime int64  `json:"refresh_time"`
+}
+
+type tokenRequest struct {
+	done  chan struct{}
+	token *cachedToken
+	err   error
+}
+
+// NewTokenManager returns a token manager requesting the tokens with the given IAM authenticator.
+// The tokens are only cached in memory when cachePath is empty.
+func NewTokenManager(authenticator *core.IamAuthenticator, cachePath string) *TokenManager {
+	return newTokenManager(authenticator, iamCredential(authenticator), cachePath)
+}
+
+// NewAssumeTokenManager returns a token manager requesting the tokens of a trusted profile with the
+// given assume authenticator. The tokens are only cached in memory when cachePath is empty.
+func NewAssumeTokenManager(authenticator *AssumeAuthenticator, cachePath string) *TokenManager {
+	credential := iamCredential(authenticator.Authenticator) + "|" + authenticator.ProfileID + "|" + authenticator.ProfileName + "|" + authenticator.AccountID
+	return newTokenManager(authenticator, credential, cachePath)
+}
+
+func newTokenManager(authenticator tokenRequester, credential, cachePath string) *TokenManager {
+	sum := sha256.Sum256([]byte(credential))
+	return &TokenManager{
+		authenticator: authenticator,
+		cachePath:     cachePath,
+		cacheKey:      hex.EncodeToString(sum[:]),
+	}
+}
+
+func iamCredential(authenticator *core.IamAuthenticator) string {
+	return authenticator.ApiKey + "|" + authenticator.RefreshToken + "|" + authenticator.URL
+}
+
+// AuthenticationType returns the authentication type of the underlying authenticator
+func (tm *TokenManager) AuthenticationType() string {
+	return tm.authenticator.AuthenticationType()
+}
+
+// Validate validates the configuration of the underlying authenticator
+func (tm *TokenManager) Validate() error {
+	return tm.authenticator.Validate()
+}
+
+// Authenticate adds the shared access token to the Authorization header of the request
+func (tm *TokenManager) Authenticate(request *gohttp.Request) error {
+	token, err := tm.GetToken()
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// SeedToken makes the manager use an access token that was already obtained with the same
+// credentials, such as the token of the Bluemix session, until it needs to be refreshed.
+func (tm *TokenManager) SeedToken(accessToken string) {
+	token, err := newCachedTokenFromJWT(strings.TrimPrefix(accessToken, "Bearer "))
+	if err != nil {
+		log.Printf("[DEBUG] Not reusing the IAM access token of the session: %s", err)
+		return
+	}
+	tm.mutex.Lock()
+	defer tm.mutex.Unlock()
+	if tm.token == nil || tm.token.Expiration < token.Expiration {
+		tm.token = token
+	}
+}
+
+// GetToken returns a valid access token. An expired token is requested synchronously, while a token
+// that reached its refresh time is refreshed in the background and keeps being used meanwhile.
+func (tm *TokenManager) GetToken() (string, error) {
+	tm.mutex.Lock()
+	if tm.token == nil {
+		tm.token = tm.readCache()
+	}
+	now := core.GetCurrentTime()
+	if token := tm.token; token != nil && token.AccessToken != "" && now < token.Expiration {
+		if now > token.RefreshTime && tm.inflight == nil {
+			tm.startRequest()
+		}
+		tm.mutex.Unlock()
+		return token.AccessToken, nil
+	}
+	request := tm.inflight
+	if request == nil {
+		request = tm.startRequest()
+	}
+	tm.mutex.Unlock()
+
+	<-request.done
+	if request.err != nil {
+		return "", request.err
+	}
+	return request.token.AccessToken, nil
+}
+
+// startRequest starts the token request shared by all the callers until it completes. It must be
+// called with the mutex held.
+func (tm *TokenManager) startRequest() *tokenRequest {
+	request := &tokenRequest{done: make(chan struct{})}
+	tm.inflight = request
+	go func() {
+		request.token, request.err = tm.requestToken()
+		tm.mutex.Lock()
+		if request.err == nil {
+			tm.token = request.token
+			tm.writeCache(request.token)
+		}
+		tm.inflight = nil
+		tm.mutex.Unlock()
+		close(request.done)
+	}()
+	return request
+}
+
+func (tm *TokenManager) requestToken() (*cachedToken, error) {
+	response, err := tm.authenticator.RequestToken()
+	if err != nil {
+		return nil, err
+	}
+	if response == nil || response.AccessToken == "" {
+		return nil, fmt.Errorf("[ERROR] Error while trying to get access token")
+	}
+	// Refresh the token once 80% of its lifetime has passed, like the IAM authenticator does
+	return &cachedToken{
+		AccessToken: response.AccessToken,
+		Expiration:  response.Expiration,
+		RefreshTime: response.Expiration - int64(float64(response.ExpiresIn)*0.2),
+	}, nil
+}
+
+// Transport returns a round tripper that replaces the bearer token of the requests with the access
+// token of the manager, for the clients that only take an access token when they are configured.
+func (tm *TokenManager) Transport(base gohttp.RoundTripper) gohttp.RoundTripper {
+	if base == nil {
+		base = gohttp.DefaultTransport
+	}
+	return &bearerTransport{tokenManager: tm, base: base}
+}
+
+type bearerTransport struct {
+	tokenManager *TokenManager
+	base         gohttp.RoundTripper
+}
+
+func (t *bearerTransport) RoundTrip(request *gohttp.Request) (*gohttp.Response, error) {
+	// The requests of the clients to IAM itself use basic authentication and are left untouched
+	if !strings.HasPrefix(request.Header.Get("Authorization"), "Bearer ") {
+		return t.base.RoundTrip(request)
+	}
+	token, err := t.tokenManager.GetToken()
+	if err != nil {
+		return nil, err
+	}
+	request = request.Clone(request.Context())
+	request.Header.Set("Authorization", "Bearer "+token)
+	return t.base.RoundTrip(request)
+}
+
+// readCache returns the token of the credentials of the manager stored in the cache file, if any
+func (tm *TokenManager) readCache() *cachedToken {
+	if tm.cachePath == "" {
+		return nil
+	}
+	tokens, err := readTokenCacheFile(tm.cachePath)
+	if err != nil {
+		log.Printf("[DEBUG] Error reading the IAM token cache %s: %s", tm.cachePath, err)
+		return nil
+	}
+	return tokens[tm.cacheKey]
+}
+
+// writeCache stores the token in the cache file, keeping the tokens of other credentials
+func (tm *TokenManager) writeCache(token *cachedToken) {
+	if tm.cachePath == "" {
+		return
+	}
+	tokens, err := readTokenCacheFile(tm.cachePath)
+	if err != nil {
+		tokens = map[string]*cachedToken{}
+	}
+	now := core.GetCurrentTime()
+	for key, t := range tokens {
+		if t == nil || t.Expiration <= now {
+			delete(tokens, key)
+		}
+	}
+	tokens[tm.cacheKey] = token
+	if err := writeTokenCacheFile(tm.cachePath, tokens); err != nil {
+		log.Printf("[DEBUG] Error writing the IAM token cache %s: %s", tm.cachePath, err)
+	}
+}
+
+func readTokenCacheFile(path string) (map[string]*cachedToken, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	tokens := map[string]*cachedToken{}
+	if err := json.Unmarshal(data, &tokens); err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// writeTokenCacheFile replaces the cache file atomically, readable by the owner only
+func writeTokenCacheFile(path string, tokens map[string]*cachedToken) error {
+	data, err := json.Marshal(tokens)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(0600); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// newCachedTokenFromJWT reads the lifetime of an access token from its claims
+func newCachedTokenFromJWT(accessToken string) (*cachedToken, error) {
+	parts := strings.Split(accessToken, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("the access token is not a JWT")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, err
+	}
+	var claims struct {
+		IssuedAt   int64 `json:"iat"`
+		Expiration int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, err
+	}
+	if claims.Expiration <= core.GetCurrentTime() {
+		return nil, fmt.Errorf("the access token is expired")
+	}
+	return &cachedToken{
+		AccessToken: accessToken,
+		Expiration:  claims.Expiration,
+		RefreshTime: claims.Expiration - int64(float64(claims.Expiration-claims.IssuedAt)*0.2),
+	}, nil
+}
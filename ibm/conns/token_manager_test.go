@@ -0,0 +1,158 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func testIAMServer(t *testing.T, requests *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(requests, 1)
+		// Give the concurrent callers the time to wait on the same request
+		time.Sleep(50 * time.Millisecond)
+		now := time.Now().Unix()
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"%s","refresh_token":"refresh","token_type":"Bearer","expires_in":3600,"expiration":%d}`,
+			testJWT(now, now+3600, n), now+3600)
+	}))
+}
+
+func testJWT(iat, exp int64, n int32) string {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"iat":%d,"exp":%d,"n":%d}`, iat, exp, n)))
+	return "eyJhbGciOiJub25lIn0." + payload + ".signature"
+}
+
+func TestTokenManagerSharesTokenRequest(t *testing.T) {
+	var requests int32
+	server := testIAMServer(t, &requests)
+	defer server.Close()
+
+	tm := NewTokenManager(&core.IamAuthenticator{ApiKey: "apikey", URL: server.URL}, "")
+
+	var wg sync.WaitGroup
+	tokens := make([]string, 10)
+	for i := range tokens {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			token, err := tm.GetToken()
+			if err != nil {
+				t.Errorf("GetToken failed: %s", err)
+			}
+			tokens[i] = token
+		}(i)
+	}
+	wg.Wait()
+
+	if requests != 1 {
+		t.Fatalf("expected 1 token request, got %d", requests)
+	}
+	for _, token := range tokens {
+		if token != tokens[0] {
+			t.Fatalf("expected the callers to share the same token")
+		}
+	}
+}
+
+func TestTokenManagerSeedToken(t *testing.T) {
+	var requests int32
+	server := testIAMServer(t, &requests)
+	defer server.Close()
+
+	tm := NewTokenManager(&core.IamAuthenticator{ApiKey: "apikey", URL: server.URL}, "")
+	now := time.Now().Unix()
+	seed := testJWT(now, now+3600, 0)
+	tm.SeedToken("Bearer " + seed)
+
+	token, err := tm.GetToken()
+	if err != nil {
+		t.Fatalf("GetToken failed: %s", err)
+	}
+	if token != seed || requests != 0 {
+		t.Fatalf("expected the seeded token to be used without a token request, got %d requests", requests)
+	}
+}
+
+func TestTokenManagerCacheFile(t *testing.T) {
+	var requests int32
+	server := testIAMServer(t, &requests)
+	defer server.Close()
+
+	cachePath := filepath.Join(t.TempDir(), "cache", "tokens.json")
+	authenticator := &core.IamAuthenticator{ApiKey: "apikey", URL: server.URL}
+
+	token, err := NewTokenManager(authenticator, cachePath).GetToken()
+	if err != nil {
+		t.Fatalf("GetToken failed: %s", err)
+	}
+
+	cached, err := NewTokenManager(authenticator, cachePath).GetToken()
+	if err != nil {
+		t.Fatalf("GetToken failed: %s", err)
+	}
+	if cached != token || requests != 1 {
+		t.Fatalf("expected the token to be read from the cache file, got %d requests", requests)
+	}
+
+	other, err := NewTokenManager(&core.IamAuthenticator{ApiKey: "other", URL: server.URL}, cachePath).GetToken()
+	if err != nil {
+		t.Fatalf("GetToken failed: %s", err)
+	}
+	if other == token || requests != 2 {
+		t.Fatalf("expected other credentials not to use the cached token, got %d requests", requests)
+	}
+
+	tokens, err := readTokenCacheFile(cachePath)
+	if err != nil {
+		t.Fatalf("reading the cache file failed: %s", err)
+	}
+	if len(tokens) != 2 {
+		t.Fatalf("expected 2 cached tokens, got %d", len(tokens))
+	}
+}
+
+func TestTokenManagerTransport(t *testing.T) {
+	var requests int32
+	server := testIAMServer(t, &requests)
+	defer server.Close()
+
+	var authorizations []string
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+	}))
+	defer backend.Close()
+
+	tm := NewTokenManager(&core.IamAuthenticator{ApiKey: "apikey", URL: server.URL}, "")
+	client := &http.Client{Transport: tm.Transport(nil)}
+	for _, authorization := range []string{"Bearer expired", "Basic Yng6Yng="} {
+		request, _ := http.NewRequest(http.MethodGet, backend.URL, nil)
+		request.Header.Set("Authorization", authorization)
+		response, err := client.Do(request)
+		if err != nil {
+			t.Fatalf("request failed: %s", err)
+		}
+		response.Body.Close()
+	}
+
+	token, _ := tm.GetToken()
+	if authorizations[0] != "Bearer "+token {
+		t.Fatalf("expected the bearer token to be replaced with the token of the manager, got %q", authorizations[0])
+	}
+	if authorizations[1] != "Basic Yng6Yng=" {
+		t.Fatalf("expected the basic authorization to be kept, got %q", authorizations[1])
+	}
+	if requests != 1 {
+		t.Fatalf("expected 1 token request, got %d", requests)
+	}
+}
@@ -0,0 +1,326 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	gohttp "net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+const (
+	// VCRModeRecord records the HTTP interactions of the service clients in the cassette
+	VCRModeRecord = "record"
+	// VCRModeReplay replays the HTTP interactions of the cassette instead of calling the services
+	VCRModeReplay = "replay"
+
+	vcrModeEnv     = "IBMCLOUD_VCR_MODE"
+	vcrCassetteEnv = "IBMCLOUD_VCR_CASSETTE"
+	vcrRedacted    = "REDACTED"
+)
+
+// The credentials and the tokens of the IAM token exchanges are not written in the cassettes
+var vcrSecrets = []*regexp.Regexp{
+	regexp.MustCompile(`((?:apikey|refresh_token|delegated_refresh_token|access_token|client_secret|password)=)[^&]*`),
+	regexp.MustCompile(`("(?:apikey|refresh_token|delegated_refresh_token|ims_token|api_key|password)"\s*:\s*")[^"]*`),
+	regexp.MustCompile(`((?i:bearer)\s+)[A-Za-z0-9\-_.~+/]{20,}=*`),
+}
+
+// vcrAccessToken matches the access tokens of the IAM responses, they are replaced by synthetic tokens
+var vcrAccessToken = regexp.MustCompile(`("access_token"\s*:\s*")([^"]*)`)
+
+// vcrTokenClaims are the claims of the access tokens that are kept in the synthetic tokens, the session reads
+// the user and the account of the provider from them
+var vcrTokenClaims = []string{"iam_id", "id", "sub", "realmid", "iss", "iat", "exp", "account"}
+
+// vcrSecretHeaders are the headers whose values are not written in the cassettes
+var vcrSecretHeaders = []string{"Authorization", "X-Auth-Refresh-Token", "X-Auth-Token", "Refresh-Token"}
+
+// VCRInteraction is a request of a service client and the response of the service
+type VCRInteraction struct {
+	Method        string              `json:"method"`
+	URL           string              `json:"url"`
+	RequestHeader map[string][]string `json:"request_header,omitempty"`
+	RequestBody   string              `json:"request_body,omitempty"`
+	StatusCode    int                 `json:"status_code"`
+	Header        map[string][]string `json:"header,omitempty"`
+	ResponseBody  string              `json:"response_body,omitempty"`
+}
+
+// VCRCassette is the file of the interactions recorded by a test
+type VCRCassette struct {
+	Interactions []*VCRInteraction `json:"interactions"`
+}
+
+// vcrTest is the test that uses a cassette, testing.T is not imported outside of the tests
+type vcrTest interface {
+	Name() string
+	Cleanup(func())
+}
+
+// vcrRecorder is shared by the transports of all the service clients, so a cassette is used by one
+// test at a time. The tests that use cassettes can't run in parallel.
+type vcrRecorder struct {
+	mu       sync.Mutex
+	owner    string
+	path     string
+	cassette *VCRCassette
+	replayed map[*VCRInteraction]bool
+}
+
+var vcr = &vcrRecorder{
+	path:     os.Getenv(vcrCassetteEnv),
+	cassette: &VCRCassette{},
+	replayed: map[*VCRInteraction]bool{},
+}
+
+// VCRMode returns the mode of the recorder set with IBMCLOUD_VCR_MODE, or an empty string when the
+// service clients call the services without recording
+func VCRMode() string {
+	switch mode := os.Getenv(vcrModeEnv); mode {
+	case VCRModeRecord, VCRModeReplay:
+		return mode
+	case "":
+		return ""
+	default:
+		log.Printf("[WARN] Unknown %s %q, the HTTP interactions are not recorded", vcrModeEnv, mode)
+		return ""
+	}
+}
+
+// UseVCRCassette selects the cassette that the HTTP interactions of the test are recorded in or
+// replayed from, the cassette is loaded in replay mode and emptied in record mode. The cassette is
+// released when the test ends, it fails while another test uses a cassette, for example a test that
+// runs in parallel with t.Parallel.
+func UseVCRCassette(test vcrTest, path string) error {
+	vcr.mu.Lock()
+	defer vcr.mu.Unlock()
+	if vcr.owner != "" && vcr.owner != test.Name() {
+		return fmt.Errorf("[ERROR] The cassette %s of test %s is in use, the tests that use cassettes can't run in parallel with %s, run them with -parallel 1", vcr.path, vcr.owner, test.Name())
+	}
+	if vcr.owner == "" {
+		test.Cleanup(func() {
+			vcr.mu.Lock()
+			defer vcr.mu.Unlock()
+			vcr.owner = ""
+		})
+	}
+	vcr.owner = test.Name()
+	vcr.path = path
+	vcr.cassette = &VCRCassette{}
+	vcr.replayed = map[*VCRInteraction]bool{}
+	if VCRMode() != VCRModeReplay {
+		return nil
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error reading the cassette %s: %s", path, err)
+	}
+	if err := json.Unmarshal(data, vcr.cassette); err != nil {
+		return fmt.Errorf("[ERROR] Error parsing the cassette %s: %s", path, err)
+	}
+	return nil
+}
+
+// WrapVCRTransport returns the transport that records or replays the requests of next according to
+// IBMCLOUD_VCR_MODE, next is returned unchanged when the recorder is disabled
+func WrapVCRTransport(next gohttp.RoundTripper) gohttp.RoundTripper {
+	if VCRMode() == "" {
+		return next
+	}
+	return &vcrTransport{next: next}
+}
+
+// vcrHTTPClient returns the HTTP client of the clients that build their own HTTP client, nil when the
+// recorder is disabled so that they keep their default client
+func vcrHTTPClient() *gohttp.Client {
+	if VCRMode() == "" {
+		return nil
+	}
+	return &gohttp.Client{Transport: WrapVCRTransport(gohttp.DefaultTransport)}
+}
+
+type vcrTransport struct {
+	next gohttp.RoundTripper
+}
+
+func (t *vcrTransport) RoundTrip(request *gohttp.Request) (*gohttp.Response, error) {
+	requestBody, err := readVCRBody(&request.Body)
+	if err != nil {
+		return nil, err
+	}
+	requestBody = redactVCRSecrets(requestBody)
+	if VCRMode() == VCRModeReplay {
+		return vcr.replay(request, requestBody)
+	}
+
+	response, err := t.next.RoundTrip(request)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := readVCRBody(&response.Body)
+	if err != nil {
+		return nil, err
+	}
+	header := redactVCRHeader(response.Header)
+	header.Del("Set-Cookie")
+	vcr.record(&VCRInteraction{
+		Method:        request.Method,
+		URL:           request.URL.String(),
+		RequestHeader: redactVCRHeader(request.Header),
+		RequestBody:   requestBody,
+		StatusCode:    response.StatusCode,
+		Header:        header,
+		ResponseBody:  redactVCRSecrets(responseBody),
+	})
+	return response, nil
+}
+
+// readVCRBody reads the body and replaces it with a copy, so that it can still be read by the client
+func readVCRBody(body *io.ReadCloser) (string, error) {
+	if *body == nil {
+		return "", nil
+	}
+	data, err := ioutil.ReadAll(*body)
+	(*body).Close()
+	if err != nil {
+		return "", err
+	}
+	*body = ioutil.NopCloser(bytes.NewReader(data))
+	return string(data), nil
+}
+
+func redactVCRSecrets(s string) string {
+	for _, secret := range vcrSecrets {
+		s = secret.ReplaceAllString(s, "${1}"+vcrRedacted)
+	}
+	return vcrAccessToken.ReplaceAllStringFunc(s, func(match string) string {
+		parts := vcrAccessToken.FindStringSubmatch(match)
+		return parts[1] + syntheticVCRAccessToken(parts[2])
+	})
+}
+
+// syntheticVCRAccessToken returns a token without signature that only has the claims of the access token that
+// are not secret, so that the replayed sessions still find the user and the account of the provider. The
+// algorithm of the header is kept, the session doesn't verify the signature of the token.
+func syntheticVCRAccessToken(token string) string {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return vcrRedacted
+	}
+	header, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[0], "="))
+	if err != nil {
+		return vcrRedacted
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return vcrRedacted
+	}
+	var claims map[string]interface{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return vcrRedacted
+	}
+	kept := map[string]interface{}{}
+	for _, name := range vcrTokenClaims {
+		if value, ok := claims[name]; ok {
+			kept[name] = value
+		}
+	}
+	// Only the ID of the account is kept from the account claim
+	if account, ok := kept["account"].(map[string]interface{}); ok {
+		kept["account"] = map[string]interface{}{"bss": account["bss"]}
+	}
+	payload, err = json.Marshal(kept)
+	if err != nil {
+		return vcrRedacted
+	}
+	return base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload) + "."
+}
+
+// redactVCRHeader returns a copy of the header without the values of the credentials
+func redactVCRHeader(header gohttp.Header) gohttp.Header {
+	redacted := header.Clone()
+	if redacted == nil {
+		return gohttp.Header{}
+	}
+	for _, name := range vcrSecretHeaders {
+		if redacted.Get(name) != "" {
+			redacted.Set(name, vcrRedacted)
+		}
+	}
+	for name, values := range redacted {
+		for i, value := range values {
+			values[i] = redactVCRSecrets(value)
+		}
+		redacted[name] = values
+	}
+	return redacted
+}
+
+// record appends the interaction and saves the cassette, the provider has no hook to save it on exit
+func (r *vcrRecorder) record(interaction *VCRInteraction) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	if r.path == "" {
+		return
+	}
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err == nil {
+		if err = os.MkdirAll(filepath.Dir(r.path), 0755); err == nil {
+			err = ioutil.WriteFile(r.path, data, 0644)
+		}
+	}
+	if err != nil {
+		log.Printf("[WARN] Error saving the cassette %s: %s", r.path, err)
+	}
+}
+
+// replay returns the response of the first interaction of the request, with the same method, URL and
+// body, that was not replayed yet, so that the interactions of a request are replayed in the order
+// they were recorded. The last one is replayed again once they are all replayed, for the polling and
+// the token refreshes.
+func (r *vcrRecorder) replay(request *gohttp.Request, requestBody string) (*gohttp.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var match *VCRInteraction
+	for _, interaction := range r.cassette.Interactions {
+		if interaction.Method != request.Method || interaction.URL != request.URL.String() || interaction.RequestBody != requestBody {
+			continue
+		}
+		match = interaction
+		if !r.replayed[interaction] {
+			break
+		}
+	}
+	if match == nil {
+		return nil, fmt.Errorf("[ERROR] The cassette %s has no interaction for %s %s with the body of the request", r.path, request.Method, request.URL)
+	}
+	r.replayed[match] = true
+	header := gohttp.Header(match.Header).Clone()
+	if header == nil {
+		header = gohttp.Header{}
+	}
+	return &gohttp.Response{
+		Status:        fmt.Sprintf("%d %s", match.StatusCode, gohttp.StatusText(match.StatusCode)),
+		StatusCode:    match.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader([]byte(match.ResponseBody))),
+		ContentLength: int64(len(match.ResponseBody)),
+		Request:       request,
+	}, nil
+}
@@ -0,0 +1,231 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	jwt "github.com/golang-jwt/jwt"
+)
+
+func TestVCRRecordAndReplay(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"name":"vpc","refresh_token":"secret"}`))
+	}))
+	defer server.Close()
+	cassette := filepath.Join(t.TempDir(), "cassette.json")
+
+	os.Setenv(vcrModeEnv, VCRModeRecord)
+	defer os.Unsetenv(vcrModeEnv)
+	if err := UseVCRCassette(t, cassette); err != nil {
+		t.Fatalf("UseVCRCassette failed: %s", err)
+	}
+	client := NewHTTPClient(DefaultHTTPTransportConfig())
+	response, err := client.Post(server.URL+"/v1/vpcs", "application/x-www-form-urlencoded", strings.NewReader("apikey=secret"))
+	if err != nil {
+		t.Fatalf("recording failed: %s", err)
+	}
+	body, _ := ioutil.ReadAll(response.Body)
+	if !strings.Contains(string(body), `"secret"`) {
+		t.Fatalf("expected the client to read the response of the service, got %s", body)
+	}
+	data, err := ioutil.ReadFile(cassette)
+	if err != nil {
+		t.Fatalf("reading the cassette failed: %s", err)
+	}
+	if strings.Contains(string(data), "secret") {
+		t.Fatalf("expected the credentials to be redacted in the cassette, got %s", data)
+	}
+
+	os.Setenv(vcrModeEnv, VCRModeReplay)
+	if err := UseVCRCassette(t, cassette); err != nil {
+		t.Fatalf("UseVCRCassette failed: %s", err)
+	}
+	client = NewHTTPClient(DefaultHTTPTransportConfig())
+	for i := 0; i < 2; i++ {
+		response, err = client.Post(server.URL+"/v1/vpcs", "application/x-www-form-urlencoded", strings.NewReader("apikey=other"))
+		if err != nil {
+			t.Fatalf("replaying failed: %s", err)
+		}
+		body, _ = ioutil.ReadAll(response.Body)
+		if response.StatusCode != http.StatusOK || !strings.Contains(string(body), `"name":"vpc"`) {
+			t.Fatalf("unexpected replayed response %d %s", response.StatusCode, body)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("expected 1 call to the service, got %d", calls)
+	}
+	if _, err := client.Get(server.URL + "/v1/subnets"); err == nil {
+		t.Fatalf("expected an error for a request that is not in the cassette")
+	}
+}
+
+func TestVCRRedactsTokenExchange(t *testing.T) {
+	tokens := []string{"eyJhbGciOiJSUzI1NiJ9.access.token", "refresh-token-value", "delegated-refresh-token-value", "eyJhbGciOiJSUzI1NiJ9.request.token"}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Auth-Refresh-Token", tokens[1])
+		w.Write([]byte(`{"access_token":"` + tokens[0] + `","refresh_token":"` + tokens[1] + `","delegated_refresh_token":"` + tokens[2] + `","token_type":"Bearer","expires_in":3600}`))
+	}))
+	defer server.Close()
+	cassette := filepath.Join(t.TempDir(), "cassette.json")
+
+	os.Setenv(vcrModeEnv, VCRModeRecord)
+	defer os.Unsetenv(vcrModeEnv)
+	if err := UseVCRCassette(t, cassette); err != nil {
+		t.Fatalf("UseVCRCassette failed: %s", err)
+	}
+	client := NewHTTPClient(DefaultHTTPTransportConfig())
+	body := "grant_type=urn:ibm:params:oauth:grant-type:apikey&apikey=secret&response_type=cloud_iam,delegated_refresh_token&access_token=" + tokens[3]
+	request, _ := http.NewRequest(http.MethodPost, server.URL+"/identity/token", strings.NewReader(body))
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Authorization", "Bearer "+tokens[3])
+	request.Header.Set("X-Auth-Refresh-Token", tokens[1])
+	response, err := client.Do(request)
+	if err != nil {
+		t.Fatalf("recording failed: %s", err)
+	}
+	response.Body.Close()
+
+	data, err := ioutil.ReadFile(cassette)
+	if err != nil {
+		t.Fatalf("reading the cassette failed: %s", err)
+	}
+	for _, token := range append(tokens, "secret") {
+		if strings.Contains(string(data), token) {
+			t.Fatalf("expected %s to be redacted in the cassette, got %s", token, data)
+		}
+	}
+}
+
+func TestVCRReplayMatchesBodyInOrder(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Write([]byte(fmt.Sprintf(`{"call":%d,"request":%q}`, calls, body)))
+	}))
+	defer server.Close()
+	cassette := filepath.Join(t.TempDir(), "cassette.json")
+
+	os.Setenv(vcrModeEnv, VCRModeRecord)
+	defer os.Unsetenv(vcrModeEnv)
+	if err := UseVCRCassette(t, cassette); err != nil {
+		t.Fatalf("UseVCRCassette failed: %s", err)
+	}
+	client := NewHTTPClient(DefaultHTTPTransportConfig())
+	for _, body := range []string{`{"name":"one"}`, `{"name":"two"}`, ""} {
+		response, err := client.Post(server.URL+"/v1/vpcs", "application/json", strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("recording failed: %s", err)
+		}
+		response.Body.Close()
+	}
+	for i := 0; i < 2; i++ {
+		response, err := client.Get(server.URL + "/v1/vpcs/one")
+		if err != nil {
+			t.Fatalf("recording failed: %s", err)
+		}
+		response.Body.Close()
+	}
+
+	os.Setenv(vcrModeEnv, VCRModeReplay)
+	if err := UseVCRCassette(t, cassette); err != nil {
+		t.Fatalf("UseVCRCassette failed: %s", err)
+	}
+	replay := func(method, url, body string) string {
+		request, _ := http.NewRequest(method, url, strings.NewReader(body))
+		response, err := client.Do(request)
+		if err != nil {
+			t.Fatalf("replaying %s %s %s failed: %s", method, url, body, err)
+		}
+		defer response.Body.Close()
+		data, _ := ioutil.ReadAll(response.Body)
+		return string(data)
+	}
+	if got := replay(http.MethodPost, server.URL+"/v1/vpcs", `{"name":"two"}`); !strings.Contains(got, `"call":2`) {
+		t.Fatalf("expected the interaction of the body of the request, got %s", got)
+	}
+	if got := replay(http.MethodPost, server.URL+"/v1/vpcs", `{"name":"one"}`); !strings.Contains(got, `"call":1`) {
+		t.Fatalf("expected the interaction of the body of the request, got %s", got)
+	}
+	for i, want := range []string{`"call":4`, `"call":5`, `"call":5`} {
+		if got := replay(http.MethodGet, server.URL+"/v1/vpcs/one", ""); !strings.Contains(got, want) {
+			t.Fatalf("expected replay %d of the GET to be %s, got %s", i, want, got)
+		}
+	}
+	if _, err := client.Post(server.URL+"/v1/vpcs", "application/json", strings.NewReader(`{"name":"three"}`)); err == nil {
+		t.Fatalf("expected an error for a body that is not in the cassette")
+	}
+	if calls != 5 {
+		t.Fatalf("expected 5 calls to the service, got %d", calls)
+	}
+}
+
+type vcrTestStub struct {
+	name     string
+	cleanups []func()
+}
+
+func (s *vcrTestStub) Name() string     { return s.name }
+func (s *vcrTestStub) Cleanup(f func()) { s.cleanups = append(s.cleanups, f) }
+
+func TestVCRCassetteInUse(t *testing.T) {
+	first := &vcrTestStub{name: "TestFirst"}
+	second := &vcrTestStub{name: "TestSecond"}
+	if err := UseVCRCassette(first, filepath.Join(t.TempDir(), "first.json")); err != nil {
+		t.Fatalf("UseVCRCassette failed: %s", err)
+	}
+	if err := UseVCRCassette(second, filepath.Join(t.TempDir(), "second.json")); err == nil {
+		t.Fatalf("expected an error while the cassette is used by another test")
+	}
+	for _, cleanup := range first.cleanups {
+		cleanup()
+	}
+	if err := UseVCRCassette(second, filepath.Join(t.TempDir(), "second.json")); err != nil {
+		t.Fatalf("expected the cassette to be released at the end of the test, got %s", err)
+	}
+	for _, cleanup := range second.cleanups {
+		cleanup()
+	}
+}
+
+func TestVCRSyntheticAccessToken(t *testing.T) {
+	encode := func(s string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(s))
+	}
+	token := encode(`{"alg":"RS256","kid":"key"}`) + "." +
+		encode(`{"iam_id":"IBMid-1","id":"IBMid-1","iss":"https://iam.cloud.ibm.com/identity","exp":4102444800,"email":"user@example.com","account":{"bss":"account-1","ims":"123","valid":true}}`) +
+		".c2lnbmF0dXJl"
+	body := redactVCRSecrets(`{"access_token":"` + token + `","refresh_token":"secret","expires_in":3600}`)
+	if strings.Contains(body, token) || strings.Contains(body, "secret") || strings.Contains(body, "user@example.com") {
+		t.Fatalf("expected the tokens and the email to be redacted, got %s", body)
+	}
+
+	synthetic := body[len(`{"access_token":"`):strings.Index(body, `","refresh_token"`)]
+	// The session parses the token without verifying its signature, as fetchUserDetails does
+	parsed, err := jwt.Parse(synthetic, func(token *jwt.Token) (interface{}, error) {
+		return "", nil
+	})
+	if err == nil || !strings.Contains(err.Error(), "key is of invalid type") {
+		t.Fatalf("expected only the signature check to fail, got %v", err)
+	}
+	claims := parsed.Claims.(jwt.MapClaims)
+	if claims["account"].(map[string]interface{})["bss"] != "account-1" || claims["iam_id"] != "IBMid-1" || claims["iss"] != "https://iam.cloud.ibm.com/identity" || claims["exp"] != float64(4102444800) {
+		t.Fatalf("unexpected claims %v", claims)
+	}
+	if _, ok := claims["email"]; ok {
+		t.Fatalf("expected the email to be removed, got %v", claims)
+	}
+}
@@ -20,12 +20,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// SuppressEquivalentJSON suppresses the diff of JSON documents that only differ by whitespace or by the
+// order of the keys of their objects. Lists of key/value objects, such as the annotations and the
+// parameters of the functions, are compared by their keys, regardless of their order.
 func SuppressEquivalentJSON(k, old, new string, d *schema.ResourceData) bool {
 
 	if old == "" {
 		return false
 	}
-	var oldObj, newObj []map[string]interface{}
+	var oldObj, newObj interface{}
 	err := json.Unmarshal([]byte(old), &oldObj)
 	if err != nil {
 		log.Printf("Error unmarshalling old json :: %s", err.Error())
@@ -36,17 +39,39 @@ func SuppressEquivalentJSON(k, old, new string, d *schema.ResourceData) bool {
 		log.Printf("Error unmarshalling new json :: %s", err.Error())
 		return false
 	}
+	return reflect.DeepEqual(keyValueJSON(oldObj), keyValueJSON(newObj))
+}
 
-	oldm := make(map[interface{}]interface{})
-	newm := make(map[interface{}]interface{})
-
-	for _, m := range oldObj {
-		oldm[m["key"]] = m["value"]
+// keyValueJSON returns a list of key/value objects as a map of the values by key, other documents are
+// returned as is.
+func keyValueJSON(doc interface{}) interface{} {
+	list, ok := doc.([]interface{})
+	if !ok || len(list) == 0 {
+		return doc
+	}
+	m := make(map[string]interface{}, len(list))
+	for _, item := range list {
+		obj, ok := item.(map[string]interface{})
+		if !ok {
+			return doc
+		}
+		key, ok := obj["key"].(string)
+		if !ok {
+			return doc
+		}
+		m[key] = obj["value"]
 	}
-	for _, m := range newObj {
-		newm[m["key"]] = m["value"]
+	return m
+}
+
+// NormalizeJSONStateFunc stores a JSON attribute in its normalized form, so that reformatting the JSON
+// in the configuration doesn't change the state. A value that isn't valid JSON is stored as empty.
+func NormalizeJSONStateFunc(v interface{}) string {
+	json, err := NormalizeJSONString(v)
+	if err != nil {
+		return ""
 	}
-	return reflect.DeepEqual(oldm, newm)
+	return json
 }
 
 func SuppressHashedRawSecret(k, old, new string, d *schema.ResourceData) bool {
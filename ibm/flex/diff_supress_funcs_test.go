@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex_test
+
+import (
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestSuppressEquivalentJSON(t *testing.T) {
+	testCases := []struct {
+		name     string
+		old      string
+		new      string
+		expected bool
+	}{
+		{"empty old value", "", `{"a":1}`, false},
+		{"same document", `{"a":1}`, `{"a":1}`, true},
+		{"whitespace", `{"a":1,"b":[1,2]}`, "{\n  \"a\": 1,\n  \"b\": [1, 2]\n}", true},
+		{"key order", `{"a":1,"b":{"c":"d","e":"f"}}`, `{"b":{"e":"f","c":"d"},"a":1}`, true},
+		{"different value", `{"a":1}`, `{"a":2}`, false},
+		{"additional key", `{"a":1}`, `{"a":1,"b":2}`, false},
+		{"list order", `[1,2]`, `[2,1]`, false},
+		{"key/value list order", `[{"key":"a","value":1},{"key":"b","value":2}]`, `[{"value":2,"key":"b"},{"key":"a","value":1}]`, true},
+		{"key/value list value", `[{"key":"a","value":1}]`, `[{"key":"a","value":2}]`, false},
+		{"key/value list and empty list", `[]`, `[{"key":"a","value":1}]`, false},
+		{"list of objects without keys", `[{"name":"a"},{"name":"b"}]`, `[{"name":"b"},{"name":"a"}]`, false},
+		{"invalid old value", `{"a":`, `{"a":1}`, false},
+		{"invalid new value", `{"a":1}`, `{"a":`, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := flex.SuppressEquivalentJSON("key", tc.old, tc.new, nil); actual != tc.expected {
+				t.Errorf("expected %t for %q and %q, got %t", tc.expected, tc.old, tc.new, actual)
+			}
+		})
+	}
+}
+
+func TestNormalizeJSONStateFunc(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    interface{}
+		expected string
+	}{
+		{"empty", "", ""},
+		{"nil", nil, ""},
+		{"object", "{\n  \"b\": 1,\n  \"a\": [1, 2]\n}", `{"a":[1,2],"b":1}`},
+		{"list", `[ {"key": "a", "value": "b"} ]`, `[{"key":"a","value":"b"}]`},
+		{"invalid", `{"a":`, ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := flex.NormalizeJSONStateFunc(tc.value); actual != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSuppressPropertyRawSecretText(t *testing.T) {
+	propertySchema := map[string]*schema.Schema{
+		"pipeline_id": {Type: schema.TypeString, Optional: true},
+		"trigger_id":  {Type: schema.TypeString, Optional: true},
+		"name":        {Type: schema.TypeString, Optional: true},
+		"type":        {Type: schema.TypeString, Optional: true},
+		"value":       {Type: schema.TypeString, Optional: true},
+	}
+	d := schema.TestResourceDataRaw(t, propertySchema, map[string]interface{}{
+		"pipeline_id": "pipeline",
+		"trigger_id":  "trigger",
+		"name":        "property",
+		"type":        "text",
+	})
+	// The text properties are free-form strings, the values that look like JSON are compared as strings
+	testCases := []struct {
+		old      string
+		new      string
+		expected bool
+	}{
+		{"value", "value", true},
+		{"1.0", "1", false},
+		{`{"a":1,"b":2}`, `{"b":2,"a":1}`, false},
+	}
+	for _, tc := range testCases {
+		if actual := flex.SuppressPipelinePropertyRawSecret("value", tc.old, tc.new, d); actual != tc.expected {
+			t.Errorf("pipeline property: expected %t for %q and %q, got %t", tc.expected, tc.old, tc.new, actual)
+		}
+		if actual := flex.SuppressTriggerPropertyRawSecret("value", tc.old, tc.new, d); actual != tc.expected {
+			t.Errorf("trigger property: expected %t for %q and %q, got %t", tc.expected, tc.old, tc.new, actual)
+		}
+	}
+}
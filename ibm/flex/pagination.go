@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+const (
+	paginationMaxRetries   = 5
+	paginationInitialDelay = 2 * time.Second
+	paginationMaxDelay     = 30 * time.Second
+)
+
+// Pager is implemented by the pagers of the IBM Cloud SDKs, such as vpcv1.SubnetsPager
+type Pager[T any] interface {
+	HasNext() bool
+	GetNextWithContext(ctx context.Context) ([]T, error)
+}
+
+// PaginateAll returns the items of all the pages of the pager. The pages are requested until the
+// context is canceled, and the pagination stops with an error when the pager keeps returning empty
+// pages. The pagers don't return the response of the request, rate limited requests are retried by
+// the retries of the SDK client.
+func PaginateAll[T any](ctx context.Context, pager Pager[T]) ([]T, error) {
+	allItems := []T{}
+	emptyPages := 0
+	for pager.HasNext() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		page, err := pager.GetNextWithContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(page) == 0 {
+			// A collection with more pages never returns an empty page, the pager is not moving forward
+			if emptyPages++; emptyPages > 1 {
+				return nil, fmt.Errorf("[ERROR] Error listing the pages: the pages are empty but the collection has more pages")
+			}
+		} else {
+			emptyPages = 0
+		}
+		allItems = append(allItems, page...)
+	}
+	return allItems, nil
+}
+
+// PaginateAllByToken returns the items of all the pages listed by fetch, for the APIs that have no
+// pager in their SDK. fetch lists the page starting at the token, which is empty for the first page,
+// and returns the token of the next page, or an empty token for the last page. The token can be a
+// start token or an offset. A page that is rate limited is requested again with an exponential backoff.
+func PaginateAllByToken[T any](ctx context.Context, fetch func(ctx context.Context, start string) ([]T, string, *core.DetailedResponse, error)) ([]T, error) {
+	allItems := []T{}
+	seen := map[string]bool{}
+	start := ""
+	for {
+		var page []T
+		var next string
+		err := paginationBackoff(ctx, func() (*core.DetailedResponse, error) {
+			var response *core.DetailedResponse
+			var err error
+			page, next, response, err = fetch(ctx, start)
+			return response, err
+		})
+		if err != nil {
+			return nil, err
+		}
+		allItems = append(allItems, page...)
+		if next == "" {
+			return allItems, nil
+		}
+		if seen[next] {
+			return nil, fmt.Errorf("[ERROR] Error listing the pages: the page %s was already listed", next)
+		}
+		seen[next] = true
+		start = next
+	}
+}
+
+// paginationBackoff calls list until it is not rate limited, the context is canceled, or the retries
+// are exhausted. The page is not moved forward when the request fails, so it is safe to retry.
+func paginationBackoff(ctx context.Context, list func() (*core.DetailedResponse, error)) error {
+	delay := paginationInitialDelay
+	for retry := 0; ; retry++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		response, err := list()
+		if err == nil || !isRateLimited(response) || retry == paginationMaxRetries {
+			return err
+		}
+		log.Printf("[DEBUG] Listing the page is rate limited, retrying in %s: %s", delay, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		if delay *= 2; delay > paginationMaxDelay {
+			delay = paginationMaxDelay
+		}
+	}
+}
+
+func isRateLimited(response *core.DetailedResponse) bool {
+	return response != nil && response.StatusCode == http.StatusTooManyRequests
+}
@@ -0,0 +1,164 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+type testPager struct {
+	pages [][]string
+	next  int
+}
+
+func (p *testPager) HasNext() bool {
+	return p.next < len(p.pages)
+}
+
+func (p *testPager) GetNextWithContext(ctx context.Context) ([]string, error) {
+	page := p.pages[p.next]
+	p.next++
+	return page, nil
+}
+
+// stuckPager has more pages but never moves forward
+type stuckPager struct{}
+
+func (p *stuckPager) HasNext() bool {
+	return true
+}
+
+func (p *stuckPager) GetNextWithContext(ctx context.Context) ([]string, error) {
+	return []string{}, nil
+}
+
+func TestPaginateAll(t *testing.T) {
+	pager := &testPager{pages: [][]string{{"a", "b"}, {"c"}, {"d", "e"}}}
+	items, err := flex.PaginateAll[string](context.Background(), pager)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expected := []string{"a", "b", "c", "d", "e"}; !reflect.DeepEqual(items, expected) {
+		t.Errorf("expected %v, got %v", expected, items)
+	}
+}
+
+func TestPaginateAllStuckPager(t *testing.T) {
+	_, err := flex.PaginateAll[string](context.Background(), &stuckPager{})
+	if err == nil {
+		t.Fatal("expected an error for a pager that does not move forward")
+	}
+}
+
+func TestPaginateAllCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	pager := &testPager{pages: [][]string{{"a"}}}
+	_, err := flex.PaginateAll[string](ctx, pager)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected %s, got %v", context.Canceled, err)
+	}
+}
+
+func TestPaginateAllByToken(t *testing.T) {
+	pages := map[string][]string{"": {"a", "b"}, "token-1": {"c"}, "token-2": {"d"}}
+	nextTokens := map[string]string{"": "token-1", "token-1": "token-2", "token-2": ""}
+	items, err := flex.PaginateAllByToken(context.Background(), func(ctx context.Context, start string) ([]string, string, *core.DetailedResponse, error) {
+		return pages[start], nextTokens[start], &core.DetailedResponse{StatusCode: http.StatusOK}, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expected := []string{"a", "b", "c", "d"}; !reflect.DeepEqual(items, expected) {
+		t.Errorf("expected %v, got %v", expected, items)
+	}
+}
+
+func TestPaginateAllByOffset(t *testing.T) {
+	const total, limit = 7, 3
+	items, err := flex.PaginateAllByToken(context.Background(), func(ctx context.Context, offset string) ([]int, string, *core.DetailedResponse, error) {
+		first := 0
+		if offset != "" {
+			first, _ = strconv.Atoi(offset)
+		}
+		page := []int{}
+		for i := first; i < total && i < first+limit; i++ {
+			page = append(page, i)
+		}
+		next := ""
+		if first+limit < total {
+			next = strconv.Itoa(first + limit)
+		}
+		return page, next, &core.DetailedResponse{StatusCode: http.StatusOK}, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expected := []int{0, 1, 2, 3, 4, 5, 6}; !reflect.DeepEqual(items, expected) {
+		t.Errorf("expected %v, got %v", expected, items)
+	}
+}
+
+func TestPaginateAllByTokenRepeatedToken(t *testing.T) {
+	_, err := flex.PaginateAllByToken(context.Background(), func(ctx context.Context, start string) ([]string, string, *core.DetailedResponse, error) {
+		return []string{"a"}, "token-1", &core.DetailedResponse{StatusCode: http.StatusOK}, nil
+	})
+	if err == nil {
+		t.Fatal("expected an error for a token that was already listed")
+	}
+}
+
+func TestPaginateAllByTokenRetriesRateLimited(t *testing.T) {
+	calls := 0
+	items, err := flex.PaginateAllByToken(context.Background(), func(ctx context.Context, start string) ([]string, string, *core.DetailedResponse, error) {
+		calls++
+		if calls == 1 {
+			return nil, "", &core.DetailedResponse{StatusCode: http.StatusTooManyRequests}, errors.New("Too Many Requests")
+		}
+		return []string{"a"}, "", &core.DetailedResponse{StatusCode: http.StatusOK}, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected the rate limited page to be requested again, got %d calls", calls)
+	}
+	if expected := []string{"a"}; !reflect.DeepEqual(items, expected) {
+		t.Errorf("expected %v, got %v", expected, items)
+	}
+}
+
+func TestPaginateAllByTokenDoesNotRetryOtherErrors(t *testing.T) {
+	calls := 0
+	_, err := flex.PaginateAllByToken(context.Background(), func(ctx context.Context, start string) ([]string, string, *core.DetailedResponse, error) {
+		calls++
+		// The message mentions a rate limit, only the status code decides the retry
+		return nil, "", &core.DetailedResponse{StatusCode: http.StatusBadRequest}, errors.New("invalid rate limit filter")
+	})
+	if err == nil {
+		t.Fatal("expected the error of the request")
+	}
+	if calls != 1 {
+		t.Errorf("expected a single request, got %d calls", calls)
+	}
+}
+
+func TestPaginateAllByTokenCanceledBackoff(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	_, err := flex.PaginateAllByToken(ctx, func(ctx context.Context, start string) ([]string, string, *core.DetailedResponse, error) {
+		cancel()
+		return nil, "", &core.DetailedResponse{StatusCode: http.StatusTooManyRequests}, errors.New("Too Many Requests")
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected %s, got %v", context.Canceled, err)
+	}
+}
@@ -5,6 +5,7 @@ package flex
 
 import (
 	"bytes"
+	"context"
 	b64 "encoding/base64"
 	"encoding/json"
 	"errors"
@@ -2790,13 +2791,6 @@ func ResourceVolumeValidate(diff *schema.ResourceDiff) error {
 		iops = int64(iopsOk.(int))
 	}
 
-	if diff.HasChange("profile") {
-		oldProfile, newProfile := diff.GetChange("profile")
-		if oldProfile.(string) == "custom" || newProfile.(string) == "custom" {
-			diff.ForceNew("profile")
-		}
-	}
-
 	if profile != "custom" {
 		if iops != 0 && diff.NewValueKnown("iops") && diff.HasChange("iops") {
 			return fmt.Errorf("VolumeError : iops is applicable for only custom volume profiles")
@@ -4224,3 +4218,200 @@ func Listdifference(a, b []string) []string {
 	}
 	return ab
 }
+
+// DefaultAuthorizationRoles are the roles granted by the service to service authorizations that are
+// required for a source service to work with a target service, keyed by source and target service names.
+var DefaultAuthorizationRoles = map[string]map[string][]string{
+	"cloud-object-storage": {
+		"kms":       {"Reader"},
+		"hs-crypto": {"Reader"},
+	},
+	"databases-for-postgresql": {
+		"kms":       {"Reader"},
+		"hs-crypto": {"Reader"},
+	},
+	"databases-for-mysql": {
+		"kms":       {"Reader"},
+		"hs-crypto": {"Reader"},
+	},
+	"databases-for-redis": {
+		"kms":       {"Reader"},
+		"hs-crypto": {"Reader"},
+	},
+	"databases-for-mongodb": {
+		"kms":       {"Reader"},
+		"hs-crypto": {"Reader"},
+	},
+	"databases-for-elasticsearch": {
+		"kms":       {"Reader"},
+		"hs-crypto": {"Reader"},
+	},
+	"messagehub": {
+		"kms":       {"Reader"},
+		"hs-crypto": {"Reader"},
+	},
+	"secrets-manager": {
+		"kms":                 {"Reader"},
+		"hs-crypto":           {"Reader"},
+		"event-notifications": {"Event Source Manager"},
+	},
+	"event-notifications": {
+		"kms":       {"Reader"},
+		"hs-crypto": {"Reader"},
+	},
+	"logs": {
+		"cloud-object-storage": {"Writer"},
+		"event-notifications":  {"Event Source Manager"},
+	},
+	"logdna": {
+		"cloud-object-storage": {"Writer"},
+	},
+	"is": {
+		"cloud-object-storage": {"Writer"},
+		"kms":                  {"Reader"},
+		"hs-crypto":            {"Reader"},
+	},
+	"container-registry": {
+		"kms":       {"Reader"},
+		"hs-crypto": {"Reader"},
+	},
+}
+
+// GetAuthorizationRoleNames returns the roles of a service to service authorization, the default
+// roles of the source and target services are used when no role is given.
+func GetAuthorizationRoleNames(sourceServiceName, targetServiceName string, roleNames []string) ([]string, error) {
+	if len(roleNames) > 0 {
+		return roleNames, nil
+	}
+	if roles, ok := DefaultAuthorizationRoles[sourceServiceName][targetServiceName]; ok {
+		return roles, nil
+	}
+	return nil, fmt.Errorf("[ERROR] There are no default roles for the authorization of %s to %s, the roles must be set", sourceServiceName, targetServiceName)
+}
+
+// GetServiceInstanceGUID returns the GUID of a service instance from its GUID or CRN, or from the CRN
+// of one of its resources such as a key.
+func GetServiceInstanceGUID(instance string) string {
+	// crn:v1:<cname>:<ctype>:<service-name>:<location>:a/<account>:<service-instance>:<resource-type>:<resource>
+	if crnParts := strings.Split(instance, ":"); len(crnParts) > 7 && crnParts[0] == "crn" {
+		return crnParts[7]
+	}
+	return instance
+}
+
+// CreateAuthorizationPolicy creates the service to service authorization policy granting the roles on the
+// target service instance, or on all the instances of the target service, to the source service instance.
+// An empty policy ID is returned when an identical authorization policy already exists in the account.
+func CreateAuthorizationPolicy(meta interface{}, sourceServiceName, sourceInstanceID, targetServiceName, targetInstanceID string, roleNames []string) (string, error) {
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return "", err
+	}
+	iampapClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return "", err
+	}
+
+	roleNames, err = GetAuthorizationRoleNames(sourceServiceName, targetServiceName, roleNames)
+	if err != nil {
+		return "", err
+	}
+	roleList, resp, err := iampapClient.ListRoles(&iampolicymanagementv1.ListRolesOptions{
+		ServiceName:       &targetServiceName,
+		SourceServiceName: &sourceServiceName,
+		PolicyType:        core.StringPtr("authorization"),
+	})
+	if err != nil || roleList == nil {
+		return "", fmt.Errorf("[ERROR] Error in listing roles %s, %s", err, resp)
+	}
+	roles, err := GetRolesFromRoleNames(roleNames, MapRoleListToPolicyRoles(*roleList))
+	if err != nil {
+		return "", err
+	}
+
+	policySubject := iampolicymanagementv1.PolicySubject{
+		Attributes: []iampolicymanagementv1.SubjectAttribute{
+			{Name: core.StringPtr("serviceName"), Value: core.StringPtr(sourceServiceName)},
+			{Name: core.StringPtr("accountId"), Value: core.StringPtr(userDetails.UserAccount)},
+			{Name: core.StringPtr("serviceInstance"), Value: core.StringPtr(GetServiceInstanceGUID(sourceInstanceID))},
+		},
+	}
+	policyResource := iampolicymanagementv1.PolicyResource{
+		Attributes: []iampolicymanagementv1.ResourceAttribute{
+			{Name: core.StringPtr("serviceName"), Value: core.StringPtr(targetServiceName), Operator: core.StringPtr("stringEquals")},
+			{Name: core.StringPtr("accountId"), Value: core.StringPtr(userDetails.UserAccount), Operator: core.StringPtr("stringEquals")},
+		},
+	}
+	if targetInstanceID != "" {
+		policyResource.Attributes = append(policyResource.Attributes, iampolicymanagementv1.ResourceAttribute{
+			Name:  core.StringPtr("serviceInstance"),
+			Value: core.StringPtr(GetServiceInstanceGUID(targetInstanceID)),
+		})
+	}
+
+	createPolicyOptions := iampapClient.NewCreatePolicyOptions(
+		"authorization",
+		[]iampolicymanagementv1.PolicySubject{policySubject},
+		roles,
+		[]iampolicymanagementv1.PolicyResource{policyResource},
+	)
+	createPolicyOptions.Description = core.StringPtr(fmt.Sprintf("Authorization of %s to %s created by terraform", sourceServiceName, targetServiceName))
+	authPolicy, resp, err := iampapClient.CreatePolicy(createPolicyOptions)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 409 {
+			log.Printf("[INFO] The authorization of %s to %s already exists", sourceServiceName, targetServiceName)
+			return "", nil
+		}
+		return "", fmt.Errorf("[ERROR] Error creating authorization policy: %s %s", err, resp)
+	}
+	return *authPolicy.ID, nil
+}
+
+// DeleteAuthorizationPolicy deletes a service to service authorization policy created by
+// CreateAuthorizationPolicy, the policies that no longer exist are ignored.
+func DeleteAuthorizationPolicy(meta interface{}, policyID string) error {
+	if policyID == "" {
+		return nil
+	}
+	iampapClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+	resp, err := iampapClient.DeletePolicy(&iampolicymanagementv1.DeletePolicyOptions{
+		PolicyID: core.StringPtr(policyID),
+	})
+	if err != nil && (resp == nil || resp.StatusCode != 404) {
+		return fmt.Errorf("[ERROR] Error deleting authorization policy %s: %s %s", policyID, err, resp)
+	}
+	return nil
+}
+
+// WithImportDefaults sets the default values of the optional arguments of the resource that are not returned by the
+// API when the resource is imported, so that a plan right after the import, or the configuration generated with
+// terraform plan -generate-config-out, shows no changes for the arguments that keep their default values.
+func WithImportDefaults(resource *schema.Resource) *schema.Resource {
+	if resource.Importer == nil {
+		return resource
+	}
+	state, stateContext := resource.Importer.State, resource.Importer.StateContext
+	resource.Importer = &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			for key, keySchema := range resource.Schema {
+				if keySchema.Default == nil || keySchema.Computed {
+					continue
+				}
+				if _, ok := d.GetOk(key); !ok {
+					d.Set(key, keySchema.Default)
+				}
+			}
+			switch {
+			case stateContext != nil:
+				return stateContext(ctx, d, meta)
+			case state != nil:
+				return state(d, meta)
+			}
+			return schema.ImportStatePassthroughContext(ctx, d, meta)
+		},
+	}
+	return resource
+}
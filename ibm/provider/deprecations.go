@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// deprecationsAsErrorsEnv is the environment variable that turns the deprecation warnings into errors, for example to
+// enforce migrations in CI pipelines.
+const deprecationsAsErrorsEnv = "IBMCLOUD_DEPRECATIONS_AS_ERRORS"
+
+// deprecationGuideURL is the URL of the migration guide, the anchor of a deprecation is appended to it.
+const deprecationGuideURL = "https://registry.terraform.io/providers/IBM-Cloud/ibm/latest/docs/guides/deprecations"
+
+// deprecation describes why a resource or data source is deprecated and what replaces it.
+type deprecation struct {
+	// Reason is why the resource or data source is deprecated
+	Reason string
+	// Replacements are the resources or data sources to migrate to
+	Replacements []string
+	// Anchor is the anchor of the section of the migration guide
+	Anchor string
+}
+
+// message returns the warning of the deprecated resource or data source name
+func (dep deprecation) message(kind, name string) string {
+	msg := fmt.Sprintf("%s %s is deprecated: %s", kind, name, dep.Reason)
+	switch len(dep.Replacements) {
+	case 0:
+	case 1:
+		msg += fmt.Sprintf(" Use %s instead.", dep.Replacements[0])
+	default:
+		msg += " Use "
+		for i, replacement := range dep.Replacements {
+			switch {
+			case i == 0:
+			case i == len(dep.Replacements)-1:
+				msg += " and "
+			default:
+				msg += ", "
+			}
+			msg += replacement
+		}
+		msg += " instead."
+	}
+	return fmt.Sprintf("%s Migration guide: %s#%s", msg, deprecationGuideURL, dep.Anchor)
+}
+
+const cisFirewallDeprecationReason = "the lockdowns, access rules and user agent rules of the CIS firewall are deprecated by Cloudflare."
+
+// functionsDeprecationReason returns the reason of a deprecated ibm_function_* resource, which points to the data
+// sources that keep reading the existing assets of the resource
+func functionsDeprecationReason(dataSources string) string {
+	return fmt.Sprintf("IBM Cloud Functions is deprecated and the resource will be removed. Use the %s to read the existing assets while migrating to Code Engine.", dataSources)
+}
+
+// deprecatedResources is the registry of the deprecated resources. The replacements are left empty when no resource
+// of the provider is equivalent, the migration guide describes the migration instead.
+var deprecatedResources = map[string]deprecation{
+	"ibm_function_action": {
+		Reason:       functionsDeprecationReason("ibm_function_action and ibm_function_actions data sources"),
+		Replacements: []string{"ibm_code_engine_app"},
+		Anchor:       "ibm-cloud-functions",
+	},
+	"ibm_function_namespace": {
+		Reason:       functionsDeprecationReason("ibm_function_namespace data source"),
+		Replacements: []string{"ibm_code_engine_project"},
+		Anchor:       "ibm-cloud-functions",
+	},
+	"ibm_function_package": {
+		Reason: functionsDeprecationReason("ibm_function_package and ibm_function_packages data sources"),
+		Anchor: "ibm-cloud-functions",
+	},
+	"ibm_function_rule": {
+		Reason: functionsDeprecationReason("ibm_function_rule and ibm_function_rules data sources"),
+		Anchor: "ibm-cloud-functions",
+	},
+	"ibm_function_trigger": {
+		Reason: functionsDeprecationReason("ibm_function_trigger and ibm_function_triggers data sources"),
+		Anchor: "ibm-cloud-functions",
+	},
+	"ibm_container_alb_cert": {
+		Reason:       "the certificates of Certificate Manager are no longer supported.",
+		Replacements: []string{"ibm_container_ingress_domain_certificate", "ibm_sm_imported_certificate"},
+		Anchor:       "certificate-manager",
+	},
+	"ibm_cis_firewall": {
+		Reason:       cisFirewallDeprecationReason,
+		Replacements: []string{"ibm_cis_filter", "ibm_cis_firewall_rule"},
+		Anchor:       "cis-firewall",
+	},
+}
+
+// deprecatedDataSources is the registry of the deprecated data sources
+var deprecatedDataSources = map[string]deprecation{
+	"ibm_cis_firewall": {
+		Reason:       cisFirewallDeprecationReason,
+		Replacements: []string{"ibm_cis_filters", "ibm_cis_firewall_rules"},
+		Anchor:       "cis-firewall",
+	},
+}
+
+// applyDeprecations sets the deprecation warnings of the resources and data sources of the registry. The warnings are
+// shown when the configuration is validated, so at plan time. When IBMCLOUD_DEPRECATIONS_AS_ERRORS is true, the plans
+// of the deprecated resources and the reads of the deprecated data sources fail with the warning.
+func applyDeprecations(provider *schema.Provider) {
+	asErrors, _ := strconv.ParseBool(conns.EnvFallBack([]string{deprecationsAsErrorsEnv}, "false"))
+
+	for name, dep := range deprecatedResources {
+		resource, ok := provider.ResourcesMap[name]
+		if !ok {
+			continue
+		}
+		msg := dep.message("Resource", name)
+		resource.DeprecationMessage = msg
+		if asErrors {
+			customizeDiffs := []schema.CustomizeDiffFunc{func(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				return fmt.Errorf("[ERROR] %s (%s is set)", msg, deprecationsAsErrorsEnv)
+			}}
+			if resource.CustomizeDiff != nil {
+				customizeDiffs = append(customizeDiffs, resource.CustomizeDiff)
+			}
+			resource.CustomizeDiff = customdiff.All(customizeDiffs...)
+		}
+	}
+
+	for name, dep := range deprecatedDataSources {
+		dataSource, ok := provider.DataSourcesMap[name]
+		if !ok {
+			continue
+		}
+		msg := dep.message("Data source", name)
+		dataSource.DeprecationMessage = msg
+		if asErrors {
+			dataSource.Read = nil
+			dataSource.ReadWithoutTimeout = nil
+			dataSource.ReadContext = func(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+				return diag.Errorf("[ERROR] %s (%s is set)", msg, deprecationsAsErrorsEnv)
+			}
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// TestDeprecationsRegistry checks that the deprecated resources and data sources, and their replacements, are
+// registered in the provider, and that the migration guide has a section for each of them.
+func TestDeprecationsRegistry(t *testing.T) {
+	provider := Provider()
+
+	guide, err := os.ReadFile("../../website/docs/guides/deprecations.html.md")
+	if err != nil {
+		t.Fatalf("reading the migration guide failed: %s", err)
+	}
+	anchors := map[string]bool{}
+	for _, heading := range regexp.MustCompile(`(?m)^## (.+)$`).FindAllStringSubmatch(string(guide), -1) {
+		anchors[strings.ReplaceAll(strings.ToLower(strings.TrimSpace(heading[1])), " ", "-")] = true
+	}
+
+	for name, dep := range deprecatedResources {
+		if _, ok := provider.ResourcesMap[name]; !ok {
+			t.Errorf("the deprecated resource %s is not registered", name)
+		}
+		for _, replacement := range dep.Replacements {
+			if _, ok := provider.ResourcesMap[replacement]; !ok {
+				t.Errorf("the replacement %s of the resource %s is not a registered resource", replacement, name)
+			}
+		}
+		if !anchors[dep.Anchor] {
+			t.Errorf("the migration guide has no section %s for the resource %s", dep.Anchor, name)
+		}
+	}
+	for name, dep := range deprecatedDataSources {
+		if _, ok := provider.DataSourcesMap[name]; !ok {
+			t.Errorf("the deprecated data source %s is not registered", name)
+		}
+		for _, replacement := range dep.Replacements {
+			if _, ok := provider.DataSourcesMap[replacement]; !ok {
+				t.Errorf("the replacement %s of the data source %s is not a registered data source", replacement, name)
+			}
+		}
+		if !anchors[dep.Anchor] {
+			t.Errorf("the migration guide has no section %s for the data source %s", dep.Anchor, name)
+		}
+	}
+}
+
+func TestDeprecationMessage(t *testing.T) {
+	testCases := []struct {
+		name     string
+		dep      deprecation
+		expected string
+	}{
+		{
+			"no replacement",
+			deprecation{Reason: "it is deprecated.", Anchor: "a"},
+			"Resource r is deprecated: it is deprecated. Migration guide: " + deprecationGuideURL + "#a",
+		},
+		{
+			"one replacement",
+			deprecation{Reason: "it is deprecated.", Replacements: []string{"x"}, Anchor: "a"},
+			"Resource r is deprecated: it is deprecated. Use x instead. Migration guide: " + deprecationGuideURL + "#a",
+		},
+		{
+			"several replacements",
+			deprecation{Reason: "it is deprecated.", Replacements: []string{"x", "y", "z"}, Anchor: "a"},
+			"Resource r is deprecated: it is deprecated. Use x, y and z instead. Migration guide: " + deprecationGuideURL + "#a",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := tc.dep.message("Resource", "r"); actual != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, actual)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ package provider
 
 import (
 	"os"
+	"strings"
 	"sync"
 	"time"
 
@@ -12,7 +13,9 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/apigateway"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/appconfiguration"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/appid"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/assistant"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/atracker"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/backuprecovery"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/catalogmanagement"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cdtektonpipeline"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cdtoolchain"
@@ -25,6 +28,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/contextbasedrestrictions"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cos"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/database"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/db2"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/directlink"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/dnsservices"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/enterprise"
@@ -38,7 +42,9 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/iampolicy"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/kms"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/kubernetes"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/logs"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/metricsrouter"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/ns1"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/power"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/project"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/pushnotification"
@@ -50,14 +56,17 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/schematics"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/transitgateway"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/usagereports"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vmware"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // Provider returns a *schema.Provider.
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"bluemix_api_key": {
 				Type:        schema.TypeString,
@@ -188,6 +197,26 @@ func Provider() *schema.Provider {
 				Description: "IAM Trusted Profile Authentication token",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_IAM_PROFILE_ID", "IBMCLOUD_IAM_PROFILE_ID"}, nil),
 			},
+			"iam_assume_profile_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The ID or CRN of the trusted profile to assume with the credentials of the provider, which can be in another account of the enterprise",
+				DefaultFunc:   schema.MultiEnvDefaultFunc([]string{"IC_IAM_ASSUME_PROFILE_ID", "IBMCLOUD_IAM_ASSUME_PROFILE_ID"}, nil),
+				ConflictsWith: []string{"iam_assume_profile_name"},
+			},
+			"iam_assume_profile_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The name of the trusted profile to assume with the credentials of the provider, in the account iam_assume_account_id",
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"IC_IAM_ASSUME_PROFILE_NAME", "IBMCLOUD_IAM_ASSUME_PROFILE_NAME"}, nil),
+				RequiredWith: []string{"iam_assume_account_id"},
+			},
+			"iam_assume_account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the account of the trusted profile iam_assume_profile_name",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_IAM_ASSUME_ACCOUNT_ID", "IBMCLOUD_IAM_ASSUME_ACCOUNT_ID"}, nil),
+			},
 			"iam_token": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -213,6 +242,46 @@ func Provider() *schema.Provider {
 				Description: "Path of the file that contains private and public regional endpoints mapping",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_ENDPOINTS_FILE_PATH", "IBMCLOUD_ENDPOINTS_FILE_PATH"}, nil),
 			},
+			"max_idle_conns_per_host": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The maximum number of idle connections kept open to each IBM Cloud service endpoint.",
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"IC_MAX_IDLE_CONNS_PER_HOST", "IBMCLOUD_MAX_IDLE_CONNS_PER_HOST"}, conns.DefaultMaxIdleConnsPerHost),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"idle_conn_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The time (in seconds) after which an idle connection to an IBM Cloud service endpoint is closed.",
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"IC_IDLE_CONN_TIMEOUT", "IBMCLOUD_IDLE_CONN_TIMEOUT"}, int(conns.DefaultIdleConnTimeout.Seconds())),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"keep_alive": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The interval (in seconds) of the keep-alive probes of the connections to the IBM Cloud service endpoints. Set to 0 to use the default interval, or to -1 to disable the probes.",
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"IC_KEEP_ALIVE", "IBMCLOUD_KEEP_ALIVE"}, int(conns.DefaultKeepAlive.Seconds())),
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+			"tls_session_cache_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The number of TLS sessions cached to resume the handshakes with the IBM Cloud service endpoints. Set to 0 to disable the cache.",
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"IC_TLS_SESSION_CACHE_SIZE", "IBMCLOUD_TLS_SESSION_CACHE_SIZE"}, conns.DefaultTLSSessionCacheSize),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"iam_token_cache_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path of the file that caches the IAM access tokens between runs. The tokens are only cached in memory when it is not set",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_IAM_TOKEN_CACHE_PATH", "IBMCLOUD_IAM_TOKEN_CACHE_PATH"}, nil),
+			},
+			"kms_failover_regions": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comma separated list of the regions whose Key Protect endpoints are tried in order by the Key Protect data sources when the endpoint of the region of an instance fails with a server or network error",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_KMS_FAILOVER_REGIONS", "IBMCLOUD_KMS_FAILOVER_REGIONS"}, nil),
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -256,6 +325,10 @@ func Provider() *schema.Provider {
 			"ibm_function_rule":                            functions.DataSourceIBMFunctionRule(),
 			"ibm_function_trigger":                         functions.DataSourceIBMFunctionTrigger(),
 			"ibm_function_namespace":                       functions.DataSourceIBMFunctionNamespace(),
+			"ibm_function_actions":                         functions.DataSourceIBMFunctionActions(),
+			"ibm_function_packages":                        functions.DataSourceIBMFunctionPackages(),
+			"ibm_function_rules":                           functions.DataSourceIBMFunctionRules(),
+			"ibm_function_triggers":                        functions.DataSourceIBMFunctionTriggers(),
 			"ibm_cis":                                      cis.DataSourceIBMCISInstance(),
 			"ibm_cis_dns_records":                          cis.DataSourceIBMCISDNSRecords(),
 			"ibm_cis_certificates":                         cis.DataSourceIBMCISCertificates(),
@@ -296,6 +369,7 @@ func Provider() *schema.Provider {
 			"ibm_database_tasks":                           database.DataSourceIBMDatabaseTasks(),
 			"ibm_database_backup":                          database.DataSourceIBMDatabaseBackup(),
 			"ibm_database_backups":                         database.DataSourceIBMDatabaseBackups(),
+			"ibm_db2_backups":                              db2.DataSourceIBMDb2Backups(),
 			"ibm_compute_bare_metal":                       classicinfrastructure.DataSourceIBMComputeBareMetal(),
 			"ibm_compute_image_template":                   classicinfrastructure.DataSourceIBMComputeImageTemplate(),
 			"ibm_compute_placement_group":                  classicinfrastructure.DataSourceIBMComputePlacementGroup(),
@@ -303,6 +377,7 @@ func Provider() *schema.Provider {
 			"ibm_compute_ssh_key":                          classicinfrastructure.DataSourceIBMComputeSSHKey(),
 			"ibm_compute_vm_instance":                      classicinfrastructure.DataSourceIBMComputeVmInstance(),
 			"ibm_container_addons":                         kubernetes.DataSourceIBMContainerAddOns(),
+			"ibm_container_cluster_health":                 kubernetes.DataSourceIBMContainerClusterHealth(),
 			"ibm_container_alb":                            kubernetes.DataSourceIBMContainerALB(),
 			"ibm_container_alb_cert":                       kubernetes.DataSourceIBMContainerALBCert(),
 			"ibm_container_ingress_instance":               kubernetes.DataSourceIBMContainerIngressInstance(),
@@ -311,6 +386,7 @@ func Provider() *schema.Provider {
 			"ibm_container_bind_service":                   kubernetes.DataSourceIBMContainerBindService(),
 			"ibm_container_cluster":                        kubernetes.DataSourceIBMContainerCluster(),
 			"ibm_container_cluster_config":                 kubernetes.DataSourceIBMContainerClusterConfig(),
+			"ibm_container_cluster_kubeconfig":             kubernetes.DataSourceIBMContainerClusterKubeconfig(),
 			"ibm_container_cluster_versions":               kubernetes.DataSourceIBMContainerClusterVersions(),
 			"ibm_container_cluster_worker":                 kubernetes.DataSourceIBMContainerClusterWorker(),
 			"ibm_container_nlb_dns":                        kubernetes.DataSourceIBMContainerNLBDNS(),
@@ -327,9 +403,11 @@ func Provider() *schema.Provider {
 			"ibm_container_dedicated_host_flavors":         kubernetes.DataSourceIBMContainerDedicatedHostFlavors(),
 			"ibm_container_dedicated_host":                 kubernetes.DataSourceIBMContainerDedicatedHost(),
 			"ibm_cr_namespaces":                            registry.DataIBMContainerRegistryNamespaces(),
+			"ibm_cr_va_exemptions":                         registry.DataIBMCrVaExemptions(),
 			"ibm_cloud_shell_account_settings":             cloudshell.DataSourceIBMCloudShellAccountSettings(),
 			"ibm_cos_bucket":                               cos.DataSourceIBMCosBucket(),
 			"ibm_cos_bucket_object":                        cos.DataSourceIBMCosBucketObject(),
+			"ibm_cos_bucket_objects_checksum":              cos.DataSourceIBMCosBucketObjectsChecksum(),
 			"ibm_dns_domain_registration":                  classicinfrastructure.DataSourceIBMDNSDomainRegistration(),
 			"ibm_dns_domain":                               classicinfrastructure.DataSourceIBMDNSDomain(),
 			"ibm_dns_secondary":                            classicinfrastructure.DataSourceIBMDNSSecondary(),
@@ -355,6 +433,8 @@ func Provider() *schema.Provider {
 			"ibm_iam_service_id":                           iamidentity.DataSourceIBMIAMServiceID(),
 			"ibm_iam_service_policy":                       iampolicy.DataSourceIBMIAMServicePolicy(),
 			"ibm_iam_api_key":                              iamidentity.DataSourceIBMIamApiKey(),
+			"ibm_iam_api_keys":                             iamidentity.DataSourceIBMIamAPIKeys(),
+			"ibm_iam_service_ids":                          iamidentity.DataSourceIBMIamServiceIDs(),
 			"ibm_iam_trusted_profile":                      iamidentity.DataSourceIBMIamTrustedProfile(),
 			"ibm_iam_trusted_profile_identity":             iamidentity.DataSourceIBMIamTrustedProfileIdentity(),
 			"ibm_iam_trusted_profile_identities":           iamidentity.DataSourceIBMIamTrustedProfileIdentities(),
@@ -365,6 +445,7 @@ func Provider() *schema.Provider {
 			"ibm_iam_trusted_profiles":                     iamidentity.DataSourceIBMIamTrustedProfiles(),
 			"ibm_iam_trusted_profile_policy":               iampolicy.DataSourceIBMIAMTrustedProfilePolicy(),
 			"ibm_iam_user_mfa_enrollments":                 iamidentity.DataSourceIBMIamUserMfaEnrollments(),
+			"ibm_iam_account_identity_report":              iamidentity.DataSourceIBMIamAccountIdentityReport(),
 			"ibm_iam_account_settings_template":            iamidentity.DataSourceIBMAccountSettingsTemplate(),
 			"ibm_iam_trusted_profile_template":             iamidentity.DataSourceIBMTrustedProfileTemplate(),
 			"ibm_iam_account_settings_template_assignment": iamidentity.DataSourceIBMAccountSettingsTemplateAssignment(),
@@ -438,6 +519,7 @@ func Provider() *schema.Provider {
 			"ibm_is_instance_network_interface":      vpc.DataSourceIBMIsInstanceNetworkInterface(),
 			"ibm_is_instance_network_interfaces":     vpc.DataSourceIBMIsInstanceNetworkInterfaces(),
 			"ibm_is_instance_disk":                   vpc.DataSourceIbmIsInstanceDisk(),
+			"ibm_is_instance_console_access":         vpc.DataSourceIBMIsInstanceConsoleAccess(),
 			"ibm_is_instance_disks":                  vpc.DataSourceIbmIsInstanceDisks(),
 
 			// reserved ips
@@ -450,6 +532,7 @@ func Provider() *schema.Provider {
 			"ibm_is_ipsec_policies":                  vpc.DataSourceIBMIsIpsecPolicies(),
 			"ibm_is_ike_policies":                    vpc.DataSourceIBMIsIkePolicies(),
 			"ibm_is_ike_policy":                      vpc.DataSourceIBMIsIkePolicy(),
+			"ibm_is_vpn_policy_algorithms":           vpc.DataSourceIBMIsVPNPolicyAlgorithms(),
 			"ibm_is_lb":                              vpc.DataSourceIBMISLB(),
 			"ibm_is_lb_listener":                     vpc.DataSourceIBMISLBListener(),
 			"ibm_is_lb_listeners":                    vpc.DataSourceIBMISLBListeners(),
@@ -527,6 +610,7 @@ func Provider() *schema.Provider {
 			"ibm_is_network_acl":                     vpc.DataSourceIBMIsNetworkACL(),
 			"ibm_is_network_acl_rule":                vpc.DataSourceIBMISNetworkACLRule(),
 			"ibm_is_network_acl_rules":               vpc.DataSourceIBMISNetworkACLRules(),
+			"ibm_is_network_acl_rule_templates":      vpc.DataSourceIBMISNetworkACLRuleTemplates(),
 			"ibm_lbaas":                              classicinfrastructure.DataSourceIBMLbaas(),
 			"ibm_network_vlan":                       classicinfrastructure.DataSourceIBMNetworkVlan(),
 			"ibm_org":                                cloudfoundry.DataSourceIBMOrg(),
@@ -535,6 +619,7 @@ func Provider() *schema.Provider {
 			"ibm_kp_key":                             kms.DataSourceIBMkey(),
 			"ibm_kms_key_rings":                      kms.DataSourceIBMKMSkeyRings(),
 			"ibm_kms_key_policies":                   kms.DataSourceIBMKMSkeyPolicies(),
+			"ibm_kms_key_registrations":              kms.DataSourceIBMKMSKeyRegistrations(),
 			"ibm_kms_keys":                           kms.DataSourceIBMKMSkeys(),
 			"ibm_kms_key":                            kms.DataSourceIBMKMSkey(),
 			"ibm_pn_application_chrome":              pushnotification.DataSourceIBMPNApplicationChrome(),
@@ -542,6 +627,7 @@ func Provider() *schema.Provider {
 			"ibm_app_config_environments":            appconfiguration.DataSourceIBMAppConfigEnvironments(),
 			"ibm_app_config_collection":              appconfiguration.DataSourceIBMAppConfigCollection(),
 			"ibm_app_config_collections":             appconfiguration.DataSourceIBMAppConfigCollections(),
+			"ibm_app_config_evaluation":              appconfiguration.DataSourceIBMAppConfigEvaluation(),
 			"ibm_app_config_feature":                 appconfiguration.DataSourceIBMAppConfigFeature(),
 			"ibm_app_config_features":                appconfiguration.DataSourceIBMAppConfigFeatures(),
 			"ibm_app_config_property":                appconfiguration.DataSourceIBMAppConfigProperty(),
@@ -551,24 +637,26 @@ func Provider() *schema.Provider {
 			"ibm_app_config_snapshot":                appconfiguration.DataSourceIBMAppConfigSnapshot(),
 			"ibm_app_config_snapshots":               appconfiguration.DataSourceIBMAppConfigSnapshots(),
 
-			"ibm_resource_quota":    resourcecontroller.DataSourceIBMResourceQuota(),
-			"ibm_resource_group":    resourcemanager.DataSourceIBMResourceGroup(),
-			"ibm_resource_instance": resourcecontroller.DataSourceIBMResourceInstance(),
-			"ibm_resource_key":      resourcecontroller.DataSourceIBMResourceKey(),
-			"ibm_security_group":    classicinfrastructure.DataSourceIBMSecurityGroup(),
-			"ibm_service_instance":  cloudfoundry.DataSourceIBMServiceInstance(),
-			"ibm_service_key":       cloudfoundry.DataSourceIBMServiceKey(),
-			"ibm_service_plan":      cloudfoundry.DataSourceIBMServicePlan(),
-			"ibm_space":             cloudfoundry.DataSourceIBMSpace(),
+			"ibm_resource_quota":        resourcecontroller.DataSourceIBMResourceQuota(),
+			"ibm_resource_group":        resourcemanager.DataSourceIBMResourceGroup(),
+			"ibm_resource_instance":     resourcecontroller.DataSourceIBMResourceInstance(),
+			"ibm_resource_key":          resourcecontroller.DataSourceIBMResourceKey(),
+			"ibm_resource_reclamations": resourcecontroller.DataSourceIBMResourceReclamations(),
+			"ibm_security_group":        classicinfrastructure.DataSourceIBMSecurityGroup(),
+			"ibm_service_instance":      cloudfoundry.DataSourceIBMServiceInstance(),
+			"ibm_service_key":           cloudfoundry.DataSourceIBMServiceKey(),
+			"ibm_service_plan":          cloudfoundry.DataSourceIBMServicePlan(),
+			"ibm_space":                 cloudfoundry.DataSourceIBMSpace(),
 
 			// Added for Schematics
-			"ibm_schematics_workspace":      schematics.DataSourceIBMSchematicsWorkspace(),
-			"ibm_schematics_output":         schematics.DataSourceIBMSchematicsOutput(),
-			"ibm_schematics_state":          schematics.DataSourceIBMSchematicsState(),
-			"ibm_schematics_action":         schematics.DataSourceIBMSchematicsAction(),
-			"ibm_schematics_job":            schematics.DataSourceIBMSchematicsJob(),
-			"ibm_schematics_inventory":      schematics.DataSourceIBMSchematicsInventory(),
-			"ibm_schematics_resource_query": schematics.DataSourceIBMSchematicsResourceQuery(),
+			"ibm_schematics_workspace":         schematics.DataSourceIBMSchematicsWorkspace(),
+			"ibm_schematics_output":            schematics.DataSourceIBMSchematicsOutput(),
+			"ibm_schematics_state":             schematics.DataSourceIBMSchematicsState(),
+			"ibm_schematics_workspace_outputs": schematics.DataSourceIBMSchematicsWorkspaceOutputs(),
+			"ibm_schematics_action":            schematics.DataSourceIBMSchematicsAction(),
+			"ibm_schematics_job":               schematics.DataSourceIBMSchematicsJob(),
+			"ibm_schematics_inventory":         schematics.DataSourceIBMSchematicsInventory(),
+			"ibm_schematics_resource_query":    schematics.DataSourceIBMSchematicsResourceQuery(),
 
 			// Added for Power Resources
 			"ibm_pi_catalog_images":                         power.DataSourceIBMPICatalogImages(),
@@ -625,6 +713,7 @@ func Provider() *schema.Provider {
 			"ibm_dns_permitted_networks":               dnsservices.DataSourceIBMPrivateDNSPermittedNetworks(),
 			"ibm_dns_resource_records":                 dnsservices.DataSourceIBMPrivateDNSResourceRecords(),
 			"ibm_dns_glb_monitors":                     dnsservices.DataSourceIBMPrivateDNSGLBMonitors(),
+			"ibm_dns_glb_pool_health":                  dnsservices.DataSourceIBMPrivateDNSGLBPoolHealth(),
 			"ibm_dns_glb_pools":                        dnsservices.DataSourceIBMPrivateDNSGLBPools(),
 			"ibm_dns_glbs":                             dnsservices.DataSourceIBMPrivateDNSGLBs(),
 			"ibm_dns_custom_resolvers":                 dnsservices.DataSourceIBMPrivateDNSCustomResolver(),
@@ -663,6 +752,7 @@ func Provider() *schema.Provider {
 			"ibm_enterprises":               enterprise.DataSourceIBMEnterprises(),
 			"ibm_enterprise_account_groups": enterprise.DataSourceIBMEnterpriseAccountGroups(),
 			"ibm_enterprise_accounts":       enterprise.DataSourceIBMEnterpriseAccounts(),
+			"ibm_enterprise_hierarchy":      enterprise.DataSourceIBMEnterpriseHierarchy(),
 
 			// Added for Secrets Manager
 			// V1 data sources:
@@ -708,6 +798,7 @@ func Provider() *schema.Provider {
 			"ibm_satellite_cluster_worker_pool_zone_attachment": satellite.DataSourceIBMSatelliteClusterWorkerPoolAttachment(),
 			"ibm_satellite_storage_configuration":               satellite.DataSourceIBMSatelliteStorageConfiguration(),
 			"ibm_satellite_storage_assignment":                  satellite.DataSourceIBMSatelliteStorageAssignment(),
+			"ibm_satellite_unassigned_hosts":                    satellite.DataSourceIBMSatelliteUnassignedHosts(),
 
 			// Catalog related resources
 			"ibm_cm_catalog":           catalogmanagement.DataSourceIBMCmCatalog(),
@@ -724,6 +815,11 @@ func Provider() *schema.Provider {
 			"ibm_atracker_targets": atracker.DataSourceIBMAtrackerTargets(),
 			"ibm_atracker_routes":  atracker.DataSourceIBMAtrackerRoutes(),
 
+			// Usage Reports
+			"ibm_billing_account_usage":           usagereports.DataSourceIBMBillingAccountUsage(),
+			"ibm_billing_resource_instance_usage": usagereports.DataSourceIBMBillingResourceInstanceUsage(),
+			"ibm_billing_snapshot_list":           usagereports.DataSourceIBMBillingSnapshotList(),
+
 			// Metrics Router
 			"ibm_metrics_router_targets": metricsrouter.DataSourceIBMMetricsRouterTargets(),
 			"ibm_metrics_router_routes":  metricsrouter.DataSourceIBMMetricsRouterRoutes(),
@@ -754,8 +850,11 @@ func Provider() *schema.Provider {
 			"ibm_scc_rule":                     scc.DataSourceIbmSccRule(),
 
 			// Added for Context Based Restrictions
-			"ibm_cbr_zone": contextbasedrestrictions.DataSourceIBMCbrZone(),
-			"ibm_cbr_rule": contextbasedrestrictions.DataSourceIBMCbrRule(),
+			"ibm_cbr_zone":               contextbasedrestrictions.DataSourceIBMCbrZone(),
+			"ibm_cbr_rule":               contextbasedrestrictions.DataSourceIBMCbrRule(),
+			"ibm_cbr_rule_evaluation":    contextbasedrestrictions.DataSourceIBMCbrRuleEvaluation(),
+			"ibm_cbr_serviceref_targets": contextbasedrestrictions.DataSourceIBMCbrServicerefTargets(),
+			"ibm_cbr_service_operations": contextbasedrestrictions.DataSourceIBMCbrServiceOperations(),
 
 			// Added for Event Notifications
 			"ibm_en_source":                 eventnotification.DataSourceIBMEnSource(),
@@ -883,6 +982,14 @@ func Provider() *schema.Provider {
 			"ibm_function_namespace":                       functions.ResourceIBMFunctionNamespace(),
 			"ibm_cis":                                      cis.ResourceIBMCISInstance(),
 			"ibm_database":                                 database.ResourceIBMDatabaseInstance(),
+			"ibm_database_replica_promotion":               database.ResourceIBMDatabaseReplicaPromotion(),
+			"ibm_db2_allowlist":                            db2.ResourceIBMDb2Allowlist(),
+			"ibm_db2_autoscale":                            db2.ResourceIBMDb2Autoscale(),
+			"ibm_db2_backup":                               db2.ResourceIBMDb2Backup(),
+			"ibm_db2_user":                                 db2.ResourceIBMDb2User(),
+			"ibm_ns1_monitor":                              ns1.ResourceIBMNS1Monitor(),
+			"ibm_ns1_record":                               ns1.ResourceIBMNS1Record(),
+			"ibm_ns1_zone":                                 ns1.ResourceIBMNS1Zone(),
 			"ibm_cis_domain":                               cis.ResourceIBMCISDomain(),
 			"ibm_cis_domain_settings":                      cis.ResourceIBMCISSettings(),
 			"ibm_cis_firewall":                             cis.ResourceIBMCISFirewallRecord(),
@@ -892,6 +999,7 @@ func Provider() *schema.Provider {
 			"ibm_cis_global_load_balancer":                 cis.ResourceIBMCISGlb(),
 			"ibm_cis_certificate_upload":                   cis.ResourceIBMCISCertificateUpload(),
 			"ibm_cis_dns_record":                           cis.ResourceIBMCISDnsRecord(),
+			"ibm_cis_dns_records":                          cis.ResourceIBMCISDNSRecords(),
 			"ibm_cis_dns_records_import":                   cis.ResourceIBMCISDNSRecordsImport(),
 			"ibm_cis_rate_limit":                           cis.ResourceIBMCISRateLimit(),
 			"ibm_cis_page_rule":                            cis.ResourceIBMCISPageRule(),
@@ -904,6 +1012,8 @@ func Provider() *schema.Provider {
 			"ibm_cis_mtls":                                 cis.ResourceIBMCISMtls(),
 			"ibm_cis_mtls_app":                             cis.ResourceIBMCISMtlsApp(),
 			"ibm_cis_bot_management":                       cis.ResourceIBMCISBotManagement(),
+			"ibm_cis_managed_transforms":                   cis.ResourceIBMCISManagedTransforms(),
+			"ibm_cis_transform_rules":                      cis.ResourceIBMCISTransformRules(),
 			"ibm_cis_logpush_job":                          cis.ResourceIBMCISLogPushJob(),
 			"ibm_cis_alert":                                cis.ResourceIBMCISAlert(),
 			"ibm_cis_routing":                              cis.ResourceIBMCISRouting(),
@@ -916,6 +1026,8 @@ func Provider() *schema.Provider {
 			"ibm_cis_firewall_rule":                        cis.ResourceIBMCISFirewallrules(),
 			"ibm_cloudant":                                 cloudant.ResourceIBMCloudant(),
 			"ibm_cloudant_database":                        cloudant.ResourceIBMCloudantDatabase(),
+			"ibm_cloudant_database_security":               cloudant.ResourceIBMCloudantDatabaseSecurity(),
+			"ibm_cloudant_index":                           cloudant.ResourceIBMCloudantIndex(),
 			"ibm_cloud_shell_account_settings":             cloudshell.ResourceIBMCloudShellAccountSettings(),
 			"ibm_compute_autoscale_group":                  classicinfrastructure.ResourceIBMComputeAutoScaleGroup(),
 			"ibm_compute_autoscale_policy":                 classicinfrastructure.ResourceIBMComputeAutoScalePolicy(),
@@ -930,6 +1042,7 @@ func Provider() *schema.Provider {
 			"ibm_compute_user":                             classicinfrastructure.ResourceIBMComputeUser(),
 			"ibm_compute_vm_instance":                      classicinfrastructure.ResourceIBMComputeVmInstance(),
 			"ibm_container_addons":                         kubernetes.ResourceIBMContainerAddOns(),
+			"ibm_container_cluster_wait":                   kubernetes.ResourceIBMContainerClusterWait(),
 			"ibm_container_alb":                            kubernetes.ResourceIBMContainerALB(),
 			"ibm_container_alb_create":                     kubernetes.ResourceIBMContainerAlbCreate(),
 			"ibm_container_api_key_reset":                  kubernetes.ResourceIBMContainerAPIKeyReset(),
@@ -937,11 +1050,13 @@ func Provider() *schema.Provider {
 			"ibm_container_vpc_alb_create":                 kubernetes.ResourceIBMContainerVpcAlbCreateNew(),
 			"ibm_container_vpc_worker_pool":                kubernetes.ResourceIBMContainerVpcWorkerPool(),
 			"ibm_container_vpc_worker":                     kubernetes.ResourceIBMContainerVpcWorker(),
+			"ibm_container_vpc_worker_replace":             kubernetes.ResourceIBMContainerVpcWorkerReplace(),
 			"ibm_container_vpc_cluster":                    kubernetes.ResourceIBMContainerVpcCluster(),
 			"ibm_container_alb_cert":                       kubernetes.ResourceIBMContainerALBCert(),
 			"ibm_container_ingress_instance":               kubernetes.ResourceIBMContainerIngressInstance(),
 			"ibm_container_ingress_secret_tls":             kubernetes.ResourceIBMContainerIngressSecretTLS(),
 			"ibm_container_ingress_secret_opaque":          kubernetes.ResourceIBMContainerIngressSecretOpaque(),
+			"ibm_container_ingress_domain_certificate":     kubernetes.ResourceIBMContainerIngressDomainCertificate(),
 			"ibm_container_cluster":                        kubernetes.ResourceIBMContainerCluster(),
 			"ibm_container_cluster_feature":                kubernetes.ResourceIBMContainerClusterFeature(),
 			"ibm_container_bind_service":                   kubernetes.ResourceIBMContainerBindService(),
@@ -951,8 +1066,11 @@ func Provider() *schema.Provider {
 			"ibm_container_nlb_dns":                        kubernetes.ResourceIBMContainerNlbDns(),
 			"ibm_container_dedicated_host_pool":            kubernetes.ResourceIBMContainerDedicatedHostPool(),
 			"ibm_container_dedicated_host":                 kubernetes.ResourceIBMContainerDedicatedHost(),
+			"ibm_container_logs_agent":                     kubernetes.ResourceIBMContainerLogsAgent(),
+			"ibm_container_monitoring_agent":               kubernetes.ResourceIBMContainerMonitoringAgent(),
 			"ibm_cr_namespace":                             registry.ResourceIBMCrNamespace(),
 			"ibm_cr_retention_policy":                      registry.ResourceIBMCrRetentionPolicy(),
+			"ibm_cr_va_exemption":                          registry.ResourceIBMCrVaExemption(),
 			"ibm_ob_logging":                               kubernetes.ResourceIBMObLogging(),
 			"ibm_ob_monitoring":                            kubernetes.ResourceIBMObMonitoring(),
 			"ibm_cos_bucket":                               cos.ResourceIBMCOSBucket(),
@@ -966,6 +1084,10 @@ func Provider() *schema.Provider {
 			"ibm_dns_record":                               classicinfrastructure.ResourceIBMDNSRecord(),
 			"ibm_event_streams_topic":                      eventstreams.ResourceIBMEventStreamsTopic(),
 			"ibm_event_streams_schema":                     eventstreams.ResourceIBMEventStreamsSchema(),
+			"ibm_event_streams_schema_global_rule":         eventstreams.ResourceIBMEventStreamsSchemaGlobalRule(),
+			"ibm_event_streams_schema_rule":                eventstreams.ResourceIBMEventStreamsSchemaRule(),
+			"ibm_event_streams_connector":                  eventstreams.ResourceIBMEventStreamsConnector(),
+			"ibm_event_streams_capacity":                   eventstreams.ResourceIBMEventStreamsCapacity(),
 			"ibm_firewall":                                 classicinfrastructure.ResourceIBMFirewall(),
 			"ibm_firewall_policy":                          classicinfrastructure.ResourceIBMFirewallPolicy(),
 			"ibm_hpcs":                                     hpcs.ResourceIBMHPCS(),
@@ -991,6 +1113,7 @@ func Provider() *schema.Provider {
 			"ibm_iam_service_api_key":                      iamidentity.ResourceIBMIAMServiceAPIKey(),
 			"ibm_iam_service_policy":                       iampolicy.ResourceIBMIAMServicePolicy(),
 			"ibm_iam_user_invite":                          iampolicy.ResourceIBMIAMUserInvite(),
+			"ibm_iam_user_removal":                         iampolicy.ResourceIBMIAMUserRemoval(),
 			"ibm_iam_api_key":                              iamidentity.ResourceIBMIAMApiKey(),
 			"ibm_iam_trusted_profile":                      iamidentity.ResourceIBMIAMTrustedProfile(),
 			"ibm_iam_trusted_profile_identity":             iamidentity.ResourceIBMIamTrustedProfileIdentity(),
@@ -1092,6 +1215,7 @@ func Provider() *schema.Provider {
 			"ibm_network_public_ip":                         classicinfrastructure.ResourceIBMNetworkPublicIp(),
 			"ibm_network_vlan":                              classicinfrastructure.ResourceIBMNetworkVlan(),
 			"ibm_network_vlan_spanning":                     classicinfrastructure.ResourceIBMNetworkVlanSpan(),
+			"ibm_network_vlan_trunk":                        classicinfrastructure.ResourceIBMNetworkVlanTrunk(),
 			"ibm_object_storage_account":                    classicinfrastructure.ResourceIBMObjectStorageAccount(),
 			"ibm_org":                                       cloudfoundry.ResourceIBMOrg(),
 			"ibm_pn_application_chrome":                     pushnotification.ResourceIBMPNApplicationChrome(),
@@ -1108,9 +1232,12 @@ func Provider() *schema.Provider {
 			"ibm_kms_key_policies":                          kms.ResourceIBMKmskeyPolicies(),
 			"ibm_kp_key":                                    kms.ResourceIBMkey(),
 			"ibm_kms_instance_policies":                     kms.ResourceIBMKmsInstancePolicy(),
+			"ibm_kms_event_notifications":                   kms.ResourceIBMKmsEventNotifications(),
 			"ibm_resource_group":                            resourcemanager.ResourceIBMResourceGroup(),
 			"ibm_resource_instance":                         resourcecontroller.ResourceIBMResourceInstance(),
 			"ibm_resource_key":                              resourcecontroller.ResourceIBMResourceKey(),
+			"ibm_monitoring_platform_metrics":               resourcecontroller.ResourceIBMMonitoringPlatformMetrics(),
+			"ibm_resource_reclamation_action":               resourcecontroller.ResourceIBMResourceReclamationAction(),
 			"ibm_security_group":                            classicinfrastructure.ResourceIBMSecurityGroup(),
 			"ibm_security_group_rule":                       classicinfrastructure.ResourceIBMSecurityGroupRule(),
 			"ibm_service_instance":                          cloudfoundry.ResourceIBMServiceInstance(),
@@ -1119,6 +1246,8 @@ func Provider() *schema.Provider {
 			"ibm_storage_evault":                            classicinfrastructure.ResourceIBMStorageEvault(),
 			"ibm_storage_block":                             classicinfrastructure.ResourceIBMStorageBlock(),
 			"ibm_storage_file":                              classicinfrastructure.ResourceIBMStorageFile(),
+			"ibm_storage_replica":                           classicinfrastructure.ResourceIBMStorageReplica(),
+			"ibm_storage_replica_failover":                  classicinfrastructure.ResourceIBMStorageReplicaFailover(),
 			"ibm_subnet":                                    classicinfrastructure.ResourceIBMSubnet(),
 			"ibm_dns_reverse_record":                        classicinfrastructure.ResourceIBMDNSReverseRecord(),
 			"ibm_ssl_certificate":                           classicinfrastructure.ResourceIBMSSLCertificate(),
@@ -1137,6 +1266,7 @@ func Provider() *schema.Provider {
 			"ibm_pi_volume_attach":                   power.ResourceIBMPIVolumeAttach(),
 			"ibm_pi_capture":                         power.ResourceIBMPICapture(),
 			"ibm_pi_image":                           power.ResourceIBMPIImage(),
+			"ibm_pi_images":                          power.ResourceIBMPIImages(),
 			"ibm_pi_image_export":                    power.ResourceIBMPIImageExport(),
 			"ibm_pi_network_port":                    power.ResourceIBMPINetworkPort(),
 			"ibm_pi_snapshot":                        power.ResourceIBMPISnapshot(),
@@ -1151,6 +1281,7 @@ func Provider() *schema.Provider {
 			"ibm_pi_placement_group":                 power.ResourceIBMPIPlacementGroup(),
 			"ibm_pi_spp_placement_group":             power.ResourceIBMPISPPPlacementGroup(),
 			"ibm_pi_shared_processor_pool":           power.ResourceIBMPISharedProcessorPool(),
+			"ibm_pi_transit_gateway_connection":      power.ResourceIBMPITransitGatewayConnection(),
 
 			// Private DNS related resources
 			"ibm_dns_zone":              dnsservices.ResourceIBMPrivateDNSZone(),
@@ -1182,6 +1313,7 @@ func Provider() *schema.Provider {
 			"ibm_tg_route_report":             transitgateway.ResourceIBMTransitGatewayRouteReport(),
 
 			// Catalog related resources
+			"ibm_cm_account":           catalogmanagement.ResourceIBMCmAccount(),
 			"ibm_cm_offering_instance": catalogmanagement.ResourceIBMCmOfferingInstance(),
 			"ibm_cm_catalog":           catalogmanagement.ResourceIBMCmCatalog(),
 			"ibm_cm_offering":          catalogmanagement.ResourceIBMCmOffering(),
@@ -1194,6 +1326,31 @@ func Provider() *schema.Provider {
 			"ibm_enterprise_account_group": enterprise.ResourceIBMEnterpriseAccountGroup(),
 			"ibm_enterprise_account":       enterprise.ResourceIBMEnterpriseAccount(),
 
+			// Usage Reports
+			"ibm_billing_resource_usage_export": usagereports.ResourceIBMBillingResourceUsageExport(),
+			"ibm_billing_report_snapshot":       usagereports.ResourceIBMBillingReportSnapshot(),
+
+			// Cloud Logs
+			"ibm_logs_policy":             logs.ResourceIbmLogsPolicy(),
+			"ibm_logs_data_usage_metrics": logs.ResourceIbmLogsDataUsageMetrics(),
+			"ibm_logs_archive_buckets":    logs.ResourceIbmLogsArchiveBuckets(),
+
+			// watsonx Assistant
+			"ibm_watsonx_assistant":               assistant.ResourceIBMWatsonxAssistant(),
+			"ibm_watsonx_assistant_skills_import": assistant.ResourceIBMWatsonxAssistantSkillsImport(),
+			"ibm_watsonx_assistant_environment":   assistant.ResourceIBMWatsonxAssistantEnvironment(),
+
+			// Backup and Recovery
+			"ibm_backup_recovery_source_registration": backuprecovery.ResourceIbmBackupRecoverySourceRegistration(),
+			"ibm_backup_recovery_protection_policy":   backuprecovery.ResourceIbmBackupRecoveryProtectionPolicy(),
+			"ibm_backup_recovery_protection_group":    backuprecovery.ResourceIbmBackupRecoveryProtectionGroup(),
+			"ibm_backup_recovery":                     backuprecovery.ResourceIbmBackupRecovery(),
+
+			// VMware Solutions
+			"ibm_vmware_classic_cluster_hosts":         vmware.ResourceIbmVMwareClassicClusterHosts(),
+			"ibm_vmware_classic_cluster_nfs_datastore": vmware.ResourceIbmVMwareClassicClusterNfsDatastore(),
+			"ibm_vmware_classic_service":               vmware.ResourceIbmVMwareClassicService(),
+
 			// Added for Schematics
 			"ibm_schematics_workspace":      schematics.ResourceIBMSchematicsWorkspace(),
 			"ibm_schematics_action":         schematics.ResourceIBMSchematicsAction(),
@@ -1225,6 +1382,7 @@ func Provider() *schema.Provider {
 			// satellite  resources
 			"ibm_satellite_location":                            satellite.ResourceIBMSatelliteLocation(),
 			"ibm_satellite_host":                                satellite.ResourceIBMSatelliteHost(),
+			"ibm_satellite_host_assignment_policy":              satellite.ResourceIBMSatelliteHostAssignmentPolicy(),
 			"ibm_satellite_cluster":                             satellite.ResourceIBMSatelliteCluster(),
 			"ibm_satellite_cluster_worker_pool":                 satellite.ResourceIBMSatelliteClusterWorkerPool(),
 			"ibm_satellite_link":                                satellite.ResourceIBMSatelliteLink(),
@@ -1259,6 +1417,8 @@ func Provider() *schema.Provider {
 			"ibm_scc_profile":                scc.ResourceIbmSccProfile(),
 			"ibm_scc_profile_attachment":     scc.ResourceIbmSccProfileAttachment(),
 			"ibm_scc_provider_type_instance": scc.ResourceIbmSccProviderTypeInstance(),
+			"ibm_scc_instance_settings":      scc.ResourceIbmSccInstanceSettings(),
+			"ibm_scc_scope":                  scc.ResourceIbmSccScope(),
 
 			// Added for Context Based Restrictions
 			"ibm_cbr_zone": contextbasedrestrictions.ResourceIBMCbrZone(),
@@ -1302,6 +1462,7 @@ func Provider() *schema.Provider {
 
 			// Added for Toolchain
 			"ibm_cd_toolchain":                         cdtoolchain.ResourceIBMCdToolchain(),
+			"ibm_cd_toolchain_template":                cdtoolchain.ResourceIBMCdToolchainTemplate(),
 			"ibm_cd_toolchain_tool_keyprotect":         cdtoolchain.ResourceIBMCdToolchainToolKeyprotect(),
 			"ibm_cd_toolchain_tool_secretsmanager":     cdtoolchain.ResourceIBMCdToolchainToolSecretsmanager(),
 			"ibm_cd_toolchain_tool_bitbucketgit":       cdtoolchain.ResourceIBMCdToolchainToolBitbucketgit(),
@@ -1347,6 +1508,9 @@ func Provider() *schema.Provider {
 
 		ConfigureFunc: providerConfigure,
 	}
+
+	applyDeprecations(provider)
+	return provider
 }
 
 var (
@@ -1389,6 +1553,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_cis_webhook":                              cis.ResourceIBMCISWebhooksValidator(),
 				"ibm_cis_alert":                                cis.ResourceIBMCISAlertValidator(),
 				"ibm_cis_dns_record":                           cis.ResourceIBMCISDnsRecordValidator(),
+				"ibm_cis_dns_records":                          cis.ResourceIBMCISDNSRecordsValidator(),
 				"ibm_cis_dns_records_import":                   cis.ResourceIBMCISDnsRecordsImportValidator(),
 				"ibm_cis_edge_functions_action":                cis.ResourceIBMCISEdgeFunctionsActionValidator(),
 				"ibm_cis_edge_functions_trigger":               cis.ResourceIBMCISEdgeFunctionsTriggerValidator(),
@@ -1397,6 +1562,8 @@ func Validator() validate.ValidatorDict {
 				"ibm_cis_mtls_app":                             cis.ResourceIBMCISMtlsAppValidator(),
 				"ibm_cis_mtls":                                 cis.ResourceIBMCISMtlsValidator(),
 				"ibm_cis_bot_management":                       cis.ResourceIBMCISBotManagementValidator(),
+				"ibm_cis_managed_transforms":                   cis.ResourceIBMCISManagedTransformsValidator(),
+				"ibm_cis_transform_rules":                      cis.ResourceIBMCISTransformRulesValidator(),
 				"ibm_cis_origin_auth":                          cis.ResourceIBMCISOriginAuthPullValidator(),
 				"ibm_cis_origin_pool":                          cis.ResourceIBMCISPoolValidator(),
 				"ibm_container_cluster":                        kubernetes.ResourceIBMContainerClusterValidator(),
@@ -1406,6 +1573,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_container_vpc_cluster":                    kubernetes.ResourceIBMContainerVpcClusterValidator(),
 				"ibm_cos_bucket":                               cos.ResourceIBMCOSBucketValidator(),
 				"ibm_cr_namespace":                             registry.ResourceIBMCrNamespaceValidator(),
+				"ibm_cr_va_exemption":                          registry.ResourceIBMCrVaExemptionValidator(),
 				"ibm_tg_gateway":                               transitgateway.ResourceIBMTGValidator(),
 				"ibm_app_config_feature":                       appconfiguration.ResourceIBMAppConfigFeatureValidator(),
 				"ibm_tg_connection":                            transitgateway.ResourceIBMTransitGatewayConnectionValidator(),
@@ -1416,6 +1584,13 @@ func Validator() validate.ValidatorDict {
 				"ibm_dl_provider_gateway":                      directlink.ResourceIBMDLProviderGatewayValidator(),
 				"ibm_dl_gateway_action":                        directlink.ResourceIBMDLGatewayActionValidator(),
 				"ibm_database":                                 database.ResourceIBMICDValidator(),
+				"ibm_db2_autoscale":                            db2.ResourceIBMDb2AutoscaleValidator(),
+				"ibm_db2_user":                                 db2.ResourceIBMDb2UserValidator(),
+				"ibm_ns1_monitor":                              ns1.ResourceIBMNS1MonitorValidator(),
+				"ibm_ns1_record":                               ns1.ResourceIBMNS1RecordValidator(),
+				"ibm_billing_resource_usage_export":            usagereports.ResourceIBMBillingResourceUsageExportValidator(),
+				"ibm_billing_report_snapshot":                  usagereports.ResourceIBMBillingReportSnapshotValidator(),
+				"ibm_logs_policy":                              logs.ResourceIbmLogsPolicyValidator(),
 				"ibm_function_package":                         functions.ResourceIBMFuncPackageValidator(),
 				"ibm_function_action":                          functions.ResourceIBMFuncActionValidator(),
 				"ibm_function_rule":                            functions.ResourceIBMFuncRuleValidator(),
@@ -1485,6 +1660,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_is_vpn_server":                       vpc.ResourceIBMIsVPNServerValidator(),
 				"ibm_is_vpn_server_route":                 vpc.ResourceIBMIsVPNServerRouteValidator(),
 				"ibm_kms_key_rings":                       kms.ResourceIBMKeyRingValidator(),
+				"ibm_kms_event_notifications":             kms.ResourceIBMKmsEventNotificationsValidator(),
 				"ibm_dns_glb_monitor":                     dnsservices.ResourceIBMPrivateDNSGLBMonitorValidator(),
 				"ibm_dns_custom_resolver_forwarding_rule": dnsservices.ResourceIBMPrivateDNSForwardingRuleValidator(),
 				"ibm_schematics_action":                   schematics.ResourceIBMSchematicsActionValidator(),
@@ -1516,6 +1692,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_scc_profile":                scc.ResourceIbmSccProfileValidator(),
 				"ibm_scc_profile_attachment":     scc.ResourceIbmSccProfileAttachmentValidator(),
 				"ibm_scc_provider_type_instance": scc.ResourceIbmSccProviderTypeInstanceValidator(),
+				"ibm_scc_scope":                  scc.ResourceIbmSccScopeValidator(),
 
 				// Added for Toolchains
 				"ibm_cd_toolchain":                         cdtoolchain.ResourceIBMCdToolchainValidator(),
@@ -1549,6 +1726,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_cd_tekton_pipeline_trigger":          cdtektonpipeline.ResourceIBMCdTektonPipelineTriggerValidator(),
 
 				"ibm_container_addons":                      kubernetes.ResourceIBMContainerAddOnsValidator(),
+				"ibm_container_cluster_wait":                kubernetes.ResourceIBMContainerClusterWaitValidator(),
 				"ibm_container_alb_create":                  kubernetes.ResourceIBMContainerAlbCreateValidator(),
 				"ibm_container_nlb_dns":                     kubernetes.ResourceIBMContainerNlbDnsValidator(),
 				"ibm_container_vpc_alb_create":              kubernetes.ResourceIBMContainerVpcAlbCreateNewValidator(),
@@ -1559,7 +1737,10 @@ func Validator() validate.ValidatorDict {
 				"ibm_container_ingress_instance":            kubernetes.ResourceIBMContainerIngressInstanceValidator(),
 				"ibm_container_ingress_secret_tls":          kubernetes.ResourceIBMContainerIngressSecretTLSValidator(),
 				"ibm_container_ingress_secret_opaque":       kubernetes.ResourceIBMContainerIngressSecretOpaqueValidator(),
+				"ibm_container_ingress_domain_certificate":  kubernetes.ResourceIBMContainerIngressDomainCertificateValidator(),
 				"ibm_container_cluster_feature":             kubernetes.ResourceIBMContainerClusterFeatureValidator(),
+				"ibm_container_logs_agent":                  kubernetes.ResourceIBMContainerLogsAgentValidator(),
+				"ibm_container_monitoring_agent":            kubernetes.ResourceIBMContainerMonitoringAgentValidator(),
 
 				"ibm_iam_access_group_dynamic_rule":        iamaccessgroup.ResourceIBMIAMDynamicRuleValidator(),
 				"ibm_iam_access_group_members":             iamaccessgroup.ResourceIBMIAMAccessGroupMembersValidator(),
@@ -1609,6 +1790,8 @@ func Validator() validate.ValidatorDict {
 				// bare_metal_server
 				"ibm_is_bare_metal_server": vpc.DataSourceIBMIsBareMetalServerValidator(),
 
+				"ibm_is_network_acl_rule_templates": vpc.DataSourceIBMISNetworkACLRuleTemplatesValidator(),
+
 				"ibm_is_vpc":                      vpc.DataSourceIBMISVpcValidator(),
 				"ibm_is_volume":                   vpc.DataSourceIBMISVolumeValidator(),
 				"ibm_secrets_manager_secret":      secretsmanager.DataSourceIBMSecretsManagerSecretValidator(),
@@ -1652,12 +1835,14 @@ func Validator() validate.ValidatorDict {
 				"ibm_database":                        database.DataSourceIBMDatabaseInstanceValidator(),
 
 				"ibm_container_addons":                  kubernetes.DataSourceIBMContainerAddOnsValidator(),
+				"ibm_container_cluster_health":          kubernetes.DataSourceIBMContainerClusterHealthValidator(),
 				"ibm_container_nlb_dns":                 kubernetes.DataSourceIBMContainerNLBDNSValidator(),
 				"ibm_container_storage_attachment":      kubernetes.DataSourceIBMContainerVpcWorkerVolumeAttachmentValidator(),
 				"ibm_container_vpc_cluster_worker_pool": kubernetes.DataSourceIBMContainerVpcClusterWorkerPoolValidator(),
 				"ibm_container_worker_pool":             kubernetes.DataSourceIBMContainerWorkerPoolValidator(),
 				"ibm_container_bind_service":            kubernetes.DataSourceIBMContainerBindServiceValidator(),
 				"ibm_container_cluster_config":          kubernetes.DataSourceIBMContainerClusterConfigValidator(),
+				"ibm_container_cluster_kubeconfig":      kubernetes.DataSourceIBMContainerClusterKubeconfigValidator(),
 				"ibm_container_cluster":                 kubernetes.DataSourceIBMContainerClusterValidator(),
 				"ibm_container_vpc_cluster_worker":      kubernetes.DataSourceIBMContainerVPCClusterWorkerValidator(),
 				"ibm_container_vpc_cluster":             kubernetes.DataSourceIBMContainerVPCClusterValidator(),
@@ -1675,10 +1860,15 @@ func Validator() validate.ValidatorDict {
 				"ibm_iam_trusted_profile":             iamidentity.DataSourceIBMIamTrustedProfileValidator(),
 				"ibm_iam_trusted_profile_claim_rules": iamidentity.DataSourceIBMIamTrustedProfileClaimRulesValidator(),
 				"ibm_iam_trusted_profiles":            iamidentity.DataSourceIBMIamTrustedProfilesValidator(),
+				"ibm_iam_api_keys":                    iamidentity.DataSourceIBMIamAPIKeysValidator(),
+				"ibm_iam_service_ids":                 iamidentity.DataSourceIBMIamServiceIDsValidator(),
 
 				"ibm_iam_access_group_policy":    iampolicy.DataSourceIBMIAMAccessGroupPolicyValidator(),
 				"ibm_iam_service_policy":         iampolicy.DataSourceIBMIAMServicePolicyValidator(),
 				"ibm_iam_trusted_profile_policy": iampolicy.DataSourceIBMIAMTrustedProfilePolicyValidator(),
+
+				"ibm_cbr_rule_evaluation":    contextbasedrestrictions.DataSourceIBMCbrRuleEvaluationValidator(),
+				"ibm_cbr_serviceref_targets": contextbasedrestrictions.DataSourceIBMCbrServicerefTargetsValidator(),
 			},
 		}
 	})
@@ -1745,11 +1935,29 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	if f, ok := d.GetOk("endpoints_file_path"); ok {
 		file = f.(string)
 	}
+	var tokenCachePath string
+	if p, ok := d.GetOk("iam_token_cache_path"); ok {
+		tokenCachePath = p.(string)
+	}
+	var kmsFailoverRegions []string
+	if r, ok := d.GetOk("kms_failover_regions"); ok {
+		for _, region := range strings.Split(r.(string), ",") {
+			if region = strings.TrimSpace(region); region != "" {
+				kmsFailoverRegions = append(kmsFailoverRegions, region)
+			}
+		}
+	}
 
 	resourceGrp := d.Get("resource_group").(string)
 	region := d.Get("region").(string)
 	zone := d.Get("zone").(string)
 	retryCount := d.Get("max_retries").(int)
+	httpTransport := conns.HTTPTransportConfig{
+		MaxIdleConnsPerHost: d.Get("max_idle_conns_per_host").(int),
+		IdleConnTimeout:     time.Duration(d.Get("idle_conn_timeout").(int)) * time.Second,
+		KeepAlive:           time.Duration(d.Get("keep_alive").(int)) * time.Second,
+		TLSSessionCacheSize: d.Get("tls_session_cache_size").(int),
+	}
 	wskNameSpace := d.Get("function_namespace").(string)
 	riaasEndPoint := d.Get("riaas_endpoint").(string)
 
@@ -1781,6 +1989,12 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		Visibility:           visibility,
 		EndpointsFile:        file,
 		IAMTrustedProfileID:  iamTrustedProfileId,
+		IAMAssumeProfileID:   d.Get("iam_assume_profile_id").(string),
+		IAMAssumeProfileName: d.Get("iam_assume_profile_name").(string),
+		IAMAssumeAccountID:   d.Get("iam_assume_account_id").(string),
+		IAMTokenCachePath:    tokenCachePath,
+		HTTPTransport:        httpTransport,
+		KMSFailoverRegions:   kmsFailoverRegions,
 	}
 
 	return config.ClientSession()
@@ -0,0 +1,381 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package appconfiguration
+
+import (
+	"encoding/binary"
+	"fmt"
+	"log"
+	"math"
+	"math/bits"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+)
+
+// appConfigDefaultValue is the value of a segment rule that falls back to the value of the feature or property
+const appConfigDefaultValue = "$default"
+
+func DataSourceIBMAppConfigEvaluation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIbmAppConfigEvaluationRead,
+
+		Schema: map[string]*schema.Schema{
+			"guid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "GUID of the App Configuration service. Get it from the service instance credentials section of the dashboard.",
+			},
+			"environment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Environment Id.",
+			},
+			"feature_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"feature_id", "property_id"},
+				Description:  "Id of the feature flag to evaluate.",
+			},
+			"property_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"feature_id", "property_id"},
+				Description:  "Id of the property to evaluate.",
+			},
+			"entity_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Id of the entity the feature flag or property is evaluated for. It is used for the rollout percentage.",
+			},
+			"entity_attributes": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Attributes of the entity that are matched against the rules of the segments.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the feature flag or property (BOOLEAN, STRING, NUMERIC).",
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Evaluated value of the feature flag or property for the entity.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the feature flag is enabled for the entity. Always true for a property.",
+			},
+			"segment_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Id of the segment that matched the entity, empty when no segment matched.",
+			},
+		},
+	}
+}
+
+// appConfigEvaluationRule is a segment rule of a feature flag or property
+type appConfigEvaluationRule struct {
+	Segments          []appconfigurationv1.TargetSegments
+	Value             interface{}
+	Order             int64
+	RolloutPercentage *int64
+}
+
+func dataSourceIbmAppConfigEvaluationRead(d *schema.ResourceData, meta interface{}) error {
+	guid := d.Get("guid").(string)
+
+	appconfigClient, err := getAppConfigClient(meta, guid)
+	if err != nil {
+		return err
+	}
+
+	environmentID := d.Get("environment_id").(string)
+	entityID := d.Get("entity_id").(string)
+	attributes := make(map[string]string)
+	for k, v := range d.Get("entity_attributes").(map[string]interface{}) {
+		attributes[k] = v.(string)
+	}
+
+	var id, itemType, segmentID string
+	var value interface{}
+	enabled := true
+
+	if featureID, ok := d.GetOk("feature_id"); ok {
+		options := &appconfigurationv1.GetFeatureOptions{}
+		options.SetEnvironmentID(environmentID)
+		options.SetFeatureID(featureID.(string))
+
+		feature, response, err := appconfigClient.GetFeature(options)
+		if err != nil {
+			log.Printf("[DEBUG] GetFeature failed %s\n%s", err, response)
+			return err
+		}
+		id = *feature.FeatureID
+		itemType = *feature.Type
+
+		rules := make([]appConfigEvaluationRule, 0, len(feature.SegmentRules))
+		for _, rule := range feature.SegmentRules {
+			rules = append(rules, appConfigEvaluationRule{
+				Segments:          rule.Rules,
+				Value:             rule.Value,
+				Order:             *rule.Order,
+				RolloutPercentage: rule.RolloutPercentage,
+			})
+		}
+
+		rolloutPercentage := int64(100)
+		if feature.RolloutPercentage != nil {
+			rolloutPercentage = *feature.RolloutPercentage
+		}
+
+		if feature.Enabled == nil || !*feature.Enabled {
+			value, enabled = feature.DisabledValue, false
+		} else {
+			rule, err := appConfigMatchSegmentRule(appconfigClient, environmentID, rules, attributes)
+			if err != nil {
+				return err
+			}
+			value = feature.EnabledValue
+			if rule != nil {
+				segmentID = rule.segmentID
+				if rule.RolloutPercentage != nil {
+					rolloutPercentage = *rule.RolloutPercentage
+				}
+				if v, ok := rule.Value.(string); !ok || v != appConfigDefaultValue {
+					value = rule.Value
+				}
+			}
+			if !appConfigInRollout(entityID, id, rolloutPercentage) {
+				value, enabled = feature.DisabledValue, false
+			}
+		}
+	} else {
+		options := &appconfigurationv1.GetPropertyOptions{}
+		options.SetEnvironmentID(environmentID)
+		options.SetPropertyID(d.Get("property_id").(string))
+
+		property, response, err := appconfigClient.GetProperty(options)
+		if err != nil {
+			log.Printf("[DEBUG] GetProperty failed %s\n%s", err, response)
+			return err
+		}
+		id = *property.PropertyID
+		itemType = *property.Type
+
+		rules := make([]appConfigEvaluationRule, 0, len(property.SegmentRules))
+		for _, rule := range property.SegmentRules {
+			rules = append(rules, appConfigEvaluationRule{
+				Segments: rule.Rules,
+				Value:    rule.Value,
+				Order:    *rule.Order,
+			})
+		}
+
+		rule, err := appConfigMatchSegmentRule(appconfigClient, environmentID, rules, attributes)
+		if err != nil {
+			return err
+		}
+		value = property.Value
+		if rule != nil {
+			segmentID = rule.segmentID
+			if v, ok := rule.Value.(string); !ok || v != appConfigDefaultValue {
+				value = rule.Value
+			}
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", guid, environmentID, id, entityID))
+	if err = d.Set("type", itemType); err != nil {
+		return fmt.Errorf("[ERROR] Error setting type: %s", err)
+	}
+	if err = d.Set("value", appConfigValueString(value)); err != nil {
+		return fmt.Errorf("[ERROR] Error setting value: %s", err)
+	}
+	if err = d.Set("enabled", enabled); err != nil {
+		return fmt.Errorf("[ERROR] Error setting enabled: %s", err)
+	}
+	if err = d.Set("segment_id", segmentID); err != nil {
+		return fmt.Errorf("[ERROR] Error setting segment_id: %s", err)
+	}
+	return nil
+}
+
+// appConfigMatchedRule is the segment rule that matched an entity, with the segment that matched
+type appConfigMatchedRule struct {
+	appConfigEvaluationRule
+	segmentID string
+}
+
+// appConfigMatchSegmentRule returns the first segment rule, by order, with a segment that matches the attributes of the entity
+func appConfigMatchSegmentRule(appconfigClient *appconfigurationv1.AppConfigurationV1, environmentID string, rules []appConfigEvaluationRule, attributes map[string]string) (*appConfigMatchedRule, error) {
+	if len(rules) == 0 || len(attributes) == 0 {
+		return nil, nil
+	}
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].Order < rules[j].Order
+	})
+
+	segments := make(map[string]*appconfigurationv1.Segment)
+	for _, rule := range rules {
+		for _, target := range rule.Segments {
+			for _, segmentID := range target.Segments {
+				segment, ok := segments[segmentID]
+				if !ok {
+					options := &appconfigurationv1.GetSegmentOptions{}
+					options.SetSegmentID(segmentID)
+					result, response, err := appconfigClient.GetSegment(options)
+					if err != nil {
+						log.Printf("[DEBUG] GetSegment failed %s\n%s", err, response)
+						return nil, fmt.Errorf("[ERROR] Error getting segment %s of environment %s: %s", segmentID, environmentID, err)
+					}
+					segment = result
+					segments[segmentID] = segment
+				}
+				if appConfigSegmentMatches(segment, attributes) {
+					return &appConfigMatchedRule{appConfigEvaluationRule: rule, segmentID: segmentID}, nil
+				}
+			}
+		}
+	}
+	return nil, nil
+}
+
+// appConfigSegmentMatches returns whether the attributes match all the rules of a segment. A rule matches when the
+// attribute matches any of its values.
+func appConfigSegmentMatches(segment *appconfigurationv1.Segment, attributes map[string]string) bool {
+	for _, rule := range segment.Rules {
+		if rule.AttributeName == nil || rule.Operator == nil {
+			return false
+		}
+		attribute, ok := attributes[*rule.AttributeName]
+		if !ok {
+			return false
+		}
+		matched := false
+		for _, value := range rule.Values {
+			if appConfigOperatorMatches(*rule.Operator, attribute, value) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// appConfigOperatorMatches evaluates an operator of a segment rule on an attribute of the entity
+func appConfigOperatorMatches(operator, attribute, value string) bool {
+	switch operator {
+	case "is":
+		return attribute == value
+	case "contains":
+		return strings.Contains(attribute, value)
+	case "startsWith":
+		return strings.HasPrefix(attribute, value)
+	case "endsWith":
+		return strings.HasSuffix(attribute, value)
+	}
+
+	a, err := strconv.ParseFloat(attribute, 64)
+	if err != nil {
+		return false
+	}
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return false
+	}
+	switch operator {
+	case "greaterThan":
+		return a > v
+	case "lesserThan":
+		return a < v
+	case "greaterThanEquals":
+		return a >= v
+	case "lesserThanEquals":
+		return a <= v
+	}
+	log.Printf("[WARN] Unsupported segment rule operator %s", operator)
+	return false
+}
+
+// appConfigInRollout returns whether an entity is in the rollout percentage of a feature flag. The entities are
+// bucketed with the murmur3 hash of "<entity_id>:<feature_id>", like the App Configuration client SDKs do.
+func appConfigInRollout(entityID, featureID string, rolloutPercentage int64) bool {
+	if rolloutPercentage >= 100 {
+		return true
+	}
+	hash := appConfigMurmur3([]byte(fmt.Sprintf("%s:%s", entityID, featureID)), 0)
+	normalized := int64(float64(hash) / math.MaxUint32 * 100)
+	return normalized < rolloutPercentage
+}
+
+// appConfigMurmur3 returns the 32-bit murmur3 hash of data
+func appConfigMurmur3(data []byte, seed uint32) uint32 {
+	const (
+		c1 = 0xcc9e2d51
+		c2 = 0x1b873593
+	)
+	h := seed
+	n := len(data) / 4
+	for i := 0; i < n; i++ {
+		k := binary.LittleEndian.Uint32(data[i*4:])
+		k *= c1
+		k = bits.RotateLeft32(k, 15)
+		k *= c2
+		h ^= k
+		h = bits.RotateLeft32(h, 13)
+		h = h*5 + 0xe6546b64
+	}
+
+	var k uint32
+	tail := data[n*4:]
+	switch len(tail) {
+	case 3:
+		k ^= uint32(tail[2]) << 16
+		fallthrough
+	case 2:
+		k ^= uint32(tail[1]) << 8
+		fallthrough
+	case 1:
+		k ^= uint32(tail[0])
+		k *= c1
+		k = bits.RotateLeft32(k, 15)
+		k *= c2
+		h ^= k
+	}
+
+	h ^= uint32(len(data))
+	h ^= h >> 16
+	h *= 0x85ebca6b
+	h ^= h >> 13
+	h *= 0xc2b2ae35
+	h ^= h >> 16
+	return h
+}
+
+// appConfigValueString formats a value of a feature flag or property
+func appConfigValueString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return fmt.Sprintf("%v", v)
+	case bool:
+		return strconv.FormatBool(v)
+	case nil:
+		return ""
+	}
+	return fmt.Sprintf("%v", value)
+}
@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package appconfiguration_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIbmAppConfigEvaluationDataSource(t *testing.T) {
+	environmentID := "dev"
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	featureID := fmt.Sprintf("tf_feature_id_%d", acctest.RandIntRange(10, 100))
+	instanceName := fmt.Sprintf("tf_app_config_test_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmAppConfigEvaluationDataSourceConfigBasic(instanceName, name, environmentID, featureID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_app_config_evaluation.ibm_app_config_evaluation_data1", "id"),
+					resource.TestCheckResourceAttr("data.ibm_app_config_evaluation.ibm_app_config_evaluation_data1", "type", "BOOLEAN"),
+					resource.TestCheckResourceAttr("data.ibm_app_config_evaluation.ibm_app_config_evaluation_data1", "enabled", "false"),
+					resource.TestCheckResourceAttr("data.ibm_app_config_evaluation.ibm_app_config_evaluation_data1", "value", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmAppConfigEvaluationDataSourceConfigBasic(instanceName, name, environmentID, featureID string) string {
+	return fmt.Sprintf(`
+		resource "ibm_resource_instance" "app_config_terraform_test483" {
+			name     = "%s"
+			location = "us-south"
+			service  = "apprapp"
+			plan     = "lite"
+		}
+
+		resource "ibm_app_config_feature" "app_config_feature_resource1" {
+			guid           = ibm_resource_instance.app_config_terraform_test483.guid
+			name           = "%s"
+			environment_id = "%s"
+			feature_id     = "%s"
+			type           = "BOOLEAN"
+			enabled_value  = "true"
+			disabled_value = "false"
+		}
+
+		data "ibm_app_config_evaluation" "ibm_app_config_evaluation_data1" {
+			guid           = ibm_app_config_feature.app_config_feature_resource1.guid
+			environment_id = ibm_app_config_feature.app_config_feature_resource1.environment_id
+			feature_id     = ibm_app_config_feature.app_config_feature_resource1.feature_id
+			entity_id      = "tf_entity"
+			entity_attributes = {
+				region = "us-south"
+			}
+		}
+		`, instanceName, name, environmentID, featureID)
+}
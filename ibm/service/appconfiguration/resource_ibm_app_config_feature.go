@@ -52,14 +52,16 @@ func ResourceIBMIbmAppConfigFeature() *schema.Resource {
 				Description:  "Type of the feature (BOOLEAN, STRING, NUMERIC).",
 			},
 			"enabled_value": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Value of the feature when it is enabled. The value can be BOOLEAN, STRING or a NUMERIC value as per the `type` attribute.",
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: flex.SuppressEquivalentJSON,
+				Description:      "Value of the feature when it is enabled. The value can be BOOLEAN, STRING or a NUMERIC value as per the `type` attribute.",
 			},
 			"disabled_value": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Value of the feature when it is disabled. The value can be BOOLEAN, STRING or a NUMERIC value as per the `type` attribute.",
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: flex.SuppressEquivalentJSON,
+				Description:      "Value of the feature when it is disabled. The value can be BOOLEAN, STRING or a NUMERIC value as per the `type` attribute.",
 			},
 			"description": {
 				Type:        schema.TypeString,
@@ -98,9 +100,10 @@ func ResourceIBMIbmAppConfigFeature() *schema.Resource {
 							},
 						},
 						"value": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "Value to be used for evaluation for this rule. The value can be Boolean, String or a Numeric value as per the `type` attribute.",
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: flex.SuppressEquivalentJSON,
+							Description:      "Value to be used for evaluation for this rule. The value can be Boolean, String or a Numeric value as per the `type` attribute.",
 						},
 						"order": {
 							Type:        schema.TypeInt,
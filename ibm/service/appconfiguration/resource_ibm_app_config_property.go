@@ -49,9 +49,10 @@ func ResourceIBMIbmAppConfigProperty() *schema.Resource {
 				Description: "Type of the Property  (BOOLEAN, STRING, NUMERIC).",
 			},
 			"value": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Value of the Property. The value can be Boolean, String or a Numeric value as per the `type` attribute.",
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: flex.SuppressEquivalentJSON,
+				Description:      "Value of the Property. The value can be Boolean, String or a Numeric value as per the `type` attribute.",
 			},
 			"description": {
 				Type:        schema.TypeString,
@@ -90,9 +91,10 @@ func ResourceIBMIbmAppConfigProperty() *schema.Resource {
 							},
 						},
 						"value": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "Value to be used for evaluation for this rule. The value can be Boolean, String or a Numeric value as per the `type` attribute.",
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: flex.SuppressEquivalentJSON,
+							Description:      "Value to be used for evaluation for this rule. The value can be Boolean, String or a Numeric value as per the `type` attribute.",
 						},
 						"order": {
 							Type:        schema.TypeInt,
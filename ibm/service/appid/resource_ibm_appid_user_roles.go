@@ -6,6 +6,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/IBM-Cloud/bluemix-go/helpers"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	appid "github.com/IBM/appid-management-go-sdk/appidmanagementv4"
@@ -31,10 +32,19 @@ func ResourceIBMAppIDUserRoles() *schema.Resource {
 				Description: "The AppID instance GUID",
 			},
 			"subject": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "The user's identifier ('subject' in identity token)",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"subject", "email"},
+				Description:  "The user's identifier ('subject' in identity token)",
+			},
+			"email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"subject", "email"},
+				Description:  "The user's email, used to look up the user's identifier",
 			},
 			"role_ids": {
 				Description: "A set of AppID role IDs that should be assigned to the user",
@@ -96,6 +106,13 @@ func resourceIBMAppIDUserRolesCreate(ctx context.Context, d *schema.ResourceData
 	subject := d.Get("subject").(string)
 	roleIds := d.Get("role_ids").(*schema.Set)
 
+	if email, ok := d.GetOk("email"); ok && subject == "" {
+		subject, err = appIDUserSubjectByEmail(ctx, appIDClient, tenantID, email.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	input := &appid.UpdateUserRolesOptions{
 		TenantID: &tenantID,
 		ID:       &subject,
@@ -155,3 +172,27 @@ func flattenAppIDUserRoleIDs(r []appid.GetUserRolesResponseRolesItem) []string {
 	}
 	return result
 }
+
+// appIDUserSubjectByEmail looks up the subject of the user profile with the given email
+func appIDUserSubjectByEmail(ctx context.Context, appIDClient *appid.AppIDManagementV4, tenantID string, email string) (string, error) {
+	users, resp, err := appIDClient.UsersSearchUserProfileWithContext(ctx, &appid.UsersSearchUserProfileOptions{
+		TenantID:  &tenantID,
+		DataScope: helpers.String(appid.UsersSearchUserProfileOptionsDataScopeIndexConst),
+		Email:     &email,
+	})
+
+	if err != nil {
+		log.Printf("[DEBUG] Error searching AppID users: %s\n%s", err, resp)
+		return "", fmt.Errorf("Error searching AppID users: %s", err)
+	}
+
+	if len(users.Users) == 0 {
+		return "", fmt.Errorf("Error searching AppID users: no user found with email %s", email)
+	}
+
+	if len(users.Users) > 1 {
+		return "", fmt.Errorf("Error searching AppID users: %d users found with email %s, use subject instead", len(users.Users), email)
+	}
+
+	return *users.Users[0].ID, nil
+}
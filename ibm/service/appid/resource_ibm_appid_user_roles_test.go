@@ -34,6 +34,25 @@ func TestAccIBMAppIDUserRolesRoles_basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMAppIDUserRolesRoles_email(t *testing.T) {
+	roleName := fmt.Sprintf("tf_testacc_role_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMAppIDUserRolesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: setupAppIDUserRolesEmailConfig(acc.AppIDTenantID, roleName, acc.AppIDTestUserEmail),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_appid_user_roles.roles", "role_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("ibm_appid_user_roles.roles", "subject", "ibm_appid_cloud_directory_user.test_user", "subject"),
+				),
+			},
+		},
+	})
+}
+
 // Test assumes there are no pre-existing roles
 func setupAppIDUserRolesConfig(tenantID string, roleName string, email string) string {
 	return fmt.Sprintf(`	
@@ -61,6 +80,32 @@ func setupAppIDUserRolesConfig(tenantID string, roleName string, email string) s
 	`, tenantID, roleName, email)
 }
 
+func setupAppIDUserRolesEmailConfig(tenantID string, roleName string, email string) string {
+	return fmt.Sprintf(`
+		resource "ibm_appid_role" "role" {
+			tenant_id = "%s"
+			name = "%s"
+			description = "test role"
+		}
+
+		resource "ibm_appid_cloud_directory_user" "test_user" {
+			tenant_id = ibm_appid_role.role.tenant_id
+			email {
+				value = "%s"
+				primary = true
+			}
+			password = "P@ssw0rd"
+			status = "PENDING"
+		}
+
+		resource "ibm_appid_user_roles" "roles" {
+			tenant_id = ibm_appid_role.role.tenant_id
+			email = ibm_appid_cloud_directory_user.test_user.email[0].value
+			role_ids = [ibm_appid_role.role.role_id]
+		}
+	`, tenantID, roleName, email)
+}
+
 func testAccCheckIBMAppIDUserRolesDestroy(s *terraform.State) error {
 	appIDClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).AppIDAPI()
 
@@ -0,0 +1,221 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package assistant
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// assistantAPIVersion is the version date of the watsonx Assistant v2 API sent with every request
+const assistantAPIVersion = "2023-06-15"
+
+// assistantInstance is an assistant of the watsonx Assistant v2 API
+type assistantInstance struct {
+	AssistantID           *string                `json:"assistant_id,omitempty"`
+	Name                  *string                `json:"name,omitempty"`
+	Description           *string                `json:"description,omitempty"`
+	Language              *string                `json:"language,omitempty"`
+	AssistantSkills       []assistantSkillRef    `json:"assistant_skills,omitempty"`
+	AssistantEnvironments []assistantEnvironment `json:"assistant_environments,omitempty"`
+}
+
+type assistantSkillRef struct {
+	SkillID *string `json:"skill_id,omitempty"`
+	Type    *string `json:"type,omitempty"`
+}
+
+type assistantEnvironment struct {
+	Name          *string `json:"name,omitempty"`
+	EnvironmentID *string `json:"environment_id,omitempty"`
+	Environment   *string `json:"environment,omitempty"`
+}
+
+type assistantCollection struct {
+	Assistants []assistantInstance `json:"assistants"`
+	Pagination struct {
+		NextCursor *string `json:"next_cursor,omitempty"`
+	} `json:"pagination"`
+}
+
+// addAssistantInstanceSchema adds the arguments that identify the watsonx Assistant instance of a resource
+func addAssistantInstanceSchema(resource *schema.Resource) *schema.Resource {
+	resource.Schema["instance_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The GUID of the watsonx Assistant instance.",
+	}
+	resource.Schema["region"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+		Description: "The region of the watsonx Assistant instance. The default is the region of the provider.",
+	}
+	resource.Schema["endpoint_type"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Default:      "public",
+		ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+		Description:  "The type of the endpoint of the watsonx Assistant instance: public or private.",
+	}
+	return resource
+}
+
+// getAssistantService returns the watsonx Assistant service of the instance of the resource, and sets its region
+func getAssistantService(d *schema.ResourceData, meta interface{}) (*core.BaseService, error) {
+	assistantClient, err := meta.(conns.ClientSession).AssistantV2()
+	if err != nil {
+		return nil, err
+	}
+
+	region := d.Get("region").(string)
+	if region == "" {
+		bxSession, err := meta.(conns.ClientSession).BluemixSession()
+		if err != nil {
+			return nil, err
+		}
+		region = bxSession.Config.Region
+		d.Set("region", region)
+	}
+
+	host := fmt.Sprintf("api.%s.assistant.watson.cloud.ibm.com", region)
+	if d.Get("endpoint_type").(string) == "private" {
+		host = fmt.Sprintf("api.private.%s.assistant.watson.cloud.ibm.com", region)
+	}
+
+	service := assistantClient.Clone()
+	if err = service.SetServiceURL(fmt.Sprintf("https://%s/instances/%s", host, d.Get("instance_id").(string))); err != nil {
+		return nil, err
+	}
+	return service, nil
+}
+
+// assistantRequest sends a request to the watsonx Assistant API and unmarshals the response into result.
+// The path is relative to the URL of the instance.
+func assistantRequest(context context.Context, service *core.BaseService, method, path string, pathParamsMap map[string]string, query map[string]string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(context)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(service.Options.URL, path, pathParamsMap)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	builder.AddQuery("version", assistantAPIVersion)
+	for name, value := range query {
+		builder.AddQuery(name, value)
+	}
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		if _, err := builder.SetBodyContentJSON(body); err != nil {
+			return nil, err
+		}
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return service.Request(request, result)
+}
+
+// getAssistant returns the assistant of the instance, or nil when it does not exist. The API has no
+// operation to get an assistant, it is looked up in the list of the assistants.
+func getAssistant(context context.Context, service *core.BaseService, assistantID string) (*assistantInstance, error) {
+	assistants, err := flex.PaginateAllByToken(context, func(context context.Context, cursor string) ([]assistantInstance, string, *core.DetailedResponse, error) {
+		query := map[string]string{"page_limit": "100"}
+		if cursor != "" {
+			query["cursor"] = cursor
+		}
+		collection := &assistantCollection{}
+		response, err := assistantRequest(context, service, core.GET, "/v2/assistants", nil, query, nil, collection)
+		if err != nil {
+			return nil, "", response, fmt.Errorf("[ERROR] Error listing the assistants: %s\n%s", err, response)
+		}
+		next := ""
+		if collection.Pagination.NextCursor != nil {
+			next = *collection.Pagination.NextCursor
+		}
+		return collection.Assistants, next, response, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range assistants {
+		if assistants[i].AssistantID != nil && *assistants[i].AssistantID == assistantID {
+			return &assistants[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// parseAssistantID splits the ID region/instanceID/assistantID[/child] of a resource and sets the instance arguments
+func parseAssistantID(d *schema.ResourceData, parts int, format string) ([]string, error) {
+	idParts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return nil, err
+	}
+	if len(idParts) != parts {
+		return nil, fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of %s", d.Id(), format)
+	}
+	d.Set("region", idParts[0])
+	d.Set("instance_id", idParts[1])
+	return idParts, nil
+}
+
+// assistantRelease is a release of the draft of an assistant
+type assistantRelease struct {
+	Release     *string `json:"release,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Status      *string `json:"status,omitempty"`
+}
+
+// createAssistantRelease creates a release of the current draft of the assistant and waits for it to be available
+func createAssistantRelease(context context.Context, service *core.BaseService, assistantID, description string, timeout time.Duration) (string, error) {
+	pathParamsMap := map[string]string{"assistant_id": assistantID}
+	prototype := &assistantRelease{}
+	if description != "" {
+		prototype.Description = core.StringPtr(description)
+	}
+	release := &assistantRelease{}
+	response, err := assistantRequest(context, service, core.POST, "/v2/assistants/{assistant_id}/releases", pathParamsMap, nil, prototype, release)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error creating a release of watsonx assistant %s: %s\n%s", assistantID, err, response)
+	}
+
+	pathParamsMap["release"] = *release.Release
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"", "Processing"},
+		Target:  []string{"Available"},
+		Refresh: func() (interface{}, string, error) {
+			release := &assistantRelease{}
+			response, err := assistantRequest(context, service, core.GET, "/v2/assistants/{assistant_id}/releases/{release}", pathParamsMap, nil, nil, release)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting the release %s of watsonx assistant %s: %s\n%s", pathParamsMap["release"], assistantID, err, response)
+			}
+			if release.Status != nil && *release.Status == "Failed" {
+				return release, *release.Status, fmt.Errorf("[ERROR] The release %s of watsonx assistant %s failed", pathParamsMap["release"], assistantID)
+			}
+			return release, core.StringNilMapper(release.Status), nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(context); err != nil {
+		return "", err
+	}
+	return *release.Release, nil
+}
@@ -0,0 +1,199 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package assistant
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func ResourceIBMWatsonxAssistant() *schema.Resource {
+	return addAssistantInstanceSchema(&schema.Resource{
+		CreateContext: resourceIBMWatsonxAssistantCreate,
+		ReadContext:   resourceIBMWatsonxAssistantRead,
+		DeleteContext: resourceIBMWatsonxAssistantDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+				Description:  "The name of the assistant.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The description of the assistant.",
+			},
+			"language": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "en",
+				Description: "The language of the assistant.",
+			},
+			"assistant_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the assistant.",
+			},
+			"skills": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The skills of the assistant.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"skill_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the skill.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the skill: action, dialog or search.",
+						},
+					},
+				},
+			},
+			"environments": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The environments of the assistant, the draft environment and the live environments.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the environment.",
+						},
+						"environment_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the environment.",
+						},
+						"environment": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the environment: draft or live.",
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+func resourceIBMWatsonxAssistantCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	assistantClient, err := getAssistantService(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	prototype := &assistantInstance{
+		Name:     core.StringPtr(d.Get("name").(string)),
+		Language: core.StringPtr(d.Get("language").(string)),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		prototype.Description = core.StringPtr(v.(string))
+	}
+	assistant := &assistantInstance{}
+	response, err := assistantRequest(context, assistantClient, core.POST, "/v2/assistants", nil, nil, prototype, assistant)
+	if err != nil {
+		log.Printf("[DEBUG] Error creating watsonx assistant %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating watsonx assistant %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", d.Get("region").(string), d.Get("instance_id").(string), *assistant.AssistantID))
+
+	return resourceIBMWatsonxAssistantRead(context, d, meta)
+}
+
+func resourceIBMWatsonxAssistantRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := parseAssistantID(d, 3, "region/instanceID/assistantID")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	assistantClient, err := getAssistantService(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	assistant, err := getAssistant(context, assistantClient, parts[2])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if assistant == nil {
+		log.Printf("[WARN] The watsonx assistant %s is not found, removing it from the state", parts[2])
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("assistant_id", assistant.AssistantID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting assistant_id: %s", err))
+	}
+	if err = d.Set("name", assistant.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
+	}
+	if err = d.Set("description", assistant.Description); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting description: %s", err))
+	}
+	if assistant.Language != nil {
+		if err = d.Set("language", assistant.Language); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting language: %s", err))
+		}
+	}
+	skills := make([]map[string]interface{}, 0, len(assistant.AssistantSkills))
+	for _, skill := range assistant.AssistantSkills {
+		skills = append(skills, map[string]interface{}{
+			"skill_id": skill.SkillID,
+			"type":     skill.Type,
+		})
+	}
+	if err = d.Set("skills", skills); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting skills: %s", err))
+	}
+	environments := make([]map[string]interface{}, 0, len(assistant.AssistantEnvironments))
+	for _, environment := range assistant.AssistantEnvironments {
+		environments = append(environments, map[string]interface{}{
+			"name":           environment.Name,
+			"environment_id": environment.EnvironmentID,
+			"environment":    environment.Environment,
+		})
+	}
+	if err = d.Set("environments", environments); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting environments: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMWatsonxAssistantDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	assistantClient, err := getAssistantService(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	pathParamsMap := map[string]string{"assistant_id": d.Get("assistant_id").(string)}
+	response, err := assistantRequest(context, assistantClient, core.DELETE, "/v2/assistants/{assistant_id}", pathParamsMap, nil, nil, nil)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] Error deleting watsonx assistant %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting watsonx assistant %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
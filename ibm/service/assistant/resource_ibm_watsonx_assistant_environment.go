@@ -0,0 +1,215 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package assistant
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// assistantEnvironmentDetails is an environment of the watsonx Assistant v2 API
+type assistantEnvironmentDetails struct {
+	Name             *string `json:"name,omitempty"`
+	Description      *string `json:"description,omitempty"`
+	EnvironmentID    *string `json:"environment_id,omitempty"`
+	Environment      *string `json:"environment,omitempty"`
+	SessionTimeout   *int64  `json:"session_timeout,omitempty"`
+	ReleaseReference *struct {
+		Release *string `json:"release,omitempty"`
+	} `json:"release_reference,omitempty"`
+	Created *string `json:"created,omitempty"`
+	Updated *string `json:"updated,omitempty"`
+}
+
+func ResourceIBMWatsonxAssistantEnvironment() *schema.Resource {
+	return addAssistantInstanceSchema(&schema.Resource{
+		CreateContext: resourceIBMWatsonxAssistantEnvironmentCreate,
+		ReadContext:   resourceIBMWatsonxAssistantEnvironmentRead,
+		UpdateContext: resourceIBMWatsonxAssistantEnvironmentUpdate,
+		DeleteContext: resourceIBMWatsonxAssistantEnvironmentDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"assistant_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the assistant of the environment.",
+			},
+			"environment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the environment, the environments are created with the assistant.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the environment.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The description of the environment.",
+			},
+			"session_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The session inactivity timeout of the environment in seconds.",
+			},
+			"release": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The release deployed in the environment. The releases can't be deployed in the draft environment.",
+			},
+			"environment": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the environment: draft or live.",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when the environment was created.",
+			},
+			"updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when the environment was updated.",
+			},
+		},
+	})
+}
+
+func resourceIBMWatsonxAssistantEnvironmentCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The environments are created with the assistant, the resource manages the settings of an existing environment
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", d.Get("region").(string), d.Get("instance_id").(string), d.Get("assistant_id").(string), d.Get("environment_id").(string)))
+	if err := updateAssistantEnvironment(context, d, meta, true); err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMWatsonxAssistantEnvironmentRead(context, d, meta)
+}
+
+func resourceIBMWatsonxAssistantEnvironmentRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := parseAssistantID(d, 4, "region/instanceID/assistantID/environmentID")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("assistant_id", parts[2])
+	d.Set("environment_id", parts[3])
+
+	assistantClient, err := getAssistantService(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	environment := &assistantEnvironmentDetails{}
+	pathParamsMap := map[string]string{"assistant_id": parts[2], "environment_id": parts[3]}
+	response, err := assistantRequest(context, assistantClient, core.GET, "/v2/assistants/{assistant_id}/environments/{environment_id}", pathParamsMap, nil, nil, environment)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Error getting watsonx assistant environment %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting watsonx assistant environment %s\n%s", err, response))
+	}
+
+	if err = d.Set("name", environment.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
+	}
+	if err = d.Set("description", environment.Description); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting description: %s", err))
+	}
+	if err = d.Set("session_timeout", flex.IntValue(environment.SessionTimeout)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting session_timeout: %s", err))
+	}
+	if environment.ReleaseReference != nil {
+		if err = d.Set("release", environment.ReleaseReference.Release); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting release: %s", err))
+		}
+	}
+	if err = d.Set("environment", environment.Environment); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting environment: %s", err))
+	}
+	if err = d.Set("created", environment.Created); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting created: %s", err))
+	}
+	if err = d.Set("updated", environment.Updated); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting updated: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMWatsonxAssistantEnvironmentUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := updateAssistantEnvironment(context, d, meta, false); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMWatsonxAssistantEnvironmentRead(context, d, meta)
+}
+
+// updateAssistantEnvironment updates the settings of the environment that are set, and deploys the release
+func updateAssistantEnvironment(context context.Context, d *schema.ResourceData, meta interface{}, create bool) error {
+	assistantClient, err := getAssistantService(d, meta)
+	if err != nil {
+		return err
+	}
+
+	pathParamsMap := map[string]string{"assistant_id": d.Get("assistant_id").(string), "environment_id": d.Get("environment_id").(string)}
+	patch := &assistantEnvironmentDetails{}
+	hasChange := false
+	if v, ok := d.GetOk("name"); ok && (create || d.HasChange("name")) {
+		patch.Name = core.StringPtr(v.(string))
+		hasChange = true
+	}
+	if v, ok := d.GetOk("description"); ok && (create || d.HasChange("description")) {
+		patch.Description = core.StringPtr(v.(string))
+		hasChange = true
+	}
+	if v, ok := d.GetOk("session_timeout"); ok && (create || d.HasChange("session_timeout")) {
+		patch.SessionTimeout = core.Int64Ptr(int64(v.(int)))
+		hasChange = true
+	}
+	if hasChange {
+		response, err := assistantRequest(context, assistantClient, core.POST, "/v2/assistants/{assistant_id}/environments/{environment_id}", pathParamsMap, nil, patch, nil)
+		if err != nil {
+			log.Printf("[DEBUG] Error updating watsonx assistant environment %s\n%s", err, response)
+			return fmt.Errorf("[ERROR] Error updating watsonx assistant environment %s\n%s", err, response)
+		}
+	}
+
+	if v, ok := d.GetOk("release"); ok && (create || d.HasChange("release")) {
+		release := v.(string)
+		pathParamsMap["release"] = release
+		body := map[string]string{"environment_id": pathParamsMap["environment_id"]}
+		response, err := assistantRequest(context, assistantClient, core.POST, "/v2/assistants/{assistant_id}/releases/{release}/deploy", pathParamsMap, nil, body, nil)
+		if err != nil {
+			log.Printf("[DEBUG] Error deploying watsonx assistant release %s\n%s", err, response)
+			return fmt.Errorf("[ERROR] Error deploying the release %s in watsonx assistant environment %s: %s\n%s", release, pathParamsMap["environment_id"], err, response)
+		}
+	}
+	return nil
+}
+
+func resourceIBMWatsonxAssistantEnvironmentDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The environments are deleted with the assistant
+	log.Printf("[DEBUG] Removing watsonx assistant environment %s from the state, the environment is not deleted", d.Get("environment_id"))
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package assistant_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMWatsonxAssistantEnvironmentBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-assistant-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckAssistant(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMWatsonxAssistantEnvironmentConfig(name, 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_watsonx_assistant_environment.live", "environment", "live"),
+					resource.TestCheckResourceAttr("ibm_watsonx_assistant_environment.live", "session_timeout", "600"),
+				),
+			},
+			{
+				Config: testAccCheckIBMWatsonxAssistantEnvironmentConfig(name, 900),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_watsonx_assistant_environment.live", "session_timeout", "900"),
+				),
+			},
+			{
+				ResourceName:            "ibm_watsonx_assistant_environment.live",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"endpoint_type"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMWatsonxAssistantEnvironmentConfig(name string, sessionTimeout int) string {
+	return testAccCheckIBMWatsonxAssistantConfig(name) + fmt.Sprintf(`
+		resource "ibm_watsonx_assistant_environment" "live" {
+			instance_id     = ibm_watsonx_assistant.assistant.instance_id
+			region          = ibm_watsonx_assistant.assistant.region
+			assistant_id    = ibm_watsonx_assistant.assistant.assistant_id
+			environment_id  = [for environment in ibm_watsonx_assistant.assistant.environments : environment.environment_id if environment.environment == "live"][0]
+			session_timeout = %d
+		}
+	`, sessionTimeout)
+}
@@ -0,0 +1,209 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package assistant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// assistantSkillsImportStatus is the status of the last import of skills in an assistant
+type assistantSkillsImportStatus struct {
+	Status       *string `json:"status,omitempty"`
+	StatusErrors []struct {
+		Message *string `json:"message,omitempty"`
+	} `json:"status_errors,omitempty"`
+	StatusDescription *string `json:"status_description,omitempty"`
+}
+
+func ResourceIBMWatsonxAssistantSkillsImport() *schema.Resource {
+	return addAssistantInstanceSchema(&schema.Resource{
+		CreateContext: resourceIBMWatsonxAssistantSkillsImportCreate,
+		ReadContext:   resourceIBMWatsonxAssistantSkillsImportRead,
+		UpdateContext: resourceIBMWatsonxAssistantSkillsImportUpdate,
+		DeleteContext: resourceIBMWatsonxAssistantSkillsImportDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"assistant_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the assistant to import the skills in.",
+			},
+			"skills_json": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: flex.SuppressEquivalentJSON,
+				StateFunc:        flex.NormalizeJSONStateFunc,
+				Description:      "The JSON export of the skills of an assistant, with the assistant_skills and the assistant_state of the export.",
+			},
+			"create_release": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to create a release of the draft of the assistant once the skills are imported.",
+			},
+			"release_description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the release created once the skills are imported.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the last import of the skills.",
+			},
+			"release": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The release created once the skills are imported, when create_release is true.",
+			},
+		},
+	})
+}
+
+func resourceIBMWatsonxAssistantSkillsImportCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := importAssistantSkills(context, d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", d.Get("region").(string), d.Get("instance_id").(string), d.Get("assistant_id").(string)))
+
+	return resourceIBMWatsonxAssistantSkillsImportRead(context, d, meta)
+}
+
+// importAssistantSkills imports the skills of the export in the assistant, waits for the import to complete
+// and creates a release of the draft when create_release is true
+func importAssistantSkills(context context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	assistantClient, err := getAssistantService(d, meta)
+	if err != nil {
+		return err
+	}
+
+	assistantID := d.Get("assistant_id").(string)
+	var skills map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("skills_json").(string)), &skills); err != nil {
+		return fmt.Errorf("[ERROR] Error parsing skills_json: %s", err)
+	}
+	if _, ok := skills["assistant_skills"]; !ok {
+		return fmt.Errorf("[ERROR] Error parsing skills_json: the export has no assistant_skills")
+	}
+
+	pathParamsMap := map[string]string{"assistant_id": assistantID}
+	response, err := assistantRequest(context, assistantClient, core.POST, "/v2/assistants/{assistant_id}/skills_import", pathParamsMap, nil, skills, nil)
+	if err != nil {
+		log.Printf("[DEBUG] Error importing the skills of watsonx assistant %s\n%s", err, response)
+		return fmt.Errorf("[ERROR] Error importing the skills of watsonx assistant %s: %s\n%s", assistantID, err, response)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"", "Processing"},
+		Target:  []string{"Available"},
+		Refresh: func() (interface{}, string, error) {
+			status := &assistantSkillsImportStatus{}
+			response, err := assistantRequest(context, assistantClient, core.GET, "/v2/assistants/{assistant_id}/skills_import/status", pathParamsMap, nil, nil, status)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting the status of the skills import of watsonx assistant %s: %s\n%s", assistantID, err, response)
+			}
+			if status.Status != nil && *status.Status == "Failed" {
+				return status, *status.Status, fmt.Errorf("[ERROR] The skills import of watsonx assistant %s failed: %s", assistantID, assistantSkillsImportErrors(status))
+			}
+			return status, core.StringNilMapper(status.Status), nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(context); err != nil {
+		return err
+	}
+
+	if d.Get("create_release").(bool) {
+		release, err := createAssistantRelease(context, assistantClient, assistantID, d.Get("release_description").(string), timeout)
+		if err != nil {
+			return err
+		}
+		d.Set("release", release)
+	}
+	return nil
+}
+
+func assistantSkillsImportErrors(status *assistantSkillsImportStatus) string {
+	messages := []string{}
+	for _, statusError := range status.StatusErrors {
+		if statusError.Message != nil {
+			messages = append(messages, *statusError.Message)
+		}
+	}
+	if len(messages) == 0 {
+		return core.StringNilMapper(status.StatusDescription)
+	}
+	return strings.Join(messages, ", ")
+}
+
+func resourceIBMWatsonxAssistantSkillsImportRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := parseAssistantID(d, 3, "region/instanceID/assistantID")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("assistant_id", parts[2])
+
+	assistantClient, err := getAssistantService(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The skills are not read back, the export of the service has properties that the imports don't have
+	status := &assistantSkillsImportStatus{}
+	pathParamsMap := map[string]string{"assistant_id": parts[2]}
+	response, err := assistantRequest(context, assistantClient, core.GET, "/v2/assistants/{assistant_id}/skills_import/status", pathParamsMap, nil, nil, status)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Error getting the status of the skills import %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting the status of the skills import of watsonx assistant %s: %s\n%s", parts[2], err, response))
+	}
+	if err = d.Set("status", status.Status); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting status: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMWatsonxAssistantSkillsImportUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("skills_json") {
+		if err := importAssistantSkills(context, d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMWatsonxAssistantSkillsImportRead(context, d, meta)
+}
+
+func resourceIBMWatsonxAssistantSkillsImportDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The skills of an assistant can't be removed, they stay in the draft of the assistant
+	log.Printf("[DEBUG] Removing the skills import of watsonx assistant %s from the state, the skills are not deleted", d.Get("assistant_id"))
+	d.SetId("")
+	return nil
+}
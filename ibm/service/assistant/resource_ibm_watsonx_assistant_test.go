@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package assistant_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMWatsonxAssistantBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-assistant-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckAssistant(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMWatsonxAssistantConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_watsonx_assistant.assistant", "name", name),
+					resource.TestCheckResourceAttr("ibm_watsonx_assistant.assistant", "language", "en"),
+					resource.TestCheckResourceAttrSet("ibm_watsonx_assistant.assistant", "assistant_id"),
+					resource.TestCheckResourceAttrSet("ibm_watsonx_assistant.assistant", "environments.#"),
+				),
+			},
+			{
+				ResourceName:            "ibm_watsonx_assistant.assistant",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"endpoint_type"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMWatsonxAssistantConfig(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_watsonx_assistant" "assistant" {
+			instance_id = "%s"
+			region      = "%s"
+			name        = "%s"
+			description = "Assistant of the acceptance tests"
+		}
+	`, acc.AssistantInstanceID, acc.AssistantInstanceRegion, name)
+}
@@ -1,12 +1,27 @@
 package atracker
 
 import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/atrackerv2"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
 )
 
 const (
 	REDACTED_TEXT = "REDACTED"
+
+	atrackerServiceName = "atracker"
+	cosServiceName      = "cloud-object-storage"
+	cosWriterRole       = "Object Writer"
 )
 
 func getAtrackerClients(meta interface{}) (
@@ -23,3 +38,116 @@ func getAtrackerClients(meta interface{}) (
 
 	return atrackerClientv2, nil
 }
+
+// createAtrackerCosAuthorization creates the service to service authorization that allows Activity Tracker
+// to write to the Cloud Object Storage instance of the target CRN, and returns its ID. An empty ID is
+// returned if an identical authorization already exists, as it is not managed by the target.
+func createAtrackerCosAuthorization(meta interface{}, targetCRN string) (string, error) {
+	crnParts := strings.Split(targetCRN, ":")
+	if len(crnParts) < COS_CRN_PARTS || crnParts[4] != cosServiceName || crnParts[7] == "" {
+		return "", fmt.Errorf("[ERROR] The target_crn %s is not the CRN of a Cloud Object Storage instance", targetCRN)
+	}
+	cosInstanceID := crnParts[7]
+
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return "", err
+	}
+	iampapClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return "", err
+	}
+
+	listRoleOptions := &iampolicymanagementv1.ListRolesOptions{
+		ServiceName:       core.StringPtr(cosServiceName),
+		SourceServiceName: core.StringPtr(atrackerServiceName),
+		PolicyType:        core.StringPtr("authorization"),
+	}
+	roleList, resp, err := iampapClient.ListRoles(listRoleOptions)
+	if err != nil || roleList == nil {
+		return "", fmt.Errorf("[ERROR] Error in listing roles %s, %s", err, resp)
+	}
+	roles, err := flex.GetRolesFromRoleNames([]string{cosWriterRole}, flex.MapRoleListToPolicyRoles(*roleList))
+	if err != nil {
+		return "", err
+	}
+
+	policySubject := iampolicymanagementv1.PolicySubject{
+		Attributes: []iampolicymanagementv1.SubjectAttribute{
+			{Name: core.StringPtr("serviceName"), Value: core.StringPtr(atrackerServiceName)},
+			{Name: core.StringPtr("accountId"), Value: core.StringPtr(userDetails.UserAccount)},
+		},
+	}
+	policyResource := iampolicymanagementv1.PolicyResource{
+		Attributes: []iampolicymanagementv1.ResourceAttribute{
+			{Name: core.StringPtr("serviceName"), Value: core.StringPtr(cosServiceName), Operator: core.StringPtr("stringEquals")},
+			{Name: core.StringPtr("accountId"), Value: core.StringPtr(userDetails.UserAccount), Operator: core.StringPtr("stringEquals")},
+			{Name: core.StringPtr("serviceInstance"), Value: core.StringPtr(cosInstanceID)},
+		},
+	}
+	createPolicyOptions := iampapClient.NewCreatePolicyOptions(
+		"authorization",
+		[]iampolicymanagementv1.PolicySubject{policySubject},
+		roles,
+		[]iampolicymanagementv1.PolicyResource{policyResource},
+	)
+	createPolicyOptions.SetDescription("Allows Activity Tracker to write to the Cloud Object Storage target")
+
+	authPolicy, resp, err := iampapClient.CreatePolicy(createPolicyOptions)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 409 {
+			log.Printf("[INFO] The authorization of Activity Tracker to write to the Cloud Object Storage instance %s already exists", cosInstanceID)
+			return "", nil
+		}
+		return "", fmt.Errorf("[ERROR] Error creating the authorization of Activity Tracker to write to the Cloud Object Storage instance %s: %s %s", cosInstanceID, err, resp)
+	}
+	return *authPolicy.ID, nil
+}
+
+func deleteAtrackerCosAuthorization(meta interface{}, policyID string) error {
+	iampapClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	deletePolicyOptions := &iampolicymanagementv1.DeletePolicyOptions{
+		PolicyID: core.StringPtr(policyID),
+	}
+	resp, err := iampapClient.DeletePolicy(deletePolicyOptions)
+	if err != nil && (resp == nil || resp.StatusCode != 404) {
+		return fmt.Errorf("[ERROR] Error deleting the service authorization %s of the target: %s %s", policyID, err, resp)
+	}
+	return nil
+}
+
+// waitForAtrackerTargetWrite sends test events to the target until one is written, as a new service
+// authorization can take some time to be effective, and fails with the reason of the last failure
+func waitForAtrackerTargetWrite(context context.Context, atrackerClient *atrackerv2.AtrackerV2, id string, timeout time.Duration) error {
+	reason := ""
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"failed"},
+		Target:  []string{"success"},
+		Refresh: func() (interface{}, string, error) {
+			validateTargetOptions := &atrackerv2.ValidateTargetOptions{}
+			validateTargetOptions.SetID(id)
+			target, response, err := atrackerClient.ValidateTargetWithContext(context, validateTargetOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("ValidateTargetWithContext failed %s\n%s", err, response)
+			}
+			if target.WriteStatus != nil && target.WriteStatus.Status != nil && *target.WriteStatus.Status == "success" {
+				return target, "success", nil
+			}
+			if target.WriteStatus != nil && target.WriteStatus.ReasonForLastFailure != nil {
+				reason = *target.WriteStatus.ReasonForLastFailure
+			}
+			return target, "failed", nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(context); err != nil {
+		return fmt.Errorf("[ERROR] The test event could not be written to the target %s: %s. Check the endpoint, the bucket and the access of Activity Tracker to it: %s", id, reason, err)
+	}
+	return nil
+}
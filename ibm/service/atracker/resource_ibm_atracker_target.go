@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,6 +29,11 @@ func ResourceIBMAtrackerTarget() *schema.Resource {
 		DeleteContext: resourceIBMAtrackerTargetDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -135,6 +141,24 @@ func ResourceIBMAtrackerTarget() *schema.Resource {
 					},
 				},
 			},
+			"create_service_authorization": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Create the service to service authorization that allows Activity Tracker to write to the Cloud Object Storage instance of the cos_endpoint. The authorization is deleted with the target. Only supported when service_to_service_enabled is true.",
+			},
+			"validate_target": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Send a test event to the target after it is created or updated, and fail if it can't be written.",
+			},
+			"service_authorization_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the service to service authorization created for the target.",
+			},
 			"region": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -307,14 +331,36 @@ func resourceIBMAtrackerTargetCreate(context context.Context, d *schema.Resource
 		createTargetOptions.SetRegion(d.Get("region").(string))
 	}
 
+	if d.Get("create_service_authorization").(bool) {
+		if createTargetOptions.CosEndpoint == nil || !*createTargetOptions.CosEndpoint.ServiceToServiceEnabled {
+			return diag.FromErr(fmt.Errorf("[ERROR] create_service_authorization is only supported for a cos_endpoint with service_to_service_enabled"))
+		}
+		policyID, err := createAtrackerCosAuthorization(meta, *createTargetOptions.CosEndpoint.TargetCRN)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("service_authorization_id", policyID)
+	}
+
 	target, response, err := atrackerClient.CreateTargetWithContext(context, createTargetOptions)
 	if err != nil {
 		log.Printf("[DEBUG] CreateTargetWithContext failed %s\n%s", err, response)
+		if policyID, ok := d.GetOk("service_authorization_id"); ok {
+			if deleteErr := deleteAtrackerCosAuthorization(meta, policyID.(string)); deleteErr != nil {
+				log.Printf("[WARN] Error deleting the service authorization %s of the target: %s", policyID, deleteErr)
+			}
+		}
 		return diag.FromErr(fmt.Errorf("CreateTargetWithContext failed %s\n%s", err, response))
 	}
 
 	d.SetId(*target.ID)
 
+	if d.Get("validate_target").(bool) {
+		if err = waitForAtrackerTargetWrite(context, atrackerClient, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceIBMAtrackerTargetRead(context, d, meta)
 }
 
@@ -483,6 +529,12 @@ func resourceIBMAtrackerTargetUpdate(context context.Context, d *schema.Resource
 		}
 	}
 
+	if d.Get("validate_target").(bool) && (hasChange || d.HasChange("validate_target")) {
+		if err = waitForAtrackerTargetWrite(context, atrackerClient, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceIBMAtrackerTargetRead(context, d, meta)
 }
 
@@ -502,6 +554,12 @@ func resourceIBMAtrackerTargetDelete(context context.Context, d *schema.Resource
 		return diag.FromErr(fmt.Errorf("DeleteTargetWithContext failed %s\n%s", err, response))
 	}
 
+	if policyID, ok := d.GetOk("service_authorization_id"); ok {
+		if err = deleteAtrackerCosAuthorization(meta, policyID.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	d.SetId("")
 
 	return nil
@@ -43,9 +43,30 @@ func TestAccIBMAtrackerTargetBasic(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "ibm_atracker_target.atracker_target",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "ibm_atracker_target.atracker_target",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_service_authorization", "validate_target"},
+			},
+		},
+	})
+}
+
+func TestAccIBMAtrackerTargetServiceAuthorization(t *testing.T) {
+	name := fmt.Sprintf("tf-atracker-target-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMAtrackerTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMAtrackerTargetConfigServiceAuthorization(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_atracker_target.atracker_target", "name", name),
+					resource.TestCheckResourceAttrSet("ibm_atracker_target.atracker_target", "service_authorization_id"),
+					resource.TestCheckResourceAttr("ibm_atracker_target.atracker_target", "write_status.0.status", "success"),
+				),
 			},
 		},
 	})
@@ -68,6 +89,37 @@ func testAccCheckIBMAtrackerTargetConfigBasic(name string, targetType string) st
 	`, name, targetType)
 }
 
+func testAccCheckIBMAtrackerTargetConfigServiceAuthorization(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_resource_instance" "cos_instance" {
+			name     = "%[1]s"
+			service  = "cloud-object-storage"
+			plan     = "standard"
+			location = "global"
+		}
+
+		resource "ibm_cos_bucket" "cos_bucket" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = ibm_resource_instance.cos_instance.id
+			region_location      = "us-south"
+			storage_class        = "standard"
+		}
+
+		resource "ibm_atracker_target" "atracker_target" {
+			name        = "%[1]s"
+			target_type = "cloud_object_storage"
+			cos_endpoint {
+				endpoint                   = "s3.private.us-south.cloud-object-storage.appdomain.cloud"
+				target_crn                 = ibm_resource_instance.cos_instance.crn
+				bucket                     = ibm_cos_bucket.cos_bucket.bucket_name
+				service_to_service_enabled = true
+			}
+			create_service_authorization = true
+			validate_target              = true
+		}
+	`, name)
+}
+
 func testAccCheckIBMAtrackerTargetConfig(name string, targetType string, region string) string {
 	return fmt.Sprintf(`
 
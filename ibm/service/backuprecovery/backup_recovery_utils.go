@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package backuprecovery
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// addBackupRecoveryInstanceSchema adds the arguments that identify the Backup and Recovery instance and tenant of a resource
+func addBackupRecoveryInstanceSchema(resource *schema.Resource) *schema.Resource {
+	resource.Schema["instance_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The GUID of the Backup and Recovery instance.",
+	}
+	resource.Schema["region"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+		Description: "The region of the Backup and Recovery instance. The default is the region of the provider.",
+	}
+	resource.Schema["endpoint_type"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Default:      "public",
+		ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+		Description:  "The type of the endpoint of the Backup and Recovery instance: public or private.",
+	}
+	resource.Schema["x_ibm_tenant_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The ID of the tenant of the Backup and Recovery instance.",
+	}
+	return resource
+}
+
+// backupRecoveryID returns the ID of a Backup and Recovery object of a resource. The tenant ID is last, it ends with a slash.
+func backupRecoveryID(d *schema.ResourceData, objectID string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", d.Get("region").(string), d.Get("instance_id").(string), objectID, d.Get("x_ibm_tenant_id").(string))
+}
+
+// parseBackupRecoveryID sets the instance arguments of a resource from its ID and returns the ID of the Backup and Recovery object
+func parseBackupRecoveryID(d *schema.ResourceData) (string, error) {
+	parts := strings.SplitN(d.Id(), "/", 4)
+	if len(parts) != 4 || parts[2] == "" {
+		return "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of region/instanceID/objectID/tenantID", d.Id())
+	}
+	d.Set("region", parts[0])
+	d.Set("instance_id", parts[1])
+	d.Set("x_ibm_tenant_id", parts[3])
+	return parts[2], nil
+}
+
+// getBackupRecoveryService returns the Backup and Recovery service of the instance of the resource, and sets its region
+func getBackupRecoveryService(d *schema.ResourceData, meta interface{}) (*core.BaseService, error) {
+	backupRecoveryClient, err := meta.(conns.ClientSession).BackupRecoveryV1()
+	if err != nil {
+		return nil, err
+	}
+
+	region := d.Get("region").(string)
+	if region == "" {
+		bxSession, err := meta.(conns.ClientSession).BluemixSession()
+		if err != nil {
+			return nil, err
+		}
+		region = bxSession.Config.Region
+		d.Set("region", region)
+	}
+
+	host := fmt.Sprintf("%s.%s.backup-recovery.cloud.ibm.com", d.Get("instance_id").(string), region)
+	if d.Get("endpoint_type").(string) == "private" {
+		host = fmt.Sprintf("%s.private.%s.backup-recovery.cloud.ibm.com", d.Get("instance_id").(string), region)
+	}
+
+	service := backupRecoveryClient.Clone()
+	serviceURL := conns.EnvFallBack([]string{"IBMCLOUD_BACKUP_RECOVERY_ENDPOINT"}, fmt.Sprintf("https://%s/v2", host))
+	if err = service.SetServiceURL(serviceURL); err != nil {
+		return nil, err
+	}
+	return service, nil
+}
+
+// backupRecoveryRequest sends a request for the tenant of the resource to the Backup and Recovery API and unmarshals
+// the response into result. The path is relative to the URL of the instance.
+func backupRecoveryRequest(context context.Context, d *schema.ResourceData, service *core.BaseService, method, path string, pathParamsMap map[string]string, queryParams map[string]string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(context)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(service.Options.URL, path, pathParamsMap)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	builder.AddHeader("X-IBM-Tenant-Id", d.Get("x_ibm_tenant_id").(string))
+	for k, v := range queryParams {
+		builder.AddQuery(k, v)
+	}
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		if _, err := builder.SetBodyContentJSON(body); err != nil {
+			return nil, err
+		}
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return service.Request(request, result)
+}
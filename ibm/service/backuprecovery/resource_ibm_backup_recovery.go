@@ -0,0 +1,422 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package backuprecovery
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+const (
+	backupRecoveryStatusAccepted  = "Accepted"
+	backupRecoveryStatusRunning   = "Running"
+	backupRecoveryStatusSucceeded = "Succeeded"
+)
+
+// backupRecoveryRecovery is a recovery of the Backup and Recovery API
+type backupRecoveryRecovery struct {
+	ID                  *string                       `json:"id,omitempty"`
+	Name                *string                       `json:"name"`
+	SnapshotEnvironment *string                       `json:"snapshotEnvironment"`
+	PhysicalParams      *backupRecoveryRecoveryParams `json:"physicalParams,omitempty"`
+	VmwareParams        *backupRecoveryRecoveryParams `json:"vmwareParams,omitempty"`
+	Status              *string                       `json:"status,omitempty"`
+	StartTimeUsecs      *int64                        `json:"startTimeUsecs,omitempty"`
+	EndTimeUsecs        *int64                        `json:"endTimeUsecs,omitempty"`
+	Messages            []string                      `json:"messages,omitempty"`
+}
+
+type backupRecoveryRecoveryParams struct {
+	Objects                    []backupRecoveryRecoveryObject `json:"objects"`
+	RecoveryAction             *string                        `json:"recoveryAction"`
+	RecoverFileAndFolderParams map[string]interface{}         `json:"recoverFileAndFolderParams,omitempty"`
+	RecoverVmParams            map[string]interface{}         `json:"recoverVmParams,omitempty"`
+}
+
+type backupRecoveryRecoveryObject struct {
+	SnapshotID *string `json:"snapshotId"`
+}
+
+type backupRecoverySnapshots struct {
+	Snapshots []struct {
+		ID                     *string `json:"id"`
+		SnapshotTimestampUsecs *int64  `json:"snapshotTimestampUsecs"`
+	} `json:"snapshots"`
+}
+
+func ResourceIbmBackupRecovery() *schema.Resource {
+	return addBackupRecoveryInstanceSchema(&schema.Resource{
+		CreateContext: resourceIbmBackupRecoveryCreate,
+		ReadContext:   resourceIbmBackupRecoveryRead,
+		DeleteContext: resourceIbmBackupRecoveryDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the recovery.",
+			},
+			"snapshot_environment": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{backupRecoveryEnvironmentPhysical, backupRecoveryEnvironmentVMware}),
+				Description:  "The environment of the snapshot: kPhysical to recover files, or kVMware to recover a virtual machine.",
+			},
+			"snapshot_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"snapshot_id", "object_id"},
+				Description:  "The ID of the snapshot that is recovered.",
+			},
+			"object_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"protection_group_id"},
+				Description:  "The ID of the protected object the latest snapshot of is recovered.",
+			},
+			"protection_group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"object_id"},
+				Description:  "The ID of the protection group of the latest snapshot of object_id.",
+			},
+			"physical_params": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "The parameters of the recovery of files.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"files": {
+							Type:        schema.TypeList,
+							Required:    true,
+							ForceNew:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The absolute paths of the files and directories that are recovered.",
+						},
+						"target_id": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "The source_id of the host the files are recovered to. The default is the host of the snapshot.",
+						},
+						"restore_to_original_paths": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							ForceNew:    true,
+							Default:     true,
+							Description: "Whether the files are recovered to their original paths.",
+						},
+						"alternate_restore_directory": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "The directory the files are recovered to when restore_to_original_paths is false.",
+						},
+						"overwrite_existing": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							ForceNew:    true,
+							Default:     false,
+							Description: "Whether the existing files are overwritten.",
+						},
+					},
+				},
+			},
+			"vmware_params": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "The parameters of the recovery of a virtual machine to its original location.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"power_on_vms": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							ForceNew:    true,
+							Default:     true,
+							Description: "Whether the virtual machine is powered on after the recovery.",
+						},
+						"overwrite_existing": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							ForceNew:    true,
+							Default:     false,
+							Description: "Whether the existing virtual machine is overwritten.",
+						},
+					},
+				},
+			},
+			"recovery_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the recovery.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the recovery.",
+			},
+			"start_time_usecs": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The time the recovery started, in microseconds since the epoch.",
+			},
+			"end_time_usecs": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The time the recovery ended, in microseconds since the epoch.",
+			},
+			"messages": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The messages of the recovery.",
+			},
+		},
+	})
+}
+
+func resourceIbmBackupRecoveryCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	backupRecoveryClient, err := getBackupRecoveryService(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	snapshotID := d.Get("snapshot_id").(string)
+	if objectID, ok := d.GetOk("object_id"); ok {
+		snapshots := &backupRecoverySnapshots{}
+		pathParamsMap := map[string]string{"id": strconv.Itoa(objectID.(int))}
+		queryParams := map[string]string{"protectionGroupIds": d.Get("protection_group_id").(string)}
+		response, err := backupRecoveryRequest(context, d, backupRecoveryClient, core.GET, "/data-protect/objects/{id}/snapshots", pathParamsMap, queryParams, nil, snapshots)
+		if err != nil {
+			log.Printf("[DEBUG] Error listing backup recovery snapshots %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("Error listing backup recovery snapshots %s\n%s", err, response))
+		}
+		var latest int64
+		for _, snapshot := range snapshots.Snapshots {
+			if snapshot.ID != nil && snapshot.SnapshotTimestampUsecs != nil && *snapshot.SnapshotTimestampUsecs >= latest {
+				snapshotID = *snapshot.ID
+				latest = *snapshot.SnapshotTimestampUsecs
+			}
+		}
+		if snapshotID == "" {
+			return diag.FromErr(fmt.Errorf("[ERROR] No snapshot of object %d in protection group %s", objectID, d.Get("protection_group_id")))
+		}
+	}
+
+	prototype, err := resourceIbmBackupRecoveryPrototype(d, snapshotID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	recovery := &backupRecoveryRecovery{}
+	response, err := backupRecoveryRequest(context, d, backupRecoveryClient, core.POST, "/data-protect/recoveries", nil, nil, prototype, recovery)
+	if err != nil {
+		log.Printf("[DEBUG] Error creating backup recovery %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error creating backup recovery %s\n%s", err, response))
+	}
+
+	d.SetId(backupRecoveryID(d, *recovery.ID))
+	d.Set("snapshot_id", snapshotID)
+
+	if err = waitForBackupRecovery(context, d, backupRecoveryClient, *recovery.ID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIbmBackupRecoveryRead(context, d, meta)
+}
+
+// waitForBackupRecovery waits until a recovery succeeded
+func waitForBackupRecovery(context context.Context, d *schema.ResourceData, backupRecoveryClient *core.BaseService, recoveryID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{backupRecoveryStatusAccepted, backupRecoveryStatusRunning},
+		Target:  []string{backupRecoveryStatusSucceeded},
+		Refresh: func() (interface{}, string, error) {
+			recovery := &backupRecoveryRecovery{}
+			response, err := backupRecoveryRequest(context, d, backupRecoveryClient, core.GET, "/data-protect/recoveries/{id}", map[string]string{"id": recoveryID}, nil, nil, recovery)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error getting backup recovery %s\n%s", err, response)
+			}
+			status := backupRecoveryStatusAccepted
+			if recovery.Status != nil {
+				status = *recovery.Status
+			}
+			if status != backupRecoveryStatusSucceeded && status != backupRecoveryStatusAccepted && status != backupRecoveryStatusRunning {
+				return recovery, status, fmt.Errorf("[ERROR] The backup recovery %s ended with status %s: %v", recoveryID, status, recovery.Messages)
+			}
+			return recovery, status, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(context); err != nil {
+		return fmt.Errorf("Error waiting for backup recovery (%s) to succeed: %s", recoveryID, err)
+	}
+	return nil
+}
+
+func resourceIbmBackupRecoveryRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	recoveryID, err := parseBackupRecoveryID(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backupRecoveryClient, err := getBackupRecoveryService(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	recovery := &backupRecoveryRecovery{}
+	response, err := backupRecoveryRequest(context, d, backupRecoveryClient, core.GET, "/data-protect/recoveries/{id}", map[string]string{"id": recoveryID}, nil, nil, recovery)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Error getting backup recovery %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error getting backup recovery %s\n%s", err, response))
+	}
+
+	if err = d.Set("recovery_id", recoveryID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting recovery_id: %s", err))
+	}
+	if err = d.Set("name", recovery.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	if err = d.Set("snapshot_environment", recovery.SnapshotEnvironment); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting snapshot_environment: %s", err))
+	}
+	params := recovery.PhysicalParams
+	if params == nil {
+		params = recovery.VmwareParams
+	}
+	if params != nil && len(params.Objects) > 0 {
+		if err = d.Set("snapshot_id", params.Objects[0].SnapshotID); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting snapshot_id: %s", err))
+		}
+	}
+	if err = d.Set("status", recovery.Status); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting status: %s", err))
+	}
+	if err = d.Set("start_time_usecs", flex.IntValue(recovery.StartTimeUsecs)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting start_time_usecs: %s", err))
+	}
+	if err = d.Set("end_time_usecs", flex.IntValue(recovery.EndTimeUsecs)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting end_time_usecs: %s", err))
+	}
+	if err = d.Set("messages", recovery.Messages); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting messages: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIbmBackupRecoveryDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// A recovery that is still running is canceled, the recovered files and virtual machines are kept
+	status := d.Get("status").(string)
+	if status == backupRecoveryStatusAccepted || status == backupRecoveryStatusRunning {
+		backupRecoveryClient, err := getBackupRecoveryService(d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		pathParamsMap := map[string]string{"id": d.Get("recovery_id").(string)}
+		response, err := backupRecoveryRequest(context, d, backupRecoveryClient, core.POST, "/data-protect/recoveries/{id}/cancel", pathParamsMap, nil, nil, nil)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			log.Printf("[DEBUG] Error canceling backup recovery %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("Error canceling backup recovery %s\n%s", err, response))
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIbmBackupRecoveryPrototype(d *schema.ResourceData, snapshotID string) (*backupRecoveryRecovery, error) {
+	environment := d.Get("snapshot_environment").(string)
+	recovery := &backupRecoveryRecovery{
+		Name:                core.StringPtr(d.Get("name").(string)),
+		SnapshotEnvironment: core.StringPtr(environment),
+	}
+	objects := []backupRecoveryRecoveryObject{{SnapshotID: core.StringPtr(snapshotID)}}
+
+	if environment == backupRecoveryEnvironmentPhysical {
+		v, ok := d.GetOk("physical_params.0")
+		if !ok {
+			return nil, fmt.Errorf("[ERROR] physical_params is required by the kPhysical environment")
+		}
+		params := v.(map[string]interface{})
+		filesAndFolders := []map[string]interface{}{}
+		for _, path := range flex.ExpandStringList(params["files"].([]interface{})) {
+			filesAndFolders = append(filesAndFolders, map[string]interface{}{"absolutePath": path})
+		}
+		targetParams := map[string]interface{}{
+			"restoreToOriginalPaths": params["restore_to_original_paths"].(bool),
+			"overwriteExisting":      params["overwrite_existing"].(bool),
+		}
+		if targetID := params["target_id"].(int); targetID != 0 {
+			targetParams["recoverTarget"] = map[string]interface{}{"id": targetID}
+		}
+		if directory := params["alternate_restore_directory"].(string); directory != "" {
+			targetParams["alternateRestoreDirectory"] = directory
+		}
+		recovery.PhysicalParams = &backupRecoveryRecoveryParams{
+			Objects:        objects,
+			RecoveryAction: core.StringPtr("RecoverFiles"),
+			RecoverFileAndFolderParams: map[string]interface{}{
+				"filesAndFolders":      filesAndFolders,
+				"targetEnvironment":    backupRecoveryEnvironmentPhysical,
+				"physicalTargetParams": targetParams,
+			},
+		}
+		return recovery, nil
+	}
+
+	targetParams := map[string]interface{}{
+		"recoveryTargetConfig": map[string]interface{}{"recoverToNewSource": false},
+		"powerOnVms":           true,
+		"overwriteExistingVm":  false,
+	}
+	if v, ok := d.GetOk("vmware_params.0"); ok {
+		params := v.(map[string]interface{})
+		targetParams["powerOnVms"] = params["power_on_vms"].(bool)
+		targetParams["overwriteExistingVm"] = params["overwrite_existing"].(bool)
+	}
+	recovery.VmwareParams = &backupRecoveryRecoveryParams{
+		Objects:        objects,
+		RecoveryAction: core.StringPtr("RecoverVMs"),
+		RecoverVmParams: map[string]interface{}{
+			"targetEnvironment":  backupRecoveryEnvironmentVMware,
+			"vmwareTargetParams": targetParams,
+		},
+	}
+	return recovery, nil
+}
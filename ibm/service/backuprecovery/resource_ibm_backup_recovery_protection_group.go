@@ -0,0 +1,330 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package backuprecovery
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// backupRecoveryProtectionGroup is a protection group of the Backup and Recovery API
+type backupRecoveryProtectionGroup struct {
+	ID             *string                                `json:"id,omitempty"`
+	Name           *string                                `json:"name"`
+	PolicyID       *string                                `json:"policyId"`
+	Description    *string                                `json:"description,omitempty"`
+	Environment    *string                                `json:"environment"`
+	IsPaused       *bool                                  `json:"isPaused,omitempty"`
+	IsActive       *bool                                  `json:"isActive,omitempty"`
+	IsDeleted      *bool                                  `json:"isDeleted,omitempty"`
+	PhysicalParams *backupRecoveryPhysicalProtectionGroup `json:"physicalParams,omitempty"`
+	VmwareParams   *backupRecoveryVmwareProtectionGroup   `json:"vmwareParams,omitempty"`
+}
+
+type backupRecoveryPhysicalProtectionGroup struct {
+	ProtectionType           *string                           `json:"protectionType"`
+	FileProtectionTypeParams *backupRecoveryFileProtectionType `json:"fileProtectionTypeParams"`
+}
+
+type backupRecoveryFileProtectionType struct {
+	Objects []backupRecoveryProtectedObject `json:"objects"`
+}
+
+type backupRecoveryVmwareProtectionGroup struct {
+	Objects []backupRecoveryProtectedObject `json:"objects"`
+}
+
+type backupRecoveryProtectedObject struct {
+	ID        *int64                   `json:"id"`
+	Name      *string                  `json:"name,omitempty"`
+	FilePaths []backupRecoveryFilePath `json:"filePaths,omitempty"`
+}
+
+type backupRecoveryFilePath struct {
+	IncludedPath  *string  `json:"includedPath"`
+	ExcludedPaths []string `json:"excludedPaths,omitempty"`
+}
+
+func ResourceIbmBackupRecoveryProtectionGroup() *schema.Resource {
+	return addBackupRecoveryInstanceSchema(&schema.Resource{
+		CreateContext: resourceIbmBackupRecoveryProtectionGroupCreate,
+		ReadContext:   resourceIbmBackupRecoveryProtectionGroupRead,
+		UpdateContext: resourceIbmBackupRecoveryProtectionGroupUpdate,
+		DeleteContext: resourceIbmBackupRecoveryProtectionGroupDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the protection group.",
+			},
+			"policy_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the protection policy of the protection group.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the protection group.",
+			},
+			"environment": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{backupRecoveryEnvironmentPhysical, backupRecoveryEnvironmentVMware}),
+				Description:  "The environment of the protected objects: kPhysical for the files of hosts, or kVMware for virtual machines.",
+			},
+			"objects": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The protected objects.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "The ID of the object, the source_id of a physical source or the ID of a virtual machine.",
+						},
+						"included_paths": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The paths of the files that are protected, only for the kPhysical environment. The default is /.",
+						},
+						"excluded_paths": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The paths of the files that are not protected, only for the kPhysical environment.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the object.",
+						},
+					},
+				},
+			},
+			"is_paused": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the backups of the protection group are paused.",
+			},
+			"delete_snapshots": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the snapshots of the protection group are deleted with the protection group.",
+			},
+			"group_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the protection group.",
+			},
+			"is_active": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the protection group is active.",
+			},
+		},
+	})
+}
+
+func resourceIbmBackupRecoveryProtectionGroupCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	backupRecoveryClient, err := getBackupRecoveryService(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	group := &backupRecoveryProtectionGroup{}
+	response, err := backupRecoveryRequest(context, d, backupRecoveryClient, core.POST, "/data-protect/protection-groups", nil, nil, resourceIbmBackupRecoveryProtectionGroupPrototype(d), group)
+	if err != nil {
+		log.Printf("[DEBUG] Error creating backup recovery protection group %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error creating backup recovery protection group %s\n%s", err, response))
+	}
+
+	d.SetId(backupRecoveryID(d, *group.ID))
+
+	return resourceIbmBackupRecoveryProtectionGroupRead(context, d, meta)
+}
+
+func resourceIbmBackupRecoveryProtectionGroupRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	groupID, err := parseBackupRecoveryID(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backupRecoveryClient, err := getBackupRecoveryService(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	group := &backupRecoveryProtectionGroup{}
+	response, err := backupRecoveryRequest(context, d, backupRecoveryClient, core.GET, "/data-protect/protection-groups/{id}", map[string]string{"id": groupID}, nil, nil, group)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Error getting backup recovery protection group %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error getting backup recovery protection group %s\n%s", err, response))
+	}
+	// Deleted protection groups are kept with their snapshots
+	if group.IsDeleted != nil && *group.IsDeleted {
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("group_id", groupID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting group_id: %s", err))
+	}
+	if err = d.Set("name", group.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	if err = d.Set("policy_id", group.PolicyID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting policy_id: %s", err))
+	}
+	if err = d.Set("description", group.Description); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting description: %s", err))
+	}
+	if err = d.Set("environment", group.Environment); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting environment: %s", err))
+	}
+	var objects []backupRecoveryProtectedObject
+	if group.PhysicalParams != nil && group.PhysicalParams.FileProtectionTypeParams != nil {
+		objects = group.PhysicalParams.FileProtectionTypeParams.Objects
+	} else if group.VmwareParams != nil {
+		objects = group.VmwareParams.Objects
+	}
+	if err = d.Set("objects", backupRecoveryProtectedObjectsToList(objects)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting objects: %s", err))
+	}
+	if err = d.Set("is_paused", group.IsPaused != nil && *group.IsPaused); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting is_paused: %s", err))
+	}
+	if err = d.Set("is_active", group.IsActive); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting is_active: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIbmBackupRecoveryProtectionGroupUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	backupRecoveryClient, err := getBackupRecoveryService(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("name", "policy_id", "description", "objects", "is_paused") {
+		pathParamsMap := map[string]string{"id": d.Get("group_id").(string)}
+		response, err := backupRecoveryRequest(context, d, backupRecoveryClient, core.PUT, "/data-protect/protection-groups/{id}", pathParamsMap, nil, resourceIbmBackupRecoveryProtectionGroupPrototype(d), nil)
+		if err != nil {
+			log.Printf("[DEBUG] Error updating backup recovery protection group %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("Error updating backup recovery protection group %s\n%s", err, response))
+		}
+	}
+
+	return resourceIbmBackupRecoveryProtectionGroupRead(context, d, meta)
+}
+
+func resourceIbmBackupRecoveryProtectionGroupDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	backupRecoveryClient, err := getBackupRecoveryService(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	pathParamsMap := map[string]string{"id": d.Get("group_id").(string)}
+	queryParams := map[string]string{"deleteSnapshots": strconv.FormatBool(d.Get("delete_snapshots").(bool))}
+	response, err := backupRecoveryRequest(context, d, backupRecoveryClient, core.DELETE, "/data-protect/protection-groups/{id}", pathParamsMap, queryParams, nil, nil)
+	if err != nil {
+		log.Printf("[DEBUG] Error deleting backup recovery protection group %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error deleting backup recovery protection group %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIbmBackupRecoveryProtectionGroupPrototype(d *schema.ResourceData) *backupRecoveryProtectionGroup {
+	group := &backupRecoveryProtectionGroup{
+		Name:        core.StringPtr(d.Get("name").(string)),
+		PolicyID:    core.StringPtr(d.Get("policy_id").(string)),
+		Environment: core.StringPtr(d.Get("environment").(string)),
+		IsPaused:    core.BoolPtr(d.Get("is_paused").(bool)),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		group.Description = core.StringPtr(v.(string))
+	}
+
+	objects := []backupRecoveryProtectedObject{}
+	for _, v := range d.Get("objects").([]interface{}) {
+		modelMap := v.(map[string]interface{})
+		object := backupRecoveryProtectedObject{
+			ID: core.Int64Ptr(int64(modelMap["id"].(int))),
+		}
+		if *group.Environment == backupRecoveryEnvironmentPhysical {
+			includedPaths := flex.ExpandStringList(modelMap["included_paths"].([]interface{}))
+			if len(includedPaths) == 0 {
+				includedPaths = []string{"/"}
+			}
+			excludedPaths := flex.ExpandStringList(modelMap["excluded_paths"].([]interface{}))
+			for _, path := range includedPaths {
+				object.FilePaths = append(object.FilePaths, backupRecoveryFilePath{
+					IncludedPath:  core.StringPtr(path),
+					ExcludedPaths: excludedPaths,
+				})
+			}
+		}
+		objects = append(objects, object)
+	}
+
+	if *group.Environment == backupRecoveryEnvironmentPhysical {
+		group.PhysicalParams = &backupRecoveryPhysicalProtectionGroup{
+			ProtectionType:           core.StringPtr("kFile"),
+			FileProtectionTypeParams: &backupRecoveryFileProtectionType{Objects: objects},
+		}
+	} else {
+		group.VmwareParams = &backupRecoveryVmwareProtectionGroup{Objects: objects}
+	}
+	return group
+}
+
+func backupRecoveryProtectedObjectsToList(objects []backupRecoveryProtectedObject) []map[string]interface{} {
+	objectList := make([]map[string]interface{}, 0, len(objects))
+	for _, object := range objects {
+		includedPaths := []string{}
+		excludedPaths := []string{}
+		for _, path := range object.FilePaths {
+			if path.IncludedPath != nil {
+				includedPaths = append(includedPaths, *path.IncludedPath)
+			}
+			// The excluded paths are the same for all the included paths
+			if len(excludedPaths) == 0 {
+				excludedPaths = append(excludedPaths, path.ExcludedPaths...)
+			}
+		}
+		objectList = append(objectList, map[string]interface{}{
+			"id":             flex.IntValue(object.ID),
+			"included_paths": includedPaths,
+			"excluded_paths": excludedPaths,
+			"name":           object.Name,
+		})
+	}
+	return objectList
+}
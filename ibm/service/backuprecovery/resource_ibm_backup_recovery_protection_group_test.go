@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package backuprecovery_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmBackupRecoveryProtectionGroupBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-group-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckBackupRecovery(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmBackupRecoveryProtectionGroupConfig(name, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_backup_recovery_protection_group.protection_group", "name", name),
+					resource.TestCheckResourceAttr("ibm_backup_recovery_protection_group.protection_group", "objects.0.included_paths.0", "/etc"),
+					resource.TestCheckResourceAttr("ibm_backup_recovery_protection_group.protection_group", "is_paused", "false"),
+					resource.TestCheckResourceAttrSet("ibm_backup_recovery_protection_group.protection_group", "group_id"),
+				),
+			},
+			{
+				Config: testAccCheckIbmBackupRecoveryProtectionGroupConfig(name, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_backup_recovery_protection_group.protection_group", "is_paused", "true"),
+				),
+			},
+			{
+				ResourceName:            "ibm_backup_recovery_protection_group.protection_group",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"endpoint_type", "delete_snapshots"},
+			},
+		},
+	})
+}
+
+func testAccCheckIbmBackupRecoveryProtectionGroupConfig(name string, isPaused bool) string {
+	return fmt.Sprintf(`
+		resource "ibm_backup_recovery_source_registration" "source_registration" {
+			instance_id     = "%[1]s"
+			region          = "%[2]s"
+			x_ibm_tenant_id = "%[3]s"
+			environment     = "kPhysical"
+			connection_id   = "%[4]s"
+			physical_params {
+				endpoint = "%[5]s"
+			}
+		}
+
+		resource "ibm_backup_recovery_protection_policy" "protection_policy" {
+			instance_id     = "%[1]s"
+			region          = "%[2]s"
+			x_ibm_tenant_id = "%[3]s"
+			name            = "%[6]s"
+			backup_schedule {
+				unit = "Days"
+			}
+			retention {
+				unit     = "Weeks"
+				duration = 2
+			}
+		}
+
+		resource "ibm_backup_recovery_protection_group" "protection_group" {
+			instance_id      = "%[1]s"
+			region           = "%[2]s"
+			x_ibm_tenant_id  = "%[3]s"
+			name             = "%[6]s"
+			policy_id        = ibm_backup_recovery_protection_policy.protection_policy.policy_id
+			environment      = "kPhysical"
+			is_paused        = %[7]t
+			delete_snapshots = true
+			objects {
+				id             = ibm_backup_recovery_source_registration.source_registration.source_id
+				included_paths = ["/etc"]
+				excluded_paths = ["/etc/ssl"]
+			}
+		}
+	`, acc.BackupRecoveryInstanceID, acc.BackupRecoveryInstanceRegion, acc.BackupRecoveryTenantID, acc.BackupRecoveryConnectionID, acc.BackupRecoveryEndpoint, name, isPaused)
+}
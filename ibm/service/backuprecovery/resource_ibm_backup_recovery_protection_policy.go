@@ -0,0 +1,303 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package backuprecovery
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// backupRecoveryScheduleKeys are the keys of the schedule of a policy by unit
+var backupRecoveryScheduleKeys = map[string]string{
+	"Minutes": "minuteSchedule",
+	"Hours":   "hourSchedule",
+	"Days":    "daySchedule",
+	"Weeks":   "weekSchedule",
+}
+
+// backupRecoveryPolicy is a protection policy of the Backup and Recovery API
+type backupRecoveryPolicy struct {
+	ID           *string                     `json:"id,omitempty"`
+	Name         *string                     `json:"name"`
+	Description  *string                     `json:"description,omitempty"`
+	BackupPolicy *backupRecoveryBackupPolicy `json:"backupPolicy"`
+	IsUsable     *bool                       `json:"isUsable,omitempty"`
+}
+
+type backupRecoveryBackupPolicy struct {
+	Regular *backupRecoveryRegularBackup `json:"regular"`
+}
+
+type backupRecoveryRegularBackup struct {
+	Incremental *backupRecoveryIncrementalBackup `json:"incremental,omitempty"`
+	Retention   *backupRecoveryRetention         `json:"retention"`
+}
+
+type backupRecoveryIncrementalBackup struct {
+	// Schedule holds the unit and the schedule of the unit, for example hourSchedule
+	Schedule map[string]interface{} `json:"schedule"`
+}
+
+type backupRecoveryRetention struct {
+	Unit     *string `json:"unit"`
+	Duration *int64  `json:"duration"`
+}
+
+func ResourceIbmBackupRecoveryProtectionPolicy() *schema.Resource {
+	return addBackupRecoveryInstanceSchema(&schema.Resource{
+		CreateContext: resourceIbmBackupRecoveryProtectionPolicyCreate,
+		ReadContext:   resourceIbmBackupRecoveryProtectionPolicyRead,
+		UpdateContext: resourceIbmBackupRecoveryProtectionPolicyUpdate,
+		DeleteContext: resourceIbmBackupRecoveryProtectionPolicyDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the protection policy.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the protection policy.",
+			},
+			"backup_schedule": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The schedule of the incremental backups.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"unit": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{"Minutes", "Hours", "Days", "Weeks"}),
+							Description:  "The unit of the schedule: Minutes, Hours, Days or Weeks.",
+						},
+						"frequency": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     1,
+							Description: "The number of units between two backups. Not supported by the Weeks unit.",
+						},
+						"days_of_week": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "The days of the week of the backups of the Weeks unit, for example Sunday.",
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.ValidateAllowedStringValues([]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}),
+							},
+						},
+					},
+				},
+			},
+			"retention": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "The retention of the backups.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"unit": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{"Days", "Weeks", "Months", "Years"}),
+							Description:  "The unit of the retention: Days, Weeks, Months or Years.",
+						},
+						"duration": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "The number of units the backups are retained.",
+						},
+					},
+				},
+			},
+			"policy_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the protection policy.",
+			},
+			"is_usable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the protection policy can be used by protection groups.",
+			},
+		},
+	})
+}
+
+func resourceIbmBackupRecoveryProtectionPolicyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	backupRecoveryClient, err := getBackupRecoveryService(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policy := &backupRecoveryPolicy{}
+	response, err := backupRecoveryRequest(context, d, backupRecoveryClient, core.POST, "/data-protect/policies", nil, nil, resourceIbmBackupRecoveryProtectionPolicyPrototype(d), policy)
+	if err != nil {
+		log.Printf("[DEBUG] Error creating backup recovery protection policy %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error creating backup recovery protection policy %s\n%s", err, response))
+	}
+
+	d.SetId(backupRecoveryID(d, *policy.ID))
+
+	return resourceIbmBackupRecoveryProtectionPolicyRead(context, d, meta)
+}
+
+func resourceIbmBackupRecoveryProtectionPolicyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	policyID, err := parseBackupRecoveryID(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backupRecoveryClient, err := getBackupRecoveryService(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policy := &backupRecoveryPolicy{}
+	response, err := backupRecoveryRequest(context, d, backupRecoveryClient, core.GET, "/data-protect/policies/{id}", map[string]string{"id": policyID}, nil, nil, policy)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Error getting backup recovery protection policy %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error getting backup recovery protection policy %s\n%s", err, response))
+	}
+
+	if err = d.Set("policy_id", policyID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting policy_id: %s", err))
+	}
+	if err = d.Set("name", policy.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	if err = d.Set("description", policy.Description); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting description: %s", err))
+	}
+	if policy.BackupPolicy != nil && policy.BackupPolicy.Regular != nil {
+		regular := policy.BackupPolicy.Regular
+		if regular.Incremental != nil {
+			if err = d.Set("backup_schedule", backupRecoveryScheduleToList(regular.Incremental.Schedule)); err != nil {
+				return diag.FromErr(fmt.Errorf("Error setting backup_schedule: %s", err))
+			}
+		}
+		if regular.Retention != nil {
+			retention := []map[string]interface{}{{
+				"unit":     regular.Retention.Unit,
+				"duration": flex.IntValue(regular.Retention.Duration),
+			}}
+			if err = d.Set("retention", retention); err != nil {
+				return diag.FromErr(fmt.Errorf("Error setting retention: %s", err))
+			}
+		}
+	}
+	if err = d.Set("is_usable", policy.IsUsable); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting is_usable: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIbmBackupRecoveryProtectionPolicyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	backupRecoveryClient, err := getBackupRecoveryService(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("name", "description", "backup_schedule", "retention") {
+		pathParamsMap := map[string]string{"id": d.Get("policy_id").(string)}
+		response, err := backupRecoveryRequest(context, d, backupRecoveryClient, core.PUT, "/data-protect/policies/{id}", pathParamsMap, nil, resourceIbmBackupRecoveryProtectionPolicyPrototype(d), nil)
+		if err != nil {
+			log.Printf("[DEBUG] Error updating backup recovery protection policy %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("Error updating backup recovery protection policy %s\n%s", err, response))
+		}
+	}
+
+	return resourceIbmBackupRecoveryProtectionPolicyRead(context, d, meta)
+}
+
+func resourceIbmBackupRecoveryProtectionPolicyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	backupRecoveryClient, err := getBackupRecoveryService(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	pathParamsMap := map[string]string{"id": d.Get("policy_id").(string)}
+	response, err := backupRecoveryRequest(context, d, backupRecoveryClient, core.DELETE, "/data-protect/policies/{id}", pathParamsMap, nil, nil, nil)
+	if err != nil {
+		log.Printf("[DEBUG] Error deleting backup recovery protection policy %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error deleting backup recovery protection policy %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIbmBackupRecoveryProtectionPolicyPrototype(d *schema.ResourceData) *backupRecoveryPolicy {
+	policy := &backupRecoveryPolicy{
+		Name: core.StringPtr(d.Get("name").(string)),
+		BackupPolicy: &backupRecoveryBackupPolicy{
+			Regular: &backupRecoveryRegularBackup{
+				Incremental: &backupRecoveryIncrementalBackup{
+					Schedule: backupRecoveryMapToSchedule(d.Get("backup_schedule.0").(map[string]interface{})),
+				},
+				Retention: &backupRecoveryRetention{
+					Unit:     core.StringPtr(d.Get("retention.0.unit").(string)),
+					Duration: core.Int64Ptr(int64(d.Get("retention.0.duration").(int))),
+				},
+			},
+		},
+	}
+	if v, ok := d.GetOk("description"); ok {
+		policy.Description = core.StringPtr(v.(string))
+	}
+	return policy
+}
+
+func backupRecoveryMapToSchedule(modelMap map[string]interface{}) map[string]interface{} {
+	unit := modelMap["unit"].(string)
+	unitSchedule := map[string]interface{}{}
+	if unit == "Weeks" {
+		unitSchedule["dayOfWeek"] = flex.ExpandStringList(modelMap["days_of_week"].([]interface{}))
+	} else {
+		unitSchedule["frequency"] = modelMap["frequency"].(int)
+	}
+	return map[string]interface{}{
+		"unit":                           unit,
+		backupRecoveryScheduleKeys[unit]: unitSchedule,
+	}
+}
+
+func backupRecoveryScheduleToList(schedule map[string]interface{}) []map[string]interface{} {
+	unit, _ := schedule["unit"].(string)
+	if unit == "" {
+		return []map[string]interface{}{}
+	}
+	modelMap := map[string]interface{}{
+		"unit":         unit,
+		"frequency":    1,
+		"days_of_week": []interface{}{},
+	}
+	if unitSchedule, ok := schedule[backupRecoveryScheduleKeys[unit]].(map[string]interface{}); ok {
+		if frequency, ok := unitSchedule["frequency"].(float64); ok {
+			modelMap["frequency"] = int(frequency)
+		}
+		if days, ok := unitSchedule["dayOfWeek"].([]interface{}); ok {
+			modelMap["days_of_week"] = days
+		}
+	}
+	return []map[string]interface{}{modelMap}
+}
@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package backuprecovery_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmBackupRecoveryProtectionPolicyBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-policy-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckBackupRecovery(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmBackupRecoveryProtectionPolicyConfig(name, 4, 7),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_backup_recovery_protection_policy.protection_policy", "name", name),
+					resource.TestCheckResourceAttr("ibm_backup_recovery_protection_policy.protection_policy", "backup_schedule.0.unit", "Hours"),
+					resource.TestCheckResourceAttr("ibm_backup_recovery_protection_policy.protection_policy", "backup_schedule.0.frequency", "4"),
+					resource.TestCheckResourceAttr("ibm_backup_recovery_protection_policy.protection_policy", "retention.0.duration", "7"),
+					resource.TestCheckResourceAttrSet("ibm_backup_recovery_protection_policy.protection_policy", "policy_id"),
+				),
+			},
+			{
+				Config: testAccCheckIbmBackupRecoveryProtectionPolicyConfig(name, 12, 14),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_backup_recovery_protection_policy.protection_policy", "backup_schedule.0.frequency", "12"),
+					resource.TestCheckResourceAttr("ibm_backup_recovery_protection_policy.protection_policy", "retention.0.duration", "14"),
+				),
+			},
+			{
+				ResourceName:            "ibm_backup_recovery_protection_policy.protection_policy",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"endpoint_type"},
+			},
+		},
+	})
+}
+
+func testAccCheckIbmBackupRecoveryProtectionPolicyConfig(name string, frequency, retention int) string {
+	return fmt.Sprintf(`
+		resource "ibm_backup_recovery_protection_policy" "protection_policy" {
+			instance_id     = "%s"
+			region          = "%s"
+			x_ibm_tenant_id = "%s"
+			name            = "%s"
+			backup_schedule {
+				unit      = "Hours"
+				frequency = %d
+			}
+			retention {
+				unit     = "Days"
+				duration = %d
+			}
+		}
+	`, acc.BackupRecoveryInstanceID, acc.BackupRecoveryInstanceRegion, acc.BackupRecoveryTenantID, name, frequency, retention)
+}
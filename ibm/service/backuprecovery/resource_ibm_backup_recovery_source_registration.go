@@ -0,0 +1,343 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package backuprecovery
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+const (
+	backupRecoveryEnvironmentPhysical = "kPhysical"
+	backupRecoveryEnvironmentVMware   = "kVMware"
+)
+
+// backupRecoverySourceRegistration is the registration of a protection source of the Backup and Recovery API
+type backupRecoverySourceRegistration struct {
+	ID                    *int64                                `json:"id,omitempty"`
+	Name                  *string                               `json:"name,omitempty"`
+	Environment           *string                               `json:"environment"`
+	ConnectionID          *int64                                `json:"connectionId,omitempty"`
+	PhysicalParams        *backupRecoveryPhysicalSourceParams   `json:"physicalParams,omitempty"`
+	VmwareParams          *backupRecoveryVmwareSourceParams     `json:"vmwareParams,omitempty"`
+	SourceInfo            *backupRecoverySourceRegistrationInfo `json:"sourceInfo,omitempty"`
+	AuthenticationStatus  *string                               `json:"authenticationStatus,omitempty"`
+	RegistrationTimeMsecs *int64                                `json:"registrationTimeMsecs,omitempty"`
+}
+
+type backupRecoveryPhysicalSourceParams struct {
+	Endpoint     *string `json:"endpoint"`
+	HostType     *string `json:"hostType,omitempty"`
+	PhysicalType *string `json:"physicalType,omitempty"`
+}
+
+type backupRecoveryVmwareSourceParams struct {
+	Type          *string                            `json:"type"`
+	VCenterParams *backupRecoveryVCenterSourceParams `json:"vCenterParams,omitempty"`
+}
+
+type backupRecoveryVCenterSourceParams struct {
+	Endpoint *string `json:"endpoint"`
+	Username *string `json:"username"`
+	Password *string `json:"password,omitempty"`
+}
+
+type backupRecoverySourceRegistrationInfo struct {
+	ID   *int64  `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+}
+
+func ResourceIbmBackupRecoverySourceRegistration() *schema.Resource {
+	return addBackupRecoveryInstanceSchema(&schema.Resource{
+		CreateContext: resourceIbmBackupRecoverySourceRegistrationCreate,
+		ReadContext:   resourceIbmBackupRecoverySourceRegistrationRead,
+		UpdateContext: resourceIbmBackupRecoverySourceRegistrationUpdate,
+		DeleteContext: resourceIbmBackupRecoverySourceRegistrationDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"environment": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{backupRecoveryEnvironmentPhysical, backupRecoveryEnvironmentVMware}),
+				Description:  "The environment of the protection source: kPhysical for a host with the Backup and Recovery agent, or kVMware for a vCenter.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the registration. The default is the endpoint of the source.",
+			},
+			"connection_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The ID of the data source connection the source is reached through.",
+			},
+			"physical_params": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"physical_params", "vmware_params"},
+				Description:  "The parameters of a physical source.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The IP address or hostname of the host.",
+						},
+						"host_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "kLinux",
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{"kLinux", "kWindows", "kAix", "kSolaris"}),
+							Description:  "The operating system of the host: kLinux, kWindows, kAix or kSolaris.",
+						},
+						"physical_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "kHost",
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{"kHost", "kWindowsCluster", "kOracleRACCluster"}),
+							Description:  "The type of the physical source: kHost, kWindowsCluster or kOracleRACCluster.",
+						},
+					},
+				},
+			},
+			"vmware_params": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"physical_params", "vmware_params"},
+				Description:  "The parameters of a vCenter source.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The IP address or hostname of the vCenter.",
+						},
+						"username": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The user the vCenter is accessed with.",
+						},
+						"password": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "The password of the user.",
+						},
+					},
+				},
+			},
+			"registration_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the registration.",
+			},
+			"source_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the protection source, used as object ID by the protection groups.",
+			},
+			"authentication_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the authentication of the source.",
+			},
+			"registration_time_msecs": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The time the source was registered, in milliseconds since the epoch.",
+			},
+		},
+	})
+}
+
+func resourceIbmBackupRecoverySourceRegistrationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	backupRecoveryClient, err := getBackupRecoveryService(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	prototype, err := resourceIbmBackupRecoverySourceRegistrationPrototype(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	registration := &backupRecoverySourceRegistration{}
+	response, err := backupRecoveryRequest(context, d, backupRecoveryClient, core.POST, "/data-protect/sources/registrations", nil, nil, prototype, registration)
+	if err != nil {
+		log.Printf("[DEBUG] Error registering backup recovery source %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error registering backup recovery source %s\n%s", err, response))
+	}
+
+	d.SetId(backupRecoveryID(d, strconv.FormatInt(*registration.ID, 10)))
+
+	return resourceIbmBackupRecoverySourceRegistrationRead(context, d, meta)
+}
+
+func resourceIbmBackupRecoverySourceRegistrationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	registrationID, err := parseBackupRecoveryID(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backupRecoveryClient, err := getBackupRecoveryService(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	registration := &backupRecoverySourceRegistration{}
+	response, err := backupRecoveryRequest(context, d, backupRecoveryClient, core.GET, "/data-protect/sources/registrations/{id}", map[string]string{"id": registrationID}, nil, nil, registration)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Error getting backup recovery source registration %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error getting backup recovery source registration %s\n%s", err, response))
+	}
+
+	if err = d.Set("registration_id", registrationID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting registration_id: %s", err))
+	}
+	if err = d.Set("environment", registration.Environment); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting environment: %s", err))
+	}
+	if err = d.Set("name", registration.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	if registration.ConnectionID != nil {
+		if err = d.Set("connection_id", strconv.FormatInt(*registration.ConnectionID, 10)); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting connection_id: %s", err))
+		}
+	}
+	physicalParams := []map[string]interface{}{}
+	if registration.PhysicalParams != nil {
+		physicalParams = append(physicalParams, map[string]interface{}{
+			"endpoint":      registration.PhysicalParams.Endpoint,
+			"host_type":     registration.PhysicalParams.HostType,
+			"physical_type": registration.PhysicalParams.PhysicalType,
+		})
+	}
+	if err = d.Set("physical_params", physicalParams); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting physical_params: %s", err))
+	}
+	vmwareParams := []map[string]interface{}{}
+	if registration.VmwareParams != nil && registration.VmwareParams.VCenterParams != nil {
+		// The password is not returned by the API
+		vmwareParams = append(vmwareParams, map[string]interface{}{
+			"endpoint": registration.VmwareParams.VCenterParams.Endpoint,
+			"username": registration.VmwareParams.VCenterParams.Username,
+			"password": d.Get("vmware_params.0.password").(string),
+		})
+	}
+	if err = d.Set("vmware_params", vmwareParams); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting vmware_params: %s", err))
+	}
+	if registration.SourceInfo != nil {
+		if err = d.Set("source_id", flex.IntValue(registration.SourceInfo.ID)); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting source_id: %s", err))
+		}
+	}
+	if err = d.Set("authentication_status", registration.AuthenticationStatus); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting authentication_status: %s", err))
+	}
+	if err = d.Set("registration_time_msecs", flex.IntValue(registration.RegistrationTimeMsecs)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting registration_time_msecs: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIbmBackupRecoverySourceRegistrationUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	backupRecoveryClient, err := getBackupRecoveryService(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("name", "physical_params", "vmware_params") {
+		prototype, err := resourceIbmBackupRecoverySourceRegistrationPrototype(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		pathParamsMap := map[string]string{"id": d.Get("registration_id").(string)}
+		response, err := backupRecoveryRequest(context, d, backupRecoveryClient, core.PUT, "/data-protect/sources/registrations/{id}", pathParamsMap, nil, prototype, nil)
+		if err != nil {
+			log.Printf("[DEBUG] Error updating backup recovery source registration %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("Error updating backup recovery source registration %s\n%s", err, response))
+		}
+	}
+
+	return resourceIbmBackupRecoverySourceRegistrationRead(context, d, meta)
+}
+
+func resourceIbmBackupRecoverySourceRegistrationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	backupRecoveryClient, err := getBackupRecoveryService(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	pathParamsMap := map[string]string{"id": d.Get("registration_id").(string)}
+	response, err := backupRecoveryRequest(context, d, backupRecoveryClient, core.DELETE, "/data-protect/sources/registrations/{id}", pathParamsMap, nil, nil, nil)
+	if err != nil {
+		log.Printf("[DEBUG] Error deleting backup recovery source registration %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error deleting backup recovery source registration %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIbmBackupRecoverySourceRegistrationPrototype(d *schema.ResourceData) (*backupRecoverySourceRegistration, error) {
+	registration := &backupRecoverySourceRegistration{
+		Environment: core.StringPtr(d.Get("environment").(string)),
+	}
+	if v, ok := d.GetOk("name"); ok {
+		registration.Name = core.StringPtr(v.(string))
+	}
+	if v, ok := d.GetOk("connection_id"); ok {
+		connectionID, err := strconv.ParseInt(v.(string), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] The connection_id %s is not a number: %s", v, err)
+		}
+		registration.ConnectionID = &connectionID
+	}
+	if v, ok := d.GetOk("physical_params.0"); ok {
+		params := v.(map[string]interface{})
+		registration.PhysicalParams = &backupRecoveryPhysicalSourceParams{
+			Endpoint:     core.StringPtr(params["endpoint"].(string)),
+			HostType:     core.StringPtr(params["host_type"].(string)),
+			PhysicalType: core.StringPtr(params["physical_type"].(string)),
+		}
+	}
+	if v, ok := d.GetOk("vmware_params.0"); ok {
+		params := v.(map[string]interface{})
+		registration.VmwareParams = &backupRecoveryVmwareSourceParams{
+			Type: core.StringPtr("kVCenter"),
+			VCenterParams: &backupRecoveryVCenterSourceParams{
+				Endpoint: core.StringPtr(params["endpoint"].(string)),
+				Username: core.StringPtr(params["username"].(string)),
+				Password: core.StringPtr(params["password"].(string)),
+			},
+		}
+	}
+	if registration.PhysicalParams != nil && *registration.Environment != backupRecoveryEnvironmentPhysical ||
+		registration.VmwareParams != nil && *registration.Environment != backupRecoveryEnvironmentVMware {
+		return nil, fmt.Errorf("[ERROR] physical_params is only supported by the kPhysical environment and vmware_params by the kVMware environment")
+	}
+	return registration, nil
+}
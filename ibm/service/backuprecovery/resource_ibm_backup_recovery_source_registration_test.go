@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package backuprecovery_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmBackupRecoverySourceRegistrationBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-source-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf-source-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckBackupRecovery(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmBackupRecoverySourceRegistrationConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_backup_recovery_source_registration.source_registration", "name", name),
+					resource.TestCheckResourceAttr("ibm_backup_recovery_source_registration.source_registration", "environment", "kPhysical"),
+					resource.TestCheckResourceAttr("ibm_backup_recovery_source_registration.source_registration", "physical_params.0.endpoint", acc.BackupRecoveryEndpoint),
+					resource.TestCheckResourceAttrSet("ibm_backup_recovery_source_registration.source_registration", "registration_id"),
+					resource.TestCheckResourceAttrSet("ibm_backup_recovery_source_registration.source_registration", "source_id"),
+				),
+			},
+			{
+				Config: testAccCheckIbmBackupRecoverySourceRegistrationConfig(nameUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_backup_recovery_source_registration.source_registration", "name", nameUpdate),
+				),
+			},
+			{
+				ResourceName:            "ibm_backup_recovery_source_registration.source_registration",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"endpoint_type"},
+			},
+		},
+	})
+}
+
+func testAccCheckIbmBackupRecoverySourceRegistrationConfig(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_backup_recovery_source_registration" "source_registration" {
+			instance_id     = "%s"
+			region          = "%s"
+			x_ibm_tenant_id = "%s"
+			name            = "%s"
+			environment     = "kPhysical"
+			connection_id   = "%s"
+			physical_params {
+				endpoint  = "%s"
+				host_type = "kLinux"
+			}
+		}
+	`, acc.BackupRecoveryInstanceID, acc.BackupRecoveryInstanceRegion, acc.BackupRecoveryTenantID, name, acc.BackupRecoveryConnectionID, acc.BackupRecoveryEndpoint)
+}
@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package catalogmanagement
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
+)
+
+func ResourceIBMCmAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCmAccountCreate,
+		ReadContext:   resourceIBMCmAccountRead,
+		UpdateContext: resourceIBMCmAccountUpdate,
+		DeleteContext: resourceIBMCmAccountDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"hide_ibm_cloud_catalog": &schema.Schema{
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Hide the IBM Cloud catalog in the account, so that the users of the account only see the private catalogs.",
+			},
+			"account_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the account of the provider.",
+			},
+			"rev": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Cloudant revision.",
+			},
+		},
+	}
+}
+
+func resourceIBMCmAccountCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The catalog settings of an account always exist, they are updated from their current revision
+	account, response, err := catalogManagementClient.GetCatalogAccountWithContext(context, &catalogmanagementv1.GetCatalogAccountOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] GetCatalogAccountWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetCatalogAccountWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(*account.ID)
+	d.Set("rev", account.Rev)
+
+	if diags := resourceIBMCmAccountApply(context, d, meta, account); diags != nil {
+		return diags
+	}
+
+	return resourceIBMCmAccountRead(context, d, meta)
+}
+
+// resourceIBMCmAccountApply updates the catalog settings of the account, keeping the account filters as they are
+func resourceIBMCmAccountApply(context context.Context, d *schema.ResourceData, meta interface{}, account *catalogmanagementv1.Account) diag.Diagnostics {
+	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	updateCatalogAccountOptions := &catalogmanagementv1.UpdateCatalogAccountOptions{}
+	updateCatalogAccountOptions.SetID(d.Id())
+	updateCatalogAccountOptions.SetRev(d.Get("rev").(string))
+	updateCatalogAccountOptions.SetHideIBMCloudCatalog(d.Get("hide_ibm_cloud_catalog").(bool))
+	if account != nil {
+		updateCatalogAccountOptions.AccountFilters = account.AccountFilters
+	}
+
+	_, response, err := catalogManagementClient.UpdateCatalogAccountWithContext(context, updateCatalogAccountOptions)
+	if err != nil {
+		log.Printf("[DEBUG] UpdateCatalogAccountWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("UpdateCatalogAccountWithContext failed %s\n%s", err, response))
+	}
+
+	return nil
+}
+
+func resourceIBMCmAccountRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	account, response, err := catalogManagementClient.GetCatalogAccountWithContext(context, &catalogmanagementv1.GetCatalogAccountOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] GetCatalogAccountWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetCatalogAccountWithContext failed %s\n%s", err, response))
+	}
+	// The catalog settings are only read for the account of the provider
+	if account.ID != nil && *account.ID != d.Id() {
+		return diag.FromErr(fmt.Errorf("[ERROR] The catalog settings of the account %s can't be read, the account of the provider is %s", d.Id(), *account.ID))
+	}
+
+	if err = d.Set("account_id", account.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting account_id: %s", err))
+	}
+	if err = d.Set("rev", account.Rev); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting rev: %s", err))
+	}
+	hideIBMCloudCatalog := false
+	if account.HideIBMCloudCatalog != nil {
+		hideIBMCloudCatalog = *account.HideIBMCloudCatalog
+	}
+	if err = d.Set("hide_ibm_cloud_catalog", hideIBMCloudCatalog); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting hide_ibm_cloud_catalog: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMCmAccountUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("hide_ibm_cloud_catalog") {
+		catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		account, response, err := catalogManagementClient.GetCatalogAccountWithContext(context, &catalogmanagementv1.GetCatalogAccountOptions{})
+		if err != nil {
+			log.Printf("[DEBUG] GetCatalogAccountWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("GetCatalogAccountWithContext failed %s\n%s", err, response))
+		}
+		d.Set("rev", account.Rev)
+		if diags := resourceIBMCmAccountApply(context, d, meta, account); diags != nil {
+			return diags
+		}
+	}
+
+	return resourceIBMCmAccountRead(context, d, meta)
+}
+
+func resourceIBMCmAccountDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The catalog settings of an account can't be deleted, they are kept as they are
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package catalogmanagement_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMCmAccountBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCmAccountConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cm_account.cm_account", "hide_ibm_cloud_catalog", "true"),
+					resource.TestCheckResourceAttrSet("ibm_cm_account.cm_account", "account_id"),
+					resource.TestCheckResourceAttrSet("ibm_cm_account.cm_account", "rev"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMCmAccountConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cm_account.cm_account", "hide_ibm_cloud_catalog", "false"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_cm_account.cm_account",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCmAccountConfig(hideIBMCloudCatalog bool) string {
+	return fmt.Sprintf(`
+		resource "ibm_cm_account" "cm_account" {
+			hide_ibm_cloud_catalog = %t
+		}
+	`, hideIBMCloudCatalog)
+}
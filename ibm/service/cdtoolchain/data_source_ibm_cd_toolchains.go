@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/continuous-delivery-go-sdk/cdtoolchainv2"
 )
 
@@ -123,7 +124,7 @@ func dataSourceIBMCdToolchainsRead(context context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	allItems, err := pager.GetAll()
+	allItems, err := flex.PaginateAll[cdtoolchainv2.ToolchainModel](context, pager)
 	if err != nil {
 		log.Printf("[DEBUG] ToolchainsPager.GetAll() failed %s", err)
 		return diag.FromErr(fmt.Errorf("ToolchainsPager.GetAll() failed %s", err))
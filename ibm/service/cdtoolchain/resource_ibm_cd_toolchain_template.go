@@ -0,0 +1,420 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cdtoolchain
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/continuous-delivery-go-sdk/cdtoolchainv2"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// cdToolchainSetupURL is the endpoint that creates toolchains from Open Toolchain templates
+const cdToolchainSetupURL = "https://cloud.ibm.com/devops/setup/deploy"
+
+var cdToolchainIDRegexp = regexp.MustCompile(`/toolchains/([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})`)
+
+func ResourceIBMCdToolchainTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCdToolchainTemplateCreate,
+		ReadContext:   resourceIBMCdToolchainTemplateRead,
+		UpdateContext: resourceIBMCdToolchainTemplateUpdate,
+		DeleteContext: resourceIBMCdToolchainTemplateDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"template_repository": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "URL of the Git repository of the Open Toolchain template, for example https://github.com/open-toolchain/simple-toolchain.",
+			},
+			"template_branch": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Branch of the template repository. The default branch of the repository is used when not set.",
+			},
+			"template_parameters": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Parameters of the template, by the name of the form field of the template.",
+			},
+			"resource_group_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_cd_toolchain", "resource_group_id"),
+				Description:  "Resource group where the toolchain is created.",
+			},
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Region where the toolchain is created. The region of the provider is used when not set.",
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_cd_toolchain", "name"),
+				Description:  "Toolchain name. The name of the template is used when not set.",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Describes the toolchain.",
+			},
+			"account_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Account ID where toolchain can be found.",
+			},
+			"location": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Toolchain region.",
+			},
+			"crn": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Toolchain CRN.",
+			},
+			"href": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URI that can be used to retrieve toolchain.",
+			},
+			"ui_href": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URL of a user-facing user interface for this toolchain.",
+			},
+			"created_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Toolchain creation timestamp.",
+			},
+			"created_by": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identity that created the toolchain.",
+			},
+			"tools": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Tools that the template created in the toolchain.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tool_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Tool ID.",
+						},
+						"tool_type_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique name of the provisioned tool, for example pipeline or githubconsolidated.",
+						},
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the tool.",
+						},
+						"crn": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Tool CRN.",
+						},
+						"href": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "URI representing the tool.",
+						},
+						"state": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Current configuration state of the tool.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMCdToolchainTemplateCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cdToolchainClient, err := meta.(conns.ClientSession).CdToolchainV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region := d.Get("region").(string)
+	if region == "" {
+		bluemixSession, err := meta.(conns.ClientSession).BluemixSession()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		region = bluemixSession.Config.Region
+	}
+
+	form := url.Values{}
+	form.Set("repository", d.Get("template_repository").(string))
+	if branch, ok := d.GetOk("template_branch"); ok {
+		form.Set("branch", branch.(string))
+	}
+	form.Set("env_id", fmt.Sprintf("ibm:yp:%s", region))
+	form.Set("resourceGroupId", d.Get("resource_group_id").(string))
+	form.Set("autocreate", "true")
+	for k, v := range d.Get("template_parameters").(map[string]interface{}) {
+		form.Set(k, v.(string))
+	}
+
+	toolchainID, err := createCdToolchainFromTemplate(context, cdToolchainClient, form)
+	if err != nil {
+		log.Printf("[DEBUG] Creating the toolchain from template %s failed %s", d.Get("template_repository"), err)
+		return diag.FromErr(fmt.Errorf("Creating the toolchain from template %s failed %s", d.Get("template_repository"), err))
+	}
+
+	d.SetId(toolchainID)
+	d.Set("region", region)
+
+	if err = waitForCdToolchainTemplateTools(context, cdToolchainClient, toolchainID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if name, ok := d.GetOk("name"); ok {
+		if diags := updateCdToolchainTemplateName(context, cdToolchainClient, d.Id(), name.(string)); diags != nil {
+			return diags
+		}
+	}
+
+	return resourceIBMCdToolchainTemplateRead(context, d, meta)
+}
+
+// createCdToolchainFromTemplate posts the form of a template to the toolchain setup endpoint and returns the ID of
+// the toolchain, which is only found in the location of the redirect of the response.
+func createCdToolchainFromTemplate(context context.Context, cdToolchainClient *cdtoolchainv2.CdToolchainV2, form url.Values) (string, error) {
+	setupURL := conns.EnvFallBack([]string{"IBMCLOUD_TOOLCHAIN_SETUP_ENDPOINT"}, cdToolchainSetupURL)
+	req, err := http.NewRequestWithContext(context, http.MethodPost, setupURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if err = cdToolchainClient.Service.Options.Authenticator.Authenticate(req); err != nil {
+		return "", err
+	}
+
+	client := &http.Client{
+		Timeout: 5 * time.Minute,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	response, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	location := response.Header.Get("Location")
+	if response.StatusCode >= 400 || location == "" {
+		return "", fmt.Errorf("unexpected response %s from %s", response.Status, setupURL)
+	}
+	match := cdToolchainIDRegexp.FindStringSubmatch(location)
+	if match == nil {
+		return "", fmt.Errorf("no toolchain ID in the location %s of the response", location)
+	}
+	return match[1], nil
+}
+
+// waitForCdToolchainTemplateTools waits until the template has configured all the tools of the toolchain
+func waitForCdToolchainTemplateTools(context context.Context, cdToolchainClient *cdtoolchainv2.CdToolchainV2, toolchainID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{cdtoolchainv2.ToolModelStateConfiguringConst},
+		Target:  []string{cdtoolchainv2.ToolModelStateConfiguredConst},
+		Refresh: func() (interface{}, string, error) {
+			tools, err := listCdToolchainTemplateTools(context, cdToolchainClient, toolchainID)
+			if err != nil {
+				return nil, "", err
+			}
+			for _, tool := range tools {
+				if tool.State != nil && *tool.State == cdtoolchainv2.ToolModelStateConfiguringConst {
+					return tools, cdtoolchainv2.ToolModelStateConfiguringConst, nil
+				}
+			}
+			return tools, cdtoolchainv2.ToolModelStateConfiguredConst, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(context); err != nil {
+		return fmt.Errorf("Error waiting for the tools of toolchain (%s) to be configured: %s", toolchainID, err)
+	}
+	return nil
+}
+
+func listCdToolchainTemplateTools(context context.Context, cdToolchainClient *cdtoolchainv2.CdToolchainV2, toolchainID string) ([]cdtoolchainv2.ToolModel, error) {
+	pager, err := cdToolchainClient.NewToolsPager(&cdtoolchainv2.ListToolsOptions{
+		ToolchainID: core.StringPtr(toolchainID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	tools, err := pager.GetAllWithContext(context)
+	if err != nil {
+		log.Printf("[DEBUG] ListToolsWithContext failed %s", err)
+		return nil, fmt.Errorf("ListToolsWithContext failed %s", err)
+	}
+	return tools, nil
+}
+
+func updateCdToolchainTemplateName(context context.Context, cdToolchainClient *cdtoolchainv2.CdToolchainV2, toolchainID, name string) diag.Diagnostics {
+	updateToolchainOptions := &cdtoolchainv2.UpdateToolchainOptions{}
+	updateToolchainOptions.SetToolchainID(toolchainID)
+	patchVals := &cdtoolchainv2.ToolchainPrototypePatch{Name: &name}
+	updateToolchainOptions.ToolchainPrototypePatch, _ = patchVals.AsPatch()
+
+	_, response, err := cdToolchainClient.UpdateToolchainWithContext(context, updateToolchainOptions)
+	if err != nil {
+		log.Printf("[DEBUG] UpdateToolchainWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("UpdateToolchainWithContext failed %s\n%s", err, response))
+	}
+	return nil
+}
+
+func resourceIBMCdToolchainTemplateRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cdToolchainClient, err := meta.(conns.ClientSession).CdToolchainV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getToolchainByIDOptions := &cdtoolchainv2.GetToolchainByIDOptions{}
+
+	getToolchainByIDOptions.SetToolchainID(d.Id())
+
+	toolchain, response, err := cdToolchainClient.GetToolchainByIDWithContext(context, getToolchainByIDOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetToolchainByIDWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetToolchainByIDWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("name", toolchain.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	if !core.IsNil(toolchain.Description) {
+		if err = d.Set("description", toolchain.Description); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting description: %s", err))
+		}
+	}
+	if err = d.Set("resource_group_id", toolchain.ResourceGroupID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting resource_group_id: %s", err))
+	}
+	if err = d.Set("account_id", toolchain.AccountID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting account_id: %s", err))
+	}
+	if err = d.Set("location", toolchain.Location); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting location: %s", err))
+	}
+	if err = d.Set("crn", toolchain.CRN); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting crn: %s", err))
+	}
+	if err = d.Set("href", toolchain.Href); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting href: %s", err))
+	}
+	if err = d.Set("ui_href", toolchain.UIHref); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting ui_href: %s", err))
+	}
+	if err = d.Set("created_at", flex.DateTimeToString(toolchain.CreatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_at: %s", err))
+	}
+	if err = d.Set("created_by", toolchain.CreatedBy); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_by: %s", err))
+	}
+
+	tools, err := listCdToolchainTemplateTools(context, cdToolchainClient, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	toolList := make([]map[string]interface{}, 0, len(tools))
+	for _, tool := range tools {
+		toolList = append(toolList, map[string]interface{}{
+			"tool_id":      tool.ID,
+			"tool_type_id": tool.ToolTypeID,
+			"name":         tool.Name,
+			"crn":          tool.CRN,
+			"href":         tool.Href,
+			"state":        tool.State,
+		})
+	}
+	if err = d.Set("tools", toolList); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting tools: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMCdToolchainTemplateUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cdToolchainClient, err := meta.(conns.ClientSession).CdToolchainV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		if diags := updateCdToolchainTemplateName(context, cdToolchainClient, d.Id(), d.Get("name").(string)); diags != nil {
+			return diags
+		}
+	}
+
+	return resourceIBMCdToolchainTemplateRead(context, d, meta)
+}
+
+func resourceIBMCdToolchainTemplateDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cdToolchainClient, err := meta.(conns.ClientSession).CdToolchainV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteToolchainOptions := &cdtoolchainv2.DeleteToolchainOptions{}
+
+	deleteToolchainOptions.SetToolchainID(d.Id())
+
+	// Deleting the toolchain deletes the tools that the template created
+	response, err := cdToolchainClient.DeleteToolchainWithContext(context, deleteToolchainOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DeleteToolchainWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteToolchainWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
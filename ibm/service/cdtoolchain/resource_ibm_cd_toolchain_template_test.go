@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cdtoolchain_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/continuous-delivery-go-sdk/cdtoolchainv2"
+)
+
+func TestAccIBMCdToolchainTemplateBasic(t *testing.T) {
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	rgName := acc.CdResourceGroupName
+	nameUpdate := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCdToolchainTemplateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCdToolchainTemplateConfigBasic(name, rgName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cd_toolchain_template.cd_toolchain_template", "name", name),
+					resource.TestCheckResourceAttrSet("ibm_cd_toolchain_template.cd_toolchain_template", "crn"),
+					resource.TestCheckResourceAttrSet("ibm_cd_toolchain_template.cd_toolchain_template", "tools.#"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMCdToolchainTemplateConfigBasic(nameUpdate, rgName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cd_toolchain_template.cd_toolchain_template", "name", nameUpdate),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCdToolchainTemplateConfigBasic(name string, rgName string) string {
+	return fmt.Sprintf(`
+		data "ibm_resource_group" "resource_group" {
+			name = "%s"
+		}
+
+		resource "ibm_cd_toolchain_template" "cd_toolchain_template" {
+			template_repository = "https://github.com/open-toolchain/empty-toolchain"
+			resource_group_id   = data.ibm_resource_group.resource_group.id
+			name                = "%s"
+		}
+	`, rgName, name)
+}
+
+func testAccCheckIBMCdToolchainTemplateDestroy(s *terraform.State) error {
+	cdToolchainClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).CdToolchainV2()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_cd_toolchain_template" {
+			continue
+		}
+
+		getToolchainByIDOptions := &cdtoolchainv2.GetToolchainByIDOptions{}
+
+		getToolchainByIDOptions.SetToolchainID(rs.Primary.ID)
+
+		_, response, err := cdToolchainClient.GetToolchainByID(getToolchainByIDOptions)
+
+		if err == nil {
+			return fmt.Errorf("cd_toolchain_template still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("Error checking for cd_toolchain_template (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
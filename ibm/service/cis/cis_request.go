@@ -0,0 +1,44 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"encoding/json"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// cisZoneRequest sends a request to a zone API of the CIS instance, for the zone APIs which are not part of
+// the networking SDK, and returns the result of the response. The service is the base service of any of the
+// CIS clients, the path is relative to /v1/{crn}/zones/{zone_identifier}.
+func cisZoneRequest(service *core.BaseService, crn, zoneID, method, path string, body interface{}) (json.RawMessage, *core.DetailedResponse, error) {
+	pathParamsMap := map[string]string{
+		"crn":             crn,
+		"zone_identifier": zoneID,
+	}
+	builder := core.NewRequestBuilder(method)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(service.Options.URL, `/v1/{crn}/zones/{zone_identifier}`+path, pathParamsMap)
+	if err != nil {
+		return nil, nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		if _, err := builder.SetBodyContentJSON(body); err != nil {
+			return nil, nil, err
+		}
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rawResponse map[string]json.RawMessage
+	response, err := service.Request(request, &rawResponse)
+	if err != nil {
+		return nil, response, err
+	}
+	return rawResponse["result"], response, nil
+}
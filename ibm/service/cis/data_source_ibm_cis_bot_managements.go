@@ -7,6 +7,7 @@ import (
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -63,6 +64,31 @@ func DataSourceIBMCISBotManagement() *schema.Resource {
 				Computed:    true,
 				Description: "Use Latest Model",
 			},
+			cisBotManagementSBFMDefinitelyAutomated: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Super bot fight mode action on the definitely automated traffic",
+			},
+			cisBotManagementSBFMLikelyAutomated: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Super bot fight mode action on the likely automated traffic",
+			},
+			cisBotManagementSBFMVerifiedBots: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Super bot fight mode action on the verified bots",
+			},
+			cisBotManagementSBFMStaticResourceProtection: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the super bot fight mode actions apply to the static resources",
+			},
+			cisBotManagementOptimizeWordpress: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the super bot fight mode is optimized for the WordPress sites",
+			},
 		},
 	}
 }
@@ -113,5 +139,23 @@ func dataSourceIBMCISBotManagementRead(d *schema.ResourceData, meta interface{})
 	d.Set(cisBotManagementAuthIdLogging, res.AuthIdLogging)
 	d.Set(cisBotManagementUseLatestModel, res.UseLatestModel)
 
+	settings, resp, err := cisBotManagementRequest(cisClient, core.GET, nil)
+	if err != nil {
+		log.Printf("dataSourceIBMCISBotManagementRead - GetBotManagement Failed %s\n", resp)
+		return err
+	}
+	for _, key := range []string{
+		cisBotManagementSBFMDefinitelyAutomated,
+		cisBotManagementSBFMLikelyAutomated,
+		cisBotManagementSBFMVerifiedBots,
+		cisBotManagementSBFMStaticResourceProtection,
+		cisBotManagementOptimizeWordpress,
+	} {
+		if value, ok := settings[key]; ok {
+			d.Set(key, value)
+		}
+	}
+	d.SetId(flex.ConvertCisToTfTwoVar(zoneName, crn))
+
 	return nil
 }
@@ -192,17 +192,14 @@ func dataSourceIBMCISDNSRecordsRead(d *schema.ResourceData, meta interface{}) er
 		d.Set(cisDNSRecordsExportFile, file)
 	}
 
-	opt := sess.NewListAllDnsRecordsOptions()
-	opt.SetPage(1)
-	opt.SetPerPage(1000)
-	result, response, err := sess.ListAllDnsRecords(opt)
+	result, err := listAllCISDNSRecords(sess)
 	if err != nil {
-		log.Printf("Error reading dns records: %s", response)
+		log.Printf("Error reading dns records: %s", err)
 		return err
 	}
 
 	records = make([]map[string]interface{}, 0)
-	for _, instance := range result.Result {
+	for _, instance := range result {
 		record := map[string]interface{}{}
 		record["id"] = flex.ConvertCisToTfThreeVar(*instance.ID, zoneID, crn)
 		record[cisDNSRecordID] = *instance.ID
@@ -4,17 +4,30 @@
 package cis
 
 import (
+	"encoding/json"
 	"fmt"
+	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/botmanagementv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+const (
+	ibmCISBotManagement                          = "ibm_cis_bot_management"
+	cisBotManagementSBFMDefinitelyAutomated      = "sbfm_definitely_automated"
+	cisBotManagementSBFMLikelyAutomated          = "sbfm_likely_automated"
+	cisBotManagementSBFMVerifiedBots             = "sbfm_verified_bots"
+	cisBotManagementSBFMStaticResourceProtection = "sbfm_static_resource_protection"
+	cisBotManagementOptimizeWordpress            = "optimize_wordpress"
+)
+
 func ResourceIBMCISBotManagement() *schema.Resource {
 	return &schema.Resource{
-		Read:     dataSourceIBMCISBotAnalyticsRead,
+		Read:     ResourceIBMCISBotManagementRead,
 		Create:   ResourceIBMCISBotManagementCreate,
 		Update:   ResourceIBMCISBotManagementUpdate,
 		Delete:   ResourceIBMCISBotManagementDelete,
@@ -24,7 +37,7 @@ func ResourceIBMCISBotManagement() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "CIS instance crn",
 				Required:    true,
-				ValidateFunc: validate.InvokeValidator("ibm_cis_bot_management",
+				ValidateFunc: validate.InvokeValidator(ibmCISBotManagement,
 					"cis_id"),
 			},
 			cisDomainID: {
@@ -34,87 +47,176 @@ func ResourceIBMCISBotManagement() *schema.Resource {
 				DiffSuppressFunc: suppressDomainIDDiff,
 			},
 			cisBotManagementFightMode: {
-				Type:        schema.TypeString,
+				Type:        schema.TypeBool,
+				Optional:    true,
 				Computed:    true,
 				Description: "Fight Mode",
 			},
 			cisBotManagementSessionScore: {
-				Type:        schema.TypeString,
+				Type:        schema.TypeBool,
+				Optional:    true,
 				Computed:    true,
 				Description: "Session Score",
 			},
 			cisBotManagementEnableJs: {
-				Type:        schema.TypeString,
+				Type:        schema.TypeBool,
+				Optional:    true,
 				Computed:    true,
-				Description: "Enable JS",
+				Description: "Use lightweight, invisible JavaScript detections",
 			},
 			cisBotManagementAuthIdLogging: {
-				Type:        schema.TypeString,
+				Type:        schema.TypeBool,
+				Optional:    true,
 				Computed:    true,
 				Description: "Auth ID Logging",
 			},
 			cisBotManagementUseLatestModel: {
-				Type:        schema.TypeString,
+				Type:        schema.TypeBool,
+				Optional:    true,
 				Computed:    true,
 				Description: "Use Latest Model",
 			},
+			cisBotManagementSBFMDefinitelyAutomated: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator(ibmCISBotManagement, cisBotManagementSBFMDefinitelyAutomated),
+				Description:  "Super bot fight mode action on the definitely automated traffic: allow, block or managed_challenge",
+			},
+			cisBotManagementSBFMLikelyAutomated: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator(ibmCISBotManagement, cisBotManagementSBFMLikelyAutomated),
+				Description:  "Super bot fight mode action on the likely automated traffic: allow, block or managed_challenge",
+			},
+			cisBotManagementSBFMVerifiedBots: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator(ibmCISBotManagement, cisBotManagementSBFMVerifiedBots),
+				Description:  "Super bot fight mode action on the verified bots, such as search engine crawlers: allow or block",
+			},
+			cisBotManagementSBFMStaticResourceProtection: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Apply the super bot fight mode actions to the static resources",
+			},
+			cisBotManagementOptimizeWordpress: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Optimize the super bot fight mode for the WordPress sites",
+			},
 		},
 	}
 }
 
 func ResourceIBMCISBotManagementCreate(d *schema.ResourceData, meta interface{}) error {
-
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
+	d.SetId(flex.ConvertCisToTfTwoVar(zoneID, crn))
 	return ResourceIBMCISBotManagementUpdate(d, meta)
 }
 
 func ResourceIBMCISBotManagementUpdate(d *schema.ResourceData, meta interface{}) error {
-	sess, err := meta.(conns.ClientSession).CisBotManagementSession()
-	if err != nil {
-		return fmt.Errorf("[ERROR] Error while Getting IAM Access Token using BluemixSession %s", err)
-	}
-	crn := d.Get(cisID).(string)
-	sess.Crn = core.StringPtr(crn)
-
 	cisClient, err := meta.(conns.ClientSession).CisBotManagementSession()
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error while getting the CisBotManagementSession %s", err)
 	}
+	zoneID, crn, _ := flex.ConvertTftoCisTwoVar(d.Id())
+	cisClient.Crn = core.StringPtr(crn)
+	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 
-	if d.HasChange(cisBotManagementFightMode) ||
-		d.HasChange(cisBotManagementSessionScore) ||
-		d.HasChange(cisBotManagementEnableJs) ||
-		d.HasChange(cisBotManagementAuthIdLogging) ||
-		d.HasChange(cisBotManagementUseLatestModel) {
-
-		opt := cisClient.NewUpdateBotManagementOptions()
-
-		if f, ok := d.GetOk(cisBotManagementFightMode); ok {
-			fightmode := f.(bool)
-			opt.SetFightMode(fightmode)
+	settings := map[string]interface{}{}
+	for _, key := range []string{
+		cisBotManagementFightMode,
+		cisBotManagementSessionScore,
+		cisBotManagementEnableJs,
+		cisBotManagementAuthIdLogging,
+		cisBotManagementUseLatestModel,
+		cisBotManagementSBFMStaticResourceProtection,
+		cisBotManagementOptimizeWordpress,
+	} {
+		if v, ok := d.GetOkExists(key); ok && d.HasChange(key) {
+			settings[key] = v.(bool)
 		}
-		if s, ok := d.GetOk(cisBotManagementSessionScore); ok {
-			sessionscore := s.(bool)
-			opt.SetSessionScore(sessionscore)
-		}
-		if e, ok := d.GetOk(cisBotManagementEnableJs); ok {
-			enablejs := e.(bool)
-			opt.SetEnableJs(enablejs)
-		}
-		if a, ok := d.GetOk(cisBotManagementAuthIdLogging); ok {
-			authidlogging := a.(bool)
-			opt.SetAuthIdLogging(authidlogging)
-		}
-		if sl, ok := d.GetOk(cisBotManagementUseLatestModel); ok {
-			uselatestmodel := sl.(bool)
-			opt.SetUseLatestModel(uselatestmodel)
+	}
+	for _, key := range []string{
+		cisBotManagementSBFMDefinitelyAutomated,
+		cisBotManagementSBFMLikelyAutomated,
+		cisBotManagementSBFMVerifiedBots,
+	} {
+		if v, ok := d.GetOk(key); ok && d.HasChange(key) {
+			settings[key] = v.(string)
 		}
+	}
 
-		_, resp, err := cisClient.UpdateBotManagement(opt)
+	if len(settings) > 0 {
+		_, resp, err := cisBotManagementRequest(cisClient, core.PUT, settings)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating BotManagement with error: %s %s", err, resp)
 		}
 	}
-	return dataSourceIBMCISBotManagementRead(d, meta)
+	return ResourceIBMCISBotManagementRead(d, meta)
+}
+
+func ResourceIBMCISBotManagementRead(d *schema.ResourceData, meta interface{}) error {
+	cisClient, err := meta.(conns.ClientSession).CisBotManagementSession()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error while getting the CisBotManagementSession %s", err)
+	}
+	zoneID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return err
+	}
+	cisClient.Crn = core.StringPtr(crn)
+	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
+
+	settings, resp, err := cisBotManagementRequest(cisClient, core.GET, nil)
+	if err != nil {
+		log.Printf("ResourceIBMCISBotManagementRead - GetBotManagement Failed %s\n", resp)
+		return err
+	}
+	d.Set(cisID, crn)
+	d.Set(cisDomainID, zoneID)
+	for key, value := range settings {
+		switch key {
+		case cisBotManagementFightMode,
+			cisBotManagementSessionScore,
+			cisBotManagementEnableJs,
+			cisBotManagementAuthIdLogging,
+			cisBotManagementUseLatestModel,
+			cisBotManagementSBFMDefinitelyAutomated,
+			cisBotManagementSBFMLikelyAutomated,
+			cisBotManagementSBFMVerifiedBots,
+			cisBotManagementSBFMStaticResourceProtection,
+			cisBotManagementOptimizeWordpress:
+			d.Set(key, value)
+		}
+	}
+	return nil
+}
+
+// cisBotManagementRequest gets or updates the bot management settings of the zone, including the super
+// bot fight mode settings which are not part of the bot management models of the SDK.
+func cisBotManagementRequest(cisClient *botmanagementv1.BotManagementV1, method string, settings map[string]interface{}) (map[string]interface{}, *core.DetailedResponse, error) {
+	var body interface{}
+	if settings != nil {
+		body = settings
+	}
+	rawResult, response, err := cisZoneRequest(cisClient.Service, *cisClient.Crn, *cisClient.ZoneIdentifier, method, "/bot_management", body)
+	if err != nil {
+		return nil, response, err
+	}
+	result := map[string]interface{}{}
+	if rawResult != nil {
+		if err := json.Unmarshal(rawResult, &result); err != nil {
+			return nil, response, err
+		}
+	}
+	return result, response, nil
 }
 
 func ResourceIBMCISBotManagementValidator() *validate.ResourceValidator {
@@ -127,11 +229,34 @@ func ResourceIBMCISBotManagementValidator() *validate.ResourceValidator {
 			CloudDataType:              "resource_instance",
 			CloudDataRange:             []string{"service:internet-svcs"},
 			Required:                   true})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 cisBotManagementSBFMDefinitelyAutomated,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "allow, block, managed_challenge"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 cisBotManagementSBFMLikelyAutomated,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "allow, block, managed_challenge"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 cisBotManagementSBFMVerifiedBots,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "allow, block"})
 
-	ibmCISBotManagementResourceValidator := validate.ResourceValidator{ResourceName: "ibm_cis_bot_management", Schema: validateSchema}
+	ibmCISBotManagementResourceValidator := validate.ResourceValidator{ResourceName: ibmCISBotManagement, Schema: validateSchema}
 	return &ibmCISBotManagementResourceValidator
 }
 
 func ResourceIBMCISBotManagementDelete(d *schema.ResourceData, meta interface{}) error {
+	// Nothing to delete on CIS resource
+	d.SetId("")
 	return nil
 }
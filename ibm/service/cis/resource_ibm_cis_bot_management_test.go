@@ -33,6 +33,37 @@ func TestAccIBMCisBotManagement_Basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMCisBotManagement_SuperBotFightMode(t *testing.T) {
+	name := "ibm_cis_bot_management." + "test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCisBotManagementSuperBotFightMode("test", acc.CisDomainStatic),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "sbfm_definitely_automated", "block"),
+					resource.TestCheckResourceAttr(name, "sbfm_likely_automated", "managed_challenge"),
+					resource.TestCheckResourceAttr(name, "sbfm_verified_bots", "allow"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCisBotManagementSuperBotFightMode(id string, CisDomainStatic string) string {
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
+	resource "ibm_cis_bot_management" "%[1]s" {
+		cis_id                    = data.ibm_cis.cis.id
+		domain_id                 = data.ibm_cis_domain.cis_domain.domain_id
+		sbfm_definitely_automated = "block"
+		sbfm_likely_automated     = "managed_challenge"
+		sbfm_verified_bots        = "allow"
+	  }
+`, id)
+}
+
 func testAccCheckCisBotManagementBasic1(id string, CisDomainStatic string) string {
 	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
 	resource "ibm_cis_bot_management" "%[1]s" {
@@ -4,6 +4,8 @@
 package cis
 
 import (
+	"encoding/json"
+	"fmt"
 	"log"
 	"time"
 
@@ -11,6 +13,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/sslcertificateapiv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -21,11 +24,24 @@ const (
 	cisCertificateOrderHosts         = "hosts"
 	cisCertificateOrderType          = "type"
 	cisCertificateOrderTypeDedicated = "dedicated"
+	cisCertificateOrderTypeAdvanced  = "advanced"
+	cisCertificateOrderCA            = "certificate_authority"
+	cisCertificateOrderValidityDays  = "validity_days"
+	cisCertificateOrderValidation    = "validation_method"
 	cisCertificateOrderStatus        = "status"
+	cisCertificateOrderActive        = "active"
 	cisCertificateOrderDeleted       = "deleted"
 	cisCertificateOrderDeletePending = "deleting"
 )
 
+// cisCertificateOrderPendingStatuses are the statuses of the certificates being validated, issued or deployed
+var cisCertificateOrderPendingStatuses = []string{
+	"initializing",
+	"pending_validation",
+	"pending_issuance",
+	"pending_deployment",
+}
+
 func ResourceIBMCISCertificateOrder() *schema.Resource {
 	return &schema.Resource{
 		Create:   ResourceIBMCISCertificateOrderCreate,
@@ -34,6 +50,12 @@ func ResourceIBMCISCertificateOrder() *schema.Resource {
 		Delete:   ResourceIBMCISCertificateOrderDelete,
 		Exists:   ResourceIBMCISCertificateOrderExist,
 		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			cisID: {
 				Type:        schema.TypeString,
@@ -57,6 +79,7 @@ func ResourceIBMCISCertificateOrder() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "certificate type",
 				Optional:    true,
+				ForceNew:    true,
 				Default:     cisCertificateOrderTypeDedicated,
 				ValidateFunc: validate.InvokeValidator(ibmCISCertificateOrder,
 					cisCertificateOrderType),
@@ -65,8 +88,36 @@ func ResourceIBMCISCertificateOrder() *schema.Resource {
 				Type:        schema.TypeList,
 				Description: "Hosts which certificate need to be ordered",
 				Required:    true,
+				ForceNew:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			cisCertificateOrderCA: {
+				Type:        schema.TypeString,
+				Description: "Certificate authority of the advanced certificate pack",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				ValidateFunc: validate.InvokeValidator(ibmCISCertificateOrder,
+					cisCertificateOrderCA),
+			},
+			cisCertificateOrderValidityDays: {
+				Type:        schema.TypeInt,
+				Description: "Validity in days of the advanced certificate pack",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				ValidateFunc: validate.InvokeValidator(ibmCISCertificateOrder,
+					cisCertificateOrderValidityDays),
+			},
+			cisCertificateOrderValidation: {
+				Type:        schema.TypeString,
+				Description: "Validation method of the advanced certificate pack",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				ValidateFunc: validate.InvokeValidator(ibmCISCertificateOrder,
+					cisCertificateOrderValidation),
+			},
 			cisCertificateOrderStatus: {
 				Type:        schema.TypeString,
 				Description: "certificate status",
@@ -92,7 +143,28 @@ func ResourceIBMCISCertificateOrderValidator() *validate.ResourceValidator {
 			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
 			Type:                       validate.TypeString,
 			Required:                   true,
-			AllowedValues:              cisCertificateOrderTypeDedicated})
+			AllowedValues:              "dedicated, advanced"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 cisCertificateOrderCA,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "google, lets_encrypt, ssl_com"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 cisCertificateOrderValidityDays,
+			ValidateFunctionIdentifier: validate.ValidateAllowedIntValue,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			AllowedValues:              "14, 30, 90, 365"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 cisCertificateOrderValidation,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "email, http, txt"})
 
 	cisCertificateOrderValidator := validate.ResourceValidator{
 		ResourceName: ibmCISCertificateOrder,
@@ -113,17 +185,48 @@ func ResourceIBMCISCertificateOrderCreate(d *schema.ResourceData, meta interface
 
 	hosts := d.Get(cisCertificateOrderHosts)
 	hostsList := flex.ExpandStringList(hosts.([]interface{}))
-	opt := cisClient.NewOrderCertificateOptions()
-	opt.SetType(certType)
-	opt.SetHosts(hostsList)
 
-	result, resp, err := cisClient.OrderCertificate(opt)
+	if certType == cisCertificateOrderTypeAdvanced {
+		order := map[string]interface{}{
+			"type":                cisCertificateOrderTypeAdvanced,
+			"hosts":               hostsList,
+			"cloudflare_branding": false,
+		}
+		for _, key := range []string{cisCertificateOrderCA, cisCertificateOrderValidityDays, cisCertificateOrderValidation} {
+			v, ok := d.GetOk(key)
+			if !ok {
+				return fmt.Errorf("[ERROR] %s is required to order an advanced certificate pack", key)
+			}
+			order[key] = v
+		}
+		pack, resp, err := cisCertificatePackRequest(cisClient, core.POST, "/order", order)
+		if err != nil {
+			log.Printf("Certificate order failed: %v", resp)
+			return err
+		}
+		d.SetId(flex.ConvertCisToTfThreeVar(pack["id"].(string), zoneID, crn))
+	} else {
+		for _, key := range []string{cisCertificateOrderCA, cisCertificateOrderValidityDays, cisCertificateOrderValidation} {
+			if _, ok := d.GetOk(key); ok {
+				return fmt.Errorf("[ERROR] %s is only supported by the advanced certificate packs", key)
+			}
+		}
+		opt := cisClient.NewOrderCertificateOptions()
+		opt.SetType(certType)
+		opt.SetHosts(hostsList)
+
+		result, resp, err := cisClient.OrderCertificate(opt)
+		if err != nil {
+			log.Printf("Certificate order failed: %v", resp)
+			return err
+		}
+		d.SetId(flex.ConvertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
+	}
+
+	_, err = waitForCISCertificateOrderActive(d, meta)
 	if err != nil {
-		log.Printf("Certificate order failed: %v", resp)
 		return err
 	}
-
-	d.SetId(flex.ConvertCisToTfThreeVar(*result.Result.ID, zoneID, crn))
 	return ResourceIBMCISCertificateOrderRead(d, meta)
 }
 
@@ -139,6 +242,25 @@ func ResourceIBMCISCertificateOrderRead(d *schema.ResourceData, meta interface{}
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
+	if d.Get(cisCertificateOrderType).(string) == cisCertificateOrderTypeAdvanced {
+		pack, resp, err := cisCertificatePackRequest(cisClient, core.GET, "/"+certificateID, nil)
+		if err != nil {
+			log.Printf("Certificate read failed: %v", resp)
+			return err
+		}
+		d.Set(cisID, crn)
+		d.Set(cisDomainID, zoneID)
+		d.Set(cisCertificateOrderID, pack["id"])
+		d.Set(cisCertificateOrderType, cisCertificateOrderTypeAdvanced)
+		d.Set(cisCertificateOrderHosts, pack["hosts"])
+		d.Set(cisCertificateOrderStatus, pack["status"])
+		d.Set(cisCertificateOrderCA, pack[cisCertificateOrderCA])
+		if validityDays, ok := pack[cisCertificateOrderValidityDays].(float64); ok {
+			d.Set(cisCertificateOrderValidityDays, int(validityDays))
+		}
+		d.Set(cisCertificateOrderValidation, pack[cisCertificateOrderValidation])
+		return nil
+	}
 	opt := cisClient.NewGetCustomCertificateOptions(certificateID)
 	result, resp, err := cisClient.GetCustomCertificate(opt)
 	if err != nil {
@@ -193,10 +315,15 @@ func ResourceIBMCISCertificateOrderExist(d *schema.ResourceData, meta interface{
 	}
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
-	opt := cisClient.NewGetCustomCertificateOptions(certificateID)
-	_, response, err := cisClient.GetCustomCertificate(opt)
+	var response *core.DetailedResponse
+	if d.Get(cisCertificateOrderType).(string) == cisCertificateOrderTypeAdvanced {
+		_, response, err = cisCertificatePackRequest(cisClient, core.GET, "/"+certificateID, nil)
+	} else {
+		opt := cisClient.NewGetCustomCertificateOptions(certificateID)
+		_, response, err = cisClient.GetCustomCertificate(opt)
+	}
 	if err != nil {
-		if response != nil && response.StatusCode == 400 {
+		if response != nil && (response.StatusCode == 400 || response.StatusCode == 404) {
 			log.Printf("Certificate is not found")
 			return false, nil
 		}
@@ -219,13 +346,20 @@ func waitForCISCertificateOrderDelete(d *schema.ResourceData, meta interface{})
 	cisClient.Crn = core.StringPtr(crn)
 	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
 	opt := cisClient.NewGetCustomCertificateOptions(certificateID)
+	advanced := d.Get(cisCertificateOrderType).(string) == cisCertificateOrderTypeAdvanced
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{cisCertificateOrderDeletePending},
 		Target:  []string{cisCertificateOrderDeleted},
 		Refresh: func() (interface{}, string, error) {
-			_, detail, err := cisClient.GetCustomCertificate(opt)
+			var detail *core.DetailedResponse
+			var err error
+			if advanced {
+				_, detail, err = cisCertificatePackRequest(cisClient, core.GET, "/"+certificateID, nil)
+			} else {
+				_, detail, err = cisClient.GetCustomCertificate(opt)
+			}
 			if err != nil {
-				if detail != nil && detail.StatusCode == 400 {
+				if detail != nil && (detail.StatusCode == 400 || detail.StatusCode == 404) {
 					return detail, cisCertificateOrderDeleted, nil
 				}
 				return nil, "", err
@@ -240,3 +374,58 @@ func waitForCISCertificateOrderDelete(d *schema.ResourceData, meta interface{})
 
 	return stateConf.WaitForState()
 }
+
+func waitForCISCertificateOrderActive(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+	cisClient, err := meta.(conns.ClientSession).CisSSLClientSession()
+	if err != nil {
+		return nil, err
+	}
+	certificateID, zoneID, crn, err := flex.ConvertTfToCisThreeVar(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	cisClient.Crn = core.StringPtr(crn)
+	cisClient.ZoneIdentifier = core.StringPtr(zoneID)
+	advanced := d.Get(cisCertificateOrderType).(string) == cisCertificateOrderTypeAdvanced
+	stateConf := &resource.StateChangeConf{
+		Pending: cisCertificateOrderPendingStatuses,
+		Target:  []string{cisCertificateOrderActive},
+		Refresh: func() (interface{}, string, error) {
+			if advanced {
+				pack, detail, err := cisCertificatePackRequest(cisClient, core.GET, "/"+certificateID, nil)
+				if err != nil {
+					return nil, "", fmt.Errorf("[ERROR] Error getting the certificate pack %s: %s %s", certificateID, err, detail)
+				}
+				status, _ := pack["status"].(string)
+				return pack, status, nil
+			}
+			result, detail, err := cisClient.GetCustomCertificate(cisClient.NewGetCustomCertificateOptions(certificateID))
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting the certificate %s: %s %s", certificateID, err, detail)
+			}
+			return result, *result.Result.Status, nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        10 * time.Second,
+		MinTimeout:   10 * time.Second,
+		PollInterval: 30 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+// cisCertificatePackRequest orders or gets an advanced certificate pack, which are not part of the certificate
+// models of the SDK. The path is relative to /ssl/certificate_packs.
+func cisCertificatePackRequest(cisClient *sslcertificateapiv1.SslCertificateApiV1, method, path string, body interface{}) (map[string]interface{}, *core.DetailedResponse, error) {
+	rawResult, response, err := cisZoneRequest(cisClient.Service, *cisClient.Crn, *cisClient.ZoneIdentifier, method, "/ssl/certificate_packs"+path, body)
+	if err != nil {
+		return nil, response, err
+	}
+	result := map[string]interface{}{}
+	if rawResult != nil {
+		if err := json.Unmarshal(rawResult, &result); err != nil {
+			return nil, response, err
+		}
+	}
+	return result, response, nil
+}
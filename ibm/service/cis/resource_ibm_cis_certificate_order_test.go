@@ -36,6 +36,28 @@ func TestAccIBMCisCertificateOrder_Basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMCisCertificateOrder_Advanced(t *testing.T) {
+	name := "ibm_cis_certificate_order.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheckCis(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckCisCertificateOrderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCisCertificateOrderConfigAdvanced(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "certificate_id"),
+					resource.TestCheckResourceAttr(name, "type", "advanced"),
+					resource.TestCheckResourceAttr(name, "certificate_authority", "lets_encrypt"),
+					resource.TestCheckResourceAttr(name, "validity_days", "90"),
+					resource.TestCheckResourceAttr(name, "status", "active"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMCisCertificateOrder_import(t *testing.T) {
 	name := "ibm_cis_certificate_order.test"
 
@@ -170,3 +192,17 @@ func testAccCheckCisCertificateOrderConfigBasic() string {
 	  }
 	`, acc.CisDomainStatic)
 }
+
+func testAccCheckCisCertificateOrderConfigAdvanced() string {
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
+	resource "ibm_cis_certificate_order" "test" {
+		cis_id                = data.ibm_cis.cis.id
+		domain_id             = data.ibm_cis_domain.cis_domain.domain_id
+		type                  = "advanced"
+		hosts                 = ["%[1]s", "*.%[1]s"]
+		certificate_authority = "lets_encrypt"
+		validity_days         = 90
+		validation_method     = "txt"
+	  }
+	`, acc.CisDomainStatic)
+}
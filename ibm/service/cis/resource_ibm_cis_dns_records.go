@@ -0,0 +1,696 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	ibmCISDNSRecords                = "ibm_cis_dns_records"
+	cisDNSRecordsRecords            = "records"
+	cisDNSRecordsFile               = "file"
+	cisDNSRecordsProxiedByDefault   = "proxied_by_default"
+	cisDNSRecordsBatchSize          = 200
+	cisDNSRecordsListPageSize       = 1000
+	cisDNSRecordsApexName           = "@"
+	cisDNSRecordsDefaultTTL         = 1
+	cisDNSRecordsBindFileDefaultTTL = 3600
+)
+
+// cisDNSRecordsManagedTypes are the types of the DNS records reconciled by ibm_cis_dns_records, the
+// records of the other types are left unchanged
+var cisDNSRecordsManagedTypes = map[string]bool{
+	cisDNSRecordTypeA:     true,
+	cisDNSRecordTypeAAAA:  true,
+	cisDNSRecordTypeCNAME: true,
+	cisDNSRecordTypeMX:    true,
+	cisDNSRecordTypeNS:    true,
+	cisDNSRecordTypePTR:   true,
+	cisDNSRecordTypeSPF:   true,
+	cisDNSRecordTypeTXT:   true,
+}
+
+// cisDNSRecordsProxiableTypes are the types of the DNS records proxied by proxied_by_default
+var cisDNSRecordsProxiableTypes = map[string]bool{
+	cisDNSRecordTypeA:     true,
+	cisDNSRecordTypeAAAA:  true,
+	cisDNSRecordTypeCNAME: true,
+}
+
+func ResourceIBMCISDNSRecords() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMCISDNSRecordsUpdate,
+		Read:     resourceIBMCISDNSRecordsRead,
+		Update:   resourceIBMCISDNSRecordsUpdate,
+		Delete:   resourceIBMCISDNSRecordsDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMCISDNSRecordsFileCustomizeDiff(diff, v)
+			},
+		),
+
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Description: "CIS instance crn",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validate.InvokeValidator(ibmCISDNSRecords,
+					"cis_id"),
+			},
+			cisDomainID: {
+				Type:             schema.TypeString,
+				Description:      "Associated CIS domain",
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressDomainIDDiff,
+			},
+			cisDNSRecordsFile: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{cisDNSRecordsRecords},
+				Description:   "The BIND zone file of the records of the domain",
+			},
+			cisDNSRecordsProxiedByDefault: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Proxy the A, AAAA and CNAME records of the BIND zone file",
+			},
+			cisDNSRecordsRecords: {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{cisDNSRecordsFile},
+				Description:   "The A, AAAA, CNAME, MX, NS, PTR, SPF and TXT records of the domain",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						cisDNSRecordName: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the record relative to the domain, @ for the domain itself",
+						},
+						cisDNSRecordType: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator(ibmCISDNSRecords, cisDNSRecordType),
+							Description:  "The type of the record",
+						},
+						cisDNSRecordContent: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The content of the record",
+						},
+						cisDNSRecordTTL: {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     cisDNSRecordsDefaultTTL,
+							Description: "The TTL of the record in seconds, 1 for automatic",
+						},
+						cisDNSRecordPriority: {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     0,
+							Description: "The priority of the MX record",
+						},
+						cisDNSRecordProxied: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether the traffic of the record is proxied by CIS",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceIBMCISDNSRecordsValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cis_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "resource_instance",
+			CloudDataRange:             []string{"service:internet-svcs"},
+			Required:                   true})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 cisDNSRecordType,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "A, AAAA, CNAME, MX, NS, PTR, SPF, TXT"})
+
+	ibmCISDNSRecordsResourceValidator := validate.ResourceValidator{ResourceName: ibmCISDNSRecords, Schema: validateSchema}
+	return &ibmCISDNSRecordsResourceValidator
+}
+
+// resourceIBMCISDNSRecordsFileCustomizeDiff plans the records of the BIND zone file, so that the changes of the
+// records of the file are shown in the plan.
+func resourceIBMCISDNSRecordsFileCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	file := diff.Get(cisDNSRecordsFile).(string)
+	if meta == nil || file == "" || !diff.NewValueKnown(cisDNSRecordsFile) || !diff.NewValueKnown(cisDomainID) || !diff.NewValueKnown(cisID) {
+		return nil
+	}
+	zoneID, _, _ := flex.ConvertTftoCisTwoVar(diff.Get(cisDomainID).(string))
+	zoneName, err := getCISZoneName(meta, diff.Get(cisID).(string), zoneID)
+	if err != nil {
+		return err
+	}
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error reading the zone file %s: %s", file, err)
+	}
+	records, err := parseCISBindFile(string(content), zoneName, diff.Get(cisDNSRecordsProxiedByDefault).(bool))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error parsing the zone file %s: %s", file, err)
+	}
+	return diff.SetNew(cisDNSRecordsRecords, records)
+}
+
+func resourceIBMCISDNSRecordsUpdate(d *schema.ResourceData, meta interface{}) error {
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
+	zoneName, err := getCISZoneName(meta, crn, zoneID)
+	if err != nil {
+		return err
+	}
+
+	var records []interface{}
+	if file, ok := d.GetOk(cisDNSRecordsFile); ok {
+		content, err := os.ReadFile(file.(string))
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error reading the zone file %s: %s", file, err)
+		}
+		parsed, err := parseCISBindFile(string(content), zoneName, d.Get(cisDNSRecordsProxiedByDefault).(bool))
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error parsing the zone file %s: %s", file, err)
+		}
+		for _, record := range parsed {
+			records = append(records, record)
+		}
+	} else {
+		records = d.Get(cisDNSRecordsRecords).(*schema.Set).List()
+	}
+
+	desired := make(map[string]map[string]interface{}, len(records))
+	for _, r := range records {
+		record := expandCISDNSRecordsRecord(r.(map[string]interface{}), zoneName)
+		key := cisDNSRecordsKey(record)
+		if _, ok := desired[key]; ok {
+			return fmt.Errorf("[ERROR] The %s record %s %s is defined more than once", record[cisDNSRecordType], record[cisDNSRecordName], record[cisDNSRecordContent])
+		}
+		desired[key] = record
+	}
+
+	sess, err := cisDNSRecordsClient(meta, crn, zoneID)
+	if err != nil {
+		return err
+	}
+	existing, err := listAllCISDNSRecords(sess)
+	if err != nil {
+		return err
+	}
+
+	batch := cisDNSRecordsBatch{}
+	for _, record := range existing {
+		if !cisDNSRecordsIsManaged(record, zoneName) {
+			continue
+		}
+		current := flattenCISDNSRecordsRecord(record, "")
+		key := cisDNSRecordsKey(current)
+		want, ok := desired[key]
+		if !ok {
+			batch.Deletes = append(batch.Deletes, map[string]interface{}{"id": *record.ID})
+			continue
+		}
+		delete(desired, key)
+		if want[cisDNSRecordTTL] != current[cisDNSRecordTTL] || want[cisDNSRecordPriority] != current[cisDNSRecordPriority] || want[cisDNSRecordProxied] != current[cisDNSRecordProxied] {
+			patch := map[string]interface{}{"id": *record.ID}
+			for k, v := range want {
+				patch[k] = v
+			}
+			batch.Patches = append(batch.Patches, patch)
+		}
+	}
+	for _, record := range desired {
+		batch.Posts = append(batch.Posts, record)
+	}
+
+	if err := applyCISDNSRecordsBatch(sess, batch); err != nil {
+		return err
+	}
+	d.SetId(flex.ConvertCisToTfTwoVar(zoneID, crn))
+	return resourceIBMCISDNSRecordsRead(d, meta)
+}
+
+func resourceIBMCISDNSRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	zoneID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return err
+	}
+	zoneName, err := getCISZoneName(meta, crn, zoneID)
+	if err != nil {
+		return err
+	}
+	sess, err := cisDNSRecordsClient(meta, crn, zoneID)
+	if err != nil {
+		return err
+	}
+	existing, err := listAllCISDNSRecords(sess)
+	if err != nil {
+		return err
+	}
+
+	records := make([]interface{}, 0, len(existing))
+	for _, record := range existing {
+		if cisDNSRecordsIsManaged(record, zoneName) {
+			records = append(records, flattenCISDNSRecordsRecord(record, zoneName))
+		}
+	}
+	d.Set(cisID, crn)
+	d.Set(cisDomainID, zoneID)
+	d.Set(cisDNSRecordsRecords, records)
+	return nil
+}
+
+func resourceIBMCISDNSRecordsDelete(d *schema.ResourceData, meta interface{}) error {
+	zoneID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return err
+	}
+	zoneName, err := getCISZoneName(meta, crn, zoneID)
+	if err != nil {
+		return err
+	}
+	sess, err := cisDNSRecordsClient(meta, crn, zoneID)
+	if err != nil {
+		return err
+	}
+	existing, err := listAllCISDNSRecords(sess)
+	if err != nil {
+		return err
+	}
+
+	// Only the records of the resource are deleted, the records added since the last apply are kept
+	managed := map[string]bool{}
+	for _, r := range d.Get(cisDNSRecordsRecords).(*schema.Set).List() {
+		managed[cisDNSRecordsKey(expandCISDNSRecordsRecord(r.(map[string]interface{}), zoneName))] = true
+	}
+	batch := cisDNSRecordsBatch{}
+	for _, record := range existing {
+		if cisDNSRecordsIsManaged(record, zoneName) && managed[cisDNSRecordsKey(flattenCISDNSRecordsRecord(record, ""))] {
+			batch.Deletes = append(batch.Deletes, map[string]interface{}{"id": *record.ID})
+		}
+	}
+	if err := applyCISDNSRecordsBatch(sess, batch); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func cisDNSRecordsClient(meta interface{}, crn, zoneID string) (*dnsrecordsv1.DnsRecordsV1, error) {
+	sess, err := meta.(conns.ClientSession).CisDNSRecordClientSession()
+	if err != nil {
+		return nil, err
+	}
+	sess.Crn = core.StringPtr(crn)
+	sess.ZoneIdentifier = core.StringPtr(zoneID)
+	return sess, nil
+}
+
+func getCISZoneName(meta interface{}, crn, zoneID string) (string, error) {
+	cisClient, err := meta.(conns.ClientSession).CisZonesV1ClientSession()
+	if err != nil {
+		return "", err
+	}
+	cisClient.Crn = core.StringPtr(crn)
+	result, resp, err := cisClient.GetZone(cisClient.NewGetZoneOptions(zoneID))
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error getting the zone %s: %s %s", zoneID, err, resp)
+	}
+	return *result.Result.Name, nil
+}
+
+// listAllCISDNSRecords lists all the DNS records of the zone, page by page
+func listAllCISDNSRecords(sess *dnsrecordsv1.DnsRecordsV1) ([]dnsrecordsv1.DnsrecordDetails, error) {
+	records := []dnsrecordsv1.DnsrecordDetails{}
+	opt := sess.NewListAllDnsRecordsOptions()
+	opt.SetPerPage(cisDNSRecordsListPageSize)
+	for page := int64(1); ; page++ {
+		opt.SetPage(page)
+		result, response, err := sess.ListAllDnsRecords(opt)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error listing the DNS records: %s %s", err, response)
+		}
+		records = append(records, result.Result...)
+		if result.ResultInfo == nil || result.ResultInfo.TotalCount == nil || int64(len(records)) >= *result.ResultInfo.TotalCount || len(result.Result) == 0 {
+			break
+		}
+	}
+	return records, nil
+}
+
+// cisDNSRecordsIsManaged reports whether the record is reconciled by ibm_cis_dns_records, the name servers
+// of the domain itself are managed by CIS.
+func cisDNSRecordsIsManaged(record dnsrecordsv1.DnsrecordDetails, zoneName string) bool {
+	if record.ID == nil || record.Type == nil || record.Name == nil || !cisDNSRecordsManagedTypes[*record.Type] {
+		return false
+	}
+	return !(*record.Type == cisDNSRecordTypeNS && strings.EqualFold(*record.Name, zoneName))
+}
+
+// cisDNSRecordsKey identifies a record by its type, name and content, the other attributes of the
+// records are updated in place.
+func cisDNSRecordsKey(record map[string]interface{}) string {
+	return fmt.Sprintf("%s|%s|%s", record[cisDNSRecordType], strings.ToLower(record[cisDNSRecordName].(string)), record[cisDNSRecordContent])
+}
+
+// expandCISDNSRecordsRecord returns the record in the format of the DNS records API, with its fully
+// qualified name.
+func expandCISDNSRecordsRecord(r map[string]interface{}, zoneName string) map[string]interface{} {
+	record := map[string]interface{}{
+		cisDNSRecordName:    cisDNSRecordsFQDN(r[cisDNSRecordName].(string), zoneName),
+		cisDNSRecordType:    r[cisDNSRecordType].(string),
+		cisDNSRecordContent: r[cisDNSRecordContent].(string),
+		cisDNSRecordTTL:     int64(r[cisDNSRecordTTL].(int)),
+		cisDNSRecordProxied: r[cisDNSRecordProxied].(bool),
+	}
+	if priority := int64(r[cisDNSRecordPriority].(int)); priority != 0 || record[cisDNSRecordType] == cisDNSRecordTypeMX {
+		record[cisDNSRecordPriority] = priority
+	}
+	return record
+}
+
+// flattenCISDNSRecordsRecord returns the record in the format of the records attribute, the name is
+// made relative to the zone when its name is given.
+func flattenCISDNSRecordsRecord(record dnsrecordsv1.DnsrecordDetails, zoneName string) map[string]interface{} {
+	r := map[string]interface{}{
+		cisDNSRecordName:    *record.Name,
+		cisDNSRecordType:    *record.Type,
+		cisDNSRecordContent: "",
+		cisDNSRecordTTL:     int64(cisDNSRecordsDefaultTTL),
+		cisDNSRecordProxied: false,
+	}
+	if zoneName != "" {
+		r[cisDNSRecordName] = cisDNSRecordsRelativeName(*record.Name, zoneName)
+	}
+	if record.Content != nil {
+		r[cisDNSRecordContent] = *record.Content
+	}
+	if record.TTL != nil {
+		r[cisDNSRecordTTL] = *record.TTL
+	}
+	if record.Proxied != nil {
+		r[cisDNSRecordProxied] = *record.Proxied
+	}
+	if record.Priority != nil {
+		r[cisDNSRecordPriority] = *record.Priority
+	} else if zoneName != "" {
+		r[cisDNSRecordPriority] = int64(0)
+	}
+	return r
+}
+
+func cisDNSRecordsFQDN(name, zoneName string) string {
+	name = strings.TrimSuffix(name, ".")
+	if name == cisDNSRecordsApexName || name == "" || strings.EqualFold(name, zoneName) {
+		return zoneName
+	}
+	if strings.HasSuffix(strings.ToLower(name), "."+strings.ToLower(zoneName)) {
+		return name
+	}
+	return name + "." + zoneName
+}
+
+func cisDNSRecordsRelativeName(name, zoneName string) string {
+	if strings.EqualFold(name, zoneName) {
+		return cisDNSRecordsApexName
+	}
+	if strings.HasSuffix(strings.ToLower(name), "."+strings.ToLower(zoneName)) {
+		return name[:len(name)-len(zoneName)-1]
+	}
+	return name
+}
+
+// cisDNSRecordsBatch holds the changes of the records, in the format of the batch API of the DNS records
+type cisDNSRecordsBatch struct {
+	Deletes []map[string]interface{} `json:"deletes,omitempty"`
+	Patches []map[string]interface{} `json:"patches,omitempty"`
+	Posts   []map[string]interface{} `json:"posts,omitempty"`
+}
+
+// applyCISDNSRecordsBatch applies the changes of the records with the batch API, by batches of at most
+// cisDNSRecordsBatchSize changes. The changes are applied one by one when the batch API is not available.
+func applyCISDNSRecordsBatch(sess *dnsrecordsv1.DnsRecordsV1, batch cisDNSRecordsBatch) error {
+	log.Printf("[INFO] Applying the DNS records changes: %d deletes, %d patches, %d posts", len(batch.Deletes), len(batch.Patches), len(batch.Posts))
+	for len(batch.Deletes)+len(batch.Patches)+len(batch.Posts) > 0 {
+		next := cisDNSRecordsBatch{}
+		size := 0
+		take := func(changes *[]map[string]interface{}) []map[string]interface{} {
+			n := len(*changes)
+			if n > cisDNSRecordsBatchSize-size {
+				n = cisDNSRecordsBatchSize - size
+			}
+			taken := (*changes)[:n]
+			*changes = (*changes)[n:]
+			size += n
+			return taken
+		}
+		next.Deletes = take(&batch.Deletes)
+		next.Patches = take(&batch.Patches)
+		next.Posts = take(&batch.Posts)
+
+		_, resp, err := cisZoneRequest(sess.Service, *sess.Crn, *sess.ZoneIdentifier, core.POST, "/dns_records/batch", next)
+		if err != nil && resp != nil && (resp.StatusCode == 404 || resp.StatusCode == 405) {
+			err = applyCISDNSRecordsChanges(sess, next)
+		}
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error applying the DNS records changes: %s %s", err, resp)
+		}
+	}
+	return nil
+}
+
+// applyCISDNSRecordsChanges applies the changes of the records one by one
+func applyCISDNSRecordsChanges(sess *dnsrecordsv1.DnsRecordsV1, batch cisDNSRecordsBatch) error {
+	for _, record := range batch.Deletes {
+		opt := sess.NewDeleteDnsRecordOptions(record["id"].(string))
+		_, resp, err := sess.DeleteDnsRecord(opt)
+		if err != nil && (resp == nil || resp.StatusCode != 404) {
+			return fmt.Errorf("[ERROR] Error deleting the DNS record %s: %s %s", record["id"], err, resp)
+		}
+	}
+	for _, record := range batch.Patches {
+		opt := sess.NewUpdateDnsRecordOptions(record["id"].(string))
+		opt.SetName(record[cisDNSRecordName].(string))
+		opt.SetType(record[cisDNSRecordType].(string))
+		opt.SetContent(record[cisDNSRecordContent].(string))
+		opt.SetTTL(record[cisDNSRecordTTL].(int64))
+		opt.SetProxied(record[cisDNSRecordProxied].(bool))
+		if priority, ok := record[cisDNSRecordPriority]; ok {
+			opt.SetPriority(priority.(int64))
+		}
+		if _, resp, err := sess.UpdateDnsRecord(opt); err != nil {
+			return fmt.Errorf("[ERROR] Error updating the DNS record %s: %s %s", record["id"], err, resp)
+		}
+	}
+	for _, record := range batch.Posts {
+		opt := sess.NewCreateDnsRecordOptions()
+		opt.SetName(record[cisDNSRecordName].(string))
+		opt.SetType(record[cisDNSRecordType].(string))
+		opt.SetContent(record[cisDNSRecordContent].(string))
+		opt.SetTTL(record[cisDNSRecordTTL].(int64))
+		opt.SetProxied(record[cisDNSRecordProxied].(bool))
+		if priority, ok := record[cisDNSRecordPriority]; ok {
+			opt.SetPriority(priority.(int64))
+		}
+		if _, resp, err := sess.CreateDnsRecord(opt); err != nil {
+			return fmt.Errorf("[ERROR] Error creating the %s record %s: %s %s", record[cisDNSRecordType], record[cisDNSRecordName], err, resp)
+		}
+	}
+	return nil
+}
+
+// parseCISBindFile parses the records of a BIND zone file, in the format of the records attribute. The SOA
+// record and the name servers of the domain itself are skipped as they are managed by CIS, the records of
+// the types not reconciled by ibm_cis_dns_records are rejected.
+func parseCISBindFile(content, zoneName string, proxiedByDefault bool) ([]interface{}, error) {
+	origin := zoneName
+	ttl := int64(cisDNSRecordsBindFileDefaultTTL)
+	name := cisDNSRecordsApexName
+	records := []interface{}{}
+
+	lines := strings.Split(content, "\n")
+	for i := 0; i < len(lines); i++ {
+		lineNumber := i + 1
+		line := cisBindFileStripComment(lines[i])
+		// Join the lines of the records split by parentheses
+		for strings.Count(line, "(") > strings.Count(line, ")") && i+1 < len(lines) {
+			i++
+			line += " " + cisBindFileStripComment(lines[i])
+		}
+		line = strings.NewReplacer("(", " ", ")", " ").Replace(line)
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields, err := cisBindFileFields(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", lineNumber, err)
+		}
+
+		switch strings.ToUpper(fields[0]) {
+		case "$ORIGIN":
+			if len(fields) < 2 {
+				return nil, fmt.Errorf("line %d: $ORIGIN without a domain", lineNumber)
+			}
+			origin = cisDNSRecordsFQDN(fields[1], origin)
+			continue
+		case "$TTL":
+			if len(fields) < 2 {
+				return nil, fmt.Errorf("line %d: $TTL without a value", lineNumber)
+			}
+			if ttl, err = strconv.ParseInt(fields[1], 10, 64); err != nil {
+				return nil, fmt.Errorf("line %d: invalid $TTL %s", lineNumber, fields[1])
+			}
+			continue
+		case "$INCLUDE", "$GENERATE":
+			return nil, fmt.Errorf("line %d: the %s directive is not supported", lineNumber, fields[0])
+		}
+
+		// A record starting with a blank has the name of the previous record
+		if line[0] != ' ' && line[0] != '\t' {
+			name = cisDNSRecordsFQDN(fields[0], origin)
+			fields = fields[1:]
+		}
+		recordTTL := ttl
+		for len(fields) > 0 {
+			if v, err := strconv.ParseInt(fields[0], 10, 64); err == nil {
+				recordTTL = v
+			} else if !strings.EqualFold(fields[0], "IN") {
+				break
+			}
+			fields = fields[1:]
+		}
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("line %d: the record has no type or no data", lineNumber)
+		}
+		recordType := strings.ToUpper(fields[0])
+		data := fields[1:]
+		if recordType == "SOA" || (recordType == cisDNSRecordTypeNS && strings.EqualFold(name, zoneName)) {
+			continue
+		}
+		if !cisDNSRecordsManagedTypes[recordType] {
+			return nil, fmt.Errorf("line %d: the %s records are not supported", lineNumber, recordType)
+		}
+
+		record := map[string]interface{}{
+			cisDNSRecordName:     cisDNSRecordsRelativeName(name, zoneName),
+			cisDNSRecordType:     recordType,
+			cisDNSRecordTTL:      int(recordTTL),
+			cisDNSRecordPriority: 0,
+			cisDNSRecordProxied:  proxiedByDefault && cisDNSRecordsProxiableTypes[recordType],
+		}
+		switch recordType {
+		case cisDNSRecordTypeMX:
+			if len(data) < 2 {
+				return nil, fmt.Errorf("line %d: the MX record has no priority or no mail server", lineNumber)
+			}
+			priority, err := strconv.Atoi(data[0])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid MX priority %s", lineNumber, data[0])
+			}
+			record[cisDNSRecordPriority] = priority
+			record[cisDNSRecordContent] = cisDNSRecordsFQDN(data[1], origin)
+		case cisDNSRecordTypeCNAME, cisDNSRecordTypeNS, cisDNSRecordTypePTR:
+			record[cisDNSRecordContent] = cisDNSRecordsFQDN(data[0], origin)
+		case cisDNSRecordTypeTXT, cisDNSRecordTypeSPF:
+			record[cisDNSRecordContent] = strings.Join(data, "")
+		default:
+			record[cisDNSRecordContent] = data[0]
+		}
+		if record[cisDNSRecordProxied].(bool) {
+			// The TTL of the proxied records is automatic
+			record[cisDNSRecordTTL] = cisDNSRecordsDefaultTTL
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// cisBindFileStripComment removes the comment of a line of a zone file
+func cisBindFileStripComment(line string) string {
+	quoted := false
+	for i, c := range line {
+		switch {
+		case c == '"' && (i == 0 || line[i-1] != '\\'):
+			quoted = !quoted
+		case c == ';' && !quoted:
+			return strings.TrimRight(line[:i], " \t\r")
+		}
+	}
+	return strings.TrimRight(line, " \t\r")
+}
+
+// cisBindFileFields splits a line of a zone file into its fields, the quotes of the quoted strings are removed
+func cisBindFileFields(line string) ([]string, error) {
+	fields := []string{}
+	var field strings.Builder
+	quoted, inField := false, false
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case c == '\\' && i+1 < len(line):
+			i++
+			field.WriteByte(line[i])
+			inField = true
+		case c == '"':
+			quoted = !quoted
+			inField = true
+		case (c == ' ' || c == '\t') && !quoted:
+			if inField {
+				fields = append(fields, field.String())
+				field.Reset()
+				inField = false
+			}
+		default:
+			field.WriteByte(c)
+			inField = true
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("unterminated quoted string")
+	}
+	if inField {
+		fields = append(fields, field.String())
+	}
+	return fields, nil
+}
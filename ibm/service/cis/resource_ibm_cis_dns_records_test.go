@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCisDNSRecords_Basic(t *testing.T) {
+	name := "ibm_cis_dns_records.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCisDNSRecordsConfigBasic("192.168.0.10"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "records.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "records.*", map[string]string{
+						"name":    "test-records",
+						"type":    "A",
+						"content": "192.168.0.10",
+						"proxied": "true",
+					}),
+				),
+			},
+			{
+				Config: testAccCheckCisDNSRecordsConfigBasic("192.168.0.11"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "records.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "records.*", map[string]string{
+						"name":    "test-records",
+						"type":    "A",
+						"content": "192.168.0.11",
+					}),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"proxied_by_default",
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckCisDNSRecordsConfigBasic(ip string) string {
+	return testAccCheckIBMCisDNSRecordConfigCisDSBasic(
+		"test-dns-record", acc.CisDomainStatic) +
+		fmt.Sprintf(`
+	resource "ibm_cis_dns_records" "test" {
+		cis_id    = data.ibm_cis.cis.id
+		domain_id = data.ibm_cis_domain.cis_domain.domain_id
+
+		records {
+			name    = "test-records"
+			type    = "A"
+			content = "%[1]s"
+			proxied = true
+		}
+		records {
+			name    = "test-records-txt"
+			type    = "TXT"
+			content = "test records"
+			ttl     = 300
+		}
+		records {
+			name     = "@"
+			type     = "MX"
+			content  = "mail.%[2]s"
+			priority = 10
+		}
+	}`, ip, acc.CisDomainStatic)
+}
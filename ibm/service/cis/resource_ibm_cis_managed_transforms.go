@@ -0,0 +1,223 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	ibmCISManagedTransforms                = "ibm_cis_managed_transforms"
+	cisManagedTransformsRequestHeaders     = "managed_request_headers"
+	cisManagedTransformsResponseHeaders    = "managed_response_headers"
+	cisManagedTransformsAvailableRequest   = "available_request_headers"
+	cisManagedTransformsAvailableResponse  = "available_response_headers"
+	cisManagedTransformsManagedHeadersPath = "/managed_headers"
+)
+
+// cisManagedHeader is a managed transform of the managed headers API
+type cisManagedHeader struct {
+	ID      string `json:"id"`
+	Enabled bool   `json:"enabled"`
+}
+
+type cisManagedHeaders struct {
+	ManagedRequestHeaders  []cisManagedHeader `json:"managed_request_headers"`
+	ManagedResponseHeaders []cisManagedHeader `json:"managed_response_headers"`
+}
+
+func ResourceIBMCISManagedTransforms() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMCISManagedTransformsUpdate,
+		Read:     resourceIBMCISManagedTransformsRead,
+		Update:   resourceIBMCISManagedTransformsUpdate,
+		Delete:   resourceIBMCISManagedTransformsDelete,
+		Importer: &schema.ResourceImporter{},
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Description: "CIS instance crn",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validate.InvokeValidator(ibmCISManagedTransforms,
+					"cis_id"),
+			},
+			cisDomainID: {
+				Type:             schema.TypeString,
+				Description:      "Associated CIS domain",
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressDomainIDDiff,
+			},
+			cisManagedTransformsRequestHeaders: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the enabled managed transforms of the request headers, such as add_true_client_ip_headers",
+			},
+			cisManagedTransformsResponseHeaders: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the enabled managed transforms of the response headers, such as remove_x-powered-by_header",
+			},
+			cisManagedTransformsAvailableRequest: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the managed transforms of the request headers available for the zone",
+			},
+			cisManagedTransformsAvailableResponse: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the managed transforms of the response headers available for the zone",
+			},
+		},
+	}
+}
+
+func ResourceIBMCISManagedTransformsValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cis_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "resource_instance",
+			CloudDataRange:             []string{"service:internet-svcs"},
+			Required:                   true})
+
+	ibmCISManagedTransformsResourceValidator := validate.ResourceValidator{ResourceName: ibmCISManagedTransforms, Schema: validateSchema}
+	return &ibmCISManagedTransformsResourceValidator
+}
+
+func resourceIBMCISManagedTransformsUpdate(d *schema.ResourceData, meta interface{}) error {
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
+	err := updateCISManagedTransforms(meta, crn, zoneID,
+		flex.ExpandStringList(d.Get(cisManagedTransformsRequestHeaders).(*schema.Set).List()),
+		flex.ExpandStringList(d.Get(cisManagedTransformsResponseHeaders).(*schema.Set).List()))
+	if err != nil {
+		return err
+	}
+	d.SetId(flex.ConvertCisToTfTwoVar(zoneID, crn))
+	return resourceIBMCISManagedTransformsRead(d, meta)
+}
+
+func resourceIBMCISManagedTransformsRead(d *schema.ResourceData, meta interface{}) error {
+	zoneID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return err
+	}
+	headers, err := getCISManagedTransforms(meta, crn, zoneID)
+	if err != nil {
+		return err
+	}
+	enabledRequest, availableRequest := flattenCISManagedHeaders(headers.ManagedRequestHeaders)
+	enabledResponse, availableResponse := flattenCISManagedHeaders(headers.ManagedResponseHeaders)
+	d.Set(cisID, crn)
+	d.Set(cisDomainID, zoneID)
+	d.Set(cisManagedTransformsRequestHeaders, enabledRequest)
+	d.Set(cisManagedTransformsResponseHeaders, enabledResponse)
+	d.Set(cisManagedTransformsAvailableRequest, availableRequest)
+	d.Set(cisManagedTransformsAvailableResponse, availableResponse)
+	return nil
+}
+
+func resourceIBMCISManagedTransformsDelete(d *schema.ResourceData, meta interface{}) error {
+	zoneID, crn, err := flex.ConvertTftoCisTwoVar(d.Id())
+	if err != nil {
+		return err
+	}
+	// Disable all the managed transforms
+	if err := updateCISManagedTransforms(meta, crn, zoneID, nil, nil); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func getCISManagedTransforms(meta interface{}, crn, zoneID string) (*cisManagedHeaders, error) {
+	cisClient, err := meta.(conns.ClientSession).CisDomainSettingsClientSession()
+	if err != nil {
+		return nil, err
+	}
+	rawResult, resp, err := cisZoneRequest(cisClient.Service, crn, zoneID, core.GET, cisManagedTransformsManagedHeadersPath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting the managed transforms: %s %s", err, resp)
+	}
+	headers := &cisManagedHeaders{}
+	if rawResult != nil {
+		if err := json.Unmarshal(rawResult, headers); err != nil {
+			return nil, err
+		}
+	}
+	return headers, nil
+}
+
+// updateCISManagedTransforms enables the given managed transforms of the zone and disables the others
+func updateCISManagedTransforms(meta interface{}, crn, zoneID string, requestHeaders, responseHeaders []string) error {
+	current, err := getCISManagedTransforms(meta, crn, zoneID)
+	if err != nil {
+		return err
+	}
+	headers := cisManagedHeaders{
+		ManagedRequestHeaders:  []cisManagedHeader{},
+		ManagedResponseHeaders: []cisManagedHeader{},
+	}
+	available := map[string]bool{}
+	for _, header := range current.ManagedRequestHeaders {
+		available[header.ID] = true
+		headers.ManagedRequestHeaders = append(headers.ManagedRequestHeaders, cisManagedHeader{ID: header.ID, Enabled: false})
+	}
+	for _, header := range current.ManagedResponseHeaders {
+		available[header.ID] = true
+		headers.ManagedResponseHeaders = append(headers.ManagedResponseHeaders, cisManagedHeader{ID: header.ID, Enabled: false})
+	}
+	for _, id := range append(append([]string{}, requestHeaders...), responseHeaders...) {
+		if !available[id] {
+			return fmt.Errorf("[ERROR] The managed transform %s is not available for the zone %s", id, zoneID)
+		}
+	}
+	enable := func(managed []cisManagedHeader, ids []string) {
+		for i := range managed {
+			for _, id := range ids {
+				if managed[i].ID == id {
+					managed[i].Enabled = true
+				}
+			}
+		}
+	}
+	enable(headers.ManagedRequestHeaders, requestHeaders)
+	enable(headers.ManagedResponseHeaders, responseHeaders)
+
+	cisClient, err := meta.(conns.ClientSession).CisDomainSettingsClientSession()
+	if err != nil {
+		return err
+	}
+	_, resp, err := cisZoneRequest(cisClient.Service, crn, zoneID, core.PATCH, cisManagedTransformsManagedHeadersPath, headers)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error updating the managed transforms: %s %s", err, resp)
+	}
+	return nil
+}
+
+func flattenCISManagedHeaders(headers []cisManagedHeader) (enabled []string, available []string) {
+	enabled, available = []string{}, []string{}
+	for _, header := range headers {
+		available = append(available, header.ID)
+		if header.Enabled {
+			enabled = append(enabled, header.ID)
+		}
+	}
+	return
+}
@@ -4,12 +4,16 @@
 package cis
 
 import (
+	"encoding/json"
+	"fmt"
 	"log"
+	"net/url"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/sslcertificateapiv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -19,6 +23,10 @@ const (
 	cisTLSSettingsTLS12Only     = "tls_1_2_only"
 	cisTLSSettingsTLS13         = "tls_1_3"
 	cisTLSSettingsMinTLSVersion = "min_tls_version"
+	cisTLSSettingsTotalTLS      = "total_tls"
+	cisTLSSettingsTotalTLSCA    = "total_tls_certificate_authority"
+	cisTLSSettingsHostnames     = "hostname_min_tls_version"
+	cisTLSSettingsHostname      = "hostname"
 )
 
 func ResourceIBMCISTLSSettings() *schema.Resource {
@@ -58,6 +66,39 @@ func ResourceIBMCISTLSSettings() *schema.Resource {
 				ValidateFunc: validate.InvokeValidator(ibmCISTLSSettings, cisTLSSettingsMinTLSVersion),
 				Default:      "1.1",
 			},
+			cisTLSSettingsTotalTLS: {
+				Type:        schema.TypeBool,
+				Description: "Total TLS setting, to issue the certificates of all the proxied hostnames",
+				Optional:    true,
+				Computed:    true,
+			},
+			cisTLSSettingsTotalTLSCA: {
+				Type:         schema.TypeString,
+				Description:  "Certificate authority of the Total TLS certificates",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator(ibmCISTLSSettings, cisTLSSettingsTotalTLSCA),
+			},
+			cisTLSSettingsHostnames: {
+				Type:        schema.TypeSet,
+				Description: "Minimum version of TLS required by a hostname",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						cisTLSSettingsHostname: {
+							Type:        schema.TypeString,
+							Description: "Hostname of the domain",
+							Required:    true,
+						},
+						cisTLSSettingsMinTLSVersion: {
+							Type:         schema.TypeString,
+							Description:  "Minimum version of TLS required by the hostname",
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator(ibmCISTLSSettings, cisTLSSettingsMinTLSVersion),
+						},
+					},
+				},
+			},
 		},
 		Create:   resourceCISTLSSettingsUpdate,
 		Read:     resourceCISTLSSettingsRead,
@@ -92,6 +133,13 @@ func ResourceIBMCISTLSSettingsValidator() *validate.ResourceValidator {
 			Type:                       validate.TypeString,
 			Required:                   true,
 			AllowedValues:              "1.1, 1.2, 1.3, 1.4"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 cisTLSSettingsTotalTLSCA,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "google, lets_encrypt, ssl_com"})
 	ibmCISTLSSettingsResourceValidator := validate.ResourceValidator{
 		ResourceName: ibmCISTLSSettings,
 		Schema:       validateSchema}
@@ -152,6 +200,51 @@ func resourceCISTLSSettingsUpdate(d *schema.ResourceData, meta interface{}) erro
 			}
 		}
 	}
+
+	// Total TLS setting
+	if d.HasChange(cisTLSSettingsTotalTLS) || d.HasChange(cisTLSSettingsTotalTLSCA) {
+		if totalTLS, ok := d.GetOkExists(cisTLSSettingsTotalTLS); ok {
+			setting := map[string]interface{}{
+				"enabled": totalTLS.(bool),
+			}
+			if ca, ok := d.GetOk(cisTLSSettingsTotalTLSCA); ok {
+				setting["certificate_authority"] = ca.(string)
+			}
+			_, resp, err := cisZoneRequest(cisClient.Service, crn, zoneID, core.POST, "/acm/total_tls", setting)
+			if err != nil {
+				log.Printf("Update Total TLS setting Failed : %v\n", resp)
+				return err
+			}
+		}
+	}
+
+	// Minimum TLS version of the hostnames
+	if d.HasChange(cisTLSSettingsHostnames) {
+		o, n := d.GetChange(cisTLSSettingsHostnames)
+		versions := map[string]string{}
+		for _, h := range n.(*schema.Set).List() {
+			hostname := h.(map[string]interface{})
+			versions[hostname[cisTLSSettingsHostname].(string)] = hostname[cisTLSSettingsMinTLSVersion].(string)
+		}
+		for _, h := range o.(*schema.Set).List() {
+			hostname := h.(map[string]interface{})[cisTLSSettingsHostname].(string)
+			if _, ok := versions[hostname]; !ok {
+				_, resp, err := cisZoneRequest(cisClient.Service, crn, zoneID, core.DELETE, "/hostnames/settings/min_tls_version/"+url.PathEscape(hostname), nil)
+				if err != nil && (resp == nil || resp.StatusCode != 404) {
+					log.Printf("Delete minimum TLS version of %s Failed : %v\n", hostname, resp)
+					return err
+				}
+			}
+		}
+		for hostname, version := range versions {
+			_, resp, err := cisZoneRequest(cisClient.Service, crn, zoneID, core.PUT, "/hostnames/settings/min_tls_version/"+url.PathEscape(hostname),
+				map[string]interface{}{"value": version})
+			if err != nil {
+				log.Printf("Update minimum TLS version of %s Failed : %v\n", hostname, resp)
+				return err
+			}
+		}
+	}
 	d.SetId(flex.ConvertCisToTfTwoVar(zoneID, crn))
 	return resourceCISTLSSettingsRead(d, meta)
 }
@@ -193,14 +286,62 @@ func resourceCISTLSSettingsRead(d *schema.ResourceData, meta interface{}) error
 		log.Printf("Min TLS Version setting get request failed : %v", resp)
 		return err
 	}
+
+	// Total TLS setting
+	totalTLS, resp, err := cisTLSSettingsRequest(cisClient, "/acm/total_tls")
+	if err != nil {
+		log.Printf("Get Total TLS setting failed : %v\n", resp)
+		return err
+	}
+	totalTLSResult := struct {
+		Enabled              bool   `json:"enabled"`
+		CertificateAuthority string `json:"certificate_authority"`
+	}{}
+	if totalTLS != nil {
+		if err := json.Unmarshal(totalTLS, &totalTLSResult); err != nil {
+			return fmt.Errorf("[ERROR] Error reading the Total TLS setting: %s", err)
+		}
+	}
+
+	// Minimum TLS version of the hostnames
+	hostnames, resp, err := cisTLSSettingsRequest(cisClient, "/hostnames/settings/min_tls_version")
+	if err != nil {
+		log.Printf("Get minimum TLS version of the hostnames failed : %v\n", resp)
+		return err
+	}
+	hostnamesResult := []struct {
+		Hostname string `json:"hostname"`
+		Value    string `json:"value"`
+	}{}
+	if hostnames != nil {
+		if err := json.Unmarshal(hostnames, &hostnamesResult); err != nil {
+			return fmt.Errorf("[ERROR] Error reading the minimum TLS version of the hostnames: %s", err)
+		}
+	}
+	hostnameVersions := make([]map[string]interface{}, 0, len(hostnamesResult))
+	for _, hostname := range hostnamesResult {
+		hostnameVersions = append(hostnameVersions, map[string]interface{}{
+			cisTLSSettingsHostname:      hostname.Hostname,
+			cisTLSSettingsMinTLSVersion: hostname.Value,
+		})
+	}
+
 	d.Set(cisID, crn)
 	d.Set(cisDomainID, zoneID)
 	d.Set(cisTLSSettingsTLS13, tls13Result.Result.Value)
 	d.Set(cisTLSSettingsUniversalSSL, universalSSLResult.Result.Enabled)
 	d.Set(cisTLSSettingsMinTLSVersion, minTLSVerResult.Result.Value)
+	d.Set(cisTLSSettingsTotalTLS, totalTLSResult.Enabled)
+	d.Set(cisTLSSettingsTotalTLSCA, totalTLSResult.CertificateAuthority)
+	d.Set(cisTLSSettingsHostnames, hostnameVersions)
 	return nil
 }
 
+// cisTLSSettingsRequest gets a TLS setting which is not part of the SSL settings of the SDK
+func cisTLSSettingsRequest(cisClient *sslcertificateapiv1.SslCertificateApiV1, path string) (json.RawMessage, *core.DetailedResponse, error) {
+	return cisZoneRequest(cisClient.Service, *cisClient.Crn, *cisClient.ZoneIdentifier, core.GET, path, nil)
+}
+
 func resourceCISTLSSettingsDelete(d *schema.ResourceData, meta interface{}) error {
 	// Nothing to delete on CIS resource
 	d.SetId("")
@@ -104,3 +104,47 @@ func testAccCheckCisTLSSettingsConfigBasic3(id string, CisDomainStatic string) s
 	  }
 `, id)
 }
+
+func TestAccIBMCisTLSSettings_HostnameMinTLSVersion(t *testing.T) {
+	name := "ibm_cis_tls_settings.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCisTLSSettingsConfigHostnames("1.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "hostname_min_tls_version.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "hostname_min_tls_version.*", map[string]string{
+						"hostname":        "app." + acc.CisDomainStatic,
+						"min_tls_version": "1.2",
+					}),
+				),
+			},
+			{
+				Config: testAccCheckCisTLSSettingsConfigHostnames("1.3"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(name, "hostname_min_tls_version.*", map[string]string{
+						"hostname":        "app." + acc.CisDomainStatic,
+						"min_tls_version": "1.3",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCisTLSSettingsConfigHostnames(version string) string {
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
+	resource "ibm_cis_tls_settings" "test" {
+		cis_id          = data.ibm_cis.cis.id
+		domain_id       = data.ibm_cis_domain.cis_domain.domain_id
+		min_tls_version = "1.1"
+
+		hostname_min_tls_version {
+			hostname        = "app.%[1]s"
+			min_tls_version = "%[2]s"
+		}
+	  }
+`, acc.CisDomainStatic, version)
+}
@@ -0,0 +1,367 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	ibmCISTransformRules               = "ibm_cis_transform_rules"
+	cisTransformRulesPhase             = "phase"
+	cisTransformRules                  = "rules"
+	cisTransformRuleID                 = "rule_id"
+	cisTransformRuleDescription        = "description"
+	cisTransformRuleExpression         = "expression"
+	cisTransformRuleEnabled            = "enabled"
+	cisTransformRuleURIPath            = "uri_path"
+	cisTransformRuleURIPathExpression  = "uri_path_expression"
+	cisTransformRuleURIQuery           = "uri_query"
+	cisTransformRuleURIQueryExpression = "uri_query_expression"
+	cisTransformRuleHeaders            = "headers"
+	cisTransformRuleHeaderName         = "name"
+	cisTransformRuleHeaderOperation    = "operation"
+	cisTransformRuleHeaderValue        = "value"
+	cisTransformRuleHeaderExpression   = "expression"
+	cisTransformRulesPhaseURLRewrite   = "http_request_transform"
+)
+
+func ResourceIBMCISTransformRules() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMCISTransformRulesUpdate,
+		Read:     resourceIBMCISTransformRulesRead,
+		Update:   resourceIBMCISTransformRulesUpdate,
+		Delete:   resourceIBMCISTransformRulesDelete,
+		Importer: &schema.ResourceImporter{},
+		Schema: map[string]*schema.Schema{
+			cisID: {
+				Type:        schema.TypeString,
+				Description: "CIS instance crn",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validate.InvokeValidator(ibmCISTransformRules,
+					"cis_id"),
+			},
+			cisDomainID: {
+				Type:             schema.TypeString,
+				Description:      "Associated CIS domain",
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressDomainIDDiff,
+			},
+			cisTransformRulesPhase: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator(ibmCISTransformRules, cisTransformRulesPhase),
+				Description:  "The phase of the transform rules: http_request_transform for the URL rewrites, http_request_late_transform for the request header modifications or http_response_headers_transform for the response header modifications",
+			},
+			cisTransformRules: {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "The transform rules of the phase, in the order of their evaluation",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						cisTransformRuleID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the rule",
+						},
+						cisTransformRuleDescription: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The description of the rule",
+						},
+						cisTransformRuleExpression: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The expression of the requests the rule applies to",
+						},
+						cisTransformRuleEnabled: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether the rule is enabled",
+						},
+						cisTransformRuleURIPath: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The static path the URL path is rewritten to",
+						},
+						cisTransformRuleURIPathExpression: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The expression of the path the URL path is rewritten to",
+						},
+						cisTransformRuleURIQuery: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The static query string the URL query string is rewritten to",
+						},
+						cisTransformRuleURIQueryExpression: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The expression of the query string the URL query string is rewritten to",
+						},
+						cisTransformRuleHeaders: {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "The header modifications of the rule",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									cisTransformRuleHeaderName: {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The name of the header",
+									},
+									cisTransformRuleHeaderOperation: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.InvokeValidator(ibmCISTransformRules, cisTransformRuleHeaderOperation),
+										Description:  "The operation on the header: set, add or remove",
+									},
+									cisTransformRuleHeaderValue: {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The static value of the header",
+									},
+									cisTransformRuleHeaderExpression: {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The expression of the value of the header",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceIBMCISTransformRulesValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cis_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			CloudDataType:              "resource_instance",
+			CloudDataRange:             []string{"service:internet-svcs"},
+			Required:                   true})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 cisTransformRulesPhase,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "http_request_transform, http_request_late_transform, http_response_headers_transform"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 cisTransformRuleHeaderOperation,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "set, add, remove"})
+
+	ibmCISTransformRulesResourceValidator := validate.ResourceValidator{ResourceName: ibmCISTransformRules, Schema: validateSchema}
+	return &ibmCISTransformRulesResourceValidator
+}
+
+func resourceIBMCISTransformRulesUpdate(d *schema.ResourceData, meta interface{}) error {
+	cisClient, err := meta.(conns.ClientSession).CisDomainSettingsClientSession()
+	if err != nil {
+		return err
+	}
+	crn := d.Get(cisID).(string)
+	zoneID, _, _ := flex.ConvertTftoCisTwoVar(d.Get(cisDomainID).(string))
+	phase := d.Get(cisTransformRulesPhase).(string)
+
+	rules := []map[string]interface{}{}
+	for _, r := range d.Get(cisTransformRules).([]interface{}) {
+		rule, err := expandCISTransformRule(phase, r.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		rules = append(rules, rule)
+	}
+
+	_, resp, err := cisZoneRequest(cisClient.Service, crn, zoneID, core.PUT, "/rulesets/phases/"+phase+"/entrypoint", map[string]interface{}{
+		"rules": rules,
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error updating the %s transform rules: %s %s", phase, err, resp)
+	}
+	d.SetId(flex.ConvertCisToTfThreeVar(phase, zoneID, crn))
+	return resourceIBMCISTransformRulesRead(d, meta)
+}
+
+func resourceIBMCISTransformRulesRead(d *schema.ResourceData, meta interface{}) error {
+	cisClient, err := meta.(conns.ClientSession).CisDomainSettingsClientSession()
+	if err != nil {
+		return err
+	}
+	phase, zoneID, crn, err := flex.ConvertTfToCisThreeVar(d.Id())
+	if err != nil {
+		return err
+	}
+
+	rawResult, resp, err := cisZoneRequest(cisClient.Service, crn, zoneID, core.GET, "/rulesets/phases/"+phase+"/entrypoint", nil)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[WARN] The %s transform rules of the zone %s are not found", phase, zoneID)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error getting the %s transform rules: %s %s", phase, err, resp)
+	}
+	var ruleset struct {
+		Rules []map[string]interface{} `json:"rules"`
+	}
+	if rawResult != nil {
+		if err := json.Unmarshal(rawResult, &ruleset); err != nil {
+			return err
+		}
+	}
+
+	rules := make([]map[string]interface{}, 0, len(ruleset.Rules))
+	for _, rule := range ruleset.Rules {
+		rules = append(rules, flattenCISTransformRule(rule))
+	}
+	d.Set(cisID, crn)
+	d.Set(cisDomainID, zoneID)
+	d.Set(cisTransformRulesPhase, phase)
+	d.Set(cisTransformRules, rules)
+	return nil
+}
+
+func resourceIBMCISTransformRulesDelete(d *schema.ResourceData, meta interface{}) error {
+	cisClient, err := meta.(conns.ClientSession).CisDomainSettingsClientSession()
+	if err != nil {
+		return err
+	}
+	phase, zoneID, crn, err := flex.ConvertTfToCisThreeVar(d.Id())
+	if err != nil {
+		return err
+	}
+	_, resp, err := cisZoneRequest(cisClient.Service, crn, zoneID, core.PUT, "/rulesets/phases/"+phase+"/entrypoint", map[string]interface{}{
+		"rules": []interface{}{},
+	})
+	if err != nil && (resp == nil || resp.StatusCode != 404) {
+		return fmt.Errorf("[ERROR] Error deleting the %s transform rules: %s %s", phase, err, resp)
+	}
+	d.SetId("")
+	return nil
+}
+
+func expandCISTransformRule(phase string, r map[string]interface{}) (map[string]interface{}, error) {
+	actionParameters := map[string]interface{}{}
+	if phase == cisTransformRulesPhaseURLRewrite {
+		uri := map[string]interface{}{}
+		if path := cisTransformValue(r[cisTransformRuleURIPath].(string), r[cisTransformRuleURIPathExpression].(string)); path != nil {
+			uri["path"] = path
+		}
+		if query := cisTransformValue(r[cisTransformRuleURIQuery].(string), r[cisTransformRuleURIQueryExpression].(string)); query != nil {
+			uri["query"] = query
+		}
+		if len(uri) == 0 {
+			return nil, fmt.Errorf("[ERROR] The URL rewrite rule %q must rewrite the path or the query string", r[cisTransformRuleExpression])
+		}
+		actionParameters["uri"] = uri
+	} else {
+		headers := map[string]interface{}{}
+		for _, h := range r[cisTransformRuleHeaders].(*schema.Set).List() {
+			header := h.(map[string]interface{})
+			operation := header[cisTransformRuleHeaderOperation].(string)
+			modification := map[string]interface{}{
+				"operation": operation,
+			}
+			if operation != "remove" {
+				value := cisTransformValue(header[cisTransformRuleHeaderValue].(string), header[cisTransformRuleHeaderExpression].(string))
+				if value == nil {
+					return nil, fmt.Errorf("[ERROR] The %s operation of the header %s must have a value or an expression", operation, header[cisTransformRuleHeaderName])
+				}
+				for k, v := range value {
+					modification[k] = v
+				}
+			}
+			headers[header[cisTransformRuleHeaderName].(string)] = modification
+		}
+		if len(headers) == 0 {
+			return nil, fmt.Errorf("[ERROR] The header modification rule %q must modify at least one header", r[cisTransformRuleExpression])
+		}
+		actionParameters["headers"] = headers
+	}
+
+	rule := map[string]interface{}{
+		"action":            "rewrite",
+		"action_parameters": actionParameters,
+		"expression":        r[cisTransformRuleExpression].(string),
+		"enabled":           r[cisTransformRuleEnabled].(bool),
+	}
+	if description := r[cisTransformRuleDescription].(string); description != "" {
+		rule["description"] = description
+	}
+	return rule, nil
+}
+
+// cisTransformValue returns the static value or the expression of a rewrite, or nil when neither is set
+func cisTransformValue(value, expression string) map[string]interface{} {
+	if expression != "" {
+		return map[string]interface{}{"expression": expression}
+	}
+	if value != "" {
+		return map[string]interface{}{"value": value}
+	}
+	return nil
+}
+
+func flattenCISTransformRule(rule map[string]interface{}) map[string]interface{} {
+	r := map[string]interface{}{
+		cisTransformRuleID:          rule["id"],
+		cisTransformRuleDescription: rule["description"],
+		cisTransformRuleExpression:  rule["expression"],
+		cisTransformRuleEnabled:     rule["enabled"],
+	}
+	actionParameters, _ := rule["action_parameters"].(map[string]interface{})
+	if uri, ok := actionParameters["uri"].(map[string]interface{}); ok {
+		if path, ok := uri["path"].(map[string]interface{}); ok {
+			r[cisTransformRuleURIPath] = path["value"]
+			r[cisTransformRuleURIPathExpression] = path["expression"]
+		}
+		if query, ok := uri["query"].(map[string]interface{}); ok {
+			r[cisTransformRuleURIQuery] = query["value"]
+			r[cisTransformRuleURIQueryExpression] = query["expression"]
+		}
+	}
+	if headers, ok := actionParameters["headers"].(map[string]interface{}); ok {
+		names := make([]string, 0, len(headers))
+		for name := range headers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		modifications := make([]map[string]interface{}, 0, len(names))
+		for _, name := range names {
+			header, _ := headers[name].(map[string]interface{})
+			modifications = append(modifications, map[string]interface{}{
+				cisTransformRuleHeaderName:       name,
+				cisTransformRuleHeaderOperation:  header["operation"],
+				cisTransformRuleHeaderValue:      header["value"],
+				cisTransformRuleHeaderExpression: header["expression"],
+			})
+		}
+		r[cisTransformRuleHeaders] = modifications
+	}
+	return r
+}
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cis_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCisTransformRules_Basic(t *testing.T) {
+	name := "ibm_cis_transform_rules." + "test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCisTransformRulesConfigBasic("test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "phase", "http_response_headers_transform"),
+					resource.TestCheckResourceAttr(name, "rules.#", "1"),
+					resource.TestCheckResourceAttr(name, "rules.0.headers.#", "2"),
+					resource.TestCheckResourceAttrSet(name, "rules.0.rule_id"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIBMCisManagedTransforms_Basic(t *testing.T) {
+	name := "ibm_cis_managed_transforms." + "test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCis(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCisManagedTransformsConfigBasic("test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "managed_request_headers.#", "1"),
+					resource.TestCheckResourceAttr(name, "managed_response_headers.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCisTransformRulesConfigBasic(id string) string {
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
+	resource "ibm_cis_transform_rules" "%[1]s" {
+		cis_id    = data.ibm_cis.cis.id
+		domain_id = data.ibm_cis_domain.cis_domain.domain_id
+		phase     = "http_response_headers_transform"
+		rules {
+			description = "security headers"
+			expression  = "true"
+			headers {
+				name      = "X-Frame-Options"
+				operation = "set"
+				value     = "DENY"
+			}
+			headers {
+				name      = "Server"
+				operation = "remove"
+			}
+		}
+	}
+`, id)
+}
+
+func testAccCheckCisManagedTransformsConfigBasic(id string) string {
+	return testAccCheckIBMCisDomainDataSourceConfigBasic1() + fmt.Sprintf(`
+	resource "ibm_cis_managed_transforms" "%[1]s" {
+		cis_id                   = data.ibm_cis.cis.id
+		domain_id                = data.ibm_cis_domain.cis_domain.domain_id
+		managed_request_headers  = ["add_true_client_ip_headers"]
+		managed_response_headers = ["remove_x-powered-by_header"]
+	}
+`, id)
+}
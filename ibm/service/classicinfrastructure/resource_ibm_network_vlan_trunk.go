@@ -0,0 +1,198 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package classicinfrastructure
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func ResourceIBMNetworkVlanTrunk() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMNetworkVlanTrunkCreate,
+		Read:     resourceIBMNetworkVlanTrunkRead,
+		Update:   resourceIBMNetworkVlanTrunkUpdate,
+		Delete:   resourceIBMNetworkVlanTrunkDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"hardware_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the bare metal server",
+			},
+			"network": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "private",
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "The network of the uplink that the VLANs are trunked to, public or private",
+			},
+			"vlan_ids": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Set:         schema.HashInt,
+				Description: "The IDs of the VLANs that are trunked to the uplink",
+			},
+			"network_component_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the network component of the uplink",
+			},
+		},
+	}
+}
+
+func resourceIBMNetworkVlanTrunkCreate(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(conns.ClientSession).SoftLayerSession()
+
+	hardwareID := d.Get("hardware_id").(int)
+	network := d.Get("network").(string)
+
+	componentID, err := getHardwareNetworkComponentID(meta, hardwareID, network)
+	if err != nil {
+		return err
+	}
+
+	vlans := expandNetworkVlans(d.Get("vlan_ids").(*schema.Set).List())
+	_, err = services.GetNetworkComponentService(sess).Id(componentID).AddNetworkVlanTrunks(vlans)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error adding VLAN trunks to the %s uplink of the bare metal server %d: %s", network, hardwareID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d/%s", hardwareID, network))
+
+	return resourceIBMNetworkVlanTrunkRead(d, meta)
+}
+
+func resourceIBMNetworkVlanTrunkRead(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(conns.ClientSession).SoftLayerSession()
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	if len(parts) < 2 {
+		return fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of hardwareID/network", d.Id())
+	}
+	hardwareID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return fmt.Errorf("[ERROR] Not a valid hardware ID, must be an integer: %s", err)
+	}
+	network := parts[1]
+
+	componentID, err := getHardwareNetworkComponentID(meta, hardwareID, network)
+	if err != nil {
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			log.Printf("[WARN] Bare metal server %d is not found, removing the VLAN trunks from state", hardwareID)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	trunks, err := services.GetNetworkComponentService(sess).Id(componentID).Mask("networkVlanId").GetNetworkVlanTrunks()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving the VLAN trunks of the %s uplink of the bare metal server %d: %s", network, hardwareID, err)
+	}
+
+	vlanIDs := make([]int, 0, len(trunks))
+	for _, trunk := range trunks {
+		if trunk.NetworkVlanId != nil {
+			vlanIDs = append(vlanIDs, *trunk.NetworkVlanId)
+		}
+	}
+
+	d.Set("hardware_id", hardwareID)
+	d.Set("network", network)
+	d.Set("network_component_id", componentID)
+	d.Set("vlan_ids", flex.FlattenIntList(vlanIDs))
+
+	return nil
+}
+
+func resourceIBMNetworkVlanTrunkUpdate(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(conns.ClientSession).SoftLayerSession()
+
+	if d.HasChange("vlan_ids") {
+		componentID := d.Get("network_component_id").(int)
+		service := services.GetNetworkComponentService(sess).Id(componentID)
+
+		o, n := d.GetChange("vlan_ids")
+		removed := o.(*schema.Set).Difference(n.(*schema.Set)).List()
+		added := n.(*schema.Set).Difference(o.(*schema.Set)).List()
+
+		if len(removed) > 0 {
+			if _, err := service.RemoveNetworkVlanTrunks(expandNetworkVlans(removed)); err != nil {
+				return fmt.Errorf("[ERROR] Error removing VLAN trunks from the network component %d: %s", componentID, err)
+			}
+		}
+		if len(added) > 0 {
+			if _, err := service.AddNetworkVlanTrunks(expandNetworkVlans(added)); err != nil {
+				return fmt.Errorf("[ERROR] Error adding VLAN trunks to the network component %d: %s", componentID, err)
+			}
+		}
+	}
+
+	return resourceIBMNetworkVlanTrunkRead(d, meta)
+}
+
+func resourceIBMNetworkVlanTrunkDelete(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(conns.ClientSession).SoftLayerSession()
+
+	componentID := d.Get("network_component_id").(int)
+	vlans := expandNetworkVlans(d.Get("vlan_ids").(*schema.Set).List())
+	if len(vlans) > 0 {
+		_, err := services.GetNetworkComponentService(sess).Id(componentID).RemoveNetworkVlanTrunks(vlans)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error removing VLAN trunks from the network component %d: %s", componentID, err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// getHardwareNetworkComponentID returns the ID of the primary network component of the public or private uplink of a bare metal server
+func getHardwareNetworkComponentID(meta interface{}, hardwareID int, network string) (int, error) {
+	service := services.GetHardwareService(meta.(conns.ClientSession).SoftLayerSession())
+
+	hardware, err := service.Id(hardwareID).Mask("id,primaryNetworkComponent[id],primaryBackendNetworkComponent[id]").GetObject()
+	if err != nil {
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			return 0, err
+		}
+		return 0, fmt.Errorf("[ERROR] Error retrieving the bare metal server %d: %s", hardwareID, err)
+	}
+
+	component := hardware.PrimaryBackendNetworkComponent
+	if network == "public" {
+		component = hardware.PrimaryNetworkComponent
+	}
+	if component == nil || component.Id == nil {
+		return 0, fmt.Errorf("[ERROR] The bare metal server %d has no %s network component", hardwareID, network)
+	}
+
+	return *component.Id, nil
+}
+
+func expandNetworkVlans(ids []interface{}) []datatypes.Network_Vlan {
+	vlans := make([]datatypes.Network_Vlan, 0, len(ids))
+	for _, id := range ids {
+		vlans = append(vlans, datatypes.Network_Vlan{Id: sl.Int(id.(int))})
+	}
+	return vlans
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package classicinfrastructure_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMNetworkVlanTrunk_Basic(t *testing.T) {
+	hostname := fmt.Sprintf("tfuat%s", acctest.RandString(11))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMNetworkVlanTrunkConfig(hostname, "[ibm_network_vlan.trunk_vlan_1.id]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_network_vlan_trunk.trunk", "network", "private"),
+					resource.TestCheckResourceAttr("ibm_network_vlan_trunk.trunk", "vlan_ids.#", "1"),
+					resource.TestCheckResourceAttrSet("ibm_network_vlan_trunk.trunk", "network_component_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMNetworkVlanTrunkConfig(hostname, "[ibm_network_vlan.trunk_vlan_1.id, ibm_network_vlan.trunk_vlan_2.id]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_network_vlan_trunk.trunk", "vlan_ids.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "ibm_network_vlan_trunk.trunk",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMNetworkVlanTrunkConfig(hostname, vlanIDs string) string {
+	return fmt.Sprintf(`
+resource "ibm_compute_bare_metal" "trunk_bm" {
+  hostname             = "%s"
+  domain               = "terraformuat.ibm.com"
+  os_reference_code    = "UBUNTU_16_64"
+  datacenter           = "dal10"
+  network_speed        = 100
+  hourly_billing       = true
+  private_network_only = false
+  fixed_config_preset  = "S1270_32GB_1X1TBSATA_NORAID"
+}
+
+resource "ibm_network_vlan" "trunk_vlan_1" {
+  name       = "tfuat-trunk-1"
+  datacenter = "dal10"
+  type       = "PRIVATE"
+}
+
+resource "ibm_network_vlan" "trunk_vlan_2" {
+  name       = "tfuat-trunk-2"
+  datacenter = "dal10"
+  type       = "PRIVATE"
+}
+
+resource "ibm_network_vlan_trunk" "trunk" {
+  hardware_id = ibm_compute_bare_metal.trunk_bm.id
+  network     = "private"
+  vlan_ids    = %s
+}
+`, hostname, vlanIDs)
+}
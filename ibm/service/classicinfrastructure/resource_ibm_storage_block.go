@@ -94,6 +94,8 @@ func ResourceIBMStorageBlock() *schema.Resource {
 				Description: "OS formatr type",
 			},
 
+			"snapshot_schedule": resourceIBMStorageSnapshotScheduleSchema(),
+
 			"allowed_virtual_guest_ids": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -430,6 +432,8 @@ func resourceIBMStorageBlockRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("hourly_billing", storage.BillingItem.HourlyFlag)
 	}
 
+	d.Set("snapshot_schedule", flattenStorageSnapshotSchedules(storage.Schedules))
+
 	d.Set("target_address", storage.IscsiTargetIpAddresses)
 	d.Set(flex.ResourceControllerURL, fmt.Sprintf("https://cloud.ibm.com/classic/storage/block/%s", d.Id()))
 	d.Set(flex.ResourceName, *storage.ServiceResourceName)
@@ -493,6 +497,14 @@ func resourceIBMStorageBlockUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
+	// Enable Storage Snapshot Schedule
+	if d.HasChange("snapshot_schedule") {
+		err := enableStorageSnapshot(d, sess, storage)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error creating storage snapshot schedule: %s", err)
+		}
+	}
+
 	if (d.HasChange("capacity") || d.HasChange("iops")) && !d.IsNewResource() {
 		size := d.Get("capacity").(int)
 		iops := d.Get("iops").(float64)
@@ -172,54 +172,7 @@ func ResourceIBMStorageFile() *schema.Resource {
 				Description: "Notes",
 			},
 
-			"snapshot_schedule": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				MaxItems: 3,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"schedule_type": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validate.ValidateScheduleType,
-							Description:  "schedule type",
-						},
-
-						"retention_count": {
-							Type:        schema.TypeInt,
-							Required:    true,
-							Description: "Retention count",
-						},
-
-						"minute": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							ValidateFunc: validate.ValidateMinute(0, 59),
-							Description:  "Time duration in minutes",
-						},
-
-						"hour": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							ValidateFunc: validate.ValidateHour(0, 23),
-							Description:  "Time duration in hour",
-						},
-
-						"day_of_week": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: validate.ValidateDayOfWeek,
-							Description:  "Day of the week",
-						},
-
-						"enable": {
-							Type:     schema.TypeBool,
-							Optional: true,
-						},
-					},
-				},
-				Set: resourceIBMFilSnapshotHash,
-			},
+			"snapshot_schedule": resourceIBMStorageSnapshotScheduleSchema(),
 			"mountpoint": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -420,33 +373,7 @@ func resourceIBMStorageFileRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("hourly_billing", storage.BillingItem.HourlyFlag)
 	}
 
-	schds := make([]interface{}, len(storage.Schedules))
-	for i, schd := range storage.Schedules {
-		s := make(map[string]interface{})
-		s["retention_count"], _ = strconv.Atoi(*schd.RetentionCount)
-		if *schd.Minute != "-1" {
-
-			s["minute"], _ = strconv.Atoi(*schd.Minute)
-		}
-		if *schd.Hour != "-1" {
-			s["hour"], _ = strconv.Atoi(*schd.Hour)
-		}
-		if *schd.Active > 0 {
-			s["enable"], _ = strconv.ParseBool("true")
-		} else {
-			s["enable"], _ = strconv.ParseBool("false")
-		}
-
-		if *schd.DayOfWeek != "-1" {
-			s["day_of_week"] = snapshotDay[*schd.DayOfWeek]
-		}
-
-		stype := *schd.Type.Keyname
-		stype = stype[strings.LastIndex(stype, "_")+1:]
-		s["schedule_type"] = stype
-		schds[i] = s
-	}
-	d.Set("snapshot_schedule", schds)
+	d.Set("snapshot_schedule", flattenStorageSnapshotSchedules(storage.Schedules))
 	d.Set(flex.ResourceControllerURL, fmt.Sprintf("https://cloud.ibm.com/classic/storage/file/%s", d.Id()))
 
 	d.Set(flex.ResourceName, *storage.ServiceResourceName)
@@ -1157,6 +1084,87 @@ func getStorageTypeFromKeyName(key string) (string, error) {
 	return "", fmt.Errorf("[ERROR] Could n't find storage type for key %s", key)
 }
 
+func resourceIBMStorageSnapshotScheduleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		MaxItems: 3,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"schedule_type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.ValidateScheduleType,
+					Description:  "schedule type",
+				},
+
+				"retention_count": {
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Retention count",
+				},
+
+				"minute": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validate.ValidateMinute(0, 59),
+					Description:  "Time duration in minutes",
+				},
+
+				"hour": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validate.ValidateHour(0, 23),
+					Description:  "Time duration in hour",
+				},
+
+				"day_of_week": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validate.ValidateDayOfWeek,
+					Description:  "Day of the week",
+				},
+
+				"enable": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+			},
+		},
+		Set: resourceIBMFilSnapshotHash,
+	}
+}
+
+func flattenStorageSnapshotSchedules(schedules []datatypes.Network_Storage_Schedule) []interface{} {
+	schds := make([]interface{}, len(schedules))
+	for i, schd := range schedules {
+		s := make(map[string]interface{})
+		s["retention_count"], _ = strconv.Atoi(*schd.RetentionCount)
+		if *schd.Minute != "-1" {
+
+			s["minute"], _ = strconv.Atoi(*schd.Minute)
+		}
+		if *schd.Hour != "-1" {
+			s["hour"], _ = strconv.Atoi(*schd.Hour)
+		}
+		if *schd.Active > 0 {
+			s["enable"], _ = strconv.ParseBool("true")
+		} else {
+			s["enable"], _ = strconv.ParseBool("false")
+		}
+
+		if *schd.DayOfWeek != "-1" {
+			s["day_of_week"] = snapshotDay[*schd.DayOfWeek]
+		}
+
+		stype := *schd.Type.Keyname
+		stype = stype[strings.LastIndex(stype, "_")+1:]
+		s["schedule_type"] = stype
+		schds[i] = s
+	}
+	return schds
+}
+
 func resourceIBMFilSnapshotHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
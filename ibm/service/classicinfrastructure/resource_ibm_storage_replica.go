@@ -0,0 +1,276 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package classicinfrastructure
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/softlayer/softlayer-go/datatypes"
+	"github.com/softlayer/softlayer-go/helpers/product"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/session"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func ResourceIBMStorageReplica() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMStorageReplicaCreate,
+		Read:     resourceIBMStorageReplicaRead,
+		Delete:   resourceIBMStorageReplicaDelete,
+		Exists:   resourceIBMStorageReplicaExists,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(45 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"origin_volume_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the file or block storage volume that is replicated",
+			},
+			"datacenter": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The data center of the replica",
+			},
+			"schedule_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateScheduleType,
+				Description:  "The snapshot schedule of the origin volume that drives the replication, HOURLY, DAILY or WEEKLY",
+			},
+			"volumename": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the replica",
+			},
+			"replication_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The replication status of the origin volume",
+			},
+		},
+	}
+}
+
+func resourceIBMStorageReplicaCreate(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(conns.ClientSession).SoftLayerSession()
+
+	originID := d.Get("origin_volume_id").(int)
+	datacenter := d.Get("datacenter").(string)
+	scheduleType := d.Get("schedule_type").(string)
+
+	origin, err := services.GetNetworkStorageService(sess).
+		Id(originID).
+		Mask(storageDetailMask).
+		GetObject()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving the origin volume %d: %s", originID, err)
+	}
+
+	storageType, err := getStorageTypeFromKeyName(*origin.StorageType.KeyName)
+	if err != nil {
+		return err
+	}
+	storageProtocol := blockStorage
+	if strings.Contains(*origin.StorageType.KeyName, "FILE") {
+		storageProtocol = fileStorage
+	}
+	iops, err := getIops(origin, storageType)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving the origin volume %d: %s", originID, err)
+	}
+	capacity := *origin.CapacityGb
+	snapshotCapacity := 0
+	if origin.SnapshotCapacityGb != nil {
+		snapshotCapacity, _ = strconv.Atoi(*origin.SnapshotCapacityGb)
+	}
+	hourlyBilling := origin.BillingItem != nil && origin.BillingItem.HourlyFlag != nil && *origin.BillingItem.HourlyFlag
+
+	scheduleID, err := getStorageSnapshotScheduleID(sess, originID, scheduleType)
+	if err != nil {
+		return err
+	}
+
+	storageOrderContainer, err := buildStorageProductOrderContainer(sess, storageType, iops, capacity, snapshotCapacity, storageProtocol, datacenter, hourlyBilling)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error while creating storage replica: %s", err)
+	}
+	replicationPrice, err := getSaaSReplicationPrice(sess, storageType, iops)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error while creating storage replica: %s", err)
+	}
+	storageOrderContainer.Prices = append(storageOrderContainer.Prices, replicationPrice)
+
+	order := &datatypes.Container_Product_Order_Network_Storage_AsAService{
+		Container_Product_Order: storageOrderContainer,
+		OriginVolumeId:          sl.Int(originID),
+		OriginVolumeScheduleId:  sl.Int(scheduleID),
+		VolumeSize:              &capacity,
+	}
+	if storageType == performanceType {
+		order.Iops = sl.Int(int(iops))
+	}
+	if storageProtocol == blockStorage && origin.OsType != nil {
+		order.OsFormatType = &datatypes.Network_Storage_Iscsi_OS_Type{
+			Id:      origin.OsType.Id,
+			KeyName: origin.OsType.KeyName,
+		}
+	}
+
+	log.Println("[INFO] Creating storage replica")
+
+	receipt, err := services.GetProductOrderService(sess.SetRetries(0)).PlaceOrder(order, sl.Bool(false))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error during creation of storage replica: %s", err)
+	}
+
+	replica, err := findStorageByOrderId(sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error during creation of storage replica: %s", err)
+	}
+	d.SetId(fmt.Sprintf("%d", *replica.Id))
+
+	_, err = WaitForStorageAvailable(d, meta)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for storage replica (%s) to become ready: %s", d.Id(), err)
+	}
+
+	// SoftLayer changes the device ID after completion of provisioning. It is necessary to refresh device ID.
+	replica, err = findStorageByOrderId(sess, *receipt.OrderId, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error during creation of storage replica: %s", err)
+	}
+	d.SetId(fmt.Sprintf("%d", *replica.Id))
+
+	log.Printf("[INFO] Storage replica ID: %s", d.Id())
+
+	return resourceIBMStorageReplicaRead(d, meta)
+}
+
+func resourceIBMStorageReplicaRead(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(conns.ClientSession).SoftLayerSession()
+	replicaID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("[ERROR] Not a valid ID, must be an integer: %s", err)
+	}
+
+	replica, err := services.GetNetworkStorageService(sess).
+		Id(replicaID).
+		Mask("id,username,serviceResource[datacenter[name]],replicationPartners[id]").
+		GetObject()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving storage replica information: %s", err)
+	}
+
+	d.Set("volumename", replica.Username)
+	if replica.ServiceResource != nil && replica.ServiceResource.Datacenter != nil {
+		d.Set("datacenter", replica.ServiceResource.Datacenter.Name)
+	}
+
+	// The replica has a single partner, the origin volume
+	if len(replica.ReplicationPartners) > 0 {
+		originID := *replica.ReplicationPartners[0].Id
+		d.Set("origin_volume_id", originID)
+
+		origin, err := services.GetNetworkStorageService(sess).
+			Id(originID).
+			Mask("id,replicationStatus,replicationSchedule[type[keyname]]").
+			GetObject()
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error retrieving the origin volume %d: %s", originID, err)
+		}
+		d.Set("replication_status", origin.ReplicationStatus)
+		if scheduleType := getStorageReplicationScheduleType(origin.ReplicationSchedule); scheduleType != "" {
+			d.Set("schedule_type", scheduleType)
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMStorageReplicaDelete(d *schema.ResourceData, meta interface{}) error {
+	return resourceIBMStorageFileDelete(d, meta)
+}
+
+func resourceIBMStorageReplicaExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	return resourceIBMStorageFileExists(d, meta)
+}
+
+// getStorageSnapshotScheduleID returns the ID of the snapshot schedule of a volume, which must be enabled to replicate it
+func getStorageSnapshotScheduleID(sess *session.Session, volumeID int, scheduleType string) (int, error) {
+	schedules, err := services.GetNetworkStorageService(sess).
+		Id(volumeID).
+		Mask("id,type[keyname]").
+		GetSchedules()
+	if err != nil {
+		return 0, fmt.Errorf("[ERROR] Error retrieving the snapshot schedules of the volume %d: %s", volumeID, err)
+	}
+
+	keyName := "SNAPSHOT_" + strings.ToUpper(scheduleType)
+	for _, schedule := range schedules {
+		if schedule.Type != nil && schedule.Type.Keyname != nil && *schedule.Type.Keyname == keyName {
+			return *schedule.Id, nil
+		}
+	}
+
+	return 0, fmt.Errorf("[ERROR] The volume %d has no %s snapshot schedule, add it to the snapshot_schedule of the volume before replicating it", volumeID, scheduleType)
+}
+
+// getStorageReplicationScheduleType returns the schedule type, HOURLY, DAILY or WEEKLY, of the replication schedule of a volume
+func getStorageReplicationScheduleType(schedule *datatypes.Network_Storage_Schedule) string {
+	if schedule == nil || schedule.Type == nil || schedule.Type.Keyname == nil {
+		return ""
+	}
+	keyName := *schedule.Type.Keyname
+	for _, prefix := range []string{"SNAPSHOT_", "REPLICATION_"} {
+		keyName = strings.TrimPrefix(keyName, prefix)
+	}
+	return keyName
+}
+
+func getSaaSReplicationPrice(sess *session.Session, storageType string, iops float64) (datatypes.Product_Item_Price, error) {
+	pkg, err := product.GetPackageByType(sess, storagePackageType)
+	if err != nil {
+		return datatypes.Product_Item_Price{}, err
+	}
+
+	productItems, err := product.GetPackageProducts(sess, *pkg.Id, itemMask)
+	if err != nil {
+		return datatypes.Product_Item_Price{}, err
+	}
+
+	keyName := "REPLICATION_FOR_IOPSBASED_PERFORMANCE"
+	restrictionType := "IOPS"
+	restrictionValue := int(iops)
+	if storageType == enduranceType {
+		keyName = "REPLICATION_FOR_TIERBASED_PERFORMANCE"
+		restrictionType = "STORAGE_TIER_LEVEL"
+		restrictionValue = enduranceCapacityRestrictionMap[iops]
+	}
+
+	for _, item := range productItems {
+		if item.KeyName == nil || *item.KeyName != keyName {
+			continue
+		}
+		price := getPrice(item.Prices, "performance_storage_replication", restrictionType, restrictionValue)
+		if price.Id != nil {
+			return price, nil
+		}
+	}
+
+	return datatypes.Product_Item_Price{}, fmt.Errorf("[ERROR] Could not find price for replication")
+}
@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package classicinfrastructure
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/softlayer/softlayer-go/services"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func ResourceIBMStorageReplicaFailover() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMStorageReplicaFailoverCreate,
+		Read:   resourceIBMStorageReplicaFailoverRead,
+		Delete: resourceIBMStorageReplicaFailoverDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"volume_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the origin volume",
+			},
+			"replicant_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the replica that the volume fails over to",
+			},
+			"disaster_recovery": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Fail over to the replica when the origin volume is unreachable. A disaster recovery failover can't be failed back",
+			},
+			"replication_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The replication status of the volume",
+			},
+		},
+	}
+}
+
+func resourceIBMStorageReplicaFailoverCreate(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(conns.ClientSession).SoftLayerSession()
+	service := services.GetNetworkStorageService(sess)
+
+	volumeID := d.Get("volume_id").(int)
+	replicantID := d.Get("replicant_id").(int)
+
+	var err error
+	if d.Get("disaster_recovery").(bool) {
+		_, err = service.Id(volumeID).DisasterRecoveryFailoverToReplicant(sl.Int(replicantID))
+	} else {
+		_, err = service.Id(volumeID).FailoverToReplicant(sl.Int(replicantID))
+	}
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error failing over the volume %d to the replica %d: %s", volumeID, replicantID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d/%d", volumeID, replicantID))
+
+	err = waitForStorageReplicaFailover(meta, volumeID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for the failover of the volume %d: %s", volumeID, err)
+	}
+
+	return resourceIBMStorageReplicaFailoverRead(d, meta)
+}
+
+func resourceIBMStorageReplicaFailoverRead(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(conns.ClientSession).SoftLayerSession()
+	volumeID := d.Get("volume_id").(int)
+
+	volume, err := services.GetNetworkStorageService(sess).
+		Id(volumeID).
+		Mask("id,replicationStatus").
+		GetObject()
+	if err != nil {
+		if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+			log.Printf("[WARN] Volume %d is not found, removing the failover from state", volumeID)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error retrieving the volume %d: %s", volumeID, err)
+	}
+
+	d.Set("replication_status", volume.ReplicationStatus)
+
+	return nil
+}
+
+func resourceIBMStorageReplicaFailoverDelete(d *schema.ResourceData, meta interface{}) error {
+	sess := meta.(conns.ClientSession).SoftLayerSession()
+	volumeID := d.Get("volume_id").(int)
+
+	if d.Get("disaster_recovery").(bool) {
+		log.Printf("[WARN] The disaster recovery failover of the volume %d can't be failed back, removing it from state only", volumeID)
+		d.SetId("")
+		return nil
+	}
+
+	_, err := services.GetNetworkStorageService(sess).Id(volumeID).FailbackFromReplicant()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error failing back the volume %d from its replica: %s", volumeID, err)
+	}
+
+	err = waitForStorageReplicaFailover(meta, volumeID, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for the failback of the volume %d: %s", volumeID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// waitForStorageReplicaFailover waits for the transactions of the failover or failback of a volume to complete
+func waitForStorageReplicaFailover(meta interface{}, volumeID int, timeout time.Duration) error {
+	sess := meta.(conns.ClientSession).SoftLayerSession()
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"retry", "pending"},
+		Target:  []string{"complete"},
+		Refresh: func() (interface{}, string, error) {
+			volume, err := services.GetNetworkStorageService(sess).
+				Id(volumeID).
+				Mask("id,activeTransactionCount").
+				GetObject()
+			if err != nil {
+				if apiErr, ok := err.(sl.Error); ok && apiErr.StatusCode == 404 {
+					return nil, "", fmt.Errorf("[ERROR] Error retrieving the volume %d: %s", volumeID, err)
+				}
+				return false, "retry", nil
+			}
+			if volume.ActiveTransactionCount != nil && *volume.ActiveTransactionCount > 0 {
+				return volume, "pending", nil
+			}
+			return volume, "complete", nil
+		},
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
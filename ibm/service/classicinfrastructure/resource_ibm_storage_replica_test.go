@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package classicinfrastructure_test
+
+import (
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMStorageReplica_Basic(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMStorageReplicaConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMStorageBlockExists("ibm_storage_block.bs_origin"),
+					resource.TestCheckResourceAttr(
+						"ibm_storage_block.bs_origin", "snapshot_schedule.#", "1"),
+					testAccCheckIBMStorageBlockExists("ibm_storage_replica.bs_replica"),
+					resource.TestCheckResourceAttr(
+						"ibm_storage_replica.bs_replica", "datacenter", "dal10"),
+					resource.TestCheckResourceAttrPair(
+						"ibm_storage_replica.bs_replica", "origin_volume_id", "ibm_storage_block.bs_origin", "id"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_storage_replica.bs_replica", "volumename"),
+					resource.TestCheckResourceAttr(
+						"ibm_storage_replica.bs_replica", "schedule_type", "HOURLY"),
+				),
+			},
+			{
+				ResourceName:      "ibm_storage_replica.bs_replica",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCheckIBMStorageReplicaConfig_failover,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"ibm_storage_replica_failover.bs_failover", "replication_status"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckIBMStorageReplicaConfig_basic = `
+resource "ibm_storage_block" "bs_origin" {
+        type = "Endurance"
+        datacenter = "dal09"
+        capacity = 20
+        iops = 0.25
+        snapshot_capacity = 10
+        os_format_type = "Linux"
+
+        snapshot_schedule {
+                schedule_type   = "HOURLY"
+                retention_count = 5
+                minute          = 2
+                enable          = true
+        }
+}
+
+resource "ibm_storage_replica" "bs_replica" {
+        origin_volume_id = ibm_storage_block.bs_origin.id
+        datacenter       = "dal10"
+        schedule_type    = "HOURLY"
+}
+`
+
+const testAccCheckIBMStorageReplicaConfig_failover = testAccCheckIBMStorageReplicaConfig_basic + `
+resource "ibm_storage_replica_failover" "bs_failover" {
+        volume_id    = ibm_storage_block.bs_origin.id
+        replicant_id = ibm_storage_replica.bs_replica.id
+}
+`
@@ -0,0 +1,250 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+func ResourceIBMCloudantDatabaseSecurity() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCloudantDatabaseSecurityUpdate,
+		ReadContext:   resourceIBMCloudantDatabaseSecurityRead,
+		UpdateContext: resourceIBMCloudantDatabaseSecurityUpdate,
+		DeleteContext: resourceIBMCloudantDatabaseSecurityDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_crn": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cloudant Instance CRN.",
+			},
+			"db": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path parameter to specify the database name.",
+			},
+			"admins":  cloudantSecurityObjectSchema("The names and roles of the database admins."),
+			"members": cloudantSecurityObjectSchema("The names and roles of the database members."),
+			"cloudant": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The Cloudant roles of the legacy credentials and API keys of the database.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"principal": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The username or API key, or nobody for unauthenticated access.",
+						},
+						"roles": &schema.Schema{
+							Type:        schema.TypeSet,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The Cloudant roles of the principal, such as _reader, _writer, _admin or _replicator.",
+						},
+					},
+				},
+			},
+			"couchdb_auth_only": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Manage permissions using the `_users` database only.",
+			},
+		},
+	}
+}
+
+func cloudantSecurityObjectSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"names": &schema.Schema{
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "List of usernames.",
+				},
+				"roles": &schema.Schema{
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "List of roles.",
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMCloudantDatabaseSecurityUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Get("instance_crn").(string)
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dbName := d.Get("db").(string)
+	putSecurityOptions := cloudantClient.NewPutSecurityOptions(dbName)
+	putSecurityOptions.Admins = expandCloudantSecurityObject(d.Get("admins").([]interface{}))
+	putSecurityOptions.Members = expandCloudantSecurityObject(d.Get("members").([]interface{}))
+	putSecurityOptions.SetCouchdbAuthOnly(d.Get("couchdb_auth_only").(bool))
+
+	cloudantRoles := make(map[string][]string)
+	for _, c := range d.Get("cloudant").(*schema.Set).List() {
+		principal := c.(map[string]interface{})
+		cloudantRoles[principal["principal"].(string)] = flex.ExpandStringList(principal["roles"].(*schema.Set).List())
+	}
+	if len(cloudantRoles) > 0 {
+		putSecurityOptions.SetCloudant(cloudantRoles)
+	}
+
+	_, response, err := cloudantClient.PutSecurityWithContext(context, putSecurityOptions)
+	if err != nil {
+		log.Printf("[DEBUG] PutSecurityWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("PutSecurityWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceCRN, dbName))
+
+	return resourceIBMCloudantDatabaseSecurityRead(context, d, meta)
+}
+
+func resourceIBMCloudantDatabaseSecurityRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceCRN, dbName := strings.Join(parts[:len(parts)-1], "/"), parts[len(parts)-1]
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getSecurityOptions := cloudantClient.NewGetSecurityOptions(dbName)
+	security, response, err := cloudantClient.GetSecurityWithContext(context, getSecurityOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetSecurityWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetSecurityWithContext failed %s\n%s", err, response))
+	}
+
+	d.Set("instance_crn", instanceCRN)
+	d.Set("db", dbName)
+
+	if err = d.Set("admins", flattenCloudantSecurityObject(security.Admins)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting admins: %s", err))
+	}
+	if err = d.Set("members", flattenCloudantSecurityObject(security.Members)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting members: %s", err))
+	}
+
+	cloudantRoles := make([]map[string]interface{}, 0, len(security.Cloudant))
+	for principal, roles := range security.Cloudant {
+		cloudantRoles = append(cloudantRoles, map[string]interface{}{
+			"principal": principal,
+			"roles":     roles,
+		})
+	}
+	if err = d.Set("cloudant", cloudantRoles); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting cloudant: %s", err))
+	}
+
+	if security.CouchdbAuthOnly != nil {
+		d.Set("couchdb_auth_only", *security.CouchdbAuthOnly)
+	}
+
+	return nil
+}
+
+func resourceIBMCloudantDatabaseSecurityDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceCRN, dbName := strings.Join(parts[:len(parts)-1], "/"), parts[len(parts)-1]
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The security document cannot be deleted, it is reset to an empty document
+	putSecurityOptions := cloudantClient.NewPutSecurityOptions(dbName)
+	putSecurityOptions.Admins = &cloudantv1.SecurityObject{Names: []string{}, Roles: []string{}}
+	putSecurityOptions.Members = &cloudantv1.SecurityObject{Names: []string{}, Roles: []string{}}
+
+	_, response, err := cloudantClient.PutSecurityWithContext(context, putSecurityOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] PutSecurityWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("PutSecurityWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func expandCloudantSecurityObject(l []interface{}) *cloudantv1.SecurityObject {
+	securityObject := &cloudantv1.SecurityObject{Names: []string{}, Roles: []string{}}
+	if len(l) == 0 || l[0] == nil {
+		return securityObject
+	}
+	m := l[0].(map[string]interface{})
+	securityObject.Names = flex.ExpandStringList(m["names"].(*schema.Set).List())
+	securityObject.Roles = flex.ExpandStringList(m["roles"].(*schema.Set).List())
+	return securityObject
+}
+
+func flattenCloudantSecurityObject(securityObject *cloudantv1.SecurityObject) []map[string]interface{} {
+	if securityObject == nil || (len(securityObject.Names) == 0 && len(securityObject.Roles) == 0) {
+		return []map[string]interface{}{}
+	}
+	return []map[string]interface{}{
+		{
+			"names": securityObject.Names,
+			"roles": securityObject.Roles,
+		},
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCloudantDatabaseSecurityBasic(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_instance_%d", acctest.RandIntRange(10, 100))
+	db := fmt.Sprintf("tf_db_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCloudantDatabaseSecurityConfig(instanceName, db, "_reader"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cloudant_database_security.cloudant_database_security", "db", db),
+					resource.TestCheckResourceAttr("ibm_cloudant_database_security.cloudant_database_security", "admins.0.names.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cloudant_database_security.cloudant_database_security", "members.0.roles.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cloudant_database_security.cloudant_database_security", "cloudant.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMCloudantDatabaseSecurityConfig(instanceName, db, "_writer"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cloudant_database_security.cloudant_database_security", "cloudant.#", "1"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_cloudant_database_security.cloudant_database_security",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCloudantDatabaseSecurityConfig(instanceName, db, role string) string {
+	return fmt.Sprintf(`
+
+		data "ibm_resource_group" "cloudant" {
+			is_default=true
+		}
+
+		resource "ibm_cloudant" "cloudant_instance" {
+			name              = "%s"
+			plan              = "standard"
+			location          = "us-south"
+			resource_group_id = data.ibm_resource_group.cloudant.id
+		}
+
+		resource "ibm_cloudant_database" "cloudant_database" {
+			instance_crn = ibm_cloudant.cloudant_instance.crn
+			db = "%s"
+		}
+
+		resource "ibm_cloudant_database_security" "cloudant_database_security" {
+			instance_crn = ibm_cloudant_database.cloudant_database.instance_crn
+			db = ibm_cloudant_database.cloudant_database.db
+			admins {
+				names = ["tf-admin"]
+			}
+			members {
+				roles = ["tf-members"]
+			}
+			cloudant {
+				principal = "nobody"
+				roles = ["%s"]
+			}
+		}
+	`, instanceName, db, role)
+}
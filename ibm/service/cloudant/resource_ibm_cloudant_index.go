@@ -0,0 +1,302 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+func ResourceIBMCloudantIndex() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCloudantIndexCreate,
+		ReadContext:   resourceIBMCloudantIndexRead,
+		DeleteContext: resourceIBMCloudantIndexDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_crn": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cloudant Instance CRN.",
+			},
+			"db": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path parameter to specify the database name.",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Name of the index. If omitted, a name is generated.",
+			},
+			"ddoc": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Name of the design document in which the index is created. If omitted, a design document is generated for the index.",
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "json",
+				ValidateFunc: validation.StringInSlice([]string{"json", "text"}, false),
+				Description:  "The type of the index, json or text.",
+			},
+			"partitioned": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "Whether the index is a partitioned index. Defaults to the partitioning of the database.",
+			},
+			"fields": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The fields of the documents that are indexed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "Name of the field.",
+						},
+						"direction": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"asc", "desc"}, false),
+							Description:  "The sort direction of the field in a json index.",
+						},
+						"type": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"boolean", "number", "string"}, false),
+							Description:  "The type of the field in a text index.",
+						},
+					},
+				},
+			},
+			"partial_filter_selector": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				StateFunc: func(v interface{}) string {
+					json, err := flex.NormalizeJSONString(v)
+					if err != nil {
+						return fmt.Sprintf("%q", err.Error())
+					}
+					return json
+				},
+				Description: "JSON selector that limits the documents that are indexed.",
+			},
+		},
+	}
+}
+
+func resourceIBMCloudantIndexCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Get("instance_crn").(string)
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	indexType := d.Get("type").(string)
+	indexDefinition := &cloudantv1.IndexDefinition{}
+	for _, f := range d.Get("fields").([]interface{}) {
+		field := f.(map[string]interface{})
+		indexField := cloudantv1.IndexField{}
+		if indexType == "text" {
+			indexField.Name = flex.PtrToString(field["name"].(string))
+			if field["type"].(string) != "" {
+				indexField.Type = flex.PtrToString(field["type"].(string))
+			}
+		} else {
+			direction := "asc"
+			if field["direction"].(string) != "" {
+				direction = field["direction"].(string)
+			}
+			indexField.SetProperty(field["name"].(string), &direction)
+		}
+		indexDefinition.Fields = append(indexDefinition.Fields, indexField)
+	}
+	if s, ok := d.GetOk("partial_filter_selector"); ok {
+		var selector map[string]interface{}
+		if err = json.Unmarshal([]byte(s.(string)), &selector); err != nil {
+			return diag.FromErr(fmt.Errorf("partial_filter_selector JSON invalid: %s", err))
+		}
+		indexDefinition.PartialFilterSelector = selector
+	}
+
+	dbName := d.Get("db").(string)
+	postIndexOptions := cloudantClient.NewPostIndexOptions(dbName, indexDefinition)
+	postIndexOptions.SetType(indexType)
+	if name, ok := d.GetOk("name"); ok {
+		postIndexOptions.SetName(name.(string))
+	}
+	if ddoc, ok := d.GetOk("ddoc"); ok {
+		postIndexOptions.SetDdoc(strings.TrimPrefix(ddoc.(string), "_design/"))
+	}
+	if partitioned, ok := d.GetOkExists("partitioned"); ok {
+		postIndexOptions.SetPartitioned(partitioned.(bool))
+	}
+
+	indexResult, response, err := cloudantClient.PostIndexWithContext(context, postIndexOptions)
+	if err != nil {
+		log.Printf("[DEBUG] PostIndexWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("PostIndexWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", instanceCRN, dbName, strings.TrimPrefix(*indexResult.ID, "_design/"), *indexResult.Name))
+
+	return resourceIBMCloudantIndexRead(context, d, meta)
+}
+
+func resourceIBMCloudantIndexRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, dbName, ddoc, indexName, err := cloudantIndexIdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getIndexesInformationOptions := cloudantClient.NewGetIndexesInformationOptions(dbName)
+	indexesInformation, response, err := cloudantClient.GetIndexesInformationWithContext(context, getIndexesInformationOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetIndexesInformationWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetIndexesInformationWithContext failed %s\n%s", err, response))
+	}
+
+	var index *cloudantv1.IndexInformation
+	for i := range indexesInformation.Indexes {
+		info := indexesInformation.Indexes[i]
+		if info.Ddoc != nil && strings.TrimPrefix(*info.Ddoc, "_design/") == ddoc && *info.Name == indexName {
+			index = &info
+			break
+		}
+	}
+	if index == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("instance_crn", instanceCRN)
+	d.Set("db", dbName)
+	d.Set("ddoc", ddoc)
+	d.Set("name", indexName)
+	d.Set("type", index.Type)
+	if index.Partitioned != nil {
+		d.Set("partitioned", *index.Partitioned)
+	}
+
+	if index.Def != nil {
+		fields := make([]map[string]interface{}, 0)
+		for _, indexField := range index.Def.Fields {
+			if indexField.Name != nil {
+				fields = append(fields, map[string]interface{}{
+					"name": *indexField.Name,
+					"type": core.StringNilMapper(indexField.Type),
+				})
+				continue
+			}
+			for name, direction := range indexField.GetProperties() {
+				fields = append(fields, map[string]interface{}{
+					"name":      name,
+					"direction": core.StringNilMapper(direction),
+				})
+			}
+		}
+		if err = d.Set("fields", fields); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting fields: %s", err))
+		}
+		if len(index.Def.PartialFilterSelector) > 0 {
+			selector, err := json.Marshal(index.Def.PartialFilterSelector)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("Error marshalling partial_filter_selector: %s", err))
+			}
+			d.Set("partial_filter_selector", string(selector))
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMCloudantIndexDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, dbName, ddoc, indexName, err := cloudantIndexIdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteIndexOptions := cloudantClient.NewDeleteIndexOptions(dbName, ddoc, d.Get("type").(string), indexName)
+
+	_, response, err := cloudantClient.DeleteIndexWithContext(context, deleteIndexOptions)
+	if err != nil {
+		log.Printf("[DEBUG] DeleteIndexWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteIndexWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// The ID is <instance_crn>/<db>/<ddoc>/<index name>, the instance CRN itself contains a slash
+func cloudantIndexIdParts(id string) (instanceCRN, dbName, ddoc, indexName string, err error) {
+	parts, err := flex.IdParts(id)
+	if err != nil {
+		return
+	}
+	if len(parts) < 4 {
+		err = fmt.Errorf("Incorrect ID %s: ID should be a combination of instanceCRN/db/ddoc/name", id)
+		return
+	}
+	n := len(parts)
+	return strings.Join(parts[:n-3], "/"), parts[n-3], parts[n-2], parts[n-1], nil
+}
@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCloudantIndexJSON(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_instance_%d", acctest.RandIntRange(10, 100))
+	db := fmt.Sprintf("tf_db_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCloudantIndexConfigJSON(instanceName, db),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cloudant_index.cloudant_index", "db", db),
+					resource.TestCheckResourceAttr("ibm_cloudant_index.cloudant_index", "name", "by-email"),
+					resource.TestCheckResourceAttr("ibm_cloudant_index.cloudant_index", "ddoc", "users"),
+					resource.TestCheckResourceAttr("ibm_cloudant_index.cloudant_index", "type", "json"),
+					resource.TestCheckResourceAttr("ibm_cloudant_index.cloudant_index", "fields.0.name", "email"),
+					resource.TestCheckResourceAttr("ibm_cloudant_index.cloudant_index", "fields.0.direction", "asc"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_cloudant_index.cloudant_index",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIBMCloudantIndexText(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_instance_%d", acctest.RandIntRange(10, 100))
+	db := fmt.Sprintf("tf_db_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCloudantIndexConfigText(instanceName, db),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cloudant_index.cloudant_index", "type", "text"),
+					resource.TestCheckResourceAttr("ibm_cloudant_index.cloudant_index", "fields.0.name", "description"),
+					resource.TestCheckResourceAttr("ibm_cloudant_index.cloudant_index", "fields.0.type", "string"),
+					resource.TestCheckResourceAttrSet("ibm_cloudant_index.cloudant_index", "name"),
+					resource.TestCheckResourceAttrSet("ibm_cloudant_index.cloudant_index", "ddoc"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCloudantIndexConfigJSON(instanceName, db string) string {
+	return fmt.Sprintf(`
+
+		data "ibm_resource_group" "cloudant" {
+			is_default=true
+		}
+
+		resource "ibm_cloudant" "cloudant_instance" {
+			name              = "%s"
+			plan              = "standard"
+			location          = "us-south"
+			resource_group_id = data.ibm_resource_group.cloudant.id
+		}
+
+		resource "ibm_cloudant_database" "cloudant_database" {
+			instance_crn = ibm_cloudant.cloudant_instance.crn
+			db = "%s"
+		}
+
+		resource "ibm_cloudant_index" "cloudant_index" {
+			instance_crn = ibm_cloudant_database.cloudant_database.instance_crn
+			db = ibm_cloudant_database.cloudant_database.db
+			name = "by-email"
+			ddoc = "users"
+			fields {
+				name = "email"
+				direction = "asc"
+			}
+			partial_filter_selector = jsonencode({
+				type = "user"
+			})
+		}
+	`, instanceName, db)
+}
+
+func testAccCheckIBMCloudantIndexConfigText(instanceName, db string) string {
+	return fmt.Sprintf(`
+
+		data "ibm_resource_group" "cloudant" {
+			is_default=true
+		}
+
+		resource "ibm_cloudant" "cloudant_instance" {
+			name              = "%s"
+			plan              = "standard"
+			location          = "us-south"
+			resource_group_id = data.ibm_resource_group.cloudant.id
+		}
+
+		resource "ibm_cloudant_database" "cloudant_database" {
+			instance_crn = ibm_cloudant.cloudant_instance.crn
+			db = "%s"
+		}
+
+		resource "ibm_cloudant_index" "cloudant_index" {
+			instance_crn = ibm_cloudant_database.cloudant_database.instance_crn
+			db = ibm_cloudant_database.cloudant_database.db
+			type = "text"
+			fields {
+				name = "description"
+				type = "string"
+			}
+		}
+	`, instanceName, db)
+}
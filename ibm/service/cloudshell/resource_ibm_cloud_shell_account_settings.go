@@ -29,9 +29,10 @@ func ResourceIBMCloudShellAccountSettings() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"account_id": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
-				Description: "The account ID in which the account settings belong to.",
+				Description: "The account ID in which the account settings belong to. The default is the account of the provider.",
 			},
 			"rev": {
 				Type:        schema.TypeString,
@@ -129,20 +130,40 @@ func resourceIBMCloudShellAccountSettingsCreate(context context.Context, d *sche
 		return diag.FromErr(err)
 	}
 
+	accountID := d.Get("account_id").(string)
+	if accountID == "" {
+		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		accountID = userDetails.UserAccount
+	}
+
 	updateAccountSettingsOptions := &ibmcloudshellv1.UpdateAccountSettingsOptions{}
 
-	updateAccountSettingsOptions.SetAccountID(d.Get("account_id").(string))
+	updateAccountSettingsOptions.SetAccountID(accountID)
 	if _, ok := d.GetOk("rev"); ok {
 		updateAccountSettingsOptions.SetRev(d.Get("rev").(string))
+	} else {
+		// The settings of an account always exist, they are updated from their current revision
+		getAccountSettingsOptions := &ibmcloudshellv1.GetAccountSettingsOptions{}
+		getAccountSettingsOptions.SetAccountID(accountID)
+		accountSettings, response, err := ibmCloudShellClient.GetAccountSettingsWithContext(context, getAccountSettingsOptions)
+		if err != nil {
+			log.Printf("[DEBUG] GetAccountSettingsWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("GetAccountSettingsWithContext failed %s\n%s", err, response))
+		}
+		updateAccountSettingsOptions.Rev = accountSettings.Rev
 	}
-	if _, ok := d.GetOk("default_enable_new_features"); ok {
-		updateAccountSettingsOptions.SetDefaultEnableNewFeatures(d.Get("default_enable_new_features").(bool))
+	// The settings that are false are sent too, so that Cloud Shell can be disabled
+	if v, ok := d.GetOkExists("default_enable_new_features"); ok {
+		updateAccountSettingsOptions.SetDefaultEnableNewFeatures(v.(bool))
 	}
-	if _, ok := d.GetOk("default_enable_new_regions"); ok {
-		updateAccountSettingsOptions.SetDefaultEnableNewRegions(d.Get("default_enable_new_regions").(bool))
+	if v, ok := d.GetOkExists("default_enable_new_regions"); ok {
+		updateAccountSettingsOptions.SetDefaultEnableNewRegions(v.(bool))
 	}
-	if _, ok := d.GetOk("enabled"); ok {
-		updateAccountSettingsOptions.SetEnabled(d.Get("enabled").(bool))
+	if v, ok := d.GetOkExists("enabled"); ok {
+		updateAccountSettingsOptions.SetEnabled(v.(bool))
 	}
 	if _, ok := d.GetOk("features"); ok {
 		var features []ibmcloudshellv1.Feature
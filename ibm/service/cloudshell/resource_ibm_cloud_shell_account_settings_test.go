@@ -81,6 +81,37 @@ func TestAccIBMCloudShellAccountSettingsAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIBMCloudShellAccountSettingsDefaultAccount(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCloudShell(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCloudShellAccountSettingsConfigDefaultAccount(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cloud_shell_account_settings.cloud_shell_account_settings", "account_id", acc.CloudShellAccountID),
+					resource.TestCheckResourceAttrSet("ibm_cloud_shell_account_settings.cloud_shell_account_settings", "rev"),
+					resource.TestCheckResourceAttr("ibm_cloud_shell_account_settings.cloud_shell_account_settings", "enabled", "false"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCloudShellAccountSettingsConfigDefaultAccount(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cloud_shell_account_settings.cloud_shell_account_settings", "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCloudShellAccountSettingsConfigDefaultAccount(enabled bool) string {
+	return fmt.Sprintf(`
+	resource "ibm_cloud_shell_account_settings" "cloud_shell_account_settings" {
+		enabled = %t
+	}
+	`, enabled)
+}
+
 func testAccCheckIBMCloudShellAccountSettingsConfigBasic(accountID string) string {
 	return fmt.Sprintf(`
 	data "ibm_cloud_shell_account_settings" "account_settings" {
@@ -4,9 +4,18 @@
 package codeengine
 
 import (
+	"archive/tar"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -26,6 +35,8 @@ func ResourceIbmCodeEngineBuild() *schema.Resource {
 		DeleteContext: resourceIbmCodeEngineBuildDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		CustomizeDiff: resourceIbmCodeEngineBuildCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"project_id": &schema.Schema{
 				Type:         schema.TypeString,
@@ -49,9 +60,15 @@ func ResourceIbmCodeEngineBuild() *schema.Resource {
 			},
 			"output_secret": &schema.Schema{
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validate.InvokeValidator("ibm_code_engine_build", "output_secret"),
-				Description:  "The secret that is required to access the image registry. Make sure that the secret is granted with push permissions towards the specified container registry namespace.",
+				Description:  "The secret that is required to access the image registry. Make sure that the secret is granted with push permissions towards the specified container registry namespace. If omitted for an image in IBM Cloud Container Registry, a registry secret is created in the project with the API key of the provider.",
+			},
+			"output_secret_created": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the registry secret in `output_secret` was created for the build, and is deleted with the build.",
 			},
 			"strategy_type": &schema.Schema{
 				Type:         schema.TypeString,
@@ -84,6 +101,16 @@ func ResourceIbmCodeEngineBuild() *schema.Resource {
 				ValidateFunc: validate.InvokeValidator("ibm_code_engine_build", "source_type"),
 				Description:  "Specifies the type of source to determine if your build source is in a repository or based on local source code.* local - For builds from local source code.* git - For builds from git version controlled source code.",
 			},
+			"source_path": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The path to a local directory with the source code of a build with `source_type` `local`. The directory is archived and hashed to detect changes of the source code.",
+			},
+			"source_hash": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA-256 hash of the archive of the directory in `source_path`.",
+			},
 			"source_url": &schema.Schema{
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -256,12 +283,10 @@ func ResourceIbmCodeEngineBuildValidator() *validate.ResourceValidator {
 		},
 		validate.ValidateSchema{
 			Identifier:                 "strategy_size",
-			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
 			Type:                       validate.TypeString,
 			Optional:                   true,
-			Regexp:                     `[\S]*`,
-			MinValueLength:             1,
-			MaxValueLength:             253,
+			AllowedValues:              "small, medium, large, xlarge, xxlarge",
 		},
 		validate.ValidateSchema{
 			Identifier:                 "strategy_spec_file",
@@ -297,6 +322,16 @@ func resourceIbmCodeEngineBuildCreate(context context.Context, d *schema.Resourc
 	createBuildOptions.SetProjectID(d.Get("project_id").(string))
 	createBuildOptions.SetName(d.Get("name").(string))
 	createBuildOptions.SetOutputImage(d.Get("output_image").(string))
+	outputSecretCreated := false
+	if d.GetRawConfig().AsValueMap()["output_secret"].IsNull() {
+		secretName, err := resourceIbmCodeEngineBuildCreateRegistrySecret(context, codeEngineClient, d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("output_secret", secretName)
+		outputSecretCreated = true
+	}
+	d.Set("output_secret_created", outputSecretCreated)
 	createBuildOptions.SetOutputSecret(d.Get("output_secret").(string))
 	createBuildOptions.SetStrategyType(d.Get("strategy_type").(string))
 	if _, ok := d.GetOk("source_context_dir"); ok {
@@ -572,6 +607,17 @@ func resourceIbmCodeEngineBuildDelete(context context.Context, d *schema.Resourc
 		return diag.FromErr(fmt.Errorf("DeleteBuildWithContext failed %s\n%s", err, response))
 	}
 
+	if d.Get("output_secret_created").(bool) {
+		deleteSecretOptions := &codeenginev2.DeleteSecretOptions{}
+		deleteSecretOptions.SetProjectID(parts[0])
+		deleteSecretOptions.SetName(d.Get("output_secret").(string))
+		response, err := codeEngineClient.DeleteSecretWithContext(context, deleteSecretOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			log.Printf("[DEBUG] DeleteSecretWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("DeleteSecretWithContext failed %s\n%s", err, response))
+		}
+	}
+
 	d.SetId("")
 
 	return nil
@@ -584,3 +630,145 @@ func resourceIbmCodeEngineBuildBuildStatusToMap(model *codeenginev2.BuildStatus)
 	}
 	return modelMap, nil
 }
+
+func resourceIbmCodeEngineBuildCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.GetRawConfig().AsValueMap()["output_secret"].IsNull() && diff.Get("output_secret").(string) == "" {
+		if outputImage := diff.Get("output_image").(string); outputImage != "" && !isIbmCodeEngineBuildICRImage(outputImage) {
+			return fmt.Errorf("output_secret is required when output_image is not in IBM Cloud Container Registry")
+		}
+	}
+
+	sourcePath := diff.Get("source_path").(string)
+	if sourcePath == "" {
+		if diff.Get("source_hash").(string) != "" {
+			return diff.SetNew("source_hash", "")
+		}
+		return nil
+	}
+	if diff.Get("source_type").(string) != "local" {
+		return fmt.Errorf("source_path requires source_type local")
+	}
+	sourceHash, err := resourceIbmCodeEngineBuildSourceHash(sourcePath)
+	if err != nil {
+		return err
+	}
+	if sourceHash != diff.Get("source_hash").(string) {
+		return diff.SetNew("source_hash", sourceHash)
+	}
+	return nil
+}
+
+// resourceIbmCodeEngineBuildSourceHash returns the SHA-256 hash of a tar archive of the directory. The entries
+// are sorted and their modification times, owners and groups are omitted, so that the hash only changes with
+// the paths, modes and contents of the files.
+func resourceIbmCodeEngineBuildSourceHash(sourcePath string) (string, error) {
+	info, err := os.Stat(sourcePath)
+	if err != nil {
+		return "", fmt.Errorf("Error reading source_path: %s", err)
+	}
+	if !info.IsDir() {
+		return "", fmt.Errorf("source_path %s is not a directory", sourcePath)
+	}
+
+	paths := []string{}
+	err = filepath.Walk(sourcePath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if path != sourcePath {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("Error reading source_path: %s", err)
+	}
+	sort.Strings(paths)
+
+	hash := sha256.New()
+	tarWriter := tar.NewWriter(hash)
+	for _, path := range paths {
+		info, err := os.Lstat(path)
+		if err != nil {
+			return "", err
+		}
+		link := ""
+		if info.Mode()&os.ModeSymlink != 0 {
+			if link, err = os.Readlink(path); err != nil {
+				return "", err
+			}
+		}
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return "", err
+		}
+		relPath, err := filepath.Rel(sourcePath, path)
+		if err != nil {
+			return "", err
+		}
+		header.Name = filepath.ToSlash(relPath)
+		header.ModTime = time.Time{}
+		header.AccessTime = time.Time{}
+		header.ChangeTime = time.Time{}
+		header.Uid, header.Gid = 0, 0
+		header.Uname, header.Gname = "", ""
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return "", err
+		}
+		if info.Mode().IsRegular() {
+			file, err := os.Open(path)
+			if err != nil {
+				return "", err
+			}
+			_, err = io.Copy(tarWriter, file)
+			file.Close()
+			if err != nil {
+				return "", err
+			}
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func isIbmCodeEngineBuildICRImage(outputImage string) bool {
+	server := strings.SplitN(outputImage, "/", 2)[0]
+	return server == "icr.io" || strings.HasSuffix(server, ".icr.io")
+}
+
+// resourceIbmCodeEngineBuildCreateRegistrySecret creates a registry secret in the project of the build, with
+// push access to the IBM Cloud Container Registry of the output image using the API key of the provider.
+func resourceIbmCodeEngineBuildCreateRegistrySecret(context context.Context, codeEngineClient *codeenginev2.CodeEngineV2, d *schema.ResourceData, meta interface{}) (string, error) {
+	outputImage := d.Get("output_image").(string)
+	if !isIbmCodeEngineBuildICRImage(outputImage) {
+		return "", fmt.Errorf("output_secret is required when output_image is not in IBM Cloud Container Registry")
+	}
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return "", err
+	}
+	apiKey := bxSession.Config.BluemixAPIKey
+	if apiKey == "" {
+		return "", fmt.Errorf("output_secret is required when the provider is not configured with an API key")
+	}
+
+	secretName := fmt.Sprintf("%s-registry", d.Get("name").(string))
+	createSecretOptions := &codeenginev2.CreateSecretOptions{}
+	createSecretOptions.SetProjectID(d.Get("project_id").(string))
+	createSecretOptions.SetName(secretName)
+	createSecretOptions.SetFormat("registry")
+	createSecretOptions.SetData(&codeenginev2.SecretDataRegistrySecretData{
+		Username: core.StringPtr("iamapikey"),
+		Password: core.StringPtr(apiKey),
+		Server:   core.StringPtr(strings.SplitN(outputImage, "/", 2)[0]),
+		Email:    core.StringPtr(""),
+	})
+	_, response, err := codeEngineClient.CreateSecretWithContext(context, createSecretOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateSecretWithContext failed %s\n%s", err, response)
+		return "", fmt.Errorf("CreateSecretWithContext failed %s\n%s", err, response)
+	}
+	return secretName, nil
+}
@@ -5,6 +5,8 @@ package codeengine_test
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -131,3 +133,64 @@ func testAccCheckIbmCodeEngineBuildDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func TestAccIbmCodeEngineBuildLocalSource(t *testing.T) {
+	var conf codeenginev2.Build
+	name := fmt.Sprintf("tf-build-local-%d", acctest.RandIntRange(10, 1000))
+	outputImage := fmt.Sprintf("private.us.icr.io/ce-terraform-test/%s", name)
+	projectID := acc.CeProjectId
+
+	sourcePath := t.TempDir()
+	dockerfile := filepath.Join(sourcePath, "Dockerfile")
+	if err := os.WriteFile(dockerfile, []byte("FROM busybox\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmCodeEngineBuildDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmCodeEngineBuildConfigLocalSource(projectID, name, outputImage, sourcePath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmCodeEngineBuildExists("ibm_code_engine_build.code_engine_build_instance", conf),
+					resource.TestCheckResourceAttr("ibm_code_engine_build.code_engine_build_instance", "source_type", "local"),
+					resource.TestCheckResourceAttr("ibm_code_engine_build.code_engine_build_instance", "strategy_size", "large"),
+					resource.TestCheckResourceAttr("ibm_code_engine_build.code_engine_build_instance", "output_secret", name+"-registry"),
+					resource.TestCheckResourceAttr("ibm_code_engine_build.code_engine_build_instance", "output_secret_created", "true"),
+					resource.TestCheckResourceAttrSet("ibm_code_engine_build.code_engine_build_instance", "source_hash"),
+				),
+			},
+			resource.TestStep{
+				PreConfig: func() {
+					if err := os.WriteFile(dockerfile, []byte("FROM busybox\nRUN echo updated\n"), 0644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             testAccCheckIbmCodeEngineBuildConfigLocalSource(projectID, name, outputImage, sourcePath),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIbmCodeEngineBuildConfigLocalSource(projectID string, name string, outputImage string, sourcePath string) string {
+	return fmt.Sprintf(`
+		data "ibm_code_engine_project" "code_engine_project_instance" {
+			project_id = "%s"
+		}
+
+		resource "ibm_code_engine_build" "code_engine_build_instance" {
+			project_id    = data.ibm_code_engine_project.code_engine_project_instance.project_id
+			name          = "%s"
+			output_image  = "%s"
+			source_type   = "local"
+			source_path   = "%s"
+			strategy_type = "dockerfile"
+			strategy_size = "large"
+			timeout       = 1200
+		}
+	`, projectID, name, outputImage, sourcePath)
+}
@@ -0,0 +1,420 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package contextbasedrestrictions
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+)
+
+const (
+	cbrEnforcementModeDisabled = "disabled"
+	cbrEnforcementModeEnabled  = "enabled"
+	cbrEnforcementModeReport   = "report"
+
+	cbrDecisionAllowed = "allowed"
+	cbrDecisionDenied  = "denied"
+	cbrDecisionReport  = "report"
+)
+
+func DataSourceIBMCbrRuleEvaluation() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMCbrRuleEvaluationRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the account owning the rules. Defaults to the account of the provider.",
+			},
+			"service_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the service that receives the request.",
+			},
+			"service_instance": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The service instance that receives the request.",
+			},
+			"service_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The type of the service that receives the request.",
+			},
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The region of the resource that receives the request.",
+			},
+			"resource_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The type of the resource that receives the request.",
+			},
+			"resource": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The resource that receives the request.",
+			},
+			"resource_tags": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The tags of the resource that receives the request, by tag name.",
+			},
+			"api_type_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The API type of the request. When not set, the rules of all the API types are evaluated.",
+			},
+			"ip_address": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ValidateIP,
+				Description:  "The IP address the request originates from.",
+			},
+			"endpoint_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "public",
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_cbr_rule_evaluation", "endpoint_type"),
+				Description:  "The endpoint the request is sent to, public, private or direct.",
+			},
+			"allowed": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the request is allowed by the enabled rules.",
+			},
+			"decision": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The decision for the request: allowed, denied, or report when the request is allowed but denied by a rule in report mode.",
+			},
+			"rules": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The enabled and report mode rules that apply to the request.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the rule.",
+						},
+						"description": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the rule.",
+						},
+						"enforcement_mode": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The enforcement mode of the rule.",
+						},
+						"allowed": &schema.Schema{
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether one of the contexts of the rule allows the request.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DataSourceIBMCbrRuleEvaluationValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "endpoint_type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "direct, private, public",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_cbr_rule_evaluation", Schema: validateSchema}
+	return &resourceValidator
+}
+
+// cbrEvaluationRequest holds the attributes of the request that is evaluated against the rules
+type cbrEvaluationRequest struct {
+	accountID    string
+	attributes   map[string]string
+	tags         map[string]string
+	apiTypeID    string
+	ip           net.IP
+	endpointType string
+}
+
+func dataSourceIBMCbrRuleEvaluationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	contextBasedRestrictionsClient, err := meta.(conns.ClientSession).ContextBasedRestrictionsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	request := cbrEvaluationRequest{
+		attributes: map[string]string{
+			"serviceName": d.Get("service_name").(string),
+		},
+		tags:         map[string]string{},
+		apiTypeID:    d.Get("api_type_id").(string),
+		ip:           net.ParseIP(d.Get("ip_address").(string)),
+		endpointType: d.Get("endpoint_type").(string),
+	}
+	if v, ok := d.GetOk("account_id"); ok {
+		request.accountID = v.(string)
+	} else {
+		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		request.accountID = userDetails.UserAccount
+	}
+	request.attributes["accountId"] = request.accountID
+	for attribute, key := range map[string]string{
+		"serviceInstance": "service_instance",
+		"serviceType":     "service_type",
+		"region":          "region",
+		"resourceType":    "resource_type",
+		"resource":        "resource",
+	} {
+		if v, ok := d.GetOk(key); ok {
+			request.attributes[attribute] = v.(string)
+		}
+	}
+	for name, value := range d.Get("resource_tags").(map[string]interface{}) {
+		request.tags[name] = value.(string)
+	}
+
+	listRulesOptions := &contextbasedrestrictionsv1.ListRulesOptions{}
+	listRulesOptions.SetAccountID(request.accountID)
+	listRulesOptions.SetServiceName(request.attributes["serviceName"])
+
+	ruleList, response, err := contextBasedRestrictionsClient.ListRulesWithContext(context, listRulesOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListRulesWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("ListRulesWithContext failed %s\n%s", err, response))
+	}
+
+	zones := map[string]*contextbasedrestrictionsv1.Zone{}
+	allowed := true
+	reported := false
+	rules := []map[string]interface{}{}
+	for _, rule := range ruleList.Rules {
+		enforcementMode := cbrEnforcementModeEnabled
+		if rule.EnforcementMode != nil {
+			enforcementMode = *rule.EnforcementMode
+		}
+		if enforcementMode == cbrEnforcementModeDisabled || !cbrRuleAppliesTo(rule, request) {
+			continue
+		}
+
+		ruleAllowed := false
+		for _, ruleContext := range rule.Contexts {
+			contextAllowed, err := cbrContextAllows(context, contextBasedRestrictionsClient, zones, ruleContext, request)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if contextAllowed {
+				ruleAllowed = true
+				break
+			}
+		}
+		if !ruleAllowed {
+			if enforcementMode == cbrEnforcementModeReport {
+				reported = true
+			} else {
+				allowed = false
+			}
+		}
+
+		rules = append(rules, map[string]interface{}{
+			"rule_id":          rule.ID,
+			"description":      rule.Description,
+			"enforcement_mode": enforcementMode,
+			"allowed":          ruleAllowed,
+		})
+	}
+
+	decision := cbrDecisionAllowed
+	if !allowed {
+		decision = cbrDecisionDenied
+	} else if reported {
+		decision = cbrDecisionReport
+	}
+
+	d.SetId(time.Now().UTC().String())
+	if err = d.Set("allowed", allowed); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting allowed: %s", err))
+	}
+	if err = d.Set("decision", decision); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting decision: %s", err))
+	}
+	if err = d.Set("rules", rules); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting rules: %s", err))
+	}
+
+	return nil
+}
+
+// cbrRuleAppliesTo reports whether one of the resources of the rule matches the request and
+// whether the operations of the rule include the API type of the request
+func cbrRuleAppliesTo(rule contextbasedrestrictionsv1.Rule, request cbrEvaluationRequest) bool {
+	if request.apiTypeID != "" && rule.Operations != nil && len(rule.Operations.APITypes) > 0 {
+		found := false
+		for _, apiType := range rule.Operations.APITypes {
+			if apiType.APITypeID != nil && *apiType.APITypeID == request.apiTypeID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	for _, ruleResource := range rule.Resources {
+		if cbrResourceMatches(ruleResource, request) {
+			return true
+		}
+	}
+	return false
+}
+
+func cbrResourceMatches(ruleResource contextbasedrestrictionsv1.Resource, request cbrEvaluationRequest) bool {
+	for _, attribute := range ruleResource.Attributes {
+		// A rule scoped to an attribute the request does not specify cannot be evaluated and does not apply
+		value, ok := request.attributes[*attribute.Name]
+		if !ok || !cbrAttributeMatches(attribute.Operator, *attribute.Value, value) {
+			return false
+		}
+	}
+	for _, tag := range ruleResource.Tags {
+		value, ok := request.tags[*tag.Name]
+		if !ok || !cbrAttributeMatches(tag.Operator, *tag.Value, value) {
+			return false
+		}
+	}
+	return true
+}
+
+func cbrAttributeMatches(operator *string, pattern, value string) bool {
+	if operator == nil || *operator != "stringMatch" {
+		return pattern == value
+	}
+	expression := regexp.QuoteMeta(pattern)
+	expression = strings.ReplaceAll(expression, `\*`, ".*")
+	expression = strings.ReplaceAll(expression, `\?`, ".")
+	matched, err := regexp.MatchString("^"+expression+"$", value)
+	return err == nil && matched
+}
+
+func cbrContextAllows(context context.Context, client *contextbasedrestrictionsv1.ContextBasedRestrictionsV1, zones map[string]*contextbasedrestrictionsv1.Zone, ruleContext contextbasedrestrictionsv1.RuleContext, request cbrEvaluationRequest) (bool, error) {
+	for _, attribute := range ruleContext.Attributes {
+		switch *attribute.Name {
+		case "endpointType":
+			if !cbrListContains(*attribute.Value, request.endpointType) {
+				return false, nil
+			}
+		case "networkZoneId":
+			inZone := false
+			for _, zoneID := range strings.Split(*attribute.Value, ",") {
+				zone, err := cbrGetZone(context, client, zones, strings.TrimSpace(zoneID))
+				if err != nil {
+					return false, err
+				}
+				if cbrZoneContains(zone, request.ip) {
+					inZone = true
+					break
+				}
+			}
+			if !inZone {
+				return false, nil
+			}
+		default:
+			log.Printf("[WARN] Context attribute %s cannot be evaluated, the context is considered not to match", *attribute.Name)
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func cbrGetZone(context context.Context, client *contextbasedrestrictionsv1.ContextBasedRestrictionsV1, zones map[string]*contextbasedrestrictionsv1.Zone, zoneID string) (*contextbasedrestrictionsv1.Zone, error) {
+	if zone, ok := zones[zoneID]; ok {
+		return zone, nil
+	}
+	getZoneOptions := &contextbasedrestrictionsv1.GetZoneOptions{}
+	getZoneOptions.SetZoneID(zoneID)
+	zone, response, err := client.GetZoneWithContext(context, getZoneOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetZoneWithContext failed %s\n%s", err, response)
+		return nil, fmt.Errorf("GetZoneWithContext failed %s\n%s", err, response)
+	}
+	zones[zoneID] = zone
+	return zone, nil
+}
+
+// cbrZoneContains reports whether the IP address is in the IP addresses, ranges or subnets of the zone
+// and not excluded. VPC and service reference addresses cannot be resolved to IP addresses and are skipped.
+func cbrZoneContains(zone *contextbasedrestrictionsv1.Zone, ip net.IP) bool {
+	for _, excluded := range zone.Excluded {
+		if cbrAddressContains(excluded, ip) {
+			return false
+		}
+	}
+	for _, address := range zone.Addresses {
+		if cbrAddressContains(address, ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func cbrAddressContains(address contextbasedrestrictionsv1.AddressIntf, ip net.IP) bool {
+	switch address := address.(type) {
+	case *contextbasedrestrictionsv1.AddressIPAddress:
+		addressIP := net.ParseIP(*address.Value)
+		return addressIP != nil && addressIP.Equal(ip)
+	case *contextbasedrestrictionsv1.AddressIPAddressRange:
+		bounds := strings.SplitN(*address.Value, "-", 2)
+		if len(bounds) != 2 {
+			return false
+		}
+		start, end := net.ParseIP(strings.TrimSpace(bounds[0])), net.ParseIP(strings.TrimSpace(bounds[1]))
+		if start == nil || end == nil || (start.To4() == nil) != (ip.To4() == nil) {
+			return false
+		}
+		return bytes.Compare(ip.To16(), start.To16()) >= 0 && bytes.Compare(ip.To16(), end.To16()) <= 0
+	case *contextbasedrestrictionsv1.AddressSubnet:
+		_, subnet, err := net.ParseCIDR(*address.Value)
+		return err == nil && subnet.Contains(ip)
+	}
+	return false
+}
+
+func cbrListContains(list, value string) bool {
+	for _, item := range strings.Split(list, ",") {
+		if strings.TrimSpace(item) == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package contextbasedrestrictions_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMCbrRuleEvaluationDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCbrRuleEvaluationDataSourceConfig("169.23.56.234", "report"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_cbr_rule_evaluation.cbr_rule_evaluation", "id"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_evaluation.cbr_rule_evaluation", "allowed", "true"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_evaluation.cbr_rule_evaluation", "decision", "allowed"),
+					resource.TestCheckResourceAttrSet("data.ibm_cbr_rule_evaluation.cbr_rule_evaluation", "rules.#"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMCbrRuleEvaluationDataSourceConfig("10.0.0.1", "report"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_evaluation.cbr_rule_evaluation", "allowed", "true"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule_evaluation.cbr_rule_evaluation", "decision", "report"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCbrRuleEvaluationDataSourceConfig(ipAddress string, enforcementMode string) string {
+	return fmt.Sprintf(`
+		data "ibm_iam_account_settings" "iam_account_settings" {
+		}
+
+		resource "ibm_cbr_zone" "cbr_zone" {
+			name = "Test Zone Rule Evaluation"
+			account_id = data.ibm_iam_account_settings.iam_account_settings.account_id
+			addresses {
+				type = "ipRange"
+				value = "169.23.22.0-169.23.76.255"
+			}
+		}
+
+		resource "ibm_cbr_rule" "cbr_rule" {
+			description = "Test Rule Evaluation"
+			contexts {
+				attributes {
+					name = "networkZoneId"
+					value = ibm_cbr_zone.cbr_zone.id
+				}
+			}
+			resources {
+				attributes {
+					name = "accountId"
+					value = data.ibm_iam_account_settings.iam_account_settings.account_id
+				}
+				attributes {
+					name = "serviceName"
+					value = "iam-groups"
+				}
+			}
+			enforcement_mode = "%s"
+		}
+
+		data "ibm_cbr_rule_evaluation" "cbr_rule_evaluation" {
+			service_name = "iam-groups"
+			ip_address = "%s"
+			depends_on = [ibm_cbr_rule.cbr_rule]
+		}
+	`, enforcementMode, ipAddress)
+}
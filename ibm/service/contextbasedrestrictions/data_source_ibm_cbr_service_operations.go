@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package contextbasedrestrictions
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+)
+
+func DataSourceIBMCbrServiceOperations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMCbrServiceOperationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"service_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the service, for example `is` or `cloud-object-storage`.",
+			},
+			"api_types": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The API types of the service that can be used in the operations of a rule.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_type_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the API type, to use as the api_type_id of the operations of a rule.",
+						},
+						"display_name": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The display name of the API type.",
+						},
+						"description": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the API type.",
+						},
+						"actions": &schema.Schema{
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The actions of the service that are restricted by a rule on the API type.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"action_id": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The ID of the action.",
+									},
+									"description": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The description of the action.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMCbrServiceOperationsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	contextBasedRestrictionsClient, err := meta.(conns.ClientSession).ContextBasedRestrictionsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	serviceName := d.Get("service_name").(string)
+	listAvailableServiceOperationsOptions := &contextbasedrestrictionsv1.ListAvailableServiceOperationsOptions{}
+	listAvailableServiceOperationsOptions.SetServiceName(serviceName)
+
+	operationsList, response, err := contextBasedRestrictionsClient.ListAvailableServiceOperationsWithContext(context, listAvailableServiceOperationsOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListAvailableServiceOperationsWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("ListAvailableServiceOperationsWithContext failed %s\n%s", err, response))
+	}
+
+	apiTypes := []map[string]interface{}{}
+	for _, apiType := range operationsList.APITypes {
+		actions := []map[string]interface{}{}
+		for _, action := range apiType.Actions {
+			actions = append(actions, map[string]interface{}{
+				"action_id":   action.ActionID,
+				"description": action.Description,
+			})
+		}
+		apiTypes = append(apiTypes, map[string]interface{}{
+			"api_type_id":  apiType.APITypeID,
+			"display_name": apiType.DisplayName,
+			"description":  apiType.Description,
+			"actions":      actions,
+		})
+	}
+
+	d.SetId(serviceName)
+	if err = d.Set("api_types", apiTypes); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting api_types: %s", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package contextbasedrestrictions_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMCbrServiceOperationsDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCbrServiceOperationsDataSourceConfig("is"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_cbr_service_operations.cbr_service_operations", "id", "is"),
+					resource.TestCheckResourceAttrSet("data.ibm_cbr_service_operations.cbr_service_operations", "api_types.0.api_type_id"),
+					resource.TestCheckResourceAttrSet("data.ibm_cbr_service_operations.cbr_service_operations", "api_types.0.actions.0.action_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCbrServiceOperationsDataSourceConfig(serviceName string) string {
+	return fmt.Sprintf(`
+		data "ibm_cbr_service_operations" "cbr_service_operations" {
+			service_name = "%s"
+		}
+	`, serviceName)
+}
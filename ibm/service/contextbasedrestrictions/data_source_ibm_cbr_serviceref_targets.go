@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package contextbasedrestrictions
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+)
+
+func DataSourceIBMCbrServicerefTargets() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMCbrServicerefTargetsRead,
+
+		Schema: map[string]*schema.Schema{
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_cbr_serviceref_targets", "type"),
+				Description:  "The types of services to list, all or platform_service.",
+			},
+			"service_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the target of this service.",
+			},
+			"targets": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The services that can be used in the serviceRef addresses of a zone.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_name": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the service.",
+						},
+						"service_type": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the service.",
+						},
+						"locations": &schema.Schema{
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The locations the service is available in, to use as the location of a serviceRef address.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DataSourceIBMCbrServicerefTargetsValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "all, platform_service",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_cbr_serviceref_targets", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func dataSourceIBMCbrServicerefTargetsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	contextBasedRestrictionsClient, err := meta.(conns.ClientSession).ContextBasedRestrictionsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	listAvailableServicerefTargetsOptions := &contextbasedrestrictionsv1.ListAvailableServicerefTargetsOptions{}
+	if v, ok := d.GetOk("type"); ok {
+		listAvailableServicerefTargetsOptions.SetType(v.(string))
+	}
+
+	targetList, response, err := contextBasedRestrictionsClient.ListAvailableServicerefTargetsWithContext(context, listAvailableServicerefTargetsOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListAvailableServicerefTargetsWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("ListAvailableServicerefTargetsWithContext failed %s\n%s", err, response))
+	}
+
+	serviceName := d.Get("service_name").(string)
+	targets := []map[string]interface{}{}
+	for _, target := range targetList.Targets {
+		if serviceName != "" && *target.ServiceName != serviceName {
+			continue
+		}
+		locations := []string{}
+		for _, location := range target.Locations {
+			locations = append(locations, *location.Name)
+		}
+		targets = append(targets, map[string]interface{}{
+			"service_name": target.ServiceName,
+			"service_type": target.ServiceType,
+			"locations":    locations,
+		})
+	}
+
+	d.SetId(time.Now().UTC().String())
+	if err = d.Set("targets", targets); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting targets: %s", err))
+	}
+
+	return nil
+}
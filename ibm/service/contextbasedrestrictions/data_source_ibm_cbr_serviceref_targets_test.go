@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package contextbasedrestrictions_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMCbrServicerefTargetsDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCbrServicerefTargetsDataSourceConfig("cloud-object-storage"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_cbr_serviceref_targets.cbr_serviceref_targets", "id"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_serviceref_targets.cbr_serviceref_targets", "targets.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_serviceref_targets.cbr_serviceref_targets", "targets.0.service_name", "cloud-object-storage"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCbrServicerefTargetsDataSourceConfig(serviceName string) string {
+	return fmt.Sprintf(`
+		data "ibm_cbr_serviceref_targets" "cbr_serviceref_targets" {
+			service_name = "%s"
+		}
+	`, serviceName)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -24,6 +25,7 @@ func ResourceIBMCbrRule() *schema.Resource {
 		ReadContext:   resourceIBMCbrRuleRead,
 		UpdateContext: resourceIBMCbrRuleUpdate,
 		DeleteContext: resourceIBMCbrRuleDelete,
+		CustomizeDiff: resourceIBMCbrRuleOperationsCustomizeDiff,
 		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
@@ -84,9 +86,10 @@ func ResourceIBMCbrRule() *schema.Resource {
 										Description: "The attribute value.",
 									},
 									"operator": &schema.Schema{
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "The attribute operator.",
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.InvokeValidator("ibm_cbr_rule", "operator"),
+										Description:  "The attribute operator, stringEquals or stringMatch. stringMatch supports the `*` and `?` wildcards in the value.",
 									},
 								},
 							},
@@ -94,7 +97,7 @@ func ResourceIBMCbrRule() *schema.Resource {
 						"tags": &schema.Schema{
 							Type:        schema.TypeList,
 							Optional:    true,
-							Description: "The optional resource tags.",
+							Description: "The optional resource tags. The rule only applies to the resources that carry all the tags.",
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"name": &schema.Schema{
@@ -108,9 +111,10 @@ func ResourceIBMCbrRule() *schema.Resource {
 										Description: "The tag attribute value.",
 									},
 									"operator": &schema.Schema{
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "The attribute operator.",
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.InvokeValidator("ibm_cbr_rule", "operator"),
+										Description:  "The attribute operator, stringEquals or stringMatch. stringMatch supports the `*` and `?` wildcards in the value.",
 									},
 								},
 							},
@@ -132,8 +136,9 @@ func ResourceIBMCbrRule() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"api_type_id": &schema.Schema{
-										Type:     schema.TypeString,
-										Required: true,
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The ID of the API type, for example `crn:v1:bluemix:public:containers-kubernetes::::api-type:management`. The API types of a service are listed by the ibm_cbr_service_operations data source.",
 									},
 								},
 							},
@@ -217,6 +222,13 @@ func ResourceIBMCbrRuleValidator() *validate.ResourceValidator {
 			Optional:                   true,
 			AllowedValues:              "disabled, enabled, report",
 		},
+		validate.ValidateSchema{
+			Identifier:                 "operator",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "stringEquals, stringMatch",
+		},
 		validate.ValidateSchema{
 			Identifier:                 "x_correlation_id",
 			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
@@ -241,6 +253,70 @@ func ResourceIBMCbrRuleValidator() *validate.ResourceValidator {
 	return &resourceValidator
 }
 
+// resourceIBMCbrRuleOperationsCustomizeDiff checks the API types of the operations against the operations of the
+// services of the rule, so that an unknown API type fails the plan instead of the apply. The services are the
+// serviceName attributes of the resources that are known at plan time and are not wildcards.
+func resourceIBMCbrRuleOperationsCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("operations") || !diff.NewValueKnown("resources") {
+		return nil
+	}
+	operations := diff.Get("operations").([]interface{})
+	if len(operations) == 0 || operations[0] == nil {
+		return nil
+	}
+	apiTypeIDs := []string{}
+	for _, apiType := range operations[0].(map[string]interface{})["api_types"].([]interface{}) {
+		// An API type that is not known at plan time is empty
+		if apiType != nil && apiType.(map[string]interface{})["api_type_id"].(string) != "" {
+			apiTypeIDs = append(apiTypeIDs, apiType.(map[string]interface{})["api_type_id"].(string))
+		}
+	}
+
+	serviceNames := map[string]bool{}
+	for _, r := range diff.Get("resources").([]interface{}) {
+		if r == nil {
+			continue
+		}
+		for _, a := range r.(map[string]interface{})["attributes"].([]interface{}) {
+			attribute := a.(map[string]interface{})
+			if attribute["name"].(string) == "serviceName" && attribute["value"].(string) != "" && attribute["operator"].(string) != "stringMatch" {
+				serviceNames[attribute["value"].(string)] = true
+			}
+		}
+	}
+	if len(apiTypeIDs) == 0 || len(serviceNames) == 0 {
+		return nil
+	}
+
+	contextBasedRestrictionsClient, err := meta.(conns.ClientSession).ContextBasedRestrictionsV1()
+	if err != nil {
+		return err
+	}
+	for serviceName := range serviceNames {
+		listAvailableServiceOperationsOptions := &contextbasedrestrictionsv1.ListAvailableServiceOperationsOptions{}
+		listAvailableServiceOperationsOptions.SetServiceName(serviceName)
+		operationsList, response, err := contextBasedRestrictionsClient.ListAvailableServiceOperationsWithContext(context, listAvailableServiceOperationsOptions)
+		if err != nil {
+			// The rule is validated by the API on apply
+			log.Printf("[WARN] Could not list the operations of service %s to validate the API types of the rule: %s\n%s", serviceName, err, response)
+			continue
+		}
+		available := map[string]bool{}
+		availableIDs := []string{}
+		for _, apiType := range operationsList.APITypes {
+			available[*apiType.APITypeID] = true
+			availableIDs = append(availableIDs, *apiType.APITypeID)
+		}
+		for _, apiTypeID := range apiTypeIDs {
+			if !available[apiTypeID] {
+				return fmt.Errorf("[ERROR] The API type %s is not an API type of service %s, the API types of the service are: %s", apiTypeID, serviceName, strings.Join(availableIDs, ", "))
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourceIBMCbrRuleCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	contextBasedRestrictionsClient, err := meta.(conns.ClientSession).ContextBasedRestrictionsV1()
 	if err != nil {
@@ -367,6 +443,11 @@ func resourceIBMCbrRuleRead(context context.Context, d *schema.ResourceData, met
 		if err = d.Set("operations", []map[string]interface{}{operationsMap}); err != nil {
 			return diag.FromErr(fmt.Errorf("Error setting operations: %s", err))
 		}
+	} else {
+		// A rule without operations applies to all the API types of the service
+		if err = d.Set("operations", nil); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting operations: %s", err))
+		}
 	}
 	if err = d.Set("enforcement_mode", rule.EnforcementMode); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting enforcement_mode: %s", err))
@@ -442,6 +523,10 @@ func resourceIBMCbrRuleUpdate(context context.Context, d *schema.ResourceData, m
 	if _, ok := d.GetOk("enforcement_mode"); ok {
 		replaceRuleOptions.SetEnforcementMode(d.Get("enforcement_mode").(string))
 	}
+	if d.HasChange("enforcement_mode") {
+		oldMode, newMode := d.GetChange("enforcement_mode")
+		log.Printf("[INFO] Changing the enforcement mode of rule %s from %s to %s", d.Id(), oldMode, newMode)
+	}
 	if _, ok := d.GetOk("x_correlation_id"); ok {
 		replaceRuleOptions.SetXCorrelationID(d.Get("x_correlation_id").(string))
 	}
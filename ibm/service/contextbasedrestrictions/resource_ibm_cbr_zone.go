@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -16,6 +17,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
 )
 
 func ResourceIBMCbrZone() *schema.Resource {
@@ -46,15 +48,17 @@ func ResourceIBMCbrZone() *schema.Resource {
 				Description:  "The description of the zone.",
 			},
 			"addresses": &schema.Schema{
-				Type:        schema.TypeList,
-				Required:    true,
-				Description: "The list of addresses in the zone.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				AtLeastOneOf: []string{"addresses", "vpc_crn"},
+				Description:  "The list of addresses in the zone.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"type": &schema.Schema{
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The type of address.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_cbr_zone", "type"),
+							Description:  "The type of address, ipAddress, ipRange, subnet, vpc or serviceRef.",
 						},
 						"value": &schema.Schema{
 							Type:        schema.TypeString,
@@ -91,7 +95,7 @@ func ResourceIBMCbrZone() *schema.Resource {
 									"location": &schema.Schema{
 										Type:        schema.TypeString,
 										Optional:    true,
-										Description: "The location.",
+										Description: "The location of the service, the available locations of a service are listed by the ibm_cbr_serviceref_targets data source.",
 									},
 								},
 							},
@@ -118,6 +122,18 @@ func ResourceIBMCbrZone() *schema.Resource {
 					},
 				},
 			},
+			"vpc_crn": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_cbr_zone", "vpc_crn"),
+				Description:  "The CRN of a VPC to add to the zone. The zone tracks the address space of the VPC, the subnets of the VPC do not need to be added to the addresses.",
+			},
+			"vpc_subnets": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The current IPv4 CIDR blocks of the subnets of the VPC of vpc_crn, refreshed on every read.",
+			},
 			"x_correlation_id": &schema.Schema{
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -208,6 +224,22 @@ func ResourceIBMCbrZoneValidator() *validate.ResourceValidator {
 			MinValueLength:             0,
 			MaxValueLength:             300,
 		},
+		validate.ValidateSchema{
+			Identifier:                 "type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "ipAddress, ipRange, serviceRef, subnet, vpc",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "vpc_crn",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^crn:v1:[a-z]+:[a-z]+:is:[a-z0-9-]+:[^:]*::vpc:[a-zA-Z0-9\-_]+$`,
+			MinValueLength:             1,
+			MaxValueLength:             1024,
+		},
 		validate.ValidateSchema{
 			Identifier:                 "x_correlation_id",
 			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
@@ -249,18 +281,11 @@ func resourceIBMCbrZoneCreate(context context.Context, d *schema.ResourceData, m
 	if _, ok := d.GetOk("description"); ok {
 		createZoneOptions.SetDescription(d.Get("description").(string))
 	}
-	if _, ok := d.GetOk("addresses"); ok {
-		var addresses []contextbasedrestrictionsv1.AddressIntf
-		for _, e := range d.Get("addresses").([]interface{}) {
-			value := e.(map[string]interface{})
-			addressesItem, err := resourceIBMCbrZoneMapToAddress(value)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			addresses = append(addresses, addressesItem)
-		}
-		createZoneOptions.SetAddresses(addresses)
+	addresses, err := resourceIBMCbrZoneExpandAddresses(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	createZoneOptions.SetAddresses(addresses)
 	if _, ok := d.GetOk("excluded"); ok {
 		var excluded []contextbasedrestrictionsv1.AddressIntf
 		for _, e := range d.Get("excluded").([]interface{}) {
@@ -326,9 +351,14 @@ func resourceIBMCbrZoneRead(context context.Context, d *schema.ResourceData, met
 	if err = d.Set("description", zone.Description); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting description: %s", err))
 	}
+	vpcCRN := d.Get("vpc_crn").(string)
 	addresses := []map[string]interface{}{}
 	if zone.Addresses != nil {
 		for _, addressesItem := range zone.Addresses {
+			// The address of vpc_crn is managed by the vpc_crn argument
+			if vpcAddress, ok := addressesItem.(*contextbasedrestrictionsv1.AddressVPC); ok && vpcCRN != "" && *vpcAddress.Value == vpcCRN {
+				continue
+			}
 			addressesItemMap, err := resourceIBMCbrZoneAddressToMap(addressesItem)
 			if err != nil {
 				return diag.FromErr(err)
@@ -352,6 +382,16 @@ func resourceIBMCbrZoneRead(context context.Context, d *schema.ResourceData, met
 	if err = d.Set("excluded", excluded); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting excluded: %s", err))
 	}
+	vpcSubnets := []string{}
+	if vpcCRN != "" {
+		vpcSubnets, err = resourceIBMCbrZoneListVPCSubnets(context, meta, vpcCRN)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err = d.Set("vpc_subnets", vpcSubnets); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting vpc_subnets: %s", err))
+	}
 	if err = d.Set("crn", zone.CRN); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting crn: %s", err))
 	}
@@ -401,18 +441,11 @@ func resourceIBMCbrZoneUpdate(context context.Context, d *schema.ResourceData, m
 	if _, ok := d.GetOk("description"); ok {
 		replaceZoneOptions.SetDescription(d.Get("description").(string))
 	}
-	if _, ok := d.GetOk("addresses"); ok {
-		var addresses []contextbasedrestrictionsv1.AddressIntf
-		for _, e := range d.Get("addresses").([]interface{}) {
-			value := e.(map[string]interface{})
-			addressesItem, err := resourceIBMCbrZoneMapToAddress(value)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			addresses = append(addresses, addressesItem)
-		}
-		replaceZoneOptions.SetAddresses(addresses)
+	addresses, err := resourceIBMCbrZoneExpandAddresses(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	replaceZoneOptions.SetAddresses(addresses)
 	if _, ok := d.GetOk("excluded"); ok {
 		var excluded []contextbasedrestrictionsv1.AddressIntf
 		for _, e := range d.Get("excluded").([]interface{}) {
@@ -463,6 +496,66 @@ func resourceIBMCbrZoneDelete(context context.Context, d *schema.ResourceData, m
 	return nil
 }
 
+func resourceIBMCbrZoneExpandAddresses(d *schema.ResourceData) ([]contextbasedrestrictionsv1.AddressIntf, error) {
+	addresses := []contextbasedrestrictionsv1.AddressIntf{}
+	vpcCRN := d.Get("vpc_crn").(string)
+	for _, e := range d.Get("addresses").([]interface{}) {
+		value := e.(map[string]interface{})
+		if value["type"] == "vpc" && value["value"] == vpcCRN {
+			continue
+		}
+		addressesItem, err := resourceIBMCbrZoneMapToAddress(value)
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, addressesItem)
+	}
+	if vpcCRN != "" {
+		addresses = append(addresses, &contextbasedrestrictionsv1.AddressVPC{
+			Type:  core.StringPtr("vpc"),
+			Value: core.StringPtr(vpcCRN),
+		})
+	}
+	return addresses, nil
+}
+
+// resourceIBMCbrZoneListVPCSubnets lists the IPv4 CIDR blocks of the subnets of the VPC, in the region of the VPC
+func resourceIBMCbrZoneListVPCSubnets(context context.Context, meta interface{}, vpcCRN string) ([]string, error) {
+	crnParts := strings.Split(vpcCRN, ":")
+	if len(crnParts) < 6 {
+		return nil, fmt.Errorf("[ERROR] Invalid VPC CRN %s", vpcCRN)
+	}
+	vpcClient, err := meta.(conns.ClientSession).VpcV1APIForRegion(crnParts[5])
+	if err != nil {
+		return nil, err
+	}
+
+	start := ""
+	subnets := []string{}
+	for {
+		listSubnetsOptions := &vpcv1.ListSubnetsOptions{}
+		listSubnetsOptions.SetVPCCRN(vpcCRN)
+		if start != "" {
+			listSubnetsOptions.SetStart(start)
+		}
+		subnetCollection, response, err := vpcClient.ListSubnetsWithContext(context, listSubnetsOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListSubnetsWithContext failed %s\n%s", err, response)
+			return nil, fmt.Errorf("ListSubnetsWithContext failed %s\n%s", err, response)
+		}
+		for _, subnet := range subnetCollection.Subnets {
+			if subnet.Ipv4CIDRBlock != nil {
+				subnets = append(subnets, *subnet.Ipv4CIDRBlock)
+			}
+		}
+		start = flex.GetNext(subnetCollection.Next)
+		if start == "" {
+			break
+		}
+	}
+	return subnets, nil
+}
+
 func resourceIBMCbrZoneMapToAddress(modelMap map[string]interface{}) (contextbasedrestrictionsv1.AddressIntf, error) {
 	discValue, ok := modelMap["type"]
 	if ok {
@@ -512,6 +605,9 @@ func resourceIBMCbrZoneMapToAddressIPAddress(modelMap map[string]interface{}) (*
 func resourceIBMCbrZoneMapToAddressServiceRef(modelMap map[string]interface{}) (*contextbasedrestrictionsv1.AddressServiceRef, error) {
 	model := &contextbasedrestrictionsv1.AddressServiceRef{}
 	model.Type = core.StringPtr(modelMap["type"].(string))
+	if refs, ok := modelMap["ref"].([]interface{}); !ok || len(refs) == 0 || refs[0] == nil {
+		return model, fmt.Errorf("[ERROR] ref is required for addresses of type serviceRef")
+	}
 	RefModel, err := resourceIBMCbrZoneMapToServiceRefValue(modelMap["ref"].([]interface{})[0].(map[string]interface{}))
 	if err != nil {
 		return model, err
@@ -113,6 +113,52 @@ func testAccCheckIBMCbrZoneConfig(name string, accountID string, description str
 	`, name, description, accountID, accountID)
 }
 
+func TestAccIBMCbrZoneVPCCRN(t *testing.T) {
+	var conf contextbasedrestrictionsv1.Zone
+	vpcName := fmt.Sprintf("tf-cbr-vpc-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCbrZoneDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCbrZoneConfigVPCCRN(vpcName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCbrZoneExists("ibm_cbr_zone.cbr_zone", conf),
+					resource.TestCheckResourceAttrPair("ibm_cbr_zone.cbr_zone", "vpc_crn", "ibm_is_vpc.vpc", "crn"),
+					resource.TestCheckResourceAttr("ibm_cbr_zone.cbr_zone", "addresses.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cbr_zone.cbr_zone", "address_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCbrZoneConfigVPCCRN(vpcName string) string {
+	return fmt.Sprintf(`
+		data "ibm_iam_account_settings" "iam_account_settings" {
+		}
+
+		resource "ibm_is_vpc" "vpc" {
+			name = "%s"
+		}
+
+		resource "ibm_cbr_zone" "cbr_zone" {
+			name = "Test Zone VPC CRN"
+			account_id = data.ibm_iam_account_settings.iam_account_settings.account_id
+			vpc_crn = ibm_is_vpc.vpc.crn
+			addresses {
+				type = "serviceRef"
+				ref {
+					account_id = data.ibm_iam_account_settings.iam_account_settings.account_id
+					service_name = "cloud-object-storage"
+				}
+			}
+		}
+	`, vpcName)
+}
+
 func testAccCheckIBMCbrZoneExists(n string, obj contextbasedrestrictionsv1.Zone) resource.TestCheckFunc {
 
 	return func(s *terraform.State) error {
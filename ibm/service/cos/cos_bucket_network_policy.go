@@ -0,0 +1,373 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	cosServiceName          = "cloud-object-storage"
+	cbrNetworkZoneAttribute = "networkZoneId"
+	cbrZoneNameMaxLength    = 128
+)
+
+var cbrZoneNameInvalidChars = regexp.MustCompile(`[^a-zA-Z0-9 \-_]`)
+
+// cosBucketNetworkPolicyAccount returns the account ID and the GUID of the Object Storage instance of a CRN
+func cosBucketNetworkPolicyAccount(serviceID string) (string, string, error) {
+	parts := strings.Split(serviceID, ":")
+	if len(parts) < 8 || parts[6] == "" || parts[7] == "" {
+		return "", "", fmt.Errorf("[ERROR] The resource instance ID %s is not the CRN of an Object Storage instance", serviceID)
+	}
+	return strings.TrimPrefix(parts[6], "a/"), parts[7], nil
+}
+
+// cosBucketNetworkPolicyZoneName returns the name of the zone that holds the VPCs of the network policy of a bucket.
+// The names of the zones only allow letters, digits, spaces, dashes and underscores, so the dots of the bucket name
+// are replaced by dashes.
+func cosBucketNetworkPolicyZoneName(bucketName string) string {
+	name := cbrZoneNameInvalidChars.ReplaceAllString(fmt.Sprintf("cos-bucket-%s", bucketName), "-")
+	if len(name) > cbrZoneNameMaxLength {
+		name = name[:cbrZoneNameMaxLength]
+	}
+	return name
+}
+
+// cosBucketNetworkPolicyRuleDescription returns the description of the rule of the network policy of a bucket
+func cosBucketNetworkPolicyRuleDescription(bucketName string) string {
+	return fmt.Sprintf("Network policy of the Object Storage bucket %s", bucketName)
+}
+
+// applyCOSBucketNetworkPolicy creates, replaces or deletes the context-based restriction rule of the network policy of a bucket
+func applyCOSBucketNetworkPolicy(d *schema.ResourceData, meta interface{}, serviceID, bucketName string) error {
+	cbrClient, err := meta.(conns.ClientSession).ContextBasedRestrictionsV1()
+	if err != nil {
+		return err
+	}
+
+	oldRuleID := d.Get("network_policy_rule_id").(string)
+	oldZoneID := d.Get("network_policy_zone_id").(string)
+
+	policies := d.Get("network_policy").([]interface{})
+	if len(policies) == 0 || policies[0] == nil {
+		if err := deleteCOSBucketNetworkPolicy(cbrClient, oldRuleID, oldZoneID); err != nil {
+			return err
+		}
+		d.Set("network_policy_rule_id", "")
+		d.Set("network_policy_zone_id", "")
+		return nil
+	}
+	policy := policies[0].(map[string]interface{})
+
+	accountID, instanceID, err := cosBucketNetworkPolicyAccount(serviceID)
+	if err != nil {
+		return err
+	}
+
+	// The VPCs are kept in a zone owned by the bucket, the zones of zone_ids are only referenced
+	vpcCRNs := flex.ExpandStringList(policy["vpc_crns"].(*schema.Set).List())
+	zoneID := oldZoneID
+	if len(vpcCRNs) > 0 {
+		addresses := make([]contextbasedrestrictionsv1.AddressIntf, 0, len(vpcCRNs))
+		for _, vpcCRN := range vpcCRNs {
+			addresses = append(addresses, &contextbasedrestrictionsv1.AddressVPC{
+				Type:  core.StringPtr(contextbasedrestrictionsv1.AddressVPCTypeVPCConst),
+				Value: core.StringPtr(vpcCRN),
+			})
+		}
+		description := fmt.Sprintf("VPCs allowed to access the Object Storage bucket %s", bucketName)
+		if zoneID == "" {
+			createZoneOptions := &contextbasedrestrictionsv1.CreateZoneOptions{
+				Name:        core.StringPtr(cosBucketNetworkPolicyZoneName(bucketName)),
+				AccountID:   &accountID,
+				Description: &description,
+				Addresses:   addresses,
+			}
+			zone, response, err := cbrClient.CreateZone(createZoneOptions)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error creating the network zone of the COS Bucket %s: %s\n%s", bucketName, err, response)
+			}
+			zoneID = *zone.ID
+			d.Set("network_policy_zone_id", zoneID)
+		} else {
+			_, response, err := cbrClient.GetZone(&contextbasedrestrictionsv1.GetZoneOptions{ZoneID: &zoneID})
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error getting the network zone %s of the COS Bucket %s: %s\n%s", zoneID, bucketName, err, response)
+			}
+			replaceZoneOptions := &contextbasedrestrictionsv1.ReplaceZoneOptions{
+				ZoneID:      &zoneID,
+				IfMatch:     core.StringPtr(response.Headers.Get("Etag")),
+				Name:        core.StringPtr(cosBucketNetworkPolicyZoneName(bucketName)),
+				AccountID:   &accountID,
+				Description: &description,
+				Addresses:   addresses,
+			}
+			_, response, err = cbrClient.ReplaceZone(replaceZoneOptions)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error updating the network zone %s of the COS Bucket %s: %s\n%s", zoneID, bucketName, err, response)
+			}
+		}
+	} else {
+		zoneID = ""
+	}
+
+	zoneIDs := flex.ExpandStringList(policy["zone_ids"].(*schema.Set).List())
+	if zoneID != "" {
+		zoneIDs = append(zoneIDs, zoneID)
+	}
+	if len(zoneIDs) == 0 {
+		return fmt.Errorf("[ERROR] The network policy of the COS Bucket %s must have at least one VPC or zone", bucketName)
+	}
+	contexts := make([]contextbasedrestrictionsv1.RuleContext, 0, len(zoneIDs))
+	for _, id := range zoneIDs {
+		contexts = append(contexts, contextbasedrestrictionsv1.RuleContext{
+			Attributes: []contextbasedrestrictionsv1.RuleContextAttribute{
+				{
+					Name:  core.StringPtr(cbrNetworkZoneAttribute),
+					Value: core.StringPtr(id),
+				},
+			},
+		})
+	}
+	resources := []contextbasedrestrictionsv1.Resource{
+		{
+			Attributes: []contextbasedrestrictionsv1.ResourceAttribute{
+				{Name: core.StringPtr("accountId"), Value: core.StringPtr(accountID)},
+				{Name: core.StringPtr("serviceName"), Value: core.StringPtr(cosServiceName)},
+				{Name: core.StringPtr("serviceInstance"), Value: core.StringPtr(instanceID)},
+				{Name: core.StringPtr("resourceType"), Value: core.StringPtr("bucket")},
+				{Name: core.StringPtr("resource"), Value: core.StringPtr(bucketName)},
+			},
+		},
+	}
+	description := cosBucketNetworkPolicyRuleDescription(bucketName)
+	enforcementMode := policy["enforcement_mode"].(string)
+
+	if oldRuleID == "" {
+		createRuleOptions := &contextbasedrestrictionsv1.CreateRuleOptions{
+			Description:     &description,
+			Contexts:        contexts,
+			Resources:       resources,
+			EnforcementMode: &enforcementMode,
+		}
+		rule, response, err := cbrClient.CreateRule(createRuleOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error creating the network policy rule of the COS Bucket %s: %s\n%s", bucketName, err, response)
+		}
+		d.Set("network_policy_rule_id", *rule.ID)
+	} else {
+		_, response, err := cbrClient.GetRule(&contextbasedrestrictionsv1.GetRuleOptions{RuleID: &oldRuleID})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error getting the network policy rule %s of the COS Bucket %s: %s\n%s", oldRuleID, bucketName, err, response)
+		}
+		replaceRuleOptions := &contextbasedrestrictionsv1.ReplaceRuleOptions{
+			RuleID:          &oldRuleID,
+			IfMatch:         core.StringPtr(response.Headers.Get("Etag")),
+			Description:     &description,
+			Contexts:        contexts,
+			Resources:       resources,
+			EnforcementMode: &enforcementMode,
+		}
+		_, response, err = cbrClient.ReplaceRule(replaceRuleOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error updating the network policy rule %s of the COS Bucket %s: %s\n%s", oldRuleID, bucketName, err, response)
+		}
+	}
+
+	// The zone of the VPCs is deleted once the rule doesn't reference it anymore
+	if oldZoneID != "" && zoneID == "" {
+		if err := deleteCOSBucketNetworkPolicy(cbrClient, "", oldZoneID); err != nil {
+			return err
+		}
+		d.Set("network_policy_zone_id", "")
+	}
+
+	return nil
+}
+
+// readCOSBucketNetworkPolicy sets the network policy of a bucket from its context-based restriction rule
+func readCOSBucketNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
+	ruleID := d.Get("network_policy_rule_id").(string)
+	if ruleID == "" {
+		return nil
+	}
+	cbrClient, err := meta.(conns.ClientSession).ContextBasedRestrictionsV1()
+	if err != nil {
+		return err
+	}
+
+	rule, response, err := cbrClient.GetRule(&contextbasedrestrictionsv1.GetRuleOptions{RuleID: &ruleID})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] The network policy rule %s of the COS Bucket is not found", ruleID)
+			d.Set("network_policy_rule_id", "")
+			d.Set("network_policy", []interface{}{})
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error getting the network policy rule %s of the COS Bucket: %s\n%s", ruleID, err, response)
+	}
+
+	zoneID := d.Get("network_policy_zone_id").(string)
+	zoneIDs := make([]string, 0)
+	for _, ruleContext := range rule.Contexts {
+		for _, attribute := range ruleContext.Attributes {
+			if attribute.Name != nil && *attribute.Name == cbrNetworkZoneAttribute && attribute.Value != nil && *attribute.Value != zoneID {
+				zoneIDs = append(zoneIDs, *attribute.Value)
+			}
+		}
+	}
+
+	vpcCRNs := make([]string, 0)
+	if zoneID != "" {
+		zone, response, err := cbrClient.GetZone(&contextbasedrestrictionsv1.GetZoneOptions{ZoneID: &zoneID})
+		if err != nil {
+			if response == nil || response.StatusCode != 404 {
+				return fmt.Errorf("[ERROR] Error getting the network zone %s of the COS Bucket: %s\n%s", zoneID, err, response)
+			}
+			log.Printf("[WARN] The network zone %s of the COS Bucket is not found", zoneID)
+			d.Set("network_policy_zone_id", "")
+		} else {
+			for _, address := range zone.Addresses {
+				if vpc, ok := address.(*contextbasedrestrictionsv1.AddressVPC); ok && vpc.Value != nil {
+					vpcCRNs = append(vpcCRNs, *vpc.Value)
+				}
+			}
+		}
+	}
+
+	policy := map[string]interface{}{
+		"vpc_crns":         vpcCRNs,
+		"zone_ids":         zoneIDs,
+		"enforcement_mode": contextbasedrestrictionsv1.CreateRuleOptionsEnforcementModeEnabledConst,
+	}
+	if rule.EnforcementMode != nil {
+		policy["enforcement_mode"] = *rule.EnforcementMode
+	}
+	d.Set("network_policy", []interface{}{policy})
+	return nil
+}
+
+// findCOSBucketNetworkPolicy sets the IDs of the rule and the zone of the network policy of a bucket that isn't
+// in the state yet, such as an imported bucket. The rule is looked up by the attributes and the description of the
+// rule created by the network policy, and the zone by its name among the zones of the rule.
+func findCOSBucketNetworkPolicy(d *schema.ResourceData, meta interface{}, serviceID, bucketName string) error {
+	accountID, instanceID, err := cosBucketNetworkPolicyAccount(serviceID)
+	if err != nil {
+		return err
+	}
+	cbrClient, err := meta.(conns.ClientSession).ContextBasedRestrictionsV1()
+	if err != nil {
+		return err
+	}
+
+	listRulesOptions := &contextbasedrestrictionsv1.ListRulesOptions{
+		AccountID:       &accountID,
+		ServiceName:     core.StringPtr(cosServiceName),
+		ServiceInstance: &instanceID,
+		ResourceType:    core.StringPtr("bucket"),
+		Resource:        &bucketName,
+	}
+	rules, response, err := cbrClient.ListRules(listRulesOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error listing the context-based restriction rules of the COS Bucket %s: %s\n%s", bucketName, err, response)
+	}
+
+	// The rules of other resources, such as ibm_cbr_rule, can also restrict the bucket. Only the rule created by
+	// the network policy is adopted, the bucket would otherwise delete the rule of another resource when its
+	// configuration has no network policy.
+	var rule *contextbasedrestrictionsv1.Rule
+	for i := range rules.Rules {
+		candidate := &rules.Rules[i]
+		if candidate.Description == nil || *candidate.Description != cosBucketNetworkPolicyRuleDescription(bucketName) {
+			continue
+		}
+		if isCOSBucketNetworkPolicyRule(candidate, accountID, instanceID, bucketName) {
+			rule = candidate
+			break
+		}
+	}
+	if rule == nil || rule.ID == nil {
+		return nil
+	}
+	d.Set("network_policy_rule_id", *rule.ID)
+
+	listZonesOptions := &contextbasedrestrictionsv1.ListZonesOptions{
+		AccountID: &accountID,
+		Name:      core.StringPtr(cosBucketNetworkPolicyZoneName(bucketName)),
+	}
+	zones, response, err := cbrClient.ListZones(listZonesOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error listing the network zones of the COS Bucket %s: %s\n%s", bucketName, err, response)
+	}
+	for _, zone := range zones.Zones {
+		if zone.ID != nil && cbrRuleReferencesZone(rule, *zone.ID) {
+			d.Set("network_policy_zone_id", *zone.ID)
+			break
+		}
+	}
+	return nil
+}
+
+// isCOSBucketNetworkPolicyRule returns whether the rule only restricts the bucket
+func isCOSBucketNetworkPolicyRule(rule *contextbasedrestrictionsv1.Rule, accountID, instanceID, bucketName string) bool {
+	if len(rule.Resources) != 1 {
+		return false
+	}
+	expected := map[string]string{
+		"accountId":       accountID,
+		"serviceName":     cosServiceName,
+		"serviceInstance": instanceID,
+		"resourceType":    "bucket",
+		"resource":        bucketName,
+	}
+	attributes := rule.Resources[0].Attributes
+	if len(attributes) != len(expected) {
+		return false
+	}
+	for _, attribute := range attributes {
+		if attribute.Name == nil || attribute.Value == nil || expected[*attribute.Name] != *attribute.Value {
+			return false
+		}
+	}
+	return true
+}
+
+// cbrRuleReferencesZone returns whether a context of the rule is the network zone
+func cbrRuleReferencesZone(rule *contextbasedrestrictionsv1.Rule, zoneID string) bool {
+	for _, ruleContext := range rule.Contexts {
+		for _, attribute := range ruleContext.Attributes {
+			if attribute.Name != nil && *attribute.Name == cbrNetworkZoneAttribute && attribute.Value != nil && *attribute.Value == zoneID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// deleteCOSBucketNetworkPolicy deletes the context-based restriction rule and the zone of the network policy of a bucket
+func deleteCOSBucketNetworkPolicy(cbrClient *contextbasedrestrictionsv1.ContextBasedRestrictionsV1, ruleID, zoneID string) error {
+	if ruleID != "" {
+		response, err := cbrClient.DeleteRule(&contextbasedrestrictionsv1.DeleteRuleOptions{RuleID: &ruleID})
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return fmt.Errorf("[ERROR] Error deleting the network policy rule %s of the COS Bucket: %s\n%s", ruleID, err, response)
+		}
+	}
+	if zoneID != "" {
+		response, err := cbrClient.DeleteZone(&contextbasedrestrictionsv1.DeleteZoneOptions{ZoneID: &zoneID})
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return fmt.Errorf("[ERROR] Error deleting the network zone %s of the COS Bucket: %s\n%s", zoneID, err, response)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	cosTransferModeStandard  = "standard"
+	cosTransferModeMultipart = "multipart"
+	cosTransferModeAspera    = "aspera"
+
+	cosTransferSingle = "single"
+
+	cosMiB = int64(1024 * 1024)
+)
+
+// cosAsperaUpload uploads an object with Aspera high-speed transfer. It is nil when the provider is built
+// without the Aspera transfer SDK, the uploads that ask for Aspera then fall back to multipart.
+var cosAsperaUpload func(ctx context.Context, s3Client *s3.S3, bucketName, objectKey string, body io.Reader) error
+
+// cosObjectSize returns the size of the body and rewinds it
+func cosObjectSize(body io.ReadSeeker) (int64, error) {
+	if body == nil {
+		return 0, nil
+	}
+	size, err := body.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, err
+	}
+	_, err = body.Seek(0, io.SeekStart)
+	return size, err
+}
+
+// putCOSObject uploads the body of an object with the transfer mode of the resource and returns the transfer that was used
+func putCOSObject(ctx context.Context, d *schema.ResourceData, s3Client *s3.S3, bucketName, objectKey string, body io.ReadSeeker, websiteRedirect *string) (string, error) {
+	mode := d.Get("transfer_mode").(string)
+	threshold := int64(d.Get("multipart_threshold").(int)) * cosMiB
+
+	size, err := cosObjectSize(body)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error reading the size of object (%s): %s", objectKey, err)
+	}
+
+	if mode == cosTransferModeAspera && size >= threshold {
+		if cosAsperaUpload != nil && websiteRedirect == nil {
+			if err := cosAsperaUpload(ctx, s3Client, bucketName, objectKey, body); err != nil {
+				return "", fmt.Errorf("[ERROR] Error transferring object (%s) to COS bucket (%s) with Aspera: %s", objectKey, bucketName, err)
+			}
+			return cosTransferModeAspera, nil
+		}
+		log.Printf("[WARN] Aspera high-speed transfer is not available for object (%s), uploading it with multipart", objectKey)
+		mode = cosTransferModeMultipart
+	}
+
+	if mode == cosTransferModeMultipart && size >= threshold {
+		uploader := s3manager.NewUploaderWithClient(s3Client, func(u *s3manager.Uploader) {
+			u.PartSize = int64(d.Get("multipart_part_size").(int)) * cosMiB
+		})
+		uploadInput := &s3manager.UploadInput{
+			Bucket:                  aws.String(bucketName),
+			Key:                     aws.String(objectKey),
+			Body:                    body,
+			WebsiteRedirectLocation: websiteRedirect,
+		}
+		if _, err := uploader.UploadWithContext(ctx, uploadInput); err != nil {
+			return "", fmt.Errorf("[ERROR] Error uploading object (%s) in parts to COS bucket (%s): %s", objectKey, bucketName, err)
+		}
+		return cosTransferModeMultipart, nil
+	}
+
+	putInput := &s3.PutObjectInput{
+		Bucket:                  aws.String(bucketName),
+		Key:                     aws.String(objectKey),
+		Body:                    body,
+		WebsiteRedirectLocation: websiteRedirect,
+	}
+	if _, err := s3Client.PutObject(putInput); err != nil {
+		return "", fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err)
+	}
+	return cosTransferSingle, nil
+}
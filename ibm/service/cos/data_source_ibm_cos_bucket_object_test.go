@@ -54,3 +54,51 @@ func testAccIBMCOSBucketObjectDataSourceConfig_basic(name string, crn string) st
 			key             = ibm_cos_bucket_object.testacc.key
 		}`, name, crn)
 }
+
+func TestAccIBMCOSBucketObjectsChecksumDataSource_basic(t *testing.T) {
+	name := "tf-testacc-cos-checksum"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketObjectsChecksumDataSourceConfig_basic(name, acc.CosCRN),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects_checksum.etag", "objects.#", "1"),
+					resource.TestCheckResourceAttrPair("data.ibm_cos_bucket_objects_checksum.etag", "objects.0.checksum", "ibm_cos_bucket_object.testacc", "etag"),
+					resource.TestCheckResourceAttrSet("data.ibm_cos_bucket_objects_checksum.etag", "checksum"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects_checksum.sha256", "objects.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects_checksum.sha256", "objects.0.checksum", "0d14b7358ff43401eaa5795f0c25793cca7f3de9b84b313da19c9210718a2d25"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIBMCOSBucketObjectsChecksumDataSourceConfig_basic(name string, crn string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_bucket_object" "testacc" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			key             = "artifacts/%[1]s.txt"
+			content         = "Acceptance testing"
+		}
+		data "ibm_cos_bucket_objects_checksum" "etag" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			prefix          = "artifacts/"
+			depends_on      = [ibm_cos_bucket_object.testacc]
+		}
+		data "ibm_cos_bucket_objects_checksum" "sha256" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			keys            = [ibm_cos_bucket_object.testacc.key]
+			algorithm       = "sha256"
+		}`, name, crn)
+}
@@ -0,0 +1,246 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	cosChecksumETag   = "etag"
+	cosChecksumSHA256 = "sha256"
+)
+
+func DataSourceIBMCosBucketObjectsChecksum() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMCosBucketObjectsChecksumRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"keys"},
+				Description:   "Prefix of the keys of the objects",
+			},
+			"keys": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"prefix"},
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Description:   "Keys of the objects",
+			},
+			"algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      cosChecksumETag,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{cosChecksumETag, cosChecksumSHA256}),
+				Description:  "Checksum of the objects: etag or sha256. sha256 downloads the content of every object",
+			},
+			"objects": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Checksums of the objects, sorted by key",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "COS object key",
+						},
+						"etag": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "COS object etag, the MD5 hexdigest of objects that were not uploaded in parts",
+						},
+						"checksum": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Checksum of the object with the algorithm",
+						},
+						"content_length": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "COS object content length",
+						},
+						"last_modified": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "COS object last modified date",
+						},
+					},
+				},
+			},
+			"checksums": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Checksums of the objects by key",
+			},
+			"checksum": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA256 of the keys and checksums of all the objects, changes when any object changes",
+			},
+		},
+	}
+}
+
+// cosObjectChecksum is the checksum of an object of a bucket
+type cosObjectChecksum struct {
+	Key           string
+	ETag          string
+	Checksum      string
+	ContentLength int64
+	LastModified  *time.Time
+}
+
+func dataSourceIBMCosBucketObjectsChecksumRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+
+	bxSession, err := m.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	prefix := d.Get("prefix").(string)
+	objects := []*cosObjectChecksum{}
+	if keys, ok := d.GetOk("keys"); ok {
+		for _, key := range flex.ExpandStringList(keys.([]interface{})) {
+			out, err := s3Client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+				Bucket: aws.String(bucketName),
+				Key:    aws.String(key),
+			})
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("failed getting COS bucket (%s) object (%s): %w", bucketName, key, err))
+			}
+			objects = append(objects, &cosObjectChecksum{
+				Key:           key,
+				ETag:          strings.Trim(aws.StringValue(out.ETag), `"`),
+				ContentLength: aws.Int64Value(out.ContentLength),
+				LastModified:  out.LastModified,
+			})
+		}
+	} else {
+		listInput := &s3.ListObjectsInput{
+			Bucket: aws.String(bucketName),
+			Prefix: aws.String(prefix),
+		}
+		err = s3Client.ListObjectsPagesWithContext(ctx, listInput, func(page *s3.ListObjectsOutput, lastPage bool) bool {
+			for _, object := range page.Contents {
+				objects = append(objects, &cosObjectChecksum{
+					Key:           aws.StringValue(object.Key),
+					ETag:          strings.Trim(aws.StringValue(object.ETag), `"`),
+					ContentLength: aws.Int64Value(object.Size),
+					LastModified:  object.LastModified,
+				})
+			}
+			return !lastPage
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed listing the objects of COS bucket (%s) with prefix (%s): %w", bucketName, prefix, err))
+		}
+	}
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].Key < objects[j].Key
+	})
+
+	algorithm := d.Get("algorithm").(string)
+	for _, object := range objects {
+		if algorithm != cosChecksumSHA256 {
+			object.Checksum = object.ETag
+			continue
+		}
+		object.Checksum, err = cosObjectSHA256(ctx, s3Client, bucketName, object.Key)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	objectList := make([]map[string]interface{}, 0, len(objects))
+	checksums := make(map[string]interface{}, len(objects))
+	aggregate := sha256.New()
+	for _, object := range objects {
+		lastModified := ""
+		if object.LastModified != nil {
+			lastModified = object.LastModified.Format(time.RFC1123)
+		}
+		objectList = append(objectList, map[string]interface{}{
+			"key":            object.Key,
+			"etag":           object.ETag,
+			"checksum":       object.Checksum,
+			"content_length": object.ContentLength,
+			"last_modified":  lastModified,
+		})
+		checksums[object.Key] = object.Checksum
+		fmt.Fprintf(aggregate, "%s:%s\n", object.Key, object.Checksum)
+	}
+	log.Printf("[DEBUG] Computed the %s checksums of %d objects of COS bucket (%s)", algorithm, len(objects), bucketName)
+
+	d.SetId(fmt.Sprintf("%s:checksum:%s", bucketCRN, prefix))
+	d.Set("objects", objectList)
+	d.Set("checksums", checksums)
+	d.Set("checksum", hex.EncodeToString(aggregate.Sum(nil)))
+	return nil
+}
+
+// cosObjectSHA256 downloads an object and returns the SHA256 hexdigest of its content
+func cosObjectSHA256(ctx context.Context, s3Client *s3.S3, bucketName, objectKey string) (string, error) {
+	out, err := s3Client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objectKey),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed getting COS bucket (%s) object (%s): %w", bucketName, objectKey, err)
+	}
+	defer out.Body.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, out.Body); err != nil {
+		return "", fmt.Errorf("failed reading content of COS bucket (%s) object (%s): %w", bucketName, objectKey, err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
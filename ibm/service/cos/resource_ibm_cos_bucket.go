@@ -60,13 +60,15 @@ func resourceinstanceidDiffSuppress(_, old, new string, _ *schema.ResourceData)
 	return false
 }
 func ResourceIBMCOSBucket() *schema.Resource {
-	return &schema.Resource{
-		Read:          resourceIBMCOSBucketRead,
-		Create:        resourceIBMCOSBucketCreate,
-		Update:        resourceIBMCOSBucketUpdate,
-		Delete:        resourceIBMCOSBucketDelete,
-		Exists:        resourceIBMCOSBucketExists,
-		Importer:      &schema.ResourceImporter{},
+	return flex.WithImportDefaults(&schema.Resource{
+		Read:   resourceIBMCOSBucketRead,
+		Create: resourceIBMCOSBucketCreate,
+		Update: resourceIBMCOSBucketUpdate,
+		Delete: resourceIBMCOSBucketDelete,
+		Exists: resourceIBMCOSBucketExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMCOSBucketImport,
+		},
 		CustomizeDiff: resourceExpiryValidate,
 
 		Timeouts: &schema.ResourceTimeout{
@@ -99,6 +101,7 @@ func ResourceIBMCOSBucket() *schema.Resource {
 				ForceNew:      true,
 				Optional:      true,
 				ConflictsWith: []string{"kms_key_crn"},
+				ValidateFunc:  validate.ValidateCRNService("kms", "hs-crypto"),
 				Description:   "CRN of the key you want to use data at rest encryption",
 			},
 			"kms_key_crn": {
@@ -106,8 +109,21 @@ func ResourceIBMCOSBucket() *schema.Resource {
 				ForceNew:      true,
 				Optional:      true,
 				ConflictsWith: []string{"key_protect"},
+				ValidateFunc:  validate.ValidateCRNService("kms", "hs-crypto"),
 				Description:   "CRN of the key you want to use data at rest encryption",
 			},
+			"kms_authorization": {
+				Type:        schema.TypeBool,
+				ForceNew:    true,
+				Optional:    true,
+				Default:     false,
+				Description: "Create the authorization of the Object Storage instance to read the key of key_protect or kms_key_crn before creating the bucket",
+			},
+			"kms_authorization_policy_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the authorization policy created for the key, empty when the authorization already existed",
+			},
 			"satellite_location_id": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -171,14 +187,62 @@ func ResourceIBMCOSBucket() *schema.Resource {
 				Computed:    true,
 				Description: "Direct endpoint for the COS bucket",
 			},
+			"aspera_transfer_supported": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the objects of the bucket can be transferred with Aspera high-speed transfer",
+			},
 			"allowed_ip": {
 				Type:          schema.TypeList,
 				Optional:      true,
 				ForceNew:      false,
 				Elem:          &schema.Schema{Type: schema.TypeString},
-				ConflictsWith: []string{"satellite_location_id"},
+				ConflictsWith: []string{"satellite_location_id", "network_policy"},
+				Deprecated:    "The bucket firewall is replaced by context-based restrictions, use network_policy instead",
 				Description:   "List of IPv4 or IPv6 addresses ",
 			},
+			"network_policy": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"satellite_location_id", "allowed_ip"},
+				Description:   "Networks allowed to access the bucket, enforced by a context-based restriction rule",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vpc_crns": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "CRNs of the VPCs allowed to access the bucket, they are added to a network zone managed with the bucket",
+						},
+						"zone_ids": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "IDs of existing context-based restriction network zones allowed to access the bucket",
+						},
+						"enforcement_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "enabled",
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{"enabled", "report", "disabled"}),
+							Description:  "Enforcement mode of the rule: enabled, report or disabled",
+						},
+					},
+				},
+			},
+			"network_policy_rule_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the context-based restriction rule of the network policy",
+			},
+			"network_policy_zone_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the network zone that holds the VPCs of the network policy",
+			},
 			"activity_tracking": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -450,7 +514,7 @@ func ResourceIBMCOSBucket() *schema.Resource {
 				Description:  "Enable objectlock for the bucket. When enabled, buckets within the container vault can have Object Lock Configuration applied to the bucket.",
 			},
 		},
-	}
+	})
 }
 func ResourceIBMCOSBucketValidator() *validate.ResourceValidator {
 
@@ -1019,6 +1083,12 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	if d.HasChange("network_policy") {
+		if err := applyCOSBucketNetworkPolicy(d, meta, serviceID, bucketName); err != nil {
+			return err
+		}
+	}
+
 	return resourceIBMCOSBucketRead(d, meta)
 }
 
@@ -1178,6 +1248,14 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 	}
+	// Aspera high-speed transfer is not available for Satellite buckets and buckets encrypted with a key management service
+	_, satellite := d.GetOk("satellite_location_id")
+	kmsEncrypted := head.IBMSSEKPEnabled != nil && *head.IBMSSEKPEnabled
+	d.Set("aspera_transfer_supported", !satellite && !kmsEncrypted)
+
+	if err := readCOSBucketNetworkPolicy(d, meta); err != nil {
+		return err
+	}
 
 	if bucketPtr != nil {
 
@@ -1381,6 +1459,18 @@ func resourceIBMCOSBucketCreate(d *schema.ResourceData, meta interface{}) error
 		create.IBMSSEKPCustomerRootKeyCrn = aws.String(kmsKeyCrn.(string))
 		create.IBMSSEKPEncryptionAlgorithm = aws.String(keyAlgorithm)
 	}
+	if d.Get("kms_authorization").(bool) && create.IBMSSEKPCustomerRootKeyCrn != nil {
+		// crn:v1:<cname>:<ctype>:<service-name>:<location>:a/<account>:<service-instance>:key:<key>
+		keyCrnParts := strings.Split(*create.IBMSSEKPCustomerRootKeyCrn, ":")
+		if len(keyCrnParts) < 8 {
+			return fmt.Errorf("[ERROR] The key CRN %s is not valid", *create.IBMSSEKPCustomerRootKeyCrn)
+		}
+		policyID, err := flex.CreateAuthorizationPolicy(meta, "cloud-object-storage", d.Get("resource_instance_id").(string), keyCrnParts[4], *create.IBMSSEKPCustomerRootKeyCrn, nil)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error creating the authorization of the bucket %s to the key: %s", bucketName, err)
+		}
+		d.Set("kms_authorization_policy_id", policyID)
+	}
 
 	authEndpoint, err := rsConClient.Config.EndpointLocator.IAMEndpoint()
 	if err != nil {
@@ -1530,6 +1620,18 @@ func resourceIBMCOSBucketDelete(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("[ERROR] Error deleting COS Bucket (%s): %s", d.Id(), err)
 	}
 
+	ruleID := d.Get("network_policy_rule_id").(string)
+	zoneID := d.Get("network_policy_zone_id").(string)
+	if ruleID != "" || zoneID != "" {
+		cbrClient, err := meta.(conns.ClientSession).ContextBasedRestrictionsV1()
+		if err != nil {
+			return err
+		}
+		if err := deleteCOSBucketNetworkPolicy(cbrClient, ruleID, zoneID); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -1640,6 +1742,23 @@ func SelectSatlocCosApi(apiType string, serviceID string, bLocation string) stri
 	return ""
 }
 
+func resourceIBMCOSBucketImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// The network policy is read from the rule in the state, the rule of an imported bucket is looked up
+	if !strings.Contains(d.Id(), ":meta:") || !strings.Contains(d.Id(), ":bucket:") {
+		return []*schema.ResourceData{d}, nil
+	}
+	bucketName := parseBucketId(d.Id(), "bucketName")
+	serviceID := parseBucketId(d.Id(), "serviceID")
+	if _, _, err := cosBucketNetworkPolicyAccount(serviceID); err != nil {
+		// Satellite buckets don't support network policies
+		return []*schema.ResourceData{d}, nil
+	}
+	if err := findCOSBucketNetworkPolicy(d, meta, serviceID, bucketName); err != nil {
+		log.Printf("[WARN] The network policy of the COS Bucket %s is not imported: %s", bucketName, err)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func parseBucketId(id string, info string) string {
 	crn := strings.Split(id, ":meta:")[0]
 	meta := strings.Split(id, ":meta:")[1]
@@ -1668,6 +1787,12 @@ func parseBucketId(id string, info string) string {
 }
 
 func resourceExpiryValidate(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// The key of a regional bucket must be in the region of the bucket
+	for _, key := range []string{"key_protect", "kms_key_crn"} {
+		if err := validate.ValidateCRNSameRegion(diff, key, "region_location"); err != nil {
+			return err
+		}
+	}
 	if expire, ok := diff.GetOk("expire_rule"); ok {
 		expire_list := expire.([]interface{})
 		for _, l := range expire_list {
@@ -17,6 +17,7 @@ import (
 
 	bxsession "github.com/IBM-Cloud/bluemix-go/session"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/ibm-cos-sdk-go/aws"
 	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
@@ -30,7 +31,7 @@ import (
 )
 
 func ResourceIBMCOSBucketObject() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithImportDefaults(&schema.Resource{
 		CreateContext: resourceIBMCOSBucketObjectCreate,
 		ReadContext:   resourceIBMCOSBucketObjectRead,
 		UpdateContext: resourceIBMCOSBucketObjectUpdate,
@@ -151,8 +152,34 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 				Optional:    true,
 				Description: "Redirect a request to another object or an URL",
 			},
+			"transfer_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      cosTransferModeStandard,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{cosTransferModeStandard, cosTransferModeMultipart, cosTransferModeAspera}),
+				Description:  "Transfer of the content: standard, multipart or aspera. Aspera falls back to multipart when it is not available",
+			},
+			"multipart_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntAtLeast(5),
+				Description:  "Size in MiB from which the content is uploaded with the multipart or aspera transfer mode",
+			},
+			"multipart_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      16,
+				ValidateFunc: validation.IntAtLeast(5),
+				Description:  "Size in MiB of the parts of a multipart upload",
+			},
+			"transfer_mode_used": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Transfer used for the last upload of the content: single, multipart or aspera",
+			},
 		},
-	}
+	})
 }
 
 func resourceIBMCOSBucketObjectCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -203,19 +230,17 @@ func resourceIBMCOSBucketObjectCreate(ctx context.Context, d *schema.ResourceDat
 		}()
 	}
 
-	putInput := &s3.PutObjectInput{
-		Bucket: aws.String(bucketName),
-		Key:    aws.String(objectKey),
-		Body:   body,
-	}
+	var websiteRedirect *string
 	//if website redirect location if given for a an object
 	if v, ok := d.GetOk("website_redirect"); ok {
-		putInput.WebsiteRedirectLocation = aws.String(v.(string))
+		websiteRedirect = aws.String(v.(string))
 	}
 
-	if _, err := s3Client.PutObject(putInput); err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err))
+	transfer, err := putCOSObject(ctx, d, s3Client, bucketName, objectKey, body, websiteRedirect)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	d.Set("transfer_mode_used", transfer)
 	if v, ok := d.GetOk("object_lock_mode"); ok {
 		if d, ok := d.GetOk("object_lock_retain_until_date"); ok {
 			retainUntildate := parseDate(d.(string))
@@ -394,20 +419,18 @@ func resourceIBMCOSBucketObjectUpdate(ctx context.Context, d *schema.ResourceDat
 
 		objectKey := d.Get("key").(string)
 
-		putInput := &s3.PutObjectInput{
-			Bucket: aws.String(bucketName),
-			Key:    aws.String(objectKey),
-			Body:   body,
-		}
+		var websiteRedirect *string
 		if d.HasChange("website_redirect") {
 			if v, ok := d.GetOk("website_redirect"); ok {
-				putInput.WebsiteRedirectLocation = aws.String(v.(string))
+				websiteRedirect = aws.String(v.(string))
 			}
 		}
 
-		if _, err := s3Client.PutObject(putInput); err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err))
+		transfer, err := putCOSObject(ctx, d, s3Client, bucketName, objectKey, body, websiteRedirect)
+		if err != nil {
+			return diag.FromErr(err)
 		}
+		d.Set("transfer_mode_used", transfer)
 
 	}
 	if d.HasChange("object_lock_legal_hold_status") {
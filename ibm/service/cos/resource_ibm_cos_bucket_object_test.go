@@ -38,6 +38,8 @@ func TestAccIBMCOSBucketObject_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("ibm_cos_bucket_object.testacc", "last_modified"),
 					resource.TestCheckResourceAttrSet("ibm_cos_bucket_object.testacc", "object_sql_url"),
 					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "body", objectBody),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "transfer_mode", "standard"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "transfer_mode_used", "single"),
 				),
 			},
 			{
@@ -148,6 +148,39 @@ func TestAccIBMCosBucket_AllowedIP(t *testing.T) {
 
 }
 
+func TestAccIBMCosBucket_NetworkPolicy(t *testing.T) {
+	serviceName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform%d", acctest.RandIntRange(10, 100))
+	vpcName := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	bucketRegion := "us-south"
+	bucketClass := "standard"
+	bucketRegionType := "region_location"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCosBucket_networkPolicy(serviceName, bucketName, vpcName, bucketRegion, bucketClass, "report"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCosBucketExists("ibm_resource_instance.instance", "ibm_cos_bucket.bucket", bucketRegionType, bucketRegion, bucketName),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "network_policy.0.vpc_crns.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "network_policy.0.enforcement_mode", "report"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket.bucket", "network_policy_rule_id"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket.bucket", "network_policy_zone_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCosBucket_networkPolicy(serviceName, bucketName, vpcName, bucketRegion, bucketClass, "enabled"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "network_policy.0.enforcement_mode", "enabled"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMCosBucket_Direct(t *testing.T) {
 
 	serviceName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
@@ -689,7 +722,7 @@ func TestAccIBMCosBucket_import(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"wait_time_minutes", "parameters", "force_delete"},
+					"wait_time_minutes", "parameters"},
 			},
 		},
 	})
@@ -1587,6 +1620,38 @@ func testAccCheckIBMCosBucket_allowedip(serviceName string, bucketName string, r
 	`, serviceName, bucketName, storageClass, region, allowedIp1, allowedIp2)
 }
 
+func testAccCheckIBMCosBucket_networkPolicy(serviceName string, bucketName string, vpcName string, region string, storageClass string, enforcementMode string) string {
+
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "group" {
+		is_default=true
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+		resource_group_id = data.ibm_resource_group.group.id
+	}
+
+	resource "ibm_is_vpc" "vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name          = "%s"
+		resource_instance_id = ibm_resource_instance.instance.id
+		storage_class        = "%s"
+		region_location      = "%s"
+		network_policy {
+			vpc_crns         = [ibm_is_vpc.vpc.crn]
+			enforcement_mode = "%s"
+		}
+	}
+	`, serviceName, vpcName, bucketName, storageClass, region, enforcementMode)
+}
+
 func testAccCheckIBMCosBucket_allowedipremoved(serviceName string, bucketName string, regiontype string, region string, storageClass string) string {
 
 	return fmt.Sprintf(`
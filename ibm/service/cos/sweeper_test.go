@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos_test
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam"
+	"github.com/IBM/ibm-cos-sdk-go/aws/session"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cos"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("ibm_cos_bucket", &resource.Sweeper{
+		Name: "ibm_cos_bucket",
+		F:    testSweepCosBuckets,
+	})
+}
+
+// testSweepCosBuckets empties and deletes the buckets of the region in the instance set by IBM_COS_CRN
+func testSweepCosBuckets(region string) error {
+	if acc.CosCRN == "" {
+		log.Printf("[WARN] Skipping the sweep of the COS buckets, IBM_COS_CRN is not set")
+		return nil
+	}
+	apiKey := os.Getenv("IC_API_KEY")
+	if apiKey == "" {
+		return fmt.Errorf("[ERROR] IC_API_KEY must be set for the sweepers")
+	}
+
+	apiEndpoint, _, _ := cos.SelectCosApi("rl", region)
+	apiEndpoint = conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, apiEndpoint)
+	authEndpoint := conns.EnvFallBack([]string{"IBMCLOUD_IAM_API_ENDPOINT"}, "https://iam.cloud.ibm.com") + "/identity/token"
+	s3Conf := aws.NewConfig().WithEndpoint(apiEndpoint).WithCredentials(ibmiam.NewStaticCredentials(aws.NewConfig(), authEndpoint, apiKey, acc.CosCRN)).WithS3ForcePathStyle(true)
+	s3Client := s3.New(session.Must(session.NewSession()), s3Conf)
+
+	buckets, err := s3Client.ListBucketsExtended(&s3.ListBucketsExtendedInput{})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error listing the COS buckets: %s", err)
+	}
+
+	var sweepErrs []string
+	for _, bucket := range buckets.Buckets {
+		name := aws.StringValue(bucket.Name)
+		if !strings.HasPrefix(aws.StringValue(bucket.LocationConstraint), region+"-") || !acc.IsSweepable(name) {
+			continue
+		}
+		log.Printf("[INFO] Deleting the COS bucket %s", name)
+		if err := testEmptyCosBucket(s3Client, name); err != nil {
+			sweepErrs = append(sweepErrs, fmt.Sprintf("%s: %s", name, err))
+			continue
+		}
+		if _, err := s3Client.DeleteBucket(&s3.DeleteBucketInput{Bucket: bucket.Name}); err != nil {
+			sweepErrs = append(sweepErrs, fmt.Sprintf("%s: %s", name, err))
+		}
+	}
+	return acc.SweepErrors(sweepErrs)
+}
+
+// testEmptyCosBucket deletes all the object versions and delete markers of the bucket
+func testEmptyCosBucket(s3Client *s3.S3, bucketName string) error {
+	var lastErr error
+	input := &s3.ListObjectVersionsInput{Bucket: aws.String(bucketName)}
+	err := s3Client.ListObjectVersionsPages(input, func(page *s3.ListObjectVersionsOutput, lastPage bool) bool {
+		for _, version := range page.Versions {
+			if _, err := s3Client.DeleteObject(&s3.DeleteObjectInput{Bucket: input.Bucket, Key: version.Key, VersionId: version.VersionId}); err != nil {
+				lastErr = err
+			}
+		}
+		for _, marker := range page.DeleteMarkers {
+			if _, err := s3Client.DeleteObject(&s3.DeleteObjectInput{Bucket: input.Bucket, Key: marker.Key, VersionId: marker.VersionId}); err != nil {
+				lastErr = err
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return err
+	}
+	return lastErr
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 )
 
 func DataSourceIBMDatabaseRemotes() *schema.Resource {
@@ -42,6 +43,40 @@ func DataSourceIBMDatabaseRemotes() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"leader_location": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Location of the leader, if applicable.",
+			},
+			"replica_details": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Details of the replicas, if applicable.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Replica ID.",
+						},
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the replica.",
+						},
+						"location": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Location of the replica.",
+						},
+						"state": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "State of the replica instance.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -84,13 +119,53 @@ func dataSourceIBMDatabaseRemotesRead(context context.Context, d *schema.Resourc
 		if err = d.Set("leader", remotes.Remotes.Leader); err != nil {
 			return diag.FromErr(fmt.Errorf("Error setting leader: %s", err))
 		}
+		if *remotes.Remotes.Leader != "" {
+			leader, err := getDatabaseRemoteInstance(*remotes.Remotes.Leader, meta)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if err = d.Set("leader_location", leader.RegionID); err != nil {
+				return diag.FromErr(fmt.Errorf("Error setting leader_location: %s", err))
+			}
+		}
 	}
 
 	if remotes.Remotes.Replicas != nil {
 		if err = d.Set("replicas", remotes.Remotes.Replicas); err != nil {
 			return diag.FromErr(fmt.Errorf("Error setting replicas: %s", err))
 		}
+		replicaDetails := make([]map[string]interface{}, 0, len(remotes.Remotes.Replicas))
+		for _, replicaID := range remotes.Remotes.Replicas {
+			replica, err := getDatabaseRemoteInstance(replicaID, meta)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			replicaDetails = append(replicaDetails, map[string]interface{}{
+				"id":       replicaID,
+				"name":     replica.Name,
+				"location": replica.RegionID,
+				"state":    replica.State,
+			})
+		}
+		if err = d.Set("replica_details", replicaDetails); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting replica_details: %s", err))
+		}
 	}
 
 	return nil
 }
+
+// getDatabaseRemoteInstance returns the resource instance of a leader or of a replica
+func getDatabaseRemoteInstance(id string, meta interface{}) (*rc.ResourceInstance, error) {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return nil, err
+	}
+	instance, response, err := rsConClient.GetResourceInstance(&rc.GetResourceInstanceOptions{
+		ID: &id,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving resource instance %s: %s\n%s", id, err, response)
+	}
+	return instance, nil
+}
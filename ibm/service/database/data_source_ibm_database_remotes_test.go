@@ -28,6 +28,9 @@ func TestAccIBMDatabaseRemotesDataSourceBasic(t *testing.T) {
 					resource.TestCheckResourceAttr("data.ibm_database_remotes.database_remotes", "leader", ""),
 					resource.TestCheckResourceAttrSet("data.ibm_database_remotes.database_remotes_replica", "leader"),
 					resource.TestCheckResourceAttrSet("data.ibm_database_remotes.database_remotes", "replicas.#"),
+					resource.TestCheckResourceAttr("data.ibm_database_remotes.database_remotes", "replica_details.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_database_remotes.database_remotes", "replica_details.0.location", acc.IcdDbRegion),
+					resource.TestCheckResourceAttrSet("data.ibm_database_remotes.database_remotes_replica", "leader_location"),
 				),
 			},
 		},
@@ -13,6 +13,7 @@ import (
 	"reflect"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -29,6 +30,8 @@ import (
 	"github.com/IBM-Cloud/bluemix-go/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/resourcecontroller"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -84,7 +87,8 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			resourceIBMDatabaseInstanceDiff,
-			checkV5Groups),
+			checkV5Groups,
+			resourceIBMDatabaseInstancePasswordSecretsDiff),
 
 		Importer: &schema.ResourceImporter{},
 
@@ -154,15 +158,28 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 				Computed:    true,
 			},
 			"adminpassword": {
-				Description:  "The admin user password for the instance",
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringLenBetween(10, 32),
-				Sensitive:    true,
+				Description:   "The admin user password for the instance",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringLenBetween(10, 32),
+				Sensitive:     true,
+				ConflictsWith: []string{"adminpassword_secret_crn"},
 				// DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 				//  return true
 				// },
 			},
+			"adminpassword_secret_crn": {
+				Description:   "The CRN of the Secrets Manager secret that holds the admin user password for the instance",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"adminpassword"},
+			},
+			"password_secret_versions": {
+				Description: "The number of versions of each Secrets Manager secret when the passwords were last set, used to apply rotated secrets",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"configuration": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -298,6 +315,13 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_database", "tags")},
 				Set:      flex.ResourceIBMVPCHash,
 			},
+			"deletion_protection": resourcecontroller.ResourceIBMResourceInstanceDeletionProtectionSchema(),
+			"skip_final_backup": {
+				Description: "Whether the instance is deleted without an on-demand backup. When it is false, a backup is taken and completed before the instance is deleted.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
 			"point_in_time_recovery_deployment_id": {
 				Description:      "The CRN of source instance",
 				Type:             schema.TypeString,
@@ -324,10 +348,15 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 						"password": {
 							Description:  "User password",
 							Type:         schema.TypeString,
-							Required:     true,
+							Optional:     true,
 							Sensitive:    true,
 							ValidateFunc: validation.StringLenBetween(10, 32),
 						},
+						"password_secret_crn": {
+							Description: "The CRN of the Secrets Manager secret that holds the user password",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
 						"type": {
 							Description:  "User type",
 							Type:         schema.TypeString,
@@ -1233,25 +1262,13 @@ func resourceIBMDatabaseInstanceDiff(_ context.Context, diff *schema.ResourceDif
 			return fmt.Errorf("[ERROR] configuration JSON invalid\n%s", err)
 		}
 
-		var unmarshalFn func(m map[string]json.RawMessage, result interface{}) (err error)
-
-		var configuration clouddatabasesv5.ConfigurationIntf = new(clouddatabasesv5.Configuration)
-
-		switch service {
-		case "databases-for-postgresql":
-			unmarshalFn = clouddatabasesv5.UnmarshalConfigurationPgConfiguration
-		case "databases-for-enterprisedb":
-			unmarshalFn = clouddatabasesv5.UnmarshalConfigurationPgConfiguration
-		case "databases-for-redis":
-			unmarshalFn = clouddatabasesv5.UnmarshalConfigurationRedisConfiguration
-		case "databases-for-mysql":
-			unmarshalFn = clouddatabasesv5.UnmarshalConfigurationMySQLConfiguration
-		case "messages-for-rabbitmq":
-			unmarshalFn = clouddatabasesv5.UnmarshalConfigurationRabbitMqConfiguration
-		default:
+		unmarshalFn, ok := databaseConfigurationUnmarshallers[service]
+		if !ok {
 			return fmt.Errorf("[ERROR] configuration is not supported for %s", service)
 		}
 
+		var configuration clouddatabasesv5.ConfigurationIntf = new(clouddatabasesv5.Configuration)
+
 		err = core.UnmarshalModel(rawConfig, "", &configuration, unmarshalFn)
 		if err != nil {
 			return fmt.Errorf("[ERROR] configuration is invalid\n%s", err)
@@ -1271,8 +1288,75 @@ func resourceIBMDatabaseInstanceDiff(_ context.Context, diff *schema.ResourceDif
 		if len(invalidFields) != 0 {
 			return fmt.Errorf("[ERROR] configuration contained invalid field(s): %s", invalidFields)
 		}
+
+		// The configuration schema of the deployment is only known once it exists
+		if configSchema, ok := diff.GetOk("configuration_schema"); ok && diff.Id() != "" {
+			err = validateDatabaseConfiguration(rawConfig, configSchema.(string))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Unmarshal functions of the engine specific configurations, by service
+var databaseConfigurationUnmarshallers = map[string]func(m map[string]json.RawMessage, result interface{}) (err error){
+	"databases-for-postgresql":   clouddatabasesv5.UnmarshalConfigurationPgConfiguration,
+	"databases-for-enterprisedb": clouddatabasesv5.UnmarshalConfigurationPgConfiguration,
+	"databases-for-redis":        clouddatabasesv5.UnmarshalConfigurationRedisConfiguration,
+	"databases-for-mysql":        clouddatabasesv5.UnmarshalConfigurationMySQLConfiguration,
+	"messages-for-rabbitmq":      clouddatabasesv5.UnmarshalConfigurationRabbitMqConfiguration,
+}
+
+// Validates the configuration against the configuration schema of the deployment, the schema
+// describes each setting with its type, and minimum and maximum or allowed choices. The settings
+// that are not in the schema are left to the API, they are already checked against the engine.
+func validateDatabaseConfiguration(rawConfig map[string]json.RawMessage, configSchemaJSON string) error {
+	var configSchema map[string]interface{}
+	if err := json.Unmarshal([]byte(configSchemaJSON), &configSchema); err != nil {
+		// The configuration is still validated by the API when it is applied
+		log.Printf("[WARN] The configuration is not validated, the configuration schema of the deployment is invalid: %s", err)
+		return nil
+	}
+	if s, ok := configSchema["schema"].(map[string]interface{}); ok {
+		configSchema = s
 	}
 
+	for name, rawValue := range rawConfig {
+		setting, ok := configSchema[name].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if min, ok := setting["minimum"].(float64); ok {
+			var value float64
+			if json.Unmarshal(rawValue, &value) == nil && value < min {
+				return fmt.Errorf("[ERROR] configuration %s must be at least %v, got %v", name, min, value)
+			}
+		}
+		if max, ok := setting["maximum"].(float64); ok {
+			var value float64
+			if json.Unmarshal(rawValue, &value) == nil && value > max {
+				return fmt.Errorf("[ERROR] configuration %s must be at most %v, got %v", name, max, value)
+			}
+		}
+		if choices, ok := setting["choices"].([]interface{}); ok && len(choices) > 0 {
+			var value string
+			if json.Unmarshal(rawValue, &value) == nil {
+				valid := false
+				for _, choice := range choices {
+					if fmt.Sprint(choice) == value {
+						valid = true
+						break
+					}
+				}
+				if !valid {
+					return fmt.Errorf("[ERROR] configuration %s must be one of %v, got %s", name, choices, value)
+				}
+			}
+		}
+	}
 	return nil
 }
 
@@ -1537,9 +1621,11 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 	instanceID := *instance.ID
 	icdId := flex.EscapeUrlParm(instanceID)
 
-	if pw, ok := d.GetOk("adminpassword"); ok {
-		adminPassword := pw.(string)
-
+	adminPassword, err := databaseAdminPassword(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if adminPassword != "" {
 		getDeploymentInfoOptions := &clouddatabasesv5.GetDeploymentInfoOptions{
 			ID: core.StringPtr(instanceID),
 		}
@@ -1738,6 +1824,18 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 		}
 	}
 
+	if d.Get("deletion_protection").(bool) {
+		if err := resourcecontroller.ResourceIBMResourceInstanceSetLock(meta, instanceID, true); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	secretVersions, err := databasePasswordSecretVersions(meta, d.Get("adminpassword_secret_crn").(string), d.Get("users").(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("password_secret_versions", secretVersions)
+
 	return resourceIBMDatabaseInstanceRead(context, d, meta)
 }
 
@@ -1776,6 +1874,7 @@ func resourceIBMDatabaseInstanceRead(context context.Context, d *schema.Resource
 	d.Set("tags", tags)
 	d.Set("name", *instance.Name)
 	d.Set("status", *instance.State)
+	d.Set("deletion_protection", instance.Locked != nil && *instance.Locked)
 	d.Set("resource_group_id", *instance.ResourceGroupID)
 	if instance.CRN != nil {
 		location := strings.Split(*instance.CRN, ":")
@@ -1915,7 +2014,7 @@ func resourceIBMDatabaseInstanceRead(context context.Context, d *schema.Resource
 	}
 	d.Set("connectionstrings", flex.FlattenConnectionStrings(connectionStrings))
 
-	if serviceOff == "databases-for-postgresql" || serviceOff == "databases-for-redis" || serviceOff == "databases-for-enterprisedb" {
+	if _, ok := databaseConfigurationUnmarshallers[serviceOff]; ok {
 		configSchema, err := icdClient.Configurations().GetConfiguration(icdId)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error getting database (%s) configuration schema : %s", icdId, err))
@@ -1959,6 +2058,12 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 	}
 
 	if update {
+		// A locked instance can't be updated, it is locked again at the end of the update
+		if oldProtection, _ := d.GetChange("deletion_protection"); oldProtection.(bool) {
+			if err := resourcecontroller.ResourceIBMResourceInstanceSetLock(meta, instanceID, false); err != nil {
+				return diag.FromErr(err)
+			}
+		}
 		_, response, err := rsConClient.UpdateResourceInstance(&updateReq)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error updating resource instance: %s %s", err, response))
@@ -2205,9 +2310,14 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 		}
 	}
 
-	if d.HasChange("adminpassword") {
+	rotatedSecrets := rotatedDatabasePasswordSecrets(d)
+
+	if d.HasChanges("adminpassword", "adminpassword_secret_crn") || rotatedSecrets[d.Get("adminpassword_secret_crn").(string)] {
 		adminUser := d.Get("adminuser").(string)
-		password := d.Get("adminpassword").(string)
+		password, err := databaseAdminPassword(d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		user := &clouddatabasesv5.APasswordSettingUser{
 			Password: &password,
 		}
@@ -2266,7 +2376,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 		}
 	}
 
-	if d.HasChange("users") {
+	if d.HasChange("users") || len(rotatedSecrets) > 0 {
 		oldUsers, newUsers := d.GetChange("users")
 		userChanges := make(map[string]*userChange)
 		userKey := func(raw map[string]interface{}) string {
@@ -2322,7 +2432,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 
 			if change.New != nil {
 				// No change
-				if change.Old != nil && change.Old["password"].(string) == change.New["password"].(string) && change.Old["name"].(string) == change.New["name"].(string) {
+				if change.Old != nil && change.Old["password"].(string) == change.New["password"].(string) && change.Old["password_secret_crn"].(string) == change.New["password_secret_crn"].(string) && change.Old["name"].(string) == change.New["name"].(string) && !rotatedSecrets[change.New["password_secret_crn"].(string)] {
 					continue
 				}
 
@@ -2415,6 +2525,20 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 		}
 	}
 
+	if d.HasChange("deletion_protection") || (update && d.Get("deletion_protection").(bool)) {
+		if err := resourcecontroller.ResourceIBMResourceInstanceSetLock(meta, instanceID, d.Get("deletion_protection").(bool)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChanges("adminpassword_secret_crn", "users", "password_secret_versions") {
+		secretVersions, err := databasePasswordSecretVersions(meta, d.Get("adminpassword_secret_crn").(string), d.Get("users").(*schema.Set))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("password_secret_versions", secretVersions)
+	}
+
 	return resourceIBMDatabaseInstanceRead(context, d, meta)
 }
 
@@ -2523,11 +2647,28 @@ func getConnectionString(d *schema.ResourceData, userName, connectionEndpoint st
 }
 
 func resourceIBMDatabaseInstanceDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := resourcecontroller.ResourceIBMResourceInstanceCheckDeletionProtection(d); err != nil {
+		return diag.FromErr(err)
+	}
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	id := d.Id()
+
+	var diags diag.Diagnostics
+	if !d.Get("skip_final_backup").(bool) {
+		backupID, err := createDatabaseFinalBackup(id, d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Final backup of database %s", id),
+			Detail:   fmt.Sprintf("The final backup of the database was taken before it was deleted, its ID is %s", backupID),
+		})
+	}
+
 	recursive := true
 	deleteReq := rc.DeleteResourceInstanceOptions{
 		Recursive: &recursive,
@@ -2554,8 +2695,54 @@ func resourceIBMDatabaseInstanceDelete(context context.Context, d *schema.Resour
 
 	d.SetId("")
 
-	return nil
+	return diags
 }
+
+// createDatabaseFinalBackup takes an on-demand backup of the instance before it is deleted, waits for the backup
+// to complete, and returns the ID of the backup.
+func createDatabaseFinalBackup(instanceID string, d *schema.ResourceData, meta interface{}) (string, error) {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
+	}
+
+	startOndemandBackupOptions := cloudDatabasesClient.NewStartOndemandBackupOptions(instanceID)
+	startOndemandBackupResponse, response, err := cloudDatabasesClient.StartOndemandBackup(startOndemandBackupOptions)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error starting the final backup of database (%s): %s %s", instanceID, err, response)
+	}
+	if startOndemandBackupResponse.Task == nil || startOndemandBackupResponse.Task.ID == nil {
+		return "", fmt.Errorf("[ERROR] Error starting the final backup of database (%s): no task was returned", instanceID)
+	}
+
+	taskID := *startOndemandBackupResponse.Task.ID
+	_, err = waitForDatabaseTaskComplete(taskID, d, meta, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error waiting for the final backup of database (%s) to complete: %s", instanceID, err)
+	}
+
+	// The backup of the task is the latest on-demand backup of the instance
+	listDeploymentBackupsOptions := cloudDatabasesClient.NewListDeploymentBackupsOptions(instanceID)
+	backups, response, err := cloudDatabasesClient.ListDeploymentBackups(listDeploymentBackupsOptions)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error listing the backups of database (%s): %s %s", instanceID, err, response)
+	}
+	var finalBackup *clouddatabasesv5.Backup
+	for i, backup := range backups.Backups {
+		if backup.Type == nil || *backup.Type != clouddatabasesv5.BackupTypeOnDemandConst || backup.Status == nil || *backup.Status != clouddatabasesv5.BackupStatusCompletedConst || backup.CreatedAt == nil {
+			continue
+		}
+		if finalBackup == nil || time.Time(*backup.CreatedAt).After(time.Time(*finalBackup.CreatedAt)) {
+			finalBackup = &backups.Backups[i]
+		}
+	}
+	if finalBackup == nil || finalBackup.ID == nil {
+		return "", fmt.Errorf("[ERROR] The final backup of database (%s) was not found", instanceID)
+	}
+	log.Printf("[INFO] Took the final backup %s of database (%s)", *finalBackup.ID, instanceID)
+	return *finalBackup.ID, nil
+}
+
 func resourceIBMDatabaseInstanceExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
@@ -3130,12 +3317,110 @@ func checkV5Groups(_ context.Context, diff *schema.ResourceDiff, meta interface{
 	return nil
 }
 
+// Returns the admin password of the instance, read from Secrets Manager when adminpassword_secret_crn is set
+func databaseAdminPassword(d *schema.ResourceData, meta interface{}) (string, error) {
+	if secretCRN, ok := d.GetOk("adminpassword_secret_crn"); ok {
+		return secretsmanager.GetSecretValueByCRN(meta, secretCRN.(string))
+	}
+	return d.Get("adminpassword").(string), nil
+}
+
+// Returns the password of a user, read from Secrets Manager when password_secret_crn is set
+func databaseUserPassword(userData map[string]interface{}, meta interface{}) (string, error) {
+	if secretCRN, ok := userData["password_secret_crn"]; ok && secretCRN.(string) != "" {
+		return secretsmanager.GetSecretValueByCRN(meta, secretCRN.(string))
+	}
+	if password := userData["password"].(string); password != "" {
+		return password, nil
+	}
+	return "", fmt.Errorf("[ERROR] Exactly one of password or password_secret_crn must be set for user %s", userData["name"].(string))
+}
+
+// Checks that each user sets exactly one of password or password_secret_crn, and plans an update of
+// the passwords whose Secrets Manager secret was rotated since they were last set
+func resourceIBMDatabaseInstancePasswordSecretsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) (err error) {
+	if !diff.NewValueKnown("users") || !diff.NewValueKnown("adminpassword_secret_crn") {
+		return nil
+	}
+	users := diff.Get("users").(*schema.Set)
+	for _, raw := range users.List() {
+		user := raw.(map[string]interface{})
+		if err := validateDatabaseUserPassword(user); err != nil {
+			return err
+		}
+	}
+
+	if diff.Id() == "" {
+		return nil
+	}
+	secretVersions, err := databasePasswordSecretVersions(meta, diff.Get("adminpassword_secret_crn").(string), users)
+	if err != nil {
+		return err
+	}
+	if !reflect.DeepEqual(diff.Get("password_secret_versions").(map[string]interface{}), secretVersions) {
+		return diff.SetNew("password_secret_versions", secretVersions)
+	}
+	return nil
+}
+
+// Returns an error unless exactly one of password or password_secret_crn is set for the user
+func validateDatabaseUserPassword(user map[string]interface{}) error {
+	hasPassword := user["password"].(string) != ""
+	hasSecret := user["password_secret_crn"].(string) != ""
+	if hasPassword == hasSecret {
+		return fmt.Errorf("[ERROR] Exactly one of password or password_secret_crn must be set for user %s", user["name"].(string))
+	}
+	return nil
+}
+
+// Returns the number of versions of the Secrets Manager secrets that hold the admin and user passwords, by secret CRN
+func databasePasswordSecretVersions(meta interface{}, adminSecretCRN string, users *schema.Set) (map[string]interface{}, error) {
+	secretCRNs := []string{}
+	if adminSecretCRN != "" {
+		secretCRNs = append(secretCRNs, adminSecretCRN)
+	}
+	for _, raw := range users.List() {
+		if secretCRN := raw.(map[string]interface{})["password_secret_crn"].(string); secretCRN != "" {
+			secretCRNs = append(secretCRNs, secretCRN)
+		}
+	}
+
+	secretVersions := make(map[string]interface{}, len(secretCRNs))
+	for _, secretCRN := range secretCRNs {
+		versionsTotal, err := secretsmanager.GetSecretVersionsTotalByCRN(meta, secretCRN)
+		if err != nil {
+			return nil, err
+		}
+		secretVersions[secretCRN] = strconv.FormatInt(versionsTotal, 10)
+	}
+	return secretVersions, nil
+}
+
+// Returns the Secrets Manager secrets that were rotated since the passwords were last set
+func rotatedDatabasePasswordSecrets(d *schema.ResourceData) map[string]bool {
+	rotated := map[string]bool{}
+	if !d.HasChange("password_secret_versions") {
+		return rotated
+	}
+	oldVersions, newVersions := d.GetChange("password_secret_versions")
+	for secretCRN, version := range newVersions.(map[string]interface{}) {
+		if oldVersion, ok := oldVersions.(map[string]interface{})[secretCRN]; ok && oldVersion != version {
+			rotated[secretCRN] = true
+		}
+	}
+	return rotated
+}
+
 // Updates and creates users. Because we cannot get users, we first attempt to update the users, then create them
 func userUpdateCreate(userData map[string]interface{}, instanceID string, meta interface{}, d *schema.ResourceData) (err error) {
 	cloudDatabasesClient, _ := meta.(conns.ClientSession).CloudDatabasesV5()
+	password, err := databaseUserPassword(userData, meta)
+	if err != nil {
+		return err
+	}
 	// Attempt to update user password
 	passwordSettingUser := &clouddatabasesv5.APasswordSettingUser{
-		Password: core.StringPtr(userData["password"].(string)),
+		Password: core.StringPtr(password),
 	}
 
 	changeUserPasswordOptions := &clouddatabasesv5.ChangeUserPasswordOptions{
@@ -3171,7 +3456,7 @@ func userUpdateCreate(userData map[string]interface{}, instanceID string, meta i
 		//Attempt to create user
 		userEntry := &clouddatabasesv5.User{
 			Username: core.StringPtr(userData["name"].(string)),
-			Password: core.StringPtr(userData["password"].(string)),
+			Password: core.StringPtr(password),
 		}
 
 		// User Role only for ops_manager user type
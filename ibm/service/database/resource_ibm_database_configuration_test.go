@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+const testDatabaseConfigurationSchema = `{
+	"schema": {
+		"max_connections": {"type": "integer", "minimum": 115, "maximum": 5000},
+		"log_min_duration_statement": {"type": "integer", "minimum": -1},
+		"synchronous_commit": {"type": "string", "choices": ["local", "off"]}
+	}
+}`
+
+func TestValidateDatabaseConfiguration(t *testing.T) {
+	testCases := []struct {
+		name          string
+		configuration string
+		schema        string
+		expectedError string
+	}{
+		{"valid", `{"max_connections": 200, "synchronous_commit": "off"}`, testDatabaseConfigurationSchema, ""},
+		{"valid bounds", `{"max_connections": 5000, "log_min_duration_statement": -1}`, testDatabaseConfigurationSchema, ""},
+		{"below minimum", `{"max_connections": 100}`, testDatabaseConfigurationSchema, "max_connections must be at least 115"},
+		{"above maximum", `{"max_connections": 6000}`, testDatabaseConfigurationSchema, "max_connections must be at most 5000"},
+		{"invalid choice", `{"synchronous_commit": "remote_write"}`, testDatabaseConfigurationSchema, "synchronous_commit must be one of"},
+		{"unknown key", `{"tcp_keepalives_idle": 10}`, testDatabaseConfigurationSchema, ""},
+		{"schema without the schema key", `{"max_connections": 100}`, `{"max_connections": {"minimum": 115}}`, "max_connections must be at least 115"},
+		{"invalid schema", `{"max_connections": 100}`, `{"schema":`, ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var rawConfig map[string]json.RawMessage
+			if err := json.Unmarshal([]byte(tc.configuration), &rawConfig); err != nil {
+				t.Fatalf("invalid configuration: %s", err)
+			}
+			err := validateDatabaseConfiguration(rawConfig, tc.schema)
+			if tc.expectedError == "" {
+				if err != nil {
+					t.Errorf("expected no error, got %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.expectedError) {
+				t.Errorf("expected an error containing %q, got %v", tc.expectedError, err)
+			}
+		})
+	}
+}
@@ -68,8 +68,13 @@ func TestAccIBMMysqlDatabaseInstanceBasic(t *testing.T) {
 					resource.TestMatchResourceAttr(name, "connectionstrings.0.certbase64", regexp.MustCompile("^(?:[A-Za-z0-9+/]{4})*(?:[A-Za-z0-9+/]{2}==|[A-Za-z0-9+/]{3}=)?$")),
 					resource.TestMatchResourceAttr(name, "connectionstrings.0.database", regexp.MustCompile("[-a-z0-9]+")),
 					resource.TestCheckResourceAttr(name, "tags.#", "1"),
+					resource.TestMatchResourceAttr(name, "configuration_schema", regexp.MustCompile("innodb_buffer_pool_size_percentage")),
 				),
 			},
+			{
+				Config:      testAccCheckIBMDatabaseInstanceMysqlInvalidConfiguration(databaseResourceGroup, testName),
+				ExpectError: regexp.MustCompile("configuration innodb_buffer_pool_size_percentage must be at most"),
+			},
 		},
 	})
 }
@@ -155,3 +160,35 @@ func testAccCheckIBMDatabaseInstanceMysqlFullyspecified(databaseResourceGroup st
 	}
 				`, databaseResourceGroup, name, acc.IcdDbRegion)
 }
+
+func testAccCheckIBMDatabaseInstanceMysqlInvalidConfiguration(databaseResourceGroup string, name string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		name = "%[1]s"
+	}
+
+	resource "ibm_database" "%[2]s" {
+		resource_group_id            = data.ibm_resource_group.test_acc.id
+		name                         = "%[2]s"
+		service                      = "databases-for-mysql"
+		plan                         = "standard"
+		location                     = "%[3]s"
+		adminpassword                = "password12"
+		members_memory_allocation_mb = 6144
+		members_disk_allocation_mb   = 92160
+		members_cpu_allocation_count = 12
+		service_endpoints            = "public-and-private"
+		tags                         = ["one:two"]
+		configuration = <<CONFIGURATION
+		{
+			"innodb_buffer_pool_size_percentage": 200
+		}
+		CONFIGURATION
+		timeouts {
+			create = "120m"
+			update = "120m"
+			delete = "15m"
+		}
+	}
+				`, databaseResourceGroup, name, acc.IcdDbRegion)
+}
@@ -433,6 +433,76 @@ func TestAccIBMDatabaseInstancePostgresPITR(t *testing.T) {
 	})
 }
 
+func TestAccIBMDatabaseInstancePostgresFinalBackup(t *testing.T) {
+	t.Parallel()
+	databaseResourceGroup := "default"
+	var databaseInstanceOne string
+	serviceName := fmt.Sprintf("tf-Pgress-%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_database." + serviceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseInstancePostgresFinalBackup(databaseResourceGroup, serviceName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists(resourceName, &databaseInstanceOne),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
+					resource.TestCheckResourceAttr(resourceName, "skip_final_backup", "false"),
+				),
+			},
+			{
+				// The instance is destroyed with a final backup once the protection is disabled
+				Config: testAccCheckIBMDatabaseInstancePostgresFinalBackup(databaseResourceGroup, serviceName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists(resourceName, &databaseInstanceOne),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMDatabaseInstancePostgresPasswordSecret(t *testing.T) {
+	t.Parallel()
+	databaseResourceGroup := "default"
+	var databaseInstanceOne string
+	testName := fmt.Sprintf("tf-Pgress-%d", acctest.RandIntRange(10, 100))
+	name := "ibm_database." + testName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseInstancePostgresPasswordSecret(databaseResourceGroup, testName, "secure-Password67890"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists(name, &databaseInstanceOne),
+					resource.TestCheckResourceAttrPair(name, "adminpassword_secret_crn", "ibm_sm_arbitrary_secret.admin_password", "crn"),
+					resource.TestCheckResourceAttr(name, "users.#", "1"),
+					resource.TestCheckResourceAttr(name, "connectionstrings.#", "2"),
+					resource.TestCheckResourceAttr(name, "password_secret_versions.%", "2"),
+				),
+			},
+			{
+				// Rotating the user password secret applies the new password
+				Config: testAccCheckIBMDatabaseInstancePostgresPasswordSecret(databaseResourceGroup, testName, "secure-Password13579"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists(name, &databaseInstanceOne),
+					resource.TestCheckResourceAttr(name, "password_secret_versions.%", "2"),
+				),
+			},
+			{
+				Config:      testAccCheckIBMDatabaseInstancePostgresPasswordAndSecret(databaseResourceGroup, testName),
+				ExpectError: regexp.MustCompile("Exactly one of password or password_secret_crn must be set for user user123"),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMDatabaseInstanceDestroy(s *terraform.State) error {
 	rsContClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
@@ -1044,6 +1114,25 @@ func testAccCheckIBMDatabaseInstancePostgresMinimal(databaseResourceGroup string
 				`, databaseResourceGroup, name, acc.IcdDbRegion)
 }
 
+func testAccCheckIBMDatabaseInstancePostgresFinalBackup(databaseResourceGroup string, name string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		is_default = true
+		# name = "%[1]s"
+	}
+
+	resource "ibm_database" "%[2]s" {
+		resource_group_id   = data.ibm_resource_group.test_acc.id
+		name                = "%[2]s"
+		service             = "databases-for-postgresql"
+		plan                = "standard"
+		location            = "%[3]s"
+		deletion_protection = %[4]t
+		skip_final_backup   = false
+	}
+				`, databaseResourceGroup, name, acc.IcdDbRegion, deletionProtection)
+}
+
 func testAccCheckIBMDatabaseInstancePostgresMinimal_PITR(databaseResourceGroup string, name string) string {
 	return fmt.Sprintf(`
 	data "ibm_resource_group" "test_acc" {
@@ -1070,3 +1159,76 @@ func testAccCheckIBMDatabaseInstancePostgresMinimal_PITR(databaseResourceGroup s
 	}
 				`, databaseResourceGroup, name, acc.IcdDbRegion)
 }
+
+func testAccCheckIBMDatabaseInstancePostgresPasswordSecret(databaseResourceGroup string, name string, userPassword string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		is_default = true
+		# name = "%[1]s"
+	}
+
+	resource "ibm_sm_arbitrary_secret" "admin_password" {
+		instance_id = "%[4]s"
+		region      = "%[5]s"
+		name        = "%[2]s-admin-password"
+		payload     = "secure-Password12345"
+	}
+
+	resource "ibm_sm_arbitrary_secret" "user_password" {
+		instance_id = "%[4]s"
+		region      = "%[5]s"
+		name        = "%[2]s-user-password"
+		payload     = "%[6]s"
+	}
+
+	resource "ibm_database" "%[2]s" {
+		resource_group_id        = data.ibm_resource_group.test_acc.id
+		name                     = "%[2]s"
+		service                  = "databases-for-postgresql"
+		plan                     = "standard"
+		location                 = "%[3]s"
+		adminpassword_secret_crn = ibm_sm_arbitrary_secret.admin_password.crn
+		users {
+			name                = "user123"
+			password_secret_crn = ibm_sm_arbitrary_secret.user_password.crn
+		}
+	}
+				`, databaseResourceGroup, name, acc.IcdDbRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, userPassword)
+}
+
+func testAccCheckIBMDatabaseInstancePostgresPasswordAndSecret(databaseResourceGroup string, name string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		is_default = true
+		# name = "%[1]s"
+	}
+
+	resource "ibm_sm_arbitrary_secret" "admin_password" {
+		instance_id = "%[4]s"
+		region      = "%[5]s"
+		name        = "%[2]s-admin-password"
+		payload     = "secure-Password12345"
+	}
+
+	resource "ibm_sm_arbitrary_secret" "user_password" {
+		instance_id = "%[4]s"
+		region      = "%[5]s"
+		name        = "%[2]s-user-password"
+		payload     = "secure-Password13579"
+	}
+
+	resource "ibm_database" "%[2]s" {
+		resource_group_id        = data.ibm_resource_group.test_acc.id
+		name                     = "%[2]s"
+		service                  = "databases-for-postgresql"
+		plan                     = "standard"
+		location                 = "%[3]s"
+		adminpassword_secret_crn = ibm_sm_arbitrary_secret.admin_password.crn
+		users {
+			name                = "user123"
+			password            = "secure-Password24680"
+			password_secret_crn = ibm_sm_arbitrary_secret.user_password.crn
+		}
+	}
+				`, databaseResourceGroup, name, acc.IcdDbRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
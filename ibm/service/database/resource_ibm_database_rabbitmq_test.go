@@ -59,6 +59,7 @@ func TestAccIBMDatabaseInstance_Rabbitmq_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "users.#", "2"),
 					resource.TestCheckResourceAttr(name, "connectionstrings.#", "3"),
 					resource.TestCheckResourceAttr(name, "connectionstrings.2.name", "admin"),
+					resource.TestCheckResourceAttrSet(name, "configuration_schema"),
 				),
 			},
 			{
@@ -188,6 +189,11 @@ func testAccCheckIBMDatabaseInstanceRabbitmqFullyspecified(databaseResourceGroup
 			address     = "172.168.1.1/32"
 			description = "desc"
 		}
+		configuration = <<CONFIGURATION
+		{
+			"delete_undefined_queues": true
+		}
+		CONFIGURATION
 	}
 
 				`, databaseResourceGroup, name, acc.IcdDbRegion)
@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+)
+
+func ResourceIBMDatabaseReplicaPromotion() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseReplicaPromotionCreate,
+		ReadContext:   resourceIBMDatabaseReplicaPromotionRead,
+		DeleteContext: resourceIBMDatabaseReplicaPromotionDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Deployment ID of the read-only replica to promote.",
+			},
+			"skip_initial_backup": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether to skip the initial backup of the promoted deployment, to promote it faster.",
+			},
+			"leader_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Deployment ID of the leader the replica was following before the promotion.",
+			},
+			"task_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the promotion task.",
+			},
+		},
+	}
+}
+
+func resourceIBMDatabaseReplicaPromotionCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deploymentID := d.Get("deployment_id").(string)
+	listRemotesOptions := &clouddatabasesv5.ListRemotesOptions{
+		ID: &deploymentID,
+	}
+	remotes, response, err := cloudDatabasesClient.ListRemotesWithContext(context, listRemotesOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListRemotesWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting the leader of %s: %s\n%s", deploymentID, err, response))
+	}
+	if remotes.Remotes == nil || remotes.Remotes.Leader == nil || *remotes.Remotes.Leader == "" {
+		return diag.FromErr(fmt.Errorf("[ERROR] Deployment %s is not a read-only replica", deploymentID))
+	}
+
+	promoteReadOnlyReplicaOptions := &clouddatabasesv5.PromoteReadOnlyReplicaOptions{
+		ID: &deploymentID,
+		Promotion: map[string]interface{}{
+			"skip_initial_backup": d.Get("skip_initial_backup").(bool),
+		},
+	}
+	promoteResponse, response, err := cloudDatabasesClient.PromoteReadOnlyReplicaWithContext(context, promoteReadOnlyReplicaOptions)
+	if err != nil {
+		log.Printf("[DEBUG] PromoteReadOnlyReplicaWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error promoting the read-only replica %s: %s\n%s", deploymentID, err, response))
+	}
+
+	d.SetId(deploymentID)
+	d.Set("leader_id", remotes.Remotes.Leader)
+
+	if promoteResponse.Task != nil && promoteResponse.Task.ID != nil {
+		d.Set("task_id", promoteResponse.Task.ID)
+		_, err = waitForDatabaseTaskComplete(*promoteResponse.Task.ID, d, meta, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the promotion of the read-only replica %s: %s", deploymentID, err))
+		}
+	}
+
+	return resourceIBMDatabaseReplicaPromotionRead(context, d, meta)
+}
+
+func resourceIBMDatabaseReplicaPromotionRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deploymentID := d.Id()
+	listRemotesOptions := &clouddatabasesv5.ListRemotesOptions{
+		ID: &deploymentID,
+	}
+	remotes, response, err := cloudDatabasesClient.ListRemotesWithContext(context, listRemotesOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] The deployment %s is not found, removing the promotion from the state", deploymentID)
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] ListRemotesWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting the leader of %s: %s\n%s", deploymentID, err, response))
+	}
+
+	// A deployment that follows a leader again is a replica, the promotion has to be applied again
+	if remotes.Remotes != nil && remotes.Remotes.Leader != nil && *remotes.Remotes.Leader != "" {
+		log.Printf("[WARN] The deployment %s is a read-only replica of %s, removing the promotion from the state", deploymentID, *remotes.Remotes.Leader)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("deployment_id", deploymentID)
+
+	return nil
+}
+
+func resourceIBMDatabaseReplicaPromotionDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// A promoted deployment can't be turned back into a read-only replica
+	log.Printf("[DEBUG] Removing the promotion of %s from the state, the deployment stays promoted", d.Id())
+	d.SetId("")
+	return nil
+}
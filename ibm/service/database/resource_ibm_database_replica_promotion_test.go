@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDatabaseReplicaPromotionBasic(t *testing.T) {
+	testName := fmt.Sprintf("tf-Pgress-%s", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseReplicaPromotionConfigBasic(testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("ibm_database_replica_promotion.promotion", "deployment_id", "ibm_database.db_replica", "id"),
+					resource.TestCheckResourceAttrPair("ibm_database_replica_promotion.promotion", "leader_id", "ibm_database.db", "id"),
+					resource.TestCheckResourceAttrSet("ibm_database_replica_promotion.promotion", "task_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseReplicaPromotionConfigBasic(name string) string {
+	return testAccCheckIBMDatabaseDataSourceConfig4(name) + `
+	resource "ibm_database_replica_promotion" "promotion" {
+		deployment_id       = ibm_database.db_replica.id
+		skip_initial_backup = true
+	}
+	`
+}
@@ -0,0 +1,31 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("ibm_database", &resource.Sweeper{
+		Name: "ibm_database",
+		F:    testSweepDatabases,
+	})
+}
+
+// testSweepDatabases deletes the Cloud Databases deployments of the region
+func testSweepDatabases(region string) error {
+	return acc.SweepResourceInstances(region, func(serviceName string) bool {
+		return strings.HasPrefix(serviceName, "databases-for-") || strings.HasPrefix(serviceName, "messages-for-")
+	})
+}
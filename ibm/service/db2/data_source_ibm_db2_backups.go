@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package db2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMDb2Backups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMDb2BackupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The CRN of the Db2 SaaS instance.",
+			},
+			"backups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The backups of the Db2 SaaS instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the backup.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the backup.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the backup.",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time the backup was created.",
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The size of the backup in bytes.",
+						},
+						"duration": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The duration of the backup in seconds.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMDb2BackupsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Get("instance_crn").(string)
+	client, err := getDb2Client(meta, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backups, err := listDb2Backups(context, client, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backupList := make([]map[string]interface{}, 0, len(backups))
+	for _, backup := range backups {
+		backupList = append(backupList, map[string]interface{}{
+			"backup_id":  backup.ID,
+			"type":       backup.Type,
+			"status":     backup.Status,
+			"created_at": backup.CreatedAt,
+			"size":       backup.Size,
+			"duration":   backup.Duration,
+		})
+	}
+
+	d.SetId(instanceCRN)
+	if err = d.Set("backups", backupList); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting backups: %s", err))
+	}
+
+	return nil
+}
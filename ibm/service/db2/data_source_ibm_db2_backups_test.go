@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package db2_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDb2BackupsDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckDb2(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDb2BackupsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_db2_backups.backups", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_db2_backups.backups", "backups.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDb2BackupsDataSourceConfig() string {
+	return fmt.Sprintf(`
+	data "ibm_db2_backups" "backups" {
+		instance_crn = "%s"
+	}
+	`, acc.Db2InstanceCRN)
+}
@@ -0,0 +1,139 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package db2
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+func ResourceIBMDb2Allowlist() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDb2AllowlistUpdate,
+		ReadContext:   resourceIBMDb2AllowlistRead,
+		UpdateContext: resourceIBMDb2AllowlistUpdate,
+		DeleteContext: resourceIBMDb2AllowlistDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The CRN of the Db2 SaaS instance.",
+			},
+			"ip_addresses": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "The IP addresses or CIDR blocks that are allowed to connect to the Db2 SaaS instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ValidateRemoteIP,
+							Description:  "The IP address or CIDR block.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The description of the IP address.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMDb2AllowlistUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Get("instance_crn").(string)
+	client, err := getDb2Client(meta, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	allowlist := &db2Allowlist{IPAddresses: []db2IPAddress{}}
+	for _, ip := range d.Get("ip_addresses").(*schema.Set).List() {
+		ipAddress := ip.(map[string]interface{})
+		allowlist.IPAddresses = append(allowlist.IPAddresses, db2IPAddress{
+			Address:     ipAddress["address"].(string),
+			Description: ipAddress["description"].(string),
+		})
+	}
+
+	response, err := db2Request(context, client, instanceCRN, core.POST, "/dbsettings/whitelistips", nil, allowlist, nil)
+	if err != nil {
+		log.Printf("[DEBUG] Db2 allowlist update failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Db2 allowlist update failed %s\n%s", err, response))
+	}
+
+	d.SetId(instanceCRN)
+
+	return resourceIBMDb2AllowlistRead(context, d, meta)
+}
+
+func resourceIBMDb2AllowlistRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Id()
+	client, err := getDb2Client(meta, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	allowlist := &db2Allowlist{}
+	response, err := db2Request(context, client, instanceCRN, core.GET, "/dbsettings/whitelistips", nil, nil, allowlist)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Db2 allowlist read failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Db2 allowlist read failed %s\n%s", err, response))
+	}
+
+	ipAddresses := make([]map[string]interface{}, 0, len(allowlist.IPAddresses))
+	for _, ipAddress := range allowlist.IPAddresses {
+		ipAddresses = append(ipAddresses, map[string]interface{}{
+			"address":     ipAddress.Address,
+			"description": ipAddress.Description,
+		})
+	}
+
+	d.Set("instance_crn", instanceCRN)
+	if err = d.Set("ip_addresses", ipAddresses); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting ip_addresses: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMDb2AllowlistDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Id()
+	client, err := getDb2Client(meta, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// An empty allowlist allows connections from any IP address
+	response, err := db2Request(context, client, instanceCRN, core.POST, "/dbsettings/whitelistips", nil, &db2Allowlist{IPAddresses: []db2IPAddress{}}, nil)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Db2 allowlist delete failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Db2 allowlist delete failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
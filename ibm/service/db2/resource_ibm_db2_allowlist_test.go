@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package db2_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDb2AllowlistBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckDb2(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDb2AllowlistConfig("10.0.0.0/24"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_db2_allowlist.allowlist", "instance_crn", acc.Db2InstanceCRN),
+					resource.TestCheckResourceAttr("ibm_db2_allowlist.allowlist", "ip_addresses.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMDb2AllowlistConfig("10.0.1.0/24"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_db2_allowlist.allowlist", "ip_addresses.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "ibm_db2_allowlist.allowlist",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDb2AllowlistConfig(cidr string) string {
+	return fmt.Sprintf(`
+	resource "ibm_db2_allowlist" "allowlist" {
+		instance_crn = "%s"
+		ip_addresses {
+			address     = "127.0.0.1"
+			description = "localhost"
+		}
+		ip_addresses {
+			address     = "%s"
+			description = "private network"
+		}
+	}
+	`, acc.Db2InstanceCRN, cidr)
+}
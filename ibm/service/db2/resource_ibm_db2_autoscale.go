@@ -0,0 +1,227 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package db2
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+func ResourceIBMDb2Autoscale() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDb2AutoscaleUpdate,
+		ReadContext:   resourceIBMDb2AutoscaleRead,
+		UpdateContext: resourceIBMDb2AutoscaleUpdate,
+		DeleteContext: resourceIBMDb2AutoscaleDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The CRN of the Db2 SaaS instance.",
+			},
+			"auto_scaling_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the storage of the instance is scaled automatically.",
+			},
+			"auto_scaling_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_db2_autoscale", "auto_scaling_threshold"),
+				Description:  "The storage utilization percentage that triggers scaling.",
+			},
+			"auto_scaling_over_time_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_db2_autoscale", "auto_scaling_over_time_period"),
+				Description:  "The time period in minutes over which the storage utilization is above the threshold before scaling.",
+			},
+			"auto_scaling_pause_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_db2_autoscale", "auto_scaling_pause_limit"),
+				Description:  "The time in minutes to wait after a scaling operation before scaling again.",
+			},
+			"auto_scaling_allow_plan_limit": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the storage can be scaled up to the limit of the plan.",
+			},
+			"auto_scaling_max_storage": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The maximum storage the instance can be scaled to.",
+			},
+			"storage_unit": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unit of the storage values.",
+			},
+			"storage_utilization_percentage": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The current storage utilization percentage of the instance.",
+			},
+			"support_auto_scaling": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the plan of the instance supports auto scaling.",
+			},
+		},
+	}
+}
+
+func ResourceIBMDb2AutoscaleValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "auto_scaling_threshold",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "50",
+			MaxValue:                   "100",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "auto_scaling_over_time_period",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "1440",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "auto_scaling_pause_limit",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "1440",
+		})
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_db2_autoscale", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMDb2AutoscaleUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Get("instance_crn").(string)
+	client, err := getDb2Client(meta, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	autoscale := &db2Autoscale{
+		AutoScalingEnabled: core.BoolPtr(d.Get("auto_scaling_enabled").(bool)),
+	}
+	if v, ok := d.GetOk("auto_scaling_threshold"); ok {
+		autoscale.AutoScalingThreshold = core.Int64Ptr(int64(v.(int)))
+	}
+	if v, ok := d.GetOk("auto_scaling_over_time_period"); ok {
+		autoscale.AutoScalingOverTimePeriod = core.Int64Ptr(int64(v.(int)))
+	}
+	if v, ok := d.GetOk("auto_scaling_pause_limit"); ok {
+		autoscale.AutoScalingPauseLimit = core.Int64Ptr(int64(v.(int)))
+	}
+	if v, ok := d.GetOkExists("auto_scaling_allow_plan_limit"); ok {
+		autoscale.AutoScalingAllowPlanLimit = core.BoolPtr(v.(bool))
+	}
+
+	response, err := db2Request(context, client, instanceCRN, core.PUT, "/manage/scaling/auto", nil, autoscale, nil)
+	if err != nil {
+		log.Printf("[DEBUG] Db2 autoscale update failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Db2 autoscale update failed %s\n%s", err, response))
+	}
+
+	d.SetId(instanceCRN)
+
+	return resourceIBMDb2AutoscaleRead(context, d, meta)
+}
+
+func resourceIBMDb2AutoscaleRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Id()
+	client, err := getDb2Client(meta, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	autoscale := &db2Autoscale{}
+	response, err := db2Request(context, client, instanceCRN, core.GET, "/manage/scaling/auto", nil, nil, autoscale)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Db2 autoscale read failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Db2 autoscale read failed %s\n%s", err, response))
+	}
+
+	d.Set("instance_crn", instanceCRN)
+	if autoscale.AutoScalingEnabled != nil {
+		d.Set("auto_scaling_enabled", *autoscale.AutoScalingEnabled)
+	}
+	if autoscale.AutoScalingThreshold != nil {
+		d.Set("auto_scaling_threshold", *autoscale.AutoScalingThreshold)
+	}
+	if autoscale.AutoScalingOverTimePeriod != nil {
+		d.Set("auto_scaling_over_time_period", *autoscale.AutoScalingOverTimePeriod)
+	}
+	if autoscale.AutoScalingPauseLimit != nil {
+		d.Set("auto_scaling_pause_limit", *autoscale.AutoScalingPauseLimit)
+	}
+	if autoscale.AutoScalingAllowPlanLimit != nil {
+		d.Set("auto_scaling_allow_plan_limit", *autoscale.AutoScalingAllowPlanLimit)
+	}
+	if autoscale.AutoScalingMaxStorage != nil {
+		d.Set("auto_scaling_max_storage", *autoscale.AutoScalingMaxStorage)
+	}
+	if autoscale.StorageUnit != nil {
+		d.Set("storage_unit", *autoscale.StorageUnit)
+	}
+	if autoscale.StorageUtilizationPercentage != nil {
+		d.Set("storage_utilization_percentage", *autoscale.StorageUtilizationPercentage)
+	}
+	if autoscale.SupportAutoScaling != nil {
+		d.Set("support_auto_scaling", *autoscale.SupportAutoScaling)
+	}
+
+	return nil
+}
+
+func resourceIBMDb2AutoscaleDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Id()
+	client, err := getDb2Client(meta, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The autoscale configuration cannot be deleted, auto scaling is disabled instead
+	response, err := db2Request(context, client, instanceCRN, core.PUT, "/manage/scaling/auto", nil, &db2Autoscale{AutoScalingEnabled: core.BoolPtr(false)}, nil)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Db2 autoscale delete failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Db2 autoscale delete failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package db2_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDb2AutoscaleBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckDb2(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDb2AutoscaleConfig(90, 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_db2_autoscale.autoscale", "auto_scaling_enabled", "true"),
+					resource.TestCheckResourceAttr("ibm_db2_autoscale.autoscale", "auto_scaling_threshold", "90"),
+					resource.TestCheckResourceAttr("ibm_db2_autoscale.autoscale", "auto_scaling_over_time_period", "60"),
+					resource.TestCheckResourceAttrSet("ibm_db2_autoscale.autoscale", "storage_unit"),
+				),
+			},
+			{
+				Config: testAccCheckIBMDb2AutoscaleConfig(80, 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_db2_autoscale.autoscale", "auto_scaling_threshold", "80"),
+					resource.TestCheckResourceAttr("ibm_db2_autoscale.autoscale", "auto_scaling_over_time_period", "30"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDb2AutoscaleConfig(threshold, period int) string {
+	return fmt.Sprintf(`
+	resource "ibm_db2_autoscale" "autoscale" {
+		instance_crn                  = "%s"
+		auto_scaling_threshold        = %d
+		auto_scaling_over_time_period = %d
+		auto_scaling_pause_limit      = 70
+	}
+	`, acc.Db2InstanceCRN, threshold, period)
+}
@@ -0,0 +1,187 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package db2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	db2BackupStatusCompleted = "completed"
+	db2BackupStatusFailed    = "failed"
+	db2BackupStatusPending   = "pending"
+)
+
+func ResourceIBMDb2Backup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDb2BackupCreate,
+		ReadContext:   resourceIBMDb2BackupRead,
+		DeleteContext: resourceIBMDb2BackupDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The CRN of the Db2 SaaS instance.",
+			},
+			"backup_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the backup.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the backup.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the backup.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the backup was created.",
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The size of the backup in bytes.",
+			},
+			"duration": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The duration of the backup in seconds.",
+			},
+		},
+	}
+}
+
+func resourceIBMDb2BackupCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Get("instance_crn").(string)
+	client, err := getDb2Client(meta, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existingBackups, err := listDb2Backups(context, client, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	existing := make(map[string]bool, len(existingBackups))
+	for _, backup := range existingBackups {
+		existing[backup.ID] = true
+	}
+
+	task := &db2BackupTask{}
+	response, err := db2Request(context, client, instanceCRN, core.POST, "/manage/backups/backup", nil, nil, task)
+	if err != nil {
+		log.Printf("[DEBUG] Db2 backup create failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Db2 backup create failed %s\n%s", err, response))
+	}
+	log.Printf("[INFO] Db2 backup task %s started for instance %s", task.Task.ID, instanceCRN)
+
+	// The backup task does not return the ID of the backup, the backup is the one that was not listed before the task
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{db2BackupStatusPending},
+		Target:  []string{db2BackupStatusCompleted},
+		Refresh: func() (interface{}, string, error) {
+			backups, err := listDb2Backups(context, client, instanceCRN)
+			if err != nil {
+				return nil, "", err
+			}
+			for _, backup := range backups {
+				if existing[backup.ID] {
+					continue
+				}
+				if backup.Status == db2BackupStatusFailed {
+					return backup, backup.Status, fmt.Errorf("[ERROR] Db2 backup %s failed", backup.ID)
+				}
+				if backup.Status == db2BackupStatusCompleted {
+					return backup, backup.Status, nil
+				}
+				return backup, db2BackupStatusPending, nil
+			}
+			return db2Backup{}, db2BackupStatusPending, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      30 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+	result, err := stateConf.WaitForStateContext(context)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the Db2 backup of instance (%s) to complete: %s", instanceCRN, err))
+	}
+
+	backup := result.(db2Backup)
+	d.SetId(fmt.Sprintf("%s/%s", instanceCRN, backup.ID))
+
+	return resourceIBMDb2BackupRead(context, d, meta)
+}
+
+func resourceIBMDb2BackupRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, backupID, err := db2IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := getDb2Client(meta, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backups, err := listDb2Backups(context, client, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, backup := range backups {
+		if backup.ID != backupID {
+			continue
+		}
+		d.Set("instance_crn", instanceCRN)
+		d.Set("backup_id", backup.ID)
+		d.Set("type", backup.Type)
+		d.Set("status", backup.Status)
+		d.Set("created_at", backup.CreatedAt)
+		d.Set("size", backup.Size)
+		d.Set("duration", backup.Duration)
+		return nil
+	}
+
+	// Backups expire according to the retention of the plan
+	log.Printf("[WARN] Db2 backup %s of instance %s no longer exists", backupID, instanceCRN)
+	d.SetId("")
+	return nil
+}
+
+func resourceIBMDb2BackupDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Backups cannot be deleted, they are removed when they expire
+	log.Printf("[WARN] Db2 backup %s is removed from the state only, it expires according to the backup retention of the instance", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func listDb2Backups(context context.Context, client *core.BaseService, instanceCRN string) ([]db2Backup, error) {
+	backups := &db2Backups{}
+	response, err := db2Request(context, client, instanceCRN, core.GET, "/manage/backups", nil, nil, backups)
+	if err != nil {
+		log.Printf("[DEBUG] Db2 backups list failed %s\n%s", err, response)
+		return nil, fmt.Errorf("[ERROR] Db2 backups list failed %s\n%s", err, response)
+	}
+	return backups.Backups, nil
+}
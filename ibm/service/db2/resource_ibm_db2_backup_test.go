@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package db2_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDb2BackupBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckDb2(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDb2BackupConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_db2_backup.backup", "backup_id"),
+					resource.TestCheckResourceAttr("ibm_db2_backup.backup", "status", "completed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDb2BackupConfig() string {
+	return fmt.Sprintf(`
+	resource "ibm_db2_backup" "backup" {
+		instance_crn = "%s"
+	}
+	`, acc.Db2InstanceCRN)
+}
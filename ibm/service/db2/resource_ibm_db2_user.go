@@ -0,0 +1,252 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package db2
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+func ResourceIBMDb2User() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDb2UserCreate,
+		ReadContext:   resourceIBMDb2UserRead,
+		UpdateContext: resourceIBMDb2UserUpdate,
+		DeleteContext: resourceIBMDb2UserDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The CRN of the Db2 SaaS instance.",
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the user in the Db2 SaaS instance.",
+			},
+			"iam": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether the user is an IBM Cloud IAM user.",
+			},
+			"ibmid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The IBMid of the IAM user.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the user.",
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The email address of the user.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The password of the user, required for users that are not IAM users.",
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "bluuser",
+				ValidateFunc: validate.InvokeValidator("ibm_db2_user", "role"),
+				Description:  "The role of the user, bluadmin or bluuser.",
+			},
+			"locked": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "no",
+				ValidateFunc: validate.InvokeValidator("ibm_db2_user", "locked"),
+				Description:  "Whether the user account is locked, yes or no.",
+			},
+			"authentication_method": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The authentication method of the user.",
+			},
+			"authentication_policy_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The ID of the password policy of the user.",
+			},
+		},
+	}
+}
+
+func ResourceIBMDb2UserValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "role",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "bluadmin, bluuser",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "locked",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "no, yes",
+		})
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_db2_user", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMDb2UserCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Get("instance_crn").(string)
+	client, err := getDb2Client(meta, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	user := expandDb2User(d)
+	if !user.Iam && user.Password == "" {
+		return diag.FromErr(fmt.Errorf("[ERROR] password is required for users that are not IAM users"))
+	}
+
+	response, err := db2Request(context, client, instanceCRN, core.POST, "/users", nil, user, nil)
+	if err != nil {
+		log.Printf("[DEBUG] Db2 user create failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Db2 user create failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceCRN, user.ID))
+
+	return resourceIBMDb2UserRead(context, d, meta)
+}
+
+func resourceIBMDb2UserRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, userID, err := db2IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := getDb2Client(meta, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	user := &db2User{}
+	response, err := db2Request(context, client, instanceCRN, core.GET, "/users/{id}", map[string]string{"id": userID}, nil, user)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Db2 user read failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Db2 user read failed %s\n%s", err, response))
+	}
+
+	d.Set("instance_crn", instanceCRN)
+	d.Set("user_id", user.ID)
+	d.Set("iam", user.Iam)
+	d.Set("ibmid", user.Ibmid)
+	d.Set("name", user.Name)
+	d.Set("email", user.Email)
+	d.Set("role", user.Role)
+	d.Set("locked", user.Locked)
+	if user.Authentication != nil {
+		d.Set("authentication_method", user.Authentication.Method)
+		d.Set("authentication_policy_id", user.Authentication.PolicyID)
+	}
+
+	return nil
+}
+
+func resourceIBMDb2UserUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, userID, err := db2IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := getDb2Client(meta, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("name", "email", "password", "role", "locked") {
+		user := expandDb2User(d)
+		if !d.HasChange("password") {
+			user.Password = ""
+		}
+		response, err := db2Request(context, client, instanceCRN, core.PUT, "/users/{id}", map[string]string{"id": userID}, user, nil)
+		if err != nil {
+			log.Printf("[DEBUG] Db2 user update failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Db2 user update failed %s\n%s", err, response))
+		}
+	}
+
+	return resourceIBMDb2UserRead(context, d, meta)
+}
+
+func resourceIBMDb2UserDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, userID, err := db2IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := getDb2Client(meta, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := db2Request(context, client, instanceCRN, core.DELETE, "/users/{id}", map[string]string{"id": userID}, nil, nil)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Db2 user delete failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Db2 user delete failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func expandDb2User(d *schema.ResourceData) *db2User {
+	user := &db2User{
+		ID:       d.Get("user_id").(string),
+		Iam:      d.Get("iam").(bool),
+		Ibmid:    d.Get("ibmid").(string),
+		Name:     d.Get("name").(string),
+		Email:    d.Get("email").(string),
+		Password: d.Get("password").(string),
+		Role:     d.Get("role").(string),
+		Locked:   d.Get("locked").(string),
+	}
+	if method, ok := d.GetOk("authentication_method"); ok {
+		user.Authentication = &db2UserAuthentication{
+			Method:   method.(string),
+			PolicyID: d.Get("authentication_policy_id").(string),
+		}
+	}
+	return user
+}
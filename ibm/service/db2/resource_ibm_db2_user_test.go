@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package db2_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDb2UserBasic(t *testing.T) {
+	userID := fmt.Sprintf("tfuser%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckDb2(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDb2UserConfig(userID, "bluuser"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_db2_user.user", "user_id", userID),
+					resource.TestCheckResourceAttr("ibm_db2_user.user", "role", "bluuser"),
+					resource.TestCheckResourceAttr("ibm_db2_user.user", "locked", "no"),
+				),
+			},
+			{
+				Config: testAccCheckIBMDb2UserConfig(userID, "bluadmin"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_db2_user.user", "role", "bluadmin"),
+				),
+			},
+			{
+				ResourceName:            "ibm_db2_user.user",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDb2UserConfig(userID, role string) string {
+	return fmt.Sprintf(`
+	resource "ibm_db2_user" "user" {
+		instance_crn = "%s"
+		user_id      = "%s"
+		name         = "Terraform test user"
+		email        = "%s@example.com"
+		password     = "TfPassw0rd#2023"
+		role         = "%s"
+	}
+	`, acc.Db2InstanceCRN, userID, userID, role)
+}
@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package db2
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Db2 SaaS API models, the API identifies the Db2 instance by its CRN in the
+// x-deployment-id header of every request.
+
+type db2IPAddress struct {
+	Address     string `json:"address"`
+	Description string `json:"description"`
+}
+
+type db2Allowlist struct {
+	IPAddresses []db2IPAddress `json:"ip_addresses"`
+}
+
+type db2Autoscale struct {
+	AutoScalingEnabled           *bool   `json:"auto_scaling_enabled,omitempty"`
+	AutoScalingThreshold         *int64  `json:"auto_scaling_threshold,omitempty"`
+	AutoScalingOverTimePeriod    *int64  `json:"auto_scaling_over_time_period,omitempty"`
+	AutoScalingPauseLimit        *int64  `json:"auto_scaling_pause_limit,omitempty"`
+	AutoScalingAllowPlanLimit    *bool   `json:"auto_scaling_allow_plan_limit,omitempty"`
+	AutoScalingMaxStorage        *int64  `json:"auto_scaling_max_storage,omitempty"`
+	StorageUnit                  *string `json:"storage_unit,omitempty"`
+	StorageUtilizationPercentage *int64  `json:"storage_utilization_percentage,omitempty"`
+	SupportAutoScaling           *bool   `json:"support_auto_scaling,omitempty"`
+}
+
+type db2UserAuthentication struct {
+	Method   string `json:"method"`
+	PolicyID string `json:"policy_id"`
+}
+
+type db2User struct {
+	DvRole         string                 `json:"dvRole,omitempty"`
+	ID             string                 `json:"id"`
+	Iam            bool                   `json:"iam"`
+	Ibmid          string                 `json:"ibmid,omitempty"`
+	Name           string                 `json:"name,omitempty"`
+	Password       string                 `json:"password,omitempty"`
+	Role           string                 `json:"role"`
+	Email          string                 `json:"email,omitempty"`
+	Locked         string                 `json:"locked,omitempty"`
+	Authentication *db2UserAuthentication `json:"authentication,omitempty"`
+}
+
+type db2Backup struct {
+	ID        string `json:"id"`
+	Type      string `json:"type"`
+	Status    string `json:"status"`
+	CreatedAt string `json:"created_at"`
+	Size      int64  `json:"size"`
+	Duration  int64  `json:"duration"`
+}
+
+type db2Backups struct {
+	Backups []db2Backup `json:"backups"`
+}
+
+type db2BackupTask struct {
+	Task struct {
+		ID string `json:"id"`
+	} `json:"task"`
+}
+
+// getDb2Client returns a Db2 SaaS client pointed at the region of the instance
+func getDb2Client(meta interface{}, instanceCRN string) (*core.BaseService, error) {
+	db2Client, err := meta.(conns.ClientSession).Db2SaasV1()
+	if err != nil {
+		return nil, err
+	}
+	crnSegments := strings.Split(instanceCRN, ":")
+	if len(crnSegments) < 8 || crnSegments[4] != "dashdb-for-transactions" {
+		return nil, fmt.Errorf("[ERROR] Incorrect Db2 instance CRN %s", instanceCRN)
+	}
+	client := db2Client.Clone()
+	if os.Getenv("IBMCLOUD_DB2_API_ENDPOINT") == "" {
+		client.SetServiceURL(fmt.Sprintf("https://%s.db2.saas.ibm.com/dbapi/v4", crnSegments[5]))
+	}
+	return client, nil
+}
+
+// db2Request calls the Db2 SaaS API of the instance, the response is decoded in result when it is not nil
+func db2Request(context context.Context, client *core.BaseService, instanceCRN, method, path string, pathParams map[string]string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(context)
+	builder.EnableGzipCompression = client.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(client.GetServiceURL(), path, pathParams)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	builder.AddHeader("x-deployment-id", instanceCRN)
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		if _, err = builder.SetBodyContentJSON(body); err != nil {
+			return nil, err
+		}
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Request(request, result)
+}
+
+// The ID of the Db2 user and backup resources is <instance_crn>/<id>
+func db2IdParts(id string) (string, string, error) {
+	parts, err := flex.IdParts(id)
+	if err != nil {
+		return "", "", err
+	}
+	if len(parts) < 2 {
+		return "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of instanceCRN/id", id)
+	}
+	return strings.Join(parts[:len(parts)-1], "/"), parts[len(parts)-1], nil
+}
@@ -91,6 +91,9 @@ const (
 	dlExportRouteFilters            = "export_route_filters"
 	dlImportRouteFilters            = "import_route_filters"
 	dlAction                        = "action"
+	dlApprovalState                 = "approval_state"
+	dlChangeRequestUpdates          = "change_request_updates"
+	dlWaitForProviderApproval       = "wait_for_provider_approval"
 	dlBefore                        = "before"
 	dlGe                            = "ge"
 	dlLe                            = "le"
@@ -105,6 +108,15 @@ const (
 	dlGatewayDeleteActionUpdateDone = "deleteActiondone"
 )
 
+// Approval states of the Direct Link Connect gateways created through partner providers
+const (
+	dlApprovalStatePendingProvider = "pending_provider_approval"
+	dlApprovalStatePendingCustomer = "pending_customer_approval"
+	dlApprovalStateConfiguring     = "configuring"
+	dlApprovalStateApproved        = "approved"
+	dlApprovalStateRejected        = "rejected"
+)
+
 func NewInt64Pointer(v int64) *int64 {
 	return &v
 }
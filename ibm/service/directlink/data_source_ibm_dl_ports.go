@@ -8,7 +8,9 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	dl "github.com/IBM/networking-go-sdk/directlinkv1"
 
+	"context"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -35,6 +37,16 @@ func DataSourceIBMDirectLinkPorts() *schema.Resource {
 				Optional:    true,
 				Description: "Direct Link location short name",
 			},
+			dlSpeedMbps: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Only list the ports supporting this speed in megabits per second",
+			},
+			dlProviderName: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the ports of this provider, case insensitive",
+			},
 			dlPorts: {
 
 				Type:        schema.TypeList,
@@ -96,33 +108,31 @@ func dataSourceIBMDirectLinkPortsRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	start := ""
-	allrecs := []dl.Port{}
-	for {
-		listPortsOptions := sess.NewListPortsOptions()
-		if _, ok := d.GetOk(dlLocationName); ok {
-			dlLocationName := d.Get(dlLocationName).(string)
-			listPortsOptions.SetLocationName(dlLocationName)
-		}
-		if start != "" {
-			listPortsOptions.Start = &start
-
-		}
-
-		response, resp, err := sess.ListPorts(listPortsOptions)
-		if err != nil {
-			log.Println("[WARN] Error listing dl ports", resp, err)
-			return err
-		}
-		start = flex.GetNext(response.Next)
-		allrecs = append(allrecs, response.Ports...)
-		if start == "" {
-			break
-		}
+	listPortsOptions := sess.NewListPortsOptions()
+	if _, ok := d.GetOk(dlLocationName); ok {
+		dlLocationName := d.Get(dlLocationName).(string)
+		listPortsOptions.SetLocationName(dlLocationName)
+	}
+	pager, err := sess.NewPortsPager(listPortsOptions)
+	if err != nil {
+		return err
+	}
+	allrecs, err := flex.PaginateAll[dl.Port](context.Background(), pager)
+	if err != nil {
+		log.Println("[WARN] Error listing dl ports", err)
+		return err
 	}
 
+	speedMbps := int64(d.Get(dlSpeedMbps).(int))
+	providerName := d.Get(dlProviderName).(string)
 	portCollections := make([]map[string]interface{}, 0)
 	for _, port := range allrecs {
+		if providerName != "" && (port.ProviderName == nil || !strings.EqualFold(*port.ProviderName, providerName)) {
+			continue
+		}
+		if speedMbps != 0 && !dlPortSupportsSpeed(port, speedMbps) {
+			continue
+		}
 		portCollection := map[string]interface{}{}
 		portCollection[dlPortID] = *port.ID
 		portCollection[dlCount] = *port.DirectLinkCount
@@ -142,6 +152,15 @@ func dataSourceIBMDirectLinkPortsRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+func dlPortSupportsSpeed(port dl.Port, speedMbps int64) bool {
+	for _, s := range port.SupportedLinkSpeeds {
+		if s == speedMbps {
+			return true
+		}
+	}
+	return false
+}
+
 func dataSourceIBMDirectLinkPortsReadID(d *schema.ResourceData) string {
 	return time.Now().UTC().String()
 }
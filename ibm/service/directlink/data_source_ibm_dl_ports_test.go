@@ -37,3 +37,30 @@ func testAccCheckIBMDLPortsDataSourceConfig(name string) string {
 	   }
 	  `, name)
 }
+
+func TestAccIBMDLPortsDataSource_speedFilter(t *testing.T) {
+	resName := "data.ibm_dl_ports.test_dl_ports_speed"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDLPortsDataSourceSpeedConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "ports.0.port_id"),
+					resource.TestCheckResourceAttr(resName, "ports.0.location_name", "dal10"),
+					resource.TestCheckTypeSetElemAttr(resName, "ports.0.supported_link_speeds.*", "1000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDLPortsDataSourceSpeedConfig() string {
+	return `
+	data "ibm_dl_ports" "test_dl_ports_speed" {
+		location_name = "dal10"
+		speed_mbps    = 1000
+	}
+	`
+}
@@ -475,6 +475,51 @@ func ResourceIBMDLGateway() *schema.Resource {
 				Computed:    true,
 				Description: "Changes pending approval for provider managed Direct Link Connect gateways",
 			},
+			dlChangeRequestUpdates: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Attribute updates pending approval for provider managed Direct Link Connect gateways",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						dlSpeedMbps: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "New speed in megabits per second",
+						},
+						dlBgpAsn: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "New customer BGP ASN",
+						},
+						dlBgpCerCidr: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "New customer edge router BGP CIDR",
+						},
+						dlBgpIbmCidr: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "New IBM BGP CIDR",
+						},
+						dlVlan: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "New VLAN",
+						},
+					},
+				},
+			},
+			dlApprovalState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Approval state of Direct Link Connect gateways created through partner providers: pending_provider_approval, pending_customer_approval, configuring, approved or rejected",
+			},
+			dlWaitForProviderApproval: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait on create for the partner provider to approve a Direct Link Connect gateway on a provider port",
+			},
 			dlCompletionNoticeRejectReason: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -945,6 +990,11 @@ func resourceIBMdlGatewayCreate(d *schema.ResourceData, meta interface{}) error
 			if err != nil {
 				return err
 			}
+		} else if d.Get(dlWaitForProviderApproval).(bool) {
+			_, err = isWaitForDirectLinkProviderApproval(directLink, d.Id(), d.Timeout(schema.TimeoutCreate))
+			if err != nil {
+				return err
+			}
 		}
 
 	}
@@ -1230,11 +1280,17 @@ func resourceIBMdlGatewayRead(d *schema.ResourceData, meta interface{}) error {
 			d.Set(dlMacSecConfig, macsecList)
 		}
 	}
+	changeRequestUpdates := make([]map[string]interface{}, 0)
 	if instance.ChangeRequest != nil {
 		gatewayChangeRequestIntf := instance.ChangeRequest
 		gatewayChangeRequest := gatewayChangeRequestIntf.(*directlinkv1.GatewayChangeRequest)
 		d.Set(dlChangeRequest, *gatewayChangeRequest.Type)
+		changeRequestUpdates = flattenDLGatewayChangeRequestUpdates(gatewayChangeRequest.Updates)
+	} else {
+		d.Set(dlChangeRequest, "")
 	}
+	d.Set(dlChangeRequestUpdates, changeRequestUpdates)
+	d.Set(dlApprovalState, dlGatewayApprovalState(instance))
 	tags, err := flex.GetTagsUsingCRN(meta, *instance.Crn)
 	if err != nil {
 		log.Printf(
@@ -1305,6 +1361,90 @@ func isDirectLinkRefreshFunc(client *directlinkv1.DirectLinkV1, id string) resou
 	}
 }
 
+// isWaitForDirectLinkProviderApproval waits for the partner provider to approve and provision a Direct Link
+// Connect gateway created on a provider port, and fails if the provider rejects it.
+func isWaitForDirectLinkProviderApproval(client *directlinkv1.DirectLinkV1, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for direct link (%s) to be approved by the provider.", id)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"retry", dlApprovalStatePendingProvider, dlApprovalStatePendingCustomer, dlApprovalStateConfiguring},
+		Target:     []string{dlApprovalStateApproved},
+		Refresh:    isDirectLinkProviderApprovalRefreshFunc(client, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+	return stateConf.WaitForState()
+}
+
+func isDirectLinkProviderApprovalRefreshFunc(client *directlinkv1.DirectLinkV1, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		getOptions := &directlinkv1.GetGatewayOptions{
+			ID: &id,
+		}
+		instance, response, err := client.GetGateway(getOptions)
+		if err != nil {
+			return nil, "", fmt.Errorf("[ERROR] Error Getting Direct Link: %s\n%s", err, response)
+		}
+		state := dlGatewayApprovalState(instance)
+		if state == dlApprovalStateRejected {
+			return instance, state, fmt.Errorf("[ERROR] Direct Link Gateway (%s) was rejected by the provider", id)
+		}
+		return instance, state, nil
+	}
+}
+
+// dlGatewayApprovalState returns the approval state of a Direct Link Connect gateway. A change request is
+// pending the approval of the customer with ibm_dl_gateway_action, while a gateway pending creation
+// without change request is pending the approval of the provider.
+func dlGatewayApprovalState(instance *directlinkv1.Gateway) string {
+	if instance.ChangeRequest != nil {
+		return dlApprovalStatePendingCustomer
+	}
+	operationalStatus := ""
+	if instance.OperationalStatus != nil {
+		operationalStatus = *instance.OperationalStatus
+	}
+	switch operationalStatus {
+	case directlinkv1.Gateway_OperationalStatus_CreatePending:
+		return dlApprovalStatePendingProvider
+	case directlinkv1.Gateway_OperationalStatus_Configuring:
+		return dlApprovalStateConfiguring
+	case directlinkv1.Gateway_OperationalStatus_CreateRejected:
+		return dlApprovalStateRejected
+	case directlinkv1.Gateway_OperationalStatus_Provisioned:
+		return dlApprovalStateApproved
+	}
+	return operationalStatus
+}
+
+func flattenDLGatewayChangeRequestUpdates(updates []directlinkv1.GatewayChangeRequestUpdatesItemIntf) []map[string]interface{} {
+	changeRequestUpdates := make([]map[string]interface{}, 0)
+	for _, updateIntf := range updates {
+		update, ok := updateIntf.(*directlinkv1.GatewayChangeRequestUpdatesItem)
+		if !ok {
+			continue
+		}
+		changeRequestUpdate := map[string]interface{}{}
+		if update.SpeedMbps != nil {
+			changeRequestUpdate[dlSpeedMbps] = *update.SpeedMbps
+		}
+		if update.BgpAsn != nil {
+			changeRequestUpdate[dlBgpAsn] = *update.BgpAsn
+		}
+		if update.BgpCerCidr != nil {
+			changeRequestUpdate[dlBgpCerCidr] = *update.BgpCerCidr
+		}
+		if update.BgpIbmCidr != nil {
+			changeRequestUpdate[dlBgpIbmCidr] = *update.BgpIbmCidr
+		}
+		if update.Vlan != nil {
+			changeRequestUpdate[dlVlan] = *update.Vlan
+		}
+		changeRequestUpdates = append(changeRequestUpdates, changeRequestUpdate)
+	}
+	return changeRequestUpdates
+}
+
 func resourceIBMdlGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	directLink, err := directlinkClient(meta)
@@ -71,6 +71,7 @@ func TestAccIBMDLGatewayConnect_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckIBMDLGatewayExists("ibm_dl_gateway.test_dl_connect", instance),
 					resource.TestCheckResourceAttr("ibm_dl_gateway.test_dl_connect", "name", connectgatewayname),
+					resource.TestCheckResourceAttrSet("ibm_dl_gateway.test_dl_connect", "approval_state"),
 					resource.TestCheckResourceAttr("data.ibm_dl_export_route_filter.test_dl_export_route_filter", "prefix", exprefix),
 					resource.TestCheckResourceAttr("data.ibm_dl_import_route_filter.test_dl_import_route_filter", "prefix", imprefix),
 					//resource.TestCheckResourceAttrSet("ibm_dl_gateway.test_dl_connect", "as_prepends.#"),
@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package dnsservices
+
+import (
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	pdnsGlbPoolHealthyOrigins = "healthy_origins"
+)
+
+func DataSourceIBMPrivateDNSGLBPoolHealth() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMPrivateDNSGLBPoolHealthRead,
+		Schema: map[string]*schema.Schema{
+			pdnsInstanceID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Instance ID",
+			},
+			pdnsGlbPoolID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Pool ID",
+			},
+			pdnsGlbPoolName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the load balancer pool",
+			},
+			pdnsGlbPoolEnabled: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the load balancer pool is enabled",
+			},
+			pdnsGlbPoolHealth: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The health of the load balancer pool: UP, DEGRADED or DOWN",
+			},
+			pdnsGlbPoolHealthyOriginsThreshold: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The minimum number of origins that must be healthy for this pool to serve traffic",
+			},
+			pdnsGlbPoolHealthyOrigins: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of enabled origins that are healthy",
+			},
+			pdnsGlbPoolMonitor: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the load balancer monitor checking the origins",
+			},
+			pdnsGlbPoolRegion: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Health check region of VSIs",
+			},
+			pdnsGlbPoolSubnet: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Health check subnet crn of VSIs",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			pdnsGlbPoolVsis: pdnsGlbPoolHealthcheckVsisSchema(),
+			pdnsGlbPoolOrigins: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The health of the origins of the pool",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						pdnsGlbPoolOriginsName: {
+							Type:        schema.TypeString,
+							Description: "The name of the origin server.",
+							Computed:    true,
+						},
+						pdnsGlbPoolOriginsAddress: {
+							Type:        schema.TypeString,
+							Description: "The address of the origin server. It can be a hostname or an IP address.",
+							Computed:    true,
+						},
+						pdnsGlbPoolOriginsEnabled: {
+							Type:        schema.TypeBool,
+							Description: "Whether the origin server is enabled.",
+							Computed:    true,
+						},
+						pdnsGlbPoolOriginsDescription: {
+							Type:        schema.TypeString,
+							Description: "Description of the origin server.",
+							Computed:    true,
+						},
+						pdnsGlbPoolOriginsHealth: {
+							Type:        schema.TypeBool,
+							Description: "Whether the health is `true` or `false`.",
+							Computed:    true,
+						},
+						pdnsGlbPoolOriginsHealthFailureReason: {
+							Type:        schema.TypeString,
+							Description: "The Reason for health check failure",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMPrivateDNSGLBPoolHealthRead(d *schema.ResourceData, meta interface{}) error {
+	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
+	if err != nil {
+		return err
+	}
+	instanceID := d.Get(pdnsInstanceID).(string)
+	poolID := d.Get(pdnsGlbPoolID).(string)
+
+	pool, detail, err := sess.GetPool(sess.NewGetPoolOptions(instanceID, poolID))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error fetching pdns GLB Pool:%s\n%s", err, detail)
+	}
+
+	healthyOrigins := 0
+	for _, origin := range pool.Origins {
+		if origin.Enabled != nil && *origin.Enabled && origin.Health != nil && *origin.Health {
+			healthyOrigins++
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, poolID))
+	d.Set(pdnsGlbPoolName, pool.Name)
+	d.Set(pdnsGlbPoolEnabled, pool.Enabled)
+	d.Set(pdnsGlbPoolHealth, pool.Health)
+	d.Set(pdnsGlbPoolHealthyOriginsThreshold, pool.HealthyOriginsThreshold)
+	d.Set(pdnsGlbPoolHealthyOrigins, healthyOrigins)
+	d.Set(pdnsGlbPoolMonitor, pool.Monitor)
+	d.Set(pdnsGlbPoolRegion, pool.HealthcheckRegion)
+	d.Set(pdnsGlbPoolSubnet, pool.HealthcheckSubnets)
+	d.Set(pdnsGlbPoolVsis, flattenPDNSGlbPoolHealthcheckVsis(pool.HealthcheckVsis))
+	d.Set(pdnsGlbPoolOrigins, flattenPDNSGlbPoolOrigins(pool.Origins))
+	return nil
+}
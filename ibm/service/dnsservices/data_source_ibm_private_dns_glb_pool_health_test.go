@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package dnsservices_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMPrivateDNSGlbPoolHealthDataSource_basic(t *testing.T) {
+	node := "data.ibm_dns_glb_pool_health.test1"
+	riname := fmt.Sprintf("tf-instance-%d", acctest.RandIntRange(100, 200))
+	zonename := fmt.Sprintf("tf-dnszone-%d.com", acctest.RandIntRange(100, 200))
+	vpcname := fmt.Sprintf("tf-vpcname-%d", acctest.RandIntRange(100, 200))
+	poolname := fmt.Sprintf("tf-poolname-%d", acctest.RandIntRange(100, 200))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPrivateDNSGlbPoolHealthDataSourceConfig(vpcname, riname, zonename, poolname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(node, "name", poolname),
+					resource.TestCheckResourceAttrSet(node, "health"),
+					resource.TestCheckResourceAttrSet(node, "healthy_origins"),
+					resource.TestCheckResourceAttr(node, "origins.#", "1"),
+					resource.TestCheckResourceAttr(node, "origins.0.name", "example-1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPrivateDNSGlbPoolHealthDataSourceConfig(vpcname, riname, zonename, poolname string) string {
+	return testAccCheckIBMPrivateDNSGlbPoolsDataSourceConfig(vpcname, riname, zonename, poolname) + `
+
+	data "ibm_dns_glb_pool_health" "test1" {
+		instance_id = ibm_dns_glb_pool.test-pdns-pool.instance_id
+		pool_id     = ibm_dns_glb_pool.test-pdns-pool.pool_id
+	}`
+}
@@ -132,6 +132,7 @@ func DataSourceIBMPrivateDNSGLBPools() *schema.Resource {
 								Type: schema.TypeString,
 							},
 						},
+						pdnsGlbPoolVsis: pdnsGlbPoolHealthcheckVsisSchema(),
 					},
 				},
 			},
@@ -168,6 +169,7 @@ func dataSourceIBMPrivateDNSGLBPoolsRead(d *schema.ResourceData, meta interface{
 		dnsPool[pdnsGlbPoolRegion] = *instance.HealthcheckRegion
 		dnsPool[pdnsGlbPoolOrigins] = flattenPDNSGlbPoolOrigins(instance.Origins)
 		dnsPool[pdnsGlbPoolSubnet] = instance.HealthcheckSubnets
+		dnsPool[pdnsGlbPoolVsis] = flattenPDNSGlbPoolHealthcheckVsis(instance.HealthcheckVsis)
 
 		dnsPools = append(dnsPools, dnsPool)
 	}
@@ -4,6 +4,7 @@
 package dnsservices
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -13,6 +14,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
 	dns "github.com/IBM/networking-go-sdk/dnssvcsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -36,6 +38,11 @@ const (
 	pdnsGlbPoolChannel                    = "notification_channel"
 	pdnsGlbPoolRegion                     = "healthcheck_region"
 	pdnsGlbPoolSubnet                     = "healthcheck_subnets"
+	pdnsGlbPoolVsis                       = "healthcheck_vsis"
+	pdnsGlbPoolVsisSubnet                 = "subnet"
+	pdnsGlbPoolVsisIpv4Address            = "ipv4_address"
+	pdnsGlbPoolVsisIpv4CidrBlock          = "ipv4_cidr_block"
+	pdnsGlbPoolVsisVpc                    = "vpc"
 	pdnsGlbPoolCreatedOn                  = "created_on"
 	pdnsGlbPoolModifiedOn                 = "modified_on"
 	pdnsGlbPoolDeletePending              = "deleting"
@@ -58,6 +65,12 @@ func ResourceIBMPrivateDNSGLBPool() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.Sequence(
+			func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMPrivateDNSGLBPoolHealthcheckCustomizeDiff(diff)
+			},
+		),
+
 		Schema: map[string]*schema.Schema{
 			pdnsInstanceID: {
 				Type:        schema.TypeString,
@@ -157,6 +170,7 @@ func ResourceIBMPrivateDNSGLBPool() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			pdnsGlbPoolVsis: pdnsGlbPoolHealthcheckVsisSchema(),
 			pdnsGlbPoolCreatedOn: {
 				Type:        schema.TypeString,
 				Description: "The time when a load balancer pool is created.",
@@ -244,6 +258,7 @@ func resourceIBMPrivateDNSGLBPoolRead(d *schema.ResourceData, meta interface{})
 	d.Set(pdnsGlbPoolChannel, response.NotificationChannel)
 	d.Set(pdnsGlbPoolRegion, response.HealthcheckRegion)
 	d.Set(pdnsGlbPoolSubnet, response.HealthcheckSubnets)
+	d.Set(pdnsGlbPoolVsis, flattenPDNSGlbPoolHealthcheckVsis(response.HealthcheckVsis))
 	d.Set(pdnsGlbPoolCreatedOn, response.CreatedOn.String())
 	d.Set(pdnsGlbPoolModifiedOn, response.ModifiedOn.String())
 	d.Set(pdnsGlbPoolOrigins, flattenPDNSGlbPoolOrigins(response.Origins))
@@ -255,18 +270,108 @@ func flattenPDNSGlbPoolOrigins(list []dns.Origin) []map[string]interface{} {
 	origins := []map[string]interface{}{}
 	for _, origin := range list {
 		l := map[string]interface{}{
-			pdnsGlbPoolOriginsName:                *origin.Name,
-			pdnsGlbPoolOriginsAddress:             *origin.Address,
-			pdnsGlbPoolOriginsEnabled:             *origin.Enabled,
-			pdnsGlbPoolOriginsDescription:         *origin.Description,
-			pdnsGlbPoolOriginsHealth:              *origin.Health,
-			pdnsGlbPoolOriginsHealthFailureReason: *origin.HealthFailureReason,
+			pdnsGlbPoolOriginsName:    *origin.Name,
+			pdnsGlbPoolOriginsAddress: *origin.Address,
+			pdnsGlbPoolOriginsEnabled: *origin.Enabled,
+		}
+		if origin.Description != nil {
+			l[pdnsGlbPoolOriginsDescription] = *origin.Description
+		}
+		if origin.Health != nil {
+			l[pdnsGlbPoolOriginsHealth] = *origin.Health
+		}
+		if origin.HealthFailureReason != nil {
+			l[pdnsGlbPoolOriginsHealthFailureReason] = *origin.HealthFailureReason
 		}
 		origins = append(origins, l)
 	}
 	return origins
 }
 
+func pdnsGlbPoolHealthcheckVsisSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Health check VSIs of the pool in the health check subnets",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				pdnsGlbPoolVsisSubnet: {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Health check VSI subnet CRN",
+				},
+				pdnsGlbPoolVsisIpv4Address: {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Health check VSI IP address, to allow in the security groups and network ACLs of the origins",
+				},
+				pdnsGlbPoolVsisIpv4CidrBlock: {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "IPv4 CIDR block of the health check subnet",
+				},
+				pdnsGlbPoolVsisVpc: {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "VPC CRN of the health check subnet",
+				},
+			},
+		},
+	}
+}
+
+func flattenPDNSGlbPoolHealthcheckVsis(list []dns.PoolHealthcheckVsisItem) []map[string]interface{} {
+	vsis := []map[string]interface{}{}
+	for _, vsi := range list {
+		l := map[string]interface{}{}
+		if vsi.Subnet != nil {
+			l[pdnsGlbPoolVsisSubnet] = *vsi.Subnet
+		}
+		if vsi.Ipv4Address != nil {
+			l[pdnsGlbPoolVsisIpv4Address] = *vsi.Ipv4Address
+		}
+		if vsi.Ipv4CidrBlock != nil {
+			l[pdnsGlbPoolVsisIpv4CidrBlock] = *vsi.Ipv4CidrBlock
+		}
+		if vsi.Vpc != nil {
+			l[pdnsGlbPoolVsisVpc] = *vsi.Vpc
+		}
+		vsis = append(vsis, l)
+	}
+	return vsis
+}
+
+// resourceIBMPrivateDNSGLBPoolHealthcheckCustomizeDiff validates the private network health checks of the pool:
+// the health checks are sent from VSIs in the health check subnets, which must be VPC subnets of the health
+// check region, and the origins are checked with the monitor of the pool.
+func resourceIBMPrivateDNSGLBPoolHealthcheckCustomizeDiff(diff *schema.ResourceDiff) error {
+	if !diff.NewValueKnown(pdnsGlbPoolSubnet) || !diff.NewValueKnown(pdnsGlbPoolRegion) {
+		return nil
+	}
+	subnets := flex.ExpandStringList(diff.Get(pdnsGlbPoolSubnet).([]interface{}))
+	region := diff.Get(pdnsGlbPoolRegion).(string)
+	if len(subnets) == 0 {
+		return nil
+	}
+	if region == "" {
+		return fmt.Errorf("[ERROR] %s requires %s", pdnsGlbPoolSubnet, pdnsGlbPoolRegion)
+	}
+	if diff.NewValueKnown(pdnsGlbPoolMonitor) && diff.Get(pdnsGlbPoolMonitor).(string) == "" {
+		return fmt.Errorf("[ERROR] %s requires a %s to check the health of the origins", pdnsGlbPoolSubnet, pdnsGlbPoolMonitor)
+	}
+	for _, subnet := range subnets {
+		// crn:v1:<cname>:<ctype>:is:<zone>:a/<account>::subnet:<id>
+		parts := strings.Split(subnet, ":")
+		if len(parts) != 10 || parts[4] != "is" || parts[8] != "subnet" {
+			return fmt.Errorf("[ERROR] The health check subnet %s is not the CRN of a VPC subnet", subnet)
+		}
+		if !strings.HasPrefix(parts[5], region+"-") {
+			return fmt.Errorf("[ERROR] The health check subnet %s is not in the health check region %s", subnet, region)
+		}
+	}
+	return nil
+}
+
 func resourceIBMPrivateDNSGLBPoolUpdate(d *schema.ResourceData, meta interface{}) error {
 	sess, err := meta.(conns.ClientSession).PrivateDNSClientSession()
 	if err != nil {
@@ -307,7 +412,7 @@ func resourceIBMPrivateDNSGLBPoolUpdate(d *schema.ResourceData, meta interface{}
 		if region, ok := d.GetOk(pdnsGlbPoolRegion); ok {
 			updatePoolOptions.SetHealthcheckRegion(region.(string))
 		}
-		if _, ok := d.GetOk(pdnsGlbPoolSubnet); ok {
+		if d.HasChange(pdnsGlbPoolSubnet) {
 			updatePoolOptions.SetHealthcheckSubnets(flex.ExpandStringList(d.Get(pdnsGlbPoolSubnet).([]interface{})))
 		}
 		if _, ok := d.GetOk(pdnsGlbPoolOrigins); ok {
@@ -0,0 +1,362 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package enterprise
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+)
+
+func DataSourceIBMEnterpriseHierarchy() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIbmEnterpriseHierarchyRead,
+
+		Schema: map[string]*schema.Schema{
+			"enterprise_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the enterprise.",
+			},
+			"root": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The CRN of the enterprise or account group to start the traversal from. The default is the enterprise.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the enterprise.",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Cloud Resource Name (CRN) of the enterprise.",
+			},
+			"primary_contact_iam_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IAM ID of the primary contact of the enterprise.",
+			},
+			"primary_contact_email": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The email address of the primary contact of the enterprise.",
+			},
+			"account_groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The account groups under the root, parents before their children.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The account group ID.",
+						},
+						"crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Cloud Resource Name (CRN) of the account group.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the account group.",
+						},
+						"parent": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the parent of the account group.",
+						},
+						"parent_account_group_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the parent account group, empty for the account groups directly under the root.",
+						},
+						"depth": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The depth of the account group, 1 for the account groups directly under the root.",
+						},
+						"path": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The names of the account groups from the root to this account group, separated by '/'.",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The state of the account group.",
+						},
+						"primary_contact_iam_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IAM ID of the primary contact of the account group.",
+						},
+						"primary_contact_email": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The email address of the primary contact of the account group.",
+						},
+						"account_group_ids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The IDs of the account groups directly under the account group.",
+						},
+						"account_ids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The IDs of the accounts directly under the account group.",
+						},
+					},
+				},
+			},
+			"accounts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The accounts under the root, at any depth.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The account ID.",
+						},
+						"crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Cloud Resource Name (CRN) of the account.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the account.",
+						},
+						"parent": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the parent of the account.",
+						},
+						"parent_account_group_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the parent account group, empty for the accounts directly under the root.",
+						},
+						"depth": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The depth of the account, 1 for the accounts directly under the root.",
+						},
+						"path": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The names of the account groups from the root to the parent of the account, separated by '/'.",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The state of the account.",
+						},
+						"owner_iam_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IAM ID of the owner of the account.",
+						},
+						"owner_email": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The email address of the owner of the account.",
+						},
+						"paid": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "The type of account - whether it is free or paid.",
+						},
+						"is_enterprise_account": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the account is the enterprise account.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIbmEnterpriseHierarchyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enterpriseManagementClient, err := meta.(conns.ClientSession).EnterpriseManagementV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	enterpriseID := d.Get("enterprise_id").(string)
+	getEnterpriseOptions := enterpriseManagementClient.NewGetEnterpriseOptions(enterpriseID)
+	enterprise, response, err := enterpriseManagementClient.GetEnterpriseWithContext(context, getEnterpriseOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetEnterpriseWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+
+	root := *enterprise.CRN
+	if v, ok := d.GetOk("root"); ok {
+		root = v.(string)
+	}
+
+	accountGroups := []map[string]interface{}{}
+	accounts := []map[string]interface{}{}
+	if _, _, err := dataSourceIbmEnterpriseHierarchyWalk(context, enterpriseManagementClient, root, "", "", 1, &accountGroups, &accounts); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", enterpriseID, root))
+	if err = d.Set("name", enterprise.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
+	}
+	if err = d.Set("crn", enterprise.CRN); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting crn: %s", err))
+	}
+	if err = d.Set("primary_contact_iam_id", enterprise.PrimaryContactIamID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting primary_contact_iam_id: %s", err))
+	}
+	if err = d.Set("primary_contact_email", enterprise.PrimaryContactEmail); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting primary_contact_email: %s", err))
+	}
+	if err = d.Set("account_groups", accountGroups); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting account_groups: %s", err))
+	}
+	if err = d.Set("accounts", accounts); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting accounts: %s", err))
+	}
+
+	return nil
+}
+
+// dataSourceIbmEnterpriseHierarchyWalk appends the account groups and accounts under parent,
+// depth first, and returns the IDs of the direct children of parent.
+func dataSourceIbmEnterpriseHierarchyWalk(context context.Context, enterpriseManagementClient *enterprisemanagementv1.EnterpriseManagementV1, parent, parentAccountGroupID, path string, depth int, accountGroups, accounts *[]map[string]interface{}) ([]string, []string, error) {
+	childAccountGroups, err := listEnterpriseAccountGroupsByParent(context, enterpriseManagementClient, parent)
+	if err != nil {
+		return nil, nil, err
+	}
+	childAccounts, err := listEnterpriseAccountsByParent(context, enterpriseManagementClient, parent)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	accountIDs := make([]string, 0, len(childAccounts))
+	for _, account := range childAccounts {
+		accountIDs = append(accountIDs, *account.ID)
+		*accounts = append(*accounts, map[string]interface{}{
+			"id":                      account.ID,
+			"crn":                     account.CRN,
+			"name":                    account.Name,
+			"parent":                  account.Parent,
+			"parent_account_group_id": parentAccountGroupID,
+			"depth":                   depth,
+			"path":                    path,
+			"state":                   account.State,
+			"owner_iam_id":            account.OwnerIamID,
+			"owner_email":             account.OwnerEmail,
+			"paid":                    account.Paid,
+			"is_enterprise_account":   account.IsEnterpriseAccount,
+		})
+	}
+
+	accountGroupIDs := make([]string, 0, len(childAccountGroups))
+	for _, accountGroup := range childAccountGroups {
+		accountGroupIDs = append(accountGroupIDs, *accountGroup.ID)
+		accountGroupPath := *accountGroup.Name
+		if path != "" {
+			accountGroupPath = path + "/" + accountGroupPath
+		}
+		accountGroupMap := map[string]interface{}{
+			"id":                      accountGroup.ID,
+			"crn":                     accountGroup.CRN,
+			"name":                    accountGroup.Name,
+			"parent":                  accountGroup.Parent,
+			"parent_account_group_id": parentAccountGroupID,
+			"depth":                   depth,
+			"path":                    accountGroupPath,
+			"state":                   accountGroup.State,
+			"primary_contact_iam_id":  accountGroup.PrimaryContactIamID,
+			"primary_contact_email":   accountGroup.PrimaryContactEmail,
+		}
+		// The account group is listed before the account groups under it
+		*accountGroups = append(*accountGroups, accountGroupMap)
+
+		groupIDs, groupAccountIDs, err := dataSourceIbmEnterpriseHierarchyWalk(context, enterpriseManagementClient, *accountGroup.CRN, *accountGroup.ID, accountGroupPath, depth+1, accountGroups, accounts)
+		if err != nil {
+			return nil, nil, err
+		}
+		accountGroupMap["account_group_ids"] = flex.FlattenStringList(groupIDs)
+		accountGroupMap["account_ids"] = flex.FlattenStringList(groupAccountIDs)
+	}
+
+	return accountGroupIDs, accountIDs, nil
+}
+
+func listEnterpriseAccountGroupsByParent(context context.Context, enterpriseManagementClient *enterprisemanagementv1.EnterpriseManagementV1, parent string) ([]enterprisemanagementv1.AccountGroup, error) {
+	next_docid := ""
+	var allRecs []enterprisemanagementv1.AccountGroup
+	for {
+		listAccountGroupsOptions := &enterprisemanagementv1.ListAccountGroupsOptions{}
+		listAccountGroupsOptions.SetParent(parent)
+		if next_docid != "" {
+			listAccountGroupsOptions.NextDocid = &next_docid
+		}
+		listAccountGroupsResponse, response, err := enterpriseManagementClient.ListAccountGroupsWithContext(context, listAccountGroupsOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListAccountGroupsWithContext failed %s\n%s", err, response)
+			return nil, err
+		}
+		next_docid, err = getEnterpriseNext(listAccountGroupsResponse.NextURL)
+		if err != nil {
+			log.Printf("[DEBUG] ListAccountGroupsWithContext failed. Error occurred while parsing NextURL: %s", err)
+			return nil, err
+		}
+		allRecs = append(allRecs, listAccountGroupsResponse.Resources...)
+		if next_docid == "" {
+			break
+		}
+	}
+	return allRecs, nil
+}
+
+func listEnterpriseAccountsByParent(context context.Context, enterpriseManagementClient *enterprisemanagementv1.EnterpriseManagementV1, parent string) ([]enterprisemanagementv1.Account, error) {
+	next_docid := ""
+	var allRecs []enterprisemanagementv1.Account
+	for {
+		listAccountsOptions := &enterprisemanagementv1.ListAccountsOptions{}
+		listAccountsOptions.SetParent(parent)
+		if next_docid != "" {
+			listAccountsOptions.NextDocid = &next_docid
+		}
+		listAccountsResponse, response, err := enterpriseManagementClient.ListAccountsWithContext(context, listAccountsOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListAccountsWithContext failed %s\n%s", err, response)
+			return nil, err
+		}
+		next_docid, err = getEnterpriseNext(listAccountsResponse.NextURL)
+		if err != nil {
+			log.Printf("[DEBUG] ListAccountsWithContext failed. Error occurred while parsing NextURL: %s", err)
+			return nil, err
+		}
+		allRecs = append(allRecs, listAccountsResponse.Resources...)
+		if next_docid == "" {
+			break
+		}
+	}
+	return allRecs, nil
+}
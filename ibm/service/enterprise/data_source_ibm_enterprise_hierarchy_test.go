@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package enterprise_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+/* To run this test case ensure the IC_API_KEY belongs to an enterprise" */
+func TestAccIbmEnterpriseHierarchyDataSourceBasic(t *testing.T) {
+	accountGroupName := fmt.Sprintf("name_%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckEnterprise(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmEnterpriseHierarchyDataSourceConfigBasic(accountGroupName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_enterprise_hierarchy.hierarchy", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_enterprise_hierarchy.hierarchy", "name"),
+					resource.TestCheckResourceAttrSet("data.ibm_enterprise_hierarchy.hierarchy", "accounts.#"),
+					resource.TestCheckResourceAttr("data.ibm_enterprise_hierarchy.group", "account_groups.#", "0"),
+					resource.TestCheckResourceAttr("data.ibm_enterprise_hierarchy.group", "accounts.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmEnterpriseHierarchyDataSourceConfigBasic(accountGroupName string) string {
+
+	return fmt.Sprintf(`
+		data "ibm_enterprises" "enterprises_instance" {
+		}
+		resource "ibm_enterprise_account_group" "enterprise_account_group" {
+			parent = data.ibm_enterprises.enterprises_instance.enterprises[0].crn
+			name = "%s"
+			primary_contact_iam_id = data.ibm_enterprises.enterprises_instance.enterprises[0].primary_contact_iam_id
+		}
+
+		data "ibm_enterprise_hierarchy" "hierarchy" {
+			depends_on    = [ibm_enterprise_account_group.enterprise_account_group]
+			enterprise_id = data.ibm_enterprises.enterprises_instance.enterprises[0].id
+		}
+
+		data "ibm_enterprise_hierarchy" "group" {
+			enterprise_id = data.ibm_enterprises.enterprises_instance.enterprises[0].id
+			root          = ibm_enterprise_account_group.enterprise_account_group.crn
+		}
+	`, accountGroupName)
+}
@@ -8,14 +8,17 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
 )
 
@@ -189,8 +192,8 @@ func resourceIbmEnterpriseAccountCreate(context context.Context, d *schema.Resou
 		createAccountOptions.SetParent(d.Get("parent").(string))
 		createAccountOptions.SetName(d.Get("name").(string))
 		createAccountOptions.SetOwnerIamID(d.Get("owner_iam_id").(string))
-		if _, ok := d.GetOk("Traits"); ok {
-			createAccountOptions.SetTraits(d.Get("traits").(*enterprisemanagementv1.CreateAccountRequestTraits))
+		if traits, ok := d.GetOk("traits"); ok && traits.(*schema.Set).Len() > 0 {
+			createAccountOptions.SetTraits(resourceIbmEnterpriseAccountMapToTraits(traits.(*schema.Set).List()[0].(map[string]interface{})))
 		}
 		createAccountResponse, response, err := enterpriseManagementClient.CreateAccountWithContext(context, createAccountOptions)
 		if err != nil {
@@ -320,6 +323,11 @@ func resourceIbmEnterpriseAccountUpdate(context context.Context, d *schema.Resou
 			log.Printf("[DEBUG] UpdateAccountWithContext failed %s\n%s", err, response)
 			return diag.FromErr(err)
 		}
+		if d.HasChange("parent") {
+			if _, err = waitForEnterpriseAccountMove(context, d, meta, d.Get("parent").(string)); err != nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the account %s to move to %s: %s", d.Id(), d.Get("parent").(string), err))
+			}
+		}
 	}
 
 	return resourceIbmEnterpriseAccountRead(context, d, meta)
@@ -344,3 +352,46 @@ func resourceIbmEnterpriseAccountDelete(context context.Context, d *schema.Resou
 
 	return nil
 }
+
+func resourceIbmEnterpriseAccountMapToTraits(traitsMap map[string]interface{}) *enterprisemanagementv1.CreateAccountRequestTraits {
+	traits := &enterprisemanagementv1.CreateAccountRequestTraits{}
+	if mfa, ok := traitsMap["mfa"]; ok && mfa.(string) != "" {
+		traits.Mfa = core.StringPtr(mfa.(string))
+	}
+	if enterpriseIamManaged, ok := traitsMap["enterprise_iam_managed"]; ok {
+		traits.EnterpriseIamManaged = core.BoolPtr(enterpriseIamManaged.(bool))
+	}
+	return traits
+}
+
+// waitForEnterpriseAccountMove waits until the account is active under its new parent,
+// as the account is moved asynchronously after the update request
+func waitForEnterpriseAccountMove(context context.Context, d *schema.ResourceData, meta interface{}, parent string) (interface{}, error) {
+	enterpriseManagementClient, err := meta.(conns.ClientSession).EnterpriseManagementV1()
+	if err != nil {
+		return nil, err
+	}
+
+	getAccountOptions := &enterprisemanagementv1.GetAccountOptions{}
+	getAccountOptions.SetAccountID(d.Id())
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"moving"},
+		Target:  []string{"moved"},
+		Refresh: func() (interface{}, string, error) {
+			account, response, err := enterpriseManagementClient.GetAccountWithContext(context, getAccountOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] GetAccountWithContext failed %s\n%s", err, response)
+			}
+			if account.Parent != nil && *account.Parent == parent && account.State != nil && strings.EqualFold(*account.State, "ACTIVE") {
+				return account, "moved", nil
+			}
+			return account, "moving", nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
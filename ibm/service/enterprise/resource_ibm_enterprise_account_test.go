@@ -42,7 +42,9 @@ func TestAccIbmEnterpriseAccountBasic(t *testing.T) {
 			{
 				Config: testAccCheckIbmEnterpriseAccountConfigUpdateBasic(name),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttrSet("ibm_enterprise_account.enterprise_account", "parent"),
+					resource.TestCheckResourceAttrPair("ibm_enterprise_account.enterprise_account", "parent",
+						"data.ibm_enterprise_account_groups.account_groups_instance", "account_groups.0.crn"),
+					resource.TestCheckResourceAttr("ibm_enterprise_account.enterprise_account", "state", "ACTIVE"),
 					resource.TestCheckResourceAttrSet("ibm_enterprise_account.enterprise_account", "name"),
 					resource.TestCheckResourceAttrSet("ibm_enterprise_account.enterprise_account", "owner_iam_id"),
 				),
@@ -6,6 +6,9 @@ package eventnotification
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -22,6 +25,7 @@ func ResourceIBMEnTopic() *schema.Resource {
 		ReadContext:   resourceIBMEnTopicRead,
 		UpdateContext: resourceIBMEnTopicUpdate,
 		DeleteContext: resourceIBMEnTopicDelete,
+		CustomizeDiff: resourceIBMEnTopicValidateSources,
 		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
@@ -65,15 +69,17 @@ func ResourceIBMEnTopic() *schema.Resource {
 										Description: "Whether the rule is enabled or not.",
 									},
 									"event_type_filter": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: "Event type filter.",
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateEnEventTypeFilter,
+										Description:  "Event type filter.",
 									},
 									"notification_filter": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Default:     "",
-										Description: "Notification filter.",
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "",
+										ValidateFunc: validateEnNotificationFilter,
+										Description:  "Notification filter.",
 									},
 								},
 							},
@@ -420,3 +426,96 @@ func enTopicSubscriptionToMap(subscriptionListItem en.SubscriptionListItem) map[
 
 	return subscriptionMap
 }
+
+var enFilterConditionRegexp = regexp.MustCompile(`^\$\.[A-Za-z0-9_\-\.\[\]\*]+\s*(==|!=|>=|<=|>|<)\s*('[^']*'|"[^"]*"|-?[0-9]+(\.[0-9]+)?|true|false)$`)
+
+// validateEnFilter checks the syntax of a filter, which is either `$.*` or conditions on the
+// paths of the notification such as `$.notification_event_info.event_type == 'cert_manager'`,
+// joined with `&&` or `||`
+func validateEnFilter(filter string, pathPrefix string) error {
+	if strings.TrimSpace(filter) == "$.*" {
+		return nil
+	}
+	for _, condition := range splitEnFilter(filter) {
+		condition = strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(condition), "("), ")"))
+		if !enFilterConditionRegexp.MatchString(condition) {
+			return fmt.Errorf("invalid condition %q: a condition must compare a path starting with `$.` to a quoted string, a number or a boolean, for example `$.notification_event_info.event_type == 'cert_manager'`", condition)
+		}
+		if !strings.HasPrefix(condition, pathPrefix) {
+			return fmt.Errorf("invalid condition %q: the path must start with `%s`", condition, pathPrefix)
+		}
+	}
+	return nil
+}
+
+// splitEnFilter splits a filter on the `&&` and `||` operators that are not quoted
+func splitEnFilter(filter string) []string {
+	conditions := []string{}
+	var quote rune
+	start := 0
+	for i, c := range filter {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case strings.HasPrefix(filter[i:], "&&") || strings.HasPrefix(filter[i:], "||"):
+			conditions = append(conditions, filter[start:i])
+			start = i + 2
+		}
+	}
+	return append(conditions, filter[start:])
+}
+
+func validateEnEventTypeFilter(v interface{}, k string) (ws []string, errors []error) {
+	if err := validateEnFilter(v.(string), "$.notification_event_info."); err != nil {
+		errors = append(errors, fmt.Errorf("%q %s", k, err))
+	}
+	return
+}
+
+func validateEnNotificationFilter(v interface{}, k string) (ws []string, errors []error) {
+	if v.(string) == "" {
+		return
+	}
+	if err := validateEnFilter(v.(string), "$."); err != nil {
+		errors = append(errors, fmt.Errorf("%q %s", k, err))
+	}
+	return
+}
+
+// resourceIBMEnTopicValidateSources checks at plan time that the sources of the topic exist in the instance
+func resourceIBMEnTopicValidateSources(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("sources") || !diff.NewValueKnown("instance_guid") {
+		return nil
+	}
+
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return err
+	}
+
+	instanceID := diff.Get("instance_guid").(string)
+	for i := range diff.Get("sources").([]interface{}) {
+		key := fmt.Sprintf("sources.%d.id", i)
+		if !diff.NewValueKnown(key) {
+			continue
+		}
+
+		options := &en.GetSourceOptions{}
+		options.SetInstanceID(instanceID)
+		options.SetID(diff.Get(key).(string))
+
+		_, response, err := enClient.GetSourceWithContext(context, options)
+		if err != nil {
+			if response != nil && response.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("[ERROR] The source %s of the topic is not found in the Event Notifications instance %s", *options.ID, instanceID)
+			}
+			return fmt.Errorf("GetSourceWithContext failed %s\n%s", err, response)
+		}
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ package eventnotification_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -55,6 +56,48 @@ func TestAccIBMEnTopicAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIBMEnTopicInvalidFilter(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_instance_%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMEnTopicFilterConfig(instanceName, name, "$.notification_event_info.event_type = 'cert_manager'"),
+				ExpectError: regexp.MustCompile("invalid condition"),
+			},
+			{
+				Config:      testAccCheckIBMEnTopicFilterConfig(instanceName, name, "$.notification.severity == 'HIGH'"),
+				ExpectError: regexp.MustCompile("the path must start with"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEnTopicFilterConfig(instanceName, name, eventTypeFilter string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "en_topic_resource" {
+		name     = "%s"
+		location = "us-south"
+		plan     = "standard"
+		service  = "event-notifications"
+	}
+
+	resource "ibm_en_topic" "en_topic_resource_1" {
+		instance_guid = ibm_resource_instance.en_topic_resource.guid
+		name          = "%s"
+		sources {
+			id = "crn:v1:bluemix:public:secrets-manager:us-south:a/1234:instance::"
+			rules {
+				event_type_filter = "%s"
+			}
+		}
+	}
+	`, instanceName, name, eventTypeFilter)
+}
+
 func testAccCheckIBMEnTopicConfig(instanceName, name, description string) string {
 	return fmt.Sprintf(`
 	resource "ibm_resource_instance" "en_topic_resource" {
@@ -0,0 +1,277 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+)
+
+const (
+	// The capacity of an Enterprise instance that was created without throughput and storage_size parameters
+	defaultThroughput  = 150
+	defaultStorageSize = 2048
+
+	capacityOperationInProgress = "in progress"
+	capacityOperationSucceeded  = "succeeded"
+	capacityOperationFailed     = "failed"
+)
+
+// ResourceIBMEventStreamsCapacity scales the throughput and storage of an Enterprise Event Streams instance with the
+// update parameters of the resource instance. The instance itself is managed by ibm_resource_instance.
+func ResourceIBMEventStreamsCapacity() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMEventStreamsCapacityCreate,
+		ReadContext:   resourceIBMEventStreamsCapacityRead,
+		UpdateContext: resourceIBMEventStreamsCapacityUpdate,
+		DeleteContext: resourceIBMEventStreamsCapacityDelete,
+		CustomizeDiff: resourceIBMEventStreamsCapacityCustomizeDiff,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(3 * time.Hour),
+			Update: schema.DefaultTimeout(3 * time.Hour),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The CRN of the Event Streams Enterprise instance",
+			},
+			"throughput": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.ValidateAllowedIntValues([]int{150, 300, 450}),
+				Description:  "The throughput capacity of the instance, in MB per second. It can only be increased.",
+			},
+			"storage_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.ValidateAllowedIntValues([]int{2048, 4096, 6144, 8192, 10240, 12288}),
+				Description:  "The storage capacity of the instance, in GB. It can only be increased.",
+			},
+			"service_endpoints": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "public-and-private"}),
+				Description:  "The service endpoints of the instance, public, private or public-and-private",
+			},
+			"private_ip_allowlist": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The CIDRs of the networks that are allowed to connect to the private endpoint of the instance. All the networks are allowed when it is empty.",
+			},
+			"plan_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the plan of the instance",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the instance",
+			},
+		},
+	}
+}
+
+// resourceIBMEventStreamsCapacityCustomizeDiff fails the plan when the capacity is decreased, Event Streams can't be
+// scaled down.
+func resourceIBMEventStreamsCapacityCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"throughput", "storage_size"} {
+		if diff.Id() == "" || !diff.HasChange(key) {
+			continue
+		}
+		o, n := diff.GetChange(key)
+		if n.(int) != 0 && n.(int) < o.(int) {
+			return fmt.Errorf("[ERROR] The %s of the Event Streams instance can't be decreased from %d to %d", key, o.(int), n.(int))
+		}
+	}
+	return nil
+}
+
+func resourceIBMEventStreamsCapacityCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Get("resource_instance_id").(string)
+	d.SetId(instanceCRN)
+
+	if err := updateEventStreamsCapacity(context, d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMEventStreamsCapacityRead(context, d, meta)
+}
+
+func resourceIBMEventStreamsCapacityRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceCRN := d.Id()
+	instance, response, err := rsConClient.GetResourceInstanceWithContext(context, &rc.GetResourceInstanceOptions{ID: &instanceCRN})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting the Event Streams instance %s: %s\n%s", instanceCRN, err, response))
+	}
+	if instance.State != nil && *instance.State == "removed" {
+		d.SetId("")
+		return nil
+	}
+
+	throughput := eventStreamsCapacityParameter(instance.Parameters, "throughput", defaultThroughput)
+	storageSize := eventStreamsCapacityParameter(instance.Parameters, "storage_size", defaultStorageSize)
+	allowlist := []string{}
+	if v, ok := instance.Parameters["private_ip_allowlist"].(string); ok {
+		for _, cidr := range strings.Split(strings.Trim(v, "[]"), ",") {
+			if cidr = strings.TrimSpace(cidr); cidr != "" {
+				allowlist = append(allowlist, cidr)
+			}
+		}
+	}
+
+	if err = d.Set("resource_instance_id", instance.CRN); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resource_instance_id: %s", err))
+	}
+	if err = d.Set("throughput", throughput); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting throughput: %s", err))
+	}
+	if err = d.Set("storage_size", storageSize); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting storage_size: %s", err))
+	}
+	if endpoints, ok := instance.Parameters["service-endpoints"].(string); ok {
+		if err = d.Set("service_endpoints", endpoints); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting service_endpoints: %s", err))
+		}
+	}
+	if err = d.Set("private_ip_allowlist", allowlist); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting private_ip_allowlist: %s", err))
+	}
+	if err = d.Set("plan_id", instance.ResourcePlanID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting plan_id: %s", err))
+	}
+	if err = d.Set("state", instance.State); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting state: %s", err))
+	}
+
+	return nil
+}
+
+// eventStreamsCapacityParameter returns a capacity parameter of the instance. The parameters of the instances that
+// were created with the parameters argument of ibm_resource_instance are strings.
+func eventStreamsCapacityParameter(parameters map[string]interface{}, name string, defaultValue int) int {
+	switch v := parameters[name].(type) {
+	case float64:
+		return int(v)
+	case string:
+		if i, err := strconv.Atoi(v); err == nil {
+			return i
+		}
+	}
+	return defaultValue
+}
+
+func resourceIBMEventStreamsCapacityUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := updateEventStreamsCapacity(context, d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMEventStreamsCapacityRead(context, d, meta)
+}
+
+// resourceIBMEventStreamsCapacityDelete removes the resource from the state only, the capacity of an instance can't
+// be decreased and is removed with the instance.
+func resourceIBMEventStreamsCapacityDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+// updateEventStreamsCapacity sends the changed capacity parameters in an update of the resource instance, and waits
+// for the scaling of the instance.
+func updateEventStreamsCapacity(context context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	params := map[string]interface{}{}
+	isNew := d.IsNewResource()
+	if v, ok := d.GetOk("throughput"); ok && (isNew || d.HasChange("throughput")) {
+		params["throughput"] = v.(int)
+	}
+	if v, ok := d.GetOk("storage_size"); ok && (isNew || d.HasChange("storage_size")) {
+		params["storage_size"] = v.(int)
+	}
+	if v, ok := d.GetOk("service_endpoints"); ok && (isNew || d.HasChange("service_endpoints")) {
+		params["service-endpoints"] = v.(string)
+	}
+	if d.HasChange("private_ip_allowlist") {
+		allowlist := flex.ExpandStringList(d.Get("private_ip_allowlist").(*schema.Set).List())
+		params["private_ip_allowlist"] = "[" + strings.Join(allowlist, ",") + "]"
+	}
+	if len(params) == 0 {
+		return nil
+	}
+
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+	instanceCRN := d.Id()
+	updateOptions := &rc.UpdateResourceInstanceOptions{
+		ID:         &instanceCRN,
+		Parameters: params,
+	}
+	log.Printf("[INFO] Updating the capacity of the Event Streams instance %s with %v", instanceCRN, params)
+	_, response, err := rsConClient.UpdateResourceInstanceWithContext(context, updateOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error updating the capacity of the Event Streams instance %s: %s\n%s", instanceCRN, err, response)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{capacityOperationInProgress},
+		Target:  []string{capacityOperationSucceeded},
+		Refresh: func() (interface{}, string, error) {
+			instance, response, err := rsConClient.GetResourceInstanceWithContext(context, &rc.GetResourceInstanceOptions{ID: &instanceCRN})
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting the Event Streams instance %s: %s\n%s", instanceCRN, err, response)
+			}
+			if instance.LastOperation == nil || instance.LastOperation.State == nil {
+				return instance, capacityOperationSucceeded, nil
+			}
+			if *instance.LastOperation.State == capacityOperationFailed {
+				description := ""
+				if instance.LastOperation.Description != nil {
+					description = *instance.LastOperation.Description
+				}
+				return instance, *instance.LastOperation.State, fmt.Errorf("[ERROR] The update of the Event Streams instance %s failed: %s", instanceCRN, description)
+			}
+			return instance, *instance.LastOperation.State, nil
+		},
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(context); err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for the scaling of the Event Streams instance %s: %s", instanceCRN, err)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMEventStreamsCapacityResourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckEventStreamsEnterprise(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEventStreamsCapacityConfig(acc.EventStreamsEnterpriseInstanceCRN, 300),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_event_streams_capacity.es_capacity", "id", acc.EventStreamsEnterpriseInstanceCRN),
+					resource.TestCheckResourceAttr("ibm_event_streams_capacity.es_capacity", "throughput", "300"),
+					resource.TestCheckResourceAttrSet("ibm_event_streams_capacity.es_capacity", "storage_size"),
+					resource.TestCheckResourceAttrSet("ibm_event_streams_capacity.es_capacity", "plan_id"),
+				),
+			},
+			{
+				ResourceName:      "ibm_event_streams_capacity.es_capacity",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEventStreamsCapacityConfig(instanceCRN string, throughput int) string {
+	return fmt.Sprintf(`
+	resource "ibm_event_streams_capacity" "es_capacity" {
+		resource_instance_id = "%s"
+		throughput           = %d
+	}`, instanceCRN, throughput)
+}
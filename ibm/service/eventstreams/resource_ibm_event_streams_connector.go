@@ -0,0 +1,339 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	connectorStateRunning = "running"
+	connectorStatePaused  = "paused"
+)
+
+// connectorStatus is the status of a connector and of its tasks in the connectors API
+type connectorStatus struct {
+	Name      string `json:"name"`
+	Connector struct {
+		State    string `json:"state"`
+		WorkerID string `json:"worker_id"`
+	} `json:"connector"`
+	Tasks []struct {
+		ID       int64  `json:"id"`
+		State    string `json:"state"`
+		WorkerID string `json:"worker_id"`
+		Trace    string `json:"trace"`
+	} `json:"tasks"`
+}
+
+func ResourceIBMEventStreamsConnector() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMEventStreamsConnectorCreate,
+		ReadContext:   resourceIBMEventStreamsConnectorRead,
+		UpdateContext: resourceIBMEventStreamsConnectorUpdate,
+		DeleteContext: resourceIBMEventStreamsConnectorDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Description: "The ID or the CRN of the Event Streams service instance",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"connect_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The URL of the connectors API, the kafka_http_url of the Event Streams instance by default",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the connector",
+			},
+			"config": {
+				Type:        schema.TypeMap,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The configuration of the connector, such as the connector.class, the topics and the tasks.max of a COS sink connector",
+			},
+			"sensitive_config": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The credentials of the connector, such as the cos.api.key of a COS sink connector, merged in the configuration and not read back",
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      connectorStateRunning,
+				ValidateFunc: validation.StringInSlice([]string{connectorStateRunning, connectorStatePaused}, false),
+				Description:  "The requested state of the connector, running or paused",
+			},
+			"connector_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the connector reported by the connectors API, such as RUNNING, PAUSED or FAILED",
+			},
+			"tasks": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The tasks of the connector",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the task",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The state of the task",
+						},
+						"worker_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The worker that runs the task",
+						},
+						"trace": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The trace of the error of a failed task",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// getConnectService returns the connectors API of the Event Streams instance of the connector
+func getConnectService(d *schema.ResourceData, meta interface{}) (*core.BaseService, string, error) {
+	connectClient, err := meta.(conns.ClientSession).ESconnectSession()
+	if err != nil {
+		return nil, "", err
+	}
+	instanceCRN := d.Get("resource_instance_id").(string)
+	if len(instanceCRN) == 0 {
+		connectorID := d.Id()
+		if len(connectorID) == 0 || !strings.Contains(connectorID, ":") {
+			log.Printf("[DEBUG] getConnectService resource_instance_id is missing")
+			return nil, "", fmt.Errorf("resource_instance_id is required")
+		}
+		instanceCRN = getInstanceCRN(connectorID)
+	}
+
+	connectURL := d.Get("connect_url").(string)
+	if connectURL == "" {
+		instance, err := getInstanceDetails(instanceCRN, meta)
+		if err != nil {
+			return nil, "", err
+		}
+		if !strings.Contains(*instance.ResourcePlanID, "enterprise") {
+			return nil, "", fmt.Errorf("connectors are not supported by the Event Streams %s plan, enterprise plan is expected", *instance.ResourcePlanID)
+		}
+		connectURL = instance.Extensions["kafka_http_url"].(string)
+		d.Set("connect_url", connectURL)
+	}
+
+	service := connectClient.Clone()
+	if err = service.SetServiceURL(connectURL); err != nil {
+		return nil, "", err
+	}
+	return service, instanceCRN, nil
+}
+
+// connectRequest sends a request to the connectors API and unmarshals the response into result
+func connectRequest(context context.Context, service *core.BaseService, method, path string, pathParamsMap map[string]string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(context)
+	_, err := builder.ResolveRequestURL(service.Options.URL, path, pathParamsMap)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		if _, err := builder.SetBodyContentJSON(body); err != nil {
+			return nil, err
+		}
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return service.Request(request, result)
+}
+
+func resourceIBMEventStreamsConnectorCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connectClient, instanceCRN, err := getConnectService(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+	if err := putConnectorConfig(context, connectClient, d); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(getConnectorID(instanceCRN, name))
+
+	if d.Get("state").(string) == connectorStatePaused {
+		if err := setConnectorState(context, connectClient, name, connectorStatePaused); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMEventStreamsConnectorRead(context, d, meta)
+}
+
+// putConnectorConfig creates or updates the connector with its configuration and its credentials
+func putConnectorConfig(context context.Context, connectClient *core.BaseService, d *schema.ResourceData) error {
+	name := d.Get("name").(string)
+	config := map[string]string{}
+	for key, value := range d.Get("config").(map[string]interface{}) {
+		config[key] = value.(string)
+	}
+	for key, value := range d.Get("sensitive_config").(map[string]interface{}) {
+		config[key] = value.(string)
+	}
+	config["name"] = name
+
+	response, err := connectRequest(context, connectClient, core.PUT, "/connectors/{name}/config", map[string]string{"name": name}, config, nil)
+	if err != nil {
+		log.Printf("[DEBUG] PUT connector config failed with error: %s and response: \n%s", err, response)
+		return fmt.Errorf("[ERROR] Error configuring the connector %s: %s\n%s", name, err, response)
+	}
+	return nil
+}
+
+// setConnectorState pauses or resumes the connector and its tasks
+func setConnectorState(context context.Context, connectClient *core.BaseService, name, state string) error {
+	path := "/connectors/{name}/resume"
+	if state == connectorStatePaused {
+		path = "/connectors/{name}/pause"
+	}
+	response, err := connectRequest(context, connectClient, core.PUT, path, map[string]string{"name": name}, nil, nil)
+	if err != nil {
+		log.Printf("[DEBUG] Setting the connector state failed with error: %s and response: \n%s", err, response)
+		return fmt.Errorf("[ERROR] Error setting the state of the connector %s to %s: %s\n%s", name, state, err, response)
+	}
+	return nil
+}
+
+func resourceIBMEventStreamsConnectorRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connectClient, instanceCRN, err := getConnectService(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := getConnectorName(d.Id())
+	config := map[string]string{}
+	response, err := connectRequest(context, connectClient, core.GET, "/connectors/{name}/config", map[string]string{"name": name}, nil, &config)
+	if err != nil {
+		log.Printf("[DEBUG] GET connector config failed with error: %s and response: \n%s", err, response)
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting the connector %s: %s\n%s", name, err, response))
+	}
+
+	// The credentials are kept in sensitive_config, and the name is added to the configuration by the API
+	delete(config, "name")
+	for key := range d.Get("sensitive_config").(map[string]interface{}) {
+		delete(config, key)
+	}
+
+	status := &connectorStatus{}
+	response, err = connectRequest(context, connectClient, core.GET, "/connectors/{name}/status", map[string]string{"name": name}, nil, status)
+	if err != nil {
+		log.Printf("[DEBUG] GET connector status failed with error: %s and response: \n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting the status of the connector %s: %s\n%s", name, err, response))
+	}
+	tasks := make([]map[string]interface{}, 0, len(status.Tasks))
+	for _, task := range status.Tasks {
+		tasks = append(tasks, map[string]interface{}{
+			"id":        task.ID,
+			"state":     task.State,
+			"worker_id": task.WorkerID,
+			"trace":     task.Trace,
+		})
+	}
+
+	d.Set("resource_instance_id", instanceCRN)
+	d.Set("name", name)
+	d.Set("config", config)
+	d.Set("connector_state", status.Connector.State)
+	if status.Connector.State == "PAUSED" {
+		d.Set("state", connectorStatePaused)
+	} else {
+		d.Set("state", connectorStateRunning)
+	}
+	d.Set("tasks", tasks)
+
+	return nil
+}
+
+func resourceIBMEventStreamsConnectorUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connectClient, _, err := getConnectService(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("config", "sensitive_config") {
+		if err := putConnectorConfig(context, connectClient, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if d.HasChange("state") {
+		if err := setConnectorState(context, connectClient, d.Get("name").(string), d.Get("state").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMEventStreamsConnectorRead(context, d, meta)
+}
+
+func resourceIBMEventStreamsConnectorDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connectClient, _, err := getConnectService(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := getConnectorName(d.Id())
+	response, err := connectRequest(context, connectClient, core.DELETE, "/connectors/{name}", map[string]string{"name": name}, nil, nil)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DELETE connector failed with error: %s and response: \n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting the connector %s: %s\n%s", name, err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func getConnectorID(instanceCRN string, name string) string {
+	crnSegments := strings.Split(instanceCRN, ":")
+	crnSegments[8] = "connector"
+	crnSegments[9] = name
+	return strings.Join(crnSegments, ":")
+}
+
+func getConnectorName(id string) string {
+	return strings.Split(id, ":")[9]
+}
@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMEventStreamsConnectorBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-connector-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEventStreamsConnectorConfig(MZREnterpriseInstanceName, name, "running"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_event_streams_connector.es_connector", "id"),
+					resource.TestCheckResourceAttr("ibm_event_streams_connector.es_connector", "name", name),
+					resource.TestCheckResourceAttr("ibm_event_streams_connector.es_connector", "config.tasks.max", "1"),
+					resource.TestCheckResourceAttr("ibm_event_streams_connector.es_connector", "state", "running"),
+				),
+			},
+			{
+				Config: testAccCheckIBMEventStreamsConnectorConfig(MZREnterpriseInstanceName, name, "paused"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_event_streams_connector.es_connector", "state", "paused"),
+					resource.TestCheckResourceAttr("ibm_event_streams_connector.es_connector", "connector_state", "PAUSED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEventStreamsConnectorConfig(instanceName, name, state string) string {
+	return getPlatformResource(instanceName) + "\n" + fmt.Sprintf(`
+	resource "ibm_event_streams_topic" "es_topic" {
+		resource_instance_id = data.ibm_resource_instance.es_instance.id
+		name                 = "%[1]s-topic"
+		partitions           = 1
+	}
+	resource "ibm_event_streams_connector" "es_connector" {
+		resource_instance_id = data.ibm_resource_instance.es_instance.id
+		name                 = "%[1]s"
+		config = {
+			"connector.class" = "org.apache.kafka.connect.file.FileStreamSinkConnector"
+			"tasks.max"       = "1"
+			"topics"          = ibm_event_streams_topic.es_topic.name
+			"file"            = "/tmp/%[1]s.txt"
+		}
+		state = "%[2]s"
+	}`, name, state)
+}
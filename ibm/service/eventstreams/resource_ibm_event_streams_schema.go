@@ -58,6 +58,18 @@ func ResourceIBMEventStreamsSchema() *schema.Resource {
 				ForceNew:    true,
 				Description: "The ID to be assigned to schema, which must be unique. If this value is not specified, a generated UUID is assigned.",
 			},
+			"new_version_on_update": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Create a new version of the schema when the schema is updated instead of replacing the latest version",
+			},
+			"versions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The versions of the schema",
+			},
 		},
 	}
 }
@@ -242,6 +254,21 @@ func resourceIBMEventStreamsSchemaRead(context context.Context, d *schema.Resour
 	d.Set("resource_instance_id", instanceCRN)
 	d.Set("schema_id", schemaID)
 
+	listVersionsOptions := &schemaregistryv1.ListVersionsOptions{}
+	listVersionsOptions.SetID(schemaID)
+	versions, response, err := schemaregistryClient.ListVersionsWithContext(context, listVersionsOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Schema %s was deleted, removing it from state", schemaID)
+			d.SetId("")
+			return nil
+		}
+		// The versions are informational, the schema itself was read successfully
+		log.Printf("[WARN] ListVersionsWithContext failed with error: %s and response: \n%s", err, response)
+		return nil
+	}
+	d.Set("versions", versions)
+
 	return nil
 }
 
@@ -257,11 +284,24 @@ func resourceIBMEventStreamsSchemaUpdate(context context.Context, d *schema.Reso
 	}
 	schemaregistryClient.SetServiceURL(adminURL)
 
-	updateSchemaOptions := &schemaregistryv1.UpdateSchemaOptions{}
 	schemaID := d.Get("schema_id").(string)
-	updateSchemaOptions.SetID(schemaID)
 
-	if d.HasChange("schema") {
+	if d.HasChange("schema") && d.Get("new_version_on_update").(bool) {
+		createVersionOptions := &schemaregistryv1.CreateVersionOptions{}
+		createVersionOptions.SetID(schemaID)
+		if s, ok := d.GetOk("schema"); ok {
+			var schema map[string]interface{}
+			json.Unmarshal([]byte(s.(string)), &schema)
+			createVersionOptions.Schema = schema
+		}
+		schemaMetadata, response, err := schemaregistryClient.CreateVersionWithContext(context, createVersionOptions)
+		if err != nil || schemaMetadata == nil {
+			log.Printf("[DEBUG] CreateVersionWithContext failed with error: %s\n and response: %s", err, response)
+			return diag.FromErr(fmt.Errorf("CreateVersionWithContext failed with error: %s and response: \n%s", err, response))
+		}
+	} else if d.HasChange("schema") {
+		updateSchemaOptions := &schemaregistryv1.UpdateSchemaOptions{}
+		updateSchemaOptions.SetID(schemaID)
 		if s, ok := d.GetOk("schema"); ok {
 			var schema map[string]interface{}
 			json.Unmarshal([]byte(s.(string)), &schema)
@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/eventstreams-go-sdk/pkg/schemaregistryv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var schemaCompatibilityConfigs = []string{
+	schemaregistryv1.RuleConfigBackwardConst,
+	schemaregistryv1.RuleConfigBackwardTransitiveConst,
+	schemaregistryv1.RuleConfigForwardConst,
+	schemaregistryv1.RuleConfigForwardTransitiveConst,
+	schemaregistryv1.RuleConfigFullConst,
+	schemaregistryv1.RuleConfigFullTransitiveConst,
+	schemaregistryv1.RuleConfigNoneConst,
+}
+
+func ResourceIBMEventStreamsSchemaGlobalRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMEventStreamsSchemaGlobalRuleUpdate,
+		ReadContext:   resourceIBMEventStreamsSchemaGlobalRuleRead,
+		UpdateContext: resourceIBMEventStreamsSchemaGlobalRuleUpdate,
+		DeleteContext: resourceIBMEventStreamsSchemaGlobalRuleDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Description: "The ID or the CRN of the Event Streams service instance",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"kafka_http_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The API endpoint for interacting with an Event Streams REST API",
+			},
+			"config": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(schemaCompatibilityConfigs, false),
+				Description:  "The compatibility rule applied to the schemas that have no compatibility rule of their own",
+			},
+		},
+	}
+}
+
+func resourceIBMEventStreamsSchemaGlobalRuleUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schemaregistryClient, err := meta.(conns.ClientSession).ESschemaRegistrySession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	adminURL, instanceCRN, err := getInstanceURL(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	schemaregistryClient.SetServiceURL(adminURL)
+
+	updateGlobalRuleOptions := schemaregistryClient.NewUpdateGlobalRuleOptions(
+		schemaregistryv1.UpdateGlobalRuleOptionsRuleCompatibilityConst,
+		schemaregistryv1.RuleTypeCompatibilityConst,
+		d.Get("config").(string))
+
+	rule, response, err := schemaregistryClient.UpdateGlobalRuleWithContext(context, updateGlobalRuleOptions)
+	if err != nil || rule == nil {
+		log.Printf("[DEBUG] UpdateGlobalRuleWithContext failed with error: %s and response: \n%s", err, response)
+		return diag.FromErr(fmt.Errorf("UpdateGlobalRuleWithContext failed with error: %s and response: \n%s", err, response))
+	}
+	d.SetId(getGlobalRuleID(instanceCRN))
+
+	return resourceIBMEventStreamsSchemaGlobalRuleRead(context, d, meta)
+}
+
+func resourceIBMEventStreamsSchemaGlobalRuleRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schemaregistryClient, err := meta.(conns.ClientSession).ESschemaRegistrySession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	adminURL, instanceCRN, err := getInstanceURL(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	schemaregistryClient.SetServiceURL(adminURL)
+
+	getGlobalRuleOptions := schemaregistryClient.NewGetGlobalRuleOptions(schemaregistryv1.GetGlobalRuleOptionsRuleCompatibilityConst)
+	rule, response, err := schemaregistryClient.GetGlobalRuleWithContext(context, getGlobalRuleOptions)
+	if err != nil || rule == nil {
+		log.Printf("[DEBUG] GetGlobalRuleWithContext failed with error: %s and response: \n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetGlobalRuleWithContext failed %s\n%s", err, response))
+	}
+
+	d.Set("resource_instance_id", instanceCRN)
+	d.Set("config", rule.Config)
+
+	return nil
+}
+
+func resourceIBMEventStreamsSchemaGlobalRuleDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schemaregistryClient, err := meta.(conns.ClientSession).ESschemaRegistrySession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	adminURL, _, err := getInstanceURL(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	schemaregistryClient.SetServiceURL(adminURL)
+
+	// The global rule cannot be deleted, it is reset to the default of the schema registry
+	updateGlobalRuleOptions := schemaregistryClient.NewUpdateGlobalRuleOptions(
+		schemaregistryv1.UpdateGlobalRuleOptionsRuleCompatibilityConst,
+		schemaregistryv1.RuleTypeCompatibilityConst,
+		schemaregistryv1.RuleConfigNoneConst)
+
+	_, response, err := schemaregistryClient.UpdateGlobalRuleWithContext(context, updateGlobalRuleOptions)
+	if err != nil {
+		log.Printf("[DEBUG] UpdateGlobalRuleWithContext failed with error: %s and response: \n%s", err, response)
+		return diag.FromErr(fmt.Errorf("UpdateGlobalRuleWithContext failed with error: %s and response: \n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func getGlobalRuleID(instanceCRN string) string {
+	crnSegments := strings.Split(instanceCRN, ":")
+	crnSegments[8] = "schema-global-rule"
+	crnSegments[9] = schemaregistryv1.RuleTypeCompatibilityConst
+	return strings.Join(crnSegments, ":")
+}
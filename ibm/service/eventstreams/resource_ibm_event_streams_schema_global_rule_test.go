@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMEventStreamsSchemaGlobalRuleBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEventStreamsSchemaGlobalRuleConfig(MZREnterpriseInstanceName, "BACKWARD"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_event_streams_schema_global_rule.es_global_rule", "id"),
+					resource.TestCheckResourceAttr("ibm_event_streams_schema_global_rule.es_global_rule", "config", "BACKWARD"),
+				),
+			},
+			{
+				Config: testAccCheckIBMEventStreamsSchemaGlobalRuleConfig(MZREnterpriseInstanceName, "FULL_TRANSITIVE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_event_streams_schema_global_rule.es_global_rule", "config", "FULL_TRANSITIVE"),
+				),
+			},
+			{
+				ResourceName:      "ibm_event_streams_schema_global_rule.es_global_rule",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEventStreamsSchemaGlobalRuleConfig(instanceName, config string) string {
+	return getPlatformResource(instanceName) + "\n" + fmt.Sprintf(`
+	resource "ibm_event_streams_schema_global_rule" "es_global_rule" {
+		resource_instance_id = data.ibm_resource_instance.es_instance.id
+		config               = "%s"
+	}`, config)
+}
@@ -0,0 +1,171 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/eventstreams-go-sdk/pkg/schemaregistryv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMEventStreamsSchemaRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMEventStreamsSchemaRuleCreate,
+		ReadContext:   resourceIBMEventStreamsSchemaRuleRead,
+		UpdateContext: resourceIBMEventStreamsSchemaRuleUpdate,
+		DeleteContext: resourceIBMEventStreamsSchemaRuleDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Description: "The ID or the CRN of the Event Streams service instance",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"kafka_http_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The API endpoint for interacting with an Event Streams REST API",
+			},
+			"schema_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the schema the compatibility rule applies to",
+			},
+			"config": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(schemaCompatibilityConfigs, false),
+				Description:  "The compatibility rule of the schema, it overrides the global compatibility rule",
+			},
+		},
+	}
+}
+
+func resourceIBMEventStreamsSchemaRuleCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schemaregistryClient, err := meta.(conns.ClientSession).ESschemaRegistrySession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	adminURL, instanceCRN, err := getInstanceURL(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	schemaregistryClient.SetServiceURL(adminURL)
+
+	schemaID := d.Get("schema_id").(string)
+	createSchemaRuleOptions := schemaregistryClient.NewCreateSchemaRuleOptions(
+		schemaID,
+		schemaregistryv1.CreateSchemaRuleOptionsTypeCompatibilityConst,
+		d.Get("config").(string))
+
+	rule, response, err := schemaregistryClient.CreateSchemaRuleWithContext(context, createSchemaRuleOptions)
+	if err != nil || rule == nil {
+		log.Printf("[DEBUG] CreateSchemaRuleWithContext failed with error: %s and response: \n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateSchemaRuleWithContext failed with error: %s and response: \n%s", err, response))
+	}
+	d.SetId(getSchemaRuleID(instanceCRN, schemaID))
+
+	return resourceIBMEventStreamsSchemaRuleRead(context, d, meta)
+}
+
+func resourceIBMEventStreamsSchemaRuleRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schemaregistryClient, err := meta.(conns.ClientSession).ESschemaRegistrySession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	adminURL, instanceCRN, err := getInstanceURL(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	schemaregistryClient.SetServiceURL(adminURL)
+
+	schemaID := getSchemaID(d.Id())
+	getSchemaRuleOptions := schemaregistryClient.NewGetSchemaRuleOptions(schemaID, schemaregistryv1.GetSchemaRuleOptionsRuleCompatibilityConst)
+	rule, response, err := schemaregistryClient.GetSchemaRuleWithContext(context, getSchemaRuleOptions)
+	if err != nil || rule == nil {
+		log.Printf("[DEBUG] GetSchemaRuleWithContext failed with error: %s and response: \n%s", err, response)
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("GetSchemaRuleWithContext failed %s\n%s", err, response))
+	}
+
+	d.Set("resource_instance_id", instanceCRN)
+	d.Set("schema_id", schemaID)
+	d.Set("config", rule.Config)
+
+	return nil
+}
+
+func resourceIBMEventStreamsSchemaRuleUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schemaregistryClient, err := meta.(conns.ClientSession).ESschemaRegistrySession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	adminURL, _, err := getInstanceURL(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	schemaregistryClient.SetServiceURL(adminURL)
+
+	if d.HasChange("config") {
+		updateSchemaRuleOptions := schemaregistryClient.NewUpdateSchemaRuleOptions(
+			d.Get("schema_id").(string),
+			schemaregistryv1.UpdateSchemaRuleOptionsRuleCompatibilityConst,
+			schemaregistryv1.RuleTypeCompatibilityConst,
+			d.Get("config").(string))
+
+		rule, response, err := schemaregistryClient.UpdateSchemaRuleWithContext(context, updateSchemaRuleOptions)
+		if err != nil || rule == nil {
+			log.Printf("[DEBUG] UpdateSchemaRuleWithContext failed with error: %s and response: \n%s", err, response)
+			return diag.FromErr(fmt.Errorf("UpdateSchemaRuleWithContext failed with error: %s and response: \n%s", err, response))
+		}
+	}
+
+	return resourceIBMEventStreamsSchemaRuleRead(context, d, meta)
+}
+
+func resourceIBMEventStreamsSchemaRuleDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schemaregistryClient, err := meta.(conns.ClientSession).ESschemaRegistrySession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	adminURL, _, err := getInstanceURL(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	schemaregistryClient.SetServiceURL(adminURL)
+
+	deleteSchemaRuleOptions := schemaregistryClient.NewDeleteSchemaRuleOptions(
+		d.Get("schema_id").(string),
+		schemaregistryv1.DeleteSchemaRuleOptionsRuleCompatibilityConst)
+
+	response, err := schemaregistryClient.DeleteSchemaRuleWithContext(context, deleteSchemaRuleOptions)
+	if err != nil {
+		log.Printf("[DEBUG] DeleteSchemaRuleWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteSchemaRuleWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func getSchemaRuleID(instanceCRN string, schemaID string) string {
+	crnSegments := strings.Split(instanceCRN, ":")
+	crnSegments[8] = "schema-rule"
+	crnSegments[9] = schemaID
+	return strings.Join(crnSegments, ":")
+}
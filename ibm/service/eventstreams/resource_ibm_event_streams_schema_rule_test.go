@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM/eventstreams-go-sdk/pkg/schemaregistryv1"
+)
+
+func TestAccIBMEventStreamsSchemaRuleBasic(t *testing.T) {
+	schemaID := fmt.Sprintf("tf_schema_rule_%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEventStreamsSchemaRuleConfig(MZREnterpriseInstanceName, schemaID, "FORWARD"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_event_streams_schema_rule.es_schema_rule", "id"),
+					resource.TestCheckResourceAttr("ibm_event_streams_schema_rule.es_schema_rule", "schema_id", schemaID),
+					resource.TestCheckResourceAttr("ibm_event_streams_schema_rule.es_schema_rule", "config", "FORWARD"),
+					testAccCheckIBMEventStreamsSchemaRuleConfigured("ibm_event_streams_schema_rule.es_schema_rule", "FORWARD"),
+				),
+			},
+			{
+				Config: testAccCheckIBMEventStreamsSchemaRuleConfig(MZREnterpriseInstanceName, schemaID, "BACKWARD_TRANSITIVE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_event_streams_schema_rule.es_schema_rule", "config", "BACKWARD_TRANSITIVE"),
+					testAccCheckIBMEventStreamsSchemaRuleConfigured("ibm_event_streams_schema_rule.es_schema_rule", "BACKWARD_TRANSITIVE"),
+				),
+			},
+			{
+				Config: testAccCheckIBMEventStreamsSchemaRuleConfig(MZREnterpriseInstanceName, schemaID, "NONE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_event_streams_schema_rule.es_schema_rule", "config", "NONE"),
+					testAccCheckIBMEventStreamsSchemaRuleConfigured("ibm_event_streams_schema_rule.es_schema_rule", "NONE"),
+				),
+			},
+			{
+				ResourceName:      "ibm_event_streams_schema_rule.es_schema_rule",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEventStreamsSchemaRuleConfig(instanceName, schemaID, config string) string {
+	return testAccCheckIBMEventStreamsSchemaWithSchemaIDWithExistingInstance(instanceName, schemaID) + "\n" + fmt.Sprintf(`
+	resource "ibm_event_streams_schema_rule" "es_schema_rule" {
+		resource_instance_id = data.ibm_resource_instance.es_instance.id
+		schema_id            = ibm_event_streams_schema.es_schema.schema_id
+		config               = "%s"
+	}`, config)
+}
+
+// testAccCheckIBMEventStreamsSchemaRuleConfigured checks the compatibility rule of the schema in the registry,
+// not only in the state, so that an update that is not sent to the API fails the test.
+func testAccCheckIBMEventStreamsSchemaRuleConfigured(n, config string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		schemaregistryClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).ESschemaRegistrySession()
+		if err != nil {
+			return err
+		}
+		schemaregistryClient.SetServiceURL(rs.Primary.Attributes["kafka_http_url"])
+
+		getSchemaRuleOptions := schemaregistryClient.NewGetSchemaRuleOptions(
+			rs.Primary.Attributes["schema_id"],
+			schemaregistryv1.GetSchemaRuleOptionsRuleCompatibilityConst)
+		rule, _, err := schemaregistryClient.GetSchemaRule(getSchemaRuleOptions)
+		if err != nil {
+			return err
+		}
+		if rule.Config == nil {
+			return fmt.Errorf("[ERROR] The schema %s has no compatibility rule, expected %s", rs.Primary.Attributes["schema_id"], config)
+		}
+		if *rule.Config != config {
+			return fmt.Errorf("[ERROR] The compatibility rule of schema %s is %s, expected %s", rs.Primary.Attributes["schema_id"], *rule.Config, config)
+		}
+		return nil
+	}
+}
@@ -89,9 +89,36 @@ func TestAccIBMEventStreamsSchemaImport(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "ibm_event_streams_schema.es_schema",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "ibm_event_streams_schema.es_schema",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"new_version_on_update"},
+			},
+		},
+	})
+}
+
+func TestAccIBMEventStreamsSchemaNewVersion(t *testing.T) {
+	var conf map[string]interface{}
+	schemaID := fmt.Sprintf("tf_schema_version_%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMEventStreamsSchemaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEventStreamsSchemaNewVersion(MZREnterpriseInstanceName, schemaID, "value_1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMEventStreamsSchemaExists("ibm_event_streams_schema.es_schema", conf, schemaID),
+					resource.TestCheckResourceAttr("ibm_event_streams_schema.es_schema", "versions.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMEventStreamsSchemaNewVersion(MZREnterpriseInstanceName, schemaID, "value_2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMEventStreamsSchemaExists("ibm_event_streams_schema.es_schema", conf, schemaID),
+					resource.TestCheckResourceAttr("ibm_event_streams_schema.es_schema", "versions.#", "2"),
+				),
 			},
 		},
 	})
@@ -107,6 +134,24 @@ func testAccCheckIBMEventStreamsSchemaWithSchemaIDWithExistingInstance(instanceN
 	return s
 }
 
+func testAccCheckIBMEventStreamsSchemaNewVersion(instanceName, schemaID, fieldName string) string {
+	return getPlatformResource(instanceName) + "\n" + fmt.Sprintf(`
+	resource "ibm_event_streams_schema" "es_schema" {
+		resource_instance_id 	= data.ibm_resource_instance.es_instance.id
+		schema_id 			= "%s"
+		new_version_on_update 	= true
+		schema           		= <<SCHEMA
+		{
+			"type": "record",
+			"name": "record_name",
+			"fields" : [
+			  {"name": "%s", "type": "string", "default": ""}
+			]
+		}
+		SCHEMA
+	}`, schemaID, fieldName)
+}
+
 func createEventStreamsSchemaResourceWithoutSchemaID(createInstance bool, prefix string) string {
 	var resourceInstanceID string
 	if createInstance {
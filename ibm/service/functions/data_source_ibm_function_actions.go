@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package functions
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// funcListLimit is the maximum page size of the Cloud Functions list APIs
+const funcListLimit = 200
+
+func DataSourceIBMFunctionActions() *schema.Resource {
+	return &schema.Resource{
+
+		Read: dataSourceIBMFunctionActionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the namespace.",
+			},
+			"actions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The actions of the namespace, including the actions of its packages.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the action, prefixed with the package name for the actions of a package.",
+						},
+						"package": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the package of the action.",
+						},
+						"kind": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of action.",
+						},
+						"publish": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Action visibilty.",
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Semantic version of the action.",
+						},
+						"annotations": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "All annotations set on action by user and those set by the IBM Cloud Function backend/API.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMFunctionActionsRead(d *schema.ResourceData, meta interface{}) error {
+	functionNamespaceAPI, err := meta.(conns.ClientSession).FunctionIAMNamespaceAPI()
+	if err != nil {
+		return err
+	}
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	namespace := d.Get("namespace").(string)
+	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+	if err != nil {
+		return err
+
+	}
+
+	actionService := wskClient.Actions
+	allActions := []whisk.Action{}
+	for skip := 0; ; skip += funcListLimit {
+		options := &whisk.ActionListOptions{
+			Limit: funcListLimit,
+			Skip:  skip,
+		}
+		actions, _, err := actionService.List("", options)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing IBM Cloud Function Actions of namespace %s : %s", namespace, err)
+		}
+		allActions = append(allActions, actions...)
+		if len(actions) < funcListLimit {
+			break
+		}
+	}
+
+	actions := make([]map[string]interface{}, 0, len(allActions))
+	for _, action := range allActions {
+		name := action.Name
+		pkgName := ""
+		if temp := strings.Split(action.Namespace, "/"); len(temp) == 2 {
+			pkgName = temp[1]
+			name = fmt.Sprintf("%s/%s", pkgName, action.Name)
+		}
+		a := map[string]interface{}{
+			"name":    name,
+			"package": pkgName,
+			"publish": action.Publish != nil && *action.Publish,
+			"version": action.Version,
+		}
+		if action.Exec != nil {
+			a["kind"] = action.Exec.Kind
+		}
+		annotations, err := flex.FlattenAnnotations(action.Annotations)
+		if err != nil {
+			log.Printf(
+				"An error occured during reading of action (%s) annotations : %s", name, err)
+		}
+		a["annotations"] = annotations
+		actions = append(actions, a)
+	}
+
+	d.SetId(namespace)
+	d.Set("actions", actions)
+
+	return nil
+}
@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package functions_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccFunctionActionsDataSourceBasic(t *testing.T) {
+	name := fmt.Sprintf("terraform_action_%d", acctest.RandIntRange(10, 100))
+	namespace := os.Getenv("IBM_FUNCTION_NAMESPACE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+
+			{
+				Config: testAccCheckFunctionActionsDataSource(name, namespace),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_function_actions.actions", "namespace", namespace),
+					resource.TestCheckResourceAttrSet("data.ibm_function_actions.actions", "actions.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFunctionActionsDataSource(name, namespace string) string {
+	return fmt.Sprintf(`
+resource "ibm_function_action" "action" {
+	name      = "%s"
+	namespace = "%s"
+
+	exec {
+		kind = "nodejs:10"
+		code = "function main(params) { return params; }"
+	}
+}
+
+data "ibm_function_actions" "actions" {
+	namespace = ibm_function_action.action.namespace
+}
+`, name, namespace)
+
+}
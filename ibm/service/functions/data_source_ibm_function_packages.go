@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package functions
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMFunctionPackages() *schema.Resource {
+	return &schema.Resource{
+
+		Read: dataSourceIBMFunctionPackagesRead,
+
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the namespace.",
+			},
+			"packages": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The packages of the namespace.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the package.",
+						},
+						"bind_package_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the bind package.",
+						},
+						"publish": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Package Visibility.",
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Semantic version of the package.",
+						},
+						"annotations": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "All annotations set on package by user and those set by the IBM Cloud Function backend/API.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMFunctionPackagesRead(d *schema.ResourceData, meta interface{}) error {
+	functionNamespaceAPI, err := meta.(conns.ClientSession).FunctionIAMNamespaceAPI()
+	if err != nil {
+		return err
+	}
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	namespace := d.Get("namespace").(string)
+	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+	if err != nil {
+		return err
+
+	}
+
+	packageService := wskClient.Packages
+	allPackages := []whisk.Package{}
+	for skip := 0; ; skip += funcListLimit {
+		options := &whisk.PackageListOptions{
+			Limit: funcListLimit,
+			Skip:  skip,
+		}
+		packages, _, err := packageService.List(options)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing IBM Cloud Function Packages of namespace %s : %s", namespace, err)
+		}
+		allPackages = append(allPackages, packages...)
+		if len(packages) < funcListLimit {
+			break
+		}
+	}
+
+	packages := make([]map[string]interface{}, 0, len(allPackages))
+	for _, pkg := range allPackages {
+		p := map[string]interface{}{
+			"name":    pkg.Name,
+			"publish": pkg.Publish != nil && *pkg.Publish,
+			"version": pkg.Version,
+		}
+		if pkg.Binding != nil && pkg.Binding.Name != "" {
+			p["bind_package_name"] = fmt.Sprintf("/%s/%s", pkg.Binding.Namespace, pkg.Binding.Name)
+		}
+		annotations, err := flex.FlattenAnnotations(pkg.Annotations)
+		if err != nil {
+			log.Printf(
+				"An error occured during reading of package (%s) annotations : %s", pkg.Name, err)
+		}
+		p["annotations"] = annotations
+		packages = append(packages, p)
+	}
+
+	d.SetId(namespace)
+	d.Set("packages", packages)
+
+	return nil
+}
@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package functions
+
+import (
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMFunctionRules() *schema.Resource {
+	return &schema.Resource{
+
+		Read: dataSourceIBMFunctionRulesRead,
+
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the namespace.",
+			},
+			"rules": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The rules of the namespace.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the rule.",
+						},
+						"trigger_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the trigger.",
+						},
+						"action_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of an action.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the rule.",
+						},
+						"publish": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Rule visbility.",
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Semantic version of the rule",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMFunctionRulesRead(d *schema.ResourceData, meta interface{}) error {
+	functionNamespaceAPI, err := meta.(conns.ClientSession).FunctionIAMNamespaceAPI()
+	if err != nil {
+		return err
+	}
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	namespace := d.Get("namespace").(string)
+	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+	if err != nil {
+		return err
+
+	}
+
+	ruleService := wskClient.Rules
+	allRules := []whisk.Rule{}
+	for skip := 0; ; skip += funcListLimit {
+		options := &whisk.RuleListOptions{
+			Limit: funcListLimit,
+			Skip:  skip,
+		}
+		rules, _, err := ruleService.List(options)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing IBM Cloud Function Rules of namespace %s : %s", namespace, err)
+		}
+		allRules = append(allRules, rules...)
+		if len(rules) < funcListLimit {
+			break
+		}
+	}
+
+	rules := make([]map[string]interface{}, 0, len(allRules))
+	for _, rule := range allRules {
+		r := map[string]interface{}{
+			"name":    rule.Name,
+			"status":  rule.Status,
+			"publish": rule.Publish != nil && *rule.Publish,
+			"version": rule.Version,
+		}
+		if trigger, ok := rule.Trigger.(map[string]interface{}); ok {
+			r["trigger_name"] = fmt.Sprintf("%v", trigger["name"])
+		}
+		if action, ok := rule.Action.(map[string]interface{}); ok {
+			r["action_name"] = fmt.Sprintf("/%v/%v", action["path"], action["name"])
+		}
+		rules = append(rules, r)
+	}
+
+	d.SetId(namespace)
+	d.Set("rules", rules)
+
+	return nil
+}
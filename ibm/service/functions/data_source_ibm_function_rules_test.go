@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package functions_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccFunctionRulesDataSourceBasic(t *testing.T) {
+	name := fmt.Sprintf("terraform_rule_%d", acctest.RandIntRange(10, 100))
+	namespace := os.Getenv("IBM_FUNCTION_NAMESPACE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+
+			{
+				Config: testAccCheckFunctionRulesDataSource(name, namespace),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_function_rules.rules", "namespace", namespace),
+					resource.TestCheckResourceAttrSet("data.ibm_function_rules.rules", "rules.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFunctionRulesDataSource(name, namespace string) string {
+	return fmt.Sprintf(`
+resource "ibm_function_action" "action" {
+	name      = "%[1]s"
+	namespace = "%[2]s"
+
+	exec {
+		kind = "nodejs:10"
+		code = "function main(params) { return params; }"
+	}
+}
+
+resource "ibm_function_trigger" "trigger" {
+	name      = "%[1]s"
+	namespace = "%[2]s"
+}
+
+resource "ibm_function_rule" "rule" {
+	name         = "%[1]s"
+	namespace    = "%[2]s"
+	trigger_name = ibm_function_trigger.trigger.name
+	action_name  = ibm_function_action.action.name
+}
+
+data "ibm_function_rules" "rules" {
+	namespace = ibm_function_rule.rule.namespace
+}
+`, name, namespace)
+
+}
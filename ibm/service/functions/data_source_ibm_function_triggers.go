@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package functions
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMFunctionTriggers() *schema.Resource {
+	return &schema.Resource{
+
+		Read: dataSourceIBMFunctionTriggersRead,
+
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the namespace.",
+			},
+			"triggers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The triggers of the namespace.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of Trigger.",
+						},
+						"publish": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Trigger Visibility.",
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Semantic version of the trigger.",
+						},
+						"annotations": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "All annotations set on trigger by user and those set by the IBM Cloud Function backend/API.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMFunctionTriggersRead(d *schema.ResourceData, meta interface{}) error {
+	functionNamespaceAPI, err := meta.(conns.ClientSession).FunctionIAMNamespaceAPI()
+	if err != nil {
+		return err
+	}
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	namespace := d.Get("namespace").(string)
+	wskClient, err := conns.SetupOpenWhiskClientConfig(namespace, bxSession, functionNamespaceAPI)
+	if err != nil {
+		return err
+
+	}
+
+	triggerService := wskClient.Triggers
+	allTriggers := []whisk.Trigger{}
+	for skip := 0; ; skip += funcListLimit {
+		options := &whisk.TriggerListOptions{
+			Limit: funcListLimit,
+			Skip:  skip,
+		}
+		triggers, _, err := triggerService.List(options)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing IBM Cloud Function Triggers of namespace %s : %s", namespace, err)
+		}
+		allTriggers = append(allTriggers, triggers...)
+		if len(triggers) < funcListLimit {
+			break
+		}
+	}
+
+	triggers := make([]map[string]interface{}, 0, len(allTriggers))
+	for _, trigger := range allTriggers {
+		t := map[string]interface{}{
+			"name":    trigger.Name,
+			"publish": trigger.Publish != nil && *trigger.Publish,
+			"version": trigger.Version,
+		}
+		annotations, err := flex.FlattenAnnotations(trigger.Annotations)
+		if err != nil {
+			log.Printf(
+				"An error occured during reading of trigger (%s) annotations : %s", trigger.Name, err)
+		}
+		t["annotations"] = annotations
+		triggers = append(triggers, t)
+	}
+
+	d.SetId(namespace)
+	d.Set("triggers", triggers)
+
+	return nil
+}
@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package functions_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccFunctionTriggersDataSourceBasic(t *testing.T) {
+	name := fmt.Sprintf("terraform_trigger_%d", acctest.RandIntRange(10, 100))
+	namespace := os.Getenv("IBM_FUNCTION_NAMESPACE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+
+			{
+				Config: testAccCheckFunctionTriggersDataSource(name, namespace),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_function_triggers.triggers", "namespace", namespace),
+					resource.TestCheckResourceAttrSet("data.ibm_function_triggers.triggers", "triggers.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFunctionTriggersDataSource(name, namespace string) string {
+	return fmt.Sprintf(`
+resource "ibm_function_trigger" "trigger" {
+	name      = "%s"
+	namespace = "%s"
+}
+
+data "ibm_function_triggers" "triggers" {
+	namespace = ibm_function_trigger.trigger.namespace
+}
+`, name, namespace)
+
+}
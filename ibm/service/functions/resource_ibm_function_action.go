@@ -26,6 +26,7 @@ const (
 
 func ResourceIBMFunctionAction() *schema.Resource {
 	return &schema.Resource{
+
 		Create:   resourceIBMFunctionActionCreate,
 		Read:     resourceIBMFunctionActionRead,
 		Update:   resourceIBMFunctionActionUpdate,
@@ -146,10 +147,7 @@ func ResourceIBMFunctionAction() *schema.Resource {
 				Description:      "Annotation values in KEY VALUE format.",
 				ValidateFunc:     validate.InvokeValidator("ibm_function_action", funcActionUsrDefAnnots),
 				DiffSuppressFunc: flex.SuppressEquivalentJSON,
-				StateFunc: func(v interface{}) string {
-					json, _ := flex.NormalizeJSONString(v)
-					return json
-				},
+				StateFunc:        flex.NormalizeJSONStateFunc,
 			},
 			funcActionUsrDefParams: {
 				Type:             schema.TypeString,
@@ -158,10 +156,7 @@ func ResourceIBMFunctionAction() *schema.Resource {
 				Description:      "Parameters values in KEY VALUE format. Parameter bindings included in the context passed to the action.",
 				ValidateFunc:     validate.InvokeValidator("ibm_function_action", funcActionUsrDefParams),
 				DiffSuppressFunc: flex.SuppressEquivalentJSON,
-				StateFunc: func(v interface{}) string {
-					json, _ := flex.NormalizeJSONString(v)
-					return json
-				},
+				StateFunc:        flex.NormalizeJSONStateFunc,
 			},
 			"annotations": {
 				Type:        schema.TypeString,
@@ -24,6 +24,7 @@ const (
 
 func ResourceIBMFunctionNamespace() *schema.Resource {
 	return &schema.Resource{
+
 		Create:   resourceIBMFunctionNamespaceCreate,
 		Read:     resourceIBMFunctionNamespaceRead,
 		Update:   resourceIBMFunctionNamespaceUpdate,
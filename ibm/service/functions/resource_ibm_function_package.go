@@ -27,6 +27,7 @@ const (
 
 func ResourceIBMFunctionPackage() *schema.Resource {
 	return &schema.Resource{
+
 		Create:   resourceIBMFunctionPackageCreate,
 		Read:     resourceIBMFunctionPackageRead,
 		Update:   resourceIBMFunctionPackageUpdate,
@@ -67,10 +68,7 @@ func ResourceIBMFunctionPackage() *schema.Resource {
 				Default:          "[]",
 				ValidateFunc:     validate.InvokeValidator("ibm_function_package", funcPkgUsrDefAnnots),
 				DiffSuppressFunc: flex.SuppressEquivalentJSON,
-				StateFunc: func(v interface{}) string {
-					json, _ := flex.NormalizeJSONString(v)
-					return json
-				},
+				StateFunc:        flex.NormalizeJSONStateFunc,
 			},
 			funcPkgUsrDefParams: {
 				Type:             schema.TypeString,
@@ -79,10 +77,7 @@ func ResourceIBMFunctionPackage() *schema.Resource {
 				ValidateFunc:     validate.InvokeValidator("ibm_function_package", funcPkgUsrDefParams),
 				Default:          "[]",
 				DiffSuppressFunc: flex.SuppressEquivalentJSON,
-				StateFunc: func(v interface{}) string {
-					json, _ := flex.NormalizeJSONString(v)
-					return json
-				},
+				StateFunc:        flex.NormalizeJSONStateFunc,
 			},
 			"annotations": {
 				Type:        schema.TypeString,
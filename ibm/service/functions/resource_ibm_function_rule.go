@@ -23,6 +23,7 @@ const (
 
 func ResourceIBMFunctionRule() *schema.Resource {
 	return &schema.Resource{
+
 		Create:   resourceIBMFunctionRuleCreate,
 		Read:     resourceIBMFunctionRuleRead,
 		Update:   resourceIBMFunctionRuleUpdate,
@@ -83,10 +83,7 @@ func ResourceIBMFunctionTrigger() *schema.Resource {
 								}
 								return false
 							},
-							StateFunc: func(v interface{}) string {
-								json, _ := flex.NormalizeJSONString(v)
-								return json
-							},
+							StateFunc: flex.NormalizeJSONStateFunc,
 						},
 					},
 				},
@@ -108,10 +105,7 @@ func ResourceIBMFunctionTrigger() *schema.Resource {
 				Default:          "[]",
 				ValidateFunc:     validate.InvokeValidator("ibm_function_trigger", funcTriggerUsrDefAnnots),
 				DiffSuppressFunc: flex.SuppressEquivalentJSON,
-				StateFunc: func(v interface{}) string {
-					json, _ := flex.NormalizeJSONString(v)
-					return json
-				},
+				StateFunc:        flex.NormalizeJSONStateFunc,
 			},
 			funcTriggerUsrDefParams: {
 				Type:             schema.TypeString,
@@ -120,10 +114,7 @@ func ResourceIBMFunctionTrigger() *schema.Resource {
 				Description:      "Parameters values in KEY VALUE format. Parameter bindings included in the context passed to the trigger.",
 				ValidateFunc:     validate.InvokeValidator("ibm_function_trigger", funcTriggerUsrDefParams),
 				DiffSuppressFunc: flex.SuppressEquivalentJSON,
-				StateFunc: func(v interface{}) string {
-					json, _ := flex.NormalizeJSONString(v)
-					return json
-				},
+				StateFunc:        flex.NormalizeJSONStateFunc,
 			},
 			"annotations": {
 				Type:        schema.TypeString,
@@ -93,6 +93,16 @@ func DataSourceIBMHPCS() *schema.Resource {
 				Computed:    true,
 				Description: "The extended metadata as a map associated with the resource instance.",
 			},
+			"current_signature_threshold": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Signature Threshold Value currently set in the crypto units",
+			},
+			"current_revocation_threshold": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Revocation Threshold Value currently set in the crypto units",
+			},
 			"hsm_info": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -287,6 +297,9 @@ func dataSourceIBMHPCSRead(context context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(fmt.Errorf("[ERROR] Error Quering HSM config %s", err))
 	}
 	d.Set("hsm_info", FlattenHSMInfo(hsmInfo))
+	signatureThreshold, revocationThreshold := hsmCurrentThresholds(hsmInfo)
+	d.Set("current_signature_threshold", signatureThreshold)
+	d.Set("current_revocation_threshold", revocationThreshold)
 
 	return nil
 }
@@ -49,6 +49,7 @@ func ResourceIBMHPCS() *schema.Resource {
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ResourceTagsCustomizeDiff(diff)
 			},
+			resourceIBMHPCSAdminsCustomizeDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -103,6 +104,7 @@ func ResourceIBMHPCS() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_hpcs", "tags")},
 				Set:      flex.ResourceIBMVPCHash,
 			},
+			"deletion_protection": resourcecontroller.ResourceIBMResourceInstanceDeletionProtectionSchema(),
 			"status": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -201,21 +203,34 @@ func ResourceIBMHPCS() *schema.Resource {
 			"signature_server_url": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "URL of signing service",
+				Description: "URL of signing service. When set, the admin keys are the names of the signature keys in the signing service instead of signature key files",
 			},
 			"signature_threshold": {
-				Type:        schema.TypeInt,
-				Required:    true,
-				Description: "Signature Threshold Value",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_hpcs", "signature_threshold"),
+				Description:  "Signature Threshold Value",
 			},
 			"revocation_threshold": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_hpcs", "revocation_threshold"),
+				Description:  "Revocation Threshold Value",
+			},
+			"current_signature_threshold": {
 				Type:        schema.TypeInt,
-				Required:    true,
-				Description: "Revocation Threshold Value",
+				Computed:    true,
+				Description: "Signature Threshold Value currently set in the crypto units",
+			},
+			"current_revocation_threshold": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Revocation Threshold Value currently set in the crypto units",
 			},
 			"admins": {
 				Type:        schema.TypeSet,
 				Required:    true,
+				MaxItems:    hpcsMaxAdmins,
 				Description: "Crypto Unit Administrators",
 				Set:         resourceIBMHPCSAdminHash,
 				Elem: &schema.Resource{
@@ -228,7 +243,7 @@ func ResourceIBMHPCS() *schema.Resource {
 						"key": {
 							Type:        schema.TypeString,
 							Required:    true,
-							Description: "The administrator signature key",
+							Description: "The administrator signature key, the path of the signature key file or the name of the signature key in the signing service",
 						},
 						"token": {
 							Type:        schema.TypeString,
@@ -308,6 +323,9 @@ func ResourceIBMHPCS() *schema.Resource {
 	}
 }
 
+// hpcsMaxAdmins is the number of administrators a crypto unit can hold
+const hpcsMaxAdmins = 8
+
 type HPCSParams struct {
 	Units                 int    `json:"units,omitempty"`
 	FailoverUnits         int    `json:"failover_units,omitempty"`
@@ -325,7 +343,21 @@ func ResourceIBMHPCSValidator() *validate.ResourceValidator {
 			Optional:                   true,
 			Regexp:                     `^[A-Za-z0-9:_ .-]+$`,
 			MinValueLength:             1,
-			MaxValueLength:             128})
+			MaxValueLength:             128},
+		validate.ValidateSchema{
+			Identifier:                 "signature_threshold",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Required:                   true,
+			MinValue:                   "1",
+			MaxValue:                   strconv.Itoa(hpcsMaxAdmins)},
+		validate.ValidateSchema{
+			Identifier:                 "revocation_threshold",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Required:                   true,
+			MinValue:                   "1",
+			MaxValue:                   strconv.Itoa(hpcsMaxAdmins)})
 
 	ibmResourceInstanceResourceValidator := validate.ResourceValidator{ResourceName: "ibm_hpcs", Schema: validateSchema}
 	return &ibmResourceInstanceResourceValidator
@@ -479,6 +511,7 @@ func resourceIBMHPCSRead(context context.Context, d *schema.ResourceData, meta i
 	d.Set("resource_aliases_url", instance.ResourceAliasesURL)
 	d.Set("state", instance.State)
 	d.Set("service", strings.Split(instanceID, ":")[4])
+	d.Set("deletion_protection", instance.Locked != nil && *instance.Locked)
 	//Set tags
 	tags, err := flex.GetTagsUsingCRN(meta, *instance.CRN)
 	if err != nil {
@@ -570,6 +603,9 @@ func resourceIBMHPCSRead(context context.Context, d *schema.ResourceData, meta i
 		return diag.FromErr(fmt.Errorf("[ERROR] Error Quering HSM config: %s", err))
 	}
 	d.Set("hsm_info", FlattenHSMInfo(hsmInfo))
+	signatureThreshold, revocationThreshold := hsmCurrentThresholds(hsmInfo)
+	d.Set("current_signature_threshold", signatureThreshold)
+	d.Set("current_revocation_threshold", revocationThreshold)
 
 	if validateHSM(hsmInfo) && !d.IsNewResource() {
 		d.Set("admins", nil)
@@ -644,6 +680,12 @@ func resourceIBMHPCSUpdate(context context.Context, d *schema.ResourceData, meta
 		}
 	}
 	if update && !d.IsNewResource() { // Update RC API only if its not a new resource
+		// A locked instance can't be updated, it is locked again at the end of the update
+		if oldProtection, _ := d.GetChange("deletion_protection"); oldProtection.(bool) {
+			if err := resourcecontroller.ResourceIBMResourceInstanceSetLock(meta, instanceID, false); err != nil {
+				return diag.FromErr(err)
+			}
+		}
 		_, resp, err = rsConClient.UpdateResourceInstance(&resourceInstanceUpdate)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error updating HPCS instance: %s with resp code: %s", err, resp))
@@ -658,12 +700,12 @@ func resourceIBMHPCSUpdate(context context.Context, d *schema.ResourceData, meta
 	// Initialise HPCS Crypto Units
 
 	if d.HasChange("signature_threshold") || d.HasChange("revocation_threshold") || d.HasChange("admins") || d.HasChange("signature_server_url") {
-		if url, ok := d.GetOk("signature_server_url"); ok {
-			serverURL := url.(string)
-			err := os.Setenv("TKE_SIGNSERV_URL", serverURL)
-			if err != nil {
-				return diag.FromErr(err)
-			}
+		if err := setHPCSSignatureServerURL(d); err != nil {
+			return diag.FromErr(err)
+		}
+		if d.HasChange("admins") && !d.IsNewResource() {
+			added, removed := hpcsAdminChanges(d)
+			log.Printf("[INFO] Updating the administrators of HPCS instance (%s), adding %v and removing %v", d.Id(), added, removed)
 		}
 		hsm_config := expandHSMConfig(d, meta)
 		// Bluemix Session to get Oauth tokens
@@ -690,6 +732,11 @@ func resourceIBMHPCSUpdate(context context.Context, d *schema.ResourceData, meta
 			return diag.FromErr(fmt.Errorf("[ERROR] Error Updating Crypto Units..One or more problems were found during initial checks: %v", hsmDetails))
 		}
 	}
+	if d.HasChange("deletion_protection") || (update && !d.IsNewResource() && d.Get("deletion_protection").(bool)) {
+		if err := resourcecontroller.ResourceIBMResourceInstanceSetLock(meta, instanceID, d.Get("deletion_protection").(bool)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	return resourceIBMHPCSRead(context, d, meta)
 }
 func expandHSMConfig(d *schema.ResourceData, meta interface{}) tkesdk.HsmConfig {
@@ -717,6 +764,10 @@ func expandHSMConfig(d *schema.ResourceData, meta interface{}) tkesdk.HsmConfig
 	return hsmConfig
 }
 func resourceIBMHPCSDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The crypto units are zeroized before the instance is deleted, check the protection first
+	if err := resourcecontroller.ResourceIBMResourceInstanceCheckDeletionProtection(d); err != nil {
+		return diag.FromErr(err)
+	}
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return diag.FromErr(err)
@@ -735,12 +786,8 @@ func resourceIBMHPCSDelete(context context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 	ci.InstanceId = *instance.GUID
-	if url, ok := d.GetOk("signature_server_url"); ok {
-		serverURL := url.(string)
-		err := os.Setenv("TKE_SIGNSERV_URL", serverURL)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	if err := setHPCSSignatureServerURL(d); err != nil {
+		return diag.FromErr(err)
 	}
 	// Zeroize Crypto Units
 	hsm := expandHSMConfig(d, meta)
@@ -915,3 +962,78 @@ func hsmClient(d *schema.ResourceData, meta interface{}) (tkesdk.CommonInputs, e
 
 	return ci, err
 }
+
+// setHPCSSignatureServerURL points the TKE SDK to the signing service of the instance, or back
+// to the signature key files when no signing service is configured
+func setHPCSSignatureServerURL(d *schema.ResourceData) error {
+	if url, ok := d.GetOk("signature_server_url"); ok {
+		return os.Setenv("TKE_SIGNSERV_URL", url.(string))
+	}
+	return os.Unsetenv("TKE_SIGNSERV_URL")
+}
+
+// hsmCurrentThresholds returns the thresholds set in the crypto units, which are the same in every
+// unit once the instance is initialized
+func hsmCurrentThresholds(hsmInfo []tkesdk.HsmInfo) (int, int) {
+	if len(hsmInfo) == 0 {
+		return 0, 0
+	}
+	for _, hsm := range hsmInfo[1:] {
+		if hsm.SignatureThreshold != hsmInfo[0].SignatureThreshold || hsm.RevocationThreshold != hsmInfo[0].RevocationThreshold {
+			log.Printf("[WARN] The thresholds of crypto unit %s differ from the thresholds of crypto unit %s", hsm.HsmId, hsmInfo[0].HsmId)
+		}
+	}
+	return hsmInfo[0].SignatureThreshold, hsmInfo[0].RevocationThreshold
+}
+
+// hpcsAdminChanges returns the names of the administrators added to and removed from the crypto units
+func hpcsAdminChanges(d *schema.ResourceData) ([]string, []string) {
+	o, n := d.GetChange("admins")
+	names := func(admins *schema.Set) map[string]bool {
+		m := make(map[string]bool)
+		for _, a := range admins.List() {
+			m[a.(map[string]interface{})["name"].(string)] = true
+		}
+		return m
+	}
+	oldNames, newNames := names(o.(*schema.Set)), names(n.(*schema.Set))
+	added, removed := []string{}, []string{}
+	for name := range newNames {
+		if !oldNames[name] {
+			added = append(added, name)
+		}
+	}
+	for name := range oldNames {
+		if !newNames[name] {
+			removed = append(removed, name)
+		}
+	}
+	return added, removed
+}
+
+func resourceIBMHPCSAdminsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	admins := diff.Get("admins").(*schema.Set).List()
+	if len(admins) == 0 {
+		return nil
+	}
+	for _, threshold := range []string{"signature_threshold", "revocation_threshold"} {
+		if t := diff.Get(threshold).(int); t > len(admins) {
+			return fmt.Errorf("[ERROR] %s %d is greater than the number of admins %d", threshold, t, len(admins))
+		}
+	}
+	// Without a signing service the admin keys are signature key files on the local workstation
+	if _, ok := diff.GetOk("signature_server_url"); ok || !diff.NewValueKnown("signature_server_url") {
+		return nil
+	}
+	for _, a := range admins {
+		admin := a.(map[string]interface{})
+		key := admin["key"].(string)
+		if key == "" {
+			continue
+		}
+		if _, err := os.Stat(key); err != nil {
+			return fmt.Errorf("[ERROR] Error reading the signature key file %s of admin %s: %s", key, admin["name"].(string), err)
+		}
+	}
+	return nil
+}
@@ -37,6 +37,8 @@ func TestAccIBMHPCSInstanceBasic(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "plan", "standard"),
 					resource.TestCheckResourceAttr(name, "location", "us-south"),
 					resource.TestCheckResourceAttr(name, "admins.#", "1"),
+					resource.TestCheckResourceAttr(name, "current_signature_threshold", "1"),
+					resource.TestCheckResourceAttr(name, "current_revocation_threshold", "1"),
 				),
 			},
 			{
@@ -59,6 +61,10 @@ func TestAccIBMHPCSInstanceBasic(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "admins.#", "1"),
 				),
 			},
+			{
+				Config:      testAccCheckIBMHPCSInstanceThresholdExceedsAdmins(testName),
+				ExpectError: regexp.MustCompile(`signature_threshold 2 is greater than the number of admins 1`),
+			},
 			{
 				Config:      testAccCheckIBMHPCSInstanceUnitsUpdate(testName),
 				ExpectError: regexp.MustCompile(`'units' attribute is immutable and can't be changed`),
@@ -186,6 +192,23 @@ func testAccCheckIBMHPCSInstanceAdminDelete(name string) string {
 	}
 	`, name, acc.HpcsAdmin1, acc.HpcsToken1)
 }
+func testAccCheckIBMHPCSInstanceThresholdExceedsAdmins(name string) string {
+	return fmt.Sprintf(`
+	resource ibm_hpcs hpcs {
+		location             = "us-south"
+		name                 = "%s"
+		plan                 = "standard"
+		units                = 2
+		signature_threshold  = 2
+		revocation_threshold = 1
+		admins {
+			name  = "ad1"
+			key   = "%s"
+			token = "%s"
+		}
+	}
+	`, name, acc.HpcsAdmin1, acc.HpcsToken1)
+}
 func testAccCheckIBMHPCSInstanceUnitsUpdate(name string) string {
 	return fmt.Sprintf(`
 	resource ibm_hpcs hpcs {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -16,6 +17,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
 )
 
@@ -86,6 +88,44 @@ func ResourceIBMIAMAccessGroupTemplateAssignment() *schema.Resource {
 				Computed:    true,
 				Description: "The status of the assignment (e.g. 'accepted', 'in_progress', 'succeeded', 'failed', 'superseded').",
 			},
+			"resources": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The status of the resources created in each target account of the assignment.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the account that the resources are created in.",
+						},
+						"group": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The access group created in the target account.",
+							Elem:        &schema.Resource{Schema: assignmentResourceEntrySchema()},
+						},
+						"members": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The members added to the access group.",
+							Elem:        &schema.Resource{Schema: assignmentResourceEntrySchema()},
+						},
+						"rules": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The dynamic rules added to the access group.",
+							Elem:        &schema.Resource{Schema: assignmentResourceEntrySchema()},
+						},
+						"policy_template_references": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The policies assigned to the access group from policy templates.",
+							Elem:        &schema.Resource{Schema: assignmentResourceEntrySchema()},
+						},
+					},
+				},
+			},
 			"href": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -119,6 +159,46 @@ func ResourceIBMIAMAccessGroupTemplateAssignment() *schema.Resource {
 	}
 }
 
+func assignmentResourceEntrySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the resource in the template.",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The name of the resource.",
+		},
+		"version": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The version of the resource.",
+		},
+		"resource": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the resource created in the target account.",
+		},
+		"error": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The error that occurred while creating the resource, if any.",
+		},
+		"operation": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The operation applied to the resource.",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The status of the resource.",
+		},
+	}
+}
+
 func ResourceIBMIAMAccessGroupTemplateAssignmentValidator() *validate.ResourceValidator {
 	validateSchema := make([]validate.ValidateSchema, 0)
 	validateSchema = append(validateSchema,
@@ -247,6 +327,9 @@ func resourceIBMIAMAccessGroupTemplateAssignmentRead(context context.Context, d
 	if err = d.Set("status", templateAssignmentVerboseResponse.Status); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting status: %s", err))
 	}
+	if err = d.Set("resources", flattenAssignmentResources(templateAssignmentVerboseResponse.Resources)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting resources: %s", err))
+	}
 	if err = d.Set("href", templateAssignmentVerboseResponse.Href); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting href: %s", err))
 	}
@@ -309,7 +392,10 @@ func resourceIBMIAMAccessGroupTemplateAssignmentUpdate(context context.Context,
 			log.Printf("[DEBUG] UpdateAssignmentWithContext failed %s\n%s", err, response)
 			return diag.FromErr(fmt.Errorf("UpdateAssignmentWithContext failed %s\n%s", err, response))
 		}
-		waitForAssignment(d.Timeout(schema.TimeoutUpdate), meta, d, isAccessGroupTemplateAssigned)
+		_, err = waitForAssignment(d.Timeout(schema.TimeoutUpdate), meta, d, isAccessGroupTemplateAssigned)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating assignment %s", err))
+		}
 	}
 
 	return resourceIBMIAMAccessGroupTemplateAssignmentRead(context, d, meta)
@@ -331,7 +417,10 @@ func resourceIBMIAMAccessGroupTemplateAssignmentDelete(context context.Context,
 		return diag.FromErr(fmt.Errorf("DeleteAssignmentWithContext failed %s\n%s", err, response))
 	}
 
-	waitForAssignment(d.Timeout(schema.TimeoutDelete), meta, d, isAccessGroupTemplateAssignmentDeleted)
+	_, err = waitForAssignment(d.Timeout(schema.TimeoutDelete), meta, d, isAccessGroupTemplateAssignmentDeleted)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error removing assignment %s", err))
+	}
 
 	d.SetId("")
 
@@ -381,6 +470,9 @@ func isAccessGroupTemplateAssigned(id string, meta interface{}) resource.StateRe
 			}
 
 			if *assignment.Status == "failed" {
+				if errs := assignmentResourceErrors(assignment.Resources); len(errs) > 0 {
+					return assignment, failed, fmt.Errorf("[ERROR] The assignment %s did complete but with a 'failed' status: %s\n", id, strings.Join(errs, "; "))
+				}
 				return assignment, failed, fmt.Errorf("[ERROR] The assignment %s did complete but with a 'failed' status. Please check assignment resource for detailed errors: %s\n", id, response)
 			}
 		}
@@ -414,3 +506,62 @@ func isAccessGroupTemplateAssignmentDeleted(id string, meta interface{}) resourc
 		return assignment, InProgress, nil
 	}
 }
+
+func flattenAssignmentResources(resources []iamaccessgroupsv2.ResourceListWithTargetAccountID) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(resources))
+	for _, r := range resources {
+		resourceMap := map[string]interface{}{
+			"target":                     core.StringNilMapper(r.Target),
+			"policy_template_references": flattenAssignmentResourceEntries(r.PolicyTemplateReferences),
+		}
+		if r.Group != nil {
+			if r.Group.Group != nil {
+				resourceMap["group"] = flattenAssignmentResourceEntries([]iamaccessgroupsv2.AssignmentResourceEntry{*r.Group.Group})
+			}
+			resourceMap["members"] = flattenAssignmentResourceEntries(r.Group.Members)
+			resourceMap["rules"] = flattenAssignmentResourceEntries(r.Group.Rules)
+		}
+		result = append(result, resourceMap)
+	}
+	return result
+}
+
+func flattenAssignmentResourceEntries(entries []iamaccessgroupsv2.AssignmentResourceEntry) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, map[string]interface{}{
+			"id":        core.StringNilMapper(entry.ID),
+			"name":      core.StringNilMapper(entry.Name),
+			"version":   core.StringNilMapper(entry.Version),
+			"resource":  core.StringNilMapper(entry.Resource),
+			"error":     core.StringNilMapper(entry.Error),
+			"operation": core.StringNilMapper(entry.Operation),
+			"status":    core.StringNilMapper(entry.Status),
+		})
+	}
+	return result
+}
+
+// assignmentResourceErrors collects the errors of the resources that could not be created in the target accounts
+func assignmentResourceErrors(resources []iamaccessgroupsv2.ResourceListWithTargetAccountID) []string {
+	errs := []string{}
+	collect := func(target string, entries []iamaccessgroupsv2.AssignmentResourceEntry) {
+		for _, entry := range entries {
+			if entry.Error != nil && *entry.Error != "" {
+				errs = append(errs, fmt.Sprintf("%s %s: %s", target, core.StringNilMapper(entry.ID), *entry.Error))
+			}
+		}
+	}
+	for _, r := range resources {
+		target := core.StringNilMapper(r.Target)
+		if r.Group != nil {
+			if r.Group.Group != nil {
+				collect(target, []iamaccessgroupsv2.AssignmentResourceEntry{*r.Group.Group})
+			}
+			collect(target, r.Group.Members)
+			collect(target, r.Group.Rules)
+		}
+		collect(target, r.PolicyTemplateReferences)
+	}
+	return errs
+}
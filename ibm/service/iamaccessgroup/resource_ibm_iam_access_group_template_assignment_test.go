@@ -40,6 +40,9 @@ func TestAccIBMIAMAccessGroupTemplateAssignmentBasic(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckIBMIAMAccessGroupTemplateAssignmentExists("ibm_iam_access_group_template_assignment.assignment", conf),
 					resource.TestCheckResourceAttr("ibm_iam_access_group_template_assignment.assignment", "target", target),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_template_assignment.assignment", "status", "succeeded"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_template_assignment.assignment", "resources.0.target", target),
+					resource.TestCheckResourceAttrSet("ibm_iam_access_group_template_assignment.assignment", "resources.0.group.0.resource"),
 				),
 			},
 		},
@@ -0,0 +1,409 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+)
+
+const (
+	iamIdentityReportPending  = "pending"
+	iamIdentityReportComplete = "complete"
+)
+
+func DataSourceIBMIamAccountIdentityReport() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIamAccountIdentityReportRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the account.",
+			},
+			"reference": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Reference of an existing report to read, or latest for the latest report of the account. When not set, a new report is generated.",
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "inactive",
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"inactive"}),
+				Description:  "Type of the report to generate. inactive lists the identities that have not authenticated within the duration.",
+			},
+			"duration": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "720",
+				Description: "Duration of the report to generate, in hours.",
+			},
+			"created_by": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IAMid of the user who triggered the report.",
+			},
+			"report_duration": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Duration in hours for which the report is generated.",
+			},
+			"report_start_time": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Start time of the report.",
+			},
+			"report_end_time": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "End time of the report.",
+			},
+			"users": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of users.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"iam_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IAMid of the user.",
+						},
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the user.",
+						},
+						"username": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Username of the user.",
+						},
+						"email": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Email of the user.",
+						},
+						"last_authn": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time when the user was last authenticated.",
+						},
+					},
+				},
+			},
+			"apikeys": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of apikeys.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Unique id of the apikey.",
+						},
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name provided during creation of the apikey.",
+						},
+						"type": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the apikey. Supported values are `serviceid` and `user`.",
+						},
+						"serviceid": &schema.Schema{
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "serviceid details will be present if type is `serviceid`.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Unique identifier of this Service Id.",
+									},
+									"name": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Name provided during creation of the serviceid.",
+									},
+								},
+							},
+						},
+						"user": &schema.Schema{
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "user details will be present if type is `user`.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"iam_id": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "IAMid of the user.",
+									},
+									"name": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Name of the user.",
+									},
+									"username": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Username of the user.",
+									},
+									"email": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Email of the user.",
+									},
+								},
+							},
+						},
+						"last_authn": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time when the apikey was last authenticated.",
+						},
+					},
+				},
+			},
+			"serviceids": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of serviceids.",
+				Elem:        dataSourceIBMIamAccountIdentityReportEntityActivitySchema("serviceid"),
+			},
+			"profiles": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of profiles.",
+				Elem:        dataSourceIBMIamAccountIdentityReportEntityActivitySchema("profile"),
+			},
+		},
+	}
+}
+
+func dataSourceIBMIamAccountIdentityReportEntityActivitySchema(entity string) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Unique id of the %s.", entity),
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Name provided during creation of the %s.", entity),
+			},
+			"last_authn": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Time when the %s was last authenticated.", entity),
+			},
+		},
+	}
+}
+
+func dataSourceIBMIamAccountIdentityReportRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountID := d.Get("account_id").(string)
+	reference := d.Get("reference").(string)
+	if reference == "" {
+		createReportOptions := &iamidentityv1.CreateReportOptions{}
+		createReportOptions.SetAccountID(accountID)
+		createReportOptions.SetType(d.Get("type").(string))
+		createReportOptions.SetDuration(d.Get("duration").(string))
+
+		reportReference, response, err := iamIdentityClient.CreateReportWithContext(context, createReportOptions)
+		if err != nil {
+			log.Printf("[DEBUG] CreateReportWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("CreateReportWithContext failed %s\n%s", err, response))
+		}
+		reference = *reportReference.Reference
+	}
+
+	report, err := waitForIamAccountIdentityReport(context, iamIdentityClient, accountID, reference, d.Timeout(schema.TimeoutRead))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error waiting for report %s of account %s: %s", reference, accountID, err))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", accountID, *report.Reference))
+
+	if err = d.Set("reference", report.Reference); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting reference: %s", err))
+	}
+	if err = d.Set("created_by", report.CreatedBy); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_by: %s", err))
+	}
+	if err = d.Set("report_duration", report.ReportDuration); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting report_duration: %s", err))
+	}
+	if err = d.Set("report_start_time", report.ReportStartTime); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting report_start_time: %s", err))
+	}
+	if err = d.Set("report_end_time", report.ReportEndTime); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting report_end_time: %s", err))
+	}
+
+	users := []map[string]interface{}{}
+	for _, user := range report.Users {
+		users = append(users, dataSourceIBMIamAccountIdentityReportUserActivityToMap(&user))
+	}
+	if err = d.Set("users", users); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting users %s", err))
+	}
+
+	apikeys := []map[string]interface{}{}
+	for _, apikey := range report.Apikeys {
+		apikeys = append(apikeys, dataSourceIBMIamAccountIdentityReportApikeyActivityToMap(&apikey))
+	}
+	if err = d.Set("apikeys", apikeys); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting apikeys %s", err))
+	}
+
+	serviceids := []map[string]interface{}{}
+	for _, serviceid := range report.Serviceids {
+		serviceids = append(serviceids, dataSourceIBMIamAccountIdentityReportEntityActivityToMap(&serviceid))
+	}
+	if err = d.Set("serviceids", serviceids); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting serviceids %s", err))
+	}
+
+	profiles := []map[string]interface{}{}
+	for _, profile := range report.Profiles {
+		profiles = append(profiles, dataSourceIBMIamAccountIdentityReportEntityActivityToMap(&profile))
+	}
+	if err = d.Set("profiles", profiles); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting profiles %s", err))
+	}
+
+	return nil
+}
+
+// waitForIamAccountIdentityReport polls the report until it is generated. The report is not found, or found without
+// content, while it is generated.
+func waitForIamAccountIdentityReport(context context.Context, iamIdentityClient *iamidentityv1.IamIdentityV1, accountID, reference string, timeout time.Duration) (*iamidentityv1.Report, error) {
+	getReportOptions := &iamidentityv1.GetReportOptions{}
+	getReportOptions.SetAccountID(accountID)
+	getReportOptions.SetReference(reference)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iamIdentityReportPending},
+		Target:  []string{iamIdentityReportComplete},
+		Refresh: func() (interface{}, string, error) {
+			report, response, err := iamIdentityClient.GetReportWithContext(context, getReportOptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return response, iamIdentityReportPending, nil
+				}
+				log.Printf("[DEBUG] GetReportWithContext failed %s\n%s", err, response)
+				return nil, "", fmt.Errorf("GetReportWithContext failed %s\n%s", err, response)
+			}
+			if report == nil || response.StatusCode == 204 {
+				return response, iamIdentityReportPending, nil
+			}
+			return report, iamIdentityReportComplete, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	report, err := stateConf.WaitForStateContext(context)
+	if err != nil {
+		return nil, err
+	}
+	return report.(*iamidentityv1.Report), nil
+}
+
+func dataSourceIBMIamAccountIdentityReportUserActivityToMap(model *iamidentityv1.UserActivity) map[string]interface{} {
+	modelMap := make(map[string]interface{})
+	modelMap["iam_id"] = model.IamID
+	if model.Name != nil {
+		modelMap["name"] = model.Name
+	}
+	modelMap["username"] = model.Username
+	if model.Email != nil {
+		modelMap["email"] = model.Email
+	}
+	if model.LastAuthn != nil {
+		modelMap["last_authn"] = model.LastAuthn
+	}
+	return modelMap
+}
+
+func dataSourceIBMIamAccountIdentityReportApikeyActivityToMap(model *iamidentityv1.ApikeyActivity) map[string]interface{} {
+	modelMap := make(map[string]interface{})
+	modelMap["id"] = model.ID
+	if model.Name != nil {
+		modelMap["name"] = model.Name
+	}
+	modelMap["type"] = model.Type
+	if model.Serviceid != nil {
+		serviceidMap := make(map[string]interface{})
+		if model.Serviceid.ID != nil {
+			serviceidMap["id"] = model.Serviceid.ID
+		}
+		if model.Serviceid.Name != nil {
+			serviceidMap["name"] = model.Serviceid.Name
+		}
+		modelMap["serviceid"] = []map[string]interface{}{serviceidMap}
+	}
+	if model.User != nil {
+		userMap := make(map[string]interface{})
+		if model.User.IamID != nil {
+			userMap["iam_id"] = model.User.IamID
+		}
+		if model.User.Name != nil {
+			userMap["name"] = model.User.Name
+		}
+		if model.User.Username != nil {
+			userMap["username"] = model.User.Username
+		}
+		if model.User.Email != nil {
+			userMap["email"] = model.User.Email
+		}
+		modelMap["user"] = []map[string]interface{}{userMap}
+	}
+	if model.LastAuthn != nil {
+		modelMap["last_authn"] = model.LastAuthn
+	}
+	return modelMap
+}
+
+func dataSourceIBMIamAccountIdentityReportEntityActivityToMap(model *iamidentityv1.EntityActivity) map[string]interface{} {
+	modelMap := make(map[string]interface{})
+	modelMap["id"] = model.ID
+	if model.Name != nil {
+		modelMap["name"] = model.Name
+	}
+	if model.LastAuthn != nil {
+		modelMap["last_authn"] = model.LastAuthn
+	}
+	return modelMap
+}
@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMIamAccountIdentityReportDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIamAccountIdentityReportDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_iam_account_identity_report.report", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_account_identity_report.report", "reference"),
+					resource.TestCheckResourceAttr("data.ibm_iam_account_identity_report.report", "report_duration", "720"),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_account_identity_report.report", "report_start_time"),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_account_identity_report.report", "report_end_time"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIamAccountIdentityReportDataSourceConfigBasic() string {
+	return fmt.Sprintf(`
+		data "ibm_iam_account_identity_report" "report" {
+			account_id = "%s"
+			duration = "720"
+		}
+	`, acc.IAMAccountId)
+}
@@ -0,0 +1,266 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+)
+
+func DataSourceIBMIamAPIKeys() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIamAPIKeysRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Account ID to query for API keys. Defaults to the account of the provider.",
+			},
+			"iam_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "IAM ID of the user or service ID whose API keys are listed.",
+			},
+			"scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_iam_api_keys", "scope"),
+				Description:  "Scope of the query, entity for the API keys of the iam_id or the caller, account for all the API keys of the account.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_iam_api_keys", "type"),
+				Description:  "Type of the API keys to list, user or serviceid.",
+			},
+			"locked": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only list the API keys with this lock state.",
+			},
+			"sort": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_iam_api_keys", "sort"),
+				Description:  "Field to sort the API keys by, one of name, description, created_at or created_by.",
+			},
+			"order": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_iam_api_keys", "order"),
+				Description:  "Sort order, asc or desc.",
+			},
+			"api_keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of API keys. The values of the API keys are not returned.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the API key.",
+						},
+						"iam_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IAM ID of the user or service ID the API key belongs to.",
+						},
+						"account_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the account the API key belongs to.",
+						},
+						"crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the API key.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the API key.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the API key.",
+						},
+						"entity_tag": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Version of the API key details object.",
+						},
+						"locked": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the API key is locked for further write access.",
+						},
+						"created_by": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IAM ID of the user or service that created the API key.",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time the API key was created.",
+						},
+						"modified_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time the API key was last modified.",
+						},
+						"last_authn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time the API key was last used to authenticate, when activity tracking is enabled for the account.",
+						},
+						"authn_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of authentications with the API key, when activity tracking is enabled for the account.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DataSourceIBMIamAPIKeysValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "scope",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "account, entity",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "serviceid, user",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "sort",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "created_at, created_by, description, name",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "order",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "asc, desc",
+		})
+
+	iBMIamAPIKeysValidator := validate.ResourceValidator{ResourceName: "ibm_iam_api_keys", Schema: validateSchema}
+	return &iBMIamAPIKeysValidator
+}
+
+func dataSourceIBMIamAPIKeysRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	start := ""
+	allrecs := []iamidentityv1.APIKey{}
+	for {
+		listAPIKeysOptions := &iamidentityv1.ListAPIKeysOptions{}
+
+		if v, ok := d.GetOk("account_id"); ok {
+			listAPIKeysOptions.SetAccountID(v.(string))
+		} else {
+			listAPIKeysOptions.SetAccountID(userDetails.UserAccount)
+		}
+		if v, ok := d.GetOk("iam_id"); ok {
+			listAPIKeysOptions.SetIamID(v.(string))
+		}
+		if v, ok := d.GetOk("scope"); ok {
+			listAPIKeysOptions.SetScope(v.(string))
+		}
+		if v, ok := d.GetOk("type"); ok {
+			listAPIKeysOptions.SetType(v.(string))
+		}
+		if v, ok := d.GetOk("sort"); ok {
+			listAPIKeysOptions.SetSort(v.(string))
+		}
+		if v, ok := d.GetOk("order"); ok {
+			listAPIKeysOptions.SetOrder(v.(string))
+		}
+
+		listAPIKeysOptions.SetPagesize(int64(100))
+
+		if start != "" {
+			listAPIKeysOptions.Pagetoken = &start
+		}
+
+		apiKeys, response, err := iamIdentityClient.ListAPIKeysWithContext(context, listAPIKeysOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListAPIKeysWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("ListAPIKeysWithContext failed %s\n%s", err, response))
+		}
+		start = flex.GetNextIAM(apiKeys.Next)
+		allrecs = append(allrecs, apiKeys.Apikeys...)
+		if start == "" {
+			break
+		}
+	}
+
+	locked, filterLocked := d.GetOkExists("locked")
+	apiKeys := make([]map[string]interface{}, 0, len(allrecs))
+	for _, apiKey := range allrecs {
+		if filterLocked && apiKey.Locked != nil && *apiKey.Locked != locked.(bool) {
+			continue
+		}
+		apiKeyMap := map[string]interface{}{
+			"id":          apiKey.ID,
+			"iam_id":      apiKey.IamID,
+			"account_id":  apiKey.AccountID,
+			"crn":         apiKey.CRN,
+			"name":        apiKey.Name,
+			"description": apiKey.Description,
+			"entity_tag":  apiKey.EntityTag,
+			"locked":      apiKey.Locked,
+			"created_by":  apiKey.CreatedBy,
+			"created_at":  flex.DateTimeToString(apiKey.CreatedAt),
+			"modified_at": flex.DateTimeToString(apiKey.ModifiedAt),
+		}
+		if apiKey.Activity != nil {
+			apiKeyMap["last_authn"] = apiKey.Activity.LastAuthn
+			apiKeyMap["authn_count"] = apiKey.Activity.AuthnCount
+		}
+		apiKeys = append(apiKeys, apiKeyMap)
+	}
+
+	d.SetId(time.Now().UTC().String())
+	if err = d.Set("api_keys", apiKeys); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting api_keys: %s", err))
+	}
+
+	return nil
+}
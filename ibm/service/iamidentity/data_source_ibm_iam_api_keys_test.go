@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIamAPIKeysDataSourceBasic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIamAPIKeysDataSourceConfigBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_iam_api_keys.api_keys", "id"),
+					resource.TestCheckResourceAttr("data.ibm_iam_api_keys.api_keys", "api_keys.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_iam_api_keys.api_keys", "api_keys.0.name", name),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_api_keys.api_keys", "api_keys.0.created_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIamAPIKeysDataSourceConfigBasic(name string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_iam_service_id" "service_id" {
+			name = "%[1]s"
+		}
+
+		resource "ibm_iam_service_api_key" "api_key" {
+			name           = "%[1]s"
+			iam_service_id = ibm_iam_service_id.service_id.iam_id
+		}
+
+		data "ibm_iam_api_keys" "api_keys" {
+			iam_id = ibm_iam_service_api_key.api_key.iam_service_id
+			scope  = "entity"
+			type   = "serviceid"
+		}
+	`, name)
+}
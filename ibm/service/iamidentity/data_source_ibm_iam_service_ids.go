@@ -0,0 +1,222 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+)
+
+func DataSourceIBMIamServiceIDs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIamServiceIDsRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Account ID to query for service IDs. Defaults to the account of the provider.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the service IDs to list.",
+			},
+			"locked": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only list the service IDs with this lock state.",
+			},
+			"sort": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_iam_service_ids", "sort"),
+				Description:  "Field to sort the service IDs by, one of name, description, created_at or modified_at.",
+			},
+			"order": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_iam_service_ids", "order"),
+				Description:  "Sort order, asc or desc.",
+			},
+			"service_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of service IDs.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the service ID.",
+						},
+						"iam_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IAM ID of the service ID.",
+						},
+						"crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the service ID.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the service ID.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the service ID.",
+						},
+						"entity_tag": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Version of the service ID details object.",
+						},
+						"locked": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the service ID is locked for further write access.",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time the service ID was created.",
+						},
+						"modified_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time the service ID was last modified.",
+						},
+						"last_authn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time the service ID was last used to authenticate, when activity tracking is enabled for the account.",
+						},
+						"authn_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of authentications of the service ID, when activity tracking is enabled for the account.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DataSourceIBMIamServiceIDsValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "sort",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "created_at, description, modified_at, name",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "order",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "asc, desc",
+		})
+
+	iBMIamServiceIDsValidator := validate.ResourceValidator{ResourceName: "ibm_iam_service_ids", Schema: validateSchema}
+	return &iBMIamServiceIDsValidator
+}
+
+func dataSourceIBMIamServiceIDsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	start := ""
+	allrecs := []iamidentityv1.ServiceID{}
+	for {
+		listServiceIdsOptions := &iamidentityv1.ListServiceIdsOptions{}
+
+		if v, ok := d.GetOk("account_id"); ok {
+			listServiceIdsOptions.SetAccountID(v.(string))
+		} else {
+			listServiceIdsOptions.SetAccountID(userDetails.UserAccount)
+		}
+		if v, ok := d.GetOk("name"); ok {
+			listServiceIdsOptions.SetName(v.(string))
+		}
+		if v, ok := d.GetOk("sort"); ok {
+			listServiceIdsOptions.SetSort(v.(string))
+		}
+		if v, ok := d.GetOk("order"); ok {
+			listServiceIdsOptions.SetOrder(v.(string))
+		}
+
+		listServiceIdsOptions.SetPagesize(int64(100))
+
+		if start != "" {
+			listServiceIdsOptions.Pagetoken = &start
+		}
+
+		serviceIDs, response, err := iamIdentityClient.ListServiceIdsWithContext(context, listServiceIdsOptions)
+		if err != nil {
+			log.Printf("[DEBUG] ListServiceIdsWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("ListServiceIdsWithContext failed %s\n%s", err, response))
+		}
+		start = flex.GetNextIAM(serviceIDs.Next)
+		allrecs = append(allrecs, serviceIDs.Serviceids...)
+		if start == "" {
+			break
+		}
+	}
+
+	locked, filterLocked := d.GetOkExists("locked")
+	serviceIDs := make([]map[string]interface{}, 0, len(allrecs))
+	for _, serviceID := range allrecs {
+		if filterLocked && serviceID.Locked != nil && *serviceID.Locked != locked.(bool) {
+			continue
+		}
+		serviceIDMap := map[string]interface{}{
+			"id":          serviceID.ID,
+			"iam_id":      serviceID.IamID,
+			"crn":         serviceID.CRN,
+			"name":        serviceID.Name,
+			"description": serviceID.Description,
+			"entity_tag":  serviceID.EntityTag,
+			"locked":      serviceID.Locked,
+			"created_at":  flex.DateTimeToString(serviceID.CreatedAt),
+			"modified_at": flex.DateTimeToString(serviceID.ModifiedAt),
+		}
+		if serviceID.Activity != nil {
+			serviceIDMap["last_authn"] = serviceID.Activity.LastAuthn
+			serviceIDMap["authn_count"] = serviceID.Activity.AuthnCount
+		}
+		serviceIDs = append(serviceIDs, serviceIDMap)
+	}
+
+	d.SetId(time.Now().UTC().String())
+	if err = d.Set("service_ids", serviceIDs); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting service_ids: %s", err))
+	}
+
+	return nil
+}
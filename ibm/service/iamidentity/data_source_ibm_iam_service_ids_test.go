@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIamServiceIDsDataSourceBasic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIamServiceIDsDataSourceConfigBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_iam_service_ids.service_ids", "id"),
+					resource.TestCheckResourceAttr("data.ibm_iam_service_ids.service_ids", "service_ids.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_iam_service_ids.service_ids", "service_ids.0.name", name),
+					resource.TestCheckResourceAttr("data.ibm_iam_service_ids.service_ids", "service_ids.0.locked", "false"),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_service_ids.service_ids", "service_ids.0.created_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIamServiceIDsDataSourceConfigBasic(name string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_iam_service_id" "service_id" {
+			name = "%s"
+		}
+
+		data "ibm_iam_service_ids" "service_ids" {
+			name   = ibm_iam_service_id.service_id.name
+			locked = false
+			sort   = "created_at"
+		}
+	`, name)
+}
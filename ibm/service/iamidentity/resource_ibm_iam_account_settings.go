@@ -9,6 +9,7 @@ import (
 	"log"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -25,7 +26,7 @@ const (
 )
 
 func ResourceIBMIAMAccountSettings() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithImportDefaults(&schema.Resource{
 		CreateContext: resourceIbmIamAccountSettingsCreate,
 		ReadContext:   resourceIbmIamAccountSettingsRead,
 		UpdateContext: resourceIbmIamAccountSettingsUpdate,
@@ -167,7 +168,7 @@ func ResourceIBMIAMAccountSettings() *schema.Resource {
 				Description: "Defines the refresh token expiration in seconds. Valid values:  * Any whole number between '900' and '2592000'  * NOT_SET - To unset account setting and use service default.",
 			},
 		},
-	}
+	})
 }
 
 func ResourceIBMIAMAccountSettingsValidator() *validate.ResourceValidator {
@@ -17,7 +17,7 @@ import (
 )
 
 func ResourceIBMIAMApiKey() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithImportDefaults(&schema.Resource{
 		CreateContext: resourceIbmIamApiKeyCreate,
 		ReadContext:   resourceIbmIamApiKeyRead,
 		UpdateContext: resourceIbmIamApiKeyUpdate,
@@ -105,7 +105,7 @@ func ResourceIBMIAMApiKey() *schema.Resource {
 				Description: "If set contains a date time string of the last modification date in ISO format.",
 			},
 		},
-	}
+	})
 }
 
 func resourceIbmIamApiKeyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
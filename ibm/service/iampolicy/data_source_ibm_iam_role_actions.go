@@ -52,6 +52,41 @@ func DataSourceIBMIAMRoleAction() *schema.Resource {
 				Computed:    true,
 				Description: "List of actions for different services roles",
 			},
+			"roles": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The platform, service and custom roles of the service with their actions",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The display name of the role",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the role: platform, service or custom",
+						},
+						"role_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the role",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the role",
+						},
+						"actions": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The actions of the role",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 		},
 	}
 
@@ -82,6 +117,18 @@ func datasourceIBMIAMRoleActionRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("writer", flex.FlattenActionbyDisplayName("Writer", serviceRoles))
 	d.Set("actions", flattenRoleActions(serviceRoles))
 
+	roles := make([]map[string]interface{}, 0, len(roleList.SystemRoles)+len(serviceRoles)+len(roleList.CustomRoles))
+	for _, role := range roleList.SystemRoles {
+		roles = append(roles, flattenRoleWithActions("platform", role.DisplayName, role.CRN, role.Description, role.Actions))
+	}
+	for _, role := range serviceRoles {
+		roles = append(roles, flattenRoleWithActions("service", role.DisplayName, role.CRN, role.Description, role.Actions))
+	}
+	for _, role := range roleList.CustomRoles {
+		roles = append(roles, flattenRoleWithActions("custom", role.DisplayName, role.CRN, role.Description, role.Actions))
+	}
+	d.Set("roles", roles)
+
 	return nil
 }
 
@@ -92,3 +139,20 @@ func flattenRoleActions(object []iampolicymanagementv1.Role) map[string]string {
 	}
 	return actions
 }
+
+func flattenRoleWithActions(roleType string, name, crn, description *string, actions []string) map[string]interface{} {
+	role := map[string]interface{}{
+		"type":    roleType,
+		"actions": actions,
+	}
+	if name != nil {
+		role["name"] = *name
+	}
+	if crn != nil {
+		role["role_id"] = *crn
+	}
+	if description != nil {
+		role["description"] = *description
+	}
+	return role
+}
@@ -61,6 +61,32 @@ func TestAccIBMIAMRoleDataSourceAction_withServiceSpecificRoleActions(t *testing
 	})
 }
 
+func TestAccIBMIAMRoleDataSourceAction_roles(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMRoleActionRolesConfig("cloud-object-storage"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_iam_role_actions.test", "roles.#"),
+					resource.TestCheckResourceAttr("data.ibm_iam_role_actions.test", "roles.0.type", "platform"),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_role_actions.test", "roles.0.actions.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMRoleActionRolesConfig(serviceName string) string {
+	return fmt.Sprintf(`
+
+data "ibm_iam_role_actions" "test" {
+  service = "%s"
+}
+`, serviceName)
+}
+
 func testAccCheckIBMIAMRoleActionConfig(name, displayName, serviceName string) string {
 	return fmt.Sprintf(`
 
@@ -33,6 +33,8 @@ const (
 	AUDITOR         = "auditor"
 	BILLINGMANANGER = "billingmanager"
 	DEVELOPER       = "developer"
+
+	userStatePending = "PENDING"
 )
 
 var viewOnly = []string{
@@ -228,6 +230,23 @@ func ResourceIBMIAMUserInvite() *schema.Resource {
 					},
 				},
 			},
+			"resend_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Arbitrary value that sends the invitation again to the users that didn't accept it when it changes",
+			},
+			"invitation_states": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "State of the invitation of each user: PENDING until the user accepts it, then ACTIVE",
+			},
+			"pending_users": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Users that didn't accept the invitation yet",
+			},
 			"number_of_invited_users": {
 				Type:        schema.TypeInt,
 				Computed:    true,
@@ -245,6 +264,12 @@ func ResourceIBMIAMUserInvite() *schema.Resource {
 							Computed:    true,
 						},
 
+						"state": {
+							Description: "State of the user in the account",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
 						"user_policies": {
 							Type:     schema.TypeList,
 							Computed: true,
@@ -491,49 +516,10 @@ func resourceIBMIAMInviteUsers(d *schema.ResourceData, meta interface{}) error {
 
 	usersSet := d.Get("users").(*schema.Set)
 	usersList := flex.FlattenUsersSet(usersSet)
-	users := make([]v2.User, 0)
-	for _, user := range usersList {
-		users = append(users, v2.User{Email: user, AccountRole: MEMBER})
-	}
-	if len(users) == 0 {
-		return fmt.Errorf("[ERROR] Users email not provided")
-	}
-	var accessGroups = make([]string, 0)
-	if data, ok := d.GetOk("access_groups"); ok {
-		for _, accessGroup := range data.([]interface{}) {
-			accessGroups = append(accessGroups, fmt.Sprintf("%v", accessGroup))
-		}
-	}
-
-	var accessPolicies []v2.UserPolicy
-	if accessPolicyData, ok := d.GetOk("iam_policy"); ok {
-		accessPolicies, err = getPolicies(d, meta, accessPolicyData.([]interface{}))
-		if err != nil {
-			log.Println("IAM Acess policy: ", err.Error())
-			return err
-		}
-	}
-
-	inviteUserPayload := v2.UserInvite{}
-	log.Println(inviteUserPayload)
-	inviteUserPayload.Users = users
-	if len(accessGroups) != 0 {
-		inviteUserPayload.AccessGroup = accessGroups
-	}
-	if len(accessPolicies) != 0 {
-		inviteUserPayload.IAMPolicy = accessPolicies
-	}
-
-	if infraPermissions := getInfraPermissions(d, meta); len(infraPermissions) != 0 {
-		inviteUserPayload.InfrastructureRoles = &v2.InfraPermissions{Permissions: infraPermissions}
-	}
-	orgRoles, err := getCloudFoundryRoles(d, meta)
+	inviteUserPayload, err := getUserInvitePayload(d, meta, usersList)
 	if err != nil {
 		return err
 	}
-	if len(orgRoles) != 0 {
-		inviteUserPayload.OrganizationRoles = orgRoles
-	}
 
 	accountID, err := getAccountID(d, meta)
 	if err != nil {
@@ -663,11 +649,27 @@ func resourceIBMIAMGetUsers(d *schema.ResourceData, meta interface{}) error {
 		}
 		userInfo := map[string]interface{}{
 			"user_id":       user.Email,
+			"state":         user.State,
 			"user_policies": userPolicies,
 			"access_groups": accGroupList,
 		}
 		invitedUsers = append(invitedUsers, userInfo)
 	}
+	invitationStates := make(map[string]interface{})
+	pendingUsers := make([]string, 0)
+	for _, email := range flex.FlattenUsersSet(d.Get("users").(*schema.Set)) {
+		for _, user := range res {
+			if user.Email == email {
+				invitationStates[email] = user.State
+				if user.State == userStatePending {
+					pendingUsers = append(pendingUsers, email)
+				}
+			}
+		}
+	}
+	d.Set("invitation_states", invitationStates)
+	d.Set("pending_users", pendingUsers)
+
 	//set the number of users in an account
 	d.Set("number_of_invited_users", len(res)-1)
 	d.Set("invited_users", invitedUsers)
@@ -697,51 +699,10 @@ func resourceIBMIAMUpdateUserProfile(d *schema.ResourceData, meta interface{}) e
 
 		//Update the added users
 		if len(added) > 0 {
-			users := make([]v2.User, 0)
-			for _, user := range added {
-				users = append(users, v2.User{Email: user, AccountRole: MEMBER})
-			}
-			if len(users) == 0 {
-				return fmt.Errorf("[ERROR] Users email not provided")
-			}
-
-			var accessPolicies []v2.UserPolicy
-			if accessPolicyData, ok := d.GetOk("iam_policy"); ok {
-				accessPolicies, err = getPolicies(d, meta, accessPolicyData.([]interface{}))
-				if err != nil {
-					log.Println("IAM Acess policy: ", err.Error())
-					return err
-				}
-			}
-
-			var accessGroups = make([]string, 0)
-			if data, ok := d.GetOk("access_groups"); ok {
-				for _, accessGroup := range data.([]interface{}) {
-					accessGroups = append(accessGroups, fmt.Sprintf("%v", accessGroup))
-				}
-			}
-
-			infraPermissions := getInfraPermissions(d, meta)
-			orgRoles, err := getCloudFoundryRoles(d, meta)
+			inviteUserPayload, err := getUserInvitePayload(d, meta, added)
 			if err != nil {
 				return err
 			}
-
-			inviteUserPayload := v2.UserInvite{}
-
-			inviteUserPayload.Users = users
-			if len(accessGroups) != 0 {
-				inviteUserPayload.AccessGroup = accessGroups
-			}
-			if len(accessPolicies) != 0 {
-				inviteUserPayload.IAMPolicy = accessPolicies
-			}
-			if len(infraPermissions) != 0 {
-				inviteUserPayload.InfrastructureRoles = &v2.InfraPermissions{Permissions: infraPermissions}
-			}
-			if len(orgRoles) != 0 {
-				inviteUserPayload.OrganizationRoles = orgRoles
-			}
 			_, InviteUserError := Client.InviteUsers(accountID, inviteUserPayload)
 			if InviteUserError != nil {
 				return InviteUserError
@@ -764,9 +725,102 @@ func resourceIBMIAMUpdateUserProfile(d *schema.ResourceData, meta interface{}) e
 		}
 
 	}
+
+	if d.HasChange("resend_trigger") && !d.IsNewResource() {
+		if err := resendUserInvites(d, meta); err != nil {
+			return err
+		}
+	}
 	return resourceIBMIAMGetUsers(d, meta)
 }
 
+// getUserInvitePayload returns the invitation of the users with the access of the resource
+func getUserInvitePayload(d *schema.ResourceData, meta interface{}, emails []string) (v2.UserInvite, error) {
+	inviteUserPayload := v2.UserInvite{}
+
+	users := make([]v2.User, 0)
+	for _, user := range emails {
+		users = append(users, v2.User{Email: user, AccountRole: MEMBER})
+	}
+	if len(users) == 0 {
+		return inviteUserPayload, fmt.Errorf("[ERROR] Users email not provided")
+	}
+	inviteUserPayload.Users = users
+
+	var accessGroups = make([]string, 0)
+	if data, ok := d.GetOk("access_groups"); ok {
+		for _, accessGroup := range data.([]interface{}) {
+			accessGroups = append(accessGroups, fmt.Sprintf("%v", accessGroup))
+		}
+	}
+	if len(accessGroups) != 0 {
+		inviteUserPayload.AccessGroup = accessGroups
+	}
+
+	if accessPolicyData, ok := d.GetOk("iam_policy"); ok {
+		accessPolicies, err := getPolicies(d, meta, accessPolicyData.([]interface{}))
+		if err != nil {
+			log.Println("IAM Acess policy: ", err.Error())
+			return inviteUserPayload, err
+		}
+		if len(accessPolicies) != 0 {
+			inviteUserPayload.IAMPolicy = accessPolicies
+		}
+	}
+
+	if infraPermissions := getInfraPermissions(d, meta); len(infraPermissions) != 0 {
+		inviteUserPayload.InfrastructureRoles = &v2.InfraPermissions{Permissions: infraPermissions}
+	}
+	orgRoles, err := getCloudFoundryRoles(d, meta)
+	if err != nil {
+		return inviteUserPayload, err
+	}
+	if len(orgRoles) != 0 {
+		inviteUserPayload.OrganizationRoles = orgRoles
+	}
+	return inviteUserPayload, nil
+}
+
+// resendUserInvites invites again the users of the resource that didn't accept their invitation
+func resendUserInvites(d *schema.ResourceData, meta interface{}) error {
+	userManagement, err := meta.(conns.ClientSession).UserManagementAPI()
+	if err != nil {
+		return err
+	}
+	Client := userManagement.UserInvite()
+
+	accountID, err := getAccountID(d, meta)
+	if err != nil {
+		return err
+	}
+	res, err := Client.ListUsers(accountID)
+	if err != nil {
+		return err
+	}
+
+	usersList := flex.FlattenUsersSet(d.Get("users").(*schema.Set))
+	for _, user := range usersList {
+		for _, userInfo := range res {
+			if userInfo.Email != user || userInfo.State != userStatePending {
+				continue
+			}
+			// The invitation is sent again by removing the pending user and inviting it again
+			log.Printf("[INFO] Sending the invitation again to %s", user)
+			if err := Client.RemoveUsers(accountID, userInfo.IamID); err != nil {
+				return fmt.Errorf("[ERROR] Error removing the pending user %s: %s", user, err)
+			}
+			inviteUserPayload, err := getUserInvitePayload(d, meta, []string{user})
+			if err != nil {
+				return err
+			}
+			if _, err := Client.InviteUsers(accountID, inviteUserPayload); err != nil {
+				return fmt.Errorf("[ERROR] Error inviting %s again: %s", user, err)
+			}
+		}
+	}
+	return nil
+}
+
 func resourceIBMIAMRemoveUser(d *schema.ResourceData, meta interface{}) error {
 	userManagement, err := meta.(conns.ClientSession).UserManagementAPI()
 	if err != nil {
@@ -0,0 +1,107 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMIAMUserInvite_Basic(t *testing.T) {
+	email := fmt.Sprintf("tf-test-invite-%d@example.com", acctest.RandIntRange(10000, 99999))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMUserInviteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMUserInviteConfig(email, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIAMUserInvited(email, true),
+					resource.TestCheckResourceAttr("ibm_iam_user_invite.invite", "users.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_user_invite.invite", "invitation_states.%", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_user_invite.invite", "invitation_states."+email, "PENDING"),
+					resource.TestCheckResourceAttr("ibm_iam_user_invite.invite", "pending_users.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_user_invite.invite", "pending_users.0", email),
+				),
+			},
+			{
+				// Changing the trigger sends the invitation again to the pending user
+				Config: testAccCheckIBMIAMUserInviteConfig(email, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIAMUserInvited(email, true),
+					resource.TestCheckResourceAttr("ibm_iam_user_invite.invite", "resend_trigger", "2"),
+					resource.TestCheckResourceAttr("ibm_iam_user_invite.invite", "pending_users.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMUserInviteDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_iam_user_invite" {
+			continue
+		}
+		for key, email := range rs.Primary.Attributes {
+			if key == "users.#" || !strings.HasPrefix(key, "users.") {
+				continue
+			}
+			if err := testAccCheckIBMIAMUserInvited(email, false)(s); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// testAccCheckIBMIAMUserInvited checks whether the user is a member of the account
+func testAccCheckIBMIAMUserInvited(email string, member bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		userManagement, err := acc.TestAccProvider.Meta().(conns.ClientSession).UserManagementAPI()
+		if err != nil {
+			return err
+		}
+		userDetails, err := acc.TestAccProvider.Meta().(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return err
+		}
+		users, err := userManagement.UserInvite().ListUsers(userDetails.UserAccount)
+		if err != nil {
+			return err
+		}
+		found := false
+		for _, user := range users {
+			if user.Email == email {
+				found = true
+				break
+			}
+		}
+		if found != member {
+			if member {
+				return fmt.Errorf("User %s is not a member of the account", email)
+			}
+			return fmt.Errorf("User %s is still a member of the account", email)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMIAMUserInviteConfig(email, resendTrigger string) string {
+	return fmt.Sprintf(`
+	resource "ibm_iam_user_invite" "invite" {
+		users          = ["%s"]
+		resend_trigger = "%s"
+	}
+	`, email, resendTrigger)
+}
@@ -0,0 +1,126 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMIAMUserRemoval() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMIAMUserRemovalCreate,
+		Read:   resourceIBMIAMUserRemovalRead,
+		Delete: resourceIBMIAMUserRemovalDelete,
+
+		Schema: map[string]*schema.Schema{
+			"user": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ibm id or email of the user to remove from the account",
+			},
+			"cleanup_policies": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Delete the access policies of the user before removing it from the account",
+			},
+			"iam_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IAM ID of the removed user",
+			},
+			"removed_policy_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the access policies of the user that were deleted",
+			},
+		},
+	}
+}
+
+func resourceIBMIAMUserRemovalCreate(d *schema.ResourceData, meta interface{}) error {
+	userManagement, err := meta.(conns.ClientSession).UserManagementAPI()
+	if err != nil {
+		return err
+	}
+	Client := userManagement.UserInvite()
+
+	accountID, err := getAccountID(d, meta)
+	if err != nil {
+		return err
+	}
+
+	user := d.Get("user").(string)
+	IAMID, err := getUserIAMID(d, meta, user)
+	if err != nil {
+		return fmt.Errorf("[ERROR] User's IAM ID not found: %s", err.Error())
+	}
+	if IAMID == "" {
+		return fmt.Errorf("[ERROR] User %s is not a member of account %s", user, accountID)
+	}
+
+	removedPolicies := make([]string, 0)
+	if d.Get("cleanup_policies").(bool) {
+		iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+		if err != nil {
+			return err
+		}
+		policyList, _, err := iamPolicyManagementClient.ListPolicies(&iampolicymanagementv1.ListPoliciesOptions{
+			AccountID: core.StringPtr(accountID),
+			IamID:     core.StringPtr(IAMID),
+			Type:      core.StringPtr("access"),
+		})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error retrieving user policies: %s", err)
+		}
+		for _, policy := range policyList.Policies {
+			log.Printf("[INFO] Deleting policy %s of user %s", *policy.ID, user)
+			_, err := iamPolicyManagementClient.DeletePolicy(&iampolicymanagementv1.DeletePolicyOptions{
+				PolicyID: policy.ID,
+			})
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error deleting policy %s of user %s: %s", *policy.ID, user, err)
+			}
+			removedPolicies = append(removedPolicies, *policy.ID)
+		}
+	}
+
+	if err := Client.RemoveUsers(accountID, IAMID); err != nil {
+		return fmt.Errorf("[ERROR] Error removing user %s from account %s: %s", user, accountID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", accountID, IAMID))
+	d.Set("iam_id", IAMID)
+	d.Set("removed_policy_ids", removedPolicies)
+
+	return resourceIBMIAMUserRemovalRead(d, meta)
+}
+
+func resourceIBMIAMUserRemovalRead(d *schema.ResourceData, meta interface{}) error {
+	// A user that is a member of the account again has to be removed again
+	IAMID, err := getUserIAMID(d, meta, d.Get("user").(string))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving the users of the account: %s", err)
+	}
+	if IAMID != "" {
+		log.Printf("[WARN] User %s is a member of the account again, removing the removal from the state", d.Get("user"))
+		d.SetId("")
+	}
+	return nil
+}
+
+func resourceIBMIAMUserRemovalDelete(d *schema.ResourceData, meta interface{}) error {
+	// The user isn't invited again when the removal is deleted
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIAMUserRemoval_Basic(t *testing.T) {
+	email := fmt.Sprintf("tf-test-removal-%d@example.com", acctest.RandIntRange(10000, 99999))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMUserInviteConfig(email, "1"),
+				Check:  testAccCheckIBMIAMUserInvited(email, true),
+			},
+			{
+				// The invitation of the removed user has to be planned again
+				Config:             testAccCheckIBMIAMUserRemovalConfig(email),
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIAMUserInvited(email, false),
+					resource.TestCheckResourceAttrSet("ibm_iam_user_removal.removal", "iam_id"),
+					resource.TestCheckResourceAttr("ibm_iam_user_removal.removal", "cleanup_policies", "true"),
+					resource.TestCheckResourceAttr("ibm_iam_user_removal.removal", "removed_policy_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMIAMUserRemoval_NotMember(t *testing.T) {
+	email := fmt.Sprintf("tf-test-removal-%d@example.com", acctest.RandIntRange(10000, 99999))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMIAMUserRemovalOnlyConfig(email),
+				ExpectError: regexp.MustCompile("is not a member of account"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMUserRemovalConfig(email string) string {
+	return testAccCheckIBMIAMUserInviteConfig(email, "1") + fmt.Sprintf(`
+	resource "ibm_iam_user_removal" "removal" {
+		user       = "%s"
+		depends_on = [ibm_iam_user_invite.invite]
+	}
+	`, email)
+}
+
+func testAccCheckIBMIAMUserRemovalOnlyConfig(email string) string {
+	return fmt.Sprintf(`
+	resource "ibm_iam_user_removal" "removal" {
+		user = "%s"
+	}
+	`, email)
+}
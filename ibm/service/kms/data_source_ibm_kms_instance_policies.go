@@ -203,7 +203,7 @@ func DataSourceIBMKmsInstancePolicies() *schema.Resource {
 
 func resourceIBMKmsInstancePolicyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceID := getInstanceIDFromCRN(d.Get("instance_id").(string))
-	kpAPI, _, err := populateKPClient(d, meta, instanceID)
+	kpAPI, _, err := populateKPClientForRead(d, meta, instanceID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -183,7 +183,7 @@ func DataSourceIBMKMSkey() *schema.Resource {
 
 func dataSourceIBMKMSKeyRead(d *schema.ResourceData, meta interface{}) error {
 	instanceID := getInstanceIDFromCRN(d.Get("instance_id").(string))
-	api, _, err := populateKPClient(d, meta, instanceID)
+	api, _, err := populateKPClientForRead(d, meta, instanceID)
 	if err != nil {
 		return err
 	}
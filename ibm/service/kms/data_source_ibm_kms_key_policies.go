@@ -152,7 +152,7 @@ func DataSourceIBMKMSkeyPolicies() *schema.Resource {
 
 func dataSourceIBMKMSKeyPoliciesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceID := getInstanceIDFromCRN(d.Get("instance_id").(string))
-	api, _, err := populateKPClient(d, meta, instanceID)
+	api, _, err := populateKPClientForRead(d, meta, instanceID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
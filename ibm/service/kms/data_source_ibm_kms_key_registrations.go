@@ -0,0 +1,203 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMKMSKeyRegistrations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMKMSKeyRegistrationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Key protect or hpcs instance GUID",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "public or private",
+				Default:      "public",
+			},
+			"key_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"alias"},
+				Description:   "Key ID of the Key, the registrations of all the keys of the instance are listed when neither key_id nor alias is set",
+			},
+			"alias": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"key_id"},
+				Description:   "Alias of the Key",
+			},
+			"resource_crn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "CRN of the registered resources, with * as a wildcard for the segments after the service name",
+			},
+			"expected_resource_crns": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "CRNs of the resources that are expected to be registered with the key",
+			},
+			"registrations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Registrations of cloud resources with the key",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the key of the registration",
+						},
+						"resource_crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "CRN of the resource that is registered with the key",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the registration",
+						},
+						"prevent_key_deletion": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the registration prevents the deletion of the key",
+						},
+						"key_version_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the version of the key that the resource uses",
+						},
+						"created_by": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for the resource that created the registration.",
+						},
+						"creation_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date the registration was created. The date format follows RFC 3339.",
+						},
+						"updated_by": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for the resource that updated the registration.",
+						},
+						"last_update_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Updates when the registration is modified. The date format follows RFC 3339.",
+						},
+					},
+				},
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of registrations",
+			},
+			"prevent_key_deletion": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether any registration prevents the deletion of the key",
+			},
+			"unexpected_resource_crns": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "CRNs of the registered resources that are not in expected_resource_crns",
+			},
+		},
+	}
+}
+
+func dataSourceIBMKMSKeyRegistrationsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := getInstanceIDFromCRN(d.Get("instance_id").(string))
+	api, _, err := populateKPClientForRead(d, meta, instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	keyID := d.Get("key_id").(string)
+	if v, ok := d.GetOk("alias"); ok {
+		key, err := api.GetKey(context, v.(string))
+		if err != nil {
+			return diag.Errorf("Failed to get Key: %s", err)
+		}
+		keyID = key.ID
+	}
+	resourceCRN := d.Get("resource_crn").(string)
+
+	regs, err := api.ListRegistrations(context, keyID, resourceCRN)
+	if err != nil {
+		return diag.Errorf("Failed to list the registrations: %s", err)
+	}
+
+	expected := make(map[string]bool)
+	for _, crn := range flex.ExpandStringList(d.Get("expected_resource_crns").([]interface{})) {
+		expected[crn] = true
+	}
+
+	registrations := make([]map[string]interface{}, 0, len(regs.Registrations))
+	unexpected := make([]string, 0)
+	preventKeyDeletion := false
+	for _, reg := range regs.Registrations {
+		registrations = append(registrations, map[string]interface{}{
+			"key_id":               reg.KeyID,
+			"resource_crn":         reg.ResourceCrn,
+			"description":          reg.Description,
+			"prevent_key_deletion": reg.PreventKeyDeletion,
+			"key_version_id":       reg.KeyVersion.ID,
+			"created_by":           reg.CreatedBy,
+			"creation_date":        kmsRegistrationDate(reg.CreationDate),
+			"updated_by":           reg.UpdatedBy,
+			"last_update_date":     kmsRegistrationDate(reg.LastUpdateDate),
+		})
+		if reg.PreventKeyDeletion {
+			preventKeyDeletion = true
+		}
+		if !expected[reg.ResourceCrn] {
+			unexpected = append(unexpected, reg.ResourceCrn)
+		}
+	}
+	if len(registrations) == 0 {
+		log.Printf("[DEBUG] No registrations found for key %q of instance %s", keyID, instanceID)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", instanceID, keyID))
+	d.Set("instance_id", instanceID)
+	d.Set("key_id", keyID)
+	d.Set("registrations", registrations)
+	d.Set("total_count", len(registrations))
+	d.Set("prevent_key_deletion", preventKeyDeletion)
+	d.Set("unexpected_resource_crns", unexpected)
+
+	return nil
+}
+
+// kmsRegistrationDate formats a date of a registration in RFC 3339
+func kmsRegistrationDate(date *time.Time) string {
+	if date == nil {
+		return ""
+	}
+	return date.Format(time.RFC3339)
+}
@@ -58,7 +58,7 @@ func DataSourceIBMKMSkeyRings() *schema.Resource {
 
 func dataSourceIBMKMSKeyRingsRead(d *schema.ResourceData, meta interface{}) error {
 	instanceID := getInstanceIDFromCRN(d.Get("instance_id").(string))
-	api, _, err := populateKPClient(d, meta, instanceID)
+	api, _, err := populateKPClientForRead(d, meta, instanceID)
 	if err != nil {
 		return err
 	}
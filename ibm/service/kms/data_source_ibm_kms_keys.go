@@ -186,7 +186,7 @@ func DataSourceIBMKMSkeys() *schema.Resource {
 
 func dataSourceIBMKMSKeysRead(d *schema.ResourceData, meta interface{}) error {
 	instanceID := getInstanceIDFromCRN(d.Get("instance_id").(string))
-	api, _, err := populateKPClient(d, meta, instanceID)
+	api, _, err := populateKPClientForRead(d, meta, instanceID)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// kmsFailoverTransport sends the read requests of the Key Protect data sources to the endpoints of the failover
+// regions, in order, when the endpoint of the region of the instance fails with a server or network error. Keys are
+// replicated across the regions of a geography, so the failover regions should be in the geography of the instance.
+type kmsFailoverTransport struct {
+	base    http.RoundTripper
+	regions []string
+}
+
+func (t *kmsFailoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if req.Method != http.MethodGet || (err == nil && resp.StatusCode < 500) {
+		return resp, err
+	}
+	labels, regionIndex := kmsHostRegionIndex(req.URL.Hostname())
+	if regionIndex < 0 {
+		return resp, err
+	}
+
+	for _, region := range t.regions {
+		if region == labels[regionIndex] {
+			continue
+		}
+		failoverLabels := append([]string{}, labels...)
+		failoverLabels[regionIndex] = region
+		host := strings.Join(failoverLabels, ".")
+		if port := req.URL.Port(); port != "" {
+			host = host + ":" + port
+		}
+		if err != nil {
+			log.Printf("[WARN] Key Protect request to %s failed with error %s, retrying on %s", req.URL.Host, err, host)
+		} else {
+			log.Printf("[WARN] Key Protect request to %s failed with status %d, retrying on %s", req.URL.Host, resp.StatusCode, host)
+		}
+
+		failoverReq := req.Clone(req.Context())
+		failoverReq.URL.Host = host
+		failoverReq.Host = ""
+		failoverResp, failoverErr := t.base.RoundTrip(failoverReq)
+		if failoverErr == nil && failoverResp.StatusCode < 500 {
+			if resp != nil {
+				resp.Body.Close()
+			}
+			return failoverResp, nil
+		}
+		if failoverResp != nil {
+			failoverResp.Body.Close()
+		}
+	}
+	return resp, err
+}
+
+// kmsHostRegionIndex returns the labels of a Key Protect host, for example us-south.kms.cloud.ibm.com or
+// private.us-south.kms.cloud.ibm.com, and the index of the label of the region, which is the label before kms. The
+// index is -1 for the hosts of the other key management services, for example Hyper Protect Crypto Services.
+func kmsHostRegionIndex(host string) ([]string, int) {
+	labels := strings.Split(host, ".")
+	for i := 1; i < len(labels); i++ {
+		if labels[i] == "kms" {
+			return labels, i - 1
+		}
+	}
+	return labels, -1
+}
+
+// populateKPClientForRead returns the Key Protect client of the instance for the data sources. The requests of the
+// client are failed over to the kms_failover_regions of the provider.
+func populateKPClientForRead(d *schema.ResourceData, meta interface{}, instanceID string) (*kp.Client, *string, error) {
+	api, instanceCRN, err := populateKPClient(d, meta, instanceID)
+	if err != nil {
+		return nil, nil, err
+	}
+	regions := meta.(conns.ClientSession).KMSFailoverRegions()
+	if len(regions) == 0 {
+		return api, instanceCRN, nil
+	}
+
+	// The client of the session is shared, the transport is changed on a copy
+	client := *api
+	base := client.HttpClient.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	client.HttpClient.Transport = &kmsFailoverTransport{base: base, regions: regions}
+	return &client, instanceCRN, nil
+}
@@ -0,0 +1,295 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	en "github.com/IBM/event-notifications-go-admin-sdk/eventnotificationsv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// kmsEventTypeFilter is the Event Notifications filter that matches one key lifecycle event
+const kmsEventTypeFilter = "$.notification_event_info.event_type == '%s'"
+
+var kmsEventTypeFilterRegexp = regexp.MustCompile(`^\$\.notification_event_info\.event_type == '([a-z_]+)'$`)
+
+func ResourceIBMKmsEventNotifications() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMKmsEventNotificationsCreate,
+		Read:     resourceIBMKmsEventNotificationsRead,
+		Update:   resourceIBMKmsEventNotificationsUpdate,
+		Delete:   resourceIBMKmsEventNotificationsDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "Key protect or hpcs instance GUID or CRN",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"en_instance_guid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "GUID of the Event Notifications instance the key events are published to",
+			},
+			"topic_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the Event Notifications topic of the key events",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the Event Notifications topic of the key events",
+			},
+			"events": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeValidator("ibm_kms_event_notifications", "events")},
+				Set:         schema.HashString,
+				Description: "Key lifecycle events published to the topic",
+			},
+			"instance_crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Key protect or hpcs instance CRN, the ID of the source of the key events in Event Notifications",
+			},
+			"topic_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the Event Notifications topic of the key events",
+			},
+		},
+	}
+}
+
+func ResourceIBMKmsEventNotificationsValidator() *validate.ResourceValidator {
+
+	validateSchema := make([]validate.ValidateSchema, 0)
+
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "events",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "key_created, key_deleted, key_disabled, key_enabled, key_expiring, key_restored, key_rotated"})
+
+	ibmKmsEventNotificationsResourceValidator := validate.ResourceValidator{ResourceName: "ibm_kms_event_notifications", Schema: validateSchema}
+	return &ibmKmsEventNotificationsResourceValidator
+}
+
+func resourceIBMKmsEventNotificationsCreate(d *schema.ResourceData, meta interface{}) error {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return err
+	}
+
+	instanceCRN, err := getKmsInstanceCRN(meta, getInstanceIDFromCRN(d.Get("instance_id").(string)))
+	if err != nil {
+		return err
+	}
+	enInstanceID := d.Get("en_instance_guid").(string)
+
+	// The instance is a source of Event Notifications once it is authorized to publish to the Event Notifications instance
+	if err = checkKmsEventNotificationsSource(enClient, enInstanceID, instanceCRN); err != nil {
+		return err
+	}
+
+	options := &en.CreateTopicOptions{}
+	options.SetInstanceID(enInstanceID)
+	options.SetName(d.Get("topic_name").(string))
+	if v, ok := d.GetOk("description"); ok {
+		options.SetDescription(v.(string))
+	}
+	options.SetSources([]en.SourcesItems{expandKmsEventNotificationsSource(instanceCRN, d.Get("events").(*schema.Set))})
+
+	topic, response, err := enClient.CreateTopicWithContext(context.Background(), options)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error while creating the topic of the key events: %s\n%s", err, response)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", enInstanceID, *topic.ID))
+
+	return resourceIBMKmsEventNotificationsRead(d, meta)
+}
+
+func resourceIBMKmsEventNotificationsRead(d *schema.ResourceData, meta interface{}) error {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return err
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return err
+	}
+
+	options := &en.GetTopicOptions{}
+	options.SetInstanceID(parts[0])
+	options.SetID(parts[1])
+
+	topic, response, err := enClient.GetTopicWithContext(context.Background(), options)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error while reading the topic of the key events: %s\n%s", err, response)
+	}
+
+	instanceCRN := d.Get("instance_crn").(string)
+	events := []string{}
+	for _, source := range topic.Sources {
+		if source.ID == nil || (instanceCRN != "" && *source.ID != instanceCRN) {
+			continue
+		}
+		instanceCRN = *source.ID
+		for _, rule := range source.Rules {
+			if rule.EventTypeFilter == nil {
+				continue
+			}
+			if match := kmsEventTypeFilterRegexp.FindStringSubmatch(*rule.EventTypeFilter); match != nil {
+				events = append(events, match[1])
+			}
+		}
+		break
+	}
+
+	d.Set("en_instance_guid", parts[0])
+	d.Set("topic_id", topic.ID)
+	d.Set("topic_name", topic.Name)
+	d.Set("description", topic.Description)
+	d.Set("instance_crn", instanceCRN)
+	if _, ok := d.GetOk("instance_id"); !ok && instanceCRN != "" {
+		d.Set("instance_id", getInstanceIDFromCRN(instanceCRN))
+	}
+	if err = d.Set("events", flex.NewStringSet(schema.HashString, events)); err != nil {
+		return fmt.Errorf("[ERROR] Error setting events: %s", err)
+	}
+
+	return nil
+}
+
+func resourceIBMKmsEventNotificationsUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChanges("topic_name", "description", "events") {
+		enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+		if err != nil {
+			return err
+		}
+
+		parts, err := flex.SepIdParts(d.Id(), "/")
+		if err != nil {
+			return err
+		}
+
+		options := &en.ReplaceTopicOptions{}
+		options.SetInstanceID(parts[0])
+		options.SetID(parts[1])
+		options.SetName(d.Get("topic_name").(string))
+		if v, ok := d.GetOk("description"); ok {
+			options.SetDescription(v.(string))
+		}
+		options.SetSources([]en.SourcesItems{expandKmsEventNotificationsSource(d.Get("instance_crn").(string), d.Get("events").(*schema.Set))})
+
+		_, response, err := enClient.ReplaceTopicWithContext(context.Background(), options)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error while updating the topic of the key events: %s\n%s", err, response)
+		}
+	}
+
+	return resourceIBMKmsEventNotificationsRead(d, meta)
+}
+
+func resourceIBMKmsEventNotificationsDelete(d *schema.ResourceData, meta interface{}) error {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		return err
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return err
+	}
+
+	options := &en.DeleteTopicOptions{}
+	options.SetInstanceID(parts[0])
+	options.SetID(parts[1])
+
+	response, err := enClient.DeleteTopicWithContext(context.Background(), options)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return fmt.Errorf("[ERROR] Error while deleting the topic of the key events: %s\n%s", err, response)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func getKmsInstanceCRN(meta interface{}, instanceID string) (string, error) {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return "", err
+	}
+	resourceInstanceGet := rc.GetResourceInstanceOptions{
+		ID: &instanceID,
+	}
+	instanceData, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+	if err != nil || instanceData == nil {
+		return "", fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
+	}
+	return *instanceData.CRN, nil
+}
+
+func checkKmsEventNotificationsSource(enClient *en.EventNotificationsV1, enInstanceID, instanceCRN string) error {
+	options := &en.ListSourcesOptions{}
+	options.SetInstanceID(enInstanceID)
+	options.SetLimit(int64(100))
+	offset := int64(0)
+	for {
+		options.SetOffset(offset)
+		sources, response, err := enClient.ListSourcesWithContext(context.Background(), options)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error while listing the sources of the Event Notifications instance: %s\n%s", err, response)
+		}
+		for _, source := range sources.Sources {
+			if source.ID != nil && *source.ID == instanceCRN {
+				return nil
+			}
+		}
+		offset += int64(len(sources.Sources))
+		if len(sources.Sources) == 0 || offset >= *sources.TotalCount {
+			break
+		}
+	}
+	return fmt.Errorf("[ERROR] The instance %s is not a source of the Event Notifications instance %s, create an authorization policy with the Event Source Manager role from the instance to the Event Notifications instance", instanceCRN, enInstanceID)
+}
+
+func expandKmsEventNotificationsSource(instanceCRN string, events *schema.Set) en.SourcesItems {
+	eventList := flex.ExpandStringList(events.List())
+	sort.Strings(eventList)
+	rules := make([]en.Rules, 0, len(eventList))
+	for _, event := range eventList {
+		rules = append(rules, en.Rules{
+			Enabled:         core.BoolPtr(true),
+			EventTypeFilter: core.StringPtr(fmt.Sprintf(kmsEventTypeFilter, event)),
+		})
+	}
+	return en.SourcesItems{
+		ID:    core.StringPtr(instanceCRN),
+		Rules: rules,
+	}
+}
@@ -0,0 +1,73 @@
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSResource_Event_Notifications(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_kms_%d", acctest.RandIntRange(10, 100))
+	enInstanceName := fmt.Sprintf("tf_en_%d", acctest.RandIntRange(10, 100))
+	topicName := fmt.Sprintf("tf_kms_events_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsEventNotificationsConfig(instanceName, enInstanceName, topicName, `"key_rotated", "key_deleted"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_event_notifications.test", "topic_name", topicName),
+					resource.TestCheckResourceAttr("ibm_kms_event_notifications.test", "events.#", "2"),
+					resource.TestCheckResourceAttrSet("ibm_kms_event_notifications.test", "topic_id"),
+					resource.TestCheckResourceAttrPair("ibm_kms_event_notifications.test", "instance_crn", "ibm_resource_instance.kms_instance", "crn"),
+				),
+			},
+			{
+				Config: testAccCheckIBMKmsEventNotificationsConfig(instanceName, enInstanceName, topicName, `"key_rotated", "key_deleted", "key_disabled"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_event_notifications.test", "events.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsEventNotificationsConfig(instanceName, enInstanceName, topicName, events string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name     = "%s"
+		service  = "kms"
+		plan     = "tiered-pricing"
+		location = "us-south"
+	}
+
+	resource "ibm_resource_instance" "en_instance" {
+		name     = "%s"
+		service  = "event-notifications"
+		plan     = "standard"
+		location = "us-south"
+	}
+
+	resource "ibm_iam_authorization_policy" "kms_en_policy" {
+		source_service_name         = "kms"
+		source_resource_instance_id = ibm_resource_instance.kms_instance.guid
+		target_service_name         = "event-notifications"
+		target_resource_instance_id = ibm_resource_instance.en_instance.guid
+		roles                       = ["Event Source Manager"]
+	}
+
+	resource "ibm_kms_event_notifications" "test" {
+		instance_id      = ibm_resource_instance.kms_instance.guid
+		en_instance_guid = ibm_resource_instance.en_instance.guid
+		topic_name       = "%s"
+		events           = [%s]
+		depends_on       = [ibm_iam_authorization_policy.kms_en_policy]
+	}
+`, instanceName, enInstanceName, topicName, events)
+}
@@ -0,0 +1,296 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+// clusterHealth is the health of the master, of the add-ons and of the workers of a cluster
+type clusterHealth struct {
+	Cluster *v2.ClusterInfo
+	AddOns  []v1.AddOn
+	Workers []v2.Worker
+}
+
+// masterReady returns whether the master is deployed and ready
+func (h *clusterHealth) masterReady() bool {
+	return h.Cluster.Lifecycle.MasterStatus == ready && h.Cluster.Lifecycle.MasterState == clusterDeployed
+}
+
+// workersReady returns whether the cluster has workers and all of them are deployed and normal
+func (h *clusterHealth) workersReady() bool {
+	if len(h.Workers) == 0 {
+		return false
+	}
+	for _, worker := range h.Workers {
+		if worker.LifeCycle.ActualState != clusterDeployed || worker.Health.State != workerNormal {
+			return false
+		}
+	}
+	return true
+}
+
+// addOnsReady returns whether all the add-ons of the cluster are normal
+func (h *clusterHealth) addOnsReady() bool {
+	for _, addOn := range h.AddOns {
+		if addOn.HealthState != normal {
+			return false
+		}
+	}
+	return true
+}
+
+// healthy returns whether the master, the add-ons and the workers of the cluster are normal
+func (h *clusterHealth) healthy() bool {
+	return h.masterReady() && h.Cluster.Lifecycle.MasterHealth == normal && h.workersReady() && h.addOnsReady()
+}
+
+// pendingUpdates returns the updates of the master, of the add-ons and of the workers that are available
+func (h *clusterHealth) pendingUpdates() []string {
+	updates := []string{}
+	if h.Cluster.TargetVersion != "" && h.Cluster.TargetVersion != h.Cluster.MasterKubeVersion {
+		updates = append(updates, fmt.Sprintf("master: %s to %s", h.Cluster.MasterKubeVersion, h.Cluster.TargetVersion))
+	}
+	for _, addOn := range h.AddOns {
+		if addOn.TargetVersion != "" && addOn.TargetVersion != addOn.Version {
+			updates = append(updates, fmt.Sprintf("addon %s: %s to %s", addOn.Name, addOn.Version, addOn.TargetVersion))
+		}
+	}
+	for _, worker := range h.Workers {
+		if worker.KubeVersion.Target != "" && worker.KubeVersion.Target != worker.KubeVersion.Actual {
+			updates = append(updates, fmt.Sprintf("worker %s: %s to %s", worker.ID, worker.KubeVersion.Actual, worker.KubeVersion.Target))
+		}
+	}
+	return updates
+}
+
+// getClusterHealth gets the cluster, its add-ons and its workers
+func getClusterHealth(d *schema.ResourceData, meta interface{}, cluster string) (*clusterHealth, error) {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+	csClientV1, err := meta.(conns.ClientSession).ContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	targetEnvV1, err := getClusterTargetHeader(d, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	cls, err := csClient.Clusters().GetCluster(cluster, targetEnv)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error retrieving cluster %s: %s", cluster, err)
+	}
+	addOns, err := csClientV1.AddOns().GetAddons(cls.ID, targetEnvV1)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error retrieving the add-ons of cluster %s: %s", cluster, err)
+	}
+	workers, err := csClient.Workers().ListWorkers(cls.ID, false, targetEnv)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error retrieving the workers of cluster %s: %s", cluster, err)
+	}
+	return &clusterHealth{Cluster: cls, AddOns: addOns, Workers: workers}, nil
+}
+
+func DataSourceIBMContainerClusterHealth() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMContainerClusterHealthRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Cluster name or ID",
+				ValidateFunc: validate.InvokeDataSourceValidator(
+					"ibm_container_cluster_health",
+					"cluster"),
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the resource group.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the cluster",
+			},
+			"master_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the master",
+			},
+			"master_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the master",
+			},
+			"master_health": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Health of the master",
+			},
+			"master_status_modified_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date when the status of the master was modified",
+			},
+			"master_ready": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the master is deployed and ready",
+			},
+			"kube_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Kubernetes version of the master",
+			},
+			"target_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Kubernetes version that the master is updated to",
+			},
+			"addons": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Health of the add-ons of the cluster",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the add-on",
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Version of the add-on",
+						},
+						"target_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Version that the add-on can be updated to",
+						},
+						"health_state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Health state of the add-on",
+						},
+						"health_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Health status of the add-on",
+						},
+					},
+				},
+			},
+			"addons_ready": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether all the add-ons are normal",
+			},
+			"workers_total": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of workers of the cluster",
+			},
+			"workers_normal": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of workers that are deployed and normal",
+			},
+			"workers_ready": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the cluster has workers and all of them are deployed and normal",
+			},
+			"pending_updates": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Updates of the master, of the add-ons and of the workers that are available",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"healthy": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the master, the add-ons and the workers are normal",
+			},
+		},
+	}
+}
+
+func DataSourceIBMContainerClusterHealthValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cluster",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			CloudDataType:              "cluster",
+			CloudDataRange:             []string{"resolved_to:id"}})
+
+	iBMContainerClusterHealthValidator := validate.ResourceValidator{ResourceName: "ibm_container_cluster_health", Schema: validateSchema}
+	return &iBMContainerClusterHealthValidator
+}
+
+func dataSourceIBMContainerClusterHealthRead(d *schema.ResourceData, meta interface{}) error {
+	health, err := getClusterHealth(d, meta, d.Get("cluster").(string))
+	if err != nil {
+		return err
+	}
+	cls := health.Cluster
+
+	addOns := make([]map[string]interface{}, 0, len(health.AddOns))
+	for _, addOn := range health.AddOns {
+		addOns = append(addOns, map[string]interface{}{
+			"name":           addOn.Name,
+			"version":        addOn.Version,
+			"target_version": addOn.TargetVersion,
+			"health_state":   addOn.HealthState,
+			"health_status":  addOn.HealthStatus,
+		})
+	}
+	workersNormal := 0
+	for _, worker := range health.Workers {
+		if worker.LifeCycle.ActualState == clusterDeployed && worker.Health.State == workerNormal {
+			workersNormal++
+		}
+	}
+
+	d.SetId(cls.ID)
+	d.Set("resource_group_id", cls.ResourceGroupID)
+	d.Set("state", cls.State)
+	d.Set("master_status", cls.Lifecycle.MasterStatus)
+	d.Set("master_state", cls.Lifecycle.MasterState)
+	d.Set("master_health", cls.Lifecycle.MasterHealth)
+	d.Set("master_status_modified_date", cls.Lifecycle.MasterStatusModifiedDate)
+	d.Set("master_ready", health.masterReady())
+	d.Set("kube_version", cls.MasterKubeVersion)
+	d.Set("target_version", cls.TargetVersion)
+	d.Set("addons", addOns)
+	d.Set("addons_ready", health.addOnsReady())
+	d.Set("workers_total", len(health.Workers))
+	d.Set("workers_normal", workersNormal)
+	d.Set("workers_ready", health.workersReady())
+	d.Set("pending_updates", health.pendingUpdates())
+	d.Set("healthy", health.healthy())
+
+	return nil
+}
@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMContainerClusterHealthDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerClusterHealthDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_container_cluster_health.health", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_container_cluster_health.health", "master_status"),
+					resource.TestCheckResourceAttrSet("data.ibm_container_cluster_health.health", "master_health"),
+					resource.TestCheckResourceAttrSet("data.ibm_container_cluster_health.health", "kube_version"),
+					resource.TestCheckResourceAttrSet("data.ibm_container_cluster_health.health", "workers_total"),
+					resource.TestCheckResourceAttrSet("data.ibm_container_cluster_health.health", "healthy"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMContainerClusterWait_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerClusterWaitConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_container_cluster_wait.wait", "condition", "master_ready"),
+					resource.TestCheckResourceAttrSet("ibm_container_cluster_wait.wait", "master_health"),
+					resource.TestCheckResourceAttrSet("ibm_container_cluster_wait.wait", "state"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerClusterHealthDataSourceConfig() string {
+	return fmt.Sprintf(`
+data "ibm_container_cluster_health" "health" {
+  cluster = "%s"
+}`, acc.ClusterName)
+}
+
+func testAccCheckIBMContainerClusterWaitConfig() string {
+	return fmt.Sprintf(`
+resource "ibm_container_cluster_wait" "wait" {
+  cluster   = "%s"
+  condition = "master_ready"
+}`, acc.ClusterName)
+}
@@ -0,0 +1,414 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"log"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	yaml "gopkg.in/yaml.v3"
+
+	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+const (
+	kubeconfigAuthModeToken = "token"
+	kubeconfigAuthModeExec  = "exec"
+
+	kubeconfigExecAPIVersion = "client.authentication.k8s.io/v1beta1"
+)
+
+func DataSourceIBMContainerClusterKubeconfig() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMContainerClusterKubeconfigRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name_id": {
+				Description: "The name/id of the cluster",
+				Type:        schema.TypeString,
+				Required:    true,
+				ValidateFunc: validate.InvokeDataSourceValidator(
+					"ibm_container_cluster_kubeconfig",
+					"cluster_name_id"),
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the resource group.",
+			},
+			"admin": {
+				Description: "If set to true will return the admin client certificate and key of the cluster",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"endpoint_type": {
+				Description: "It can specify what kind of server URL will be used for the cluster context",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"auth_mode": {
+				Description: "How the kubeconfig authenticates to the cluster. With token the IAM or OpenShift OAuth token is embedded, with exec the credential is fetched by an exec plugin",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     kubeconfigAuthModeToken,
+				ValidateFunc: validate.InvokeDataSourceValidator(
+					"ibm_container_cluster_kubeconfig",
+					"auth_mode"),
+			},
+			"exec_command": {
+				Description: "The command of the exec credential plugin, required when auth_mode is exec",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"exec_args": {
+				Description: "The arguments passed to the exec credential plugin",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"exec_env": {
+				Description: "The environment variables set for the exec credential plugin",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"exec_api_version": {
+				Description: "The client.authentication.k8s.io API version of the ExecCredential returned by the plugin",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     kubeconfigExecAPIVersion,
+			},
+			"cluster_type": {
+				Description: "The type of the cluster, kubernetes or openshift",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"context_name": {
+				Description: "The name of the context set as current context of the kubeconfig",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"host": {
+				Description: "The URL of the cluster API server",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"token": {
+				Description: "The IAM token of the user, or the OpenShift OAuth token for Red Hat OpenShift clusters",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"ca_certificate": {
+				Description: "The PEM encoded CA certificate of the cluster",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"client_certificate": {
+				Description: "The PEM encoded admin client certificate, set when admin is true",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"client_key": {
+				Description: "The PEM encoded admin client key, set when admin is true",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"kubeconfig": {
+				Description: "The kubeconfig of the cluster with all the credentials inlined",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func DataSourceIBMContainerClusterKubeconfigValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cluster_name_id",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			CloudDataType:              "cluster",
+			CloudDataRange:             []string{"resolved_to:id"}},
+		validate.ValidateSchema{
+			Identifier:                 "auth_mode",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              fmt.Sprintf("%s, %s", kubeconfigAuthModeToken, kubeconfigAuthModeExec)})
+
+	iBMContainerClusterKubeconfigValidator := validate.ResourceValidator{ResourceName: "ibm_container_cluster_kubeconfig", Schema: validateSchema}
+	return &iBMContainerClusterKubeconfigValidator
+}
+
+func dataSourceIBMContainerClusterKubeconfigRead(d *schema.ResourceData, meta interface{}) error {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	csAPI := csClient.Clusters()
+	name := d.Get("cluster_name_id").(string)
+	admin := d.Get("admin").(bool)
+	endpointType := d.Get("endpoint_type").(string)
+	authMode := d.Get("auth_mode").(string)
+
+	if authMode == kubeconfigAuthModeExec && d.Get("exec_command").(string) == "" {
+		return fmt.Errorf("[ERROR] exec_command must be set when auth_mode is %s", kubeconfigAuthModeExec)
+	}
+
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+	cls, err := csAPI.GetCluster(name, targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving cluster %s: %s", name, err)
+	}
+
+	var clusterKeyDetails v1.ClusterKeyInfo
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		var err error
+		clusterKeyDetails, err = fetchClusterKubeconfig(meta, csAPI, cls, admin, targetEnv, endpointType)
+		if err != nil {
+			log.Printf("[DEBUG] Failed to fetch cluster config err %s", err)
+			if strings.Contains(err.Error(), "Could not login to openshift account runtime error:") {
+				return resource.RetryableError(err)
+			}
+			if intermittentUserLookupFailure, _ := regexp.MatchString("Error: lookup of user for \"(.+)\" failed", err.Error()); intermittentUserLookupFailure {
+				// Intermittent error resulting from synchronisation delay
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if conns.IsResourceTimeoutError(err) {
+		clusterKeyDetails, err = fetchClusterKubeconfig(meta, csAPI, cls, admin, targetEnv, endpointType)
+	}
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error fetching the cluster config [%s]: %s", name, err)
+	}
+
+	contextName := fmt.Sprintf("%s/%s", cls.Name, cls.ID)
+	user := map[string]interface{}{}
+	switch {
+	case authMode == kubeconfigAuthModeExec:
+		exec := map[string]interface{}{
+			"apiVersion":      d.Get("exec_api_version").(string),
+			"command":         d.Get("exec_command").(string),
+			"interactiveMode": "Never",
+		}
+		if args, ok := d.GetOk("exec_args"); ok {
+			exec["args"] = flex.ExpandStringList(args.([]interface{}))
+		}
+		if env, ok := d.GetOk("exec_env"); ok {
+			envList := make([]map[string]string, 0)
+			for k, v := range env.(map[string]interface{}) {
+				envList = append(envList, map[string]string{"name": k, "value": v.(string)})
+			}
+			exec["env"] = envList
+		}
+		user["exec"] = exec
+	case admin && clusterKeyDetails.Admin != "" && clusterKeyDetails.AdminKey != "":
+		user["client-certificate-data"] = base64.StdEncoding.EncodeToString([]byte(clusterKeyDetails.Admin))
+		user["client-key-data"] = base64.StdEncoding.EncodeToString([]byte(clusterKeyDetails.AdminKey))
+	default:
+		user["token"] = clusterKeyDetails.Token
+	}
+
+	kubeCluster := map[string]interface{}{
+		"server": clusterKeyDetails.Host,
+	}
+	if clusterKeyDetails.ClusterCACertificate != "" {
+		kubeCluster["certificate-authority-data"] = base64.StdEncoding.EncodeToString([]byte(clusterKeyDetails.ClusterCACertificate))
+	}
+
+	kubeconfig := map[string]interface{}{
+		"apiVersion":      "v1",
+		"kind":            "Config",
+		"current-context": contextName,
+		"clusters": []map[string]interface{}{
+			{"name": contextName, "cluster": kubeCluster},
+		},
+		"users": []map[string]interface{}{
+			{"name": contextName, "user": user},
+		},
+		"contexts": []map[string]interface{}{
+			{"name": contextName, "context": map[string]interface{}{"cluster": contextName, "user": contextName}},
+		},
+	}
+	kubeconfigYAML, err := yaml.Marshal(kubeconfig)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error generating the kubeconfig of cluster %s: %s", name, err)
+	}
+
+	d.SetId(cls.ID)
+	d.Set("cluster_type", cls.Type)
+	d.Set("context_name", contextName)
+	d.Set("host", clusterKeyDetails.Host)
+	d.Set("token", clusterKeyDetails.Token)
+	d.Set("ca_certificate", clusterKeyDetails.ClusterCACertificate)
+	d.Set("client_certificate", clusterKeyDetails.Admin)
+	d.Set("client_key", clusterKeyDetails.AdminKey)
+	d.Set("kubeconfig", string(kubeconfigYAML))
+	return nil
+}
+
+// openShiftTokenFetcher is implemented by the containerv2 clusters client, which
+// exchanges the IAM credentials for an OpenShift OAuth token in a kubeconfig.
+type openShiftTokenFetcher interface {
+	FetchOCTokenForKubeConfig(kubecfg []byte, cMeta *v2.ClusterInfo, skipSSLVerification bool, endpointType string) ([]byte, error)
+}
+
+// fetchClusterKubeconfig downloads the kubeconfig archive of the cluster and
+// reads the kubeconfig and certificates from it in memory.
+func fetchClusterKubeconfig(meta interface{}, csAPI v2.Clusters, cls *v2.ClusterInfo, admin bool, target v2.ClusterTargetHeader, endpointType string) (v1.ClusterKeyInfo, error) {
+	clusterKey := v1.ClusterKeyInfo{}
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return clusterKey, err
+	}
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return clusterKey, err
+	}
+
+	body := map[string]interface{}{
+		"cluster": cls.ID,
+		"format":  "zip",
+	}
+	if admin {
+		body["admin"] = true
+	}
+	if cls.Provider == "satellite" {
+		body["endpointType"] = "link"
+		body["admin"] = true
+	} else if endpointType != "" {
+		body["endpointType"] = endpointType
+	}
+
+	builder := core.NewRequestBuilder(core.POST)
+	builder.EnableGzipCompression = satClient.GetEnableGzipCompression()
+	if _, err := builder.ResolveRequestURL(satClient.Service.Options.URL, "/v2/applyRBACAndGetKubeconfig", nil); err != nil {
+		return clusterKey, err
+	}
+	builder.AddHeader("Content-Type", "application/json")
+	builder.AddHeader("X-Auth-Refresh-Token", bxSession.Config.IAMRefreshToken)
+	if target.ResourceGroup != "" {
+		builder.AddHeader("X-Auth-Resource-Group", target.ResourceGroup)
+	}
+	if _, err := builder.SetBodyContentJSON(body); err != nil {
+		return clusterKey, err
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return clusterKey, err
+	}
+	var archive io.ReadCloser
+	if _, err := satClient.Service.Request(request, &archive); err != nil {
+		return clusterKey, err
+	}
+	defer archive.Close()
+	content, err := io.ReadAll(archive)
+	if err != nil {
+		return clusterKey, fmt.Errorf("[ERROR] Error reading the kubeconfig archive: %s", err)
+	}
+
+	zipReader, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return clusterKey, fmt.Errorf("[ERROR] Error opening the kubeconfig archive: %s", err)
+	}
+	var kubeconfig []byte
+	for _, f := range zipReader.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		name := path.Base(f.Name)
+		if !strings.HasSuffix(name, ".yaml") && !strings.HasSuffix(name, ".yml") && !strings.HasSuffix(name, ".pem") {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return clusterKey, err
+		}
+		fileContent, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return clusterKey, err
+		}
+		switch {
+		case name == "admin-key.pem":
+			clusterKey.AdminKey = string(fileContent)
+		case name == "admin.pem":
+			clusterKey.Admin = string(fileContent)
+		case strings.HasPrefix(name, "ca") && strings.HasSuffix(name, ".pem"):
+			clusterKey.ClusterCACertificate = string(fileContent)
+		case strings.HasSuffix(name, ".yaml") || strings.HasSuffix(name, ".yml"):
+			kubeconfig = fileContent
+		}
+	}
+	if kubeconfig == nil {
+		return clusterKey, fmt.Errorf("[ERROR] Unable to locate the kubeconfig in the archive of cluster %s", cls.ID)
+	}
+
+	var yamlConfig v1.ConfigFile
+	if err := yaml.Unmarshal(kubeconfig, &yamlConfig); err != nil {
+		return clusterKey, fmt.Errorf("[ERROR] Error parsing the kubeconfig of cluster %s: %s", cls.ID, err)
+	}
+	if len(yamlConfig.Clusters) != 0 {
+		clusterKey.Host = yamlConfig.Clusters[0].Cluster.Server
+	}
+	if len(yamlConfig.Users) != 0 {
+		clusterKey.Token = yamlConfig.Users[0].User.AuthProvider.Config.IDToken
+	}
+
+	// OpenShift clusters authenticate with an OAuth token instead of the IAM token
+	if cls.Type == "openshift" && cls.Provider != "satellite" {
+		fetcher, ok := csAPI.(openShiftTokenFetcher)
+		if !ok {
+			return clusterKey, fmt.Errorf("[ERROR] The container client can not fetch the OpenShift token of cluster %s", cls.ID)
+		}
+		kubeconfig, err = fetcher.FetchOCTokenForKubeConfig(kubeconfig, cls, cls.IsStagingSatelliteCluster(), endpointType)
+		if err != nil {
+			return clusterKey, err
+		}
+		var openshiftConfig v1.ConfigFileOpenshift
+		if err := yaml.Unmarshal(kubeconfig, &openshiftConfig); err != nil {
+			return clusterKey, fmt.Errorf("[ERROR] Error parsing the OpenShift kubeconfig of cluster %s: %s", cls.ID, err)
+		}
+		for _, usr := range openshiftConfig.Users {
+			if strings.HasPrefix(usr.Name, "IAM") {
+				clusterKey.Token = usr.User.Token
+			}
+		}
+		if len(openshiftConfig.Clusters) != 0 {
+			clusterKey.Host = openshiftConfig.Clusters[0].Cluster.Server
+		}
+		clusterKey.ClusterCACertificate = ""
+	}
+	return clusterKey, nil
+}
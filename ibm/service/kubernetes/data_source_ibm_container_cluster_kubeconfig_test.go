@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMContainer_ClusterKubeconfigDataSourceBasic(t *testing.T) {
+	clusterName := fmt.Sprintf("tf-cluster-kubeconfig-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerClusterKubeconfigDataSource(clusterName, `auth_mode = "token"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_container_cluster_kubeconfig.testacc_ds_kubeconfig", "cluster_type", "kubernetes"),
+					resource.TestCheckResourceAttrSet("data.ibm_container_cluster_kubeconfig.testacc_ds_kubeconfig", "host"),
+					resource.TestCheckResourceAttrSet("data.ibm_container_cluster_kubeconfig.testacc_ds_kubeconfig", "token"),
+					resource.TestMatchResourceAttr(
+						"data.ibm_container_cluster_kubeconfig.testacc_ds_kubeconfig", "kubeconfig", regexp.MustCompile("token: ")),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerClusterKubeconfigDataSource(clusterName, `
+  auth_mode    = "exec"
+  exec_command = "ibmcloud-exec-credential"
+  exec_args    = ["--cluster", ibm_container_vpc_cluster.testacc_cluster.id]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"data.ibm_container_cluster_kubeconfig.testacc_ds_kubeconfig", "kubeconfig", regexp.MustCompile("command: ibmcloud-exec-credential")),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerClusterKubeconfigDataSource(clustername, authConfig string) string {
+	return fmt.Sprintf(`
+resource "ibm_container_vpc_cluster" "testacc_cluster" {
+  name              = "%[1]s"
+  vpc_id            = "%[2]s"
+  flavor            = "bx2.4x16"
+  worker_count      = 1
+  resource_group_id = "%[3]s"
+  zones {
+    subnet_id = "%[4]s"
+    name      = "us-south-1"
+  }
+  wait_till = "Normal"
+}
+
+data "ibm_container_cluster_kubeconfig" "testacc_ds_kubeconfig" {
+  cluster_name_id   = ibm_container_vpc_cluster.testacc_cluster.id
+  resource_group_id = "%[3]s"
+  %[5]s
+}`, clustername, acc.IksClusterVpcID, acc.IksClusterResourceGroupID, acc.IksClusterSubnetID, authConfig)
+}
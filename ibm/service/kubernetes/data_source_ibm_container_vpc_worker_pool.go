@@ -53,6 +53,36 @@ func DataSourceIBMContainerVpcClusterWorkerPool() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"effective_labels": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "All the labels applied to the workers of the worker pool, including the labels set by the system",
+			},
+			"taints": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "WorkerPool Taints",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Key for taint",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Value for taint.",
+						},
+						"effect": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Effect for taint.",
+						},
+					},
+				},
+			},
 			"operating_system": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -177,6 +207,10 @@ func dataSourceIBMContainerVpcClusterWorkerPoolRead(d *schema.ResourceData, meta
 	d.Set("flavor", workerPool.Flavor)
 	d.Set("worker_count", workerPool.WorkerCount)
 	d.Set("labels", workerPool.Labels)
+	d.Set("effective_labels", workerPool.Labels)
+	if workerPool.Taints != nil {
+		d.Set("taints", flattenWorkerPoolTaints(workerPool))
+	}
 	d.Set("operating_system", workerPool.OperatingSystem)
 	d.Set("zones", zones)
 	d.Set("cluster", clusterName)
@@ -101,6 +101,13 @@ func DataSourceIBMContainerWorkerPool() *schema.Resource {
 				Description: "list of labels to worker pool",
 			},
 
+			"effective_labels": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "All the labels applied to the workers of the worker pool, including the labels set by the system",
+			},
+
 			"operating_system": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -171,6 +178,7 @@ func dataSourceIBMContainerWorkerPoolRead(d *schema.ResourceData, meta interface
 	d.Set("state", workerPool.State)
 	if workerPool.Labels != nil {
 		d.Set("labels", workerPool.Labels)
+		d.Set("effective_labels", workerPool.Labels)
 	}
 	d.Set("operating_system", workerPool.OperatingSystem)
 	d.Set("zones", flex.FlattenZones(workerPool.Zones))
@@ -27,6 +27,8 @@ func TestAccIBMContainerWorkerPoolDataSource_basic(t *testing.T) {
 						"data.ibm_container_worker_pool.testacc_ds_worker_pool", "id"),
 					resource.TestCheckResourceAttr(
 						"data.ibm_container_worker_pool.testacc_ds_worker_pool", "autoscale_enabled", "false"),
+					resource.TestCheckResourceAttrSet(
+						"data.ibm_container_worker_pool.testacc_ds_worker_pool", "effective_labels.%"),
 				),
 			},
 		},
@@ -13,6 +13,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	yaml "gopkg.in/yaml.v3"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
@@ -133,17 +134,13 @@ func ResourceIBMContainerAddOns() *schema.Resource {
 							Description: "The add-on options",
 						},
 						"parameters_json": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Default:  "",
-							StateFunc: func(v interface{}) string {
-								json, err := flex.NormalizeJSONString(v)
-								if err != nil {
-									return fmt.Sprintf("%q", err.Error())
-								}
-								return json
-							},
-							Description: "Add-On parameters to pass in a JSON string format.",
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          "",
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: flex.SuppressEquivalentJSON,
+							StateFunc:        flex.NormalizeJSONStateFunc,
+							Description:      "Add-On parameters to pass in a JSON string format.",
 						},
 					},
 				},
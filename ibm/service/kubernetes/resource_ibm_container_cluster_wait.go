@@ -0,0 +1,189 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+const (
+	clusterConditionMasterReady  = "master_ready"
+	clusterConditionWorkersReady = "workers_ready"
+	clusterConditionAddOnsReady  = "addons_ready"
+	clusterConditionHealthy      = "healthy"
+
+	clusterConditionMet     = "met"
+	clusterConditionPending = "pending"
+)
+
+func ResourceIBMContainerClusterWait() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMContainerClusterWaitCreate,
+		Read:   resourceIBMContainerClusterWaitRead,
+		Delete: resourceIBMContainerClusterWaitDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cluster name or ID",
+				ValidateFunc: validate.InvokeValidator(
+					"ibm_container_cluster_wait",
+					"cluster"),
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "ID of the resource group.",
+			},
+			"condition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     clusterConditionHealthy,
+				Description: "Condition to wait for: master_ready, workers_ready, addons_ready or healthy",
+				ValidateFunc: validate.InvokeValidator(
+					"ibm_container_cluster_wait",
+					"condition"),
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that wait for the condition again when they change",
+			},
+			"master_health": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Health of the master once the condition is met",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the cluster once the condition is met",
+			},
+		},
+	}
+}
+
+func ResourceIBMContainerClusterWaitValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cluster",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			CloudDataType:              "cluster",
+			CloudDataRange:             []string{"resolved_to:id"}})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "condition",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              strings.Join([]string{clusterConditionMasterReady, clusterConditionWorkersReady, clusterConditionAddOnsReady, clusterConditionHealthy}, ",")})
+
+	iBMContainerClusterWaitValidator := validate.ResourceValidator{ResourceName: "ibm_container_cluster_wait", Schema: validateSchema}
+	return &iBMContainerClusterWaitValidator
+}
+
+func resourceIBMContainerClusterWaitCreate(d *schema.ResourceData, meta interface{}) error {
+	cluster := d.Get("cluster").(string)
+	condition := d.Get("condition").(string)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{clusterConditionPending},
+		Target:  []string{clusterConditionMet},
+		Refresh: func() (interface{}, string, error) {
+			health, err := getClusterHealth(d, meta, cluster)
+			if err != nil {
+				return nil, "", err
+			}
+			if health.Cluster.Lifecycle.MasterState == "deploy_failed" {
+				return health, "", fmt.Errorf("[ERROR] The master of cluster %s failed to deploy: %s", cluster, health.Cluster.Lifecycle.MasterStatus)
+			}
+			if clusterConditionMatches(health, condition) {
+				return health, clusterConditionMet, nil
+			}
+			log.Printf("[DEBUG] Waiting for cluster %s to be %s, the master is %s and the cluster is %s", cluster, condition, health.Cluster.Lifecycle.MasterStatus, health.Cluster.State)
+			return health, clusterConditionPending, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	result, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for cluster %s to be %s: %s", cluster, condition, err)
+	}
+
+	health := result.(*clusterHealth)
+	d.SetId(fmt.Sprintf("%s/%s", health.Cluster.ID, condition))
+	d.Set("resource_group_id", health.Cluster.ResourceGroupID)
+	d.Set("master_health", health.Cluster.Lifecycle.MasterHealth)
+	d.Set("state", health.Cluster.State)
+
+	return nil
+}
+
+// clusterConditionMatches returns whether the cluster meets the condition
+func clusterConditionMatches(health *clusterHealth, condition string) bool {
+	switch condition {
+	case clusterConditionMasterReady:
+		return health.masterReady()
+	case clusterConditionWorkersReady:
+		return health.masterReady() && health.workersReady()
+	case clusterConditionAddOnsReady:
+		return health.masterReady() && health.addOnsReady()
+	default:
+		return health.healthy()
+	}
+}
+
+func resourceIBMContainerClusterWaitRead(d *schema.ResourceData, meta interface{}) error {
+	// The condition is only waited for once, the resource is removed when the cluster no longer exists
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+
+	_, err = csClient.Clusters().GetCluster(d.Get("cluster").(string), targetEnv)
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			log.Printf("[WARN] The cluster %s is not found, removing the wait from the state", d.Get("cluster"))
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error retrieving cluster %s: %s", d.Get("cluster"), err)
+	}
+
+	return nil
+}
+
+func resourceIBMContainerClusterWaitDelete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
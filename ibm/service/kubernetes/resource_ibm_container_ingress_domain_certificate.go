@@ -0,0 +1,347 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+func ResourceIBMContainerIngressDomainCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceIBMContainerIngressDomainCertificateCreate,
+		Read:          resourceIBMContainerIngressDomainCertificateRead,
+		Update:        resourceIBMContainerIngressDomainCertificateUpdate,
+		Delete:        resourceIBMContainerIngressDomainCertificateDelete,
+		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceIBMContainerIngressDomainCertificateCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cluster ID or name",
+				ValidateFunc: validate.InvokeValidator(
+					"ibm_container_ingress_domain_certificate",
+					"cluster"),
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Ingress domain of the cluster that the certificate is bound to",
+			},
+			"cert_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "CRN of the Secrets Manager certificate",
+				ValidateFunc: validate.InvokeValidator(
+					"ibm_container_ingress_domain_certificate",
+					"cert_crn"),
+			},
+			"secret_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Name of the TLS secret, derived from the domain by default",
+			},
+			"secret_namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "default",
+				ForceNew:    true,
+				Description: "Namespace of the TLS secret",
+			},
+			"persistence": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Persistence of secret",
+			},
+			"sync_on_rotation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Re-sync the TLS secret when the Secrets Manager certificate is rotated",
+			},
+			"expires_on": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiration date of the certificate in the TLS secret",
+			},
+			"cert_expires_on": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiration date of the current version of the Secrets Manager certificate",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Secret Status",
+			},
+			"last_updated_timestamp": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp secret was last updated",
+			},
+		},
+	}
+}
+
+func ResourceIBMContainerIngressDomainCertificateValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cluster",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			CloudDataType:              "cluster",
+			CloudDataRange:             []string{"resolved_to:id"}})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cert_crn",
+			ValidateFunctionIdentifier: validate.ValidateRegexp,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^crn:v1:[^:]+:[^:]+:secrets-manager:[^:]+:[^:]*:[^:]+:secret:[^:]+$`})
+
+	iBMContainerIngressDomainCertificateValidator := validate.ResourceValidator{ResourceName: "ibm_container_ingress_domain_certificate", Schema: validateSchema}
+	return &iBMContainerIngressDomainCertificateValidator
+}
+
+func resourceIBMContainerIngressDomainCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	ingressClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+
+	cluster := d.Get("cluster").(string)
+	domain := d.Get("domain").(string)
+	secretName := ingressDomainSecretName(domain)
+	if v, ok := d.GetOk("secret_name"); ok {
+		secretName = v.(string)
+	}
+
+	params := v2.SecretCreateConfig{
+		Cluster:   cluster,
+		Name:      secretName,
+		Namespace: d.Get("secret_namespace").(string),
+		Type:      "TLS",
+		CRN:       d.Get("cert_crn").(string),
+	}
+	if persistence, ok := d.GetOk("persistence"); ok {
+		params.Persistence = persistence.(bool)
+	}
+
+	ingressAPI := ingressClient.Ingresses()
+	response, err := ingressAPI.CreateIngressSecret(params)
+	if err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s", cluster, response.Name, response.Namespace))
+
+	// The domain of the certificate is known once the secret is created
+	if response.Domain != "" && !ingressDomainMatches(response.Domain, domain) {
+		log.Printf("[DEBUG] Deleting the secret %s, the certificate of domain %s does not match %s", response.Name, response.Domain, domain)
+		if err := ingressAPI.DeleteIngressSecret(v2.SecretDeleteConfig{Cluster: cluster, Name: response.Name, Namespace: response.Namespace}); err != nil {
+			return fmt.Errorf("[ERROR] Error deleting the secret %s of a certificate that does not match domain %s: %s", response.Name, domain, err)
+		}
+		d.SetId("")
+		return fmt.Errorf("[ERROR] The certificate %s is for domain %s and does not match domain %s", params.CRN, response.Domain, domain)
+	}
+
+	return resourceIBMContainerIngressDomainCertificateRead(d, meta)
+}
+
+func resourceIBMContainerIngressDomainCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	ingressClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	if len(parts) < 3 {
+		return fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of cluster/secretName/secretNamespace", d.Id())
+	}
+	cluster := parts[0]
+	secretName := parts[1]
+	secretNamespace := parts[2]
+
+	ingressAPI := ingressClient.Ingresses()
+	ingressSecretConfig, err := ingressAPI.GetIngressSecret(cluster, secretName, secretNamespace)
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error getting ingress secret: %s", err)
+	}
+	if ingressSecretConfig.Status == "deleted" {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("cluster", cluster)
+	d.Set("secret_name", ingressSecretConfig.Name)
+	d.Set("secret_namespace", ingressSecretConfig.Namespace)
+	d.Set("cert_crn", ingressSecretConfig.CRN)
+	d.Set("persistence", ingressSecretConfig.Persistence)
+	d.Set("expires_on", ingressSecretConfig.ExpiresOn)
+	d.Set("status", ingressSecretConfig.Status)
+	d.Set("last_updated_timestamp", ingressSecretConfig.LastUpdatedTimestamp)
+	if _, ok := d.GetOk("domain"); !ok {
+		d.Set("domain", ingressSecretConfig.Domain)
+	}
+
+	if d.Get("sync_on_rotation").(bool) && ingressSecretConfig.CRN != "" {
+		certExpiresOn, err := secretsmanager.GetCertificateExpirationByCRN(meta, ingressSecretConfig.CRN)
+		if err != nil {
+			return err
+		}
+		d.Set("cert_expires_on", secretsmanager.DateTimeToRFC3339(certExpiresOn))
+	}
+
+	return nil
+}
+
+func resourceIBMContainerIngressDomainCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+	ingressClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// The update of the secret with the same CRN re-syncs the secret with the current version of the certificate
+	if d.HasChanges("cert_crn", "expires_on") {
+		params := v2.SecretUpdateConfig{
+			Cluster:   parts[0],
+			Name:      parts[1],
+			Namespace: parts[2],
+			CRN:       d.Get("cert_crn").(string),
+		}
+
+		ingressAPI := ingressClient.Ingresses()
+		if _, err := ingressAPI.UpdateIngressSecret(params); err != nil {
+			return err
+		}
+	}
+
+	return resourceIBMContainerIngressDomainCertificateRead(d, meta)
+}
+
+func resourceIBMContainerIngressDomainCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	ingressClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	params := v2.SecretDeleteConfig{
+		Cluster:   parts[0],
+		Name:      parts[1],
+		Namespace: parts[2],
+	}
+
+	ingressAPI := ingressClient.Ingresses()
+	err = ingressAPI.DeleteIngressSecret(params)
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// resourceIBMContainerIngressDomainCertificateCustomizeDiff plans a re-sync of the TLS secret when the
+// Secrets Manager certificate was rotated since the secret was last synced
+func resourceIBMContainerIngressDomainCertificateCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.Get("sync_on_rotation").(bool) || diff.HasChange("cert_crn") {
+		return nil
+	}
+	certExpiresOn := diff.Get("cert_expires_on").(string)
+	expiresOn := diff.Get("expires_on").(string)
+	if certExpiresOn == "" || expiresOn == "" || ingressSameTime(certExpiresOn, expiresOn) {
+		return nil
+	}
+
+	log.Printf("[DEBUG] The certificate %s expires on %s and the secret expires on %s, re-syncing the secret", diff.Get("cert_crn"), certExpiresOn, expiresOn)
+	if err := diff.SetNewComputed("expires_on"); err != nil {
+		return err
+	}
+	return diff.SetNewComputed("last_updated_timestamp")
+}
+
+// ingressDomainSecretName returns a Kubernetes secret name for the domain, such as "www-example-com" for "www.example.com"
+func ingressDomainSecretName(domain string) string {
+	name := strings.ReplaceAll(strings.TrimPrefix(strings.ToLower(domain), "*."), ".", "-")
+	if len(name) > 63 {
+		name = strings.TrimRight(name[:63], "-")
+	}
+	return name
+}
+
+// ingressDomainMatches returns whether the certificate domain, which can be a wildcard, covers the domain
+func ingressDomainMatches(certDomain, domain string) bool {
+	certDomain = strings.ToLower(certDomain)
+	domain = strings.ToLower(domain)
+	if certDomain == domain {
+		return true
+	}
+	if strings.HasPrefix(certDomain, "*.") {
+		if strings.HasPrefix(domain, "*.") {
+			return false
+		}
+		suffix := certDomain[1:]
+		return strings.HasSuffix(domain, suffix) && !strings.Contains(strings.TrimSuffix(domain, suffix), ".")
+	}
+	return false
+}
+
+// ingressSameTime compares the dates of the secret and of the certificate, the dates that can't be parsed are considered the same
+func ingressSameTime(a, b string) bool {
+	layouts := []string{time.RFC3339, "2006-01-02T15:04:05-0700", "2006-01-02T15:04:05.000Z", "2006-01-02 15:04:05 -0700 MST"}
+	parse := func(value string) (time.Time, bool) {
+		for _, layout := range layouts {
+			if t, err := time.Parse(layout, value); err == nil {
+				return t, true
+			}
+		}
+		return time.Time{}, false
+	}
+	timeA, okA := parse(a)
+	timeB, okB := parse(b)
+	if !okA || !okB {
+		return true
+	}
+	return timeA.Truncate(time.Second).Equal(timeB.Truncate(time.Second))
+}
@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMContainerIngressDomainCertificate_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheckIngressDomainCertificate(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMContainerIngressDomainCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerIngressDomainCertificateBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_domain_certificate.certificate", "cluster", acc.ClusterName),
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_domain_certificate.certificate", "domain", acc.IngressDomain),
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_domain_certificate.certificate", "secret_namespace", "default"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_domain_certificate.certificate", "cert_crn", acc.IngressDomainCertCRN),
+					resource.TestCheckResourceAttrSet(
+						"ibm_container_ingress_domain_certificate.certificate", "secret_name"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_container_ingress_domain_certificate.certificate", "cert_expires_on"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_domain_certificate.certificate", "status", "created"),
+				),
+			},
+			{
+				ResourceName:            "ibm_container_ingress_domain_certificate.certificate",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"domain", "sync_on_rotation"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerIngressDomainCertificateDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_container_ingress_domain_certificate" {
+			continue
+		}
+
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		ingressClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcContainerAPI()
+		if err != nil {
+			return err
+		}
+
+		resp, err := ingressClient.Ingresses().GetIngressSecret(parts[0], parts[1], parts[2])
+		if err == nil && resp.Status == "deleted" {
+			return nil
+		} else if err == nil || !strings.Contains(err.Error(), "404") {
+			return fmt.Errorf("[ERROR] Error checking if secret (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMContainerIngressDomainCertificateBasic() string {
+	return fmt.Sprintf(`
+resource "ibm_container_ingress_domain_certificate" "certificate" {
+  cluster  = "%s"
+  domain   = "%s"
+  cert_crn = "%s"
+}`, acc.ClusterName, acc.IngressDomain, acc.IngressDomainCertCRN)
+}
@@ -0,0 +1,474 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+// observabilityAgentConfig holds the fields shared by the logging and monitoring
+// configurations of a cluster.
+type observabilityAgentConfig struct {
+	AgentKey        string
+	AgentNamespace  string
+	CRN             string
+	DaemonsetName   string
+	DiscoveredAgent bool
+	InstanceID      string
+	InstanceName    string
+	Namespace       string
+	PrivateEndpoint bool
+}
+
+// observabilityAgentAPI configures the agent of one observability service on a
+// cluster through the /v2/observe API of the container service.
+type observabilityAgentAPI interface {
+	create(cluster, instance, ingestionKey string, privateEndpoint bool) (string, error)
+	get(cluster, instance string) (*observabilityAgentConfig, error)
+	update(cluster, instance, newInstance, ingestionKey string, privateEndpoint bool) error
+	delete(cluster, instance string) error
+}
+
+type observabilityAgentAPIFunc func(d *schema.ResourceData, meta interface{}) (observabilityAgentAPI, error)
+
+type loggingAgentAPI struct {
+	api    v2.Logging
+	target v2.LoggingTargetHeader
+}
+
+func newLoggingAgentAPI(d *schema.ResourceData, meta interface{}) (observabilityAgentAPI, error) {
+	client, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+	targetEnv, err := getLoggingTargetHeader(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	return &loggingAgentAPI{api: client.Logging(), target: targetEnv}, nil
+}
+
+func (l *loggingAgentAPI) create(cluster, instance, ingestionKey string, privateEndpoint bool) (string, error) {
+	params := v2.LoggingCreateRequest{
+		Cluster:         cluster,
+		IngestionKey:    ingestionKey,
+		LoggingInstance: instance,
+		PrivateEndpoint: privateEndpoint,
+	}
+	resp, err := l.api.CreateLoggingConfig(params, l.target)
+	return resp.InstanceID, err
+}
+
+func (l *loggingAgentAPI) get(cluster, instance string) (*observabilityAgentConfig, error) {
+	config, err := l.api.GetLoggingConfig(cluster, instance, l.target)
+	if err != nil {
+		return nil, err
+	}
+	return &observabilityAgentConfig{
+		AgentKey:        config.AgentKey,
+		AgentNamespace:  config.AgentNamespace,
+		CRN:             config.CRN,
+		DaemonsetName:   config.DaemonsetName,
+		DiscoveredAgent: config.DiscoveredAgent,
+		InstanceID:      config.InstanceID,
+		InstanceName:    config.InstanceName,
+		Namespace:       config.Namespace,
+		PrivateEndpoint: config.PrivateEndpoint,
+	}, nil
+}
+
+func (l *loggingAgentAPI) update(cluster, instance, newInstance, ingestionKey string, privateEndpoint bool) error {
+	params := v2.LoggingUpdateRequest{
+		Cluster:         cluster,
+		IngestionKey:    ingestionKey,
+		Instance:        instance,
+		NewInstance:     newInstance,
+		PrivateEndpoint: privateEndpoint,
+	}
+	_, err := l.api.UpdateLoggingConfig(params, l.target)
+	return err
+}
+
+func (l *loggingAgentAPI) delete(cluster, instance string) error {
+	params := v2.LoggingDeleteRequest{
+		Cluster:  cluster,
+		Instance: instance,
+	}
+	_, err := l.api.DeleteLoggingConfig(params, l.target)
+	return err
+}
+
+type monitoringAgentAPI struct {
+	api    v2.Monitoring
+	target v2.MonitoringTargetHeader
+}
+
+func newMonitoringAgentAPI(d *schema.ResourceData, meta interface{}) (observabilityAgentAPI, error) {
+	client, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+	targetEnv, err := getMonitoringTargetHeader(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	return &monitoringAgentAPI{api: client.Monitoring(), target: targetEnv}, nil
+}
+
+func (m *monitoringAgentAPI) create(cluster, instance, ingestionKey string, privateEndpoint bool) (string, error) {
+	params := v2.MonitoringCreateRequest{
+		Cluster:         cluster,
+		IngestionKey:    ingestionKey,
+		SysidigInstance: instance,
+		PrivateEndpoint: privateEndpoint,
+	}
+	resp, err := m.api.CreateMonitoringConfig(params, m.target)
+	return resp.InstanceID, err
+}
+
+func (m *monitoringAgentAPI) get(cluster, instance string) (*observabilityAgentConfig, error) {
+	config, err := m.api.GetMonitoringConfig(cluster, instance, m.target)
+	if err != nil {
+		return nil, err
+	}
+	return &observabilityAgentConfig{
+		AgentKey:        config.AgentKey,
+		AgentNamespace:  config.AgentNamespace,
+		CRN:             config.CRN,
+		DaemonsetName:   config.DaemonsetName,
+		DiscoveredAgent: config.DiscoveredAgent,
+		InstanceID:      config.InstanceID,
+		InstanceName:    config.InstanceName,
+		Namespace:       config.Namespace,
+		PrivateEndpoint: config.PrivateEndpoint,
+	}, nil
+}
+
+func (m *monitoringAgentAPI) update(cluster, instance, newInstance, ingestionKey string, privateEndpoint bool) error {
+	params := v2.MonitoringUpdateRequest{
+		Cluster:         cluster,
+		IngestionKey:    ingestionKey,
+		Instance:        instance,
+		NewInstance:     newInstance,
+		PrivateEndpoint: privateEndpoint,
+	}
+	_, err := m.api.UpdateMonitoringConfig(params, m.target)
+	return err
+}
+
+func (m *monitoringAgentAPI) delete(cluster, instance string) error {
+	params := v2.MonitoringDeleteRequest{
+		Cluster:  cluster,
+		Instance: instance,
+	}
+	_, err := m.api.DeleteMonitoringConfig(params, m.target)
+	return err
+}
+
+// ResourceIBMContainerLogsAgent installs the logging agent of an IBM Log Analysis instance on a cluster.
+func ResourceIBMContainerLogsAgent() *schema.Resource {
+	return resourceIBMContainerObservabilityAgent("ibm_container_logs_agent", "IBM Log Analysis", newLoggingAgentAPI)
+}
+
+// ResourceIBMContainerMonitoringAgent installs the monitoring agent of an IBM Cloud Monitoring instance on a cluster.
+func ResourceIBMContainerMonitoringAgent() *schema.Resource {
+	return resourceIBMContainerObservabilityAgent("ibm_container_monitoring_agent", "IBM Cloud Monitoring", newMonitoringAgentAPI)
+}
+
+func resourceIBMContainerObservabilityAgent(resourceName, serviceName string, newAPI observabilityAgentAPIFunc) *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMContainerObservabilityAgentCreate(serviceName, newAPI),
+		Read:     resourceIBMContainerObservabilityAgentRead(newAPI),
+		Update:   resourceIBMContainerObservabilityAgentUpdate(newAPI),
+		Delete:   resourceIBMContainerObservabilityAgentDelete(newAPI),
+		Importer: &schema.ResourceImporter{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(45 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cluster Name or ID",
+				ValidateFunc: validate.InvokeValidator(
+					resourceName,
+					"cluster"),
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "ID of the resource group.",
+			},
+			"instance_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: fmt.Sprintf("CRN of the %s instance the agent sends its data to", serviceName),
+			},
+			"ingestion_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"ingestion_key_secret_crn"},
+				Description:   fmt.Sprintf("Ingestion key of the %s instance", serviceName),
+			},
+			"ingestion_key_secret_crn": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"ingestion_key"},
+				Description:   "CRN of the Secrets Manager secret that holds the ingestion key",
+			},
+			"private_endpoint": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Send the data over the private endpoint of the instance",
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("ID of the %s instance", serviceName),
+			},
+			"instance_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Name of the %s instance", serviceName),
+			},
+			"daemonset_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the daemon set that runs the agent",
+			},
+			"agent_namespace": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Namespace the agent is deployed in",
+			},
+			"discovered_agent": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the agent was discovered on the cluster instead of deployed by the configuration",
+			},
+		},
+	}
+}
+
+func ResourceIBMContainerLogsAgentValidator() *validate.ResourceValidator {
+	return resourceIBMContainerObservabilityAgentValidator("ibm_container_logs_agent")
+}
+
+func ResourceIBMContainerMonitoringAgentValidator() *validate.ResourceValidator {
+	return resourceIBMContainerObservabilityAgentValidator("ibm_container_monitoring_agent")
+}
+
+func resourceIBMContainerObservabilityAgentValidator(resourceName string) *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "cluster",
+			ValidateFunctionIdentifier: validate.ValidateCloudData,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			CloudDataType:              "cluster",
+			CloudDataRange:             []string{"resolved_to:id"}})
+
+	iBMContainerObservabilityAgentValidator := validate.ResourceValidator{ResourceName: resourceName, Schema: validateSchema}
+	return &iBMContainerObservabilityAgentValidator
+}
+
+// observabilityInstanceID returns the GUID of a service instance CRN, which is
+// the instance ID the observe API expects.
+func observabilityInstanceID(instanceCRN string) (string, error) {
+	parts := strings.Split(instanceCRN, ":")
+	if len(parts) < 8 || parts[7] == "" {
+		return "", fmt.Errorf("[ERROR] Incorrect instance CRN %s", instanceCRN)
+	}
+	return parts[7], nil
+}
+
+func getObservabilityAgentIngestionKey(d *schema.ResourceData, meta interface{}) (string, error) {
+	if secretCRN, ok := d.GetOk("ingestion_key_secret_crn"); ok {
+		return secretsmanager.GetSecretValueByCRN(meta, secretCRN.(string))
+	}
+	if key, ok := d.GetOk("ingestion_key"); ok {
+		return key.(string), nil
+	}
+	return "", fmt.Errorf("[ERROR] One of ingestion_key or ingestion_key_secret_crn must be set")
+}
+
+func resourceIBMContainerObservabilityAgentCreate(serviceName string, newAPI observabilityAgentAPIFunc) schema.CreateFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		agentAPI, err := newAPI(d, meta)
+		if err != nil {
+			return err
+		}
+		cluster := d.Get("cluster").(string)
+		instanceID, err := observabilityInstanceID(d.Get("instance_crn").(string))
+		if err != nil {
+			return err
+		}
+		ingestionKey, err := getObservabilityAgentIngestionKey(d, meta)
+		if err != nil {
+			return err
+		}
+		privateEndpoint := d.Get("private_endpoint").(bool)
+
+		_, err = waitForClusterIntegration(d, meta, cluster)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error waiting for master node to be availabe before integrating the %s instance: %s", serviceName, err)
+		}
+
+		var configuredID string
+		err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			var err error
+			configuredID, err = agentAPI.create(cluster, instanceID, ingestionKey, privateEndpoint)
+			if err != nil {
+				log.Printf("[DEBUG] %s agent err %s", serviceName, err)
+				if strings.Contains(err.Error(), "The user doesn't have enough privileges to perform this action") || strings.Contains(err.Error(), "A logging or monitoring configuration for this cluster already exists. To use a different configuration, delete the existing configuration and try again") {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if conns.IsResourceTimeoutError(err) {
+			configuredID, err = agentAPI.create(cluster, instanceID, ingestionKey, privateEndpoint)
+		}
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error configuring the %s agent on cluster %s: %s", serviceName, cluster, err)
+		}
+		if configuredID != "" {
+			instanceID = configuredID
+		}
+		d.SetId(fmt.Sprintf("%s/%s", cluster, instanceID))
+
+		return resourceIBMContainerObservabilityAgentRead(newAPI)(d, meta)
+	}
+}
+
+func resourceIBMContainerObservabilityAgentRead(newAPI observabilityAgentAPIFunc) schema.ReadFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		parts, err := flex.IdParts(d.Id())
+		if err != nil {
+			return err
+		}
+		if len(parts) < 2 {
+			return fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of clusterNameorID/instanceID", d.Id())
+		}
+		cluster := parts[0]
+		instanceID := parts[1]
+
+		agentAPI, err := newAPI(d, meta)
+		if err != nil {
+			return err
+		}
+		config, err := agentAPI.get(cluster, instanceID)
+		if err != nil {
+			if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+				log.Printf("[WARN] The agent of instance %s is not configured on cluster %s, removing it from state", instanceID, cluster)
+				d.SetId("")
+				return nil
+			}
+			return fmt.Errorf("[ERROR] Error getting the agent configuration of instance %s on cluster %s: %s", instanceID, cluster, err)
+		}
+
+		d.Set("cluster", cluster)
+		if config.CRN != "" {
+			d.Set("instance_crn", config.CRN)
+		}
+		d.Set("instance_id", config.InstanceID)
+		d.Set("instance_name", config.InstanceName)
+		d.Set("private_endpoint", config.PrivateEndpoint)
+		d.Set("daemonset_name", config.DaemonsetName)
+		d.Set("agent_namespace", config.AgentNamespace)
+		d.Set("discovered_agent", config.DiscoveredAgent)
+		return nil
+	}
+}
+
+func resourceIBMContainerObservabilityAgentUpdate(newAPI observabilityAgentAPIFunc) schema.UpdateFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		if !d.HasChanges("instance_crn", "ingestion_key", "ingestion_key_secret_crn", "private_endpoint") {
+			return resourceIBMContainerObservabilityAgentRead(newAPI)(d, meta)
+		}
+
+		parts, err := flex.IdParts(d.Id())
+		if err != nil {
+			return err
+		}
+		cluster := parts[0]
+		instanceID := parts[1]
+
+		agentAPI, err := newAPI(d, meta)
+		if err != nil {
+			return err
+		}
+		ingestionKey, err := getObservabilityAgentIngestionKey(d, meta)
+		if err != nil {
+			return err
+		}
+
+		newInstanceID := ""
+		if d.HasChange("instance_crn") {
+			newInstanceID, err = observabilityInstanceID(d.Get("instance_crn").(string))
+			if err != nil {
+				return err
+			}
+		}
+		err = agentAPI.update(cluster, instanceID, newInstanceID, ingestionKey, d.Get("private_endpoint").(bool))
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error updating the agent configuration of instance %s on cluster %s: %s", instanceID, cluster, err)
+		}
+		if newInstanceID != "" {
+			d.SetId(fmt.Sprintf("%s/%s", cluster, newInstanceID))
+		}
+
+		return resourceIBMContainerObservabilityAgentRead(newAPI)(d, meta)
+	}
+}
+
+func resourceIBMContainerObservabilityAgentDelete(newAPI observabilityAgentAPIFunc) schema.DeleteFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		parts, err := flex.IdParts(d.Id())
+		if err != nil {
+			return err
+		}
+		cluster := parts[0]
+		instanceID := parts[1]
+
+		agentAPI, err := newAPI(d, meta)
+		if err != nil {
+			return err
+		}
+		err = agentAPI.delete(cluster, instanceID)
+		if err != nil {
+			if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+				d.SetId("")
+				return nil
+			}
+			return fmt.Errorf("[ERROR] Error removing the agent configuration of instance %s from cluster %s: %s", instanceID, cluster, err)
+		}
+
+		d.SetId("")
+		return nil
+	}
+}
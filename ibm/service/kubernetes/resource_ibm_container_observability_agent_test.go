@@ -0,0 +1,129 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMContainerMonitoringAgent_Basic(t *testing.T) {
+	instanceName := fmt.Sprintf("tf-monitoring-agent-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerMonitoringAgentBasic(instanceName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_monitoring_agent.agent", "private_endpoint", "false"),
+					resource.TestCheckResourceAttrPair(
+						"ibm_container_monitoring_agent.agent", "instance_id", "ibm_resource_instance.instance", "guid"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_container_monitoring_agent.agent", "daemonset_name"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerMonitoringAgentBasic(instanceName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_monitoring_agent.agent", "private_endpoint", "true"),
+				),
+			},
+			{
+				ResourceName:            "ibm_container_monitoring_agent.agent",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ingestion_key"},
+			},
+		},
+	})
+}
+
+func TestAccIBMContainerLogsAgent_Basic(t *testing.T) {
+	instanceName := fmt.Sprintf("tf-logs-agent-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerLogsAgentBasic(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"ibm_container_logs_agent.agent", "instance_crn", "ibm_resource_instance.instance", "crn"),
+					resource.TestCheckResourceAttrPair(
+						"ibm_container_logs_agent.agent", "instance_id", "ibm_resource_instance.instance", "guid"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_container_logs_agent.agent", "daemonset_name"),
+				),
+			},
+			{
+				ResourceName:            "ibm_container_logs_agent.agent",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ingestion_key_secret_crn"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerMonitoringAgentBasic(instanceName string, privateEndpoint bool) string {
+	return fmt.Sprintf(`
+resource "ibm_resource_instance" "instance" {
+  name     = "%[1]s"
+  service  = "sysdig-monitor"
+  plan     = "graduated-tier"
+  location = "us-south"
+}
+
+resource "ibm_resource_key" "key" {
+  name                 = "%[1]s"
+  resource_instance_id = ibm_resource_instance.instance.id
+  role                 = "Manager"
+}
+
+resource "ibm_container_monitoring_agent" "agent" {
+  cluster          = "%[2]s"
+  instance_crn     = ibm_resource_instance.instance.crn
+  ingestion_key    = ibm_resource_key.key.credentials["Sysdig Access Key"]
+  private_endpoint = %[3]t
+}`, instanceName, acc.ClusterName, privateEndpoint)
+}
+
+func testAccCheckIBMContainerLogsAgentBasic(instanceName string) string {
+	return fmt.Sprintf(`
+resource "ibm_resource_instance" "instance" {
+  name     = "%[1]s"
+  service  = "logdna"
+  plan     = "7-day"
+  location = "us-south"
+}
+
+resource "ibm_resource_key" "key" {
+  name                 = "%[1]s"
+  resource_instance_id = ibm_resource_instance.instance.id
+  role                 = "Manager"
+}
+
+resource "ibm_sm_arbitrary_secret" "ingestion_key" {
+  instance_id = "%[3]s"
+  region      = "%[4]s"
+  name        = "%[1]s-ingestion-key"
+  payload     = ibm_resource_key.key.credentials["ingestion_key"]
+}
+
+resource "ibm_container_logs_agent" "agent" {
+  cluster                  = "%[2]s"
+  instance_crn             = ibm_resource_instance.instance.crn
+  ingestion_key_secret_crn = ibm_sm_arbitrary_secret.ingestion_key.crn
+}`, instanceName, acc.ClusterName, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
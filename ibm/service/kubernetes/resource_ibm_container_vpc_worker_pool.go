@@ -35,6 +35,7 @@ func ResourceIBMContainerVpcWorkerPool() *schema.Resource {
 		Importer: &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
 			Delete: schema.DefaultTimeout(90 * time.Minute),
 		},
 
@@ -125,6 +126,13 @@ func ResourceIBMContainerVpcWorkerPool() *schema.Resource {
 				},
 			},
 
+			"replace_workers_on_update": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Replace the existing workers of the worker pool one at a time after labels or taints are updated",
+			},
+
 			"resource_group_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -389,6 +397,16 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
+	if d.HasChanges("labels", "taints") && d.Get("replace_workers_on_update").(bool) {
+		targetEnv, err := getVpcClusterTargetHeader(d, meta)
+		if err != nil {
+			return err
+		}
+		if err := replaceVpcWorkerPoolWorkers(d, meta, clusterNameOrID, workerPoolName, targetEnv); err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("worker_count") {
 		clusterNameOrID := d.Get("cluster").(string)
 		workerPoolName := d.Get("worker_pool_name").(string)
@@ -474,6 +492,70 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 	return resourceIBMContainerVpcWorkerPoolRead(d, meta)
 }
 
+// replaceVpcWorkerPoolWorkers replaces the workers of a worker pool one at a time so that
+// the workloads are rescheduled onto nodes provisioned with the current labels and taints.
+// Every replacement worker has to be deployed and normal before the next worker is replaced.
+func replaceVpcWorkerPoolWorkers(d *schema.ResourceData, meta interface{}, clusterNameOrID, workerPoolName string, targetEnv v2.ClusterTargetHeader) error {
+	wpClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	workers, err := wpClient.Workers().ListByWorkerPool(clusterNameOrID, workerPoolName, false, targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving workers of worker pool (%s): %s", workerPoolName, err)
+	}
+	known := make(map[string]bool, len(workers))
+	for _, worker := range workers {
+		known[worker.ID] = true
+	}
+	for _, worker := range workers {
+		log.Printf("[INFO] Replacing worker %s of worker pool %s", worker.ID, workerPoolName)
+		_, err = wpClient.Workers().ReplaceWokerNode(clusterNameOrID, worker.ID, targetEnv)
+		// As API returns http response 204 NO CONTENT, error raised will be exempted.
+		if err != nil && !strings.Contains(err.Error(), "EmptyResponseBody") {
+			return fmt.Errorf("[ERROR] Error replacing the worker %s of worker pool (%s): %s", worker.ID, workerPoolName, err)
+		}
+		newWorker, err := waitForVpcReplacementWorker(wpClient.Workers(), clusterNameOrID, workerPoolName, known, d.Timeout(schema.TimeoutUpdate), targetEnv)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error waiting for the replacement of worker %s of worker pool (%s) to become ready: %s", worker.ID, workerPoolName, err)
+		}
+		newWorkerID := newWorker.(v2.Worker).ID
+		known[newWorkerID] = true
+		log.Printf("[INFO] Replaced worker %s of worker pool %s with %s", worker.ID, workerPoolName, newWorkerID)
+	}
+	return nil
+}
+
+// waitForVpcReplacementWorker waits for a worker of the pool that is not yet known to be
+// deployed and normal, and returns it.
+func waitForVpcReplacementWorker(workersAPI v2.Workers, clusterNameOrID, workerPoolName string, known map[string]bool, timeout time.Duration, target v2.ClusterTargetHeader) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"creating", workerProvisioning},
+		Target:  []string{workerNormal},
+		Refresh: func() (interface{}, string, error) {
+			workers, err := workersAPI.ListByWorkerPool(clusterNameOrID, workerPoolName, false, target)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error retrieving workers of worker pool (%s): %s", workerPoolName, err)
+			}
+			for _, worker := range workers {
+				if known[worker.ID] {
+					continue
+				}
+				if worker.LifeCycle.ActualState == clusterDeployed && worker.Health.State == workerNormal {
+					return worker, workerNormal, nil
+				}
+				return worker, workerProvisioning, nil
+			}
+			return workers, "creating", nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
 func updateWorkerpoolTaints(d *schema.ResourceData, meta interface{}, clusterNameOrID string, workerPoolName string, taints []interface{}) error {
 
 	taintParam := expandWorkerPoolTaints(clusterNameOrID, workerPoolName, taints)
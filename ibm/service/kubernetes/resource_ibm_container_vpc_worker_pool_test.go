@@ -50,9 +50,10 @@ func TestAccIBMContainerVpcClusterWorkerPoolBasic(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "ibm_container_vpc_worker_pool.test_pool",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "ibm_container_vpc_worker_pool.test_pool",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"replace_workers_on_update"},
 			},
 		},
 	})
@@ -0,0 +1,451 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+const (
+	workerSurgeProvisioning = "provisioning"
+	workerSurgeProvisioned  = "provisioned"
+)
+
+var (
+	kubeVersionNumbers = regexp.MustCompile(`\d+`)
+	// The IDs of the workers end with a sequence number of the cluster, for example
+	// kube-c5ndr7qd0aqhmbd0lbsg-mycluster-default-000001b2, which orders the workers by creation.
+	workerIDSequence = regexp.MustCompile(`-([0-9a-f]{8})$`)
+)
+
+func ResourceIBMContainerVpcWorkerReplace() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMContainerVpcWorkerReplaceCreate,
+		Read:   resourceIBMContainerVpcWorkerReplaceRead,
+		Delete: resourceIBMContainerVpcWorkerReplaceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cluster name or ID",
+			},
+			"worker_pool": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name or ID of the worker pool whose workers are replaced",
+			},
+			"workers": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"workers", "replace_count"},
+				Description:  "IDs of the workers to replace",
+			},
+			"replace_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				ExactlyOneOf: []string{"workers", "replace_count"},
+				Description:  "Number of workers to replace, the oldest workers first",
+			},
+			"surge": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Create the replacement workers before the old workers are removed. When false, workers are replaced in place one at a time",
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ID of the resource group.",
+			},
+			"replaced_workers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the workers that were removed",
+			},
+			"new_workers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the workers that were created",
+			},
+			"progress": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Number of replaced workers out of the number of workers selected for replacement",
+			},
+		},
+	}
+}
+
+func resourceIBMContainerVpcWorkerReplaceCreate(d *schema.ResourceData, meta interface{}) error {
+	wkClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+
+	clusterNameorID := d.Get("cluster_name").(string)
+	workerPool := d.Get("worker_pool").(string)
+
+	pool, err := wkClient.WorkerPools().GetWorkerPool(clusterNameorID, workerPool, targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving worker pool %s of cluster %s: %s", workerPool, clusterNameorID, err)
+	}
+	poolWorkers, err := wkClient.Workers().ListByWorkerPool(clusterNameorID, pool.ID, false, targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving workers of worker pool %s: %s", workerPool, err)
+	}
+
+	targets, err := selectWorkersToReplace(d, poolWorkers)
+	if err != nil {
+		return err
+	}
+	initialIDs := make(map[string]bool, len(poolWorkers))
+	for _, w := range poolWorkers {
+		initialIDs[w.ID] = true
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", clusterNameorID, pool.ID))
+
+	var replaced, created []string
+	defer func() {
+		d.Set("replaced_workers", replaced)
+		d.Set("new_workers", created)
+		d.Set("progress", fmt.Sprintf("%d/%d", len(replaced), len(targets)))
+	}()
+	// The new workers are the workers of the pool that were not there before the replacement,
+	// listed once the replacement is complete
+	listNewWorkers := func() error {
+		workers, err := wkClient.Workers().ListByWorkerPool(clusterNameorID, pool.ID, false, targetEnv)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error retrieving workers of worker pool %s: %s", workerPool, err)
+		}
+		created = nil
+		for _, w := range workers {
+			if !initialIDs[w.ID] {
+				created = append(created, w.ID)
+			}
+		}
+		return nil
+	}
+
+	if !d.Get("surge").(bool) {
+		for _, worker := range targets {
+			newWorkerID, err := replaceVpcWorkerInPlace(d, meta, targetEnv, worker.ID)
+			if err != nil {
+				return err
+			}
+			replaced = append(replaced, worker.ID)
+			created = append(created, newWorkerID)
+			log.Printf("[INFO] Replaced worker %s with %s (%d/%d)", worker.ID, newWorkerID, len(replaced), len(targets))
+		}
+		if err := listNewWorkers(); err != nil {
+			return err
+		}
+		return resourceIBMContainerVpcWorkerReplaceRead(d, meta)
+	}
+
+	v1Client, err := meta.(conns.ClientSession).ContainerAPI()
+	if err != nil {
+		return err
+	}
+	v1Env := v1.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
+	sizePerZone := pool.WorkerCount
+
+	// Every round adds one worker per zone, waits for it to become healthy and only then
+	// removes up to one selected worker per zone. In the zones without a selected worker the
+	// surge worker itself is removed, so that the pool is back to its size before it is resized
+	// and the service does not pick the workers to remove.
+	remaining := targets
+	for len(remaining) > 0 {
+		var round []v2.Worker
+		round, remaining = nextSurgeRound(remaining)
+
+		existing, err := wkClient.Workers().ListByWorkerPool(clusterNameorID, pool.ID, false, targetEnv)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error retrieving workers of worker pool %s: %s", workerPool, err)
+		}
+		existingIDs := make(map[string]bool, len(existing))
+		for _, w := range existing {
+			existingIDs[w.ID] = true
+		}
+
+		err = v1Client.WorkerPools().ResizeWorkerPool(clusterNameorID, pool.ID, sizePerZone+1, v1Env)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error adding surge workers to worker pool %s: %s", workerPool, err)
+		}
+		surgeWorkers, err := waitForVpcSurgeWorkers(d, meta, targetEnv, pool.ID, existingIDs, len(pool.Zones))
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error waiting for surge workers of worker pool %s: %s", workerPool, err)
+		}
+		for _, w := range surgeWorkers {
+			created = append(created, w.ID)
+		}
+
+		for _, worker := range round {
+			if err = deleteVpcSurgeRoundWorker(d, meta, v1Client, v1Env, targetEnv, worker.ID); err != nil {
+				return err
+			}
+			replaced = append(replaced, worker.ID)
+			log.Printf("[INFO] Replaced worker %s (%d/%d)", worker.ID, len(replaced), len(targets))
+		}
+		for _, worker := range surplusSurgeWorkers(round, surgeWorkers) {
+			if err = deleteVpcSurgeRoundWorker(d, meta, v1Client, v1Env, targetEnv, worker.ID); err != nil {
+				return err
+			}
+			log.Printf("[INFO] Removed surge worker %s of zone %s without workers to replace", worker.ID, worker.Location)
+		}
+
+		err = v1Client.WorkerPools().ResizeWorkerPool(clusterNameorID, pool.ID, sizePerZone, v1Env)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error restoring the size of worker pool %s to %d: %s", workerPool, sizePerZone, err)
+		}
+	}
+
+	if err := listNewWorkers(); err != nil {
+		return err
+	}
+	return resourceIBMContainerVpcWorkerReplaceRead(d, meta)
+}
+
+// deleteVpcSurgeRoundWorker removes a worker by ID and waits for it to be deleted.
+func deleteVpcSurgeRoundWorker(d *schema.ResourceData, meta interface{}, v1Client v1.ContainerServiceAPI, v1Env v1.ClusterTargetHeader, targetEnv v2.ClusterTargetHeader, workerID string) error {
+	err := v1Client.Workers().Delete(d.Get("cluster_name").(string), workerID, v1Env)
+	if err != nil && !strings.Contains(err.Error(), "EmptyResponseBody") {
+		return fmt.Errorf("[ERROR] Error removing worker %s: %s", workerID, err)
+	}
+	if _, err = waitForVpcWorkerNodetoDelete(d, meta, targetEnv, workerID); err != nil {
+		return fmt.Errorf("[ERROR] Worker node - %s is failed to delete: %s", workerID, err)
+	}
+	return nil
+}
+
+// surplusSurgeWorkers returns the surge workers of the zones in which no worker of the round is
+// replaced.
+func surplusSurgeWorkers(round, surgeWorkers []v2.Worker) []v2.Worker {
+	zones := make(map[string]bool, len(round))
+	for _, w := range round {
+		zones[w.Location] = true
+	}
+	var surplus []v2.Worker
+	for _, w := range surgeWorkers {
+		if !zones[w.Location] {
+			surplus = append(surplus, w)
+		}
+	}
+	return surplus
+}
+
+func resourceIBMContainerVpcWorkerReplaceRead(d *schema.ResourceData, meta interface{}) error {
+	// The replacement is a one time action, the workers are managed by the worker pool.
+	return nil
+}
+
+func resourceIBMContainerVpcWorkerReplaceDelete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+// selectWorkersToReplace returns the workers named in "workers", or the "replace_count"
+// oldest workers of the pool.
+func selectWorkersToReplace(d *schema.ResourceData, poolWorkers []v2.Worker) ([]v2.Worker, error) {
+	if v, ok := d.GetOk("workers"); ok {
+		byID := make(map[string]v2.Worker, len(poolWorkers))
+		for _, w := range poolWorkers {
+			byID[w.ID] = w
+		}
+		var targets []v2.Worker
+		for _, id := range flex.ExpandStringList(v.(*schema.Set).List()) {
+			w, found := byID[id]
+			if !found {
+				return nil, fmt.Errorf("[ERROR] Worker %s is not part of worker pool %s", id, d.Get("worker_pool").(string))
+			}
+			targets = append(targets, w)
+		}
+		return targets, nil
+	}
+
+	count := d.Get("replace_count").(int)
+	if count > len(poolWorkers) {
+		return nil, fmt.Errorf("[ERROR] replace_count %d is larger than the number of workers (%d) in worker pool %s", count, len(poolWorkers), d.Get("worker_pool").(string))
+	}
+	sorted := make([]v2.Worker, len(poolWorkers))
+	copy(sorted, poolWorkers)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return compareWorkerAge(sorted[i], sorted[j]) < 0
+	})
+	return sorted[:count], nil
+}
+
+// compareWorkerAge orders the workers by creation with the sequence number of their IDs. The
+// workers without a sequence number are ordered by kube version after the others.
+func compareWorkerAge(a, b v2.Worker) int {
+	as, aok := workerSequence(a.ID)
+	bs, bok := workerSequence(b.ID)
+	switch {
+	case aok && bok && as != bs:
+		if as < bs {
+			return -1
+		}
+		return 1
+	case aok && !bok:
+		return -1
+	case !aok && bok:
+		return 1
+	}
+	return compareKubeVersions(a.KubeVersion.Actual, b.KubeVersion.Actual)
+}
+
+func workerSequence(workerID string) (uint64, bool) {
+	match := workerIDSequence.FindStringSubmatch(workerID)
+	if match == nil {
+		return 0, false
+	}
+	sequence, err := strconv.ParseUint(match[1], 16, 64)
+	if err != nil {
+		return 0, false
+	}
+	return sequence, true
+}
+
+// nextSurgeRound picks at most one worker per zone for the next surge round.
+func nextSurgeRound(workers []v2.Worker) (round, rest []v2.Worker) {
+	zones := make(map[string]bool)
+	for _, w := range workers {
+		if zones[w.Location] {
+			rest = append(rest, w)
+			continue
+		}
+		zones[w.Location] = true
+		round = append(round, w)
+	}
+	return round, rest
+}
+
+// compareKubeVersions compares versions such as 1.27.5_1536 numerically.
+func compareKubeVersions(a, b string) int {
+	as := kubeVersionNumbers.FindAllString(a, -1)
+	bs := kubeVersionNumbers.FindAllString(b, -1)
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		an, _ := strconv.Atoi(as[i])
+		bn, _ := strconv.Atoi(bs[i])
+		if an != bn {
+			if an < bn {
+				return -1
+			}
+			return 1
+		}
+	}
+	return len(as) - len(bs)
+}
+
+func replaceVpcWorkerInPlace(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workerID string) (string, error) {
+	wkClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return "", err
+	}
+	clusterNameorID := d.Get("cluster_name").(string)
+
+	workers, err := wkClient.Workers().ListWorkers(clusterNameorID, false, targetEnv)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+	}
+	workersInfo := make(map[string]int)
+	for index, _worker := range workers {
+		workersInfo[_worker.ID] = index
+	}
+
+	_, err = wkClient.Workers().ReplaceWokerNode(clusterNameorID, workerID, targetEnv)
+	// As API returns http response 204 NO CONTENT, error raised will be exempted.
+	if err != nil && !strings.Contains(err.Error(), "EmptyResponseBody") {
+		return "", fmt.Errorf("[ERROR] Error replacing the worker node from the cluster: %s", err)
+	}
+	if _, err = waitForVpcWorkerNodetoDelete(d, meta, targetEnv, workerID); err != nil {
+		return "", fmt.Errorf("[ERROR] Worker node - %s is failed to replace", workerID)
+	}
+	if _, err = waitForNewVpcWorker(d, meta, targetEnv, len(workers)); err != nil {
+		return "", fmt.Errorf("[ERROR] Failed to spawn new worker node")
+	}
+	newWorkerID, _, err := getNewVpcWorkerID(d, meta, targetEnv, workersInfo)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Unable to find the new worker node info")
+	}
+	return newWorkerID, nil
+}
+
+// waitForVpcSurgeWorkers waits until every zone of the pool got a new healthy
+// worker and returns the new workers.
+func waitForVpcSurgeWorkers(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, poolID string, existing map[string]bool, zoneCount int) ([]v2.Worker, error) {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+
+	clusterID := d.Get("cluster_name").(string)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{workerSurgeProvisioning},
+		Target:  []string{workerSurgeProvisioned},
+		Refresh: func() (interface{}, string, error) {
+			workers, err := csClient.Workers().ListByWorkerPool(clusterID, poolID, false, targetEnv)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error in retriving the list of worker nodes: %s", err)
+			}
+			var newWorkers []v2.Worker
+			for _, w := range workers {
+				if existing[w.ID] {
+					continue
+				}
+				if w.Health.State != workerNormal {
+					return workers, workerSurgeProvisioning, nil
+				}
+				newWorkers = append(newWorkers, w)
+			}
+			if len(newWorkers) < zoneCount {
+				return workers, workerSurgeProvisioning, nil
+			}
+			return newWorkers, workerSurgeProvisioned, nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        10 * time.Second,
+		MinTimeout:   10 * time.Second,
+		PollInterval: 30 * time.Second,
+	}
+	newWorkers, err := stateConf.WaitForState()
+	if err != nil {
+		return nil, err
+	}
+	return newWorkers.([]v2.Worker), nil
+}
@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+)
+
+func TestAccIBMContainerVpcWorkerReplaceSurge(t *testing.T) {
+	name := fmt.Sprintf("tf-vpc-worker-replace-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerVpcWorkerReplaceConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_container_vpc_worker_replace.replace", "progress", "1/1"),
+					resource.TestCheckResourceAttr("ibm_container_vpc_worker_replace.replace", "replaced_workers.#", "1"),
+					testAccCheckIBMContainerVpcWorkerReplaced("ibm_container_vpc_worker_replace.replace"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerVpcWorkerReplaced(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		wkClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcContainerAPI()
+		if err != nil {
+			return err
+		}
+		cluster := rs.Primary.Attributes["cluster_name"]
+		replaced := rs.Primary.Attributes["replaced_workers.0"]
+		worker, err := wkClient.Workers().Get(cluster, replaced, v2.ClusterTargetHeader{})
+		if err == nil && worker.LifeCycle.ActualState != "deleted" {
+			return fmt.Errorf("[ERROR] Worker %s was not removed", replaced)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMContainerVpcWorkerReplaceConfig(name string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "resource_group" {
+		is_default = true
+	}
+
+	resource "ibm_is_vpc" "vpc" {
+		name = "%[1]s"
+	}
+
+	resource "ibm_is_subnet" "subnet1" {
+		name                     = "%[1]s-1"
+		vpc                      = ibm_is_vpc.vpc.id
+		zone                     = "us-south-1"
+		total_ipv4_address_count = 256
+	}
+
+	resource "ibm_container_vpc_cluster" "cluster" {
+		name              = "%[1]s"
+		vpc_id            = ibm_is_vpc.vpc.id
+		flavor            = "cx2.2x4"
+		worker_count      = 2
+		resource_group_id = data.ibm_resource_group.resource_group.id
+		zones {
+			subnet_id = ibm_is_subnet.subnet1.id
+			name      = "us-south-1"
+		}
+	}
+
+	resource "ibm_container_vpc_worker_replace" "replace" {
+		cluster_name      = ibm_container_vpc_cluster.cluster.id
+		worker_pool       = "default"
+		replace_count     = 1
+		resource_group_id = data.ibm_resource_group.resource_group.id
+	}
+	`, name)
+}
@@ -5,6 +5,7 @@ package kubernetes
 
 import (
 	"fmt"
+	"log"
 	"strings"
 	"time"
 
@@ -170,6 +171,13 @@ func ResourceIBMContainerWorkerPool() *schema.Resource {
 				},
 			},
 
+			"reload_workers_on_update": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Reload the existing workers of the worker pool after labels or taints are updated",
+			},
+
 			"region": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -406,9 +414,78 @@ func resourceIBMContainerWorkerPoolUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
+	if d.HasChanges("labels", "taints") && d.Get("reload_workers_on_update").(bool) {
+		if err := reloadWorkerPoolWorkers(csClient.Workers(), clusterNameorID, workerPoolNameorID, d.Timeout(schema.TimeoutUpdate), targetEnv); err != nil {
+			return err
+		}
+	}
+
 	return resourceIBMContainerWorkerPoolRead(d, meta)
 }
 
+// reloadWorkerPoolWorkers reloads the workers of a classic worker pool one at a time so that the
+// updated labels and taints are applied from a clean node state. Every worker has to be healthy
+// again before the next one is reloaded.
+func reloadWorkerPoolWorkers(workersAPI v1.Workers, clusterNameOrID, workerPoolNameOrID string, timeout time.Duration, target v1.ClusterTargetHeader) error {
+	workers, err := workersAPI.ListByWorkerPool(clusterNameOrID, workerPoolNameOrID, false, target)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving workers of worker pool (%s): %s", workerPoolNameOrID, err)
+	}
+	params := v1.WorkerUpdateParam{
+		Action: reloadAction,
+	}
+	for _, worker := range workers {
+		log.Printf("[INFO] Reloading worker %s of worker pool %s", worker.ID, workerPoolNameOrID)
+		if err := workersAPI.Update(clusterNameOrID, worker.ID, params, target); err != nil {
+			return fmt.Errorf("[ERROR] Error reloading the worker %s of worker pool (%s): %s", worker.ID, workerPoolNameOrID, err)
+		}
+		if _, err := waitForWorkerReload(workersAPI, worker.ID, timeout, target); err != nil {
+			return fmt.Errorf("[ERROR] Error waiting for the worker %s of worker pool (%s) to become ready: %s", worker.ID, workerPoolNameOrID, err)
+		}
+	}
+	return nil
+}
+
+// waitForWorkerReload waits for a reloaded worker to be normal and ready again. The worker is
+// still normal right after the reload request, so the reload has to be seen started first, with
+// the worker reload pending or reloading, before a normal worker means the reload is done.
+func waitForWorkerReload(workersAPI v1.Workers, workerID string, timeout time.Duration, target v1.ClusterTargetHeader) (interface{}, error) {
+	refresh := func() (interface{}, string, error) {
+		worker, err := workersAPI.Get(workerID, target)
+		if err != nil {
+			return nil, "", fmt.Errorf("[ERROR] Error retrieving worker %s: %s", workerID, err)
+		}
+		if strings.Contains(worker.KubeVersion, "pending") || worker.State != workerNormal || worker.Status != workerReadyState {
+			return worker, workerProvisioning, nil
+		}
+		return worker, workerNormal, nil
+	}
+
+	startTime := time.Now()
+	startConf := &resource.StateChangeConf{
+		Pending:    []string{"retry", workerNormal},
+		Target:     []string{workerProvisioning},
+		Refresh:    refresh,
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := startConf.WaitForState(); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error waiting for the reload of worker %s to start: %s", workerID, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"retry", workerProvisioning},
+		Target:     []string{workerNormal},
+		Refresh:    refresh,
+		Timeout:    timeout - time.Since(startTime),
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
 func resourceIBMContainerWorkerPoolDelete(d *schema.ResourceData, meta interface{}) error {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
@@ -64,9 +64,44 @@ func TestAccIBMContainerWorkerPoolBasic(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "ibm_container_worker_pool.test_pool",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "ibm_container_worker_pool.test_pool",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"reload_workers_on_update"},
+			},
+		},
+	})
+}
+
+func TestAccIBMContainerWorkerPoolReloadWorkersOnUpdate(t *testing.T) {
+
+	workerPoolName := fmt.Sprintf("tf-cluster-worker-%d", acctest.RandIntRange(10, 100))
+	clusterName := fmt.Sprintf("tf-cluster-worker-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMContainerWorkerPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerWorkerPoolBasic(clusterName, workerPoolName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_pool.test_pool", "labels.%", "2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerWorkerPoolReloadWorkers(clusterName, workerPoolName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_pool.test_pool", "labels.%", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_pool.test_pool", "labels.test", "reloaded"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_pool.test_pool", "taints.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_pool.test_pool", "state", "active"),
+				),
 			},
 		},
 	})
@@ -190,6 +225,39 @@ resource "ibm_container_worker_pool" "test_pool" {
 }`, clusterName, acc.Datacenter, acc.MachineType, acc.PublicVlanID, acc.PrivateVlanID, acc.KubeVersion, workerPoolName, acc.MachineType)
 }
 
+func testAccCheckIBMContainerWorkerPoolReloadWorkers(clusterName, workerPoolName string) string {
+	return fmt.Sprintf(`
+
+resource "ibm_container_cluster" "testacc_cluster" {
+  name            = "%s"
+  datacenter      = "%s"
+  machine_type    = "%s"
+  hardware        = "shared"
+  public_vlan_id  = "%s"
+  private_vlan_id = "%s"
+  kube_version    = "%s"
+  wait_till         = "OneWorkerNodeReady"
+}
+
+resource "ibm_container_worker_pool" "test_pool" {
+  worker_pool_name         = "%s"
+  machine_type             = "%s"
+  cluster                  = ibm_container_cluster.testacc_cluster.id
+  size_per_zone            = 1
+  hardware                 = "shared"
+  disk_encryption          = true
+  reload_workers_on_update = true
+  labels = {
+    "test" = "reloaded"
+  }
+  taints {
+    key    = "key1"
+    value  = "value1"
+    effect = "NoSchedule"
+  }
+}`, clusterName, acc.Datacenter, acc.MachineType, acc.PublicVlanID, acc.PrivateVlanID, acc.KubeVersion, workerPoolName, acc.MachineType)
+}
+
 func testAccCheckIBMContainerWorkerPoolInvalidSizePerZone(clusterName, workerPoolName string) string {
 	return fmt.Sprintf(`
 resource "ibm_container_worker_pool" "test_pool" {
@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("ibm_container_cluster", &resource.Sweeper{
+		Name: "ibm_container_cluster",
+		F:    testSweepContainerClusters,
+	})
+	resource.AddTestSweepers("ibm_container_vpc_cluster", &resource.Sweeper{
+		Name: "ibm_container_vpc_cluster",
+		F:    testSweepContainerVpcClusters,
+	})
+}
+
+// testSweepContainerClusters deletes the classic clusters of the region, with their storage
+func testSweepContainerClusters(region string) error {
+	sess, err := acc.SharedClientSession(region)
+	if err != nil {
+		return err
+	}
+	csClient, err := sess.ContainerAPI()
+	if err != nil {
+		return err
+	}
+
+	target := v1.ClusterTargetHeader{Region: region}
+	clusters, err := csClient.Clusters().List(target)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error listing the clusters: %s", err)
+	}
+
+	var sweepErrs []string
+	for _, cluster := range clusters {
+		if cluster.Region != region || !acc.IsSweepable(cluster.Name) {
+			continue
+		}
+		log.Printf("[INFO] Deleting the cluster %s (%s)", cluster.Name, cluster.ID)
+		if err := csClient.Clusters().Delete(cluster.ID, target, true); err != nil {
+			sweepErrs = append(sweepErrs, fmt.Sprintf("%s: %s", cluster.Name, err))
+		}
+	}
+	return acc.SweepErrors(sweepErrs)
+}
+
+// testSweepContainerVpcClusters deletes the VPC clusters of the region, with their storage
+func testSweepContainerVpcClusters(region string) error {
+	sess, err := acc.SharedClientSession(region)
+	if err != nil {
+		return err
+	}
+	csClient, err := sess.VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+
+	target := v2.ClusterTargetHeader{Provider: "vpc-gen2"}
+	clusters, err := csClient.Clusters().List(target)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error listing the VPC clusters: %s", err)
+	}
+
+	var sweepErrs []string
+	for _, cluster := range clusters {
+		if cluster.Region != region || !acc.IsSweepable(cluster.Name) {
+			continue
+		}
+		log.Printf("[INFO] Deleting the VPC cluster %s (%s)", cluster.Name, cluster.ID)
+		if err := csClient.Clusters().Delete(cluster.ID, target, true); err != nil {
+			sweepErrs = append(sweepErrs, fmt.Sprintf("%s: %s", cluster.Name, err))
+		}
+	}
+	return acc.SweepErrors(sweepErrs)
+}
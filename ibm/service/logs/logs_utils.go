@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package logs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// addCloudLogsInstanceSchema adds the arguments that identify the Cloud Logs instance of a resource
+func addCloudLogsInstanceSchema(resource *schema.Resource) *schema.Resource {
+	resource.Schema["instance_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The GUID of the Cloud Logs instance.",
+	}
+	resource.Schema["region"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+		Description: "The region of the Cloud Logs instance. The default is the region of the provider.",
+	}
+	resource.Schema["endpoint_type"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Default:      "public",
+		ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+		Description:  "The type of the endpoint of the Cloud Logs instance: public or private.",
+	}
+	return resource
+}
+
+// getCloudLogsService returns the Cloud Logs service of the instance of the resource, and sets its region
+func getCloudLogsService(d *schema.ResourceData, meta interface{}) (*core.BaseService, error) {
+	cloudLogsClient, err := meta.(conns.ClientSession).CloudLogsV1()
+	if err != nil {
+		return nil, err
+	}
+
+	region := d.Get("region").(string)
+	if region == "" {
+		bxSession, err := meta.(conns.ClientSession).BluemixSession()
+		if err != nil {
+			return nil, err
+		}
+		region = bxSession.Config.Region
+		d.Set("region", region)
+	}
+
+	host := fmt.Sprintf("%s.api.%s.logs.cloud.ibm.com", d.Get("instance_id").(string), region)
+	if d.Get("endpoint_type").(string) == "private" {
+		host = fmt.Sprintf("%s.api.private.%s.logs.cloud.ibm.com", d.Get("instance_id").(string), region)
+	}
+
+	service := cloudLogsClient.Clone()
+	if err = service.SetServiceURL(fmt.Sprintf("https://%s", host)); err != nil {
+		return nil, err
+	}
+	return service, nil
+}
+
+// cloudLogsRequest sends a request to the Cloud Logs API and unmarshals the response into result.
+// The path is relative to the URL of the instance.
+func cloudLogsRequest(context context.Context, service *core.BaseService, method, path string, pathParamsMap map[string]string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(context)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(service.Options.URL, path, pathParamsMap)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		if _, err := builder.SetBodyContentJSON(body); err != nil {
+			return nil, err
+		}
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return service.Request(request, result)
+}
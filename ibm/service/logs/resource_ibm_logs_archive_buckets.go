@@ -0,0 +1,153 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package logs
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+)
+
+// The buckets of a Cloud Logs instance are parameters of the instance
+var logsArchiveBucketParameters = map[string][2]string{
+	"logs_bucket":    {"logs_bucket_crn", "logs_bucket_endpoint"},
+	"metrics_bucket": {"metrics_bucket_crn", "metrics_bucket_endpoint"},
+}
+
+func ResourceIbmLogsArchiveBuckets() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmLogsArchiveBucketsUpdate,
+		ReadContext:   resourceIbmLogsArchiveBucketsRead,
+		UpdateContext: resourceIbmLogsArchiveBucketsUpdate,
+		DeleteContext: resourceIbmLogsArchiveBucketsDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The GUID of the Cloud Logs instance.",
+			},
+			"logs_bucket": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The Cloud Object Storage bucket the logs are archived to. The logs are searchable in the bucket after the retention of the instance.",
+				Elem:        logsArchiveBucketSchema(),
+			},
+			"metrics_bucket": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The Cloud Object Storage bucket the metrics of the logs, including the data usage metrics, are stored to.",
+				Elem:        logsArchiveBucketSchema(),
+			},
+		},
+	}
+}
+
+func logsArchiveBucketSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The CRN of the bucket.",
+			},
+			"endpoint": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The endpoint of the bucket, for example s3.direct.us-south.cloud-object-storage.appdomain.cloud.",
+			},
+		},
+	}
+}
+
+func resourceIbmLogsArchiveBucketsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getResourceInstanceOptions := &rc.GetResourceInstanceOptions{}
+	getResourceInstanceOptions.SetID(d.Id())
+	instance, response, err := rsConClient.GetResourceInstanceWithContext(context, getResourceInstanceOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetResourceInstanceWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetResourceInstanceWithContext failed %s\n%s", err, response))
+	}
+	if instance.State != nil && *instance.State == "removed" {
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("instance_id", d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting instance_id: %s", err))
+	}
+	for key, parameters := range logsArchiveBucketParameters {
+		buckets := []map[string]interface{}{}
+		if crn, ok := instance.Parameters[parameters[0]].(string); ok && crn != "" {
+			endpoint, _ := instance.Parameters[parameters[1]].(string)
+			buckets = append(buckets, map[string]interface{}{
+				"crn":      crn,
+				"endpoint": endpoint,
+			})
+		}
+		if err = d.Set(key, buckets); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting %s: %s", key, err))
+		}
+	}
+
+	return nil
+}
+
+func resourceIbmLogsArchiveBucketsUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	params := map[string]interface{}{}
+	for key, parameters := range logsArchiveBucketParameters {
+		if v, ok := d.GetOk(key + ".0"); ok {
+			bucket := v.(map[string]interface{})
+			params[parameters[0]] = bucket["crn"].(string)
+			params[parameters[1]] = bucket["endpoint"].(string)
+		}
+	}
+
+	if len(params) > 0 {
+		updateResourceInstanceOptions := &rc.UpdateResourceInstanceOptions{}
+		updateResourceInstanceOptions.SetID(instanceID)
+		updateResourceInstanceOptions.SetParameters(params)
+		_, response, err := rsConClient.UpdateResourceInstanceWithContext(context, updateResourceInstanceOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateResourceInstanceWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("Error updating the buckets of the Cloud Logs instance %s: %s\n%s", instanceID, err, response))
+		}
+	}
+
+	d.SetId(instanceID)
+
+	return resourceIbmLogsArchiveBucketsRead(context, d, meta)
+}
+
+func resourceIbmLogsArchiveBucketsDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The buckets of an instance can't be disconnected, they are kept as they are
+	log.Printf("[WARN] Removing the buckets of the Cloud Logs instance %s from the state only", d.Id())
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package logs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmLogsArchiveBucketsBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-logs-archive-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCloudLogs(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmLogsArchiveBucketsConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_logs_archive_buckets.logs_archive_buckets", "instance_id", acc.CloudLogsInstanceID),
+					resource.TestCheckResourceAttrPair("ibm_logs_archive_buckets.logs_archive_buckets", "logs_bucket.0.crn", "ibm_cos_bucket.logs_bucket", "crn"),
+					resource.TestCheckResourceAttrPair("ibm_logs_archive_buckets.logs_archive_buckets", "metrics_bucket.0.crn", "ibm_cos_bucket.metrics_bucket", "crn"),
+				),
+			},
+			{
+				ResourceName:      "ibm_logs_archive_buckets.logs_archive_buckets",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIbmLogsArchiveBucketsConfig(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_resource_instance" "cos_instance" {
+			name     = "%[1]s"
+			service  = "cloud-object-storage"
+			plan     = "standard"
+			location = "global"
+		}
+
+		resource "ibm_cos_bucket" "logs_bucket" {
+			bucket_name          = "%[1]s-logs"
+			resource_instance_id = ibm_resource_instance.cos_instance.id
+			region_location      = "%[3]s"
+			storage_class        = "standard"
+		}
+
+		resource "ibm_cos_bucket" "metrics_bucket" {
+			bucket_name          = "%[1]s-metrics"
+			resource_instance_id = ibm_resource_instance.cos_instance.id
+			region_location      = "%[3]s"
+			storage_class        = "standard"
+		}
+
+		resource "ibm_logs_archive_buckets" "logs_archive_buckets" {
+			instance_id = "%[2]s"
+			logs_bucket {
+				crn      = ibm_cos_bucket.logs_bucket.crn
+				endpoint = ibm_cos_bucket.logs_bucket.s3_endpoint_direct
+			}
+			metrics_bucket {
+				crn      = ibm_cos_bucket.metrics_bucket.crn
+				endpoint = ibm_cos_bucket.metrics_bucket.s3_endpoint_direct
+			}
+		}
+	`, name, acc.CloudLogsInstanceID, acc.CloudLogsInstanceRegion)
+}
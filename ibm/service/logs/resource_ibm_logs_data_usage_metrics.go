@@ -0,0 +1,107 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package logs
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// logsDataUsageMetrics is the export status of the data usage metrics of the Cloud Logs API
+type logsDataUsageMetrics struct {
+	Enabled *bool `json:"enabled"`
+}
+
+func ResourceIbmLogsDataUsageMetrics() *schema.Resource {
+	return addCloudLogsInstanceSchema(&schema.Resource{
+		CreateContext: resourceIbmLogsDataUsageMetricsUpdate,
+		ReadContext:   resourceIbmLogsDataUsageMetricsRead,
+		UpdateContext: resourceIbmLogsDataUsageMetricsUpdate,
+		DeleteContext: resourceIbmLogsDataUsageMetricsDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Whether the data usage metrics of the instance are exported to the metrics bucket of the instance.",
+			},
+		},
+	})
+}
+
+func resourceIbmLogsDataUsageMetricsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("region", parts[0])
+	d.Set("instance_id", parts[1])
+
+	logsClient, err := getCloudLogsService(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dataUsageMetrics := &logsDataUsageMetrics{}
+	response, err := cloudLogsRequest(context, logsClient, core.GET, "/v1/data_usage", nil, nil, dataUsageMetrics)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Error getting the data usage metrics export status %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error getting the data usage metrics export status %s\n%s", err, response))
+	}
+
+	if err = d.Set("enabled", dataUsageMetrics.Enabled); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting enabled: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIbmLogsDataUsageMetricsUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	logsClient, err := getCloudLogsService(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body := &logsDataUsageMetrics{Enabled: core.BoolPtr(d.Get("enabled").(bool))}
+	response, err := cloudLogsRequest(context, logsClient, core.PUT, "/v1/data_usage", nil, body, nil)
+	if err != nil {
+		log.Printf("[DEBUG] Error updating the data usage metrics export status %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error updating the data usage metrics export status %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", d.Get("region").(string), d.Get("instance_id").(string)))
+
+	return resourceIbmLogsDataUsageMetricsRead(context, d, meta)
+}
+
+func resourceIbmLogsDataUsageMetricsDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	logsClient, err := getCloudLogsService(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The export is disabled, as it is by default
+	body := &logsDataUsageMetrics{Enabled: core.BoolPtr(false)}
+	response, err := cloudLogsRequest(context, logsClient, core.PUT, "/v1/data_usage", nil, body, nil)
+	if err != nil {
+		log.Printf("[DEBUG] Error updating the data usage metrics export status %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error updating the data usage metrics export status %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,44 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package logs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmLogsDataUsageMetricsBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCloudLogs(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmLogsDataUsageMetricsConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_logs_data_usage_metrics.logs_data_usage_metrics", "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIbmLogsDataUsageMetricsConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_logs_data_usage_metrics.logs_data_usage_metrics", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmLogsDataUsageMetricsConfig(enabled bool) string {
+	return fmt.Sprintf(`
+		resource "ibm_logs_data_usage_metrics" "logs_data_usage_metrics" {
+			instance_id = "%s"
+			region      = "%s"
+			enabled     = %t
+		}
+	`, acc.CloudLogsInstanceID, acc.CloudLogsInstanceRegion, enabled)
+}
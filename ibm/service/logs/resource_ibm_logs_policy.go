@@ -0,0 +1,350 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package logs
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// logsPolicy is a TCO policy of the Cloud Logs API
+type logsPolicy struct {
+	ID               *string                     `json:"id,omitempty"`
+	Name             *string                     `json:"name,omitempty"`
+	Description      *string                     `json:"description,omitempty"`
+	Priority         *string                     `json:"priority,omitempty"`
+	Enabled          *bool                       `json:"enabled,omitempty"`
+	Order            *int64                      `json:"order,omitempty"`
+	ApplicationRule  *logsPolicyQuotaRule        `json:"application_rule,omitempty"`
+	SubsystemRule    *logsPolicyQuotaRule        `json:"subsystem_rule,omitempty"`
+	LogRules         *logsPolicyLogRules         `json:"log_rules,omitempty"`
+	ArchiveRetention *logsPolicyArchiveRetention `json:"archive_retention,omitempty"`
+	CreatedAt        *string                     `json:"created_at,omitempty"`
+	UpdatedAt        *string                     `json:"updated_at,omitempty"`
+}
+
+type logsPolicyQuotaRule struct {
+	RuleTypeID *string `json:"rule_type_id"`
+	Name       *string `json:"name"`
+}
+
+type logsPolicyLogRules struct {
+	Severities []string `json:"severities"`
+}
+
+type logsPolicyArchiveRetention struct {
+	ID *string `json:"id"`
+}
+
+func ResourceIbmLogsPolicy() *schema.Resource {
+	return addCloudLogsInstanceSchema(&schema.Resource{
+		CreateContext: resourceIbmLogsPolicyCreate,
+		ReadContext:   resourceIbmLogsPolicyRead,
+		UpdateContext: resourceIbmLogsPolicyUpdate,
+		DeleteContext: resourceIbmLogsPolicyDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_logs_policy", "name"),
+				Description:  "The name of the policy.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the policy.",
+			},
+			"priority": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_logs_policy", "priority"),
+				Description:  "The data pipeline the matching logs are assigned to: type_high for Priority insights, type_medium for Analyze and alert, type_low for Store and search, or type_block to drop the logs.",
+			},
+			"application_rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The rule matching the application name of the logs.",
+				Elem:        logsPolicyQuotaRuleSchema(),
+			},
+			"subsystem_rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The rule matching the subsystem name of the logs.",
+				Elem:        logsPolicyQuotaRuleSchema(),
+			},
+			"log_rules": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The rules matching the severity of the logs.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"severities": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Description: "The severities of the logs: debug, verbose, info, warning, error or critical.",
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.ValidateAllowedStringValues([]string{"debug", "verbose", "info", "warning", "error", "critical"}),
+							},
+						},
+					},
+				},
+			},
+			"archive_retention_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the archive retention of the matching logs.",
+			},
+			"policy_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the policy.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the policy is enabled.",
+			},
+			"order": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The order of the policy, the first matching policy applies to a log.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when the policy was created.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when the policy was updated.",
+			},
+		},
+	})
+}
+
+func logsPolicyQuotaRuleSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"rule_type_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"is", "is_not", "start_with", "includes"}),
+				Description:  "The operator of the rule: is, is_not, start_with or includes.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The value the name is compared to.",
+			},
+		},
+	}
+}
+
+func ResourceIbmLogsPolicyValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^[\p{L}\p{N}\p{P}\p{Z}\p{S}\p{M}]+$`,
+			MinValueLength:             1,
+			MaxValueLength:             4096,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "priority",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "type_block, type_high, type_low, type_medium",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_logs_policy", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIbmLogsPolicyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	logsClient, err := getCloudLogsService(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policy := &logsPolicy{}
+	response, err := cloudLogsRequest(context, logsClient, core.POST, "/v1/policies", nil, resourceIbmLogsPolicyPrototype(d), policy)
+	if err != nil {
+		log.Printf("[DEBUG] Error creating logs policy %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error creating logs policy %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", d.Get("region").(string), d.Get("instance_id").(string), *policy.ID))
+
+	return resourceIbmLogsPolicyRead(context, d, meta)
+}
+
+func resourceIbmLogsPolicyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) != 3 {
+		return diag.FromErr(fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of region/instanceID/policyID", d.Id()))
+	}
+	d.Set("region", parts[0])
+	d.Set("instance_id", parts[1])
+
+	logsClient, err := getCloudLogsService(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policy := &logsPolicy{}
+	response, err := cloudLogsRequest(context, logsClient, core.GET, "/v1/policies/{id}", map[string]string{"id": parts[2]}, nil, policy)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Error getting logs policy %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error getting logs policy %s\n%s", err, response))
+	}
+
+	if err = d.Set("policy_id", parts[2]); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting policy_id: %s", err))
+	}
+	if err = d.Set("name", policy.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	if err = d.Set("description", policy.Description); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting description: %s", err))
+	}
+	if err = d.Set("priority", policy.Priority); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting priority: %s", err))
+	}
+	if err = d.Set("application_rule", logsPolicyQuotaRuleToList(policy.ApplicationRule)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting application_rule: %s", err))
+	}
+	if err = d.Set("subsystem_rule", logsPolicyQuotaRuleToList(policy.SubsystemRule)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting subsystem_rule: %s", err))
+	}
+	logRules := []map[string]interface{}{}
+	if policy.LogRules != nil && len(policy.LogRules.Severities) > 0 {
+		logRules = append(logRules, map[string]interface{}{"severities": policy.LogRules.Severities})
+	}
+	if err = d.Set("log_rules", logRules); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting log_rules: %s", err))
+	}
+	if policy.ArchiveRetention != nil {
+		if err = d.Set("archive_retention_id", policy.ArchiveRetention.ID); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting archive_retention_id: %s", err))
+		}
+	}
+	if err = d.Set("enabled", policy.Enabled); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting enabled: %s", err))
+	}
+	if err = d.Set("order", flex.IntValue(policy.Order)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting order: %s", err))
+	}
+	if err = d.Set("created_at", policy.CreatedAt); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_at: %s", err))
+	}
+	if err = d.Set("updated_at", policy.UpdatedAt); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting updated_at: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIbmLogsPolicyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	logsClient, err := getCloudLogsService(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("name", "description", "priority", "application_rule", "subsystem_rule", "log_rules", "archive_retention_id") {
+		pathParamsMap := map[string]string{"id": d.Get("policy_id").(string)}
+		response, err := cloudLogsRequest(context, logsClient, core.PUT, "/v1/policies/{id}", pathParamsMap, resourceIbmLogsPolicyPrototype(d), nil)
+		if err != nil {
+			log.Printf("[DEBUG] Error updating logs policy %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("Error updating logs policy %s\n%s", err, response))
+		}
+	}
+
+	return resourceIbmLogsPolicyRead(context, d, meta)
+}
+
+func resourceIbmLogsPolicyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	logsClient, err := getCloudLogsService(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	pathParamsMap := map[string]string{"id": d.Get("policy_id").(string)}
+	response, err := cloudLogsRequest(context, logsClient, core.DELETE, "/v1/policies/{id}", pathParamsMap, nil, nil)
+	if err != nil {
+		log.Printf("[DEBUG] Error deleting logs policy %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error deleting logs policy %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIbmLogsPolicyPrototype(d *schema.ResourceData) *logsPolicy {
+	policy := &logsPolicy{
+		Name:     core.StringPtr(d.Get("name").(string)),
+		Priority: core.StringPtr(d.Get("priority").(string)),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		policy.Description = core.StringPtr(v.(string))
+	}
+	if v, ok := d.GetOk("application_rule.0"); ok {
+		policy.ApplicationRule = logsPolicyMapToQuotaRule(v.(map[string]interface{}))
+	}
+	if v, ok := d.GetOk("subsystem_rule.0"); ok {
+		policy.SubsystemRule = logsPolicyMapToQuotaRule(v.(map[string]interface{}))
+	}
+	if v, ok := d.GetOk("log_rules.0.severities"); ok {
+		policy.LogRules = &logsPolicyLogRules{Severities: flex.ExpandStringList(v.([]interface{}))}
+	}
+	if v, ok := d.GetOk("archive_retention_id"); ok {
+		policy.ArchiveRetention = &logsPolicyArchiveRetention{ID: core.StringPtr(v.(string))}
+	}
+	return policy
+}
+
+func logsPolicyMapToQuotaRule(modelMap map[string]interface{}) *logsPolicyQuotaRule {
+	return &logsPolicyQuotaRule{
+		RuleTypeID: core.StringPtr(modelMap["rule_type_id"].(string)),
+		Name:       core.StringPtr(modelMap["name"].(string)),
+	}
+}
+
+func logsPolicyQuotaRuleToList(model *logsPolicyQuotaRule) []map[string]interface{} {
+	// The API returns rules of type unspecified for the rules that are not set
+	if model == nil || model.RuleTypeID == nil || *model.RuleTypeID == "unspecified" {
+		return []map[string]interface{}{}
+	}
+	return []map[string]interface{}{{
+		"rule_type_id": model.RuleTypeID,
+		"name":         model.Name,
+	}}
+}
@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package logs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmLogsPolicyBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-logs-policy-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCloudLogs(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmLogsPolicyConfig(name, "type_medium"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_logs_policy.logs_policy", "name", name),
+					resource.TestCheckResourceAttr("ibm_logs_policy.logs_policy", "priority", "type_medium"),
+					resource.TestCheckResourceAttr("ibm_logs_policy.logs_policy", "application_rule.0.rule_type_id", "start_with"),
+					resource.TestCheckResourceAttr("ibm_logs_policy.logs_policy", "log_rules.0.severities.#", "2"),
+					resource.TestCheckResourceAttrSet("ibm_logs_policy.logs_policy", "policy_id"),
+				),
+			},
+			{
+				Config: testAccCheckIbmLogsPolicyConfig(name, "type_low"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_logs_policy.logs_policy", "priority", "type_low"),
+				),
+			},
+			{
+				ResourceName:            "ibm_logs_policy.logs_policy",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"endpoint_type"},
+			},
+		},
+	})
+}
+
+func testAccCheckIbmLogsPolicyConfig(name string, priority string) string {
+	return fmt.Sprintf(`
+		resource "ibm_logs_policy" "logs_policy" {
+			instance_id = "%s"
+			region      = "%s"
+			name        = "%s"
+			description = "Debug logs of the test applications"
+			priority    = "%s"
+			application_rule {
+				rule_type_id = "start_with"
+				name         = "test-"
+			}
+			log_rules {
+				severities = ["debug", "verbose"]
+			}
+		}
+	`, acc.CloudLogsInstanceID, acc.CloudLogsInstanceRegion, name, priority)
+}
@@ -0,0 +1,238 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ns1
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+const ibmNS1Monitor = "ibm_ns1_monitor"
+
+func ResourceIBMNS1Monitor() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMNS1MonitorCreate,
+		ReadContext:   resourceIBMNS1MonitorRead,
+		UpdateContext: resourceIBMNS1MonitorUpdate,
+		DeleteContext: resourceIBMNS1MonitorDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the monitoring job.",
+			},
+			"job_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator(ibmNS1Monitor, "job_type"),
+				Description:  "The type of the monitoring job: http, tcp, ping or dns.",
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the monitoring job is active.",
+			},
+			"regions": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The NS1 regions the monitoring job runs from, for example lga, sjc or ams.",
+			},
+			"frequency": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The frequency of the checks, in seconds.",
+			},
+			"rapid_recheck": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether a failed check is checked again immediately before changing the status.",
+			},
+			"policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "quorum",
+				ValidateFunc: validate.InvokeValidator(ibmNS1Monitor, "policy"),
+				Description:  "The number of regions which must fail for the status to be down: quorum, all or one.",
+			},
+			"notify_delay": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "The delay before sending a notification of a status change, in seconds.",
+			},
+			"notify_repeat": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "The interval between the notifications while the status is down, in seconds. 0 to notify once.",
+			},
+			"config": {
+				Type:        schema.TypeMap,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The configuration of the check, for example host and port for a tcp job, or url for a http job.",
+			},
+			"rules": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The rules on the output of the checks, a check failing a rule is down.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The output metric of the check, for example status_code or rtt.",
+						},
+						"comparison": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The comparison with the value, for example ==, <, > or contains.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The value compared with the output of the check.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceIBMNS1MonitorValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "job_type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "dns, http, ping, tcp",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "policy",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "all, one, quorum",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: ibmNS1Monitor, Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMNS1MonitorCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	job := &ns1MonitoringJob{}
+	response, err := ns1Request(context, meta, core.PUT, "/monitoring/jobs", nil, expandNS1MonitoringJob(d), job)
+	if err != nil {
+		log.Printf("[DEBUG] NS1 monitoring job create failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] NS1 monitoring job create failed %s\n%s", err, response))
+	}
+
+	d.SetId(job.ID)
+
+	return resourceIBMNS1MonitorRead(context, d, meta)
+}
+
+func resourceIBMNS1MonitorRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	job := &ns1MonitoringJob{}
+	response, err := ns1Request(context, meta, core.GET, "/monitoring/jobs/{job_id}", map[string]string{"job_id": d.Id()}, nil, job)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] NS1 monitoring job read failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] NS1 monitoring job read failed %s\n%s", err, response))
+	}
+
+	rules := make([]map[string]interface{}, 0, len(job.Rules))
+	for _, rule := range job.Rules {
+		rules = append(rules, map[string]interface{}{
+			"key":        rule.Key,
+			"comparison": rule.Comparison,
+			"value":      flattenNS1Value(rule.Value),
+		})
+	}
+
+	d.Set("name", job.Name)
+	d.Set("job_type", job.JobType)
+	d.Set("active", job.Active)
+	d.Set("regions", job.Regions)
+	d.Set("frequency", job.Frequency)
+	d.Set("rapid_recheck", job.RapidRecheck)
+	d.Set("policy", job.Policy)
+	d.Set("notify_delay", job.NotifyDelay)
+	d.Set("notify_repeat", job.NotifyRepeat)
+	d.Set("config", flattenNS1Values(job.Config))
+	if err = d.Set("rules", rules); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting rules: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMNS1MonitorUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	response, err := ns1Request(context, meta, core.POST, "/monitoring/jobs/{job_id}", map[string]string{"job_id": d.Id()}, expandNS1MonitoringJob(d), nil)
+	if err != nil {
+		log.Printf("[DEBUG] NS1 monitoring job update failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] NS1 monitoring job update failed %s\n%s", err, response))
+	}
+
+	return resourceIBMNS1MonitorRead(context, d, meta)
+}
+
+func resourceIBMNS1MonitorDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	response, err := ns1Request(context, meta, core.DELETE, "/monitoring/jobs/{job_id}", map[string]string{"job_id": d.Id()}, nil, nil)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] NS1 monitoring job delete failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] NS1 monitoring job delete failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func expandNS1MonitoringJob(d *schema.ResourceData) *ns1MonitoringJob {
+	job := &ns1MonitoringJob{
+		Name:         d.Get("name").(string),
+		JobType:      d.Get("job_type").(string),
+		Active:       d.Get("active").(bool),
+		Regions:      flex.ExpandStringList(d.Get("regions").([]interface{})),
+		Frequency:    int64(d.Get("frequency").(int)),
+		RapidRecheck: d.Get("rapid_recheck").(bool),
+		Policy:       d.Get("policy").(string),
+		NotifyDelay:  int64(d.Get("notify_delay").(int)),
+		NotifyRepeat: int64(d.Get("notify_repeat").(int)),
+		Config:       expandNS1Values(d.Get("config").(map[string]interface{})),
+		Rules:        []ns1MonitoringRule{},
+	}
+	for _, r := range d.Get("rules").([]interface{}) {
+		rule := r.(map[string]interface{})
+		job.Rules = append(job.Rules, ns1MonitoringRule{
+			Key:        rule["key"].(string),
+			Comparison: rule["comparison"].(string),
+			Value:      expandNS1Value(rule["value"].(string)),
+		})
+	}
+	return job
+}
@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ns1_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMNS1MonitorTCP(t *testing.T) {
+	name := fmt.Sprintf("tf-ns1-monitor-%d", acctest.RandIntRange(10000, 99999))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckNS1(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMNS1MonitorConfig(name, 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_ns1_monitor.monitor", "name", name),
+					resource.TestCheckResourceAttr("ibm_ns1_monitor.monitor", "job_type", "tcp"),
+					resource.TestCheckResourceAttr("ibm_ns1_monitor.monitor", "config.port", "443"),
+					resource.TestCheckResourceAttr("ibm_ns1_monitor.monitor", "frequency", "60"),
+				),
+			},
+			{
+				Config: testAccCheckIBMNS1MonitorConfig(name, 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_ns1_monitor.monitor", "frequency", "30"),
+				),
+			},
+			{
+				ResourceName:      "ibm_ns1_monitor.monitor",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMNS1MonitorConfig(name string, frequency int) string {
+	return fmt.Sprintf(`
+	resource "ibm_ns1_monitor" "monitor" {
+		name      = "%s"
+		job_type  = "tcp"
+		regions   = ["lga", "sjc"]
+		frequency = %d
+		config = {
+			host = "192.0.2.10"
+			port = "443"
+			ssl  = "true"
+		}
+		rules {
+			key        = "connect"
+			comparison = "<"
+			value      = "2000"
+		}
+	}
+	`, name, frequency)
+}
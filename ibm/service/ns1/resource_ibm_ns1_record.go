@@ -0,0 +1,331 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ns1
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+const ibmNS1Record = "ibm_ns1_record"
+
+func ResourceIBMNS1Record() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMNS1RecordCreate,
+		ReadContext:   resourceIBMNS1RecordRead,
+		UpdateContext: resourceIBMNS1RecordUpdate,
+		DeleteContext: resourceIBMNS1RecordDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the zone of the record.",
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The fully qualified domain name of the record, for example www.example.com.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator(ibmNS1Record, "type"),
+				Description:  "The type of the record.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The TTL of the record, in seconds.",
+			},
+			"use_client_subnet": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the EDNS client subnet is used to select the answers.",
+			},
+			"answers": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The answers of the record, selected by the filters.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"answer": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The data of the answer, with its fields separated by spaces, for example 10 mail.example.com for a MX record.",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The name of the region of the answer.",
+						},
+						"meta": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The meta data of the answer used by the filters, for example weight, up, country or georegion.",
+						},
+					},
+				},
+			},
+			"regions": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The regions of the answers, their meta data applies to all the answers of the region.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the region.",
+						},
+						"meta": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The meta data of the region.",
+						},
+					},
+				},
+			},
+			"filters": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The filters selecting the answers, applied in order, for example up, geotarget_country, weighted_shuffle and select_first_n.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"filter": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The type of the filter.",
+						},
+						"disabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether the filter is disabled.",
+						},
+						"config": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The configuration of the filter, for example N for select_first_n.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceIBMNS1RecordValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "A, AAAA, ALIAS, CAA, CNAME, MX, NS, PTR, SPF, SRV, TXT",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: ibmNS1Record, Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMNS1RecordCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	record := expandNS1Record(d)
+	response, err := ns1Request(context, meta, core.PUT, "/zones/{zone}/{domain}/{type}", ns1RecordPathParams(record.Zone, record.Domain, record.Type), record, nil)
+	if err != nil {
+		log.Printf("[DEBUG] NS1 record create failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] NS1 record create failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", record.Zone, record.Domain, record.Type))
+
+	return resourceIBMNS1RecordRead(context, d, meta)
+}
+
+func resourceIBMNS1RecordRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zone, domain, recordType, err := ns1RecordIdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	record := &ns1Record{}
+	response, err := ns1Request(context, meta, core.GET, "/zones/{zone}/{domain}/{type}", ns1RecordPathParams(zone, domain, recordType), nil, record)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] NS1 record read failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] NS1 record read failed %s\n%s", err, response))
+	}
+
+	d.Set("zone", record.Zone)
+	d.Set("domain", record.Domain)
+	d.Set("type", record.Type)
+	d.Set("ttl", record.TTL)
+	d.Set("use_client_subnet", record.UseClientSubnet)
+	if err = d.Set("answers", flattenNS1RecordAnswers(record)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting answers: %s", err))
+	}
+	if err = d.Set("regions", flattenNS1RecordRegions(record.Regions)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting regions: %s", err))
+	}
+	if err = d.Set("filters", flattenNS1RecordFilters(record.Filters)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting filters: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMNS1RecordUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	record := expandNS1Record(d)
+	response, err := ns1Request(context, meta, core.POST, "/zones/{zone}/{domain}/{type}", ns1RecordPathParams(record.Zone, record.Domain, record.Type), record, nil)
+	if err != nil {
+		log.Printf("[DEBUG] NS1 record update failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] NS1 record update failed %s\n%s", err, response))
+	}
+
+	return resourceIBMNS1RecordRead(context, d, meta)
+}
+
+func resourceIBMNS1RecordDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zone, domain, recordType, err := ns1RecordIdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := ns1Request(context, meta, core.DELETE, "/zones/{zone}/{domain}/{type}", ns1RecordPathParams(zone, domain, recordType), nil, nil)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] NS1 record delete failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] NS1 record delete failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func ns1RecordPathParams(zone, domain, recordType string) map[string]string {
+	return map[string]string{
+		"zone":   zone,
+		"domain": domain,
+		"type":   recordType,
+	}
+}
+
+// The ID of the record is <zone>/<domain>/<type>
+func ns1RecordIdParts(id string) (string, string, string, error) {
+	parts, err := flex.IdParts(id)
+	if err != nil {
+		return "", "", "", err
+	}
+	if len(parts) != 3 {
+		return "", "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of zone/domain/type", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// ns1AnswerData splits the data of an answer into its fields, the data of the TXT and SPF records is a single field
+func ns1AnswerData(recordType, answer string) []string {
+	if recordType == "TXT" || recordType == "SPF" {
+		return []string{answer}
+	}
+	return strings.Fields(answer)
+}
+
+func expandNS1Record(d *schema.ResourceData) *ns1Record {
+	record := &ns1Record{
+		Zone:    d.Get("zone").(string),
+		Domain:  d.Get("domain").(string),
+		Type:    d.Get("type").(string),
+		Answers: []ns1Answer{},
+		Regions: map[string]ns1Region{},
+		Filters: []ns1Filter{},
+	}
+	if v, ok := d.GetOk("ttl"); ok {
+		record.TTL = core.Int64Ptr(int64(v.(int)))
+	}
+	if v, ok := d.GetOkExists("use_client_subnet"); ok {
+		record.UseClientSubnet = core.BoolPtr(v.(bool))
+	}
+	for _, a := range d.Get("answers").([]interface{}) {
+		answer := a.(map[string]interface{})
+		record.Answers = append(record.Answers, ns1Answer{
+			Answer: ns1AnswerData(record.Type, answer["answer"].(string)),
+			Region: answer["region"].(string),
+			Meta:   expandNS1Values(answer["meta"].(map[string]interface{})),
+		})
+	}
+	for _, r := range d.Get("regions").(*schema.Set).List() {
+		region := r.(map[string]interface{})
+		record.Regions[region["name"].(string)] = ns1Region{
+			Meta: expandNS1Values(region["meta"].(map[string]interface{})),
+		}
+	}
+	for _, f := range d.Get("filters").([]interface{}) {
+		filter := f.(map[string]interface{})
+		record.Filters = append(record.Filters, ns1Filter{
+			Filter:   filter["filter"].(string),
+			Disabled: filter["disabled"].(bool),
+			Config:   expandNS1Values(filter["config"].(map[string]interface{})),
+		})
+	}
+	return record
+}
+
+func flattenNS1RecordAnswers(record *ns1Record) []map[string]interface{} {
+	answers := make([]map[string]interface{}, 0, len(record.Answers))
+	for _, answer := range record.Answers {
+		answers = append(answers, map[string]interface{}{
+			"answer": strings.Join(answer.Answer, " "),
+			"region": answer.Region,
+			"meta":   flattenNS1Values(answer.Meta),
+		})
+	}
+	return answers
+}
+
+func flattenNS1RecordRegions(regions map[string]ns1Region) []map[string]interface{} {
+	flat := make([]map[string]interface{}, 0, len(regions))
+	for name, region := range regions {
+		flat = append(flat, map[string]interface{}{
+			"name": name,
+			"meta": flattenNS1Values(region.Meta),
+		})
+	}
+	return flat
+}
+
+func flattenNS1RecordFilters(filters []ns1Filter) []map[string]interface{} {
+	flat := make([]map[string]interface{}, 0, len(filters))
+	for _, filter := range filters {
+		flat = append(flat, map[string]interface{}{
+			"filter":   filter.Filter,
+			"disabled": filter.Disabled,
+			"config":   flattenNS1Values(filter.Config),
+		})
+	}
+	return flat
+}
@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ns1_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMNS1RecordWeighted(t *testing.T) {
+	zone := fmt.Sprintf("tf-ns1-%d.com", acctest.RandIntRange(10000, 99999))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckNS1(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMNS1RecordConfig(zone, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_ns1_record.www", "domain", "www."+zone),
+					resource.TestCheckResourceAttr("ibm_ns1_record.www", "answers.#", "2"),
+					resource.TestCheckResourceAttr("ibm_ns1_record.www", "answers.0.meta.weight", "10"),
+					resource.TestCheckResourceAttr("ibm_ns1_record.www", "filters.0.filter", "weighted_shuffle"),
+				),
+			},
+			{
+				Config: testAccCheckIBMNS1RecordConfig(zone, 20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_ns1_record.www", "answers.0.meta.weight", "20"),
+				),
+			},
+			{
+				ResourceName:      "ibm_ns1_record.www",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMNS1RecordConfig(zone string, weight int) string {
+	return fmt.Sprintf(`
+	resource "ibm_ns1_zone" "zone" {
+		zone = "%[1]s"
+	}
+
+	resource "ibm_ns1_record" "www" {
+		zone   = ibm_ns1_zone.zone.zone
+		domain = "www.%[1]s"
+		type   = "A"
+		ttl    = 60
+
+		answers {
+			answer = "192.0.2.10"
+			meta = {
+				weight = "%[2]d"
+			}
+		}
+		answers {
+			answer = "192.0.2.11"
+			meta = {
+				weight = "30"
+			}
+		}
+
+		filters {
+			filter = "weighted_shuffle"
+		}
+		filters {
+			filter = "select_first_n"
+			config = {
+				N = "1"
+			}
+		}
+	}
+	`, zone, weight)
+}
@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ns1
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMNS1Zone() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMNS1ZoneCreate,
+		ReadContext:   resourceIBMNS1ZoneRead,
+		UpdateContext: resourceIBMNS1ZoneUpdate,
+		DeleteContext: resourceIBMNS1ZoneDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the zone, for example example.com.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The TTL of the SOA record of the zone, in seconds.",
+			},
+			"refresh": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The SOA refresh time of the zone, in seconds.",
+			},
+			"retry": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The SOA retry time of the zone, in seconds.",
+			},
+			"expiry": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The SOA expiry time of the zone, in seconds.",
+			},
+			"nx_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The TTL of the negative answers of the zone, in seconds.",
+			},
+			"dnssec": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether DNSSEC is enabled on the zone.",
+			},
+			"hostmaster": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The hostmaster email of the SOA record of the zone.",
+			},
+			"dns_servers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The NS1 name servers of the zone, to delegate the zone to.",
+			},
+		},
+	}
+}
+
+func resourceIBMNS1ZoneCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zone := expandNS1Zone(d)
+	response, err := ns1Request(context, meta, core.PUT, "/zones/{zone}", map[string]string{"zone": zone.Zone}, zone, nil)
+	if err != nil {
+		log.Printf("[DEBUG] NS1 zone create failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] NS1 zone create failed %s\n%s", err, response))
+	}
+
+	d.SetId(zone.Zone)
+
+	return resourceIBMNS1ZoneRead(context, d, meta)
+}
+
+func resourceIBMNS1ZoneRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zone := &ns1Zone{}
+	response, err := ns1Request(context, meta, core.GET, "/zones/{zone}", map[string]string{"zone": d.Id()}, nil, zone)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] NS1 zone read failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] NS1 zone read failed %s\n%s", err, response))
+	}
+
+	d.Set("zone", zone.Zone)
+	d.Set("ttl", zone.TTL)
+	d.Set("refresh", zone.Refresh)
+	d.Set("retry", zone.Retry)
+	d.Set("expiry", zone.Expiry)
+	d.Set("nx_ttl", zone.NxTTL)
+	d.Set("dnssec", zone.DNSSEC)
+	d.Set("hostmaster", zone.Hostmaster)
+	d.Set("dns_servers", zone.DNSServers)
+
+	return nil
+}
+
+func resourceIBMNS1ZoneUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zone := expandNS1Zone(d)
+	response, err := ns1Request(context, meta, core.POST, "/zones/{zone}", map[string]string{"zone": d.Id()}, zone, nil)
+	if err != nil {
+		log.Printf("[DEBUG] NS1 zone update failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] NS1 zone update failed %s\n%s", err, response))
+	}
+
+	return resourceIBMNS1ZoneRead(context, d, meta)
+}
+
+func resourceIBMNS1ZoneDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	response, err := ns1Request(context, meta, core.DELETE, "/zones/{zone}", map[string]string{"zone": d.Id()}, nil, nil)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] NS1 zone delete failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] NS1 zone delete failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func expandNS1Zone(d *schema.ResourceData) *ns1Zone {
+	zone := &ns1Zone{Zone: d.Get("zone").(string)}
+	for key, value := range map[string]**int64{
+		"ttl":     &zone.TTL,
+		"refresh": &zone.Refresh,
+		"retry":   &zone.Retry,
+		"expiry":  &zone.Expiry,
+		"nx_ttl":  &zone.NxTTL,
+	} {
+		if v, ok := d.GetOk(key); ok {
+			*value = core.Int64Ptr(int64(v.(int)))
+		}
+	}
+	if v, ok := d.GetOkExists("dnssec"); ok {
+		zone.DNSSEC = core.BoolPtr(v.(bool))
+	}
+	return zone
+}
@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ns1_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMNS1ZoneBasic(t *testing.T) {
+	zone := fmt.Sprintf("tf-ns1-%d.com", acctest.RandIntRange(10000, 99999))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckNS1(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMNS1ZoneConfig(zone, 3600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_ns1_zone.zone", "zone", zone),
+					resource.TestCheckResourceAttr("ibm_ns1_zone.zone", "ttl", "3600"),
+					resource.TestCheckResourceAttrSet("ibm_ns1_zone.zone", "dns_servers.0"),
+				),
+			},
+			{
+				Config: testAccCheckIBMNS1ZoneConfig(zone, 7200),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_ns1_zone.zone", "ttl", "7200"),
+				),
+			},
+			{
+				ResourceName:      "ibm_ns1_zone.zone",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMNS1ZoneConfig(zone string, ttl int) string {
+	return fmt.Sprintf(`
+	resource "ibm_ns1_zone" "zone" {
+		zone = "%s"
+		ttl  = %d
+	}
+	`, zone, ttl)
+}
@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ns1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// NS1 Connect API models. The meta data of the answers and regions, the configuration of the filters and of
+// the monitoring jobs are typed values: strings, numbers, booleans, lists or feed pointers.
+
+type ns1Zone struct {
+	Zone       string   `json:"zone"`
+	TTL        *int64   `json:"ttl,omitempty"`
+	Refresh    *int64   `json:"refresh,omitempty"`
+	Retry      *int64   `json:"retry,omitempty"`
+	Expiry     *int64   `json:"expiry,omitempty"`
+	NxTTL      *int64   `json:"nx_ttl,omitempty"`
+	DNSSEC     *bool    `json:"dnssec,omitempty"`
+	Hostmaster string   `json:"hostmaster,omitempty"`
+	DNSServers []string `json:"dns_servers,omitempty"`
+}
+
+type ns1Answer struct {
+	Answer []string               `json:"answer"`
+	Region string                 `json:"region,omitempty"`
+	Meta   map[string]interface{} `json:"meta,omitempty"`
+}
+
+type ns1Region struct {
+	Meta map[string]interface{} `json:"meta"`
+}
+
+type ns1Filter struct {
+	Filter   string                 `json:"filter"`
+	Disabled bool                   `json:"disabled,omitempty"`
+	Config   map[string]interface{} `json:"config"`
+}
+
+type ns1Record struct {
+	ID              string               `json:"id,omitempty"`
+	Zone            string               `json:"zone"`
+	Domain          string               `json:"domain"`
+	Type            string               `json:"type"`
+	TTL             *int64               `json:"ttl,omitempty"`
+	UseClientSubnet *bool                `json:"use_client_subnet,omitempty"`
+	Answers         []ns1Answer          `json:"answers"`
+	Regions         map[string]ns1Region `json:"regions,omitempty"`
+	Filters         []ns1Filter          `json:"filters"`
+}
+
+type ns1MonitoringRule struct {
+	Comparison string      `json:"comparison"`
+	Key        string      `json:"key"`
+	Value      interface{} `json:"value"`
+}
+
+type ns1MonitoringJob struct {
+	ID           string                 `json:"id,omitempty"`
+	Name         string                 `json:"name"`
+	JobType      string                 `json:"job_type"`
+	Active       bool                   `json:"active"`
+	Regions      []string               `json:"regions"`
+	Frequency    int64                  `json:"frequency"`
+	RapidRecheck bool                   `json:"rapid_recheck"`
+	Policy       string                 `json:"policy"`
+	NotifyDelay  int64                  `json:"notify_delay"`
+	NotifyRepeat int64                  `json:"notify_repeat"`
+	Config       map[string]interface{} `json:"config"`
+	Rules        []ns1MonitoringRule    `json:"rules"`
+}
+
+// ns1Request calls the NS1 Connect API, the response is decoded in result when it is not nil
+func ns1Request(context context.Context, meta interface{}, method, path string, pathParams map[string]string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	client, err := meta.(conns.ClientSession).NS1ConnectV1()
+	if err != nil {
+		return nil, err
+	}
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(context)
+	builder.EnableGzipCompression = client.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(client.GetServiceURL(), path, pathParams)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		if _, err = builder.SetBodyContentJSON(body); err != nil {
+			return nil, err
+		}
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Request(request, result)
+}
+
+// expandNS1Values converts the string values of a map attribute to the typed values of the API: booleans,
+// numbers, and JSON lists or objects such as the feed pointers are decoded, the other values are strings.
+func expandNS1Values(values map[string]interface{}) map[string]interface{} {
+	typed := make(map[string]interface{}, len(values))
+	for k, v := range values {
+		typed[k] = expandNS1Value(v.(string))
+	}
+	return typed
+}
+
+func expandNS1Value(value string) interface{} {
+	if value == "true" || value == "false" {
+		return value == "true"
+	}
+	if n, err := strconv.ParseFloat(value, 64); err == nil {
+		return n
+	}
+	if strings.HasPrefix(value, "[") || strings.HasPrefix(value, "{") {
+		var v interface{}
+		if err := json.Unmarshal([]byte(value), &v); err == nil {
+			return v
+		}
+	}
+	return value
+}
+
+// flattenNS1Values converts the typed values of the API to the string values of a map attribute
+func flattenNS1Values(values map[string]interface{}) map[string]interface{} {
+	flat := make(map[string]interface{}, len(values))
+	for k, v := range values {
+		flat[k] = flattenNS1Value(v)
+	}
+	return flat
+}
+
+func flattenNS1Value(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return string(encoded)
+	}
+}
@@ -52,6 +52,40 @@ func DataSourceIBMPIInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			Attr_HealthReason: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The reason of the health status of the instance, if any.",
+			},
+			Attr_HealthLastUpdate: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the last health status change of the instance.",
+			},
+			Attr_SRCs: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The system reference codes (SRC) reported by the instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_SRC: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The SRC reference code.",
+						},
+						Attr_SRCTimestamp: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date stamp of the SRC.",
+						},
+					},
+				},
+			},
+			Attr_ConsoleLanguage: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The code of the console language of the instance.",
+			},
 			"addresses": {
 				Type:       schema.TypeList,
 				Computed:   true,
@@ -263,8 +297,14 @@ func dataSourceIBMPIInstancesRead(ctx context.Context, d *schema.ResourceData, m
 	if powervmdata.Health != nil {
 
 		d.Set("health_status", powervmdata.Health.Status)
+		d.Set(Attr_HealthReason, powervmdata.Health.Reason)
+		d.Set(Attr_HealthLastUpdate, powervmdata.Health.LastUpdate)
 
 	}
+	d.Set(Attr_SRCs, flattenPvmInstanceSRCs(powervmdata.Srcs))
+	if powervmdata.ConsoleLanguage != nil {
+		d.Set(Attr_ConsoleLanguage, powervmdata.ConsoleLanguage.Code)
+	}
 
 	return nil
 }
@@ -42,6 +42,11 @@ func DataSourceIBMPIInstanceConsoleLanguages() *schema.Resource {
 			},
 
 			// Computed Attributes
+			Attr_ConsoleLanguage: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The code of the console language selected for the instance",
+			},
 			ConsoleLanguages: {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -79,6 +84,14 @@ func dataSourceIBMPIInstanceConsoleLanguagesRead(ctx context.Context, d *schema.
 		return diag.FromErr(err)
 	}
 
+	pvm, err := client.Get(instanceName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if pvm.ConsoleLanguage != nil {
+		d.Set(Attr_ConsoleLanguage, pvm.ConsoleLanguage.Code)
+	}
+
 	var clientgenU, _ = uuid.GenerateUUID()
 	d.SetId(clientgenU)
 
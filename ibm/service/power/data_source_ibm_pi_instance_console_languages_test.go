@@ -22,6 +22,7 @@ func TestAccIBMPIInstanceConsoleLanguages(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.ibm_pi_console_languages.example", "id"),
 					resource.TestCheckResourceAttrSet("data.ibm_pi_console_languages.example", "console_languages.#"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_console_languages.example", "console_language"),
 				),
 			},
 		},
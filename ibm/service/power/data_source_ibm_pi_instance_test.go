@@ -22,6 +22,8 @@ func TestAccIBMPIInstanceDataSource_basic(t *testing.T) {
 				Config: testAccCheckIBMPIInstanceDataSourceConfig(),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.ibm_pi_instance.testacc_ds_instance", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_instance.testacc_ds_instance", "health_status"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_instance.testacc_ds_instance", "srcs.#"),
 				),
 			},
 		},
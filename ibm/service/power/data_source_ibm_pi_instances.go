@@ -50,6 +50,16 @@ func DataSourceIBMPIInstances() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						Attr_HealthReason: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The reason of the health status of the instance, if any.",
+						},
+						Attr_HealthLastUpdate: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time of the last health status change of the instance.",
+						},
 						"networks": {
 							Type:     schema.TypeList,
 							Computed: true,
@@ -203,6 +213,8 @@ func flattenPvmInstances(list []*models.PVMInstanceReference) []map[string]inter
 
 		if i.Health != nil {
 			l["health_status"] = i.Health.Status
+			l[Attr_HealthReason] = i.Health.Reason
+			l[Attr_HealthLastUpdate] = i.Health.LastUpdate
 		}
 
 		result = append(result, l)
@@ -229,3 +241,20 @@ func flattenPvmInstanceNetworks(list []*models.PVMInstanceNetwork) (networks []m
 	}
 	return
 }
+
+// flattenPvmInstanceSRCs flattens the SRC lists of the instance in the order returned by the service
+func flattenPvmInstanceSRCs(lists [][]*models.SRC) []map[string]interface{} {
+	srcs := make([]map[string]interface{}, 0)
+	for _, list := range lists {
+		for _, src := range list {
+			if src == nil {
+				continue
+			}
+			srcs = append(srcs, map[string]interface{}{
+				Attr_SRC:          src.Src,
+				Attr_SRCTimestamp: src.Timestamp,
+			})
+		}
+	}
+	return srcs
+}
@@ -5,6 +5,7 @@ import "time"
 const (
 	// used by all
 	Arg_CloudInstanceID = "pi_cloud_instance_id"
+	Arg_Zone            = "pi_zone"
 
 	// Keys
 	Arg_KeyName = "pi_key_name"
@@ -47,9 +48,15 @@ const (
 	Arg_PVMInstanceActionType   = "pi_action"
 	Arg_PVMInstanceHealthStatus = "pi_health_status"
 
-	Attr_Status       = "status"
-	Attr_Progress     = "progress"
-	Attr_HealthStatus = "health_status"
+	Attr_Status           = "status"
+	Attr_Progress         = "progress"
+	Attr_HealthStatus     = "health_status"
+	Attr_HealthReason     = "health_reason"
+	Attr_HealthLastUpdate = "health_last_update"
+	Attr_ConsoleLanguage  = "console_language"
+	Attr_SRCs             = "srcs"
+	Attr_SRC              = "src"
+	Attr_SRCTimestamp     = "timestamp"
 
 	PVMInstanceHealthOk      = "OK"
 	PVMInstanceHealthWarning = "WARNING"
@@ -104,6 +111,16 @@ const (
 	// Cloud Connections
 	PICloudConnectionTransitEnabled = "pi_cloud_connection_transit_enabled"
 
+	// Transit Gateway (Power Edge Router) Connections
+	Arg_TransitGatewayID                   = "pi_transit_gateway_id"
+	Arg_TransitGatewayConnectionName       = "pi_connection_name"
+	Arg_TransitGatewayPrefixFiltersDefault = "pi_prefix_filters_default"
+	Attr_TransitGatewayConnectionID        = "connection_id"
+	Attr_TransitGatewayConnectionStatus    = "status"
+	Attr_TransitGatewayNetworkID           = "network_id"
+	Attr_TransitGatewayCreatedAt           = "created_at"
+	PowerEdgeRouterCapability              = "power-edge-router"
+
 	// Shared Processor Pool
 	Arg_SharedProcessorPoolName                      = "pi_shared_processor_pool_name"
 	Arg_SharedProcessorPoolHostGroup                 = "pi_shared_processor_pool_host_group"
@@ -138,6 +155,15 @@ const (
 	Attr_SPPPlacementGroupPolicy  = "policy"
 	Attr_SPPPlacementGroupName    = "name"
 
+	// Images
+	Arg_ImageTargets = "pi_targets"
+
+	Attr_Images          = "images"
+	Attr_ImageID         = "image_id"
+	Attr_CloudInstanceID = "cloud_instance_id"
+	Attr_Zone            = "zone"
+	Attr_State           = "state"
+
 	// status
 	// common status states
 	StatusShutoff = "SHUTOFF"
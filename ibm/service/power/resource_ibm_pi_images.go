@@ -0,0 +1,387 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/errors"
+	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_images"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+// ResourceIBMPIImages imports an image from Cloud Object Storage into several cloud instances, in parallel
+func ResourceIBMPIImages() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPIImagesCreate,
+		ReadContext:   resourceIBMPIImagesRead,
+		UpdateContext: resourceIBMPIImagesUpdate,
+		DeleteContext: resourceIBMPIImagesDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+			// The targets the image failed to be imported into, or was deleted from, are imported again
+			if diff.Id() != "" && len(resourceIBMPIImagesMissingTargets(diff.Get(Arg_ImageTargets).(*schema.Set), diff.Get(Attr_Images).([]interface{}))) > 0 {
+				return diff.SetNewComputed(Attr_Images)
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			helpers.PIImageName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateNoZeroValues,
+				Description:  "The name of the image in the cloud instances",
+			},
+			Arg_ImageTargets: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "The cloud instances the image is imported into",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Arg_CloudInstanceID: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ValidateNoZeroValues,
+							Description:  "The ID of the PI cloud instance",
+						},
+						Arg_Zone: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The zone of the PI cloud instance, which overrides the zone of the provider",
+						},
+					},
+				},
+			},
+
+			// COS import variables
+			helpers.PIImageBucketName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateNoZeroValues,
+				Description:  "Cloud Object Storage bucket name; bucket-name[/optional/folder]",
+			},
+			helpers.PIImageBucketFileName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateNoZeroValues,
+				Description:  "Cloud Object Storage image filename",
+			},
+			helpers.PIImageBucketRegion: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateNoZeroValues,
+				Description:  "Cloud Object Storage region",
+			},
+			helpers.PIImageBucketAccess: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "public",
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "Indicates if the bucket has public or private access",
+			},
+			helpers.PIImageAccessKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				RequiredWith: []string{helpers.PIImageSecretKey},
+				Description:  "Cloud Object Storage access key; required for buckets with private access",
+			},
+			helpers.PIImageSecretKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				RequiredWith: []string{helpers.PIImageAccessKey},
+				Description:  "Cloud Object Storage secret key; required for buckets with private access",
+			},
+			helpers.PIImageStorageType: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Type of storage",
+			},
+
+			// Computed Attribute
+			Attr_Images: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The images imported into the cloud instances",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_CloudInstanceID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the PI cloud instance",
+						},
+						Attr_Zone: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The zone of the PI cloud instance",
+						},
+						Attr_ImageID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the image in the PI cloud instance",
+						},
+						Attr_State: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The state of the image",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMPIImagesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get(helpers.PIImageName).(string))
+
+	images, diags := resourceIBMPIImagesImport(ctx, d, meta, d.Get(Arg_ImageTargets).(*schema.Set).List(), d.Timeout(schema.TimeoutCreate))
+	if len(images) == 0 {
+		d.SetId("")
+		return diags
+	}
+	d.Set(Attr_Images, images)
+
+	// The resource is not tainted when the image fails to be imported into some of the targets, they are imported
+	// again by the next apply
+	for i := range diags {
+		diags[i].Severity = diag.Warning
+	}
+	return append(diags, resourceIBMPIImagesRead(ctx, d, meta)...)
+}
+
+func resourceIBMPIImagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	images := make([]interface{}, 0)
+	for _, imageIntf := range d.Get(Attr_Images).([]interface{}) {
+		image := imageIntf.(map[string]interface{})
+		sess, err := meta.(conns.ClientSession).IBMPISessionForZone(image[Attr_Zone].(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		client := st.NewIBMPIImageClient(ctx, sess, image[Attr_CloudInstanceID].(string))
+		imagedata, err := client.Get(image[Attr_ImageID].(string))
+		if err != nil {
+			uErr := errors.Unwrap(err)
+			switch uErr.(type) {
+			case *p_cloud_images.PcloudCloudinstancesImagesGetNotFound:
+				log.Printf("[DEBUG] image %s does not exist in cloud instance %s %v", image[Attr_ImageID], image[Attr_CloudInstanceID], err)
+				continue
+			}
+			log.Printf("[DEBUG] get image failed %v", err)
+			return diag.FromErr(err)
+		}
+		image[Attr_State] = imagedata.State
+		images = append(images, image)
+	}
+	d.Set(Attr_Images, images)
+
+	return nil
+}
+
+func resourceIBMPIImagesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	targets := d.Get(Arg_ImageTargets).(*schema.Set)
+	images := make([]interface{}, 0)
+
+	// The images of the removed targets are deleted
+	oldImages := d.Get(Attr_Images).([]interface{})
+	for i, imageIntf := range oldImages {
+		image := imageIntf.(map[string]interface{})
+		if resourceIBMPIImagesTarget(targets, image[Attr_CloudInstanceID].(string)) != nil {
+			images = append(images, image)
+			continue
+		}
+		if err := resourceIBMPIImagesDeleteImage(ctx, meta, image); err != nil {
+			// The images that are not deleted yet are kept in the state
+			d.Set(Attr_Images, append(images, oldImages[i:]...))
+			return diag.FromErr(err)
+		}
+	}
+
+	imported, diags := resourceIBMPIImagesImport(ctx, d, meta, resourceIBMPIImagesMissingTargets(targets, images), d.Timeout(schema.TimeoutUpdate))
+	d.Set(Attr_Images, append(images, imported...))
+	if diags.HasError() {
+		return diags
+	}
+
+	return resourceIBMPIImagesRead(ctx, d, meta)
+}
+
+func resourceIBMPIImagesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	for _, imageIntf := range d.Get(Attr_Images).([]interface{}) {
+		if err := resourceIBMPIImagesDeleteImage(ctx, meta, imageIntf.(map[string]interface{})); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMPIImagesImport imports the image into the targets in parallel, and returns the images that are imported
+// and the errors of the targets the image failed to be imported into
+func resourceIBMPIImagesImport(ctx context.Context, d *schema.ResourceData, meta interface{}, targets []interface{}, timeout time.Duration) ([]interface{}, diag.Diagnostics) {
+	var (
+		wg     sync.WaitGroup
+		mutex  sync.Mutex
+		images = make([]interface{}, 0, len(targets))
+		diags  diag.Diagnostics
+	)
+	for _, targetIntf := range targets {
+		target := targetIntf.(map[string]interface{})
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			image, err := resourceIBMPIImagesImportImage(ctx, d, meta, target, timeout)
+			mutex.Lock()
+			defer mutex.Unlock()
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("Error importing image into cloud instance %s", target[Arg_CloudInstanceID]),
+					Detail:   err.Error(),
+				})
+				return
+			}
+			images = append(images, image)
+		}()
+	}
+	wg.Wait()
+
+	return images, diags
+}
+
+// resourceIBMPIImagesImportImage imports the image into the cloud instance of the target and waits for the job
+func resourceIBMPIImagesImportImage(ctx context.Context, d *schema.ResourceData, meta interface{}, target map[string]interface{}, timeout time.Duration) (map[string]interface{}, error) {
+	cloudInstanceID := target[Arg_CloudInstanceID].(string)
+	zone := target[Arg_Zone].(string)
+	sess, err := meta.(conns.ClientSession).IBMPISessionForZone(zone)
+	if err != nil {
+		return nil, err
+	}
+
+	imageName := d.Get(helpers.PIImageName).(string)
+	bucketName := d.Get(helpers.PIImageBucketName).(string)
+	bucketAccess := d.Get(helpers.PIImageBucketAccess).(string)
+	bucketImageFileName := d.Get(helpers.PIImageBucketFileName).(string)
+	bucketRegion := d.Get(helpers.PIImageBucketRegion).(string)
+	body := &models.CreateCosImageImportJob{
+		ImageName:     &imageName,
+		BucketName:    &bucketName,
+		BucketAccess:  &bucketAccess,
+		ImageFilename: &bucketImageFileName,
+		Region:        &bucketRegion,
+	}
+	if v, ok := d.GetOk(helpers.PIImageAccessKey); ok {
+		body.AccessKey = v.(string)
+	}
+	if v, ok := d.GetOk(helpers.PIImageSecretKey); ok {
+		body.SecretKey = v.(string)
+	}
+	if v, ok := d.GetOk(helpers.PIImageStorageType); ok {
+		body.StorageType = v.(string)
+	}
+
+	client := st.NewIBMPIImageClient(ctx, sess, cloudInstanceID)
+	imageResponse, err := client.CreateCosImage(body)
+	if err != nil {
+		return nil, err
+	}
+
+	jobClient := st.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
+	_, err = waitForIBMPIJobCompleted(ctx, jobClient, *imageResponse.ID, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// Once the job is completed find by name
+	image, err := client.Get(imageName)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		Attr_CloudInstanceID: cloudInstanceID,
+		Attr_Zone:            zone,
+		Attr_ImageID:         *image.ImageID,
+		Attr_State:           image.State,
+	}, nil
+}
+
+func resourceIBMPIImagesDeleteImage(ctx context.Context, meta interface{}, image map[string]interface{}) error {
+	sess, err := meta.(conns.ClientSession).IBMPISessionForZone(image[Attr_Zone].(string))
+	if err != nil {
+		return err
+	}
+
+	client := st.NewIBMPIImageClient(ctx, sess, image[Attr_CloudInstanceID].(string))
+	err = client.Delete(image[Attr_ImageID].(string))
+	if err != nil {
+		uErr := errors.Unwrap(err)
+		switch uErr.(type) {
+		case *p_cloud_images.PcloudCloudinstancesImagesDeleteNotFound:
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// resourceIBMPIImagesTarget returns the target of the cloud instance
+func resourceIBMPIImagesTarget(targets *schema.Set, cloudInstanceID string) map[string]interface{} {
+	for _, targetIntf := range targets.List() {
+		target := targetIntf.(map[string]interface{})
+		if target[Arg_CloudInstanceID].(string) == cloudInstanceID {
+			return target
+		}
+	}
+	return nil
+}
+
+// resourceIBMPIImagesMissingTargets returns the targets that do not have an image
+func resourceIBMPIImagesMissingTargets(targets *schema.Set, images []interface{}) []interface{} {
+	missing := make([]interface{}, 0)
+	for _, targetIntf := range targets.List() {
+		target := targetIntf.(map[string]interface{})
+		found := false
+		for _, imageIntf := range images {
+			if imageIntf.(map[string]interface{})[Attr_CloudInstanceID].(string) == target[Arg_CloudInstanceID].(string) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, target)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+)
+
+func TestAccIBMPIImagesCOSPublicImport(t *testing.T) {
+	imagesRes := "ibm_pi_images.cos_images"
+	name := fmt.Sprintf("tf-pi-images-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIImagesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIImagesCOSPublicConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(imagesRes, "pi_image_name", name),
+					resource.TestCheckResourceAttr(imagesRes, "images.#", "1"),
+					resource.TestCheckResourceAttr(imagesRes, "images.0.cloud_instance_id", acc.Pi_cloud_instance_id),
+					resource.TestCheckResourceAttrSet(imagesRes, "images.0.image_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIImagesDestroy(s *terraform.State) error {
+	sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_pi_images" {
+			continue
+		}
+		imageC := st.NewIBMPIImageClient(context.Background(), sess, acc.Pi_cloud_instance_id)
+		_, err = imageC.Get(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("PI Image still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMPIImagesCOSPublicConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_pi_images" "cos_images" {
+		pi_image_name             = "%[1]s"
+		pi_image_bucket_name      = "%[3]s"
+		pi_image_bucket_access    = "public"
+		pi_image_bucket_region    = "us-south"
+		pi_image_bucket_file_name = "%[4]s"
+		pi_image_storage_type     = "tier1"
+		pi_targets {
+			pi_cloud_instance_id = "%[2]s"
+		}
+	}
+	`, name, acc.Pi_cloud_instance_id, acc.Pi_image_bucket_name, acc.Pi_image_bucket_file_name)
+}
@@ -38,6 +38,12 @@ func ResourceIBMPIKey() *schema.Resource {
 				Required:    true,
 				Description: "PI cloud instance ID",
 			},
+			Arg_Zone: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The zone of the PI cloud instance, which overrides the zone of the provider",
+			},
 			Arg_KeyName: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -77,7 +83,7 @@ func ResourceIBMPIKey() *schema.Resource {
 func resourceIBMPIKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	// session
-	sess, err := meta.(conns.ClientSession).IBMPISession()
+	sess, err := meta.(conns.ClientSession).IBMPISessionForZone(d.Get(Arg_Zone).(string))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -107,7 +113,7 @@ func resourceIBMPIKeyCreate(ctx context.Context, d *schema.ResourceData, meta in
 func resourceIBMPIKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	// session
-	sess, err := meta.(conns.ClientSession).IBMPISession()
+	sess, err := meta.(conns.ClientSession).IBMPISessionForZone(d.Get(Arg_Zone).(string))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -139,7 +145,7 @@ func resourceIBMPIKeyUpdate(ctx context.Context, d *schema.ResourceData, meta in
 func resourceIBMPIKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	// session
-	sess, err := meta.(conns.ClientSession).IBMPISession()
+	sess, err := meta.(conns.ClientSession).IBMPISessionForZone(d.Get(Arg_Zone).(string))
 	if err != nil {
 		return diag.FromErr(err)
 	}
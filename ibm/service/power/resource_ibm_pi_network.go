@@ -44,6 +44,12 @@ func ResourceIBMPINetwork() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			Arg_Zone: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The zone of the PI cloud instance, which overrides the zone of the provider",
+			},
 			helpers.PINetworkType: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -122,7 +128,7 @@ func ResourceIBMPINetwork() *schema.Resource {
 }
 
 func resourceIBMPINetworkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	sess, err := meta.(conns.ClientSession).IBMPISession()
+	sess, err := meta.(conns.ClientSession).IBMPISessionForZone(d.Get(Arg_Zone).(string))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -193,7 +199,7 @@ func resourceIBMPINetworkCreate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceIBMPINetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	sess, err := meta.(conns.ClientSession).IBMPISession()
+	sess, err := meta.(conns.ClientSession).IBMPISessionForZone(d.Get(Arg_Zone).(string))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -236,7 +242,7 @@ func resourceIBMPINetworkRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceIBMPINetworkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	sess, err := meta.(conns.ClientSession).IBMPISession()
+	sess, err := meta.(conns.ClientSession).IBMPISessionForZone(d.Get(Arg_Zone).(string))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -272,7 +278,7 @@ func resourceIBMPINetworkUpdate(ctx context.Context, d *schema.ResourceData, met
 func resourceIBMPINetworkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	log.Printf("Calling the network delete functions. ")
-	sess, err := meta.(conns.ClientSession).IBMPISession()
+	sess, err := meta.(conns.ClientSession).IBMPISessionForZone(d.Get(Arg_Zone).(string))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,281 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/transitgateway"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+)
+
+const (
+	tgNetworkTypePowerVirtualServer = "power_virtual_server"
+)
+
+func ResourceIBMPITransitGatewayConnection() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPITransitGatewayConnectionCreate,
+		ReadContext:   resourceIBMPITransitGatewayConnectionRead,
+		UpdateContext: resourceIBMPITransitGatewayConnectionUpdate,
+		DeleteContext: resourceIBMPITransitGatewayConnectionDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "PI cloud instance ID of a Power Edge Router enabled workspace",
+			},
+			Arg_TransitGatewayID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The Transit Gateway identifier",
+			},
+			Arg_TransitGatewayConnectionName: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The user-defined name for the transit gateway connection. Defaults to the workspace name",
+			},
+			Arg_TransitGatewayPrefixFiltersDefault: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "permit",
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"permit", "deny"}),
+				Description:  "Whether routes of the workspace are advertised to the transit gateway when no prefix filter matches (permit or deny)",
+			},
+
+			// Attributes
+			Attr_TransitGatewayConnectionID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Transit Gateway connection identifier",
+			},
+			Attr_TransitGatewayConnectionStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The configuration state of the connection. Possible values: [attached,failed,pending,deleting,detaching,detached]",
+			},
+			Attr_TransitGatewayNetworkID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the workspace connected to the transit gateway",
+			},
+			Attr_TransitGatewayCreatedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the connection was created",
+			},
+		},
+	}
+}
+
+func resourceIBMPITransitGatewayConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tgClient, err := meta.(conns.ClientSession).TransitGatewayV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	gatewayID := d.Get(Arg_TransitGatewayID).(string)
+
+	// PER connections are only possible for workspaces that are attached to a Power Edge Router
+	ciClient := st.NewIBMPICloudInstanceClient(ctx, sess, cloudInstanceID)
+	cloudInstance, err := ciClient.Get(cloudInstanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !flex.StringContains(cloudInstance.Capabilities, PowerEdgeRouterCapability) {
+		return diag.Errorf("[ERROR] workspace %s is not Power Edge Router enabled, use ibm_pi_cloud_connection to connect it to a transit gateway", cloudInstanceID)
+	}
+
+	workspaceCRN, err := getPIWorkspaceCRN(meta, cloudInstanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createOptions := &transitgatewayapisv1.CreateTransitGatewayConnectionOptions{}
+	createOptions.SetTransitGatewayID(gatewayID)
+	createOptions.SetNetworkType(tgNetworkTypePowerVirtualServer)
+	createOptions.SetNetworkID(workspaceCRN)
+	createOptions.SetPrefixFiltersDefault(d.Get(Arg_TransitGatewayPrefixFiltersDefault).(string))
+	if v, ok := d.GetOk(Arg_TransitGatewayConnectionName); ok {
+		createOptions.SetName(v.(string))
+	}
+
+	connection, response, err := tgClient.CreateTransitGatewayConnectionWithContext(ctx, createOptions)
+	if err != nil {
+		log.Printf("[DEBUG] create transit gateway connection failed %v\n%s", err, response)
+		return diag.Errorf("[ERROR] Error creating Transit Gateway connection for workspace %s: %s\n%s", cloudInstanceID, err, response)
+	}
+
+	tgConnectionID := fmt.Sprintf("%s/%s", gatewayID, *connection.ID)
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, tgConnectionID))
+
+	_, err = transitgateway.WaitForTransitGatewayConnectionAvailable(tgClient, tgConnectionID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMPITransitGatewayConnectionRead(ctx, d, meta)
+}
+
+func resourceIBMPITransitGatewayConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tgClient, err := meta.(conns.ClientSession).TransitGatewayV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) != 3 {
+		return diag.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of cloudInstanceID/gatewayID/connectionID", d.Id())
+	}
+	cloudInstanceID, gatewayID, connectionID := parts[0], parts[1], parts[2]
+
+	getOptions := &transitgatewayapisv1.GetTransitGatewayConnectionOptions{}
+	getOptions.SetTransitGatewayID(gatewayID)
+	getOptions.SetID(connectionID)
+	connection, response, err := tgClient.GetTransitGatewayConnectionWithContext(ctx, getOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("[ERROR] Error getting Transit Gateway connection (%s): %s\n%s", connectionID, err, response)
+	}
+
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
+	d.Set(Arg_TransitGatewayID, gatewayID)
+	d.Set(Attr_TransitGatewayConnectionID, connection.ID)
+	d.Set(Arg_TransitGatewayConnectionName, connection.Name)
+	d.Set(Attr_TransitGatewayNetworkID, connection.NetworkID)
+	d.Set(Attr_TransitGatewayConnectionStatus, connection.Status)
+	if connection.PrefixFiltersDefault != nil {
+		d.Set(Arg_TransitGatewayPrefixFiltersDefault, connection.PrefixFiltersDefault)
+	}
+	if connection.CreatedAt != nil {
+		d.Set(Attr_TransitGatewayCreatedAt, connection.CreatedAt.String())
+	}
+
+	return nil
+}
+
+func resourceIBMPITransitGatewayConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tgClient, err := meta.(conns.ClientSession).TransitGatewayV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	gatewayID, connectionID := parts[1], parts[2]
+
+	if d.HasChanges(Arg_TransitGatewayConnectionName, Arg_TransitGatewayPrefixFiltersDefault) {
+		updateOptions := &transitgatewayapisv1.UpdateTransitGatewayConnectionOptions{}
+		updateOptions.SetTransitGatewayID(gatewayID)
+		updateOptions.SetID(connectionID)
+		if d.HasChange(Arg_TransitGatewayConnectionName) {
+			updateOptions.SetName(d.Get(Arg_TransitGatewayConnectionName).(string))
+		}
+		if d.HasChange(Arg_TransitGatewayPrefixFiltersDefault) {
+			updateOptions.SetPrefixFiltersDefault(d.Get(Arg_TransitGatewayPrefixFiltersDefault).(string))
+		}
+		_, response, err := tgClient.UpdateTransitGatewayConnectionWithContext(ctx, updateOptions)
+		if err != nil {
+			return diag.Errorf("[ERROR] Error updating Transit Gateway connection (%s): %s\n%s", connectionID, err, response)
+		}
+
+		_, err = transitgateway.WaitForTransitGatewayConnectionAvailable(tgClient, fmt.Sprintf("%s/%s", gatewayID, connectionID), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMPITransitGatewayConnectionRead(ctx, d, meta)
+}
+
+func resourceIBMPITransitGatewayConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tgClient, err := meta.(conns.ClientSession).TransitGatewayV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	gatewayID, connectionID := parts[1], parts[2]
+
+	deleteOptions := &transitgatewayapisv1.DeleteTransitGatewayConnectionOptions{}
+	deleteOptions.SetTransitGatewayID(gatewayID)
+	deleteOptions.SetID(connectionID)
+	response, err := tgClient.DeleteTransitGatewayConnectionWithContext(ctx, deleteOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("[ERROR] Error deleting Transit Gateway connection (%s): %s\n%s", connectionID, err, response)
+	}
+
+	_, err = transitgateway.WaitForTransitGatewayConnectionDeleted(tgClient, fmt.Sprintf("%s/%s", gatewayID, connectionID), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// getPIWorkspaceCRN looks up the CRN of a Power Virtual Server workspace, the
+// cloud instance ID being the GUID of its resource instance.
+func getPIWorkspaceCRN(meta interface{}, cloudInstanceID string) (string, error) {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return "", err
+	}
+	instance, response, err := rsConClient.GetResourceInstance(&rc.GetResourceInstanceOptions{
+		ID: &cloudInstanceID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error retrieving workspace %s: %s\n%s", cloudInstanceID, err, response)
+	}
+	if instance.CRN == nil {
+		return "", fmt.Errorf("[ERROR] Workspace %s has no CRN", cloudInstanceID)
+	}
+	return *instance.CRN, nil
+}
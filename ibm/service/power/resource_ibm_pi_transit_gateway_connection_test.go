@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMPITransitGatewayConnectionbasic(t *testing.T) {
+	gatewayName := fmt.Sprintf("tf-tg-per-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-per-connection-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPITransitGatewayConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPITransitGatewayConnectionConfig(gatewayName, name, "permit"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPITransitGatewayConnectionExists("ibm_pi_transit_gateway_connection.per_connection"),
+					resource.TestCheckResourceAttr("ibm_pi_transit_gateway_connection.per_connection",
+						"pi_connection_name", name),
+					resource.TestCheckResourceAttr("ibm_pi_transit_gateway_connection.per_connection",
+						"status", "attached"),
+					resource.TestCheckResourceAttrSet("ibm_pi_transit_gateway_connection.per_connection", "network_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPITransitGatewayConnectionConfig(gatewayName, name, "deny"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPITransitGatewayConnectionExists("ibm_pi_transit_gateway_connection.per_connection"),
+					resource.TestCheckResourceAttr("ibm_pi_transit_gateway_connection.per_connection",
+						"pi_prefix_filters_default", "deny"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPITransitGatewayConnectionDestroy(s *terraform.State) error {
+	client, err := acc.TestAccProvider.Meta().(conns.ClientSession).TransitGatewayV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_pi_transit_gateway_connection" {
+			continue
+		}
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		getOptions := &transitgatewayapisv1.GetTransitGatewayConnectionOptions{}
+		getOptions.SetTransitGatewayID(parts[1])
+		getOptions.SetID(parts[2])
+		_, _, err = client.GetTransitGatewayConnection(getOptions)
+		if err == nil {
+			return fmt.Errorf("PI transit gateway connection still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMPITransitGatewayConnectionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return errors.New("No Record ID is set")
+		}
+
+		client, err := acc.TestAccProvider.Meta().(conns.ClientSession).TransitGatewayV1API()
+		if err != nil {
+			return err
+		}
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		getOptions := &transitgatewayapisv1.GetTransitGatewayConnectionOptions{}
+		getOptions.SetTransitGatewayID(parts[1])
+		getOptions.SetID(parts[2])
+		_, _, err = client.GetTransitGatewayConnection(getOptions)
+		return err
+	}
+}
+
+func testAccCheckIBMPITransitGatewayConnectionConfig(gatewayName, name, prefixFiltersDefault string) string {
+	return fmt.Sprintf(`
+		resource "ibm_tg_gateway" "tg_gateway" {
+			name     = "%s"
+			location = "us-south"
+			global   = true
+		}
+
+		resource "ibm_pi_transit_gateway_connection" "per_connection" {
+			pi_cloud_instance_id      = "%s"
+			pi_transit_gateway_id     = ibm_tg_gateway.tg_gateway.id
+			pi_connection_name        = "%s"
+			pi_prefix_filters_default = "%s"
+		}
+	`, gatewayName, acc.Pi_cloud_instance_id, name, prefixFiltersDefault)
+}
@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("ibm_pi_instance", &resource.Sweeper{
+		Name: "ibm_pi_instance",
+		F:    testSweepPIInstances,
+	})
+}
+
+// testSweepPIInstances deletes the instances of the workspace set by PI_CLOUDINSTANCE_ID
+func testSweepPIInstances(region string) error {
+	if acc.Pi_cloud_instance_id == "" {
+		log.Printf("[WARN] Skipping the sweep of the Power instances, PI_CLOUDINSTANCE_ID is not set")
+		return nil
+	}
+	sess, err := acc.SharedClientSession(region)
+	if err != nil {
+		return err
+	}
+	piSession, err := sess.IBMPISession()
+	if err != nil {
+		return err
+	}
+
+	client := st.NewIBMPIInstanceClient(context.Background(), piSession, acc.Pi_cloud_instance_id)
+	instances, err := client.GetAll()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error listing the Power instances: %s", err)
+	}
+
+	var sweepErrs []string
+	for _, instance := range instances.PvmInstances {
+		if instance.ServerName == nil || !acc.IsSweepable(*instance.ServerName) {
+			continue
+		}
+		log.Printf("[INFO] Deleting the Power instance %s (%s)", *instance.ServerName, *instance.PvmInstanceID)
+		if err := client.Delete(*instance.PvmInstanceID); err != nil {
+			sweepErrs = append(sweepErrs, fmt.Sprintf("%s: %s", *instance.ServerName, err))
+		}
+	}
+	return acc.SweepErrors(sweepErrs)
+}
@@ -0,0 +1,113 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package registry
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/container-registry-go-sdk/vulnerabilityadvisorv4"
+)
+
+func DataIBMCrVaExemptions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataIBMCrVaExemptionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "List the exemptions that apply to this registry resource: a namespace, namespace/repository, namespace/repository:tag, or namespace/repository@sha256:hash. If not set, all the exemptions of the account are listed.",
+			},
+			"exemptions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Vulnerability Advisor exemptions",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the IBM Cloud account of the exemption.",
+						},
+						"issue_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the exempted issue.",
+						},
+						"issue_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the exempted issue.",
+						},
+						"scope_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of scope the exemption applies to: account, namespace, repository or image.",
+						},
+						"namespace": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The namespace included in the scope of the exemption.",
+						},
+						"repository": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The repository included in the scope of the exemption.",
+						},
+						"tag": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The tag included in the scope of the exemption.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataIBMCrVaExemptionsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vulnerabilityAdvisorClient, err := meta.(conns.ClientSession).VulnerabilityAdvisorV4()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var exemptionList []vulnerabilityadvisorv4.Exemption
+	if resource, ok := d.GetOk("resource"); ok {
+		listExemptionResourceOptions := vulnerabilityAdvisorClient.NewListExemptionResourceOptions(resource.(string))
+		exemptionList, _, err = vulnerabilityAdvisorClient.ListExemptionResourceWithContext(context, listExemptionResourceOptions)
+	} else {
+		listAccountExemptionsOptions := vulnerabilityAdvisorClient.NewListAccountExemptionsOptions()
+		exemptionList, _, err = vulnerabilityAdvisorClient.ListAccountExemptionsWithContext(context, listAccountExemptionsOptions)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	exemptions := []map[string]interface{}{}
+	for _, exemptionItem := range exemptionList {
+		exemption := map[string]interface{}{}
+		exemption["account_id"] = exemptionItem.AccountID
+		exemption["issue_type"] = exemptionItem.IssueType
+		exemption["issue_id"] = exemptionItem.IssueID
+		if exemptionItem.Scope != nil {
+			exemption["scope_type"] = exemptionItem.Scope.ScopeType
+			exemption["namespace"] = exemptionItem.Scope.Namespace
+			exemption["repository"] = exemptionItem.Scope.Repository
+			exemption["tag"] = exemptionItem.Scope.Tag
+		}
+		exemptions = append(exemptions, exemption)
+	}
+	if err = d.Set("exemptions", exemptions); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting exemptions: %s", err))
+	}
+	d.SetId(time.Now().UTC().String())
+	return nil
+}
@@ -0,0 +1,232 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package registry
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/container-registry-go-sdk/vulnerabilityadvisorv4"
+)
+
+func ResourceIBMCrVaExemption() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCrVaExemptionCreate,
+		ReadContext:   resourceIBMCrVaExemptionRead,
+		DeleteContext: resourceIBMCrVaExemptionDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"resource": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The registry resource the exemption applies to: a namespace, namespace/repository, namespace/repository:tag, or namespace/repository@sha256:hash. If not set, the exemption applies to the whole account.",
+			},
+			"issue_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_cr_va_exemption", "issue_type"),
+				Description:  "The type of the exempted issue, for example 'cve', 'sn' or 'configuration'.",
+			},
+			"issue_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the exempted issue, for example 'CVE-2018-9999'.",
+			},
+			"account_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the IBM Cloud account of the exemption.",
+			},
+			"scope_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of scope the exemption applies to: account, namespace, repository or image.",
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The namespace included in the scope of the exemption.",
+			},
+			"repository": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The repository included in the scope of the exemption.",
+			},
+			"tag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The tag included in the scope of the exemption.",
+			},
+		},
+	}
+}
+
+func ResourceIBMCrVaExemptionValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "issue_type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "cve, sn, configuration",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_cr_va_exemption", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMCrVaExemptionCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vulnerabilityAdvisorClient, err := meta.(conns.ClientSession).VulnerabilityAdvisorV4()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	issueType := d.Get("issue_type").(string)
+	issueID := d.Get("issue_id").(string)
+	resource := d.Get("resource").(string)
+
+	if resource == "" {
+		createExemptionAccountOptions := vulnerabilityAdvisorClient.NewCreateExemptionAccountOptions(issueType, issueID)
+		_, response, err := vulnerabilityAdvisorClient.CreateExemptionAccountWithContext(context, createExemptionAccountOptions)
+		if err != nil {
+			log.Printf("[DEBUG] CreateExemptionAccountWithContext failed %s\n%s", err, response)
+			return diag.FromErr(err)
+		}
+	} else {
+		createExemptionResourceOptions := vulnerabilityAdvisorClient.NewCreateExemptionResourceOptions(resource, issueType, issueID)
+		_, response, err := vulnerabilityAdvisorClient.CreateExemptionResourceWithContext(context, createExemptionResourceOptions)
+		if err != nil {
+			log.Printf("[DEBUG] CreateExemptionResourceWithContext failed %s\n%s", err, response)
+			return diag.FromErr(err)
+		}
+	}
+
+	// The resource is last as it may itself contain '/'
+	d.SetId(fmt.Sprintf("%s/%s/%s", issueType, issueID, resource))
+
+	return resourceIBMCrVaExemptionRead(context, d, meta)
+}
+
+func resourceIBMCrVaExemptionRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vulnerabilityAdvisorClient, err := meta.(conns.ClientSession).VulnerabilityAdvisorV4()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	issueType, issueID, resource, err := crVaExemptionIDParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var exemption *vulnerabilityadvisorv4.Exemption
+	if resource == "" {
+		getExemptionAccountOptions := vulnerabilityAdvisorClient.NewGetExemptionAccountOptions(issueType, issueID)
+		result, detailedResponse, err := vulnerabilityAdvisorClient.GetExemptionAccountWithContext(context, getExemptionAccountOptions)
+		if err != nil {
+			if detailedResponse != nil && detailedResponse.StatusCode == 404 {
+				d.SetId("")
+				return nil
+			}
+			log.Printf("[DEBUG] GetExemptionAccountWithContext failed %s\n%s", err, detailedResponse)
+			return diag.FromErr(err)
+		}
+		exemption = result
+	} else {
+		getExemptionResourceOptions := vulnerabilityAdvisorClient.NewGetExemptionResourceOptions(resource, issueType, issueID)
+		result, detailedResponse, err := vulnerabilityAdvisorClient.GetExemptionResourceWithContext(context, getExemptionResourceOptions)
+		if err != nil {
+			if detailedResponse != nil && detailedResponse.StatusCode == 404 {
+				d.SetId("")
+				return nil
+			}
+			log.Printf("[DEBUG] GetExemptionResourceWithContext failed %s\n%s", err, detailedResponse)
+			return diag.FromErr(err)
+		}
+		exemption = result
+	}
+
+	if err = d.Set("resource", resource); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resource: %s", err))
+	}
+	if err = d.Set("issue_type", exemption.IssueType); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting issue_type: %s", err))
+	}
+	if err = d.Set("issue_id", exemption.IssueID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting issue_id: %s", err))
+	}
+	if err = d.Set("account_id", exemption.AccountID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting account_id: %s", err))
+	}
+	if exemption.Scope != nil {
+		if err = d.Set("scope_type", exemption.Scope.ScopeType); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting scope_type: %s", err))
+		}
+		if err = d.Set("namespace", exemption.Scope.Namespace); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting namespace: %s", err))
+		}
+		if err = d.Set("repository", exemption.Scope.Repository); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting repository: %s", err))
+		}
+		if err = d.Set("tag", exemption.Scope.Tag); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting tag: %s", err))
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMCrVaExemptionDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vulnerabilityAdvisorClient, err := meta.(conns.ClientSession).VulnerabilityAdvisorV4()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	issueType, issueID, resource, err := crVaExemptionIDParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if resource == "" {
+		deleteExemptionAccountOptions := vulnerabilityAdvisorClient.NewDeleteExemptionAccountOptions(issueType, issueID)
+		response, err := vulnerabilityAdvisorClient.DeleteExemptionAccountWithContext(context, deleteExemptionAccountOptions)
+		if err != nil {
+			log.Printf("[DEBUG] DeleteExemptionAccountWithContext failed %s\n%s", err, response)
+			return diag.FromErr(err)
+		}
+	} else {
+		deleteExemptionResourceOptions := vulnerabilityAdvisorClient.NewDeleteExemptionResourceOptions(resource, issueType, issueID)
+		response, err := vulnerabilityAdvisorClient.DeleteExemptionResourceWithContext(context, deleteExemptionResourceOptions)
+		if err != nil {
+			log.Printf("[DEBUG] DeleteExemptionResourceWithContext failed %s\n%s", err, response)
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// crVaExemptionIDParts splits an ID of the form <issue_type>/<issue_id>/<resource>,
+// where the resource is empty for account wide exemptions.
+func crVaExemptionIDParts(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" {
+		return "", "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of issueType/issueID/resource", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
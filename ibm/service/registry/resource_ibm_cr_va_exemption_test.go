@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package registry_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMCrVaExemptionNamespace(t *testing.T) {
+	namespace := fmt.Sprintf("tf-namespace-%d", acctest.RandIntRange(10, 100))
+	issueID := "CVE-2018-9999"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCrVaExemptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCrVaExemptionConfig(namespace, issueID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cr_va_exemption.cr_va_exemption", "resource", namespace),
+					resource.TestCheckResourceAttr("ibm_cr_va_exemption.cr_va_exemption", "issue_type", "cve"),
+					resource.TestCheckResourceAttr("ibm_cr_va_exemption.cr_va_exemption", "issue_id", issueID),
+					resource.TestCheckResourceAttr("ibm_cr_va_exemption.cr_va_exemption", "scope_type", "namespace"),
+					resource.TestCheckResourceAttr("ibm_cr_va_exemption.cr_va_exemption", "namespace", namespace),
+					resource.TestCheckResourceAttrSet("data.ibm_cr_va_exemptions.cr_va_exemptions", "exemptions.#"),
+				),
+			},
+			{
+				ResourceName:      "ibm_cr_va_exemption.cr_va_exemption",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCrVaExemptionConfig(namespace string, issueID string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cr_namespace" "cr_namespace" {
+			name = "%s"
+		}
+
+		resource "ibm_cr_va_exemption" "cr_va_exemption" {
+			resource   = ibm_cr_namespace.cr_namespace.name
+			issue_type = "cve"
+			issue_id   = "%s"
+		}
+
+		data "ibm_cr_va_exemptions" "cr_va_exemptions" {
+			resource = ibm_cr_va_exemption.cr_va_exemption.resource
+		}
+	`, namespace, issueID)
+}
+
+func testAccCheckIBMCrVaExemptionDestroy(s *terraform.State) error {
+	vulnerabilityAdvisorClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VulnerabilityAdvisorV4()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_cr_va_exemption" {
+			continue
+		}
+
+		getExemptionResourceOptions := vulnerabilityAdvisorClient.NewGetExemptionResourceOptions(
+			rs.Primary.Attributes["resource"], rs.Primary.Attributes["issue_type"], rs.Primary.Attributes["issue_id"])
+
+		_, response, err := vulnerabilityAdvisorClient.GetExemptionResource(getExemptionResourceOptions)
+
+		if err == nil {
+			return fmt.Errorf("cr_va_exemption still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 && response.StatusCode != 403 { // 403 once the namespace is deleted
+			return fmt.Errorf("[ERROR] Error checking for cr_va_exemption (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+)
+
+func DataSourceIBMResourceReclamations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMResourceReclamationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The GUID of the resource instance to list the reclamations of.",
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the resource group to list the reclamations of.",
+			},
+			"account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the account to list the reclamations of, the account of the provider by default.",
+			},
+			"reclamations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The reclamations of the service instances that were deleted and can still be restored or reclaimed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the reclamation.",
+						},
+						"entity_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the entity of the reclamation.",
+						},
+						"entity_type_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the type of the entity.",
+						},
+						"entity_crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the entity.",
+						},
+						"resource_instance_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The GUID of the resource instance.",
+						},
+						"resource_group_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the resource group of the resource instance.",
+						},
+						"account_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the account of the resource instance.",
+						},
+						"policy_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the reclamation policy.",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The state of the reclamation.",
+						},
+						"target_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time when the resource instance is reclaimed, the end of the retention window.",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date when the reclamation was created.",
+						},
+						"created_by": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The subject who created the reclamation.",
+						},
+						"updated_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date when the reclamation was last updated.",
+						},
+						"updated_by": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The subject who updated the reclamation.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMResourceReclamationsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	listOptions := &rc.ListReclamationsOptions{}
+	if v, ok := d.GetOk("resource_instance_id"); ok {
+		listOptions.SetResourceInstanceID(v.(string))
+	}
+	if v, ok := d.GetOk("resource_group_id"); ok {
+		listOptions.SetResourceGroupID(v.(string))
+	}
+	if v, ok := d.GetOk("account_id"); ok {
+		listOptions.SetAccountID(v.(string))
+	}
+	reclamationsList, response, err := rsConClient.ListReclamationsWithContext(context, listOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing the reclamations: %s with resp code: %s", err, response))
+	}
+
+	reclamations := make([]map[string]interface{}, 0, len(reclamationsList.Resources))
+	for _, reclamation := range reclamationsList.Resources {
+		reclamations = append(reclamations, flattenResourceReclamation(reclamation))
+	}
+	d.SetId(time.Now().UTC().String())
+	if err := d.Set("reclamations", reclamations); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting reclamations: %s", err))
+	}
+	return nil
+}
+
+func flattenResourceReclamation(reclamation rc.Reclamation) map[string]interface{} {
+	m := map[string]interface{}{
+		"id":                   core.StringNilMapper(reclamation.ID),
+		"entity_id":            core.StringNilMapper(reclamation.EntityID),
+		"entity_type_id":       core.StringNilMapper(reclamation.EntityTypeID),
+		"entity_crn":           core.StringNilMapper(reclamation.EntityCRN),
+		"resource_instance_id": core.StringNilMapper(reclamation.ResourceInstanceID),
+		"resource_group_id":    core.StringNilMapper(reclamation.ResourceGroupID),
+		"account_id":           core.StringNilMapper(reclamation.AccountID),
+		"policy_id":            core.StringNilMapper(reclamation.PolicyID),
+		"state":                core.StringNilMapper(reclamation.State),
+		"target_time":          core.StringNilMapper(reclamation.TargetTime),
+		"created_by":           core.StringNilMapper(reclamation.CreatedBy),
+		"updated_by":           core.StringNilMapper(reclamation.UpdatedBy),
+	}
+	if reclamation.CreatedAt != nil {
+		m["created_at"] = flex.DateTimeToString(reclamation.CreatedAt)
+	}
+	if reclamation.UpdatedAt != nil {
+		m["updated_at"] = flex.DateTimeToString(reclamation.UpdatedAt)
+	}
+	return m
+}
@@ -0,0 +1,193 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+)
+
+const (
+	monitoringServiceName = "sysdig-monitor"
+	// platformMetricsParameter is the parameter of a Monitoring instance that enables the platform metrics of its region
+	platformMetricsParameter = "default_receiver"
+)
+
+func ResourceIBMMonitoringPlatformMetrics() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMMonitoringPlatformMetricsUpdate,
+		ReadContext:   resourceIBMMonitoringPlatformMetricsRead,
+		UpdateContext: resourceIBMMonitoringPlatformMetricsUpdate,
+		DeleteContext: resourceIBMMonitoringPlatformMetricsDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The GUID of the Monitoring instance that receives the platform metrics of its region.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the platform metrics of the region of the instance are collected by the instance.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The region of the Monitoring instance, which the platform metrics are collected for.",
+			},
+			"instance_crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the Monitoring instance.",
+			},
+		},
+	}
+}
+
+func resourceIBMMonitoringPlatformMetricsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceID := d.Id()
+	instance, response, err := rsConClient.GetResourceInstanceWithContext(context, &rc.GetResourceInstanceOptions{ID: &instanceID})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving the Monitoring instance %s: %s with resp code: %s", d.Id(), err, response))
+	}
+	if instance.State != nil && *instance.State == "removed" {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("instance_id", instance.GUID)
+	d.Set("instance_crn", instance.CRN)
+	d.Set("region", instance.RegionID)
+	d.Set("enabled", monitoringPlatformMetricsEnabled(*instance))
+
+	return nil
+}
+
+func resourceIBMMonitoringPlatformMetricsUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	instance, response, err := rsConClient.GetResourceInstanceWithContext(context, &rc.GetResourceInstanceOptions{ID: &instanceID})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving the Monitoring instance %s: %s with resp code: %s", instanceID, err, response))
+	}
+	if instance.CRN == nil || !strings.Contains(*instance.CRN, ":"+monitoringServiceName+":") {
+		return diag.FromErr(fmt.Errorf("[ERROR] The instance %s is not a Monitoring instance", instanceID))
+	}
+
+	enabled := d.Get("enabled").(bool)
+	if enabled {
+		// Only one instance of a region can receive the platform metrics
+		conflict, err := findMonitoringPlatformMetricsInstance(context, rsConClient, instance)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if conflict != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] The platform metrics of the region %s are already enabled on the Monitoring instance %s (%s). Disable them on that instance first", *instance.RegionID, *conflict.Name, *conflict.GUID))
+		}
+	}
+
+	if monitoringPlatformMetricsEnabled(*instance) != enabled {
+		if err = updateMonitoringPlatformMetrics(context, rsConClient, *instance.ID, enabled); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(*instance.GUID)
+
+	return resourceIBMMonitoringPlatformMetricsRead(context, d, meta)
+}
+
+func resourceIBMMonitoringPlatformMetricsDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("enabled").(bool) {
+		if err = updateMonitoringPlatformMetrics(context, rsConClient, d.Id(), false); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func monitoringPlatformMetricsEnabled(instance rc.ResourceInstance) bool {
+	enabled, _ := instance.Parameters[platformMetricsParameter].(bool)
+	return enabled
+}
+
+func updateMonitoringPlatformMetrics(context context.Context, rsConClient *rc.ResourceControllerV2, id string, enabled bool) error {
+	updateResourceInstanceOptions := &rc.UpdateResourceInstanceOptions{}
+	updateResourceInstanceOptions.SetID(id)
+	updateResourceInstanceOptions.SetParameters(map[string]interface{}{
+		platformMetricsParameter: enabled,
+	})
+	_, response, err := rsConClient.UpdateResourceInstanceWithContext(context, updateResourceInstanceOptions)
+	if err != nil {
+		log.Printf("[DEBUG] UpdateResourceInstanceWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("[ERROR] Error updating the platform metrics of the Monitoring instance %s: %s with resp code: %s", id, err, response)
+	}
+	return nil
+}
+
+// findMonitoringPlatformMetricsInstance returns the other Monitoring instance of the region of the instance
+// that receives the platform metrics, if any
+func findMonitoringPlatformMetricsInstance(context context.Context, rsConClient *rc.ResourceControllerV2, instance *rc.ResourceInstance) (*rc.ResourceInstance, error) {
+	resourceInstanceListOptions := rc.ListResourceInstancesOptions{
+		ResourceID: instance.ResourceID,
+	}
+	resourceInstanceListOptions.SetState("active")
+
+	next_url := ""
+	for {
+		if next_url != "" {
+			resourceInstanceListOptions.Start = &next_url
+		}
+		listInstanceResponse, resp, err := rsConClient.ListResourceInstancesWithContext(context, &resourceInstanceListOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error listing the Monitoring instances: %s with resp code: %s", err, resp)
+		}
+		for _, other := range listInstanceResponse.Resources {
+			if *other.GUID != *instance.GUID && other.RegionID != nil && *other.RegionID == *instance.RegionID && monitoringPlatformMetricsEnabled(other) {
+				return &other, nil
+			}
+		}
+		next_url, err = getInstancesNext(listInstanceResponse.NextURL)
+		if err != nil {
+			return nil, fmt.Errorf("[DEBUG] ListResourceInstances failed. Error occurred while parsing NextURL: %s", err)
+		}
+		if next_url == "" {
+			break
+		}
+	}
+	return nil, nil
+}
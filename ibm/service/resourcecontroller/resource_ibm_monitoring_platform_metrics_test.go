@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMMonitoringPlatformMetrics_Basic(t *testing.T) {
+	instanceName := fmt.Sprintf("tf-monitoring-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMMonitoringPlatformMetricsBasic(instanceName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_monitoring_platform_metrics.platform_metrics", "enabled", "true"),
+					resource.TestCheckResourceAttr("ibm_monitoring_platform_metrics.platform_metrics", "region", "us-south"),
+					resource.TestCheckResourceAttrSet("ibm_monitoring_platform_metrics.platform_metrics", "instance_crn"),
+				),
+			},
+			{
+				Config: testAccCheckIBMMonitoringPlatformMetricsBasic(instanceName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_monitoring_platform_metrics.platform_metrics", "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      "ibm_monitoring_platform_metrics.platform_metrics",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMMonitoringPlatformMetricsBasic(instanceName string, enabled bool) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "monitoring" {
+		name     = "%s"
+		service  = "sysdig-monitor"
+		plan     = "graduated-tier"
+		location = "us-south"
+	}
+
+	resource "ibm_monitoring_platform_metrics" "platform_metrics" {
+		instance_id = ibm_resource_instance.monitoring.guid
+		enabled     = %t
+	}
+	`, instanceName, enabled)
+}
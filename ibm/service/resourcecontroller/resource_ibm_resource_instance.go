@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/bluemix-go/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -53,6 +54,7 @@ func ResourceIBMResourceInstance() *schema.Resource {
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ResourceTagsCustomizeDiff(diff)
 			},
+			resourceIBMResourceInstancePlanValidate,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -75,6 +77,8 @@ func ResourceIBMResourceInstance() *schema.Resource {
 				Description: "The plan type of the service",
 			},
 
+			"deletion_protection": ResourceIBMResourceInstanceDeletionProtectionSchema(),
+
 			"location": {
 				Description: "The location where the instance available",
 				Required:    true,
@@ -101,17 +105,45 @@ func ResourceIBMResourceInstance() *schema.Resource {
 				ConflictsWith: []string{"parameters_json"},
 			},
 			"parameters_json": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				ConflictsWith: []string{"parameters"},
-				StateFunc: func(v interface{}) string {
-					json, err := flex.NormalizeJSONString(v)
-					if err != nil {
-						return fmt.Sprintf("%q", err.Error())
-					}
-					return json
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"parameters"},
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: flex.SuppressEquivalentJSON,
+				StateFunc:        flex.NormalizeJSONStateFunc,
+				Description:      "Arbitrary parameters to pass in Json string format",
+			},
+
+			"authorizations": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The service to service authorizations of the instance to the target services, created with the instance",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target_service_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the target service like kms, hs-crypto, cloud-object-storage etc",
+						},
+						"target_resource_instance_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The GUID or CRN of the target service instance, all the instances of the target service when not set",
+						},
+						"roles": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The roles granted to the instance, the roles required by the source and target services when not set",
+						},
+						"policy_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the authorization policy, empty when the authorization already existed",
+						},
+					},
 				},
-				Description: "Arbitrary parameters to pass in Json string format",
 			},
 
 			"tags": {
@@ -498,7 +530,9 @@ func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 
 	}
 	if s, ok := d.GetOk("parameters_json"); ok {
-		json.Unmarshal([]byte(s.(string)), &params)
+		if err := json.Unmarshal([]byte(s.(string)), &params); err != nil {
+			return fmt.Errorf("[ERROR] Error parsing parameters_json: %s", err)
+		}
 	}
 
 	rsInst.Parameters = params
@@ -519,6 +553,14 @@ func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("[ERROR] Error waiting for create resource instance (%s) to be succeeded: %s", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("authorizations"); ok {
+		authorizations, err := resourceIBMResourceInstanceCreateAuthorizations(meta, serviceName, *instance.GUID, v.([]interface{}))
+		d.Set("authorizations", authorizations)
+		if err != nil {
+			return err
+		}
+	}
+
 	v := os.Getenv("IC_ENV_TAGS")
 	if _, ok := d.GetOk("tags"); ok || v != "" {
 		oldList, newList := d.GetChange("tags")
@@ -529,6 +571,12 @@ func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if d.Get("deletion_protection").(bool) {
+		if err := ResourceIBMResourceInstanceSetLock(meta, d.Id(), true); err != nil {
+			return err
+		}
+	}
+
 	return ResourceIBMResourceInstanceRead(d, meta)
 }
 func ResourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
@@ -643,6 +691,7 @@ func ResourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("sub_type", instance.SubType)
 	d.Set("target_crn", instance.TargetCRN)
 	d.Set("resource_plan_id", instance.ResourcePlanID)
+	d.Set("deletion_protection", instance.Locked != nil && *instance.Locked)
 	d.Set("resource_id", instance.ResourceID)
 	d.Set("resource_group_crn", instance.ResourceGroupCRN)
 	if instance.PlanHistory != nil {
@@ -652,7 +701,7 @@ func ResourceIBMResourceInstanceRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{}) (err error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return err
@@ -663,13 +712,40 @@ func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 	resourceInstanceUpdate := rc.UpdateResourceInstanceOptions{
 		ID: &instanceID,
 	}
+
+	oldProtection, newProtection := d.GetChange("deletion_protection")
+	if !d.HasChangeExcept("deletion_protection") {
+		if err := ResourceIBMResourceInstanceSetLock(meta, instanceID, newProtection.(bool)); err != nil {
+			return err
+		}
+		return ResourceIBMResourceInstanceRead(d, meta)
+	}
+
+	// A locked instance can't be updated, it is locked again after the update, or when the update fails so that
+	// it stays protected as the state says
+	if oldProtection.(bool) {
+		if err := ResourceIBMResourceInstanceSetLock(meta, instanceID, false); err != nil {
+			return err
+		}
+		defer func() {
+			if err == nil {
+				return
+			}
+			if lockErr := ResourceIBMResourceInstanceSetLock(meta, instanceID, true); lockErr != nil {
+				log.Printf("[WARN] The resource instance (%s) is left unlocked after the failed update: %s", instanceID, lockErr)
+			}
+		}()
+	}
+
 	if d.HasChange("name") {
 		name := d.Get("name").(string)
 		resourceInstanceUpdate.Name = &name
 	}
 
+	var planChangeErr func(err error) error
 	if d.HasChange("plan") {
-		plan := d.Get("plan").(string)
+		oldPlan, newPlan := d.GetChange("plan")
+		plan := newPlan.(string)
 		service := d.Get("service").(string)
 		rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
 		if err != nil {
@@ -687,6 +763,12 @@ func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("[ERROR] Error retrieving plan: %s", err)
 		}
 
+		// The broker of the service decides which plans the instance can move to
+		planChangeErr = func(err error) error {
+			planNames, _ := resourceIBMResourceInstancePlanNames(meta, serviceOff[0])
+			return fmt.Errorf("[ERROR] Error changing the plan of the resource instance (%s) from %s to %s, the broker of %s rejected the change, the plans of the service are %s: %s", d.Id(), oldPlan, plan, service, strings.Join(planNames, ", "), err)
+		}
+
 		resourceInstanceUpdate.ResourcePlanID = &servicePlan
 
 	}
@@ -748,7 +830,9 @@ func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 	}
 	if d.HasChange("parameters_json") {
 		if s, ok := d.GetOk("parameters_json"); ok {
-			json.Unmarshal([]byte(s.(string)), &params)
+			if err := json.Unmarshal([]byte(s.(string)), &params); err != nil {
+				return fmt.Errorf("[ERROR] Error parsing parameters_json: %s", err)
+			}
 			resourceInstanceUpdate.Parameters = params
 		}
 	}
@@ -766,8 +850,20 @@ func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if d.HasChange("authorizations") {
+		o, n := d.GetChange("authorizations")
+		authorizations, err := resourceIBMResourceInstanceUpdateAuthorizations(meta, d.Get("service").(string), *instance.GUID, o.([]interface{}), n.([]interface{}))
+		d.Set("authorizations", authorizations)
+		if err != nil {
+			return err
+		}
+	}
+
 	_, resp, err = rsConClient.UpdateResourceInstance(&resourceInstanceUpdate)
 	if err != nil {
+		if planChangeErr != nil && resp != nil && resp.StatusCode >= 400 && resp.StatusCode < 500 {
+			return planChangeErr(err)
+		}
 		return fmt.Errorf("[ERROR] Error updating resource instance: %s with resp code: %s", err, resp)
 	}
 
@@ -776,10 +872,61 @@ func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("[ERROR] Error waiting for update resource instance (%s) to be succeeded: %s", d.Id(), err)
 	}
 
+	if newProtection.(bool) {
+		if err := ResourceIBMResourceInstanceSetLock(meta, instanceID, true); err != nil {
+			return err
+		}
+	}
+
 	return ResourceIBMResourceInstanceRead(d, meta)
 }
 
+// resourceIBMResourceInstancePlanValidate fails the plan when the plan of an existing instance is
+// changed and the service doesn't support changing the plan in place
+func resourceIBMResourceInstancePlanValidate(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("plan") || !diff.NewValueKnown("plan") {
+		return nil
+	}
+	rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
+	if err != nil {
+		return err
+	}
+	service := diff.Get("service").(string)
+	serviceOff, err := rsCatClient.ResourceCatalog().FindByName(service, true)
+	if err != nil || len(serviceOff) == 0 {
+		// The update reports the errors of the catalog
+		log.Printf("[WARN] Error retrieving service offering %s to validate the plan change: %v", service, err)
+		return nil
+	}
+	metadata, ok := serviceOff[0].Metadata.(*models.ServiceResourceMetadata)
+	if !ok || metadata.Service.PlanUpdateable {
+		return nil
+	}
+	oldPlan, newPlan := diff.GetChange("plan")
+	planNames, _ := resourceIBMResourceInstancePlanNames(meta, serviceOff[0])
+	return fmt.Errorf("[ERROR] The plan of the %s instance %s can't be changed from %s to %s in place, the service doesn't support plan changes. Create a new instance to use another plan, the plans of the service are %s", service, diff.Id(), oldPlan, newPlan, strings.Join(planNames, ", "))
+}
+
+func resourceIBMResourceInstancePlanNames(meta interface{}, service models.Service) ([]string, error) {
+	rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
+	if err != nil {
+		return nil, err
+	}
+	plans, err := rsCatClient.ResourceCatalog().GetServicePlans(service)
+	if err != nil {
+		return nil, err
+	}
+	planNames := make([]string, 0, len(plans))
+	for _, plan := range plans {
+		planNames = append(planNames, plan.Name)
+	}
+	return planNames, nil
+}
+
 func ResourceIBMResourceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	if err := ResourceIBMResourceInstanceCheckDeletionProtection(d); err != nil {
+		return err
+	}
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return err
@@ -804,6 +951,13 @@ func ResourceIBMResourceInstanceDelete(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("[ERROR] Error waiting for resource instance (%s) to be deleted: %s", d.Id(), err)
 	}
 
+	for _, a := range d.Get("authorizations").([]interface{}) {
+		authorization := a.(map[string]interface{})
+		if err := flex.DeleteAuthorizationPolicy(meta, authorization["policy_id"].(string)); err != nil {
+			return err
+		}
+	}
+
 	d.SetId("")
 
 	return nil
@@ -960,3 +1114,108 @@ func flattenPlanHistory(keys []rc.PlanHistoryItem) []interface{} {
 	}
 	return out
 }
+
+// resourceIBMResourceInstanceCreateAuthorizations creates the authorizations of the instance and returns
+// them with their policy IDs, up to the first authorization that could not be created.
+func resourceIBMResourceInstanceCreateAuthorizations(meta interface{}, serviceName, instanceGUID string, authorizations []interface{}) ([]interface{}, error) {
+	created := make([]interface{}, 0, len(authorizations))
+	for _, a := range authorizations {
+		authorization := a.(map[string]interface{})
+		targetServiceName := authorization["target_service_name"].(string)
+		roles, err := flex.GetAuthorizationRoleNames(serviceName, targetServiceName, flex.ExpandStringList(authorization["roles"].([]interface{})))
+		if err != nil {
+			return created, err
+		}
+		policyID, err := flex.CreateAuthorizationPolicy(meta, serviceName, instanceGUID, targetServiceName, authorization["target_resource_instance_id"].(string), roles)
+		if err != nil {
+			return created, err
+		}
+		created = append(created, map[string]interface{}{
+			"target_service_name":         targetServiceName,
+			"target_resource_instance_id": authorization["target_resource_instance_id"],
+			"roles":                       flex.FlattenStringList(roles),
+			"policy_id":                   policyID,
+		})
+	}
+	return created, nil
+}
+
+// resourceIBMResourceInstanceUpdateAuthorizations deletes the authorizations of the instance that were removed
+// and creates the ones that were added, the authorizations that did not change are kept so that the instance
+// does not lose access to the target services during the update.
+func resourceIBMResourceInstanceUpdateAuthorizations(meta interface{}, serviceName, instanceGUID string, oldAuthorizations, newAuthorizations []interface{}) ([]interface{}, error) {
+	authorizationKey := func(a interface{}) string {
+		authorization := a.(map[string]interface{})
+		targetServiceName := authorization["target_service_name"].(string)
+		roles, _ := flex.GetAuthorizationRoleNames(serviceName, targetServiceName, flex.ExpandStringList(authorization["roles"].([]interface{})))
+		return fmt.Sprintf("%s/%s/%s", targetServiceName, authorization["target_resource_instance_id"], strings.Join(roles, ","))
+	}
+	existing := map[string]interface{}{}
+	for _, a := range oldAuthorizations {
+		existing[authorizationKey(a)] = a
+	}
+
+	kept := make([]interface{}, 0, len(newAuthorizations))
+	added := make([]interface{}, 0, len(newAuthorizations))
+	for _, a := range newAuthorizations {
+		key := authorizationKey(a)
+		if old, ok := existing[key]; ok {
+			kept = append(kept, old)
+			delete(existing, key)
+		} else {
+			added = append(added, a)
+		}
+	}
+	for _, a := range existing {
+		authorization := a.(map[string]interface{})
+		if err := flex.DeleteAuthorizationPolicy(meta, authorization["policy_id"].(string)); err != nil {
+			return append(kept, a), err
+		}
+	}
+	created, err := resourceIBMResourceInstanceCreateAuthorizations(meta, serviceName, instanceGUID, added)
+	return append(kept, created...), err
+}
+
+// ResourceIBMResourceInstanceDeletionProtectionSchema returns the schema of the deletion_protection
+// argument of the resources of resource instances
+func ResourceIBMResourceInstanceDeletionProtectionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Lock the resource instance, so that it can't be deleted or updated outside of Terraform, and fail the destroy until the protection is disabled",
+	}
+}
+
+// ResourceIBMResourceInstanceSetLock locks or unlocks the resource instance with the resource controller
+func ResourceIBMResourceInstanceSetLock(meta interface{}, instanceID string, locked bool) error {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+	if locked {
+		_, response, err := rsConClient.LockResourceInstance(&rc.LockResourceInstanceOptions{
+			ID: &instanceID,
+		})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error locking resource instance (%s): %s with resp code: %s", instanceID, err, response)
+		}
+		return nil
+	}
+	_, response, err := rsConClient.UnlockResourceInstance(&rc.UnlockResourceInstanceOptions{
+		ID: &instanceID,
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error unlocking resource instance (%s): %s with resp code: %s", instanceID, err, response)
+	}
+	return nil
+}
+
+// ResourceIBMResourceInstanceCheckDeletionProtection returns an error when the deletion protection of
+// the resource instance is enabled
+func ResourceIBMResourceInstanceCheckDeletionProtection(d *schema.ResourceData) error {
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("[ERROR] The resource instance (%s) can't be deleted while deletion_protection is enabled, set deletion_protection to false and apply before deleting it", d.Id())
+	}
+	return nil
+}
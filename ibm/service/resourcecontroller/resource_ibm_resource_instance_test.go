@@ -123,6 +123,68 @@ func TestAccIBMResourceInstanceWithServiceendpoints(t *testing.T) {
 	})
 }
 
+func TestAccIBMResourceInstanceDeletionProtection(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-kms-%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_resource_instance.instance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMResourceInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceInstanceDeletionProtection(serviceName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIBMResourceInstanceDeletionProtection(serviceName+"-updated", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", serviceName+"-updated"),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      testAccCheckIBMResourceInstanceDeletionProtection(serviceName+"-updated", true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("deletion_protection is enabled"),
+			},
+			{
+				Config: testAccCheckIBMResourceInstanceDeletionProtection(serviceName+"-updated", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMResourceInstanceWithAuthorizations(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
+	kmsName := fmt.Sprintf("tf-kms-%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_resource_instance.instance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMResourceInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceInstanceAuthorizations(kmsName, serviceName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "authorizations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "authorizations.0.target_service_name", "kms"),
+					resource.TestCheckResourceAttr(resourceName, "authorizations.0.roles.0", "Reader"),
+					resource.TestCheckResourceAttrSet(resourceName, "authorizations.0.policy_id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMResourceInstanceWithResourceGroup(t *testing.T) {
 	serviceName := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
 	resourceName := "ibm_resource_instance.instance"
@@ -423,3 +485,38 @@ func testAccCheckIBMResourceInstanceServiceendpoints(serviceName string) string
 			
 	`, serviceName)
 }
+
+func testAccCheckIBMResourceInstanceDeletionProtection(name string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "instance" {
+		name                = "%s"
+		location            = "us-south"
+		service             = "kms"
+		plan                = "tiered-pricing"
+		deletion_protection = %t
+	}
+	`, name, deletionProtection)
+}
+
+func testAccCheckIBMResourceInstanceAuthorizations(kmsName, serviceName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms" {
+		name     = "%s"
+		service  = "kms"
+		plan     = "tiered-pricing"
+		location = "us-south"
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name     = "%s"
+		service  = "cloud-object-storage"
+		plan     = "standard"
+		location = "global"
+
+		authorizations {
+			target_service_name         = "kms"
+			target_resource_instance_id = ibm_resource_instance.kms.guid
+		}
+	}
+	`, kmsName, serviceName)
+}
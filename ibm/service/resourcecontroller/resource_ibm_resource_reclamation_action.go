@@ -0,0 +1,164 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+)
+
+const (
+	reclamationActionRestore = "restore"
+	reclamationActionReclaim = "reclaim"
+)
+
+func ResourceIBMResourceReclamationAction() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMResourceReclamationActionCreate,
+		ReadContext:   resourceIBMResourceReclamationActionRead,
+		DeleteContext: resourceIBMResourceReclamationActionDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The GUID of the deleted resource instance to restore or reclaim.",
+			},
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{reclamationActionRestore, reclamationActionReclaim}),
+				Description:  "The action to run on the reclamation, restore to recover the instance, or reclaim to delete it permanently.",
+			},
+			"request_by": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The request initiator, if different from the request token.",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "A comment to describe the action.",
+			},
+			"reclamation_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the reclamation of the resource instance.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the reclamation after the action.",
+			},
+			"resource_instance_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the resource instance after the action.",
+			},
+		},
+	}
+}
+
+func resourceIBMResourceReclamationActionCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceID := d.Get("resource_instance_id").(string)
+	action := d.Get("action").(string)
+	reclamationsList, response, err := rsConClient.ListReclamationsWithContext(context, &rc.ListReclamationsOptions{
+		ResourceInstanceID: &instanceID,
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing the reclamations of resource instance (%s): %s with resp code: %s", instanceID, err, response))
+	}
+	if len(reclamationsList.Resources) == 0 {
+		return diag.FromErr(fmt.Errorf("[ERROR] The resource instance (%s) has no reclamation, it is not deleted or its retention window has passed", instanceID))
+	}
+	reclamation := reclamationsList.Resources[0]
+
+	actionOptions := rsConClient.NewRunReclamationActionOptions(*reclamation.ID, action)
+	if v, ok := d.GetOk("request_by"); ok {
+		actionOptions.SetRequestBy(v.(string))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		actionOptions.SetComment(v.(string))
+	}
+	result, response, err := rsConClient.RunReclamationActionWithContext(context, actionOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error running the %s action on the reclamation (%s) of resource instance (%s): %s with resp code: %s", action, *reclamation.ID, instanceID, err, response))
+	}
+	d.SetId(*reclamation.ID)
+	d.Set("reclamation_id", reclamation.ID)
+	d.Set("state", result.State)
+
+	instance, err := waitForResourceReclamationAction(context, d, meta, instanceID, action)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for the %s action on resource instance (%s): %s", action, instanceID, err))
+	}
+	d.Set("resource_instance_state", instance.(*rc.ResourceInstance).State)
+
+	return resourceIBMResourceReclamationActionRead(context, d, meta)
+}
+
+// waitForResourceReclamationAction waits for the instance to be active after a restore, and to be
+// removed after a reclaim
+func waitForResourceReclamationAction(context context.Context, d *schema.ResourceData, meta interface{}, instanceID, action string) (interface{}, error) {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return nil, err
+	}
+	pending, target := []string{RsInstanceReclamation, RsInstanceProgressStatus, RsInstanceInactiveStatus}, []string{RsInstanceSuccessStatus}
+	if action == reclamationActionReclaim {
+		pending, target = []string{RsInstanceReclamation, RsInstanceProgressStatus}, []string{RsInstanceRemovedStatus}
+	}
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  target,
+		Refresh: func() (interface{}, string, error) {
+			instance, response, err := rsConClient.GetResourceInstanceWithContext(context, &rc.GetResourceInstanceOptions{ID: &instanceID})
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Get the resource instance %s failed with resp code: %s, err: %v", instanceID, response, err)
+			}
+			if *instance.State == RsInstanceFailStatus {
+				return instance, *instance.State, fmt.Errorf("[ERROR] The resource instance %s failed", instanceID)
+			}
+			return instance, *instance.State, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	return stateConf.WaitForStateContext(context)
+}
+
+func resourceIBMResourceReclamationActionRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The action is run once, the reclamation is gone once the instance is restored or reclaimed
+	return nil
+}
+
+func resourceIBMResourceReclamationActionDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Removing the %s action of resource instance (%s) from the state, the action is not reverted", d.Get("action"), d.Get("resource_instance_id"))
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,35 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMResourceReclamationAction_noReclamation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMResourceReclamationActionConfig("00000000-0000-0000-0000-000000000000", "restore"),
+				ExpectError: regexp.MustCompile("has no reclamation"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMResourceReclamationActionConfig(instanceID, action string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_reclamation_action" "action" {
+		resource_instance_id = "%s"
+		action               = "%s"
+	}`, instanceID, action)
+}
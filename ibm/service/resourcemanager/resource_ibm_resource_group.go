@@ -6,20 +6,25 @@ package resourcemanager
 import (
 	"fmt"
 	"log"
+	"regexp"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	rg "github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceIBMResourceGroup() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMResourceGroupCreate,
-		Read:     resourceIBMResourceGroupRead,
-		Update:   resourceIBMResourceGroupUpdate,
-		Delete:   resourceIBMResourceGroupDelete,
-		Exists:   resourceIBMResourceGroupExists,
-		Importer: &schema.ResourceImporter{},
+		Create: resourceIBMResourceGroupCreate,
+		Read:   resourceIBMResourceGroupRead,
+		Update: resourceIBMResourceGroupUpdate,
+		Delete: resourceIBMResourceGroupDelete,
+		Exists: resourceIBMResourceGroupExists,
+		Importer: &schema.ResourceImporter{
+			State: resourceIBMResourceGroupImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -80,12 +85,28 @@ func ResourceIBMResourceGroup() *schema.Resource {
 				Description: "An alpha-numeric value identifying the quota ID associated with the resource group.",
 				Computed:    true,
 			},
+			"quota_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the quota definition associated with the resource group.",
+				Computed:    true,
+			},
+			"account_id": {
+				Type:        schema.TypeString,
+				Description: "An alpha-numeric value identifying the account ID of the resource group.",
+				Computed:    true,
+			},
 			"resource_linkages": {
 				Type:        schema.TypeSet,
 				Description: "An array of the resources that linked to the resource group",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Computed:    true,
 			},
+			"purge_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the resource instances of the resource group that are pending reclamation are permanently deleted before the resource group is deleted. The default resource group of the account is never deleted, it is only removed from the state.",
+			},
 		},
 	}
 }
@@ -167,6 +188,17 @@ func resourceIBMResourceGroupRead(d *schema.ResourceData, meta interface{}) erro
 	}
 	if resourceGroup.QuotaID != nil {
 		d.Set("quota_id", *resourceGroup.QuotaID)
+		quotaDefinition, resp, err := rMgtClient.GetQuotaDefinition(&rg.GetQuotaDefinitionOptions{
+			ID: resourceGroup.QuotaID,
+		})
+		if err != nil {
+			log.Printf("[WARN] Error retrieving quota definition %s of resource group: %s with response code  %s", *resourceGroup.QuotaID, err, resp)
+		} else if quotaDefinition.Name != nil {
+			d.Set("quota_name", *quotaDefinition.Name)
+		}
+	}
+	if resourceGroup.AccountID != nil {
+		d.Set("account_id", *resourceGroup.AccountID)
 	}
 	if resourceGroup.ResourceLinkages != nil {
 		rl := make([]string, 0)
@@ -175,6 +207,9 @@ func resourceIBMResourceGroupRead(d *schema.ResourceData, meta interface{}) erro
 		}
 		d.Set("resource_linkages", rl)
 	}
+	if _, ok := d.GetOk("purge_on_destroy"); !ok {
+		d.Set("purge_on_destroy", false)
+	}
 	return nil
 }
 
@@ -211,7 +246,20 @@ func resourceIBMResourceGroupDelete(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
+	// The default resource group of the account cannot be deleted
+	if d.Get("default").(bool) {
+		log.Printf("[WARN] Resource group %s is the default resource group of the account, it is only removed from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
 	resourceGroupID := d.Id()
+	if d.Get("purge_on_destroy").(bool) {
+		if err := resourceIBMResourceGroupPurgeReclamations(resourceGroupID, meta); err != nil {
+			return err
+		}
+	}
+
 	resourceGroupDelete := rg.DeleteResourceGroupOptions{
 		ID: &resourceGroupID,
 	}
@@ -250,3 +298,73 @@ func resourceIBMResourceGroupExists(d *schema.ResourceData, meta interface{}) (b
 
 	return *resourceGroup.ID == resourceGroupID, nil
 }
+
+// resourceIBMResourceGroupPurgeReclamations permanently deletes the resource instances of the resource group that are
+// pending reclamation, a resource group cannot be deleted while it has any.
+func resourceIBMResourceGroupPurgeReclamations(resourceGroupID string, meta interface{}) error {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+
+	reclamations, resp, err := rsConClient.ListReclamations(&rc.ListReclamationsOptions{
+		ResourceGroupID: &resourceGroupID,
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error listing reclamations of resource group %s: %s with response code  %s", resourceGroupID, err, resp)
+	}
+
+	for _, reclamation := range reclamations.Resources {
+		if reclamation.State == nil || *reclamation.State != "SCHEDULED" {
+			continue
+		}
+		_, resp, err := rsConClient.RunReclamationAction(&rc.RunReclamationActionOptions{
+			ID:         reclamation.ID,
+			ActionName: core.StringPtr("reclaim"),
+		})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error running reclamation %s of resource group %s: %s with response code  %s", *reclamation.ID, resourceGroupID, err, resp)
+		}
+	}
+
+	return nil
+}
+
+// resourceGroupIDRegexp matches the IDs of resource groups, other import IDs are resolved as resource group names
+var resourceGroupIDRegexp = regexp.MustCompile(`^[0-9a-f]{32}$`)
+
+// resourceIBMResourceGroupImport resolves the import ID default to the default resource group of the account, and
+// import IDs that are not resource group IDs to the resource group of the account with that name.
+func resourceIBMResourceGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if resourceGroupIDRegexp.MatchString(d.Id()) {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	rMgtClient, err := meta.(conns.ClientSession).ResourceManagerV2API()
+	if err != nil {
+		return nil, err
+	}
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return nil, err
+	}
+
+	resourceGroupList := &rg.ListResourceGroupsOptions{
+		AccountID: &userDetails.UserAccount,
+	}
+	if d.Id() == "default" {
+		resourceGroupList.Default = core.BoolPtr(true)
+	} else {
+		resourceGroupList.Name = core.StringPtr(d.Id())
+	}
+	resourceGroups, resp, err := rMgtClient.ListResourceGroups(resourceGroupList)
+	if err != nil || resourceGroups == nil {
+		return nil, fmt.Errorf("[ERROR] Error retrieving resource group %s: %s with response code  %s", d.Id(), err, resp)
+	}
+	if len(resourceGroups.Resources) != 1 {
+		return nil, fmt.Errorf("[ERROR] Expected one resource group %s in the account, found %d", d.Id(), len(resourceGroups.Resources))
+	}
+
+	d.SetId(*resourceGroups.Resources[0].ID)
+	return []*schema.ResourceData{d}, nil
+}
@@ -52,6 +52,39 @@ func TestAccIBMResourceGroupBasic(t *testing.T) {
 	})
 }
 
+func TestAccIBMResourceGroupImportDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:             testAccCheckIBMResourceGroupDefault(),
+				ResourceName:       "ibm_resource_group.defaultGroup",
+				ImportState:        true,
+				ImportStateId:      "default",
+				ImportStatePersist: true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 || states[0].Attributes["default"] != "true" {
+						return fmt.Errorf("Expected the default resource group to be imported, got %v", states)
+					}
+					if states[0].Attributes["quota_id"] == "" || states[0].Attributes["account_id"] == "" {
+						return fmt.Errorf("Expected quota_id and account_id of the default resource group, got %v", states[0].Attributes)
+					}
+					return nil
+				},
+			},
+			{
+				Config: testAccCheckIBMResourceGroupDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_resource_group.defaultGroup", "default", "true"),
+					resource.TestCheckResourceAttr("ibm_resource_group.defaultGroup", "purge_on_destroy", "false"),
+					resource.TestCheckResourceAttrSet("ibm_resource_group.defaultGroup", "quota_name"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMResourceGroupWithTags(t *testing.T) {
 	var conf string
 	resourceGroupName := fmt.Sprintf("tf-rg-%d", acctest.RandIntRange(10, 100))
@@ -148,6 +181,18 @@ func testAccCheckIBMResourceGroupBasic(resourceGroupName string) string {
 	`, resourceGroupName)
 }
 
+func testAccCheckIBMResourceGroupDefault() string {
+	return `
+	data "ibm_resource_group" "defaultGroup" {
+		is_default = true
+	}
+
+	resource "ibm_resource_group" "defaultGroup" {
+		name = data.ibm_resource_group.defaultGroup.name
+	}
+	`
+}
+
 func testAccCheckIBMResourceGroupWithtags(resourceGroupName string) string {
 	return fmt.Sprintf(`
 		  
@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package satellite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMSatelliteUnassignedHosts() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMSatelliteUnassignedHostsRead,
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name or ID of the Satellite location",
+			},
+			"host_labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Only return the hosts that have all these labels",
+			},
+			"hosts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The hosts attached to the location that are not assigned to a cluster",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the host",
+						},
+						"host_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the host",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Health status of the host",
+						},
+						"host_labels": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Labels of the host",
+						},
+					},
+				},
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of unassigned hosts",
+			},
+			"ready_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of unassigned hosts that are ready to be assigned",
+			},
+		},
+	}
+}
+
+func dataSourceIBMSatelliteUnassignedHostsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	location := d.Get("location").(string)
+
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	hostList, resp, err := satClient.GetSatelliteHostsWithContext(context, &kubernetesserviceapiv1.GetSatelliteHostsOptions{
+		Controller: &location,
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving hosts of satellite location (%s): %s\n%s", location, err, resp))
+	}
+
+	selector := make(map[string]string)
+	if l, ok := d.GetOk("host_labels"); ok {
+		selector = convertToMapStringString(l.(map[string]interface{}))
+	}
+
+	hosts := make([]map[string]interface{}, 0)
+	readyCount := 0
+	for _, h := range hostList {
+		if h.State == nil || *h.State != hostStateUnassigned || !satelliteHostMatchesLabels(h.Labels, selector) {
+			continue
+		}
+		host := map[string]interface{}{
+			"host_id":     *h.ID,
+			"host_name":   *h.Name,
+			"host_labels": h.Labels,
+		}
+		if h.Health != nil && h.Health.Status != nil {
+			host["status"] = *h.Health.Status
+			if *h.Health.Status == rsHostReadyStatus {
+				readyCount++
+			}
+		}
+		hosts = append(hosts, host)
+	}
+
+	d.SetId(location)
+	d.Set("location", location)
+	d.Set("hosts", hosts)
+	d.Set("total_count", len(hosts))
+	d.Set("ready_count", readyCount)
+	return nil
+}
@@ -0,0 +1,44 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package satellite_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSatelliteUnassignedHostsDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSatelliteUnassignedHostsDataSource(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_satellite_unassigned_hosts.hosts", "total_count"),
+					resource.TestCheckResourceAttrSet("data.ibm_satellite_unassigned_hosts.hosts", "ready_count"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSatelliteUnassignedHostsDataSource() string {
+	return fmt.Sprintf(`
+	provider "ibm" {
+		region = "us-east"
+	}
+
+	data "ibm_satellite_unassigned_hosts" "hosts" {
+		location    = "%s"
+		host_labels = {
+			"env" = "prod"
+		}
+	}
+`, acc.Satellite_location_id)
+}
@@ -67,7 +67,10 @@ func ResourceIBMSatelliteCluster() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ImmutableResourceCustomizeDiff([]string{"name", "location", "resource_group_id", "crn_token"}, diff)
+				return flex.ImmutableResourceCustomizeDiff([]string{"name", "location", "resource_group_id", "crn_token", "operating_system", "host_labels"}, diff)
+			},
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return satelliteZoneSpreadCustomizeDiff(diff)
 			},
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ResourceTagsCustomizeDiff(diff)
@@ -125,10 +128,11 @@ func ResourceIBMSatelliteCluster() *schema.Resource {
 				Description: "The OpenShift Container Platform version",
 			},
 			"operating_system": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Operating system of the default worker pool. Options are REDHAT_7_64, REDHAT_8_64, or RHCOS.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues(satelliteOperatingSystems),
+				Description:  "Operating system of the default worker pool. Options are REDHAT_7_64, REDHAT_8_64, or RHCOS.",
 			},
 			"wait_for_worker_update": {
 				Type:        schema.TypeBool,
@@ -188,6 +192,17 @@ func ResourceIBMSatelliteCluster() *schema.Resource {
 					},
 				},
 			},
+			"enforce_zone_spread": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Require the default worker pool to span at least three zones, and rebalance the workers across the zones after the worker pool is resized or its zones change",
+			},
+			"default_worker_pool_balanced": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the workers of the default worker pool are spread evenly across its zones",
+			},
 			"pull_secret": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -264,7 +279,7 @@ func ResourceIBMSatelliteCluster() *schema.Resource {
 				Type:        schema.TypeSet,
 				Optional:    true,
 				Computed:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateSatelliteHostLabel},
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Labels that describe a Satellite host for default workerpool",
 			},
@@ -441,6 +456,11 @@ func resourceIBMSatelliteClusterCreate(d *schema.ResourceData, meta interface{})
 			if err != nil {
 				return fmt.Errorf("[ERROR] Error waiting for default workerpool (%s) to become ready: %s", d.Id(), err)
 			}
+			if d.Get("enforce_zone_spread").(bool) {
+				if err := rebalanceSatelliteWorkerPool(d, meta, clusterId, workerPoolName, d.Timeout(schema.TimeoutCreate), targetEnv); err != nil {
+					return err
+				}
+			}
 		}
 	}
 
@@ -569,6 +589,9 @@ func resourceIBMSatelliteClusterRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("default_worker_pool_labels", flex.IgnoreSystemLabels(workerPool.Labels))
 	d.Set("host_labels", flex.FlattenWorkerPoolHostLabels(workerPool.HostLabels))
 	d.Set("operating_system", workerPool.OperatingSystem)
+	if workerPool != nil && workerPool.IsBalanced != nil {
+		d.Set("default_worker_pool_balanced", *workerPool.IsBalanced)
+	}
 
 	return nil
 }
@@ -672,6 +695,10 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating the worker pool size %d: %s\n%s", workerCount, err, response)
 		}
+		_, err = WaitForSatelliteWorkerPoolAvailable(d, meta, clusterID, workerPoolName, d.Timeout(schema.TimeoutUpdate), targetEnv)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error waiting for default workerpool (%s) to be resized: %s", d.Id(), err)
+		}
 	}
 
 	if d.HasChange("default_worker_pool_labels") {
@@ -761,6 +788,12 @@ func resourceIBMSatelliteClusterUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if d.Get("enforce_zone_spread").(bool) && (d.HasChange("worker_count") || d.HasChange("zones") || d.HasChange("enforce_zone_spread")) {
+		if err := rebalanceSatelliteWorkerPool(d, meta, clusterID, workerPoolName, d.Timeout(schema.TimeoutUpdate), targetEnv); err != nil {
+			return err
+		}
+	}
+
 	return resourceIBMSatelliteClusterRead(d, meta)
 }
 
@@ -980,3 +1013,67 @@ func satelliteClusterVersionRefreshFunc(client v1.Clusters, instanceID string, d
 		return clusterFields, clusterNormal, nil
 	}
 }
+
+// satelliteOperatingSystems are the operating systems of the hosts of a Satellite worker pool
+var satelliteOperatingSystems = []string{"REDHAT_7_64", "REDHAT_8_64", "RHCOS"}
+
+// validateSatelliteHostLabel validates a host label, formatted as a key:value pair
+func validateSatelliteHostLabel(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	parts := strings.Split(value, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		errors = append(errors, fmt.Errorf("%q must be a key:value pair such as cpu:4, got %q", k, value))
+	}
+	return
+}
+
+// satelliteZoneSpreadCustomizeDiff requires at least three zones for the default worker pool when the zone spread is enforced
+func satelliteZoneSpreadCustomizeDiff(diff *schema.ResourceDiff) error {
+	if !diff.Get("enforce_zone_spread").(bool) || diff.Get("infrastructure_topology").(string) == "single-replica" {
+		return nil
+	}
+	if !diff.NewValueKnown("zones") {
+		return nil
+	}
+	if zones, ok := diff.GetOk("zones"); ok && zones.(*schema.Set).Len() < 3 {
+		return fmt.Errorf("[ERROR] enforce_zone_spread requires at least 3 zones for the default worker pool, got %d", zones.(*schema.Set).Len())
+	}
+	return nil
+}
+
+// rebalanceSatelliteWorkerPool rebalances the workers of the worker pool across its zones when they are not spread evenly
+func rebalanceSatelliteWorkerPool(d *schema.ResourceData, meta interface{}, clusterID, workerPoolName string, timeout time.Duration, target v1.ClusterTargetHeader) error {
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+
+	getWorkerPoolOptions := &kubernetesserviceapiv1.GetWorkerPoolOptions{
+		Cluster:            &clusterID,
+		Workerpool:         &workerPoolName,
+		XAuthResourceGroup: &target.ResourceGroup,
+	}
+	workerPool, response, err := satClient.GetWorkerPool(getWorkerPoolOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving workerpool (%s) of cluster (%s): %s\n%s", workerPoolName, clusterID, err, response)
+	}
+	if workerPool.IsBalanced == nil || *workerPool.IsBalanced {
+		return nil
+	}
+
+	log.Printf("[INFO] Rebalancing the workers of workerpool (%s) of cluster (%s) across its zones", workerPoolName, clusterID)
+	rebalanceOptions := &kubernetesserviceapiv1.RebalanceWorkerPoolOptions{
+		Cluster:            &clusterID,
+		Workerpool:         &workerPoolName,
+		XAuthResourceGroup: &target.ResourceGroup,
+	}
+	response, err = satClient.RebalanceWorkerPool(rebalanceOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error rebalancing workerpool (%s) of cluster (%s): %s\n%s", workerPoolName, clusterID, err, response)
+	}
+	_, err = WaitForSatelliteWorkerPoolAvailable(d, meta, clusterID, workerPoolName, timeout, target)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for workerpool (%s) to be rebalanced: %s", workerPoolName, err)
+	}
+	return nil
+}
@@ -43,6 +43,9 @@ ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVE
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckSatelliteClusterExists("ibm_satellite_cluster.create_cluster", instance),
 					resource.TestCheckResourceAttr("ibm_satellite_cluster.create_cluster", "name", clusterName),
+					resource.TestCheckResourceAttr("ibm_satellite_cluster.create_cluster", "operating_system", operatingSystem),
+					resource.TestCheckResourceAttr("ibm_satellite_cluster.create_cluster", "host_labels.#", "1"),
+					resource.TestCheckResourceAttr("ibm_satellite_cluster.create_cluster", "default_worker_pool_balanced", "true"),
 				),
 			},
 		},
@@ -114,7 +117,7 @@ ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVE
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"enable_config_admin", "wait_for_worker_update", "location"},
+					"enable_config_admin", "wait_for_worker_update", "location", "enforce_zone_spread"},
 			},
 		},
 	})
@@ -264,6 +267,8 @@ func testAccCheckSatelliteClusterCreate(clusterName, locationName, managed_from,
 		kube_version           = "4.9_openshift"
 		operating_system       = "%s"
 		wait_for_worker_update = true
+		host_labels            = ["env:prod"]
+		enforce_zone_spread    = true
 		dynamic "zones" {
 			for_each = var.location_zones
 			content {
@@ -14,6 +14,7 @@ import (
 	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -113,10 +114,12 @@ func ResourceIBMSatelliteClusterWorkerPool() *schema.Resource {
 				Optional: true,
 			},
 			"operating_system": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
-				Description: "Operating system of the worker pool. Options are REDHAT_7_64, REDHAT_8_64, or RHCOS.",
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues(satelliteOperatingSystems),
+				Description:  "Operating system of the worker pool. Options are REDHAT_7_64, REDHAT_8_64, or RHCOS.",
 			},
 			"worker_count": {
 				Type:        schema.TypeInt,
@@ -151,7 +154,7 @@ func ResourceIBMSatelliteClusterWorkerPool() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 				ForceNew:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateSatelliteHostLabel},
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "Labels that describe a Satellite host",
 			},
@@ -0,0 +1,464 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package satellite
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	hostStateUnassigned  = "unassigned"
+	hostStateUnassigning = "unassigning"
+)
+
+func ResourceIBMSatelliteHostAssignmentPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMSatelliteHostAssignmentPolicyCreate,
+		Read:   resourceIBMSatelliteHostAssignmentPolicyRead,
+		Update: resourceIBMSatelliteHostAssignmentPolicyUpdate,
+		Delete: resourceIBMSatelliteHostAssignmentPolicyDelete,
+
+		CustomizeDiff: resourceIBMSatelliteHostAssignmentPolicyCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(75 * time.Minute),
+			Update: schema.DefaultTimeout(75 * time.Minute),
+			Delete: schema.DefaultTimeout(45 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			hostLocation: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name or ID of the Satellite location",
+			},
+			hostCluster: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name or ID of a Satellite location or cluster to assign the hosts to",
+			},
+			hostWorkerPool: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "default",
+				Description: "The name or ID of the worker pool within the cluster to assign the hosts to",
+			},
+			"host_labels": {
+				Type:        schema.TypeMap,
+				Required:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Label selector, only the unassigned hosts that have all these labels are assigned",
+			},
+			"zones": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The zones within the cluster to spread the hosts across",
+			},
+			"hosts_per_zone": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of hosts to assign in each zone",
+			},
+			"rebalance": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Reconcile the zones that no longer have hosts_per_zone hosts, for example after a host is removed",
+			},
+			"assigned_hosts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The hosts matching the label selector that are assigned to the worker pool",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the host",
+						},
+						"host_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the host",
+						},
+						hostZone: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The zone the host is assigned to",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Health status of the host",
+						},
+					},
+				},
+			},
+			"zone_host_counts": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The actual number of assigned hosts in each zone",
+			},
+		},
+	}
+}
+
+func resourceIBMSatelliteHostAssignmentPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	location := d.Get(hostLocation).(string)
+	cluster := d.Get(hostCluster).(string)
+	workerPool := d.Get(hostWorkerPool).(string)
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", location, cluster, workerPool))
+
+	if err := assignSatelliteHostsByPolicy(d, meta); err != nil {
+		return err
+	}
+
+	return resourceIBMSatelliteHostAssignmentPolicyRead(d, meta)
+}
+
+func resourceIBMSatelliteHostAssignmentPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	if len(parts) < 3 {
+		return fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of location/cluster/workerPool", d.Id())
+	}
+	location := parts[0]
+	cluster := parts[1]
+	workerPool := parts[2]
+
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+
+	hostList, resp, err := satClient.GetSatelliteHosts(&kubernetesserviceapiv1.GetSatelliteHostsOptions{
+		Controller: &location,
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error retrieving hosts of satellite location (%s): %s\n%s", location, err, resp)
+	}
+
+	selector := convertToMapStringString(d.Get("host_labels").(map[string]interface{}))
+	assigned := satelliteHostsAssignedTo(hostList, cluster, workerPool, selector)
+
+	hosts := make([]map[string]interface{}, 0, len(assigned))
+	zoneCounts := make(map[string]int)
+	for _, h := range assigned {
+		host := map[string]interface{}{
+			"host_id":   *h.ID,
+			"host_name": *h.Name,
+			hostZone:    *h.Assignment.Zone,
+		}
+		if h.Health != nil && h.Health.Status != nil {
+			host["status"] = *h.Health.Status
+		}
+		hosts = append(hosts, host)
+		zoneCounts[*h.Assignment.Zone]++
+	}
+
+	d.Set(hostLocation, location)
+	d.Set(hostCluster, cluster)
+	d.Set(hostWorkerPool, workerPool)
+	d.Set("assigned_hosts", hosts)
+	d.Set("zone_host_counts", zoneCounts)
+
+	return nil
+}
+
+// resourceIBMSatelliteHostAssignmentPolicyCustomizeDiff plans a reconciliation when rebalancing is on and the
+// actual number of hosts of a zone differs from hosts_per_zone, for example after a host was removed.
+func resourceIBMSatelliteHostAssignmentPolicyCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.Get("rebalance").(bool) {
+		return nil
+	}
+	desired := make(map[string]int)
+	for _, z := range flex.FlattenSatelliteZones(diff.Get("zones").(*schema.Set)) {
+		desired[z] = diff.Get("hosts_per_zone").(int)
+	}
+	actual := make(map[string]int)
+	for z, count := range diff.Get("zone_host_counts").(map[string]interface{}) {
+		actual[z] = count.(int)
+	}
+	for z := range actual {
+		if _, ok := desired[z]; !ok {
+			desired[z] = 0
+		}
+	}
+	for z, count := range desired {
+		if actual[z] != count {
+			log.Printf("[INFO] Zone %s has %d hosts instead of %d, planning the reconciliation of the hosts", z, actual[z], count)
+			if err := diff.SetNewComputed("zone_host_counts"); err != nil {
+				return err
+			}
+			return diff.SetNewComputed("assigned_hosts")
+		}
+	}
+	return nil
+}
+
+func resourceIBMSatelliteHostAssignmentPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	// With rebalancing on, the update can be planned by a zone that no longer has hosts_per_zone hosts
+	if d.HasChanges("zones", "hosts_per_zone") || d.Get("rebalance").(bool) {
+		if err := assignSatelliteHostsByPolicy(d, meta); err != nil {
+			return err
+		}
+	}
+
+	return resourceIBMSatelliteHostAssignmentPolicyRead(d, meta)
+}
+
+func resourceIBMSatelliteHostAssignmentPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	location := parts[0]
+	cluster := d.Get(hostCluster).(string)
+
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+
+	// The hosts are only unassigned, they stay attached to the location for other assignments
+	hostList, resp, err := satClient.GetSatelliteHosts(&kubernetesserviceapiv1.GetSatelliteHostsOptions{
+		Controller: &location,
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error retrieving hosts of satellite location (%s): %s\n%s", location, err, resp)
+	}
+	selector := convertToMapStringString(d.Get("host_labels").(map[string]interface{}))
+	assigned := satelliteHostsAssignedTo(hostList, cluster, d.Get(hostWorkerPool).(string), selector)
+	if err := unassignSatelliteHosts(d, meta, location, cluster, assigned, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// unassignSatelliteHosts removes the worker nodes of the hosts from the cluster and waits for the hosts to be
+// unassigned, without removing the hosts from the location.
+func unassignSatelliteHosts(d *schema.ResourceData, meta interface{}, location, cluster string, hosts []kubernetesserviceapiv1.MultishiftQueueNode, timeout time.Duration) error {
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+	for _, h := range hosts {
+		if h.Assignment == nil || h.Assignment.WorkerID == nil {
+			continue
+		}
+		log.Printf("[INFO] Unassigning satellite host %s from worker %s of cluster %s", *h.Name, *h.Assignment.WorkerID, cluster)
+		response, err := satClient.RemoveClusterWorker(&kubernetesserviceapiv1.RemoveClusterWorkerOptions{
+			IdOrName: &cluster,
+			WorkerID: h.Assignment.WorkerID,
+		})
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				continue
+			}
+			return fmt.Errorf("[ERROR] Error unassigning satellite host (%s): %s\n%s", *h.Name, err, response)
+		}
+	}
+	for _, h := range hosts {
+		if _, err := waitForSatelliteHostUnassigned(*h.ID, location, cluster, timeout, meta); err != nil {
+			return fmt.Errorf("[ERROR] Error waiting for satellite host (%s) to be unassigned: %s", *h.Name, err)
+		}
+	}
+	return nil
+}
+
+// waitForSatelliteHostUnassigned waits until the host is no longer assigned to the cluster
+func waitForSatelliteHostUnassigned(hostID, location, cluster string, timeout time.Duration, meta interface{}) (interface{}, error) {
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return nil, err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{hostStateUnassigning},
+		Target:  []string{hostStateUnassigned},
+		Refresh: func() (interface{}, string, error) {
+			hostList, resp, err := satClient.GetSatelliteHosts(&kubernetesserviceapiv1.GetSatelliteHostsOptions{
+				Controller: &location,
+			})
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error retrieving hosts of satellite location (%s): %s\n%s", location, err, resp)
+			}
+			for _, h := range hostList {
+				if h.ID == nil || *h.ID != hostID {
+					continue
+				}
+				a := h.Assignment
+				if a != nil && ((a.ClusterID != nil && *a.ClusterID == cluster) || (a.ClusterName != nil && *a.ClusterName == cluster)) {
+					return h, hostStateUnassigning, nil
+				}
+				return h, hostStateUnassigned, nil
+			}
+			// The host is not in the location anymore
+			return hostID, hostStateUnassigned, nil
+		},
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+// assignSatelliteHostsByPolicy unassigns the hosts of the zones that have more than hosts_per_zone hosts or that
+// are no longer in zones, then assigns unassigned hosts matching the label selector to every zone that has fewer
+// than hosts_per_zone hosts, filling the emptiest zone first.
+func assignSatelliteHostsByPolicy(d *schema.ResourceData, meta interface{}) error {
+	location := d.Get(hostLocation).(string)
+	cluster := d.Get(hostCluster).(string)
+	workerPool := d.Get(hostWorkerPool).(string)
+	hostsPerZone := d.Get("hosts_per_zone").(int)
+	zones := flex.FlattenSatelliteZones(d.Get("zones").(*schema.Set))
+	selector := convertToMapStringString(d.Get("host_labels").(map[string]interface{}))
+
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+
+	hostList, resp, err := satClient.GetSatelliteHosts(&kubernetesserviceapiv1.GetSatelliteHostsOptions{
+		Controller: &location,
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving hosts of satellite location (%s): %s\n%s", location, err, resp)
+	}
+
+	limits := make(map[string]int, len(zones))
+	for _, zone := range zones {
+		limits[zone] = hostsPerZone
+	}
+	zoneCounts := make(map[string]int)
+	surplus := make([]kubernetesserviceapiv1.MultishiftQueueNode, 0)
+	for _, h := range satelliteHostsAssignedTo(hostList, cluster, workerPool, selector) {
+		zone := *h.Assignment.Zone
+		if zoneCounts[zone] >= limits[zone] {
+			surplus = append(surplus, h)
+			continue
+		}
+		zoneCounts[zone]++
+	}
+	if err := unassignSatelliteHosts(d, meta, location, cluster, surplus, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+	available := satelliteUnassignedHosts(hostList, selector)
+
+	assigned := make([]string, 0)
+	for {
+		sort.SliceStable(zones, func(i, j int) bool {
+			return zoneCounts[zones[i]] < zoneCounts[zones[j]]
+		})
+		zone := zones[0]
+		if zoneCounts[zone] >= hostsPerZone {
+			break
+		}
+		if len(available) == 0 {
+			return fmt.Errorf("[ERROR] Not enough unassigned hosts matching labels %v in satellite location (%s) to assign %d hosts per zone, %d hosts assigned", selector, location, hostsPerZone, len(assigned))
+		}
+		host := available[0]
+		available = available[1:]
+
+		hostAssignOptions := &kubernetesserviceapiv1.CreateSatelliteAssignmentOptions{
+			Controller: &location,
+			Cluster:    &cluster,
+			HostID:     host.ID,
+			Labels:     host.Labels,
+			Workerpool: &workerPool,
+			Zone:       &zone,
+		}
+		log.Printf("[INFO] Assigning satellite host %s to zone %s of worker pool %s", *host.Name, zone, workerPool)
+		_, response, err := satClient.CreateSatelliteAssignment(hostAssignOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Assigning Satellite Host (%s): %s\n%s", *host.Name, err, response)
+		}
+		assigned = append(assigned, *host.Name)
+		zoneCounts[zone]++
+	}
+
+	for _, hostName := range assigned {
+		_, err = waitForHostAttachment(hostName, location, d, meta)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error waiting for host (%s) to get normal state: %s", hostName, err)
+		}
+	}
+	return nil
+}
+
+func satelliteHostMatchesLabels(labels, selector map[string]string) bool {
+	for k, v := range selector {
+		if labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func satelliteHostsAssignedTo(hostList []kubernetesserviceapiv1.MultishiftQueueNode, cluster, workerPool string, selector map[string]string) []kubernetesserviceapiv1.MultishiftQueueNode {
+	hosts := make([]kubernetesserviceapiv1.MultishiftQueueNode, 0)
+	for _, h := range hostList {
+		a := h.Assignment
+		if a == nil || a.Zone == nil || !satelliteHostMatchesLabels(h.Labels, selector) {
+			continue
+		}
+		if (a.ClusterID == nil || *a.ClusterID != cluster) && (a.ClusterName == nil || *a.ClusterName != cluster) {
+			continue
+		}
+		if (a.WorkerPoolID == nil || *a.WorkerPoolID != workerPool) && (a.WorkerPoolName == nil || *a.WorkerPoolName != workerPool) {
+			continue
+		}
+		hosts = append(hosts, h)
+	}
+	return hosts
+}
+
+func satelliteUnassignedHosts(hostList []kubernetesserviceapiv1.MultishiftQueueNode, selector map[string]string) []kubernetesserviceapiv1.MultishiftQueueNode {
+	hosts := make([]kubernetesserviceapiv1.MultishiftQueueNode, 0)
+	for _, h := range hostList {
+		if h.State == nil || *h.State != hostStateUnassigned {
+			continue
+		}
+		if h.Health == nil || h.Health.Status == nil || *h.Health.Status != rsHostReadyStatus {
+			continue
+		}
+		if satelliteHostMatchesLabels(h.Labels, selector) {
+			hosts = append(hosts, h)
+		}
+	}
+	return hosts
+}
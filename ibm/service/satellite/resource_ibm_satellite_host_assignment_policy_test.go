@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package satellite_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSatelliteHostAssignmentPolicy_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSatelliteHostAssignmentPolicy(1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_satellite_host_assignment_policy.policy", "hosts_per_zone", "1"),
+					resource.TestCheckResourceAttr("ibm_satellite_host_assignment_policy.policy", "assigned_hosts.#", "3"),
+					resource.TestCheckResourceAttr("ibm_satellite_host_assignment_policy.policy", "zone_host_counts.us-east-1", "1"),
+				),
+			},
+			{
+				Config: testAccCheckSatelliteHostAssignmentPolicy(2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_satellite_host_assignment_policy.policy", "hosts_per_zone", "2"),
+					resource.TestCheckResourceAttr("ibm_satellite_host_assignment_policy.policy", "assigned_hosts.#", "6"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSatelliteHostAssignmentPolicy(hostsPerZone int) string {
+	return fmt.Sprintf(`
+	provider "ibm" {
+		region = "us-east"
+	}
+
+	resource "ibm_satellite_host_assignment_policy" "policy" {
+		location       = "%[1]s"
+		cluster        = "%[1]s"
+		host_labels    = {
+			"env" = "prod"
+		}
+		zones          = ["us-east-1", "us-east-2", "us-east-3"]
+		hosts_per_zone = %[2]d
+	}
+`, acc.Satellite_location_id, hostsPerZone)
+}
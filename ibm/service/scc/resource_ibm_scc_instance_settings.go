@@ -0,0 +1,265 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package scc
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
+)
+
+func ResourceIbmSccInstanceSettings() *schema.Resource {
+	return AddSchemaData(&schema.Resource{
+		CreateContext: resourceIbmSccInstanceSettingsCreate,
+		ReadContext:   resourceIbmSccInstanceSettingsRead,
+		UpdateContext: resourceIbmSccInstanceSettingsUpdate,
+		DeleteContext: resourceIbmSccInstanceSettingsDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"event_notifications": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "The Event Notifications settings. The notifications of the profile attachments are sent to this instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_crn": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The Event Notifications instance CRN.",
+						},
+						"source_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The name of the source of the events in the Event Notifications instance.",
+						},
+						"source_description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The description of the source of the events in the Event Notifications instance.",
+						},
+						"source_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The connected Security and Compliance Center instance CRN.",
+						},
+						"updated_on": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date when the Event Notifications connection was updated.",
+						},
+					},
+				},
+			},
+			"object_storage": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "The Cloud Object Storage settings. The evidence of the scans, including the evidence of the provider type instances, is stored in this bucket.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_crn": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The connected Cloud Object Storage instance CRN.",
+						},
+						"bucket": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The connected Cloud Object Storage bucket name.",
+						},
+						"bucket_location": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The connected Cloud Object Storage bucket location.",
+						},
+						"bucket_endpoint": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The connected Cloud Object Storage bucket endpoint.",
+						},
+						"updated_on": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date when the bucket connection was updated.",
+						},
+					},
+				},
+			},
+			"send_test_event": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Send a test event to the Event Notifications instance after the settings are updated, and fail if it is not received.",
+			},
+		},
+	})
+}
+
+func resourceIbmSccInstanceSettingsCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+
+	if err := resourceIbmSccInstanceSettingsApply(context, d, meta, instanceID); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(instanceID)
+
+	return resourceIbmSccInstanceSettingsRead(context, d, meta)
+}
+
+func resourceIbmSccInstanceSettingsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminClient, err := meta.(conns.ClientSession).SecurityAndComplianceCenterV3()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getSettingsOptions := &securityandcompliancecenterapiv3.GetSettingsOptions{}
+	getSettingsOptions.SetInstanceID(d.Id())
+
+	settings, response, err := adminClient.GetSettingsWithContext(context, getSettingsOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetSettingsWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetSettingsWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("instance_id", d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting instance_id: %s", err))
+	}
+
+	eventNotifications := []map[string]interface{}{}
+	if !core.IsNil(settings.EventNotifications) && !core.IsNil(settings.EventNotifications.InstanceCrn) && *settings.EventNotifications.InstanceCrn != "" {
+		eventNotificationsMap, err := dataSourceIbmSccInstanceSettingsEventNotificationsToMap(settings.EventNotifications)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if settings.EventNotifications.SourceName != nil {
+			eventNotificationsMap["source_name"] = settings.EventNotifications.SourceName
+		}
+		if settings.EventNotifications.SourceDescription != nil {
+			eventNotificationsMap["source_description"] = settings.EventNotifications.SourceDescription
+		}
+		eventNotifications = append(eventNotifications, eventNotificationsMap)
+	}
+	if err = d.Set("event_notifications", eventNotifications); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting event_notifications: %s", err))
+	}
+
+	objectStorage := []map[string]interface{}{}
+	if !core.IsNil(settings.ObjectStorage) && !core.IsNil(settings.ObjectStorage.InstanceCrn) && *settings.ObjectStorage.InstanceCrn != "" {
+		objectStorageMap, err := dataSourceIbmSccInstanceSettingsObjectStorageToMap(settings.ObjectStorage)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		objectStorage = append(objectStorage, objectStorageMap)
+	}
+	if err = d.Set("object_storage", objectStorage); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting object_storage: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIbmSccInstanceSettingsUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChanges("event_notifications", "object_storage", "send_test_event") {
+		if err := resourceIbmSccInstanceSettingsApply(context, d, meta, d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIbmSccInstanceSettingsRead(context, d, meta)
+}
+
+func resourceIbmSccInstanceSettingsDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The settings of an instance can't be deleted, the connections are kept as they are
+	log.Printf("[WARN] Removing the settings of the Security and Compliance Center instance %s from the state only", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// resourceIbmSccInstanceSettingsApply updates the connections that are set in the configuration,
+// and sends a test event if requested
+func resourceIbmSccInstanceSettingsApply(context context.Context, d *schema.ResourceData, meta interface{}, instanceID string) error {
+	adminClient, err := meta.(conns.ClientSession).SecurityAndComplianceCenterV3()
+	if err != nil {
+		return err
+	}
+
+	updateSettingsOptions := &securityandcompliancecenterapiv3.UpdateSettingsOptions{}
+	updateSettingsOptions.SetInstanceID(instanceID)
+
+	hasSettings := false
+	if v, ok := d.GetOk("event_notifications"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		updateSettingsOptions.SetEventNotifications(resourceIbmSccInstanceSettingsMapToEventNotifications(v.([]interface{})[0].(map[string]interface{})))
+		hasSettings = true
+	}
+	if v, ok := d.GetOk("object_storage"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		updateSettingsOptions.SetObjectStorage(resourceIbmSccInstanceSettingsMapToObjectStorage(v.([]interface{})[0].(map[string]interface{})))
+		hasSettings = true
+	}
+
+	if hasSettings {
+		_, response, err := adminClient.UpdateSettingsWithContext(context, updateSettingsOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateSettingsWithContext failed %s\n%s", err, response)
+			return fmt.Errorf("UpdateSettingsWithContext failed %s\n%s", err, response)
+		}
+	}
+
+	if d.Get("send_test_event").(bool) {
+		postTestEventOptions := &securityandcompliancecenterapiv3.PostTestEventOptions{}
+		postTestEventOptions.SetInstanceID(instanceID)
+
+		testEvent, response, err := adminClient.PostTestEventWithContext(context, postTestEventOptions)
+		if err != nil {
+			log.Printf("[DEBUG] PostTestEventWithContext failed %s\n%s", err, response)
+			return fmt.Errorf("PostTestEventWithContext failed %s\n%s", err, response)
+		}
+		if testEvent.Success == nil || !*testEvent.Success {
+			return fmt.Errorf("The test event of the Security and Compliance Center instance %s was not received by Event Notifications", instanceID)
+		}
+	}
+
+	return nil
+}
+
+func resourceIbmSccInstanceSettingsMapToEventNotifications(modelMap map[string]interface{}) *securityandcompliancecenterapiv3.EventNotifications {
+	model := &securityandcompliancecenterapiv3.EventNotifications{}
+	if modelMap["instance_crn"] != nil {
+		model.InstanceCrn = core.StringPtr(modelMap["instance_crn"].(string))
+	}
+	if modelMap["source_name"] != nil && modelMap["source_name"].(string) != "" {
+		model.SourceName = core.StringPtr(modelMap["source_name"].(string))
+	}
+	if modelMap["source_description"] != nil && modelMap["source_description"].(string) != "" {
+		model.SourceDescription = core.StringPtr(modelMap["source_description"].(string))
+	}
+	return model
+}
+
+func resourceIbmSccInstanceSettingsMapToObjectStorage(modelMap map[string]interface{}) *securityandcompliancecenterapiv3.ObjectStorage {
+	model := &securityandcompliancecenterapiv3.ObjectStorage{}
+	if modelMap["instance_crn"] != nil {
+		model.InstanceCrn = core.StringPtr(modelMap["instance_crn"].(string))
+	}
+	if modelMap["bucket"] != nil {
+		model.Bucket = core.StringPtr(modelMap["bucket"].(string))
+	}
+	return model
+}
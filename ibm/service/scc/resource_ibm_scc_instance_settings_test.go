@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package scc_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSccInstanceSettingsBasic(t *testing.T) {
+	sourceName := fmt.Sprintf("tf-scc-source-%d", acctest.RandIntRange(10, 100))
+	sourceNameUpdate := fmt.Sprintf("tf-scc-source-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckScc(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmSccInstanceSettingsConfigBasic(acc.SccInstanceID, sourceName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_scc_instance_settings.scc_instance_settings", "instance_id", acc.SccInstanceID),
+					resource.TestCheckResourceAttr("ibm_scc_instance_settings.scc_instance_settings", "event_notifications.0.source_name", sourceName),
+					resource.TestCheckResourceAttrPair("ibm_scc_instance_settings.scc_instance_settings", "event_notifications.0.instance_crn", "ibm_resource_instance.en_instance", "crn"),
+					resource.TestCheckResourceAttrSet("ibm_scc_instance_settings.scc_instance_settings", "event_notifications.0.source_id"),
+				),
+			},
+			{
+				Config: testAccCheckIbmSccInstanceSettingsConfigBasic(acc.SccInstanceID, sourceNameUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_scc_instance_settings.scc_instance_settings", "event_notifications.0.source_name", sourceNameUpdate),
+				),
+			},
+			{
+				ResourceName:            "ibm_scc_instance_settings.scc_instance_settings",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"send_test_event"},
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSccInstanceSettingsConfigBasic(instanceID string, sourceName string) string {
+	return fmt.Sprintf(`
+		resource "ibm_resource_instance" "en_instance" {
+			name     = "tf-scc-settings-en"
+			service  = "event-notifications"
+			plan     = "lite"
+			location = "us-south"
+		}
+
+		resource "ibm_scc_instance_settings" "scc_instance_settings" {
+			instance_id = "%s"
+			event_notifications {
+				instance_crn       = ibm_resource_instance.en_instance.crn
+				source_name        = "%s"
+				source_description = "Security and Compliance Center findings"
+			}
+		}
+	`, instanceID, sourceName)
+}
@@ -207,9 +207,10 @@ func ResourceIbmSccRule() *schema.Resource {
 													Description: "The operator.",
 												},
 												"value": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: "Schema for any JSON type.",
+													Type:             schema.TypeString,
+													Optional:         true,
+													DiffSuppressFunc: flex.SuppressEquivalentJSON,
+													Description:      "Schema for any JSON type.",
 												},
 											},
 										},
@@ -236,9 +237,10 @@ func ResourceIbmSccRule() *schema.Resource {
 													Description: "The operator.",
 												},
 												"value": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: "Schema for any JSON type.",
+													Type:             schema.TypeString,
+													Optional:         true,
+													DiffSuppressFunc: flex.SuppressEquivalentJSON,
+													Description:      "Schema for any JSON type.",
 												},
 											},
 										},
@@ -254,9 +256,10 @@ func ResourceIbmSccRule() *schema.Resource {
 										Description: "The operator.",
 									},
 									"value": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "Schema for any JSON type.",
+										Type:             schema.TypeString,
+										Optional:         true,
+										DiffSuppressFunc: flex.SuppressEquivalentJSON,
+										Description:      "Schema for any JSON type.",
 									},
 								},
 							},
@@ -294,9 +297,10 @@ func ResourceIbmSccRule() *schema.Resource {
 													Description: "The operator.",
 												},
 												"value": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: "Schema for any JSON type.",
+													Type:             schema.TypeString,
+													Optional:         true,
+													DiffSuppressFunc: flex.SuppressEquivalentJSON,
+													Description:      "Schema for any JSON type.",
 												},
 											},
 										},
@@ -323,9 +327,10 @@ func ResourceIbmSccRule() *schema.Resource {
 													Description: "The operator.",
 												},
 												"value": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: "Schema for any JSON type.",
+													Type:             schema.TypeString,
+													Optional:         true,
+													DiffSuppressFunc: flex.SuppressEquivalentJSON,
+													Description:      "Schema for any JSON type.",
 												},
 											},
 										},
@@ -341,9 +346,10 @@ func ResourceIbmSccRule() *schema.Resource {
 										Description: "The operator.",
 									},
 									"value": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "Schema for any JSON type.",
+										Type:             schema.TypeString,
+										Optional:         true,
+										DiffSuppressFunc: flex.SuppressEquivalentJSON,
+										Description:      "Schema for any JSON type.",
 									},
 								},
 							},
@@ -359,9 +365,10 @@ func ResourceIbmSccRule() *schema.Resource {
 							Description: "The operator.",
 						},
 						"value": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Schema for any JSON type.",
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: flex.SuppressEquivalentJSON,
+							Description:      "Schema for any JSON type.",
 						},
 					},
 				},
@@ -0,0 +1,318 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package scc
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func ResourceIbmSccScope() *schema.Resource {
+	return AddSchemaData(&schema.Resource{
+		CreateContext: resourceIbmSccScopeCreate,
+		ReadContext:   resourceIbmSccScopeRead,
+		UpdateContext: resourceIbmSccScopeUpdate,
+		DeleteContext: resourceIbmSccScopeDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the scope.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the scope.",
+			},
+			"environment": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "ibm-cloud",
+				ValidateFunc: validate.InvokeValidator("ibm_scc_scope", "environment"),
+				Description:  "The environment of the scope.",
+			},
+			"properties": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "The target of the scope.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"scope_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of the account, enterprise, resource group or account group of the scope.",
+						},
+						"scope_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_scc_scope", "scope_type"),
+							Description:  "The type of the target of the scope.",
+						},
+					},
+				},
+			},
+			"exclusions": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The resource groups, accounts or account groups that are excluded from the scope.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"scope_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of the excluded account, resource group or account group.",
+						},
+						"scope_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_scc_scope", "scope_type"),
+							Description:  "The type of the excluded target.",
+						},
+					},
+				},
+			},
+			"scope_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the scope.",
+			},
+			"account_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the account of the scope.",
+			},
+			"attachment_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of attachments of the scope.",
+			},
+			"created_on": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when the scope was created.",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The user who created the scope.",
+			},
+			"updated_on": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when the scope was updated.",
+			},
+			"updated_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The user who updated the scope.",
+			},
+		},
+	})
+}
+
+func ResourceIbmSccScopeValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "environment",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "ibm-cloud",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "scope_type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "account, account.resource_group, enterprise, enterprise.account_group",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_scc_scope", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIbmSccScopeCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	securityAndComplianceCenterApIsClient, err := meta.(conns.ClientSession).SecurityAndComplianceCenterV3()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	properties := d.Get("properties").([]interface{})[0].(map[string]interface{})
+	scopeProperties := []map[string]interface{}{
+		{"name": "scope_id", "value": properties["scope_id"]},
+		{"name": "scope_type", "value": properties["scope_type"]},
+	}
+	if v, ok := d.GetOk("exclusions"); ok {
+		exclusions := []map[string]interface{}{}
+		for _, exclusion := range v.([]interface{}) {
+			exclusionMap := exclusion.(map[string]interface{})
+			exclusions = append(exclusions, map[string]interface{}{
+				"scope_id":   exclusionMap["scope_id"],
+				"scope_type": exclusionMap["scope_type"],
+			})
+		}
+		scopeProperties = append(scopeProperties, map[string]interface{}{"name": "exclusions", "value": exclusions})
+	}
+
+	body := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"environment": d.Get("environment").(string),
+		"properties":  scopeProperties,
+	}
+	if v, ok := d.GetOk("description"); ok {
+		body["description"] = v.(string)
+	}
+
+	var scope map[string]interface{}
+	response, err := sccInstanceRequest(context, securityAndComplianceCenterApIsClient.Service, instanceID, core.POST, "/scopes", nil, body, &scope)
+	if err != nil {
+		log.Printf("[DEBUG] Error creating scope %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error creating scope %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, scope["id"]))
+
+	return resourceIbmSccScopeRead(context, d, meta)
+}
+
+func resourceIbmSccScopeRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	securityAndComplianceCenterApIsClient, err := meta.(conns.ClientSession).SecurityAndComplianceCenterV3()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var scope map[string]interface{}
+	response, err := sccInstanceRequest(context, securityAndComplianceCenterApIsClient.Service, parts[0], core.GET, "/scopes/{scope_id}", map[string]string{"scope_id": parts[1]}, nil, &scope)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Error getting scope %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error getting scope %s\n%s", err, response))
+	}
+
+	if err = d.Set("instance_id", parts[0]); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting instance_id: %s", err))
+	}
+	if err = d.Set("scope_id", parts[1]); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting scope_id: %s", err))
+	}
+	for _, key := range []string{"name", "description", "environment", "account_id", "created_on", "created_by", "updated_on", "updated_by"} {
+		if v, ok := scope[key].(string); ok {
+			if err = d.Set(key, v); err != nil {
+				return diag.FromErr(fmt.Errorf("Error setting %s: %s", key, err))
+			}
+		}
+	}
+	if v, ok := scope["attachment_count"].(float64); ok {
+		if err = d.Set("attachment_count", int(v)); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting attachment_count: %s", err))
+		}
+	}
+
+	properties := map[string]interface{}{}
+	exclusions := []map[string]interface{}{}
+	if scopeProperties, ok := scope["properties"].([]interface{}); ok {
+		for _, property := range scopeProperties {
+			propertyMap, ok := property.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			switch propertyMap["name"] {
+			case "scope_id", "scope_type":
+				properties[propertyMap["name"].(string)] = propertyMap["value"]
+			case "exclusions":
+				values, _ := propertyMap["value"].([]interface{})
+				for _, value := range values {
+					if exclusion, ok := value.(map[string]interface{}); ok {
+						exclusions = append(exclusions, map[string]interface{}{
+							"scope_id":   exclusion["scope_id"],
+							"scope_type": exclusion["scope_type"],
+						})
+					}
+				}
+			}
+		}
+	}
+	if err = d.Set("properties", []map[string]interface{}{properties}); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting properties: %s", err))
+	}
+	if err = d.Set("exclusions", exclusions); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting exclusions: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIbmSccScopeUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	securityAndComplianceCenterApIsClient, err := meta.(conns.ClientSession).SecurityAndComplianceCenterV3()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("name", "description") {
+		body := map[string]interface{}{
+			"name":        d.Get("name").(string),
+			"description": d.Get("description").(string),
+		}
+		response, err := sccInstanceRequest(context, securityAndComplianceCenterApIsClient.Service, parts[0], core.PATCH, "/scopes/{scope_id}", map[string]string{"scope_id": parts[1]}, body, nil)
+		if err != nil {
+			log.Printf("[DEBUG] Error updating scope %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("Error updating scope %s\n%s", err, response))
+		}
+	}
+
+	return resourceIbmSccScopeRead(context, d, meta)
+}
+
+func resourceIbmSccScopeDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	securityAndComplianceCenterApIsClient, err := meta.(conns.ClientSession).SecurityAndComplianceCenterV3()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.SepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := sccInstanceRequest(context, securityAndComplianceCenterApIsClient.Service, parts[0], core.DELETE, "/scopes/{scope_id}", map[string]string{"scope_id": parts[1]}, nil, nil)
+	if err != nil {
+		log.Printf("[DEBUG] Error deleting scope %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error deleting scope %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
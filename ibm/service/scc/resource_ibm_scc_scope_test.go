@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package scc_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSccScopeBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-scc-scope-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf-scc-scope-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckScc(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmSccScopeConfigBasic(acc.SccInstanceID, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_scc_scope.scc_scope", "name", name),
+					resource.TestCheckResourceAttr("ibm_scc_scope.scc_scope", "environment", "ibm-cloud"),
+					resource.TestCheckResourceAttr("ibm_scc_scope.scc_scope", "properties.0.scope_type", "account"),
+					resource.TestCheckResourceAttrPair("ibm_scc_scope.scc_scope", "properties.0.scope_id", "data.ibm_resource_group.default", "account_id"),
+					resource.TestCheckResourceAttr("ibm_scc_scope.scc_scope", "exclusions.#", "1"),
+					resource.TestCheckResourceAttrPair("ibm_scc_scope.scc_scope", "exclusions.0.scope_id", "data.ibm_resource_group.default", "id"),
+					resource.TestCheckResourceAttrSet("ibm_scc_scope.scc_scope", "scope_id"),
+				),
+			},
+			{
+				Config: testAccCheckIbmSccScopeConfigBasic(acc.SccInstanceID, nameUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_scc_scope.scc_scope", "name", nameUpdate),
+				),
+			},
+			{
+				ResourceName:      "ibm_scc_scope.scc_scope",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSccScopeConfigBasic(instanceID string, name string) string {
+	return fmt.Sprintf(`
+		data "ibm_resource_group" "default" {
+			is_default = true
+		}
+
+		resource "ibm_scc_scope" "scc_scope" {
+			instance_id = "%s"
+			name        = "%s"
+			description = "Account scope without the default resource group"
+			properties {
+				scope_id   = data.ibm_resource_group.default.account_id
+				scope_type = "account"
+			}
+			exclusions {
+				scope_id   = data.ibm_resource_group.default.id
+				scope_type = "account.resource_group"
+			}
+		}
+	`, instanceID, name)
+}
@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package scc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// sccInstanceRequest sends a request to an API of a Security and Compliance Center instance which is not part
+// of the SDK, and unmarshals the response into result. The path is relative to /instances/{instance_id}/v3.
+func sccInstanceRequest(context context.Context, service *core.BaseService, instanceID, method, path string, pathParamsMap map[string]string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	if service.Options.URL == "" {
+		return nil, fmt.Errorf("service URL is empty")
+	}
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(context)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(fmt.Sprintf("%s/instances/%s/v3", service.Options.URL, instanceID), path, pathParamsMap)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		if _, err := builder.SetBodyContentJSON(body); err != nil {
+			return nil, err
+		}
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return service.Request(request, result)
+}
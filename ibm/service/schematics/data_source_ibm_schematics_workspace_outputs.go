@@ -0,0 +1,214 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+)
+
+// DataSourceIBMSchematicsWorkspaceOutputs reads the outputs of a workspace with their types, as JSON, so that they can
+// be decoded with jsondecode, like the outputs of terraform_remote_state.
+func DataSourceIBMSchematicsWorkspaceOutputs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMSchematicsWorkspaceOutputsRead,
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the workspace for which you want to retrieve output values. To find the workspace ID, use the `GET /workspaces` API.",
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Region of the workspace.",
+			},
+			"template_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the Terraform template of the workspace. The default is the first template of the workspace.",
+			},
+			"include_sensitive": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the values of the sensitive outputs are read into sensitive_outputs_json.",
+			},
+			"outputs_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The values of the outputs that are not sensitive, as a JSON object by output name. Decode it with jsondecode to get the values with their types.",
+			},
+			"sensitive_outputs_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The values of the sensitive outputs, as a JSON object by output name, when include_sensitive is true.",
+			},
+			"outputs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The outputs of the workspace.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the output.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Terraform type of the output, as JSON for the complex types, for example [\"list\",\"string\"].",
+						},
+						"sensitive": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the output is sensitive.",
+						},
+						"value_json": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The value of the output as JSON. It is empty for the sensitive outputs.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMSchematicsWorkspaceOutputsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schematicsClient, err := meta.(conns.ClientSession).SchematicsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if r, ok := d.GetOk("location"); ok {
+		region := r.(string)
+		schematicsURL, updatedURL, _ := SchematicsEndpointURL(region, meta)
+		if updatedURL {
+			schematicsClient.Service.Options.URL = schematicsURL
+		}
+	}
+
+	workspaceID := d.Get("workspace_id").(string)
+	getWorkspaceOutputsOptions := &schematicsv1.GetWorkspaceOutputsOptions{}
+	getWorkspaceOutputsOptions.SetWID(workspaceID)
+
+	outputValuesList, response, err := schematicsClient.GetWorkspaceOutputsWithContext(context, getWorkspaceOutputsOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetWorkspaceOutputsWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetWorkspaceOutputsWithContext failed %s\n%s", err, response))
+	}
+
+	var template *schematicsv1.OutputValuesInner
+	templateID := d.Get("template_id").(string)
+	for i := range outputValuesList {
+		if templateID == "" || (outputValuesList[i].ID != nil && *outputValuesList[i].ID == templateID) {
+			template = &outputValuesList[i]
+			break
+		}
+	}
+	if template == nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error while fetching template id %s in workspace: %s", templateID, workspaceID))
+	}
+	if template.ID != nil {
+		templateID = *template.ID
+	}
+
+	// The output values are maps of the output names to their sensitive flag, type and value
+	outputValues := map[string]map[string]interface{}{}
+	for _, outputValue := range template.OutputValues {
+		if outputMap, ok := outputValue.(map[string]interface{}); ok {
+			for name, output := range outputMap {
+				if output, ok := output.(map[string]interface{}); ok {
+					outputValues[name] = output
+				}
+			}
+		}
+	}
+	names := make([]string, 0, len(outputValues))
+	for name := range outputValues {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	includeSensitive := d.Get("include_sensitive").(bool)
+	values := map[string]interface{}{}
+	sensitiveValues := map[string]interface{}{}
+	outputs := make([]map[string]interface{}, 0, len(names))
+	for _, name := range names {
+		output := outputValues[name]
+		sensitive, _ := output["sensitive"].(bool)
+
+		outputType := ""
+		switch t := output["type"].(type) {
+		case nil:
+		case string:
+			outputType = t
+		default:
+			typeJSON, err := json.Marshal(t)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] Error encoding the type of output %s: %s", name, err))
+			}
+			outputType = string(typeJSON)
+		}
+
+		valueJSON := ""
+		if sensitive {
+			if includeSensitive {
+				sensitiveValues[name] = output["value"]
+			}
+		} else {
+			values[name] = output["value"]
+			value, err := json.Marshal(output["value"])
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] Error encoding the value of output %s: %s", name, err))
+			}
+			valueJSON = string(value)
+		}
+
+		outputs = append(outputs, map[string]interface{}{
+			"name":       name,
+			"type":       outputType,
+			"sensitive":  sensitive,
+			"value_json": valueJSON,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", workspaceID, templateID))
+	if err = d.Set("template_id", templateID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting template_id: %s", err))
+	}
+	if err = d.Set("outputs", outputs); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting outputs: %s", err))
+	}
+	valuesJSON, err := json.Marshal(values)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("outputs_json", string(valuesJSON)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting outputs_json: %s", err))
+	}
+	sensitiveValuesJSON, err := json.Marshal(sensitiveValues)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("sensitive_outputs_json", string(sensitiveValuesJSON)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting sensitive_outputs_json: %s", err))
+	}
+
+	return nil
+}
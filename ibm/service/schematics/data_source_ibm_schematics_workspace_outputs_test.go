@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSchematicsWorkspaceOutputsDataSourceBasic(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSchematicsWorkspaceOutputsDataSourceConfigBasic(acc.WorkspaceID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_schematics_workspace_outputs.outputs", "workspace_id", acc.WorkspaceID),
+					resource.TestCheckResourceAttrSet("data.ibm_schematics_workspace_outputs.outputs", "template_id"),
+					resource.TestCheckResourceAttrSet("data.ibm_schematics_workspace_outputs.outputs", "outputs_json"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSchematicsWorkspaceOutputsDataSourceConfigBasic(wID string) string {
+	return fmt.Sprintf(`
+		  data "ibm_schematics_workspace_outputs" "outputs" {
+			workspace_id = "%s"
+		  }
+	  `, wID)
+}
@@ -48,6 +48,11 @@ func ResourceIbmSmPublicCertificateConfigurationCALetsEncrypt() *schema.Resource
 					return false
 				},
 			},
+			"lets_encrypt_preferred_chain": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Prefer the chain with an issuer matching this Subject Common Name.",
+			},
 			"config_type": &schema.Schema{
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -143,6 +148,9 @@ func resourceIbmSmPublicCertificateConfigurationCALetsEncryptRead(context contex
 	if err = d.Set("lets_encrypt_private_key", configuration.LetsEncryptPrivateKey); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting lets_encrypt_private_key: %s", err))
 	}
+	if err = d.Set("lets_encrypt_preferred_chain", configuration.LetsEncryptPreferredChain); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting lets_encrypt_preferred_chain: %s", err))
+	}
 
 	return nil
 }
@@ -173,6 +181,11 @@ func resourceIbmSmPublicCertificateConfigurationCALetsEncryptUpdate(context cont
 		hasChange = true
 	}
 
+	if d.HasChange("lets_encrypt_preferred_chain") {
+		patchVals.LetsEncryptPreferredChain = core.StringPtr(d.Get("lets_encrypt_preferred_chain").(string))
+		hasChange = true
+	}
+
 	patchVals.LetsEncryptEnvironment = core.StringPtr(d.Get("lets_encrypt_environment").(string))
 	if d.HasChange("lets_encrypt_environment") {
 		hasChange = true
@@ -234,5 +247,8 @@ func resourceIbmSmPublicCertificateConfigurationCALetsEncryptMapToConfigurationP
 	if _, ok := d.GetOk("lets_encrypt_private_key"); ok {
 		model.LetsEncryptPrivateKey = core.StringPtr(formatCertificate(d.Get("lets_encrypt_private_key").(string)))
 	}
+	if _, ok := d.GetOk("lets_encrypt_preferred_chain"); ok {
+		model.LetsEncryptPreferredChain = core.StringPtr(d.Get("lets_encrypt_preferred_chain").(string))
+	}
 	return model, nil
 }
@@ -2,6 +2,7 @@ package secretsmanager
 
 import (
 	"fmt"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 	"github.com/go-openapi/strfmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -116,3 +117,114 @@ func DateTimeToRFC3339(dt *strfmt.DateTime) (s string) {
 	}
 	return
 }
+
+// GetSecretValueByCRN returns the secret value of the Secrets Manager secret with the given CRN.
+// The CRN is like "crn:v1:bluemix:public:secrets-manager:<region>:a/<account>:<instance_id>:secret:<secret_id>"
+func GetSecretValueByCRN(meta interface{}, secretCRN string) (string, error) {
+	secretsManagerClient, secretId, err := getClientBySecretCRN(meta, secretCRN)
+	if err != nil {
+		return "", err
+	}
+
+	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
+	getSecretOptions.SetID(secretId)
+	secretIntf, response, err := secretsManagerClient.GetSecret(getSecretOptions)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error getting secret %s: %s\n%s", secretCRN, err, response)
+	}
+
+	switch secret := secretIntf.(type) {
+	case *secretsmanagerv2.ArbitrarySecret:
+		if secret.Payload != nil {
+			return *secret.Payload, nil
+		}
+	case *secretsmanagerv2.IAMCredentialsSecret:
+		if secret.ApiKey != nil {
+			return *secret.ApiKey, nil
+		}
+	case *secretsmanagerv2.UsernamePasswordSecret:
+		if secret.Password != nil {
+			return *secret.Password, nil
+		}
+	default:
+		return "", fmt.Errorf("[ERROR] The type of secret %s is not supported, use an arbitrary, IAM credentials or username password secret", secretCRN)
+	}
+	return "", fmt.Errorf("[ERROR] Secret %s has no value", secretCRN)
+}
+
+// GetSecretVersionsTotalByCRN returns the number of versions of the Secrets Manager secret with the
+// given CRN. The number grows each time the secret is rotated.
+func GetSecretVersionsTotalByCRN(meta interface{}, secretCRN string) (int64, error) {
+	secretsManagerClient, secretId, err := getClientBySecretCRN(meta, secretCRN)
+	if err != nil {
+		return 0, err
+	}
+
+	getSecretMetadataOptions := &secretsmanagerv2.GetSecretMetadataOptions{}
+	getSecretMetadataOptions.SetID(secretId)
+	secretMetadataIntf, response, err := secretsManagerClient.GetSecretMetadata(getSecretMetadataOptions)
+	if err != nil {
+		return 0, fmt.Errorf("[ERROR] Error getting secret metadata %s: %s\n%s", secretCRN, err, response)
+	}
+
+	var versionsTotal *int64
+	switch secretMetadata := secretMetadataIntf.(type) {
+	case *secretsmanagerv2.ArbitrarySecretMetadata:
+		versionsTotal = secretMetadata.VersionsTotal
+	case *secretsmanagerv2.IAMCredentialsSecretMetadata:
+		versionsTotal = secretMetadata.VersionsTotal
+	case *secretsmanagerv2.UsernamePasswordSecretMetadata:
+		versionsTotal = secretMetadata.VersionsTotal
+	default:
+		return 0, fmt.Errorf("[ERROR] The type of secret %s is not supported, use an arbitrary, IAM credentials or username password secret", secretCRN)
+	}
+	if versionsTotal == nil {
+		return 0, fmt.Errorf("[ERROR] Secret %s has no versions", secretCRN)
+	}
+	return *versionsTotal, nil
+}
+
+// GetCertificateExpirationByCRN returns the expiration date of the current version of the Secrets Manager
+// certificate with the given CRN. The expiration date changes each time the certificate is rotated.
+func GetCertificateExpirationByCRN(meta interface{}, secretCRN string) (*strfmt.DateTime, error) {
+	secretsManagerClient, secretId, err := getClientBySecretCRN(meta, secretCRN)
+	if err != nil {
+		return nil, err
+	}
+
+	getSecretMetadataOptions := &secretsmanagerv2.GetSecretMetadataOptions{}
+	getSecretMetadataOptions.SetID(secretId)
+	secretMetadataIntf, response, err := secretsManagerClient.GetSecretMetadata(getSecretMetadataOptions)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting secret metadata %s: %s\n%s", secretCRN, err, response)
+	}
+
+	switch secretMetadata := secretMetadataIntf.(type) {
+	case *secretsmanagerv2.ImportedCertificateMetadata:
+		return secretMetadata.ExpirationDate, nil
+	case *secretsmanagerv2.PublicCertificateMetadata:
+		return secretMetadata.ExpirationDate, nil
+	case *secretsmanagerv2.PrivateCertificateMetadata:
+		return secretMetadata.ExpirationDate, nil
+	}
+	return nil, fmt.Errorf("[ERROR] Secret %s is not a certificate, use an imported, public or private certificate", secretCRN)
+}
+
+// getClientBySecretCRN returns a client for the instance of the secret with the given CRN, and the ID of the secret
+func getClientBySecretCRN(meta interface{}, secretCRN string) (*secretsmanagerv2.SecretsManagerV2, string, error) {
+	crnParts := strings.Split(secretCRN, ":")
+	if len(crnParts) != 10 || crnParts[4] != "secrets-manager" || crnParts[8] != "secret" {
+		return nil, "", fmt.Errorf("[ERROR] %s is not a valid Secrets Manager secret CRN", secretCRN)
+	}
+	region, instanceId, secretId := crnParts[5], crnParts[7], crnParts[9]
+
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return nil, "", err
+	}
+	endpointType := "public"
+	if strings.Contains(secretsManagerClient.Service.GetServiceURL(), "private.") {
+		endpointType = "private"
+	}
+	return getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, endpointType), secretId, nil
+}
@@ -4,19 +4,29 @@
 package transitgateway
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"os"
 	"time"
 
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam"
+	token "github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
+	"github.com/IBM/ibm-cos-sdk-go/aws/session"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
 	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
@@ -32,6 +42,26 @@ const (
 	tgUpdatedAt     = "updated_at"
 	tgGatewayTags   = "tags"
 
+	tgConnectionPrefixLimits       = "connection_prefix_limits"
+	tgConnectionsPrefixUsage       = "connections_prefix_usage"
+	tgPrefixUsageRouteReportID     = "prefix_usage_route_report_id"
+	tgPrefixUsageConnectionID      = "connection_id"
+	tgPrefixUsageConnectionName    = "name"
+	tgPrefixUsageNetworkType       = "network_type"
+	tgPrefixUsageLearnedPrefixes   = "learned_prefixes"
+	tgPrefixUsageAdvertised        = "advertised_prefixes"
+	tgPrefixUsageLimit             = "prefix_limit"
+	tgPrefixUsageLimitExceeded     = "limit_exceeded"
+	tgRouteReportExport            = "route_report_export"
+	tgRouteReportExportInstanceCRN = "cos_instance_crn"
+	tgRouteReportExportBucket      = "bucket"
+	tgRouteReportExportEndpoint    = "endpoint"
+	tgRouteReportExportKeyPrefix   = "key_prefix"
+	tgRouteReportExportInterval    = "interval"
+	tgRouteReportLastExportAt      = "route_report_last_export_at"
+	tgRouteReportLastExportID      = "route_report_last_export_id"
+	tgRouteReportLastExportKey     = "route_report_last_export_key"
+
 	isTransitGatewayProvisioning     = "provisioning"
 	isTransitGatewayProvisioningDone = "done"
 	isTransitGatewayDeleting         = "deleting"
@@ -57,6 +87,7 @@ func ResourceIBMTransitGateway() *schema.Resource {
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ResourceTagsCustomizeDiff(diff)
 			},
+			resourceIBMTransitGatewayRouteReportExportCustomizeDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -118,6 +149,115 @@ func ResourceIBMTransitGateway() *schema.Resource {
 				Description: "The Status of the resource",
 			},
 
+			tgConnectionPrefixLimits: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The prefix limits of the connections, keyed by connection ID, by network type, or default",
+			},
+			tgConnectionsPrefixUsage: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The prefix counts of the connections from the latest complete route report, versus their limits",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						tgPrefixUsageConnectionID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the connection",
+						},
+						tgPrefixUsageConnectionName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the connection",
+						},
+						tgPrefixUsageNetworkType: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The network type of the connection",
+						},
+						tgPrefixUsageLearnedPrefixes: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of prefixes the transit gateway learned from the connection",
+						},
+						tgPrefixUsageAdvertised: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of prefixes the transit gateway learned from the other connections and advertises to the connection, before the prefix filters",
+						},
+						tgPrefixUsageLimit: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The prefix limit of the connection from connection_prefix_limits, 0 if not set",
+						},
+						tgPrefixUsageLimitExceeded: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the learned prefixes exceed the prefix limit of the connection",
+						},
+					},
+				},
+			},
+			tgPrefixUsageRouteReportID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the route report the prefix usage of the connections is computed from",
+			},
+			tgRouteReportExport: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Export a route report of the transit gateway to a COS bucket when the interval has elapsed since the last export",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						tgRouteReportExportInstanceCRN: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The CRN of the COS instance of the bucket",
+						},
+						tgRouteReportExportBucket: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the COS bucket",
+						},
+						tgRouteReportExportEndpoint: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The COS endpoint of the bucket, for example s3.direct.us-south.cloud-object-storage.appdomain.cloud",
+						},
+						tgRouteReportExportKeyPrefix: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "transit-gateway-route-reports/",
+							Description: "The prefix of the keys of the exported route reports",
+						},
+						tgRouteReportExportInterval: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      24,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The minimum interval between two exports, in hours",
+						},
+					},
+				},
+			},
+			tgRouteReportLastExportAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time of the last export of a route report",
+			},
+			tgRouteReportLastExportID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the last exported route report",
+			},
+			tgRouteReportLastExportKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The COS key of the last exported route report",
+			},
+
 			flex.ResourceControllerURL: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -229,6 +369,12 @@ func resourceIBMTransitGatewayCreate(d *schema.ResourceData, meta interface{}) e
 				"Error on create of transit gateway (%s) tags: %s", d.Id(), err)
 		}
 	}
+
+	if _, ok := d.GetOk(tgRouteReportExport); ok {
+		if err := exportTransitGatewayRouteReport(d, meta, client, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
 	return resourceIBMTransitGatewayRead(d, meta)
 }
 
@@ -318,6 +464,10 @@ func resourceIBMTransitGatewayRead(d *schema.ResourceData, meta interface{}) err
 		d.Set(tgResourceGroup, *rg.ID)
 		d.Set(flex.ResourceGroupName, *rg.ID)
 	}
+
+	if err := setTransitGatewayPrefixUsage(d, client); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -362,10 +512,18 @@ func resourceIBMTransitGatewayUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	_, response, err := client.UpdateTransitGateway(updateTransitGatewayOptions)
-	if err != nil {
-		log.Printf("[DEBUG] Update Transit Gateway err %s\n%s", err, response)
-		return err
+	if d.HasChange(tgName) || d.HasChange(tgGlobal) {
+		_, response, err := client.UpdateTransitGateway(updateTransitGatewayOptions)
+		if err != nil {
+			log.Printf("[DEBUG] Update Transit Gateway err %s\n%s", err, response)
+			return err
+		}
+	}
+
+	if _, ok := d.GetOk(tgRouteReportExport); ok && (d.HasChange(tgRouteReportExport) || isTransitGatewayRouteReportExportDue(d.Get(tgRouteReportExport).([]interface{}), d.Get(tgRouteReportLastExportAt).(string))) {
+		if err := exportTransitGatewayRouteReport(d, meta, client, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
 	}
 
 	return resourceIBMTransitGatewayRead(d, meta)
@@ -454,3 +612,214 @@ func resourceIBMTransitGatewayExists(d *schema.ResourceData, meta interface{}) (
 
 	return true, nil
 }
+
+// resourceIBMTransitGatewayRouteReportExportCustomizeDiff plans an export of a route report when the
+// export settings changed or when the interval has elapsed since the last export.
+func resourceIBMTransitGatewayRouteReportExportCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	export, ok := diff.GetOk(tgRouteReportExport)
+	if !ok {
+		return nil
+	}
+	if !diff.HasChange(tgRouteReportExport) && !isTransitGatewayRouteReportExportDue(export.([]interface{}), diff.Get(tgRouteReportLastExportAt).(string)) {
+		return nil
+	}
+	for _, key := range []string{tgRouteReportLastExportAt, tgRouteReportLastExportID, tgRouteReportLastExportKey, tgPrefixUsageRouteReportID, tgConnectionsPrefixUsage} {
+		if err := diff.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isTransitGatewayRouteReportExportDue(export []interface{}, lastExportAt string) bool {
+	if len(export) == 0 || export[0] == nil {
+		return false
+	}
+	if lastExportAt == "" {
+		return true
+	}
+	last, err := time.Parse(time.RFC3339, lastExportAt)
+	if err != nil {
+		return true
+	}
+	interval := export[0].(map[string]interface{})[tgRouteReportExportInterval].(int)
+	return time.Since(last) >= time.Duration(interval)*time.Hour
+}
+
+// exportTransitGatewayRouteReport generates a route report of the transit gateway and uploads it to the
+// COS bucket of the export. The route report of the previous export is deleted once the new one is uploaded.
+func exportTransitGatewayRouteReport(d *schema.ResourceData, meta interface{}, client *transitgatewayapisv1.TransitGatewayApisV1, timeout time.Duration) error {
+	export := d.Get(tgRouteReportExport).([]interface{})[0].(map[string]interface{})
+	gatewayID := d.Id()
+
+	createTransitGatewayRouteReportOptions := &transitgatewayapisv1.CreateTransitGatewayRouteReportOptions{}
+	createTransitGatewayRouteReportOptions.SetTransitGatewayID(gatewayID)
+	routeReport, response, err := client.CreateTransitGatewayRouteReport(createTransitGatewayRouteReportOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error creating route report of Transit Gateway (%s): %s\n%s", gatewayID, err, response)
+	}
+	report, err := isWaitForTransitGatewayRouteReportAvailable(client, fmt.Sprintf("%s/%s", gatewayID, *routeReport.ID), timeout)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := transitGatewayRouteReportCOSClient(bxSession, export[tgRouteReportExportEndpoint].(string), export[tgRouteReportExportInstanceCRN].(string))
+	if err != nil {
+		return err
+	}
+	exportedAt := time.Now().UTC()
+	bucket := export[tgRouteReportExportBucket].(string)
+	key := fmt.Sprintf("%s%s/%s-%s.json", export[tgRouteReportExportKeyPrefix].(string), gatewayID, exportedAt.Format("20060102T150405Z"), *routeReport.ID)
+	putInput := &s3.PutObjectInput{
+		Bucket:      aws.String(bucket),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(body),
+		ContentType: aws.String("application/json"),
+	}
+	if _, err := s3Client.PutObject(putInput); err != nil {
+		return fmt.Errorf("[ERROR] Error exporting route report (%s) of Transit Gateway (%s) to bucket %s: %s", *routeReport.ID, gatewayID, bucket, err)
+	}
+
+	previousID, _ := d.GetChange(tgRouteReportLastExportID)
+	if previous := previousID.(string); previous != "" && previous != *routeReport.ID {
+		deleteTransitGatewayRouteReportOptions := &transitgatewayapisv1.DeleteTransitGatewayRouteReportOptions{}
+		deleteTransitGatewayRouteReportOptions.SetTransitGatewayID(gatewayID)
+		deleteTransitGatewayRouteReportOptions.SetID(previous)
+		response, err := client.DeleteTransitGatewayRouteReport(deleteTransitGatewayRouteReportOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			log.Printf("[WARN] Error deleting previously exported route report (%s) of Transit Gateway (%s): %s\n%s", previous, gatewayID, err, response)
+		}
+	}
+
+	d.Set(tgRouteReportLastExportAt, exportedAt.Format(time.RFC3339))
+	d.Set(tgRouteReportLastExportID, *routeReport.ID)
+	d.Set(tgRouteReportLastExportKey, key)
+	return nil
+}
+
+func transitGatewayRouteReportCOSClient(bxSession *bxsession.Session, endpoint string, instanceCRN string) (*s3.S3, error) {
+	var s3Conf *aws.Config
+
+	authEndpoint, err := bxSession.Config.EndpointLocator.IAMEndpoint()
+	if err != nil {
+		return nil, err
+	}
+	authEndpointPath := fmt.Sprintf("%s%s", authEndpoint, "/identity/token")
+	apiKey := bxSession.Config.BluemixAPIKey
+	if apiKey != "" {
+		s3Conf = aws.NewConfig().WithEndpoint(endpoint).WithCredentials(ibmiam.NewStaticCredentials(aws.NewConfig(), authEndpointPath, apiKey, instanceCRN)).WithS3ForcePathStyle(true)
+	}
+	iamAccessToken := bxSession.Config.IAMAccessToken
+	if iamAccessToken != "" {
+		initFunc := func() (*token.Token, error) {
+			return &token.Token{
+				AccessToken:  bxSession.Config.IAMAccessToken,
+				RefreshToken: bxSession.Config.IAMRefreshToken,
+				TokenType:    "Bearer",
+				ExpiresIn:    int64((time.Hour * 248).Seconds()) * -1,
+				Expiration:   time.Now().Add(-1 * time.Hour).Unix(),
+			}, nil
+		}
+		s3Conf = aws.NewConfig().WithEndpoint(endpoint).WithCredentials(ibmiam.NewCustomInitFuncCredentials(aws.NewConfig(), initFunc, authEndpointPath, instanceCRN)).WithS3ForcePathStyle(true)
+	}
+	s3Sess := session.Must(session.NewSession())
+	return s3.New(s3Sess, s3Conf), nil
+}
+
+// setTransitGatewayPrefixUsage sets the prefix counts of the connections from the latest complete route
+// report of the transit gateway. A connection learns the prefixes of its routes and BGP routes, and is
+// advertised the prefixes used from the other connections.
+func setTransitGatewayPrefixUsage(d *schema.ResourceData, client *transitgatewayapisv1.TransitGatewayApisV1) error {
+	gatewayID := d.Id()
+	listTransitGatewayRouteReportsOptions := &transitgatewayapisv1.ListTransitGatewayRouteReportsOptions{}
+	listTransitGatewayRouteReportsOptions.SetTransitGatewayID(gatewayID)
+	routeReports, response, err := client.ListTransitGatewayRouteReports(listTransitGatewayRouteReportsOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error while listing transit gateway route reports %s\n%s", err, response)
+	}
+
+	var latest *transitgatewayapisv1.RouteReport
+	for i := range routeReports.RouteReports {
+		routeReport := &routeReports.RouteReports[i]
+		if routeReport.Status == nil || *routeReport.Status != transitgatewayapisv1.RouteReport_Status_Complete || routeReport.CreatedAt == nil {
+			continue
+		}
+		if latest == nil || time.Time(*routeReport.CreatedAt).After(time.Time(*latest.CreatedAt)) {
+			latest = routeReport
+		}
+	}
+	if latest == nil {
+		d.Set(tgConnectionsPrefixUsage, []map[string]interface{}{})
+		d.Set(tgPrefixUsageRouteReportID, "")
+		return nil
+	}
+
+	learned := make([]map[string]bool, len(latest.Connections))
+	used := make([]map[string]bool, len(latest.Connections))
+	for i, connection := range latest.Connections {
+		learned[i] = map[string]bool{}
+		used[i] = map[string]bool{}
+		for _, route := range connection.Routes {
+			if route.Prefix != nil {
+				learned[i][*route.Prefix] = true
+				used[i][*route.Prefix] = true
+			}
+		}
+		for _, bgp := range connection.Bgps {
+			if bgp.Prefix != nil {
+				learned[i][*bgp.Prefix] = true
+			}
+		}
+	}
+
+	limits := d.Get(tgConnectionPrefixLimits).(map[string]interface{})
+	usage := make([]map[string]interface{}, 0, len(latest.Connections))
+	for i, connection := range latest.Connections {
+		advertised := map[string]bool{}
+		for j := range latest.Connections {
+			if j == i {
+				continue
+			}
+			for prefix := range used[j] {
+				advertised[prefix] = true
+			}
+		}
+		connectionID := core.StringNilMapper(connection.ID)
+		networkType := core.StringNilMapper(connection.Type)
+		limit := transitGatewayConnectionPrefixLimit(limits, connectionID, networkType)
+		usage = append(usage, map[string]interface{}{
+			tgPrefixUsageConnectionID:    connectionID,
+			tgPrefixUsageConnectionName:  core.StringNilMapper(connection.Name),
+			tgPrefixUsageNetworkType:     networkType,
+			tgPrefixUsageLearnedPrefixes: len(learned[i]),
+			tgPrefixUsageAdvertised:      len(advertised),
+			tgPrefixUsageLimit:           limit,
+			tgPrefixUsageLimitExceeded:   limit > 0 && len(learned[i]) > limit,
+		})
+	}
+	d.Set(tgConnectionsPrefixUsage, usage)
+	d.Set(tgPrefixUsageRouteReportID, *latest.ID)
+	return nil
+}
+
+// transitGatewayConnectionPrefixLimit returns the limit of the connection ID, else of the network type,
+// else the default limit, else 0.
+func transitGatewayConnectionPrefixLimit(limits map[string]interface{}, connectionID, networkType string) int {
+	for _, key := range []string{connectionID, networkType, "default"} {
+		if limit, ok := limits[key]; ok {
+			return limit.(int)
+		}
+	}
+	return 0
+}
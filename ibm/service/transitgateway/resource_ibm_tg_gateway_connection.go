@@ -40,6 +40,7 @@ const (
 	tgRemoteTunnelIp                    = "remote_tunnel_ip"
 	tgZone                              = "zone"
 	tgMtu                               = "mtu"
+	tgPrefixFiltersDefault              = "prefix_filters_default"
 )
 
 func ResourceIBMTransitGatewayConnection() *schema.Resource {
@@ -152,6 +153,13 @@ func ResourceIBMTransitGatewayConnection() *schema.Resource {
 				ForceNew:    true,
 				Description: "Location of GRE tunnel. This field only applies to network type 'gre_tunnel' and 'unbound_gre_tunnel' connections.",
 			},
+			tgPrefixFiltersDefault: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_tg_connection", tgPrefixFiltersDefault),
+				Description:  "Default setting of permit or deny which applies to any routes that don't match a specified filter. Setting it to 'deny' stops routes learned over the connection from being advertised unless a prefix filter permits them.",
+			},
 			tgCreatedAt: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -200,6 +208,13 @@ func ResourceIBMTransitGatewayConnectionValidator() *validate.ResourceValidator
 			Regexp:                     `^([a-zA-Z]|[a-zA-Z][-_a-zA-Z0-9]*[a-zA-Z0-9])$`,
 			MinValueLength:             1,
 			MaxValueLength:             63})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 tgPrefixFiltersDefault,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "permit, deny"})
 
 	ibmTransitGatewayConnectionResourceValidator := validate.ResourceValidator{ResourceName: "ibm_tg_connection", Schema: validateSchema}
 
@@ -265,6 +280,10 @@ func resourceIBMTransitGatewayConnectionCreate(d *schema.ResourceData, meta inte
 		zoneIdentity.Name = &zoneName
 		createTransitGatewayConnectionOptions.SetZone(zoneIdentity)
 	}
+	if _, ok := d.GetOk(tgPrefixFiltersDefault); ok {
+		prefixFiltersDefault := d.Get(tgPrefixFiltersDefault).(string)
+		createTransitGatewayConnectionOptions.SetPrefixFiltersDefault(prefixFiltersDefault)
+	}
 
 	tgConnections, response, err := client.CreateTransitGatewayConnection(createTransitGatewayConnectionOptions)
 	if err != nil {
@@ -285,6 +304,21 @@ func resourceIBMTransitGatewayConnectionCreate(d *schema.ResourceData, meta inte
 	}
 	return resourceIBMTransitGatewayConnectionRead(d, meta)
 }
+
+// WaitForTransitGatewayConnectionAvailable polls the connection identified by
+// gatewayID/connectionID until it leaves the pending state. It is shared with
+// resources outside this package that create transit gateway connections, such
+// as the Power Virtual Server PER connection.
+func WaitForTransitGatewayConnectionAvailable(client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
+	return isWaitForTransitGatewayConnectionAvailable(client, id, timeout)
+}
+
+// WaitForTransitGatewayConnectionDeleted polls the connection identified by
+// gatewayID/connectionID until it is detached or no longer found.
+func WaitForTransitGatewayConnectionDeleted(client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
+	return isWaitForTransitGatewayConnectionDeleted(client, id, timeout)
+}
+
 func isWaitForTransitGatewayConnectionAvailable(client *transitgatewayapisv1.TransitGatewayApisV1, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for transit gateway connection (%s) to be available.", id)
 
@@ -374,6 +408,9 @@ func resourceIBMTransitGatewayConnectionRead(d *schema.ResourceData, meta interf
 	if instance.RequestStatus != nil {
 		d.Set(tgRequestStatus, *instance.RequestStatus)
 	}
+	if instance.PrefixFiltersDefault != nil {
+		d.Set(tgPrefixFiltersDefault, *instance.PrefixFiltersDefault)
+	}
 	d.Set(tgConnectionId, *instance.ID)
 	d.Set(tgGatewayId, gatewayId)
 	getTransitGatewayOptions := &transitgatewayapisv1.GetTransitGatewayOptions{
@@ -421,6 +458,10 @@ func resourceIBMTransitGatewayConnectionUpdate(d *schema.ResourceData, meta inte
 			updateTransitGatewayConnectionOptions.Name = &name
 		}
 	}
+	if d.HasChange(tgPrefixFiltersDefault) {
+		prefixFiltersDefault := d.Get(tgPrefixFiltersDefault).(string)
+		updateTransitGatewayConnectionOptions.PrefixFiltersDefault = &prefixFiltersDefault
+	}
 
 	_, response, err = client.UpdateTransitGatewayConnection(updateTransitGatewayConnectionOptions)
 	if err != nil {
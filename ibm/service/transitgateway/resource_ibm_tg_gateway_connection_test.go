@@ -91,6 +91,7 @@ func TestAccIBMTransitGatewayConnection_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckIBMTransitGatewayConnectionExists("ibm_tg_connection.test_tg_powervs_connection", tgConnection),
 					resource.TestCheckResourceAttr("ibm_tg_connection.test_tg_powervs_connection", "name", tgConnectionName),
+					resource.TestCheckResourceAttr("ibm_tg_connection.test_tg_powervs_connection", "prefix_filters_default", "deny"),
 				),
 			},
 		},
@@ -240,6 +241,7 @@ resource "ibm_tg_connection" "test_tg_powervs_connection"{
 		network_type = "power_virtual_server"
 		name = "%s"
 		network_id = "%s"
+		prefix_filters_default = "deny"
 }	   
 	  `, gatewayName, powerVSConnName, acc.Tg_power_vs_network_id)
 }
@@ -131,3 +131,47 @@ func TestAccIBMTransitGatewayImport(t *testing.T) {
 		},
 	})
 }
+
+func TestAccIBMTransitGatewayRouteReportExport(t *testing.T) {
+	var instance string
+	gatewayname := fmt.Sprintf("tg-gateway-export-%d", acctest.RandIntRange(10, 100))
+	location := "us-south"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMTransitGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMTransitGatewayRouteReportExportConfig(gatewayname, location),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMTransitGatewayExists("ibm_tg_gateway.test_tg_gateway", instance),
+					resource.TestCheckResourceAttrSet("ibm_tg_gateway.test_tg_gateway", "route_report_last_export_at"),
+					resource.TestCheckResourceAttrSet("ibm_tg_gateway.test_tg_gateway", "route_report_last_export_key"),
+					resource.TestCheckResourceAttrPair("ibm_tg_gateway.test_tg_gateway", "prefix_usage_route_report_id",
+						"ibm_tg_gateway.test_tg_gateway", "route_report_last_export_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMTransitGatewayRouteReportExportConfig(gatewayname, location string) string {
+	return fmt.Sprintf(`
+	resource "ibm_tg_gateway" "test_tg_gateway" {
+		name     = "%s"
+		location = "%s"
+		global   = true
+
+		connection_prefix_limits = {
+			default = 1000
+		}
+
+		route_report_export {
+			cos_instance_crn = "%s"
+			bucket           = "%s"
+			endpoint         = "s3.us-south.cloud-object-storage.appdomain.cloud"
+			interval         = 24
+		}
+	}
+	`, gatewayname, location, acc.CosCRN, acc.IsCosBucketName)
+}
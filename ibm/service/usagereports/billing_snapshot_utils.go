@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package usagereports
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// The billing reports snapshot APIs of the Usage Reports API are not in the version of usagereportsv4 that the
+// provider uses, so they are called with the base service of the client.
+
+// billingSnapshotList is a page of the billing reports snapshots of an account
+type billingSnapshotList struct {
+	Count     *int64                   `json:"count"`
+	Next      *billingSnapshotListNext `json:"next,omitempty"`
+	Snapshots []billingSnapshot        `json:"snapshots"`
+}
+
+type billingSnapshotListNext struct {
+	Href   *string `json:"href,omitempty"`
+	Offset *string `json:"offset,omitempty"`
+}
+
+type billingSnapshot struct {
+	AccountID           *string                       `json:"account_id"`
+	Month               *string                       `json:"month"`
+	AccountType         *string                       `json:"account_type"`
+	ExpectedProcessedAt *int64                        `json:"expected_processed_at"`
+	State               *string                       `json:"state"`
+	BillingPeriod       *billingSnapshotBillingPeriod `json:"billing_period"`
+	SnapshotID          *string                       `json:"snapshot_id"`
+	Charges             *string                       `json:"charges"`
+	Version             *string                       `json:"version"`
+	CreatedOn           *int64                        `json:"created_on"`
+	ProcessedAt         *int64                        `json:"processed_at"`
+	ReportTypes         []billingSnapshotReportType   `json:"report_types"`
+	Files               []billingSnapshotFile         `json:"files"`
+}
+
+type billingSnapshotBillingPeriod struct {
+	Start *string `json:"start"`
+	End   *string `json:"end"`
+}
+
+type billingSnapshotReportType struct {
+	Type    *string `json:"type"`
+	Version *string `json:"version"`
+}
+
+type billingSnapshotFile struct {
+	ReportTypes *string `json:"report_types"`
+	Location    *string `json:"location"`
+	AccountID   *string `json:"account_id"`
+}
+
+// billingSnapshotConfig is the configuration of the billing reports snapshots of an account
+type billingSnapshotConfig struct {
+	AccountID        *string  `json:"account_id,omitempty"`
+	State            *string  `json:"state,omitempty"`
+	AccountType      *string  `json:"account_type,omitempty"`
+	Interval         *string  `json:"interval,omitempty"`
+	Versioning       *string  `json:"versioning,omitempty"`
+	ReportTypes      []string `json:"report_types,omitempty"`
+	Compression      *string  `json:"compression,omitempty"`
+	ContentType      *string  `json:"content_type,omitempty"`
+	CosReportsFolder *string  `json:"cos_reports_folder,omitempty"`
+	CosBucket        *string  `json:"cos_bucket,omitempty"`
+	CosLocation      *string  `json:"cos_location,omitempty"`
+	CosEndpoint      *string  `json:"cos_endpoint,omitempty"`
+	CreatedAt        *int64   `json:"created_at,omitempty"`
+	LastUpdatedAt    *int64   `json:"last_updated_at,omitempty"`
+}
+
+// billingSnapshotRequest sends a request to the Usage Reports API and unmarshals the response into result. The path
+// is relative to the URL of the API.
+func billingSnapshotRequest(context context.Context, service *core.BaseService, method, path string, query map[string]string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(context)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(service.Options.URL, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	for name, value := range query {
+		builder.AddQuery(name, value)
+	}
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		if _, err := builder.SetBodyContentJSON(body); err != nil {
+			return nil, err
+		}
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return service.Request(request, result)
+}
@@ -0,0 +1,412 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package usagereports
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/platform-services-go-sdk/usagereportsv4"
+)
+
+// DataSourceIBMBillingAccountUsage reads the summary and the usage by resource of an account for a month, with the
+// credits of the offers and the subscriptions of the account.
+func DataSourceIBMBillingAccountUsage() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMBillingAccountUsageRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the account to read the usage of. The default is the account of the provider.",
+			},
+			"month": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The billing month, in the format yyyy-mm.",
+			},
+			"billing_country_code": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The country code of the billing of the account, in ISO 3166 Alpha-3 format.",
+			},
+			"billing_currency_code": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The currency code of the billing of the account, in ISO 4217 format.",
+			},
+			"currency_rate": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The conversion rate from USD to the currency of the account.",
+			},
+			"billable_cost": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The billable charges of the account for the month.",
+			},
+			"non_billable_cost": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The charges of the account for the month that are not billable, for example the usage of the free plans.",
+			},
+			"resources": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The usage of the account by resource, for example by service in the catalog.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the resource.",
+						},
+						"resource_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the resource.",
+						},
+						"billable_cost": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The billable charges of the resource, after the discounts.",
+						},
+						"billable_rated_cost": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The billable charges of the resource, before the discounts.",
+						},
+						"non_billable_cost": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The charges of the resource that are not billable, after the discounts.",
+						},
+						"non_billable_rated_cost": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The charges of the resource that are not billable, before the discounts.",
+						},
+					},
+				},
+			},
+			"offers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The offers of the account, for example promotion credits.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"offer_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the offer.",
+						},
+						"offer_template": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The template of the offer.",
+						},
+						"credits_total": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The total credits of the offer.",
+						},
+						"valid_from": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date from which the offer is valid.",
+						},
+						"expires_on": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date on which the offer expires.",
+						},
+						"starting_balance": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The balance of the credits of the offer at the start of the month.",
+						},
+						"used": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The credits of the offer used in the month.",
+						},
+						"balance": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The balance of the credits of the offer at the end of the month.",
+						},
+					},
+				},
+			},
+			"subscription_overage": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The charges of the month that exceed the credits of the subscriptions.",
+			},
+			"subscriptions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The subscriptions of the account, with their credits.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subscription_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the subscription.",
+						},
+						"charge_agreement_number": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The charge agreement number of the subscription.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the subscription.",
+						},
+						"subscription_amount": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The amount of the subscription.",
+						},
+						"start": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The start date of the subscription.",
+						},
+						"end": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The end date of the subscription.",
+						},
+						"credits_total": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The total credits of the subscription.",
+						},
+						"terms": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The terms of the subscription, with the burn-down of their credits.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"start": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The start date of the term.",
+									},
+									"end": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The end date of the term.",
+									},
+									"total": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "The total credits of the term.",
+									},
+									"starting_balance": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "The balance of the credits of the term at the start of the month.",
+									},
+									"used": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "The credits of the term used in the month.",
+									},
+									"balance": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "The balance of the credits of the term at the end of the month.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"support": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The support plans of the account, with their charges.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the support plan.",
+						},
+						"cost": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The monthly charges of the support plan.",
+						},
+						"overage": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The charges of the support plan that exceed its monthly charges.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMBillingAccountUsageRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	usageReportsClient, err := meta.(conns.ClientSession).UsageReportsV4()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountID := d.Get("account_id").(string)
+	if accountID == "" {
+		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		accountID = userDetails.UserAccount
+	}
+	month := d.Get("month").(string)
+
+	getAccountSummaryOptions := usageReportsClient.NewGetAccountSummaryOptions(accountID, month)
+	accountSummary, response, err := usageReportsClient.GetAccountSummaryWithContext(context, getAccountSummaryOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetAccountSummaryWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting the summary of account %s for %s: %s\n%s", accountID, month, err, response))
+	}
+
+	getAccountUsageOptions := usageReportsClient.NewGetAccountUsageOptions(accountID, month)
+	getAccountUsageOptions.SetNames(true)
+	accountUsage, response, err := usageReportsClient.GetAccountUsageWithContext(context, getAccountUsageOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetAccountUsageWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting the usage of account %s for %s: %s\n%s", accountID, month, err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", accountID, month))
+	if err = d.Set("account_id", accountID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting account_id: %s", err))
+	}
+	if err = d.Set("billing_country_code", accountSummary.BillingCountryCode); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting billing_country_code: %s", err))
+	}
+	if err = d.Set("billing_currency_code", accountSummary.BillingCurrencyCode); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting billing_currency_code: %s", err))
+	}
+	if err = d.Set("currency_rate", accountUsage.CurrencyRate); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting currency_rate: %s", err))
+	}
+	if accountSummary.Resources != nil {
+		if err = d.Set("billable_cost", accountSummary.Resources.BillableCost); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting billable_cost: %s", err))
+		}
+		if err = d.Set("non_billable_cost", accountSummary.Resources.NonBillableCost); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting non_billable_cost: %s", err))
+		}
+	}
+
+	resources := make([]map[string]interface{}, 0, len(accountUsage.Resources))
+	for _, resource := range accountUsage.Resources {
+		resources = append(resources, map[string]interface{}{
+			"resource_id":             resource.ResourceID,
+			"resource_name":           resource.ResourceName,
+			"billable_cost":           resource.BillableCost,
+			"billable_rated_cost":     resource.BillableRatedCost,
+			"non_billable_cost":       resource.NonBillableCost,
+			"non_billable_rated_cost": resource.NonBillableRatedCost,
+		})
+	}
+	if err = d.Set("resources", resources); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resources: %s", err))
+	}
+
+	offers := make([]map[string]interface{}, 0, len(accountSummary.Offers))
+	for _, offer := range accountSummary.Offers {
+		offers = append(offers, dataSourceIBMBillingAccountUsageOfferToMap(offer))
+	}
+	if err = d.Set("offers", offers); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting offers: %s", err))
+	}
+
+	subscriptions := []map[string]interface{}{}
+	if accountSummary.Subscription != nil {
+		if err = d.Set("subscription_overage", accountSummary.Subscription.Overage); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting subscription_overage: %s", err))
+		}
+		for _, subscription := range accountSummary.Subscription.Subscriptions {
+			subscriptions = append(subscriptions, dataSourceIBMBillingAccountUsageSubscriptionToMap(subscription))
+		}
+	}
+	if err = d.Set("subscriptions", subscriptions); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting subscriptions: %s", err))
+	}
+
+	support := make([]map[string]interface{}, 0, len(accountSummary.Support))
+	for _, supportSummary := range accountSummary.Support {
+		support = append(support, map[string]interface{}{
+			"type":    supportSummary.Type,
+			"cost":    supportSummary.Cost,
+			"overage": supportSummary.Overage,
+		})
+	}
+	if err = d.Set("support", support); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting support: %s", err))
+	}
+
+	return nil
+}
+
+func dataSourceIBMBillingAccountUsageOfferToMap(offer usagereportsv4.Offer) map[string]interface{} {
+	offerMap := map[string]interface{}{
+		"offer_id":       offer.OfferID,
+		"offer_template": offer.OfferTemplate,
+		"credits_total":  offer.CreditsTotal,
+		"valid_from":     flex.DateTimeToString(offer.ValidFrom),
+		"expires_on":     flex.DateTimeToString(offer.ExpiresOn),
+	}
+	if offer.Credits != nil {
+		offerMap["starting_balance"] = offer.Credits.StartingBalance
+		offerMap["used"] = offer.Credits.Used
+		offerMap["balance"] = offer.Credits.Balance
+	}
+	return offerMap
+}
+
+func dataSourceIBMBillingAccountUsageSubscriptionToMap(subscription usagereportsv4.Subscription) map[string]interface{} {
+	terms := make([]map[string]interface{}, 0, len(subscription.Terms))
+	for _, term := range subscription.Terms {
+		termMap := map[string]interface{}{
+			"start": flex.DateTimeToString(term.Start),
+			"end":   flex.DateTimeToString(term.End),
+		}
+		if term.Credits != nil {
+			termMap["total"] = term.Credits.Total
+			termMap["starting_balance"] = term.Credits.StartingBalance
+			termMap["used"] = term.Credits.Used
+			termMap["balance"] = term.Credits.Balance
+		}
+		terms = append(terms, termMap)
+	}
+
+	return map[string]interface{}{
+		"subscription_id":         subscription.SubscriptionID,
+		"charge_agreement_number": subscription.ChargeAgreementNumber,
+		"type":                    subscription.Type,
+		"subscription_amount":     subscription.SubscriptionAmount,
+		"start":                   flex.DateTimeToString(subscription.Start),
+		"end":                     flex.DateTimeToString(subscription.End),
+		"credits_total":           subscription.CreditsTotal,
+		"terms":                   terms,
+	}
+}
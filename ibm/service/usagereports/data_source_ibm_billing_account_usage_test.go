@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package usagereports_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMBillingAccountUsageDataSourceBasic(t *testing.T) {
+	month := time.Now().UTC().AddDate(0, -1, 0).Format("2006-01")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMBillingAccountUsageDataSourceConfigBasic(month),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_billing_account_usage.billing_account_usage", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_billing_account_usage.billing_account_usage", "account_id"),
+					resource.TestCheckResourceAttrSet("data.ibm_billing_account_usage.billing_account_usage", "billing_currency_code"),
+					resource.TestCheckResourceAttrSet("data.ibm_billing_account_usage.billing_account_usage", "billable_cost"),
+					resource.TestCheckResourceAttrSet("data.ibm_billing_account_usage.billing_account_usage", "resources.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMBillingAccountUsageDataSourceConfigBasic(month string) string {
+	return fmt.Sprintf(`
+		data "ibm_billing_account_usage" "billing_account_usage" {
+			month = "%s"
+		}
+	`, month)
+}
@@ -0,0 +1,336 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package usagereports
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/usagereportsv4"
+)
+
+// billingResourceInstanceUsageFilters are the arguments of the data source that filter the resource instances
+var billingResourceInstanceUsageFilters = []string{"resource_group_id", "organization_id", "resource_instance_id", "resource_id", "plan_id", "region"}
+
+func DataSourceIBMBillingResourceInstanceUsage() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMBillingResourceInstanceUsageRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the account to read the usage of. The default is the account of the provider.",
+			},
+			"month": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The billing month, in the format yyyy-mm.",
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Read only the usage of the resource instances of this resource group.",
+			},
+			"organization_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Read only the usage of the resource instances of this Cloud Foundry organization.",
+			},
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Read only the usage of this resource instance.",
+			},
+			"resource_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Read only the usage of the resource instances of this resource, for example the ID of a service in the catalog.",
+			},
+			"plan_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Read only the usage of the resource instances of this plan.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Read only the usage of the resource instances of this region.",
+			},
+			"resource_instances": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The usage of the resource instances, from all the pages of the API.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_instance_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the resource instance.",
+						},
+						"resource_instance_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the resource instance.",
+						},
+						"resource_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the resource.",
+						},
+						"resource_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the resource.",
+						},
+						"resource_group_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the resource group.",
+						},
+						"resource_group_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the resource group.",
+						},
+						"organization_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the Cloud Foundry organization.",
+						},
+						"organization_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the Cloud Foundry organization.",
+						},
+						"plan_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the plan.",
+						},
+						"plan_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the plan.",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The region of the resource instance.",
+						},
+						"pricing_region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The pricing region of the usage.",
+						},
+						"pricing_country": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The country of the prices of the usage, in ISO 3166 Alpha-3 format.",
+						},
+						"currency_code": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The currency of the costs, in ISO 4217 format.",
+						},
+						"billable": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the usage is billable.",
+						},
+						"pending": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the costs of the usage are pending.",
+						},
+						"usage": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The usage of the resource instance by metric.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metric": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The ID of the metric.",
+									},
+									"metric_name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name of the metric.",
+									},
+									"unit": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The unit of the quantity.",
+									},
+									"unit_name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name of the unit of the quantity.",
+									},
+									"quantity": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "The quantity of the usage.",
+									},
+									"rateable_quantity": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "The quantity of the usage that is used to compute the cost.",
+									},
+									"cost": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "The cost of the usage, after the discounts.",
+									},
+									"rated_cost": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "The cost of the usage, before the discounts.",
+									},
+									"non_chargeable": {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Whether the usage is not charged.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMBillingResourceInstanceUsageRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	usageReportsClient, err := meta.(conns.ClientSession).UsageReportsV4()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountID := d.Get("account_id").(string)
+	if accountID == "" {
+		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		accountID = userDetails.UserAccount
+	}
+	month := d.Get("month").(string)
+
+	instances, err := flex.PaginateAllByToken(context, func(context context.Context, start string) ([]usagereportsv4.InstanceUsage, string, *core.DetailedResponse, error) {
+		getResourceUsageAccountOptions := usageReportsClient.NewGetResourceUsageAccountOptions(accountID, month)
+		getResourceUsageAccountOptions.SetNames(true)
+		getResourceUsageAccountOptions.SetLimit(usageExportPageLimit)
+		if start != "" {
+			getResourceUsageAccountOptions.SetStart(start)
+		}
+		if v, ok := d.GetOk("resource_group_id"); ok {
+			getResourceUsageAccountOptions.SetResourceGroupID(v.(string))
+		}
+		if v, ok := d.GetOk("organization_id"); ok {
+			getResourceUsageAccountOptions.SetOrganizationID(v.(string))
+		}
+		if v, ok := d.GetOk("resource_instance_id"); ok {
+			getResourceUsageAccountOptions.SetResourceInstanceID(v.(string))
+		}
+		if v, ok := d.GetOk("resource_id"); ok {
+			getResourceUsageAccountOptions.SetResourceID(v.(string))
+		}
+		if v, ok := d.GetOk("plan_id"); ok {
+			getResourceUsageAccountOptions.SetPlanID(v.(string))
+		}
+		if v, ok := d.GetOk("region"); ok {
+			getResourceUsageAccountOptions.SetRegion(v.(string))
+		}
+
+		instancesUsage, response, err := usageReportsClient.GetResourceUsageAccountWithContext(context, getResourceUsageAccountOptions)
+		if err != nil {
+			log.Printf("[DEBUG] GetResourceUsageAccountWithContext failed %s\n%s", err, response)
+			return nil, "", response, fmt.Errorf("[ERROR] Error getting the resource usage of account %s for %s: %s\n%s", accountID, month, err, response)
+		}
+		next := ""
+		if instancesUsage.Next != nil && instancesUsage.Next.Offset != nil {
+			next = *instancesUsage.Next.Offset
+		}
+		return instancesUsage.Resources, next, response, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceInstances := make([]map[string]interface{}, 0, len(instances))
+	for _, instance := range instances {
+		resourceInstances = append(resourceInstances, dataSourceIBMBillingInstanceUsageToMap(instance))
+	}
+
+	d.SetId(dataSourceIBMBillingResourceInstanceUsageID(d, accountID, month))
+	if err = d.Set("account_id", accountID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting account_id: %s", err))
+	}
+	if err = d.Set("resource_instances", resourceInstances); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resource_instances: %s", err))
+	}
+
+	return nil
+}
+
+// dataSourceIBMBillingResourceInstanceUsageID returns the ID of the data source, which includes the filters so that
+// the data sources of the same month with different filters have different IDs
+func dataSourceIBMBillingResourceInstanceUsageID(d *schema.ResourceData, accountID, month string) string {
+	id := fmt.Sprintf("%s/%s", accountID, month)
+	for _, filter := range billingResourceInstanceUsageFilters {
+		if v, ok := d.GetOk(filter); ok {
+			id = fmt.Sprintf("%s/%s=%s", id, filter, v.(string))
+		}
+	}
+	return id
+}
+
+func dataSourceIBMBillingInstanceUsageToMap(instance usagereportsv4.InstanceUsage) map[string]interface{} {
+	usage := make([]map[string]interface{}, 0, len(instance.Usage))
+	for _, metric := range instance.Usage {
+		usage = append(usage, map[string]interface{}{
+			"metric":            metric.Metric,
+			"metric_name":       metric.MetricName,
+			"unit":              metric.Unit,
+			"unit_name":         metric.UnitName,
+			"quantity":          metric.Quantity,
+			"rateable_quantity": metric.RateableQuantity,
+			"cost":              metric.Cost,
+			"rated_cost":        metric.RatedCost,
+			"non_chargeable":    metric.NonChargeable,
+		})
+	}
+
+	return map[string]interface{}{
+		"resource_instance_id":   instance.ResourceInstanceID,
+		"resource_instance_name": instance.ResourceInstanceName,
+		"resource_id":            instance.ResourceID,
+		"resource_name":          instance.ResourceName,
+		"resource_group_id":      instance.ResourceGroupID,
+		"resource_group_name":    instance.ResourceGroupName,
+		"organization_id":        instance.OrganizationID,
+		"organization_name":      instance.OrganizationName,
+		"plan_id":                instance.PlanID,
+		"plan_name":              instance.PlanName,
+		"region":                 instance.Region,
+		"pricing_region":         instance.PricingRegion,
+		"pricing_country":        instance.PricingCountry,
+		"currency_code":          instance.CurrencyCode,
+		"billable":               instance.Billable,
+		"pending":                instance.Pending,
+		"usage":                  usage,
+	}
+}
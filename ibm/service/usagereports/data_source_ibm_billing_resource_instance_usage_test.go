@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package usagereports_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMBillingResourceInstanceUsageDataSourceBasic(t *testing.T) {
+	month := time.Now().UTC().AddDate(0, -1, 0).Format("2006-01")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMBillingResourceInstanceUsageDataSourceConfigBasic(month),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_billing_resource_instance_usage.all", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_billing_resource_instance_usage.all", "account_id"),
+					resource.TestCheckResourceAttrSet("data.ibm_billing_resource_instance_usage.all", "resource_instances.#"),
+					resource.TestCheckResourceAttrSet("data.ibm_billing_resource_instance_usage.default_group", "resource_instances.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMBillingResourceInstanceUsageDataSourceConfigBasic(month string) string {
+	return fmt.Sprintf(`
+		data "ibm_resource_group" "default_group" {
+			is_default = true
+		}
+
+		data "ibm_billing_resource_instance_usage" "all" {
+			month = "%[1]s"
+		}
+
+		data "ibm_billing_resource_instance_usage" "default_group" {
+			month             = "%[1]s"
+			resource_group_id = data.ibm_resource_group.default_group.id
+		}
+	`, month)
+}
@@ -0,0 +1,274 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package usagereports
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// billingSnapshotPageLimit is the maximum page size of the billing reports snapshots API
+const billingSnapshotPageLimit = 30
+
+func DataSourceIBMBillingSnapshotList() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMBillingSnapshotListRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the account to list the snapshots of, for example a child account of the enterprise. The default is the account of the provider.",
+			},
+			"month": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The month of the snapshots, in the format yyyy-mm.",
+			},
+			"date_from": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "List only the snapshots created from this time, in milliseconds since the epoch.",
+			},
+			"date_to": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "List only the snapshots created until this time, in milliseconds since the epoch.",
+			},
+			"snapshots": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The billing reports snapshots of the month.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"snapshot_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the snapshot.",
+						},
+						"account_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the account of the snapshot.",
+						},
+						"month": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The month of the snapshot.",
+						},
+						"account_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the account, account or enterprise.",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The state of the snapshot, for example complete.",
+						},
+						"charges": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The charges of the month when the snapshot was taken.",
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The version of the snapshot.",
+						},
+						"billing_period_start": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The start of the billing period of the snapshot.",
+						},
+						"billing_period_end": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The end of the billing period of the snapshot.",
+						},
+						"expected_processed_at": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The time when the snapshot is expected to be processed, in milliseconds since the epoch.",
+						},
+						"created_on": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The time when the snapshot was created, in milliseconds since the epoch.",
+						},
+						"processed_at": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The time when the snapshot was processed, in milliseconds since the epoch.",
+						},
+						"report_types": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The types of the reports of the snapshot.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The type of the report, for example account_summary.",
+									},
+									"version": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The version of the report.",
+									},
+								},
+							},
+						},
+						"files": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The files of the reports of the snapshot in the bucket.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"report_types": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The type of the report of the file.",
+									},
+									"location": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The location of the file in the bucket.",
+									},
+									"account_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The ID of the account of the file.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMBillingSnapshotListRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	usageReportsClient, err := meta.(conns.ClientSession).UsageReportsV4()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountID := d.Get("account_id").(string)
+	if accountID == "" {
+		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		accountID = userDetails.UserAccount
+	}
+	month := d.Get("month").(string)
+
+	query := map[string]string{
+		"account_id": accountID,
+		"month":      month,
+		"limit":      strconv.Itoa(billingSnapshotPageLimit),
+	}
+	if v, ok := d.GetOk("date_from"); ok {
+		query["date_from"] = strconv.Itoa(v.(int))
+	}
+	if v, ok := d.GetOk("date_to"); ok {
+		query["date_to"] = strconv.Itoa(v.(int))
+	}
+
+	snapshots, err := flex.PaginateAllByToken(context, func(context context.Context, start string) ([]billingSnapshot, string, *core.DetailedResponse, error) {
+		pageQuery := map[string]string{}
+		for name, value := range query {
+			pageQuery[name] = value
+		}
+		if start != "" {
+			pageQuery["start"] = start
+		}
+		page := &billingSnapshotList{}
+		response, err := billingSnapshotRequest(context, usageReportsClient.Service, core.GET, "/v1/billing-reports-snapshots", pageQuery, nil, page)
+		if err != nil {
+			log.Printf("[DEBUG] Error listing the billing snapshots %s\n%s", err, response)
+			return nil, "", response, fmt.Errorf("[ERROR] Error listing the billing snapshots of account %s for %s: %s\n%s", accountID, month, err, response)
+		}
+		next := ""
+		if page.Next != nil && page.Next.Offset != nil {
+			next = *page.Next.Offset
+		}
+		return page.Snapshots, next, response, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	snapshotList := make([]map[string]interface{}, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		snapshotList = append(snapshotList, dataSourceIBMBillingSnapshotToMap(snapshot))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", accountID, month))
+	if err = d.Set("account_id", accountID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting account_id: %s", err))
+	}
+	if err = d.Set("snapshots", snapshotList); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting snapshots: %s", err))
+	}
+
+	return nil
+}
+
+func dataSourceIBMBillingSnapshotToMap(snapshot billingSnapshot) map[string]interface{} {
+	snapshotMap := map[string]interface{}{
+		"snapshot_id":  snapshot.SnapshotID,
+		"account_id":   snapshot.AccountID,
+		"month":        snapshot.Month,
+		"account_type": snapshot.AccountType,
+		"state":        snapshot.State,
+		"charges":      snapshot.Charges,
+		"version":      snapshot.Version,
+	}
+	if snapshot.BillingPeriod != nil {
+		snapshotMap["billing_period_start"] = snapshot.BillingPeriod.Start
+		snapshotMap["billing_period_end"] = snapshot.BillingPeriod.End
+	}
+	if snapshot.ExpectedProcessedAt != nil {
+		snapshotMap["expected_processed_at"] = int(*snapshot.ExpectedProcessedAt)
+	}
+	if snapshot.CreatedOn != nil {
+		snapshotMap["created_on"] = int(*snapshot.CreatedOn)
+	}
+	if snapshot.ProcessedAt != nil {
+		snapshotMap["processed_at"] = int(*snapshot.ProcessedAt)
+	}
+	reportTypes := make([]map[string]interface{}, 0, len(snapshot.ReportTypes))
+	for _, reportType := range snapshot.ReportTypes {
+		reportTypes = append(reportTypes, map[string]interface{}{
+			"type":    reportType.Type,
+			"version": reportType.Version,
+		})
+	}
+	snapshotMap["report_types"] = reportTypes
+	files := make([]map[string]interface{}, 0, len(snapshot.Files))
+	for _, file := range snapshot.Files {
+		files = append(files, map[string]interface{}{
+			"report_types": file.ReportTypes,
+			"location":     file.Location,
+			"account_id":   file.AccountID,
+		})
+	}
+	snapshotMap["files"] = files
+	return snapshotMap
+}
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package usagereports_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMBillingSnapshotListDataSourceBasic(t *testing.T) {
+	month := time.Now().UTC().AddDate(0, -1, 0).Format("2006-01")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMBillingSnapshotListDataSourceConfigBasic(month),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_billing_snapshot_list.billing_snapshot_list", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_billing_snapshot_list.billing_snapshot_list", "account_id"),
+					resource.TestCheckResourceAttr("data.ibm_billing_snapshot_list.billing_snapshot_list", "month", month),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMBillingSnapshotListDataSourceConfigBasic(month string) string {
+	return fmt.Sprintf(`
+		data "ibm_billing_snapshot_list" "billing_snapshot_list" {
+			month = "%s"
+		}
+	`, month)
+}
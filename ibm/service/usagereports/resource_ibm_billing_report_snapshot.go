@@ -0,0 +1,282 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package usagereports
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+const billingSnapshotConfigPath = "/v1/billing-reports-snapshot-config"
+
+// ResourceIBMBillingReportSnapshot manages the configuration of the billing reports snapshots of an account, which
+// are written to a COS bucket. An account has one configuration, so the ID of the resource is the account ID.
+func ResourceIBMBillingReportSnapshot() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMBillingReportSnapshotCreate,
+		ReadContext:   resourceIBMBillingReportSnapshotRead,
+		UpdateContext: resourceIBMBillingReportSnapshotUpdate,
+		DeleteContext: resourceIBMBillingReportSnapshotDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The ID of the account to configure the snapshots of. The default is the account of the provider.",
+			},
+			"interval": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_billing_report_snapshot", "interval"),
+				Description:  "The frequency of the snapshots, daily.",
+			},
+			"versioning": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "new",
+				ValidateFunc: validate.InvokeValidator("ibm_billing_report_snapshot", "versioning"),
+				Description:  "Whether a new version of the reports is written for each snapshot, new, or the reports are overwritten, overwrite.",
+			},
+			"report_types": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.ValidateAllowedStringValues([]string{"account_summary", "enterprise_summary", "account_resource_instance_usage"}),
+				},
+				Description: "The types of the reports, account_summary, enterprise_summary or account_resource_instance_usage. The default is all the types.",
+			},
+			"cos_bucket": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the COS bucket to write the reports to.",
+			},
+			"cos_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The location of the COS bucket, for example us-south.",
+			},
+			"cos_reports_folder": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "IBMCloud-Billing-Reports",
+				Description: "The folder of the bucket to write the reports to.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the configuration, enabled or disabled.",
+			},
+			"account_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the account, account or enterprise.",
+			},
+			"compression": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The compression of the reports, for example GZIP.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content type of the reports, for example text/csv.",
+			},
+			"cos_endpoint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The COS endpoint of the bucket.",
+			},
+			"created_at": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The time of the creation of the configuration, in milliseconds since the epoch.",
+			},
+			"last_updated_at": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The time of the last update of the configuration, in milliseconds since the epoch.",
+			},
+		},
+	}
+}
+
+func ResourceIBMBillingReportSnapshotValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "interval",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "daily",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "versioning",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "new, overwrite",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_billing_report_snapshot", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMBillingReportSnapshotCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	usageReportsClient, err := meta.(conns.ClientSession).UsageReportsV4()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountID := d.Get("account_id").(string)
+	if accountID == "" {
+		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		accountID = userDetails.UserAccount
+	}
+
+	body := billingSnapshotConfigBody(d, accountID)
+	result := &billingSnapshotConfig{}
+	response, err := billingSnapshotRequest(context, usageReportsClient.Service, core.POST, billingSnapshotConfigPath, nil, body, result)
+	if err != nil {
+		log.Printf("[DEBUG] Error creating the billing reports snapshot configuration %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating the billing reports snapshot configuration of account %s: %s\n%s", accountID, err, response))
+	}
+
+	d.SetId(accountID)
+
+	return resourceIBMBillingReportSnapshotRead(context, d, meta)
+}
+
+func resourceIBMBillingReportSnapshotRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	usageReportsClient, err := meta.(conns.ClientSession).UsageReportsV4()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	config := &billingSnapshotConfig{}
+	response, err := billingSnapshotRequest(context, usageReportsClient.Service, core.GET, billingSnapshotConfigPath, map[string]string{"account_id": d.Id()}, nil, config)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Error getting the billing reports snapshot configuration %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting the billing reports snapshot configuration of account %s: %s\n%s", d.Id(), err, response))
+	}
+
+	if err = d.Set("account_id", d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting account_id: %s", err))
+	}
+	if err = d.Set("interval", config.Interval); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting interval: %s", err))
+	}
+	if err = d.Set("versioning", config.Versioning); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting versioning: %s", err))
+	}
+	if err = d.Set("report_types", config.ReportTypes); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting report_types: %s", err))
+	}
+	if err = d.Set("cos_bucket", config.CosBucket); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting cos_bucket: %s", err))
+	}
+	if err = d.Set("cos_location", config.CosLocation); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting cos_location: %s", err))
+	}
+	if err = d.Set("cos_reports_folder", config.CosReportsFolder); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting cos_reports_folder: %s", err))
+	}
+	if err = d.Set("state", config.State); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting state: %s", err))
+	}
+	if err = d.Set("account_type", config.AccountType); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting account_type: %s", err))
+	}
+	if err = d.Set("compression", config.Compression); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting compression: %s", err))
+	}
+	if err = d.Set("content_type", config.ContentType); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting content_type: %s", err))
+	}
+	if err = d.Set("cos_endpoint", config.CosEndpoint); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting cos_endpoint: %s", err))
+	}
+	if err = d.Set("created_at", flex.IntValue(config.CreatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting created_at: %s", err))
+	}
+	if err = d.Set("last_updated_at", flex.IntValue(config.LastUpdatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting last_updated_at: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMBillingReportSnapshotUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	usageReportsClient, err := meta.(conns.ClientSession).UsageReportsV4()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("interval", "versioning", "report_types", "cos_bucket", "cos_location", "cos_reports_folder") {
+		body := billingSnapshotConfigBody(d, d.Id())
+		result := &billingSnapshotConfig{}
+		response, err := billingSnapshotRequest(context, usageReportsClient.Service, core.PATCH, billingSnapshotConfigPath, nil, body, result)
+		if err != nil {
+			log.Printf("[DEBUG] Error updating the billing reports snapshot configuration %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating the billing reports snapshot configuration of account %s: %s\n%s", d.Id(), err, response))
+		}
+	}
+
+	return resourceIBMBillingReportSnapshotRead(context, d, meta)
+}
+
+func resourceIBMBillingReportSnapshotDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	usageReportsClient, err := meta.(conns.ClientSession).UsageReportsV4()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The snapshots that were written to the bucket are kept
+	response, err := billingSnapshotRequest(context, usageReportsClient.Service, core.DELETE, billingSnapshotConfigPath, map[string]string{"account_id": d.Id()}, nil, nil)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] Error deleting the billing reports snapshot configuration %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting the billing reports snapshot configuration of account %s: %s\n%s", d.Id(), err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func billingSnapshotConfigBody(d *schema.ResourceData, accountID string) *billingSnapshotConfig {
+	body := &billingSnapshotConfig{
+		AccountID:        core.StringPtr(accountID),
+		Interval:         core.StringPtr(d.Get("interval").(string)),
+		Versioning:       core.StringPtr(d.Get("versioning").(string)),
+		CosBucket:        core.StringPtr(d.Get("cos_bucket").(string)),
+		CosLocation:      core.StringPtr(d.Get("cos_location").(string)),
+		CosReportsFolder: core.StringPtr(d.Get("cos_reports_folder").(string)),
+	}
+	if v, ok := d.GetOk("report_types"); ok {
+		body.ReportTypes = flex.ExpandStringList(v.([]interface{}))
+	}
+	return body
+}
@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package usagereports_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMBillingReportSnapshotBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMBillingReportSnapshotConfig("new"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_billing_report_snapshot.snapshot", "account_id"),
+					resource.TestCheckResourceAttr("ibm_billing_report_snapshot.snapshot", "interval", "daily"),
+					resource.TestCheckResourceAttr("ibm_billing_report_snapshot.snapshot", "versioning", "new"),
+					resource.TestCheckResourceAttr("ibm_billing_report_snapshot.snapshot", "report_types.#", "1"),
+					resource.TestCheckResourceAttr("ibm_billing_report_snapshot.snapshot", "state", "enabled"),
+				),
+			},
+			{
+				Config: testAccCheckIBMBillingReportSnapshotConfig("overwrite"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_billing_report_snapshot.snapshot", "versioning", "overwrite"),
+				),
+			},
+			{
+				ResourceName:      "ibm_billing_report_snapshot.snapshot",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMBillingReportSnapshotConfig(versioning string) string {
+	return fmt.Sprintf(`
+		resource "ibm_billing_report_snapshot" "snapshot" {
+			interval           = "daily"
+			versioning         = "%s"
+			report_types       = ["account_summary"]
+			cos_bucket         = "%s"
+			cos_location       = "us-south"
+			cos_reports_folder = "terraform-acc-test"
+		}
+	`, versioning, acc.IsCosBucketName)
+}
@@ -0,0 +1,446 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package usagereports
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam"
+	token "github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
+	"github.com/IBM/ibm-cos-sdk-go/aws/session"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/platform-services-go-sdk/usagereportsv4"
+)
+
+const (
+	usageExportMonthFormat = "2006-01"
+	// usageExportPageLimit is the maximum page size of the resource instance usage API
+	usageExportPageLimit = 200
+)
+
+// usageExportColumns are the columns of the exported CSV files, one row per metric of a resource instance
+var usageExportColumns = []string{
+	"month", "account_id", "resource_group_id", "resource_group_name", "resource_id", "resource_name",
+	"resource_instance_id", "resource_instance_name", "plan_id", "plan_name", "region", "pricing_region",
+	"pricing_country", "currency_code", "billable", "pending", "metric", "metric_name", "unit", "unit_name",
+	"quantity", "rateable_quantity", "cost", "rated_cost", "non_chargeable",
+}
+
+func ResourceIBMBillingResourceUsageExport() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMBillingResourceUsageExportCreate,
+		ReadContext:   resourceIBMBillingResourceUsageExportRead,
+		UpdateContext: resourceIBMBillingResourceUsageExportUpdate,
+		DeleteContext: resourceIBMBillingResourceUsageExportDelete,
+		CustomizeDiff: resourceIBMBillingResourceUsageExportCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The ID of the account to export the usage of. The default is the account of the provider.",
+			},
+			"start_month": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_billing_resource_usage_export", "start_month"),
+				Description:  "The first month to export, in the format yyyy-mm.",
+			},
+			"end_month": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_billing_resource_usage_export", "end_month"),
+				Description:  "The last month to export, in the format yyyy-mm. The default is the start month.",
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Export only the usage of the resource instances of this resource group.",
+			},
+			"resource_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Export only the usage of the resource instances of this resource, for example the ID of a service in the catalog.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Export only the usage of the resource instances of this region.",
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "csv",
+				ValidateFunc: validate.InvokeValidator("ibm_billing_resource_usage_export", "format"),
+				Description:  "The format of the exported files. Only csv is supported.",
+			},
+			"cos_instance_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The CRN of the COS instance of the bucket.",
+			},
+			"bucket": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the COS bucket to write the files to.",
+			},
+			"endpoint": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The COS endpoint of the bucket.",
+			},
+			"key_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "billing-usage/",
+				Description: "The prefix of the keys of the exported files. A file is written for each month as <key_prefix><account_id>/<month>.<format>.",
+			},
+			"exported_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the last export.",
+			},
+			"exports": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The files written by the last export.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"month": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The month of the usage in the file.",
+						},
+						"key": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The COS key of the file.",
+						},
+						"resource_instances": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of resource instances in the file.",
+						},
+						"rows": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of rows in the file, without the header.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceIBMBillingResourceUsageExportValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "start_month",
+			ValidateFunctionIdentifier: validate.ValidateRegexp,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			Regexp:                     `^\d{4}-(0[1-9]|1[0-2])$`,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "end_month",
+			ValidateFunctionIdentifier: validate.ValidateRegexp,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^\d{4}-(0[1-9]|1[0-2])$`,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "format",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "csv",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_billing_resource_usage_export", Schema: validateSchema}
+	return &resourceValidator
+}
+
+// resourceIBMBillingResourceUsageExportCustomizeDiff plans a new export on each apply, as the usage
+// of the current month keeps changing
+func resourceIBMBillingResourceUsageExportCustomizeDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	if err := diff.SetNewComputed("exported_at"); err != nil {
+		return err
+	}
+	return diff.SetNewComputed("exports")
+}
+
+func resourceIBMBillingResourceUsageExportCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	accountID := d.Get("account_id").(string)
+	if accountID == "" {
+		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		accountID = userDetails.UserAccount
+		d.Set("account_id", accountID)
+	}
+
+	if err := exportBillingResourceUsage(context, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s", accountID, d.Get("bucket").(string), d.Get("key_prefix").(string)))
+
+	return resourceIBMBillingResourceUsageExportRead(context, d, meta)
+}
+
+func resourceIBMBillingResourceUsageExportRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The exported files are not tracked after the export, so the state is kept as it is
+	return nil
+}
+
+func resourceIBMBillingResourceUsageExportUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := exportBillingResourceUsage(context, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceIBMBillingResourceUsageExportRead(context, d, meta)
+}
+
+func resourceIBMBillingResourceUsageExportDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The exported files are kept in the bucket
+	d.SetId("")
+	return nil
+}
+
+func exportBillingResourceUsage(context context.Context, d *schema.ResourceData, meta interface{}) error {
+	usageReportsClient, err := meta.(conns.ClientSession).UsageReportsV4()
+	if err != nil {
+		return err
+	}
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	months, err := billingUsageExportMonths(d.Get("start_month").(string), d.Get("end_month").(string))
+	if err != nil {
+		return err
+	}
+
+	s3Client, err := billingUsageExportCOSClient(bxSession, d.Get("endpoint").(string), d.Get("cos_instance_crn").(string))
+	if err != nil {
+		return err
+	}
+
+	accountID := d.Get("account_id").(string)
+	bucket := d.Get("bucket").(string)
+	format := d.Get("format").(string)
+	exports := make([]map[string]interface{}, 0, len(months))
+	for _, month := range months {
+		instances, err := listBillingResourceUsage(context, usageReportsClient, d, accountID, month)
+		if err != nil {
+			return err
+		}
+
+		body, rows, err := billingUsageExportCSV(instances)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error writing the resource usage of %s as %s: %s", month, format, err)
+		}
+
+		key := fmt.Sprintf("%s%s/%s.%s", d.Get("key_prefix").(string), accountID, month, format)
+		putInput := &s3.PutObjectInput{
+			Bucket:      aws.String(bucket),
+			Key:         aws.String(key),
+			Body:        bytes.NewReader(body),
+			ContentType: aws.String("text/csv"),
+		}
+		if _, err := s3Client.PutObject(putInput); err != nil {
+			return fmt.Errorf("[ERROR] Error exporting the resource usage of %s to bucket %s: %s", month, bucket, err)
+		}
+		log.Printf("[INFO] Exported the usage of %d resource instances of %s to %s/%s", len(instances), month, bucket, key)
+
+		exports = append(exports, map[string]interface{}{
+			"month":              month,
+			"key":                key,
+			"resource_instances": len(instances),
+			"rows":               rows,
+		})
+	}
+
+	if err = d.Set("exports", exports); err != nil {
+		return fmt.Errorf("[ERROR] Error setting exports: %s", err)
+	}
+	if err = d.Set("exported_at", time.Now().UTC().Format(time.RFC3339)); err != nil {
+		return fmt.Errorf("[ERROR] Error setting exported_at: %s", err)
+	}
+	return nil
+}
+
+// billingUsageExportMonths returns the months from start to end, both included
+func billingUsageExportMonths(start, end string) ([]string, error) {
+	if end == "" {
+		end = start
+	}
+	startMonth, err := time.Parse(usageExportMonthFormat, start)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Invalid start_month %s: %s", start, err)
+	}
+	endMonth, err := time.Parse(usageExportMonthFormat, end)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Invalid end_month %s: %s", end, err)
+	}
+	if endMonth.Before(startMonth) {
+		return nil, fmt.Errorf("[ERROR] end_month %s is before start_month %s", end, start)
+	}
+
+	months := []string{}
+	for month := startMonth; !month.After(endMonth); month = month.AddDate(0, 1, 0) {
+		months = append(months, month.Format(usageExportMonthFormat))
+	}
+	return months, nil
+}
+
+func listBillingResourceUsage(context context.Context, usageReportsClient *usagereportsv4.UsageReportsV4, d *schema.ResourceData, accountID, month string) ([]usagereportsv4.InstanceUsage, error) {
+	start := ""
+	allRecs := []usagereportsv4.InstanceUsage{}
+	for {
+		getResourceUsageAccountOptions := usageReportsClient.NewGetResourceUsageAccountOptions(accountID, month)
+		getResourceUsageAccountOptions.SetNames(true)
+		getResourceUsageAccountOptions.SetLimit(usageExportPageLimit)
+		if start != "" {
+			getResourceUsageAccountOptions.SetStart(start)
+		}
+		if v, ok := d.GetOk("resource_group_id"); ok {
+			getResourceUsageAccountOptions.SetResourceGroupID(v.(string))
+		}
+		if v, ok := d.GetOk("resource_id"); ok {
+			getResourceUsageAccountOptions.SetResourceID(v.(string))
+		}
+		if v, ok := d.GetOk("region"); ok {
+			getResourceUsageAccountOptions.SetRegion(v.(string))
+		}
+
+		instancesUsage, response, err := usageReportsClient.GetResourceUsageAccountWithContext(context, getResourceUsageAccountOptions)
+		if err != nil {
+			log.Printf("[DEBUG] GetResourceUsageAccountWithContext failed %s\n%s", err, response)
+			return nil, fmt.Errorf("[ERROR] Error getting the resource usage of account %s for %s: %s", accountID, month, err)
+		}
+		allRecs = append(allRecs, instancesUsage.Resources...)
+		if instancesUsage.Next == nil || instancesUsage.Next.Offset == nil || *instancesUsage.Next.Offset == "" {
+			break
+		}
+		start = *instancesUsage.Next.Offset
+	}
+	return allRecs, nil
+}
+
+// billingUsageExportCSV writes a row for each metric of each resource instance, and returns the number of rows
+func billingUsageExportCSV(instances []usagereportsv4.InstanceUsage) ([]byte, int, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(usageExportColumns); err != nil {
+		return nil, 0, err
+	}
+
+	rows := 0
+	for _, instance := range instances {
+		for _, metric := range instance.Usage {
+			row := []string{
+				core.StringNilMapper(instance.Month),
+				core.StringNilMapper(instance.AccountID),
+				core.StringNilMapper(instance.ResourceGroupID),
+				core.StringNilMapper(instance.ResourceGroupName),
+				core.StringNilMapper(instance.ResourceID),
+				core.StringNilMapper(instance.ResourceName),
+				core.StringNilMapper(instance.ResourceInstanceID),
+				core.StringNilMapper(instance.ResourceInstanceName),
+				core.StringNilMapper(instance.PlanID),
+				core.StringNilMapper(instance.PlanName),
+				core.StringNilMapper(instance.Region),
+				core.StringNilMapper(instance.PricingRegion),
+				core.StringNilMapper(instance.PricingCountry),
+				core.StringNilMapper(instance.CurrencyCode),
+				billingUsageExportBool(instance.Billable),
+				billingUsageExportBool(instance.Pending),
+				core.StringNilMapper(metric.Metric),
+				core.StringNilMapper(metric.MetricName),
+				core.StringNilMapper(metric.Unit),
+				core.StringNilMapper(metric.UnitName),
+				billingUsageExportFloat(metric.Quantity),
+				billingUsageExportFloat(metric.RateableQuantity),
+				billingUsageExportFloat(metric.Cost),
+				billingUsageExportFloat(metric.RatedCost),
+				billingUsageExportBool(metric.NonChargeable),
+			}
+			if err := w.Write(row); err != nil {
+				return nil, 0, err
+			}
+			rows++
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, 0, err
+	}
+	return buf.Bytes(), rows, nil
+}
+
+func billingUsageExportFloat(v *float64) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*v, 'f', -1, 64)
+}
+
+func billingUsageExportBool(v *bool) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatBool(*v)
+}
+
+func billingUsageExportCOSClient(bxSession *bxsession.Session, endpoint string, instanceCRN string) (*s3.S3, error) {
+	var s3Conf *aws.Config
+
+	authEndpoint, err := bxSession.Config.EndpointLocator.IAMEndpoint()
+	if err != nil {
+		return nil, err
+	}
+	authEndpointPath := fmt.Sprintf("%s%s", authEndpoint, "/identity/token")
+	apiKey := bxSession.Config.BluemixAPIKey
+	if apiKey != "" {
+		s3Conf = aws.NewConfig().WithEndpoint(endpoint).WithCredentials(ibmiam.NewStaticCredentials(aws.NewConfig(), authEndpointPath, apiKey, instanceCRN)).WithS3ForcePathStyle(true)
+	}
+	iamAccessToken := bxSession.Config.IAMAccessToken
+	if iamAccessToken != "" {
+		initFunc := func() (*token.Token, error) {
+			return &token.Token{
+				AccessToken:  bxSession.Config.IAMAccessToken,
+				RefreshToken: bxSession.Config.IAMRefreshToken,
+				TokenType:    "Bearer",
+				ExpiresIn:    int64((time.Hour * 248).Seconds()) * -1,
+				Expiration:   time.Now().Add(-1 * time.Hour).Unix(),
+			}, nil
+		}
+		s3Conf = aws.NewConfig().WithEndpoint(endpoint).WithCredentials(ibmiam.NewCustomInitFuncCredentials(aws.NewConfig(), initFunc, authEndpointPath, instanceCRN)).WithS3ForcePathStyle(true)
+	}
+	s3Sess := session.Must(session.NewSession())
+	return s3.New(s3Sess, s3Conf), nil
+}
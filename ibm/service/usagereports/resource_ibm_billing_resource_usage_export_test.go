@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package usagereports_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMBillingResourceUsageExportBasic(t *testing.T) {
+	lastMonth := time.Now().UTC().AddDate(0, -1, 0).Format("2006-01")
+	thisMonth := time.Now().UTC().Format("2006-01")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:             testAccCheckIBMBillingResourceUsageExportConfig(lastMonth, thisMonth),
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_billing_resource_usage_export.export", "account_id"),
+					resource.TestCheckResourceAttrSet("ibm_billing_resource_usage_export.export", "exported_at"),
+					resource.TestCheckResourceAttr("ibm_billing_resource_usage_export.export", "exports.#", "2"),
+					resource.TestCheckResourceAttr("ibm_billing_resource_usage_export.export", "exports.0.month", lastMonth),
+					resource.TestCheckResourceAttr("ibm_billing_resource_usage_export.export", "exports.1.month", thisMonth),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMBillingResourceUsageExportConfig(startMonth, endMonth string) string {
+	return fmt.Sprintf(`
+		resource "ibm_billing_resource_usage_export" "export" {
+			start_month      = "%s"
+			end_month        = "%s"
+			cos_instance_crn = "%s"
+			bucket           = "%s"
+			endpoint         = "s3.us-south.cloud-object-storage.appdomain.cloud"
+			key_prefix       = "terraform-acc-test/"
+		}
+	`, startMonth, endMonth, acc.CosCRN, acc.IsCosBucketName)
+}
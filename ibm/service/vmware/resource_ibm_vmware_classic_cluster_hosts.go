@@ -0,0 +1,218 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vmware
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func ResourceIbmVMwareClassicClusterHosts() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmVMwareClassicClusterHostsCreate,
+		ReadContext:   resourceIbmVMwareClassicClusterHostsRead,
+		UpdateContext: resourceIbmVMwareClassicClusterHostsUpdate,
+		DeleteContext: resourceIbmVMwareClassicClusterHostsDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(6 * time.Hour),
+			Update: schema.DefaultTimeout(6 * time.Hour),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vcenter_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the vCenter Server instance.",
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the cluster of the vCenter Server instance.",
+			},
+			"host_count": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validate.ValidateAllowedRangeInt(2, 59),
+				Description:  "The number of ESXi hosts of the cluster. Hosts are ordered or removed to match it.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the cluster.",
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The data center of the cluster.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the cluster.",
+			},
+			"hosts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The ESXi hosts of the cluster.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the host.",
+						},
+						"hostname": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The hostname of the host.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the host.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIbmVMwareClassicClusterHostsCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vmwareSolutionsClient, err := meta.(conns.ClientSession).VMwareSolutionsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", d.Get("vcenter_id").(string), d.Get("cluster_id").(string)))
+
+	if err = resourceIbmVMwareClassicClusterHostsScale(context, d, vmwareSolutionsClient, d.Timeout(schema.TimeoutCreate)); err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+
+	return resourceIbmVMwareClassicClusterHostsRead(context, d, meta)
+}
+
+func resourceIbmVMwareClassicClusterHostsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := vmwareClassicParseID(d.Id(), 2, "vcenterID/clusterID")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vmwareSolutionsClient, err := meta.(conns.ClientSession).VMwareSolutionsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cluster, response, err := getVMwareClassicCluster(context, vmwareSolutionsClient, parts[0], parts[1])
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Error getting VMware classic cluster %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error getting VMware classic cluster %s\n%s", err, response))
+	}
+
+	if err = d.Set("vcenter_id", parts[0]); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting vcenter_id: %s", err))
+	}
+	if err = d.Set("cluster_id", parts[1]); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting cluster_id: %s", err))
+	}
+	if err = d.Set("host_count", len(cluster.Hosts)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting host_count: %s", err))
+	}
+	if err = d.Set("name", cluster.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	if err = d.Set("location", cluster.Location); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting location: %s", err))
+	}
+	if err = d.Set("status", cluster.Status); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting status: %s", err))
+	}
+	hosts := make([]map[string]interface{}, 0, len(cluster.Hosts))
+	for _, host := range cluster.Hosts {
+		hosts = append(hosts, map[string]interface{}{
+			"id":       host.ID,
+			"hostname": host.Hostname,
+			"status":   host.Status,
+		})
+	}
+	if err = d.Set("hosts", hosts); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting hosts: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIbmVMwareClassicClusterHostsUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("host_count") {
+		vmwareSolutionsClient, err := meta.(conns.ClientSession).VMwareSolutionsV1()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err = resourceIbmVMwareClassicClusterHostsScale(context, d, vmwareSolutionsClient, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIbmVMwareClassicClusterHostsRead(context, d, meta)
+}
+
+// resourceIbmVMwareClassicClusterHostsDelete removes the resource from the state only, the hosts of the cluster are
+// kept: a cluster can't be left without hosts, and the hosts are removed with the cluster.
+func resourceIbmVMwareClassicClusterHostsDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+
+	return nil
+}
+
+// resourceIbmVMwareClassicClusterHostsScale orders or removes hosts of the cluster to match host_count. The most
+// recently added hosts, which are the last hosts of the cluster, are removed first.
+func resourceIbmVMwareClassicClusterHostsScale(context context.Context, d *schema.ResourceData, service *core.BaseService, timeout time.Duration) error {
+	vcenterID := d.Get("vcenter_id").(string)
+	clusterID := d.Get("cluster_id").(string)
+	hostCount := d.Get("host_count").(int)
+
+	cluster, response, err := getVMwareClassicCluster(context, service, vcenterID, clusterID)
+	if err != nil {
+		log.Printf("[DEBUG] Error getting VMware classic cluster %s\n%s", err, response)
+		return fmt.Errorf("Error getting VMware classic cluster %s\n%s", err, response)
+	}
+
+	patch := &vmwareClassicClusterPatch{Hosts: &vmwareClassicHostsPatch{}}
+	switch current := len(cluster.Hosts); {
+	case hostCount > current:
+		patch.Hosts.Add = &vmwareClassicHostsAdd{Quantity: core.Int64Ptr(int64(hostCount - current))}
+	case hostCount < current:
+		for _, host := range cluster.Hosts[hostCount:] {
+			patch.Hosts.Remove = append(patch.Hosts.Remove, *host.Hostname)
+		}
+	default:
+		return nil
+	}
+
+	if _, err = patchVMwareClassicCluster(context, service, vcenterID, clusterID, patch, timeout); err != nil {
+		log.Printf("[DEBUG] Error scaling VMware classic cluster %s to %d hosts %s", clusterID, hostCount, err)
+		return fmt.Errorf("Error scaling VMware classic cluster %s to %d hosts %s", clusterID, hostCount, err)
+	}
+	return nil
+}
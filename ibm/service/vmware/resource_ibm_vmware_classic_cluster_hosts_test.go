@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vmware_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmVMwareClassicClusterHostsBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckVMwareClassic(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmVMwareClassicClusterHostsConfig(3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_vmware_classic_cluster_hosts.cluster_hosts", "host_count", "3"),
+					resource.TestCheckResourceAttr("ibm_vmware_classic_cluster_hosts.cluster_hosts", "hosts.#", "3"),
+					resource.TestCheckResourceAttr("ibm_vmware_classic_cluster_hosts.cluster_hosts", "status", "ReadyToUse"),
+				),
+			},
+			{
+				Config: testAccCheckIbmVMwareClassicClusterHostsConfig(2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_vmware_classic_cluster_hosts.cluster_hosts", "host_count", "2"),
+					resource.TestCheckResourceAttr("ibm_vmware_classic_cluster_hosts.cluster_hosts", "hosts.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "ibm_vmware_classic_cluster_hosts.cluster_hosts",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIbmVMwareClassicClusterHostsConfig(hostCount int) string {
+	return fmt.Sprintf(`
+		resource "ibm_vmware_classic_cluster_hosts" "cluster_hosts" {
+			vcenter_id = "%s"
+			cluster_id = "%s"
+			host_count = %d
+		}
+	`, acc.VMwareClassicVcenterID, acc.VMwareClassicClusterID, hostCount)
+}
@@ -0,0 +1,194 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vmware
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func ResourceIbmVMwareClassicClusterNfsDatastore() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmVMwareClassicClusterNfsDatastoreCreate,
+		ReadContext:   resourceIbmVMwareClassicClusterNfsDatastoreRead,
+		DeleteContext: resourceIbmVMwareClassicClusterNfsDatastoreDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Hour),
+			Delete: schema.DefaultTimeout(2 * time.Hour),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vcenter_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the vCenter Server instance.",
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the cluster of the vCenter Server instance.",
+			},
+			"size": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedIntValues([]int{20, 40, 80, 100, 250, 500, 750, 1000, 2000, 4000, 8000, 12000}),
+				Description:  "The size of the NFS datastore, in GB.",
+			},
+			"performance": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"0.25IOPS", "2IOPS", "4IOPS", "10IOPS"}),
+				Description:  "The performance of the NFS datastore, in IOPS per GB.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the NFS datastore.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the NFS datastore.",
+			},
+		},
+	}
+}
+
+func resourceIbmVMwareClassicClusterNfsDatastoreCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vmwareSolutionsClient, err := meta.(conns.ClientSession).VMwareSolutionsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vcenterID := d.Get("vcenter_id").(string)
+	clusterID := d.Get("cluster_id").(string)
+
+	cluster, response, err := getVMwareClassicCluster(context, vmwareSolutionsClient, vcenterID, clusterID)
+	if err != nil {
+		log.Printf("[DEBUG] Error getting VMware classic cluster %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error getting VMware classic cluster %s\n%s", err, response))
+	}
+	existing := map[string]bool{}
+	for _, datastore := range cluster.FileShares {
+		existing[*datastore.Name] = true
+	}
+
+	patch := &vmwareClassicClusterPatch{
+		FileShares: &vmwareClassicFileSharesPatch{
+			Add: []vmwareClassicNfsDatastore{{
+				Size:        core.Int64Ptr(int64(d.Get("size").(int))),
+				Performance: core.StringPtr(d.Get("performance").(string)),
+			}},
+		},
+	}
+	cluster, err = patchVMwareClassicCluster(context, vmwareSolutionsClient, vcenterID, clusterID, patch, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		log.Printf("[DEBUG] Error adding NFS datastore to VMware classic cluster %s", err)
+		return diag.FromErr(fmt.Errorf("Error adding NFS datastore to VMware classic cluster %s", err))
+	}
+
+	// The API doesn't return the name of the datastore, it is the datastore of the cluster that didn't exist before
+	for _, datastore := range cluster.FileShares {
+		if !existing[*datastore.Name] {
+			d.SetId(fmt.Sprintf("%s/%s/%s", vcenterID, clusterID, *datastore.Name))
+			return resourceIbmVMwareClassicClusterNfsDatastoreRead(context, d, meta)
+		}
+	}
+
+	return diag.FromErr(fmt.Errorf("Error adding NFS datastore to VMware classic cluster %s: the datastore is not found in the cluster", clusterID))
+}
+
+func resourceIbmVMwareClassicClusterNfsDatastoreRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := vmwareClassicParseID(d.Id(), 3, "vcenterID/clusterID/datastoreName")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vmwareSolutionsClient, err := meta.(conns.ClientSession).VMwareSolutionsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cluster, response, err := getVMwareClassicCluster(context, vmwareSolutionsClient, parts[0], parts[1])
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Error getting VMware classic cluster %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error getting VMware classic cluster %s\n%s", err, response))
+	}
+
+	var datastore *vmwareClassicNfsDatastore
+	for i := range cluster.FileShares {
+		if *cluster.FileShares[i].Name == parts[2] {
+			datastore = &cluster.FileShares[i]
+			break
+		}
+	}
+	if datastore == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("vcenter_id", parts[0]); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting vcenter_id: %s", err))
+	}
+	if err = d.Set("cluster_id", parts[1]); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting cluster_id: %s", err))
+	}
+	if err = d.Set("name", datastore.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	if datastore.Size != nil {
+		if err = d.Set("size", *datastore.Size); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting size: %s", err))
+		}
+	}
+	if err = d.Set("performance", datastore.Performance); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting performance: %s", err))
+	}
+	if err = d.Set("status", datastore.Status); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting status: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIbmVMwareClassicClusterNfsDatastoreDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vmwareSolutionsClient, err := meta.(conns.ClientSession).VMwareSolutionsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	patch := &vmwareClassicClusterPatch{
+		FileShares: &vmwareClassicFileSharesPatch{
+			Remove: []string{d.Get("name").(string)},
+		},
+	}
+	_, err = patchVMwareClassicCluster(context, vmwareSolutionsClient, d.Get("vcenter_id").(string), d.Get("cluster_id").(string), patch, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		log.Printf("[DEBUG] Error removing NFS datastore from VMware classic cluster %s", err)
+		return diag.FromErr(fmt.Errorf("Error removing NFS datastore from VMware classic cluster %s", err))
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vmware_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmVMwareClassicClusterNfsDatastoreBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckVMwareClassic(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmVMwareClassicClusterNfsDatastoreConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_vmware_classic_cluster_nfs_datastore.datastore", "size", "2000"),
+					resource.TestCheckResourceAttr("ibm_vmware_classic_cluster_nfs_datastore.datastore", "performance", "4IOPS"),
+					resource.TestCheckResourceAttrSet("ibm_vmware_classic_cluster_nfs_datastore.datastore", "name"),
+				),
+			},
+			{
+				ResourceName:      "ibm_vmware_classic_cluster_nfs_datastore.datastore",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIbmVMwareClassicClusterNfsDatastoreConfig() string {
+	return fmt.Sprintf(`
+		resource "ibm_vmware_classic_cluster_nfs_datastore" "datastore" {
+			vcenter_id  = "%s"
+			cluster_id  = "%s"
+			size        = 2000
+			performance = "4IOPS"
+		}
+	`, acc.VMwareClassicVcenterID, acc.VMwareClassicClusterID)
+}
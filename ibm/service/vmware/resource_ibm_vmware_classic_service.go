@@ -0,0 +1,202 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vmware
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func ResourceIbmVMwareClassicService() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmVMwareClassicServiceCreate,
+		ReadContext:   resourceIbmVMwareClassicServiceRead,
+		DeleteContext: resourceIbmVMwareClassicServiceDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(6 * time.Hour),
+			Delete: schema.DefaultTimeout(2 * time.Hour),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vcenter_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the vCenter Server instance.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"HCX", "Veeam", "Zerto", "Caveonix", "KMIP", "vSRX"}),
+				Description:  "The name of the service, for example HCX or Veeam.",
+			},
+			"configuration": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The options of the service, they depend on the service.",
+			},
+			"service_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the service.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the service.",
+			},
+		},
+	}
+}
+
+func resourceIbmVMwareClassicServiceCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vmwareSolutionsClient, err := meta.(conns.ClientSession).VMwareSolutionsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vcenterID := d.Get("vcenter_id").(string)
+	body := &vmwareClassicService{
+		Name: core.StringPtr(d.Get("name").(string)),
+	}
+	if v, ok := d.GetOk("configuration"); ok {
+		body.Configuration = map[string]string{}
+		for key, value := range v.(map[string]interface{}) {
+			body.Configuration[key] = value.(string)
+		}
+	}
+
+	service := &vmwareClassicService{}
+	response, err := vmwareClassicRequest(context, vmwareSolutionsClient, core.POST, "/vcenters/{vcenter_id}/services", map[string]string{"vcenter_id": vcenterID}, body, service)
+	if err != nil {
+		log.Printf("[DEBUG] Error adding VMware classic service %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error adding VMware classic service %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", vcenterID, *service.ID))
+
+	_, err = waitForVMwareClassicService(context, vmwareSolutionsClient, vcenterID, *service.ID,
+		[]string{vmwareClassicServiceStatusInstalling}, []string{vmwareClassicServiceStatusInstalled}, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error waiting for VMware classic service %s to be installed: %s", *service.ID, err))
+	}
+
+	return resourceIbmVMwareClassicServiceRead(context, d, meta)
+}
+
+func resourceIbmVMwareClassicServiceRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := vmwareClassicParseID(d.Id(), 2, "vcenterID/serviceID")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vmwareSolutionsClient, err := meta.(conns.ClientSession).VMwareSolutionsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	service, response, err := getVMwareClassicService(context, vmwareSolutionsClient, parts[0], parts[1])
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Error getting VMware classic service %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error getting VMware classic service %s\n%s", err, response))
+	}
+	if service.Status != nil && *service.Status == vmwareClassicServiceStatusRemoved {
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("vcenter_id", parts[0]); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting vcenter_id: %s", err))
+	}
+	if err = d.Set("service_id", parts[1]); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting service_id: %s", err))
+	}
+	if err = d.Set("name", service.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	if err = d.Set("status", service.Status); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting status: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIbmVMwareClassicServiceDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vmwareSolutionsClient, err := meta.(conns.ClientSession).VMwareSolutionsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vcenterID := d.Get("vcenter_id").(string)
+	serviceID := d.Get("service_id").(string)
+	pathParamsMap := map[string]string{"vcenter_id": vcenterID, "service_id": serviceID}
+	response, err := vmwareClassicRequest(context, vmwareSolutionsClient, core.DELETE, "/vcenters/{vcenter_id}/services/{service_id}", pathParamsMap, nil, nil)
+	if err != nil {
+		log.Printf("[DEBUG] Error removing VMware classic service %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error removing VMware classic service %s\n%s", err, response))
+	}
+
+	_, err = waitForVMwareClassicService(context, vmwareSolutionsClient, vcenterID, serviceID,
+		[]string{vmwareClassicServiceStatusInstalled, vmwareClassicServiceStatusRemoving}, []string{vmwareClassicServiceStatusRemoved}, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error waiting for VMware classic service %s to be removed: %s", serviceID, err))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func getVMwareClassicService(context context.Context, service *core.BaseService, vcenterID, serviceID string) (*vmwareClassicService, *core.DetailedResponse, error) {
+	result := &vmwareClassicService{}
+	pathParamsMap := map[string]string{"vcenter_id": vcenterID, "service_id": serviceID}
+	response, err := vmwareClassicRequest(context, service, core.GET, "/vcenters/{vcenter_id}/services/{service_id}", pathParamsMap, nil, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// waitForVMwareClassicService waits for the status of the service to be one of target. A service that is not found
+// is removed.
+func waitForVMwareClassicService(context context.Context, service *core.BaseService, vcenterID, serviceID string, pending, target []string, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  target,
+		Refresh: func() (interface{}, string, error) {
+			result, response, err := getVMwareClassicService(context, service, vcenterID, serviceID)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return response, vmwareClassicServiceStatusRemoved, nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Error getting service %s of vCenter Server instance %s: %s\n%s", serviceID, vcenterID, err, response)
+			}
+			return result, *result.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vmware_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmVMwareClassicServiceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckVMwareClassic(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmVMwareClassicServiceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_vmware_classic_service.service", "name", "Veeam"),
+					resource.TestCheckResourceAttr("ibm_vmware_classic_service.service", "status", "Installed"),
+					resource.TestCheckResourceAttrSet("ibm_vmware_classic_service.service", "service_id"),
+				),
+			},
+			{
+				ResourceName:            "ibm_vmware_classic_service.service",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"configuration"},
+			},
+		},
+	})
+}
+
+func testAccCheckIbmVMwareClassicServiceConfig() string {
+	return fmt.Sprintf(`
+		resource "ibm_vmware_classic_service" "service" {
+			vcenter_id = "%s"
+			name       = "Veeam"
+			configuration = {
+				storage_size = "2000"
+			}
+		}
+	`, acc.VMwareClassicVcenterID)
+}
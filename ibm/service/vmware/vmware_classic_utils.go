@@ -0,0 +1,166 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vmware
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+const (
+	vmwareClassicStatusReadyToUse = "ReadyToUse"
+	vmwareClassicStatusModifying  = "Modifying"
+	vmwareClassicStatusUpdating   = "Updating"
+
+	vmwareClassicServiceStatusInstalled  = "Installed"
+	vmwareClassicServiceStatusInstalling = "Installing"
+	vmwareClassicServiceStatusRemoving   = "Removing"
+	vmwareClassicServiceStatusRemoved    = "Removed"
+)
+
+// vmwareClassicCluster is a cluster of a classic vCenter Server instance of the VMware Solutions API
+type vmwareClassicCluster struct {
+	ID         *string                     `json:"id"`
+	Name       *string                     `json:"name"`
+	Status     *string                     `json:"status"`
+	Location   *string                     `json:"location"`
+	Hosts      []vmwareClassicHost         `json:"hosts"`
+	FileShares []vmwareClassicNfsDatastore `json:"file_shares"`
+}
+
+type vmwareClassicHost struct {
+	ID       *string `json:"id"`
+	Hostname *string `json:"hostname"`
+	Status   *string `json:"status"`
+}
+
+type vmwareClassicNfsDatastore struct {
+	Name        *string `json:"name,omitempty"`
+	Size        *int64  `json:"size"`
+	Performance *string `json:"performance"`
+	Status      *string `json:"status,omitempty"`
+}
+
+// vmwareClassicClusterPatch adds or removes the hosts and the NFS datastores of a cluster
+type vmwareClassicClusterPatch struct {
+	Hosts      *vmwareClassicHostsPatch      `json:"hosts,omitempty"`
+	FileShares *vmwareClassicFileSharesPatch `json:"file_shares,omitempty"`
+}
+
+type vmwareClassicHostsPatch struct {
+	Add    *vmwareClassicHostsAdd `json:"add,omitempty"`
+	Remove []string               `json:"remove,omitempty"`
+}
+
+type vmwareClassicHostsAdd struct {
+	Quantity *int64 `json:"quantity"`
+}
+
+type vmwareClassicFileSharesPatch struct {
+	Add    []vmwareClassicNfsDatastore `json:"add,omitempty"`
+	Remove []string                    `json:"remove,omitempty"`
+}
+
+// vmwareClassicService is a service installed on a classic vCenter Server instance, for example HCX or Veeam
+type vmwareClassicService struct {
+	ID            *string           `json:"id,omitempty"`
+	Name          *string           `json:"name"`
+	Status        *string           `json:"status,omitempty"`
+	Configuration map[string]string `json:"configuration,omitempty"`
+}
+
+// vmwareClassicRequest sends a request to the VMware Solutions API and unmarshals the response into result. The path
+// is relative to the URL of the API.
+func vmwareClassicRequest(context context.Context, service *core.BaseService, method, path string, pathParamsMap map[string]string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(context)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(service.Options.URL, path, pathParamsMap)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		if _, err := builder.SetBodyContentJSON(body); err != nil {
+			return nil, err
+		}
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return service.Request(request, result)
+}
+
+// vmwareClassicParseID splits the ID of a resource into its parts
+func vmwareClassicParseID(id string, parts int, format string) ([]string, error) {
+	idParts := strings.SplitN(id, "/", parts)
+	if len(idParts) != parts {
+		return nil, fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of %s", id, format)
+	}
+	for _, part := range idParts {
+		if part == "" {
+			return nil, fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of %s", id, format)
+		}
+	}
+	return idParts, nil
+}
+
+func getVMwareClassicCluster(context context.Context, service *core.BaseService, vcenterID, clusterID string) (*vmwareClassicCluster, *core.DetailedResponse, error) {
+	cluster := &vmwareClassicCluster{}
+	pathParamsMap := map[string]string{"vcenter_id": vcenterID, "cluster_id": clusterID}
+	response, err := vmwareClassicRequest(context, service, core.GET, "/vcenters/{vcenter_id}/clusters/{cluster_id}", pathParamsMap, nil, cluster)
+	if err != nil {
+		return nil, response, err
+	}
+	return cluster, response, nil
+}
+
+// patchVMwareClassicCluster sends the patch of the cluster and waits for the cluster to be ready to use again. The
+// hosts and NFS datastores of a cluster can't be changed while a previous change is in progress, so the cluster is
+// waited for before the patch too.
+func patchVMwareClassicCluster(context context.Context, service *core.BaseService, vcenterID, clusterID string, patch *vmwareClassicClusterPatch, timeout time.Duration) (*vmwareClassicCluster, error) {
+	start := time.Now()
+	if _, err := waitForVMwareClassicClusterReady(context, service, vcenterID, clusterID, timeout); err != nil {
+		return nil, err
+	}
+
+	pathParamsMap := map[string]string{"vcenter_id": vcenterID, "cluster_id": clusterID}
+	response, err := vmwareClassicRequest(context, service, core.PATCH, "/vcenters/{vcenter_id}/clusters/{cluster_id}", pathParamsMap, patch, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s\n%s", err, response)
+	}
+
+	return waitForVMwareClassicClusterReady(context, service, vcenterID, clusterID, timeout-time.Since(start))
+}
+
+func waitForVMwareClassicClusterReady(context context.Context, service *core.BaseService, vcenterID, clusterID string, timeout time.Duration) (*vmwareClassicCluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{vmwareClassicStatusModifying, vmwareClassicStatusUpdating, "Initializing"},
+		Target:  []string{vmwareClassicStatusReadyToUse},
+		Refresh: func() (interface{}, string, error) {
+			cluster, response, err := getVMwareClassicCluster(context, service, vcenterID, clusterID)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting cluster %s of vCenter Server instance %s: %s\n%s", clusterID, vcenterID, err, response)
+			}
+			return cluster, *cluster.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      30 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+
+	cluster, err := stateConf.WaitForStateContext(context)
+	if err != nil {
+		return nil, err
+	}
+	return cluster.(*vmwareClassicCluster), nil
+}
@@ -179,9 +179,6 @@ func dataSourceIBMIsBackupPoliciesRead(context context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 
-	start := ""
-	matchBackupPolicies := []vpcv1.BackupPolicy{}
-
 	var resourceGroup string
 	if v, ok := d.GetOk("resource_group"); ok {
 		resourceGroup = v.(string)
@@ -197,34 +194,24 @@ func dataSourceIBMIsBackupPoliciesRead(context context.Context, d *schema.Resour
 		tag = v.(string)
 	}
 
-	for {
-		listBackupPoliciesOptions := &vpcv1.ListBackupPoliciesOptions{}
-		if start != "" {
-			listBackupPoliciesOptions.Start = &start
-		}
-		if resourceGroup != "" {
-			listBackupPoliciesOptions.SetResourceGroupID(resourceGroup)
-		}
-		if name != "" {
-			listBackupPoliciesOptions.SetName(name)
-		}
-		if tag != "" {
-			listBackupPoliciesOptions.SetTag(tag)
-		}
-		backupPolicyCollection, response, err := sess.ListBackupPoliciesWithContext(context, listBackupPoliciesOptions)
-		if err != nil {
-			log.Printf("[DEBUG] ListBackupPoliciesWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] ListBackupPoliciesWithContext failed %s\n%s", err, response))
-		}
-		if backupPolicyCollection != nil && *backupPolicyCollection.TotalCount == int64(0) {
-			break
-		}
-		start = flex.GetNext(backupPolicyCollection.Next)
-		matchBackupPolicies = append(matchBackupPolicies, backupPolicyCollection.BackupPolicies...)
-		if start == "" {
-			break
-		}
-
+	listBackupPoliciesOptions := &vpcv1.ListBackupPoliciesOptions{}
+	if resourceGroup != "" {
+		listBackupPoliciesOptions.SetResourceGroupID(resourceGroup)
+	}
+	if name != "" {
+		listBackupPoliciesOptions.SetName(name)
+	}
+	if tag != "" {
+		listBackupPoliciesOptions.SetTag(tag)
+	}
+	pager, err := sess.NewBackupPoliciesPager(listBackupPoliciesOptions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	matchBackupPolicies, err := flex.PaginateAll[vpcv1.BackupPolicy](context, pager)
+	if err != nil {
+		log.Printf("[DEBUG] ListBackupPoliciesWithContext failed %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] ListBackupPoliciesWithContext failed %s", err))
 	}
 
 	d.SetId(dataSourceIBMIsBackupPoliciesID(d))
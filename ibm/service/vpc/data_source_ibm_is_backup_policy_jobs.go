@@ -321,29 +321,14 @@ func dataSourceIBMIsBackupPolicyJobsRead(context context.Context, d *schema.Reso
 	}
 
 	// Support for pagination
-	start := ""
-	allrecs := []vpcv1.BackupPolicyJob{}
-
-	for {
-
-		if start != "" {
-			listBackupPolicyJobsOptions.Start = &start
-		}
-
-		backupPolicyJobCollection, response, err := vpcClient.ListBackupPolicyJobsWithContext(context, listBackupPolicyJobsOptions)
-		if err != nil {
-			log.Printf("[DEBUG] ListBackupPolicyJobsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListBackupPolicyJobsWithContext failed %s\n%s", err, response))
-		}
-
-		if backupPolicyJobCollection != nil && *backupPolicyJobCollection.TotalCount == int64(0) {
-			break
-		}
-		start = flex.GetNext(backupPolicyJobCollection.Next)
-		allrecs = append(allrecs, backupPolicyJobCollection.Jobs...)
-		if start == "" {
-			break
-		}
+	pager, err := vpcClient.NewBackupPolicyJobsPager(listBackupPolicyJobsOptions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.BackupPolicyJob](context, pager)
+	if err != nil {
+		log.Printf("[DEBUG] ListBackupPolicyJobsWithContext failed %s", err)
+		return diag.FromErr(fmt.Errorf("ListBackupPolicyJobsWithContext failed %s", err))
 	}
 
 	d.SetId(dataSourceIBMIsBackupPolicyJobsID(d))
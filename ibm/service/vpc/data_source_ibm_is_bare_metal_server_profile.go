@@ -58,6 +58,12 @@ func DataSourceIBMIsBareMetalServerProfile() *schema.Resource {
 				Computed:    true,
 				Description: "The URL for this bare metal server profile",
 			},
+			isInstanceProfileConfidentialComputeModes: dataSourceInstanceProfileConfidentialComputeModesSchema(),
+			isInstanceProfileSecureExecution: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether bare metal servers with this profile can run IBM Secure Execution workloads (s390x only)",
+			},
 			isBareMetalServerProfileBandwidth: {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -352,6 +358,10 @@ func dataSourceIBMISBMSProfileRead(context context.Context, d *schema.ResourceDa
 	if err != nil || bmsProfile == nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error Getting Bare Metal Server Profile (%s): %s\n%s", name, err, response))
 	}
+	profileModes, err := getInstanceProfileModes(context, sess, `/bare_metal_server/profiles/{name}`, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	d.SetId(*bmsProfile.Name)
 	d.Set(isBareMetalServerProfileName, *bmsProfile.Name)
 	d.Set(isBareMetalServerProfileFamily, *bmsProfile.Family)
@@ -459,6 +469,10 @@ func dataSourceIBMISBMSProfileRead(context context.Context, d *schema.ResourceDa
 		list = append(list, m)
 		d.Set(isBareMetalServerProfileOS, list)
 	}
+	if profileModes.ConfidentialComputeModes != nil {
+		d.Set(isInstanceProfileConfidentialComputeModes, dataSourceInstanceProfileFlattenConfidentialComputeModes(*profileModes.ConfidentialComputeModes))
+	}
+	d.Set(isInstanceProfileSecureExecution, instanceProfileSupportsSecureExecution(profileModes.ConfidentialComputeModes))
 
 	if bmsProfile.Disks != nil {
 		list := make([]map[string]interface{}, 0)
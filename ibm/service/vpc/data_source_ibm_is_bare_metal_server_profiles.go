@@ -42,6 +42,12 @@ func DataSourceIBMIsBareMetalServerProfiles() *schema.Resource {
 							Computed:    true,
 							Description: "The name for this bare metal server profile",
 						},
+						isInstanceProfileConfidentialComputeModes: dataSourceInstanceProfileConfidentialComputeModesSchema(),
+						isInstanceProfileSecureExecution: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether bare metal servers with this profile can run IBM Secure Execution workloads (s390x only)",
+						},
 
 						isBareMetalServerProfileFamily: {
 							Type:        schema.TypeString,
@@ -343,22 +349,18 @@ func dataSourceIBMIsBareMetalServerProfilesRead(context context.Context, d *sche
 		return diag.FromErr(err)
 	}
 
-	start := ""
-	allrecs := []vpcv1.BareMetalServerProfile{}
-	for {
-		listBMSProfilesOptions := &vpcv1.ListBareMetalServerProfilesOptions{}
-		if start != "" {
-			listBMSProfilesOptions.Start = &start
-		}
-		availableProfiles, response, err := sess.ListBareMetalServerProfilesWithContext(context, listBMSProfilesOptions)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error fetching Bare Metal Server Profiles %s\n%s", err, response))
-		}
-		start = flex.GetNext(availableProfiles.Next)
-		allrecs = append(allrecs, availableProfiles.Profiles...)
-		if start == "" {
-			break
-		}
+	listBMSProfilesOptions := &vpcv1.ListBareMetalServerProfilesOptions{}
+	pager, err := sess.NewBareMetalServerProfilesPager(listBMSProfilesOptions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.BareMetalServerProfile](context, pager)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error fetching Bare Metal Server Profiles %s", err))
+	}
+	profilesModes, err := listInstanceProfileModes(context, sess, `/bare_metal_server/profiles`)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	profilesInfo := make([]map[string]interface{}, 0)
@@ -469,6 +471,14 @@ func dataSourceIBMIsBareMetalServerProfilesRead(context context.Context, d *sche
 			list = append(list, m)
 			l[isBareMetalServerProfileOS] = list
 		}
+		profileModes := &instanceProfileModes{}
+		if modes, ok := profilesModes[*profile.Name]; ok {
+			profileModes = modes
+		}
+		if profileModes.ConfidentialComputeModes != nil {
+			l[isInstanceProfileConfidentialComputeModes] = dataSourceInstanceProfileFlattenConfidentialComputeModes(*profileModes.ConfidentialComputeModes)
+		}
+		l[isInstanceProfileSecureExecution] = instanceProfileSupportsSecureExecution(profileModes.ConfidentialComputeModes)
 
 		if profile.Disks != nil {
 			list := make([]map[string]interface{}, 0)
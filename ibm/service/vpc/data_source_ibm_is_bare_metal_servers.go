@@ -461,9 +461,6 @@ func dataSourceIBMISBareMetalServersRead(context context.Context, d *schema.Reso
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	start := ""
-	allrecs := []vpcv1.BareMetalServer{}
-
 	listBareMetalServersOptions := &vpcv1.ListBareMetalServersOptions{}
 	if resgroupintf, ok := d.GetOk("resource_group"); ok {
 		resGroup := resgroupintf.(string)
@@ -497,20 +494,13 @@ func dataSourceIBMISBareMetalServersRead(context context.Context, d *schema.Reso
 		subnetCrn := subnetCrnIntf.(string)
 		listBareMetalServersOptions.NetworkInterfacesSubnetCRN = &subnetCrn
 	}
-	for {
-
-		if start != "" {
-			listBareMetalServersOptions.Start = &start
-		}
-		availableServers, response, err := sess.ListBareMetalServersWithContext(context, listBareMetalServersOptions)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error fetching Bare Metal Servers %s\n%s", err, response))
-		}
-		start = flex.GetNext(availableServers.Next)
-		allrecs = append(allrecs, availableServers.BareMetalServers...)
-		if start == "" {
-			break
-		}
+	pager, err := sess.NewBareMetalServersPager(listBareMetalServersOptions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.BareMetalServer](context, pager)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error fetching Bare Metal Servers %s", err))
 	}
 
 	serversInfo := make([]map[string]interface{}, 0)
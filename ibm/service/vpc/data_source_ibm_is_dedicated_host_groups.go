@@ -192,22 +192,14 @@ func dataSourceIbmIsDedicatedHostGroupsRead(context context.Context, d *schema.R
 		listDedicatedHostGroupsOptions.Name = &name
 	}
 
-	start := ""
-	allrecs := []vpcv1.DedicatedHostGroup{}
-	for {
-		if start != "" {
-			listDedicatedHostGroupsOptions.Start = &start
-		}
-		listDedicatedHostGroupsOptions, response, err := vpcClient.ListDedicatedHostGroupsWithContext(context, listDedicatedHostGroupsOptions)
-		if err != nil {
-			log.Printf("[DEBUG] ListDedicatedHostGroupsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(err)
-		}
-		start = flex.GetNext(listDedicatedHostGroupsOptions.Next)
-		allrecs = append(allrecs, listDedicatedHostGroupsOptions.Groups...)
-		if start == "" {
-			break
-		}
+	pager, err := vpcClient.NewDedicatedHostGroupsPager(listDedicatedHostGroupsOptions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.DedicatedHostGroup](context, pager)
+	if err != nil {
+		log.Printf("[DEBUG] ListDedicatedHostGroupsWithContext failed %s", err)
+		return diag.FromErr(err)
 	}
 
 	if len(allrecs) != 0 {
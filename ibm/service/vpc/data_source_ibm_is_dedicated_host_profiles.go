@@ -350,22 +350,14 @@ func dataSourceIbmIsDedicatedHostProfilesRead(context context.Context, d *schema
 
 	listDedicatedHostProfilesOptions := &vpcv1.ListDedicatedHostProfilesOptions{}
 
-	start := ""
-	allrecs := []vpcv1.DedicatedHostProfile{}
-	for {
-		if start != "" {
-			listDedicatedHostProfilesOptions.Start = &start
-		}
-		dedicatedHostProfileCollection, response, err := vpcClient.ListDedicatedHostProfilesWithContext(context, listDedicatedHostProfilesOptions)
-		if err != nil {
-			log.Printf("[DEBUG] ListDedicatedHostProfilesWithContext failed %s\n%s", err, response)
-			return diag.FromErr(err)
-		}
-		start = flex.GetNext(dedicatedHostProfileCollection.Next)
-		allrecs = append(allrecs, dedicatedHostProfileCollection.Profiles...)
-		if start == "" {
-			break
-		}
+	pager, err := vpcClient.NewDedicatedHostProfilesPager(listDedicatedHostProfilesOptions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.DedicatedHostProfile](context, pager)
+	if err != nil {
+		log.Printf("[DEBUG] ListDedicatedHostProfilesWithContext failed %s", err)
+		return diag.FromErr(err)
 	}
 
 	if len(allrecs) > 0 {
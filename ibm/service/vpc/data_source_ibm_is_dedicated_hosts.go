@@ -409,22 +409,14 @@ func dataSourceIbmIsDedicatedHostsRead(context context.Context, d *schema.Resour
 		name := nameintf.(string)
 		listDedicatedHostsOptions.Name = &name
 	}
-	start := ""
-	allrecs := []vpcv1.DedicatedHost{}
-	for {
-		if start != "" {
-			listDedicatedHostsOptions.Start = &start
-		}
-		dedicatedHostCollection, response, err := vpcClient.ListDedicatedHostsWithContext(context, listDedicatedHostsOptions)
-		if err != nil {
-			log.Printf("[DEBUG] ListDedicatedHostsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(err)
-		}
-		start = flex.GetNext(dedicatedHostCollection.Next)
-		allrecs = append(allrecs, dedicatedHostCollection.DedicatedHosts...)
-		if start == "" {
-			break
-		}
+	pager, err := vpcClient.NewDedicatedHostsPager(listDedicatedHostsOptions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.DedicatedHost](context, pager)
+	if err != nil {
+		log.Printf("[DEBUG] ListDedicatedHostsWithContext failed %s", err)
+		return diag.FromErr(err)
 	}
 
 	if len(allrecs) > 0 {
@@ -32,6 +32,16 @@ func DataSourceIBMIsFloatingIps() *schema.Resource {
 				Optional:    true,
 				Description: "The unique user-defined name for this floating IP.",
 			},
+			"zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the zone of the floating IPs.",
+			},
+			"unbound": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether only the floating IPs that are not bound to a target are listed, so that they can be reused.",
+			},
 			"floating_ips": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -222,28 +232,19 @@ func dataSourceIBMIsFloatingIpsRead(context context.Context, d *schema.ResourceD
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	start := ""
-	allFloatingIPs := []vpcv1.FloatingIP{}
 	floatingIPOptions := &vpcv1.ListFloatingIpsOptions{}
 	if resgroupintf, ok := d.GetOk("resource_group"); ok {
 		resGroup := resgroupintf.(string)
 		floatingIPOptions.ResourceGroupID = &resGroup
 	}
-	for {
-
-		if start != "" {
-			floatingIPOptions.Start = &start
-		}
-		floatingIPs, response, err := sess.ListFloatingIps(floatingIPOptions)
-		if err != nil {
-			log.Printf("[DEBUG] Error Fetching floating IPs  %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] Error Fetching floating IPs %s\n%s", err, response))
-		}
-		start = flex.GetNext(floatingIPs.Next)
-		allFloatingIPs = append(allFloatingIPs, floatingIPs.FloatingIps...)
-		if start == "" {
-			break
-		}
+	pager, err := sess.NewFloatingIpsPager(floatingIPOptions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allFloatingIPs, err := flex.PaginateAll[vpcv1.FloatingIP](context, pager)
+	if err != nil {
+		log.Printf("[DEBUG] Error Fetching floating IPs  %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Fetching floating IPs %s", err))
 	}
 	var matchFloatingIps []vpcv1.FloatingIP
 	var name string
@@ -260,6 +261,16 @@ func dataSourceIBMIsFloatingIpsRead(context context.Context, d *schema.ResourceD
 	} else {
 		matchFloatingIps = allFloatingIPs
 	}
+	if zone, ok := d.GetOk("zone"); ok {
+		matchFloatingIps = dataSourceIBMIsFloatingIpsFilter(matchFloatingIps, func(floatingIP vpcv1.FloatingIP) bool {
+			return floatingIP.Zone != nil && *floatingIP.Zone.Name == zone.(string)
+		})
+	}
+	if d.Get("unbound").(bool) {
+		matchFloatingIps = dataSourceIBMIsFloatingIpsFilter(matchFloatingIps, func(floatingIP vpcv1.FloatingIP) bool {
+			return floatingIP.Target == nil
+		})
+	}
 	if suppliedFilter {
 		if len(matchFloatingIps) == 0 {
 			return diag.FromErr(fmt.Errorf("no FloatingIps found with name %s", name))
@@ -278,6 +289,17 @@ func dataSourceIBMIsFloatingIpsRead(context context.Context, d *schema.ResourceD
 	return nil
 }
 
+// dataSourceIBMIsFloatingIpsFilter returns the floating IPs that match the filter
+func dataSourceIBMIsFloatingIpsFilter(floatingIPs []vpcv1.FloatingIP, match func(vpcv1.FloatingIP) bool) []vpcv1.FloatingIP {
+	matchFloatingIps := []vpcv1.FloatingIP{}
+	for _, floatingIP := range floatingIPs {
+		if match(floatingIP) {
+			matchFloatingIps = append(matchFloatingIps, floatingIP)
+		}
+	}
+	return matchFloatingIps
+}
+
 // dataSourceIBMIsFloatingIpsID returns a reasonable ID for the list.
 func dataSourceIBMIsFloatingIpsID(d *schema.ResourceData) string {
 	return time.Now().UTC().String()
@@ -45,6 +45,40 @@ ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVE
 	})
 }
 
+func TestAccIBMIsFloatingIpsDataSourceUnbound(t *testing.T) {
+	fipname := fmt.Sprintf("tfip-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsFloatingIpsDataSourceConfigUnbound(fipname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_is_floating_ips.is_floating_ips", "floating_ips.#"),
+					resource.TestCheckResourceAttr("data.ibm_is_floating_ips.is_floating_ips", "floating_ips.0.zone.0.name", acc.ISZoneName),
+					resource.TestCheckResourceAttr("data.ibm_is_floating_ips.is_floating_ips", "floating_ips.0.target.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsFloatingIpsDataSourceConfigUnbound(fipname string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_floating_ip" "testacc_floatingip" {
+		name = "%s"
+		zone = "%s"
+	}
+
+	data "ibm_is_floating_ips" "is_floating_ips" {
+		zone       = ibm_is_floating_ip.testacc_floatingip.zone
+		unbound    = true
+		depends_on = [ibm_is_floating_ip.testacc_floatingip]
+	}
+	`, fipname, acc.ISZoneName)
+}
+
 func testAccCheckIBMIsFloatingIpsDataSourceConfigBasic(vpcname, subnetname, sshname, publicKey, instancename, fipname string) string {
 	// status filter defaults to empty
 	return fmt.Sprintf(`
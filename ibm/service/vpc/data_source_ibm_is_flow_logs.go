@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -146,8 +147,6 @@ func dataSourceIBMISFlowLogsRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	start := ""
-	allrecs := []vpcv1.FlowLogCollector{}
 	listOptions := &vpcv1.ListFlowLogCollectorsOptions{}
 	if resgroupintf, ok := d.GetOk("resource_group"); ok {
 		resGroup := resgroupintf.(string)
@@ -177,20 +176,13 @@ func dataSourceIBMISFlowLogsRead(d *schema.ResourceData, meta interface{}) error
 		targetType := targetTypeIntf.(string)
 		listOptions.TargetResourceType = &targetType
 	}
-	for {
-
-		if start != "" {
-			listOptions.Start = &start
-		}
-		flowlogCollectors, response, err := sess.ListFlowLogCollectors(listOptions)
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error Fetching Flow Logs for VPC %s\n%s", err, response)
-		}
-		start = flex.GetNext(flowlogCollectors.Next)
-		allrecs = append(allrecs, flowlogCollectors.FlowLogCollectors...)
-		if start == "" {
-			break
-		}
+	pager, err := sess.NewFlowLogCollectorsPager(listOptions)
+	if err != nil {
+		return err
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.FlowLogCollector](context.Background(), pager)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Fetching Flow Logs for VPC %s", err)
 	}
 	flowlogsInfo := make([]map[string]interface{}, 0)
 	for _, flowlogCollector := range allrecs {
@@ -163,23 +163,14 @@ func dataSourceIBMIsIkePoliciesRead(context context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
-	start := ""
-	allrecs := []vpcv1.IkePolicy{}
-	for {
-		listIkePoliciesOptions := &vpcv1.ListIkePoliciesOptions{}
-		if start != "" {
-			listIkePoliciesOptions.Start = &start
-		}
-		ikePolicyCollection, response, err := vpcClient.ListIkePoliciesWithContext(context, listIkePoliciesOptions)
-		if err != nil || ikePolicyCollection == nil {
-			log.Printf("[DEBUG] ListIkePoliciesWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListIkePoliciesWithContext failed %s\n%s", err, response))
-		}
-		start = flex.GetNext(ikePolicyCollection.Next)
-		allrecs = append(allrecs, ikePolicyCollection.IkePolicies...)
-		if start == "" {
-			break
-		}
+	pager, err := vpcClient.NewIkePoliciesPager(&vpcv1.ListIkePoliciesOptions{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.IkePolicy](context, pager)
+	if err != nil {
+		log.Printf("[DEBUG] ListIkePoliciesWithContext failed %s", err)
+		return diag.FromErr(fmt.Errorf("ListIkePoliciesWithContext failed %s", err))
 	}
 
 	d.SetId(dataSourceIBMIsIkePoliciesID(d))
@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -198,9 +199,6 @@ func imageList(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return err
 	}
-	start := ""
-	allrecs := []vpcv1.Image{}
-
 	var resourceGroupID string
 	if v, ok := d.GetOk(isImagesResourceGroupID); ok {
 		resourceGroupID = v.(string)
@@ -236,19 +234,13 @@ func imageList(d *schema.ResourceData, meta interface{}) error {
 		listImagesOptions.SetVisibility(visibility)
 	}
 
-	for {
-		if start != "" {
-			listImagesOptions.Start = &start
-		}
-		availableImages, response, err := sess.ListImages(listImagesOptions)
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error Fetching Images %s\n%s", err, response)
-		}
-		start = flex.GetNext(availableImages.Next)
-		allrecs = append(allrecs, availableImages.Images...)
-		if start == "" {
-			break
-		}
+	pager, err := sess.NewImagesPager(listImagesOptions)
+	if err != nil {
+		return err
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.Image](context.Background(), pager)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Fetching Images %s", err)
 	}
 
 	if status != "" {
@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/go-openapi/strfmt"
+)
+
+const (
+	isConsoleAccessInstance        = "instance"
+	isConsoleAccessBareMetalServer = "bare_metal_server"
+	isConsoleAccessConsoleType     = "console_type"
+	isConsoleAccessForce           = "force"
+)
+
+func DataSourceIBMIsInstanceConsoleAccess() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsInstanceConsoleAccessRead,
+
+		Schema: map[string]*schema.Schema{
+			isConsoleAccessInstance: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{isConsoleAccessInstance, isConsoleAccessBareMetalServer},
+				Description:  "The identifier of the virtual server instance to create the console access token for.",
+			},
+			isConsoleAccessBareMetalServer: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{isConsoleAccessInstance, isConsoleAccessBareMetalServer},
+				Description:  "The identifier of the bare metal server to create the console access token for.",
+			},
+			isConsoleAccessConsoleType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "serial",
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"serial", "vnc"}),
+				Description:  "The console type for which this token may be used, serial or vnc.",
+			},
+			isConsoleAccessForce: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates whether to disconnect an existing serial console session as the serial console cannot be shared.",
+			},
+			"access_token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "A URL safe single-use token used to access the console.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The websocket URL, including the access token, to connect to the console.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the access token was created.",
+			},
+			"expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the access token will expire.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsInstanceConsoleAccessRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	consoleType := d.Get(isConsoleAccessConsoleType).(string)
+	force := d.Get(isConsoleAccessForce).(bool)
+
+	// The instance and bare metal server tokens have the same properties
+	var accessToken, href *string
+	var createdAt, expiresAt *strfmt.DateTime
+	var id string
+	if instanceID, ok := d.GetOk(isConsoleAccessInstance); ok {
+		id = instanceID.(string)
+		options := &vpcv1.CreateInstanceConsoleAccessTokenOptions{}
+		options.SetInstanceID(id)
+		options.SetConsoleType(consoleType)
+		options.SetForce(force)
+		token, response, err := vpcClient.CreateInstanceConsoleAccessTokenWithContext(context, options)
+		if err != nil {
+			log.Printf("[DEBUG] CreateInstanceConsoleAccessTokenWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error creating the %s console access token of instance (%s): %s\n%s", consoleType, id, err, response))
+		}
+		accessToken, href = token.AccessToken, token.Href
+		createdAt, expiresAt = token.CreatedAt, token.ExpiresAt
+	} else {
+		id = d.Get(isConsoleAccessBareMetalServer).(string)
+		options := &vpcv1.CreateBareMetalServerConsoleAccessTokenOptions{}
+		options.SetBareMetalServerID(id)
+		options.SetConsoleType(consoleType)
+		options.SetForce(force)
+		token, response, err := vpcClient.CreateBareMetalServerConsoleAccessTokenWithContext(context, options)
+		if err != nil {
+			log.Printf("[DEBUG] CreateBareMetalServerConsoleAccessTokenWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error creating the %s console access token of bare metal server (%s): %s\n%s", consoleType, id, err, response))
+		}
+		accessToken, href = token.AccessToken, token.Href
+		createdAt, expiresAt = token.CreatedAt, token.ExpiresAt
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", id, consoleType))
+	if err = d.Set("access_token", accessToken); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting access_token: %s", err))
+	}
+	if err = d.Set("href", href); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting href: %s", err))
+	}
+	if createdAt != nil {
+		if err = d.Set("created_at", flex.DateTimeToString(createdAt)); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting created_at: %s", err))
+		}
+	}
+	if expiresAt != nil {
+		if err = d.Set("expires_at", flex.DateTimeToString(expiresAt)); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting expires_at: %s", err))
+		}
+	}
+	return nil
+}
@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -158,29 +159,17 @@ func dataSourceIBMISInstanceGroupManagerActionsRead(d *schema.ResourceData, meta
 	instanceGroupID := d.Get("instance_group").(string)
 
 	// Support for pagination
-	start := ""
-	allrecs := []vpcv1.InstanceGroupManagerActionIntf{}
-
-	for {
-		listInstanceGroupManagerActionsOptions := vpcv1.ListInstanceGroupManagerActionsOptions{
-			InstanceGroupID:        &instanceGroupID,
-			InstanceGroupManagerID: &instanceGroupManagerID,
-		}
-		if start != "" {
-			listInstanceGroupManagerActionsOptions.Start = &start
-		}
-		instanceGroupManagerActionsCollection, response, err := sess.ListInstanceGroupManagerActions(&listInstanceGroupManagerActionsOptions)
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error Getting InstanceGroup Manager Actions %s\n%s", err, response)
-		}
-		if instanceGroupManagerActionsCollection != nil && *instanceGroupManagerActionsCollection.TotalCount == int64(0) {
-			break
-		}
-		start = flex.GetNext(instanceGroupManagerActionsCollection.Next)
-		allrecs = append(allrecs, instanceGroupManagerActionsCollection.Actions...)
-		if start == "" {
-			break
-		}
+	listInstanceGroupManagerActionsOptions := vpcv1.ListInstanceGroupManagerActionsOptions{
+		InstanceGroupID:        &instanceGroupID,
+		InstanceGroupManagerID: &instanceGroupManagerID,
+	}
+	pager, err := sess.NewInstanceGroupManagerActionsPager(&listInstanceGroupManagerActionsOptions)
+	if err != nil {
+		return err
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.InstanceGroupManagerActionIntf](context.Background(), pager)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Getting InstanceGroup Manager Actions %s", err)
 	}
 
 	actions := make([]map[string]interface{}, 0)
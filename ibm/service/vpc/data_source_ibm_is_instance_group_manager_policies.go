@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -88,26 +89,17 @@ func dataSourceIBMISInstanceGroupManagerPoliciesRead(d *schema.ResourceData, met
 	instanceGroupID := d.Get("instance_group").(string)
 
 	// Support for pagination
-	start := ""
-	allrecs := []vpcv1.InstanceGroupManagerPolicyIntf{}
-
-	for {
-		listInstanceGroupManagerPoliciesOptions := vpcv1.ListInstanceGroupManagerPoliciesOptions{
-			InstanceGroupID:        &instanceGroupID,
-			InstanceGroupManagerID: &instanceGroupManagerID,
-		}
-		if start != "" {
-			listInstanceGroupManagerPoliciesOptions.Start = &start
-		}
-		instanceGroupManagerPolicyCollection, response, err := sess.ListInstanceGroupManagerPolicies(&listInstanceGroupManagerPoliciesOptions)
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error Getting InstanceGroup Manager Policies %s\n%s", err, response)
-		}
-		start = flex.GetNext(instanceGroupManagerPolicyCollection.Next)
-		allrecs = append(allrecs, instanceGroupManagerPolicyCollection.Policies...)
-		if start == "" {
-			break
-		}
+	listInstanceGroupManagerPoliciesOptions := vpcv1.ListInstanceGroupManagerPoliciesOptions{
+		InstanceGroupID:        &instanceGroupID,
+		InstanceGroupManagerID: &instanceGroupManagerID,
+	}
+	pager, err := sess.NewInstanceGroupManagerPoliciesPager(&listInstanceGroupManagerPoliciesOptions)
+	if err != nil {
+		return err
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.InstanceGroupManagerPolicyIntf](context.Background(), pager)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Getting InstanceGroup Manager Policies %s", err)
 	}
 
 	policies := make([]map[string]interface{}, 0)
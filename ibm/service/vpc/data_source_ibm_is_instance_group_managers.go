@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -122,28 +123,16 @@ func dataSourceIBMISInstanceGroupManagersRead(d *schema.ResourceData, meta inter
 	instanceGroupID := d.Get("instance_group").(string)
 
 	// Support for pagination
-	start := ""
-	allrecs := []vpcv1.InstanceGroupManagerIntf{}
-
-	for {
-		listInstanceGroupManagerOptions := vpcv1.ListInstanceGroupManagersOptions{
-			InstanceGroupID: &instanceGroupID,
-		}
-		if start != "" {
-			listInstanceGroupManagerOptions.Start = &start
-		}
-		instanceGroupManagerCollections, response, err := sess.ListInstanceGroupManagers(&listInstanceGroupManagerOptions)
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error Getting InstanceGroup Managers %s\n%s", err, response)
-		}
-
-		start = flex.GetNext(instanceGroupManagerCollections.Next)
-		allrecs = append(allrecs, instanceGroupManagerCollections.Managers...)
-
-		if start == "" {
-			break
-		}
-
+	listInstanceGroupManagerOptions := vpcv1.ListInstanceGroupManagersOptions{
+		InstanceGroupID: &instanceGroupID,
+	}
+	pager, err := sess.NewInstanceGroupManagersPager(&listInstanceGroupManagerOptions)
+	if err != nil {
+		return err
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.InstanceGroupManagerIntf](context.Background(), pager)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Getting InstanceGroup Managers %s", err)
 	}
 
 	instanceGroupMnagers := make([]map[string]interface{}, 0)
@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -119,28 +120,16 @@ func dataSourceIBMISInstanceGroupMembershipsRead(d *schema.ResourceData, meta in
 	}
 	instanceGroupID := d.Get(isInstanceGroup).(string)
 	// Support for pagination
-	start := ""
-	allrecs := []vpcv1.InstanceGroupMembership{}
-
-	for {
-		listInstanceGroupMembershipsOptions := vpcv1.ListInstanceGroupMembershipsOptions{
-			InstanceGroupID: &instanceGroupID,
-		}
-		if start != "" {
-			listInstanceGroupMembershipsOptions.Start = &start
-		}
-		instanceGroupMembershipCollection, response, err := sess.ListInstanceGroupMemberships(&listInstanceGroupMembershipsOptions)
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error Getting InstanceGroup Membership Collection %s\n%s", err, response)
-		}
-
-		start = flex.GetNext(instanceGroupMembershipCollection.Next)
-		allrecs = append(allrecs, instanceGroupMembershipCollection.Memberships...)
-
-		if start == "" {
-			break
-		}
-
+	listInstanceGroupMembershipsOptions := vpcv1.ListInstanceGroupMembershipsOptions{
+		InstanceGroupID: &instanceGroupID,
+	}
+	pager, err := sess.NewInstanceGroupMembershipsPager(&listInstanceGroupMembershipsOptions)
+	if err != nil {
+		return err
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.InstanceGroupMembership](context.Background(), pager)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Getting InstanceGroup Membership Collection %s", err)
 	}
 
 	memberships := make([]map[string]interface{}, 0)
@@ -331,25 +331,16 @@ func DataSourceIBMIsInstanceGroupsRead(context context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 
-	start := ""
-	allrecs := []vpcv1.InstanceGroup{}
 	listInstanceGroupsOptions := &vpcv1.ListInstanceGroupsOptions{}
-	for {
 
-		if start != "" {
-			listInstanceGroupsOptions.Start = &start
-		}
-
-		instanceGroupCollection, response, err := vpcClient.ListInstanceGroupsWithContext(context, listInstanceGroupsOptions)
-		if err != nil {
-			log.Printf("[DEBUG] ListInstanceGroupsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListInstanceGroupsWithContext failed %s\n%s", err, response))
-		}
-		start = flex.GetNext(instanceGroupCollection.Next)
-		allrecs = append(allrecs, instanceGroupCollection.InstanceGroups...)
-		if start == "" {
-			break
-		}
+	pager, err := vpcClient.NewInstanceGroupsPager(listInstanceGroupsOptions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.InstanceGroup](context, pager)
+	if err != nil {
+		log.Printf("[DEBUG] ListInstanceGroupsWithContext failed %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] ListInstanceGroupsWithContext failed %s", err))
 	}
 
 	d.SetId(DataSourceIBMIsInstanceGroupsID(d))
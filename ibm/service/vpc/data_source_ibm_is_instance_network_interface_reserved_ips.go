@@ -123,26 +123,17 @@ func dataSourceIBMISInstanceNICReservedIPsRead(context context.Context, d *schem
 	nicID := d.Get(isInstanceNICID).(string)
 
 	// Flatten all the reserved IPs
-	start := ""
-	allrecs := []vpcv1.ReservedIP{}
-	for {
-		options := &vpcv1.ListInstanceNetworkInterfaceIpsOptions{
-			InstanceID:         &instanceID,
-			NetworkInterfaceID: &nicID,
-		}
-		if start != "" {
-			options.Start = &start
-		}
-
-		result, response, err := sess.ListInstanceNetworkInterfaceIpsWithContext(context, options)
-		if err != nil || response == nil || result == nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error fetching reserved ips %s\n%s", err, response))
-		}
-		start = flex.GetNext(result.Next)
-		allrecs = append(allrecs, result.Ips...)
-		if start == "" {
-			break
-		}
+	options := &vpcv1.ListInstanceNetworkInterfaceIpsOptions{
+		InstanceID:         &instanceID,
+		NetworkInterfaceID: &nicID,
+	}
+	pager, err := sess.NewInstanceNetworkInterfaceIpsPager(options)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.ReservedIP](context, pager)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error fetching reserved ips %s", err))
 	}
 	// Now store all the reserved IP info with their response tags
 	reservedIPs := []map[string]interface{}{}
@@ -272,23 +272,13 @@ func dataSourceIBMIsInstanceNetworkInterfacesRead(context context.Context, d *sc
 	instance_name := d.Get("instance_name").(string)
 	listInstancesOptions := &vpcv1.ListInstancesOptions{}
 
-	start := ""
-	allrecs := []vpcv1.Instance{}
-	for {
-
-		if start != "" {
-			listInstancesOptions.Start = &start
-		}
-
-		instances, response, err := vpcClient.ListInstances(listInstancesOptions)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error Fetching Instances %s\n%s", err, response))
-		}
-		start = flex.GetNext(instances.Next)
-		allrecs = append(allrecs, instances.Instances...)
-		if start == "" {
-			break
-		}
+	pager, err := vpcClient.NewInstancesPager(listInstancesOptions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.Instance](context, pager)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error Fetching Instances %s", err))
 	}
 
 	ins_id := ""
@@ -4,6 +4,11 @@
 package vpc
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -14,6 +19,10 @@ const (
 	isInstanceProfileArchitecture = "architecture"
 	isInstanceVCPUArchitecture    = "vcpu_architecture"
 	isInstanceVCPUManufacturer    = "vcpu_manufacturer"
+
+	isInstanceProfileConfidentialComputeModes = "confidential_compute_modes"
+	isInstanceProfileSecureBootModes          = "secure_boot_modes"
+	isInstanceProfileSecureExecution          = "secure_execution"
 )
 
 func DataSourceIBMISInstanceProfile() *schema.Resource {
@@ -54,6 +63,16 @@ func DataSourceIBMISInstanceProfile() *schema.Resource {
 				},
 			},
 
+			isInstanceProfileConfidentialComputeModes: dataSourceInstanceProfileConfidentialComputeModesSchema(),
+
+			isInstanceProfileSecureBootModes: dataSourceInstanceProfileSecureBootModesSchema(),
+
+			isInstanceProfileSecureExecution: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether instances with this profile can run IBM Secure Execution workloads (s390x only).",
+			},
+
 			"bandwidth": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -622,6 +641,10 @@ func instanceProfileGet(d *schema.ResourceData, meta interface{}, name string) e
 	if err != nil {
 		return err
 	}
+	profileModes, err := getInstanceProfileModes(context.Background(), sess, `/instance/profiles/{name}`, name)
+	if err != nil {
+		return err
+	}
 	// For lack of anything better, compose our id from profile name.
 	d.SetId(*profile.Name)
 	d.Set(isInstanceProfileName, *profile.Name)
@@ -638,6 +661,19 @@ func instanceProfileGet(d *schema.ResourceData, meta interface{}, name string) e
 		}
 
 	}
+	if profileModes.ConfidentialComputeModes != nil {
+		err = d.Set(isInstanceProfileConfidentialComputeModes, dataSourceInstanceProfileFlattenConfidentialComputeModes(*profileModes.ConfidentialComputeModes))
+		if err != nil {
+			return err
+		}
+	}
+	if profileModes.SecureBootModes != nil {
+		err = d.Set(isInstanceProfileSecureBootModes, dataSourceInstanceProfileFlattenSecureBootModes(*profileModes.SecureBootModes))
+		if err != nil {
+			return err
+		}
+	}
+	d.Set(isInstanceProfileSecureExecution, instanceProfileSupportsSecureExecution(profileModes.ConfidentialComputeModes))
 	if profile.Bandwidth != nil {
 		err = d.Set("bandwidth", dataSourceInstanceProfileFlattenBandwidth(*profile.Bandwidth.(*vpcv1.InstanceProfileBandwidth)))
 		if err != nil {
@@ -1182,3 +1218,171 @@ func dataSourceInstanceProfileTotalVolumeBandwidthToMap(bandwidthItem vpcv1.Inst
 
 	return bandwidthMap
 }
+
+func dataSourceInstanceProfileConfidentialComputeModesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The confidential compute modes supported with this profile.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"default": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The default confidential compute mode for this profile.",
+				},
+				"type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The type for this profile field.",
+				},
+				"values": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "The supported confidential compute modes.",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceInstanceProfileSecureBootModesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The secure boot modes supported with this profile.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"default": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "The default secure boot mode for this profile.",
+				},
+				"type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The type for this profile field.",
+				},
+				"values": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "The supported `enable_secure_boot` values for an instance using this profile.",
+					Elem: &schema.Schema{
+						Type: schema.TypeBool,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceInstanceProfileFlattenConfidentialComputeModes(result instanceProfileConfidentialComputeModes) (finalList []map[string]interface{}) {
+	modesMap := map[string]interface{}{}
+	if result.Default != nil {
+		modesMap["default"] = *result.Default
+	}
+	if result.Type != nil {
+		modesMap["type"] = *result.Type
+	}
+	if result.Values != nil {
+		modesMap["values"] = result.Values
+	}
+	return append(finalList, modesMap)
+}
+
+func dataSourceInstanceProfileFlattenSecureBootModes(result instanceProfileSecureBootModes) (finalList []map[string]interface{}) {
+	modesMap := map[string]interface{}{}
+	if result.Default != nil {
+		modesMap["default"] = *result.Default
+	}
+	if result.Type != nil {
+		modesMap["type"] = *result.Type
+	}
+	if result.Values != nil {
+		modesMap["values"] = result.Values
+	}
+	return append(finalList, modesMap)
+}
+
+// instanceProfileSupportsSecureExecution reports whether the secure_execution confidential compute
+// mode is supported, which is only the case of s390x profiles.
+func instanceProfileSupportsSecureExecution(modes *instanceProfileConfidentialComputeModes) bool {
+	if modes == nil {
+		return false
+	}
+	for _, mode := range modes.Values {
+		if mode == instanceProfileConfidentialComputeModeSecureExecution {
+			return true
+		}
+	}
+	return false
+}
+
+const instanceProfileConfidentialComputeModeSecureExecution = "secure_execution"
+
+// instanceProfileModes holds the confidential compute and secure boot modes of an instance or a bare metal server
+// profile, which aren't in the models of the VPC SDK yet.
+type instanceProfileModes struct {
+	Name                     *string                                  `json:"name"`
+	ConfidentialComputeModes *instanceProfileConfidentialComputeModes `json:"confidential_compute_modes,omitempty"`
+	SecureBootModes          *instanceProfileSecureBootModes          `json:"secure_boot_modes,omitempty"`
+}
+
+type instanceProfileConfidentialComputeModes struct {
+	Default *string  `json:"default"`
+	Type    *string  `json:"type"`
+	Values  []string `json:"values"`
+}
+
+type instanceProfileSecureBootModes struct {
+	Default *bool   `json:"default"`
+	Type    *string `json:"type"`
+	Values  []bool  `json:"values"`
+}
+
+// getInstanceProfileModes gets the modes of a profile, the path is either /instance/profiles/{name} or
+// /bare_metal_server/profiles/{name}.
+func getInstanceProfileModes(ctx context.Context, sess *vpcv1.VpcV1, path, name string) (*instanceProfileModes, error) {
+	profileModes := &instanceProfileModes{}
+	response, err := vpcRequest(ctx, sess, core.GET, path, map[string]string{"name": name}, nil, nil, profileModes)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting the modes of the profile %s: %s\n%s", name, err, response)
+	}
+	return profileModes, nil
+}
+
+// listInstanceProfileModes lists the modes of the profiles by name, the path is either /instance/profiles or
+// /bare_metal_server/profiles.
+func listInstanceProfileModes(ctx context.Context, sess *vpcv1.VpcV1, path string) (map[string]*instanceProfileModes, error) {
+	profilesModes := map[string]*instanceProfileModes{}
+	start := ""
+	for {
+		var query map[string]string
+		if start != "" {
+			query = map[string]string{"start": start}
+		}
+		collection := &struct {
+			Profiles []*instanceProfileModes `json:"profiles"`
+			Next     *struct {
+				Href *string `json:"href"`
+			} `json:"next"`
+		}{}
+		response, err := vpcRequest(ctx, sess, core.GET, path, nil, query, nil, collection)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error listing the modes of the profiles: %s\n%s", err, response)
+		}
+		for _, profileModes := range collection.Profiles {
+			if profileModes.Name != nil {
+				profilesModes[*profileModes.Name] = profileModes
+			}
+		}
+		start = flex.GetNext(collection.Next)
+		if start == "" {
+			break
+		}
+	}
+	return profilesModes, nil
+}
@@ -42,6 +42,34 @@ func TestAccIBMISInstanceProfileDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMISInstanceProfileDataSource_secureExecution(t *testing.T) {
+	resName := "data.ibm_is_instance_profile.test1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISInstanceProfileDataSourceSecureExecutionConfig("bz2e-1x4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "architecture", "s390x"),
+					resource.TestCheckResourceAttr(resName, "secure_execution", "true"),
+					resource.TestCheckResourceAttrSet(resName, "confidential_compute_modes.0.default"),
+					resource.TestCheckResourceAttrSet(resName, "confidential_compute_modes.0.values.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISInstanceProfileDataSourceSecureExecutionConfig(name string) string {
+	return fmt.Sprintf(`
+
+data "ibm_is_instance_profile" "test1" {
+	name = "%s"
+}`, name)
+}
+
 func testAccCheckIBMISInstanceProfileDataSourceConfig() string {
 	return fmt.Sprintf(`
 
@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -55,6 +56,13 @@ func DataSourceIBMISInstanceProfiles() *schema.Resource {
 								Type: schema.TypeString,
 							},
 						},
+						isInstanceProfileConfidentialComputeModes: dataSourceInstanceProfileConfidentialComputeModesSchema(),
+						isInstanceProfileSecureBootModes:          dataSourceInstanceProfileSecureBootModesSchema(),
+						isInstanceProfileSecureExecution: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether instances with this profile can run IBM Secure Execution workloads (s390x only).",
+						},
 						"bandwidth": {
 							Type:     schema.TypeList,
 							Computed: true,
@@ -622,6 +630,10 @@ func instanceProfilesList(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error Fetching Instance Profiles %s\n%s", err, response)
 	}
+	profilesModes, err := listInstanceProfileModes(context.Background(), sess, `/instance/profiles`)
+	if err != nil {
+		return err
+	}
 	profilesInfo := make([]map[string]interface{}, 0)
 	for _, profile := range availableProfiles.Profiles {
 
@@ -640,6 +652,17 @@ func instanceProfilesList(d *schema.ResourceData, meta interface{}) error {
 				l["architecture_values"] = profile.OsArchitecture.Values
 			}
 		}
+		profileModes := &instanceProfileModes{}
+		if modes, ok := profilesModes[*profile.Name]; ok {
+			profileModes = modes
+		}
+		if profileModes.ConfidentialComputeModes != nil {
+			l[isInstanceProfileConfidentialComputeModes] = dataSourceInstanceProfileFlattenConfidentialComputeModes(*profileModes.ConfidentialComputeModes)
+		}
+		if profileModes.SecureBootModes != nil {
+			l[isInstanceProfileSecureBootModes] = dataSourceInstanceProfileFlattenSecureBootModes(*profileModes.SecureBootModes)
+		}
+		l[isInstanceProfileSecureExecution] = instanceProfileSupportsSecureExecution(profileModes.ConfidentialComputeModes)
 		if profile.Bandwidth != nil {
 			bandwidthList := []map[string]interface{}{}
 			bandwidthMap := dataSourceInstanceProfileBandwidthToMap(*profile.Bandwidth.(*vpcv1.InstanceProfileBandwidth))
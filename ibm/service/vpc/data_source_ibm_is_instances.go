@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -712,24 +713,14 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 		insGrp = insGrpInf.(string)
 	} else if insGrpNameInf, ok := d.GetOk(isInstanceGroupName); ok {
 		insGrpName := insGrpNameInf.(string)
-		start := ""
-		allrecs := []vpcv1.InstanceGroup{}
-		for {
-			listInstanceGroupOptions := vpcv1.ListInstanceGroupsOptions{}
-			if start != "" {
-				listInstanceGroupOptions.Start = &start
-			}
-			instanceGroupsCollection, response, err := sess.ListInstanceGroups(&listInstanceGroupOptions)
-			if err != nil {
-				return fmt.Errorf("[ERROR] Error Fetching InstanceGroups %s\n%s", err, response)
-			}
-			start = flex.GetNext(instanceGroupsCollection.Next)
-			allrecs = append(allrecs, instanceGroupsCollection.InstanceGroups...)
-
-			if start == "" {
-				break
-			}
-
+		listInstanceGroupOptions := vpcv1.ListInstanceGroupsOptions{}
+		pager, err := sess.NewInstanceGroupsPager(&listInstanceGroupOptions)
+		if err != nil {
+			return err
+		}
+		allrecs, err := flex.PaginateAll[vpcv1.InstanceGroup](context.Background(), pager)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Fetching InstanceGroups %s", err)
 		}
 
 		for _, instanceGroup := range allrecs {
@@ -771,49 +762,30 @@ func instancesList(d *schema.ResourceData, meta interface{}) error {
 		listInstancesOptions.PlacementGroupID = &placementGrpIdStr
 	}
 
-	start := ""
-	allrecs := []vpcv1.Instance{}
-	for {
+	pager, err := sess.NewInstancesPager(listInstancesOptions)
+	if err != nil {
+		return err
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.Instance](context.Background(), pager)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Fetching Instances %s", err)
+	}
 
-		if start != "" {
-			listInstancesOptions.Start = &start
+	if insGrp != "" {
+		listInstanceGroupMembershipsOptions := vpcv1.ListInstanceGroupMembershipsOptions{
+			InstanceGroupID: &insGrp,
 		}
-
-		instances, response, err := sess.ListInstances(listInstancesOptions)
+		membershipsPager, err := sess.NewInstanceGroupMembershipsPager(&listInstanceGroupMembershipsOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Fetching Instances %s\n%s", err, response)
+			return err
 		}
-		start = flex.GetNext(instances.Next)
-		allrecs = append(allrecs, instances.Instances...)
-		if start == "" {
-			break
+		memberships, err := flex.PaginateAll[vpcv1.InstanceGroupMembership](context.Background(), membershipsPager)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Getting InstanceGroup Membership Collection %s", err)
 		}
-	}
-
-	if insGrp != "" {
 		membershipMap := map[string]bool{}
-		start := ""
-		for {
-			listInstanceGroupMembershipsOptions := vpcv1.ListInstanceGroupMembershipsOptions{
-				InstanceGroupID: &insGrp,
-			}
-			if start != "" {
-				listInstanceGroupMembershipsOptions.Start = &start
-			}
-			instanceGroupMembershipCollection, response, err := sess.ListInstanceGroupMemberships(&listInstanceGroupMembershipsOptions)
-			if err != nil {
-				return fmt.Errorf("[ERROR] Error Getting InstanceGroup Membership Collection %s\n%s", err, response)
-			}
-
-			start = flex.GetNext(instanceGroupMembershipCollection.Next)
-			for _, membershipItem := range instanceGroupMembershipCollection.Memberships {
-				membershipMap[*membershipItem.Instance.ID] = true
-			}
-
-			if start == "" {
-				break
-			}
-
+		for _, membershipItem := range memberships {
+			membershipMap[*membershipItem.Instance.ID] = true
 		}
 
 		//Filtering instance allrecs to contain instance group members only
@@ -163,23 +163,15 @@ func dataSourceIBMIsIpsecPoliciesRead(context context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
-	start := ""
-	allrecs := []vpcv1.IPsecPolicy{}
-	for {
-		listIpsecPoliciesOptions := &vpcv1.ListIpsecPoliciesOptions{}
-		if start != "" {
-			listIpsecPoliciesOptions.Start = &start
-		}
-		iPsecPolicyCollection, response, err := vpcClient.ListIpsecPoliciesWithContext(context, listIpsecPoliciesOptions)
-		if err != nil || iPsecPolicyCollection == nil {
-			log.Printf("[DEBUG] ListIpsecPoliciesWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListIpsecPoliciesWithContext failed %s\n%s", err, response))
-		}
-		start = flex.GetNext(iPsecPolicyCollection.Next)
-		allrecs = append(allrecs, iPsecPolicyCollection.IpsecPolicies...)
-		if start == "" {
-			break
-		}
+	listIpsecPoliciesOptions := &vpcv1.ListIpsecPoliciesOptions{}
+	pager, err := vpcClient.NewIpsecPoliciesPager(listIpsecPoliciesOptions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.IPsecPolicy](context, pager)
+	if err != nil {
+		log.Printf("[DEBUG] ListIpsecPoliciesWithContext failed %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] ListIpsecPoliciesWithContext failed %s", err))
 	}
 
 	d.SetId(dataSourceIBMIsIpsecPoliciesID(d))
@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"time"
@@ -82,7 +83,6 @@ func dataSourceIBMISLbProfilesRead(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
-	start := ""
 	allrecs := []vpcv1.LoadBalancerProfile{}
 	if lbprofilenameok, ok := d.GetOk(isLbsProfileName); ok {
 		lbprofilename := lbprofilenameok.(string)
@@ -95,20 +95,14 @@ func dataSourceIBMISLbProfilesRead(d *schema.ResourceData, meta interface{}) err
 		}
 		allrecs = append(allrecs, *lbProfile)
 	} else {
-		for {
-			listOptions := &vpcv1.ListLoadBalancerProfilesOptions{}
-			if start != "" {
-				listOptions.Start = &start
-			}
-			profileCollectors, response, err := sess.ListLoadBalancerProfiles(listOptions)
-			if err != nil {
-				return fmt.Errorf("[ERROR] Error Fetching Load Balancer Profiles for VPC %s\n%s", err, response)
-			}
-			start = flex.GetNext(profileCollectors.Next)
-			allrecs = append(allrecs, profileCollectors.Profiles...)
-			if start == "" {
-				break
-			}
+		listOptions := &vpcv1.ListLoadBalancerProfilesOptions{}
+		pager, err := sess.NewLoadBalancerProfilesPager(listOptions)
+		if err != nil {
+			return err
+		}
+		allrecs, err = flex.PaginateAll[vpcv1.LoadBalancerProfile](context.Background(), pager)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error Fetching Load Balancer Profiles for VPC %s", err)
 		}
 	}
 	lbprofilesInfo := make([]map[string]interface{}, 0)
@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -209,6 +210,12 @@ func DataSourceIBMISLBS() *schema.Resource {
 							Description: "Load Balancer Host Name",
 						},
 
+						isLBLogging: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether datapath logging is active for this load balancer.",
+						},
+
 						isLBListeners: {
 							Type:        schema.TypeList,
 							Computed:    true,
@@ -297,22 +304,14 @@ func getLbs(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return err
 	}
-	start := ""
-	allrecs := []vpcv1.LoadBalancer{}
-	for {
-		listLoadBalancersOptions := &vpcv1.ListLoadBalancersOptions{}
-		if start != "" {
-			listLoadBalancersOptions.Start = &start
-		}
-		lbs, response, err := sess.ListLoadBalancers(listLoadBalancersOptions)
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error Fetching Load Balancers %s\n%s", err, response)
-		}
-		start = flex.GetNext(lbs.Next)
-		allrecs = append(allrecs, lbs.LoadBalancers...)
-		if start == "" {
-			break
-		}
+	listLoadBalancersOptions := &vpcv1.ListLoadBalancersOptions{}
+	pager, err := sess.NewLoadBalancersPager(listLoadBalancersOptions)
+	if err != nil {
+		return err
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.LoadBalancer](context.Background(), pager)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Fetching Load Balancers %s", err)
 	}
 
 	lbList := make([]map[string]interface{}, 0)
@@ -440,6 +439,9 @@ func getLbs(d *schema.ResourceData, meta interface{}) error {
 		}
 		lbInfo[isLBResourceGroup] = *lb.ResourceGroup.ID
 		lbInfo[isLBHostName] = *lb.Hostname
+		if lb.Logging != nil && lb.Logging.Datapath != nil && lb.Logging.Datapath.Active != nil {
+			lbInfo[isLBLogging] = *lb.Logging.Datapath.Active
+		}
 		tags, err := flex.GetGlobalTagsUsingCRN(meta, *lb.CRN, "", isUserTagType)
 		if err != nil {
 			log.Printf(
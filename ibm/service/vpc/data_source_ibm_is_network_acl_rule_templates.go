@@ -0,0 +1,255 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isNetworkACLRuleTemplates           = "templates"
+	isNetworkACLRuleTemplateNamePrefix  = "name_prefix"
+	isNetworkACLRuleTemplateRemoteCIDR  = "remote_cidr"
+	isNetworkACLRuleTemplateSubnetCIDR  = "subnet_cidr"
+	isNetworkACLRuleTemplateWebTierPort = "web_tier_ports"
+)
+
+func DataSourceIBMISNetworkACLRuleTemplates() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMISNetworkACLRuleTemplatesRead,
+
+		Schema: map[string]*schema.Schema{
+			isNetworkACLRuleTemplates: {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validate.InvokeDataSourceValidator("ibm_is_network_acl_rule_templates", isNetworkACLRuleTemplates)},
+				Description: "The baseline rule sets to compose, in order: allow-all, deny-all, ssh or web-tier. The deny-all rule set must be the last one for the rule sets before it to be reachable",
+			},
+			isNetworkACLRuleTemplateRemoteCIDR: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "0.0.0.0/0",
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_is_network_acl_rule_templates", isNetworkACLRuleTemplateRemoteCIDR),
+				Description:  "The CIDR of the clients allowed by the rules",
+			},
+			isNetworkACLRuleTemplateSubnetCIDR: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "0.0.0.0/0",
+				ValidateFunc: validate.InvokeDataSourceValidator("ibm_is_network_acl_rule_templates", isNetworkACLRuleTemplateSubnetCIDR),
+				Description:  "The CIDR of the subnets the network ACL is attached to",
+			},
+			isNetworkACLRuleTemplateWebTierPort: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The ports served by the web-tier rule set, 80 and 443 by default",
+			},
+			isNetworkACLRuleTemplateNamePrefix: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The prefix of the names of the rules",
+			},
+			isNetworkACLRules: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The rules of the rule sets, in the format of the rules of the ibm_is_network_acl resource",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isNetworkACLRuleName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the rule",
+						},
+						isNetworkACLRuleAction: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Whether to allow or deny matching traffic",
+						},
+						isNetworkACLRuleSource: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The source CIDR block",
+						},
+						isNetworkACLRuleDestination: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The destination CIDR block",
+						},
+						isNetworkACLRuleDirection: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Whether the traffic to match is inbound or outbound",
+						},
+						isNetworkACLRuleICMP: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The ICMP traffic matched by the rule",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									isNetworkACLRuleICMPCode: {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The ICMP traffic code to match",
+									},
+									isNetworkACLRuleICMPType: {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The ICMP traffic type to match",
+									},
+								},
+							},
+						},
+						isNetworkACLRuleTCP: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The TCP traffic matched by the rule",
+							Elem:        dataSourceIBMISNetworkACLRuleTemplatePortsSchema(),
+						},
+						isNetworkACLRuleUDP: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The UDP traffic matched by the rule",
+							Elem:        dataSourceIBMISNetworkACLRuleTemplatePortsSchema(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMISNetworkACLRuleTemplatePortsSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			isNetworkACLRulePortMax: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The inclusive upper bound of TCP/UDP destination port range",
+			},
+			isNetworkACLRulePortMin: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The inclusive lower bound of TCP/UDP destination port range",
+			},
+			isNetworkACLRuleSourcePortMax: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The inclusive upper bound of TCP/UDP source port range",
+			},
+			isNetworkACLRuleSourcePortMin: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The inclusive lower bound of TCP/UDP source port range",
+			},
+		},
+	}
+}
+
+func DataSourceIBMISNetworkACLRuleTemplatesValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isNetworkACLRuleTemplates,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "allow-all, deny-all, ssh, web-tier"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isNetworkACLRuleTemplateRemoteCIDR,
+			ValidateFunctionIdentifier: validate.ValidateIPorCIDR,
+			Type:                       validate.TypeString,
+			Optional:                   true})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isNetworkACLRuleTemplateSubnetCIDR,
+			ValidateFunctionIdentifier: validate.ValidateIPorCIDR,
+			Type:                       validate.TypeString,
+			Optional:                   true})
+
+	ibmISNetworkACLRuleTemplatesValidator := validate.ResourceValidator{ResourceName: "ibm_is_network_acl_rule_templates", Schema: validateSchema}
+	return &ibmISNetworkACLRuleTemplatesValidator
+}
+
+func dataSourceIBMISNetworkACLRuleTemplatesRead(d *schema.ResourceData, meta interface{}) error {
+	templates := flex.ExpandStringList(d.Get(isNetworkACLRuleTemplates).([]interface{}))
+	prefix := d.Get(isNetworkACLRuleTemplateNamePrefix).(string)
+	remote := d.Get(isNetworkACLRuleTemplateRemoteCIDR).(string)
+	subnet := d.Get(isNetworkACLRuleTemplateSubnetCIDR).(string)
+	webPorts := []int{80, 443}
+	if v, ok := d.GetOk(isNetworkACLRuleTemplateWebTierPort); ok {
+		webPorts = flex.ExpandIntList(v.([]interface{}))
+	}
+
+	rules := []map[string]interface{}{}
+	for _, template := range templates {
+		switch template {
+		case "allow-all":
+			rules = append(rules,
+				networkACLTemplateRule(prefix+"allow-all-inbound", "allow", "inbound", remote, subnet, "", nil),
+				networkACLTemplateRule(prefix+"allow-all-outbound", "allow", "outbound", subnet, remote, "", nil))
+		case "deny-all":
+			rules = append(rules,
+				networkACLTemplateRule(prefix+"deny-all-inbound", "deny", "inbound", remote, subnet, "", nil),
+				networkACLTemplateRule(prefix+"deny-all-outbound", "deny", "outbound", subnet, remote, "", nil))
+		case "ssh":
+			rules = append(rules, networkACLTemplateServerRules(prefix+"ssh", remote, subnet, 22)...)
+		case "web-tier":
+			for _, port := range webPorts {
+				rules = append(rules, networkACLTemplateServerRules(fmt.Sprintf("%sweb-%d", prefix, port), remote, subnet, port)...)
+			}
+		default:
+			return fmt.Errorf("[ERROR] Unknown network ACL rule template %s", template)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", strings.Join(templates, ","), remote, subnet))
+	if err := d.Set(isNetworkACLRules, rules); err != nil {
+		return fmt.Errorf("[ERROR] Error setting rules: %s", err)
+	}
+	return nil
+}
+
+// networkACLTemplateServerRules returns the rules allowing the clients to reach a TCP port of the
+// subnets, and the replies of the port to reach the ephemeral ports of the clients.
+func networkACLTemplateServerRules(name, remote, subnet string, port int) []map[string]interface{} {
+	return []map[string]interface{}{
+		networkACLTemplateRule(name+"-inbound", "allow", "inbound", remote, subnet, isNetworkACLRuleTCP, map[string]interface{}{
+			isNetworkACLRulePortMin:       port,
+			isNetworkACLRulePortMax:       port,
+			isNetworkACLRuleSourcePortMin: networkACLEphemeralPortMin,
+			isNetworkACLRuleSourcePortMax: 65535,
+		}),
+		networkACLTemplateRule(name+"-outbound", "allow", "outbound", subnet, remote, isNetworkACLRuleTCP, map[string]interface{}{
+			isNetworkACLRulePortMin:       networkACLEphemeralPortMin,
+			isNetworkACLRulePortMax:       65535,
+			isNetworkACLRuleSourcePortMin: port,
+			isNetworkACLRuleSourcePortMax: port,
+		}),
+	}
+}
+
+func networkACLTemplateRule(name, action, direction, source, destination, protocol string, match map[string]interface{}) map[string]interface{} {
+	rule := map[string]interface{}{
+		isNetworkACLRuleName:        name,
+		isNetworkACLRuleAction:      action,
+		isNetworkACLRuleDirection:   direction,
+		isNetworkACLRuleSource:      source,
+		isNetworkACLRuleDestination: destination,
+		isNetworkACLRuleICMP:        []interface{}{},
+		isNetworkACLRuleTCP:         []interface{}{},
+		isNetworkACLRuleUDP:         []interface{}{},
+	}
+	if protocol != "" {
+		rule[protocol] = []interface{}{match}
+	}
+	return rule
+}
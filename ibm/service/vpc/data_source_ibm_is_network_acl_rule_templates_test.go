@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMISNetworkACLRuleTemplatesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISNetworkACLRuleTemplatesDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_is_network_acl_rule_templates.example", "rules.#", "8"),
+					resource.TestCheckResourceAttr("data.ibm_is_network_acl_rule_templates.example", "rules.0.name", "tf-web-80-inbound"),
+					resource.TestCheckResourceAttr("data.ibm_is_network_acl_rule_templates.example", "rules.0.tcp.0.port_min", "80"),
+					resource.TestCheckResourceAttr("data.ibm_is_network_acl_rule_templates.example", "rules.1.tcp.0.source_port_min", "80"),
+					resource.TestCheckResourceAttr("data.ibm_is_network_acl_rule_templates.example", "rules.6.name", "tf-deny-all-inbound"),
+					resource.TestCheckResourceAttr("data.ibm_is_network_acl_rule_templates.example", "rules.7.action", "deny"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISNetworkACLRuleTemplatesDataSourceConfig() string {
+	return `
+	data "ibm_is_network_acl_rule_templates" "example" {
+		templates   = ["web-tier", "ssh", "deny-all"]
+		name_prefix = "tf-"
+		subnet_cidr = "10.240.0.0/24"
+	}
+	`
+}
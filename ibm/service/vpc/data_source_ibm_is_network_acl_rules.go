@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"time"
@@ -188,8 +189,6 @@ func networkACLRulesList(d *schema.ResourceData, meta interface{}, nwACLID strin
 	if err != nil {
 		return err
 	}
-	start := ""
-	allrecs := []vpcv1.NetworkACLRuleItemIntf{}
 	listNetworkACLRulesOptions := &vpcv1.ListNetworkACLRulesOptions{
 		NetworkACLID: &nwACLID,
 	}
@@ -197,22 +196,14 @@ func networkACLRulesList(d *schema.ResourceData, meta interface{}, nwACLID strin
 		direction := directionIntf.(string)
 		listNetworkACLRulesOptions.Direction = &direction
 	}
-	for {
 
-		if start != "" {
-			listNetworkACLRulesOptions.Start = &start
-		}
-
-		ruleList, response, err := sess.ListNetworkACLRules(listNetworkACLRulesOptions)
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error Fetching network acl ruless %s\n%s", err, response)
-		}
-		start = flex.GetNext(ruleList.Next)
-
-		allrecs = append(allrecs, ruleList.Rules...)
-		if start == "" {
-			break
-		}
+	pager, err := sess.NewNetworkACLRulesPager(listNetworkACLRulesOptions)
+	if err != nil {
+		return err
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.NetworkACLRuleItemIntf](context.Background(), pager)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Fetching network acl ruless %s", err)
 	}
 	rulesInfo := make([]map[string]interface{}, 0)
 	for _, rule := range allrecs {
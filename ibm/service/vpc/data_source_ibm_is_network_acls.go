@@ -364,26 +364,18 @@ func dataSourceIBMIsNetworkAclsRead(context context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 	resource_group_id := d.Get("resource_group").(string)
-	start := ""
-	allrecs := []vpcv1.NetworkACL{}
 	listNetworkAclsOptions := &vpcv1.ListNetworkAclsOptions{}
 	if resource_group_id != "" {
 		listNetworkAclsOptions.ResourceGroupID = &resource_group_id
 	}
-	for {
-		if start != "" {
-			listNetworkAclsOptions.Start = &start
-		}
-		networkACLCollection, response, err := vpcClient.ListNetworkAclsWithContext(context, listNetworkAclsOptions)
-		if err != nil || networkACLCollection == nil {
-			log.Printf("[DEBUG] ListNetworkAclsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListNetworkAclsWithContext failed %s\n%s", err, response))
-		}
-		start = flex.GetNext(networkACLCollection.Next)
-		allrecs = append(allrecs, networkACLCollection.NetworkAcls...)
-		if start == "" {
-			break
-		}
+	pager, err := vpcClient.NewNetworkAclsPager(listNetworkAclsOptions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.NetworkACL](context, pager)
+	if err != nil {
+		log.Printf("[DEBUG] ListNetworkAclsWithContext failed %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] ListNetworkAclsWithContext failed %s", err))
 	}
 
 	d.SetId(dataSourceIBMIsNetworkAclsID(d))
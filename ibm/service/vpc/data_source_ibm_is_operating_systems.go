@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -90,23 +91,15 @@ func osList(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return err
 	}
-	start := ""
-	allrecs := []vpcv1.OperatingSystem{}
-	for {
-		listOperatingSystemsOptions := &vpcv1.ListOperatingSystemsOptions{}
-		if start != "" {
-			listOperatingSystemsOptions.Start = &start
-		}
+	listOperatingSystemsOptions := &vpcv1.ListOperatingSystemsOptions{}
 
-		osList, response, err := sess.ListOperatingSystems(listOperatingSystemsOptions)
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error Fetching operating systems %s\n%s", err, response)
-		}
-		start = flex.GetNext(osList.Next)
-		allrecs = append(allrecs, osList.OperatingSystems...)
-		if start == "" {
-			break
-		}
+	pager, err := sess.NewOperatingSystemsPager(listOperatingSystemsOptions)
+	if err != nil {
+		return err
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.OperatingSystem](context.Background(), pager)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Fetching operating systems %s", err)
 	}
 	osInfo := make([]map[string]interface{}, 0)
 	for _, os := range allrecs {
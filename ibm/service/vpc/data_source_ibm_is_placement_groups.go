@@ -125,22 +125,14 @@ func dataSourceIbmIsPlacementGroupsRead(context context.Context, d *schema.Resou
 	}
 
 	listPlacementGroupsOptions := &vpcv1.ListPlacementGroupsOptions{}
-	start := ""
-	allrecs := []vpcv1.PlacementGroup{}
-	for {
-		if start != "" {
-			listPlacementGroupsOptions.Start = &start
-		}
-		placementGroupCollection, response, err := vpcClient.ListPlacementGroupsWithContext(context, listPlacementGroupsOptions)
-		if err != nil {
-			log.Printf("[DEBUG] ListPlacementGroupsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(err)
-		}
-		start = flex.GetNext(placementGroupCollection.Next)
-		allrecs = append(allrecs, placementGroupCollection.PlacementGroups...)
-		if start == "" {
-			break
-		}
+	pager, err := vpcClient.NewPlacementGroupsPager(listPlacementGroupsOptions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.PlacementGroup](context, pager)
+	if err != nil {
+		log.Printf("[DEBUG] ListPlacementGroupsWithContext failed %s", err)
+		return diag.FromErr(err)
 	}
 
 	d.SetId(dataSourceIbmIsPlacementGroupsID(d))
@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -149,25 +150,17 @@ func publicGatewaysGet(d *schema.ResourceData, meta interface{}, name string) er
 	if rg, ok := d.GetOk(isPublicGatewayResourceGroup); ok {
 		rgroup = rg.(string)
 	}
-	start := ""
-	allrecs := []vpcv1.PublicGateway{}
-	for {
-		listPublicGatewaysOptions := &vpcv1.ListPublicGatewaysOptions{}
-		if start != "" {
-			listPublicGatewaysOptions.Start = &start
-		}
-		if rgroup != "" {
-			listPublicGatewaysOptions.ResourceGroupID = &rgroup
-		}
-		publicgws, response, err := sess.ListPublicGateways(listPublicGatewaysOptions)
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error Fetching public gateways %s\n%s", err, response)
-		}
-		start = flex.GetNext(publicgws.Next)
-		allrecs = append(allrecs, publicgws.PublicGateways...)
-		if start == "" {
-			break
-		}
+	listPublicGatewaysOptions := &vpcv1.ListPublicGatewaysOptions{}
+	if rgroup != "" {
+		listPublicGatewaysOptions.ResourceGroupID = &rgroup
+	}
+	pager, err := sess.NewPublicGatewaysPager(listPublicGatewaysOptions)
+	if err != nil {
+		return err
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.PublicGateway](context.Background(), pager)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Fetching public gateways %s", err)
 	}
 	publicgwInfo := make([]map[string]interface{}, 0)
 	for _, publicgw := range allrecs {
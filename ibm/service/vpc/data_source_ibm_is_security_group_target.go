@@ -42,6 +42,12 @@ func DataSourceIBMISSecurityGroupTarget() *schema.Resource {
 				Description: "The CRN for this security group target",
 			},
 
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this security group target",
+			},
+
 			"resource_type": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -78,7 +84,7 @@ func dataSourceIBMISSecurityGroupTargetRead(d *schema.ResourceData, meta interfa
 		}
 		groups, response, err := sess.ListSecurityGroupTargets(listSecurityGroupTargetsOptions)
 		if err != nil {
-			return fmt.Errorf("[ERROR] Error Getting InstanceGroup Managers %s\n%s", err, response)
+			return fmt.Errorf("[ERROR] Error Getting Security Group Targets %s\n%s", err, response)
 		}
 		if *groups.TotalCount == int64(0) {
 			break
@@ -98,13 +104,11 @@ func dataSourceIBMISSecurityGroupTargetRead(d *schema.ResourceData, meta interfa
 		if *securityGroupTargetReference.Name == name {
 			d.Set("target", *securityGroupTargetReference.ID)
 			d.Set("crn", securityGroupTargetReference.CRN)
-			// d.Set("resource_type", *securityGroupTargetReference.ResourceType)
+			d.Set("href", securityGroupTargetReference.Href)
+			d.Set("resource_type", isSecurityGroupTargetType(securityGroupTargetReference))
 			if securityGroupTargetReference.Deleted != nil {
 				d.Set("more_info", *securityGroupTargetReference.Deleted.MoreInfo)
 			}
-			if securityGroupTargetReference != nil && securityGroupTargetReference.ResourceType != nil {
-				d.Set("resource_type", *securityGroupTargetReference.ResourceType)
-			}
 			d.SetId(fmt.Sprintf("%s/%s", securityGroupID, *securityGroupTargetReference.ID))
 			return nil
 		}
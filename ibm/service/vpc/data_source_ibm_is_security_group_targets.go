@@ -4,9 +4,11 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -24,6 +26,13 @@ func DataSourceIBMISSecurityGroupTargets() *schema.Resource {
 				Description: "Security group id",
 			},
 
+			"resource_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"network_interface", "bare_metal_server_network_interface", "load_balancer", "endpoint_gateway", "vpn_server", "virtual_network_interface"}),
+				Description:  "Filters the targets by resource type",
+			},
+
 			"targets": {
 				Type:        schema.TypeList,
 				Description: "List of targets",
@@ -43,6 +52,12 @@ func DataSourceIBMISSecurityGroupTargets() *schema.Resource {
 							Description: "The CRN for this target",
 						},
 
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL for this target",
+						},
+
 						"name": {
 							Type:        schema.TypeString,
 							Computed:    true,
@@ -77,46 +92,34 @@ func dataSourceIBMISSecurityGroupTargetsRead(d *schema.ResourceData, meta interf
 	securityGroupID := d.Get("security_group").(string)
 
 	// Support for pagination
-	start := ""
-	allrecs := []vpcv1.SecurityGroupTargetReferenceIntf{}
-
-	for {
-		listSecurityGroupTargetsOptions := sess.NewListSecurityGroupTargetsOptions(securityGroupID)
-		if start != "" {
-			listSecurityGroupTargetsOptions.Start = &start
-		}
-		groups, response, err := sess.ListSecurityGroupTargets(listSecurityGroupTargetsOptions)
-		if err != nil || groups == nil {
-			return fmt.Errorf("[ERROR] Error Getting InstanceGroup Managers %s\n%s", err, response)
-		}
-		if *groups.TotalCount == int64(0) {
-			break
-		}
-
-		start = flex.GetNext(groups.Next)
-		allrecs = append(allrecs, groups.Targets...)
-
-		if start == "" {
-			break
-		}
-
+	listSecurityGroupTargetsOptions := sess.NewListSecurityGroupTargetsOptions(securityGroupID)
+	pager, err := sess.NewSecurityGroupTargetsPager(listSecurityGroupTargetsOptions)
+	if err != nil {
+		return err
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.SecurityGroupTargetReferenceIntf](context.Background(), pager)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Getting Security Group Targets %s", err)
 	}
 
+	resourceType := d.Get("resource_type").(string)
 	targets := make([]map[string]interface{}, 0)
 	for _, securityGroupTargetReferenceIntf := range allrecs {
 		securityGroupTargetReference := securityGroupTargetReferenceIntf.(*vpcv1.SecurityGroupTargetReference)
+		targetType := isSecurityGroupTargetType(securityGroupTargetReference)
+		if resourceType != "" && targetType != resourceType {
+			continue
+		}
 		tr := map[string]interface{}{
-			"name":   *securityGroupTargetReference.Name,
-			"target": *securityGroupTargetReference.ID,
-			"crn":    securityGroupTargetReference.CRN,
-			// "resource_type": *securityGroupTargetReference.ResourceType,
+			"name":          *securityGroupTargetReference.Name,
+			"target":        *securityGroupTargetReference.ID,
+			"crn":           securityGroupTargetReference.CRN,
+			"href":          securityGroupTargetReference.Href,
+			"resource_type": targetType,
 		}
 		if securityGroupTargetReference.Deleted != nil {
 			tr["more_info"] = *securityGroupTargetReference.Deleted.MoreInfo
 		}
-		if securityGroupTargetReference != nil && securityGroupTargetReference.ResourceType != nil {
-			tr["resource_type"] = *securityGroupTargetReference.ResourceType
-		}
 		targets = append(targets, tr)
 	}
 	d.Set("targets", targets)
@@ -321,8 +321,6 @@ func dataSourceIBMIsSecurityGroupsRead(context context.Context, d *schema.Resour
 	vpcCrn := d.Get("vpc_crn").(string)
 	vpcName := d.Get("vpc_name").(string)
 
-	start := ""
-	allrecs := []vpcv1.SecurityGroup{}
 	listSecurityGroupsOptions := &vpcv1.ListSecurityGroupsOptions{}
 	if resourceGrp != "" {
 		listSecurityGroupsOptions.ResourceGroupID = &resourceGrp
@@ -336,23 +334,15 @@ func dataSourceIBMIsSecurityGroupsRead(context context.Context, d *schema.Resour
 	if vpcName != "" {
 		listSecurityGroupsOptions.VPCName = &vpcName
 	}
-	for {
 
-		if start != "" {
-			listSecurityGroupsOptions.Start = &start
-		}
-		securityGroupCollection, response, err := vpcClient.ListSecurityGroupsWithContext(context, listSecurityGroupsOptions)
-		if err != nil {
-			log.Printf("[DEBUG] ListSecurityGroupsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListSecurityGroupsWithContext failed %s\n%s", err, response))
-		}
-
-		start = flex.GetNext(securityGroupCollection.Next)
-		allrecs = append(allrecs, securityGroupCollection.SecurityGroups...)
-
-		if start == "" {
-			break
-		}
+	pager, err := vpcClient.NewSecurityGroupsPager(listSecurityGroupsOptions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.SecurityGroup](context, pager)
+	if err != nil {
+		log.Printf("[DEBUG] ListSecurityGroupsWithContext failed %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] ListSecurityGroupsWithContext failed %s", err))
 	}
 
 	d.SetId(dataSourceIBMIsSecurityGroupsID(d))
@@ -239,29 +239,20 @@ func dataSourceIBMIsShareTargetsRead(context context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	start := ""
-	allrecs := []vpcv1.ShareMountTarget{}
 	listShareTargetsOptions := &vpcv1.ListShareMountTargetsOptions{}
 
 	listShareTargetsOptions.SetShareID(d.Get("share").(string))
 	if name, ok := d.GetOk("name"); ok {
 		listShareTargetsOptions.SetName(name.(string))
 	}
-	for {
-		if start != "" {
-			listShareTargetsOptions.Start = &start
-		}
-		shareTargetCollection, response, err := vpcClient.ListShareMountTargetsWithContext(context, listShareTargetsOptions)
-		if err != nil {
-			log.Printf("[DEBUG] ListShareTargetsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(err)
-		}
-		start = flex.GetNext(shareTargetCollection.Next)
-		allrecs = append(allrecs, shareTargetCollection.MountTargets...)
-
-		if start == "" {
-			break
-		}
+	pager, err := vpcClient.NewShareMountTargetsPager(listShareTargetsOptions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.ShareMountTarget](context, pager)
+	if err != nil {
+		log.Printf("[DEBUG] ListShareTargetsWithContext failed %s", err)
+		return diag.FromErr(err)
 	}
 	d.SetId(dataSourceIBMIsShareTargetsID(d))
 
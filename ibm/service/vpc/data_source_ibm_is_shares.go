@@ -381,26 +381,14 @@ func dataSourceIbmIsSharesRead(context context.Context, d *schema.ResourceData,
 	if resGrp != "" {
 		listSharesOptions.ResourceGroupID = &resGrp
 	}
-	start := ""
-	allrecs := []vpcv1.Share{}
-	totalCount := 0
-	for {
-		if start != "" {
-			listSharesOptions.Start = &start
-		}
-		shareCollection, response, err := vpcClient.ListSharesWithContext(context, listSharesOptions)
-		if err != nil {
-			log.Printf("[DEBUG] ListSharesWithContext failed %s\n%s", err, response)
-			return diag.FromErr(err)
-		}
-		if totalCount == 0 {
-			totalCount = int(*shareCollection.TotalCount)
-		}
-		start = flex.GetNext(shareCollection.Next)
-		allrecs = append(allrecs, shareCollection.Shares...)
-		if start == "" {
-			break
-		}
+	pager, err := vpcClient.NewSharesPager(listSharesOptions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.Share](context, pager)
+	if err != nil {
+		log.Printf("[DEBUG] ListSharesWithContext failed %s", err)
+		return diag.FromErr(err)
 	}
 
 	d.SetId(dataSourceIbmIsSharesID(d))
@@ -411,7 +399,7 @@ func dataSourceIbmIsSharesRead(context context.Context, d *schema.ResourceData,
 			return diag.FromErr(fmt.Errorf("Error setting shares %s", err))
 		}
 	}
-	if err = d.Set("total_count", totalCount); err != nil {
+	if err = d.Set("total_count", len(allrecs)); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting total_count: %s", err))
 	}
 
@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -430,75 +431,67 @@ func getSnapshots(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return err
 	}
-	start := ""
-	allrecs := []vpcv1.Snapshot{}
-	for {
-		listSnapshotOptions := &vpcv1.ListSnapshotsOptions{}
-		if start != "" {
-			listSnapshotOptions.Start = &start
-		}
-		if rgFilterOk, ok := d.GetOk(isSnapshotResourceGroup); ok {
-			rgFilter := rgFilterOk.(string)
-			listSnapshotOptions.ResourceGroupID = &rgFilter
-		}
-		if nameFilterOk, ok := d.GetOk(isSnapshotName); ok {
-			nameFilter := nameFilterOk.(string)
-			listSnapshotOptions.Name = &nameFilter
-		}
-		if sourceImageFilterOk, ok := d.GetOk(isSnapshotSourceImage); ok {
-			sourceImageFilter := sourceImageFilterOk.(string)
-			listSnapshotOptions.SourceImageID = &sourceImageFilter
-		}
-		if sourceVolumeFilterOk, ok := d.GetOk(isSnapshotSourceVolume); ok {
-			sourceVolumeFilter := sourceVolumeFilterOk.(string)
-			listSnapshotOptions.SourceVolumeID = &sourceVolumeFilter
-		}
-		if backupPolicyPlanIdFilterOk, ok := d.GetOk("backup_policy_plan_id"); ok {
-			backupPolicyPlanIdFilter := backupPolicyPlanIdFilterOk.(string)
-			listSnapshotOptions.BackupPolicyPlanID = &backupPolicyPlanIdFilter
-		}
-		if tagFilterOk, ok := d.GetOk("tag"); ok {
-			tagFilter := tagFilterOk.(string)
-			listSnapshotOptions.Tag = &tagFilter
-		}
-		if copiesId, ok := d.GetOk(isSnapshotCopiesId); ok {
-			copiesIdFilter := copiesId.(string)
-			listSnapshotOptions.CopiesID = &copiesIdFilter
-		}
-		if copiesName, ok := d.GetOk(isSnapshotCopiesName); ok {
-			copiesNameFilter := copiesName.(string)
-			listSnapshotOptions.CopiesName = &copiesNameFilter
-		}
-		if copiesCRN, ok := d.GetOk(isSnapshotCopiesCRN); ok {
-			copiesCRNFilter := copiesCRN.(string)
-			listSnapshotOptions.CopiesCRN = &copiesCRNFilter
-		}
-		if copiesRemoteRegionName, ok := d.GetOk(isSnapshotCopiesRemoteRegionName); ok {
-			copiesRemoteRegionNameFilter := copiesRemoteRegionName.(string)
-			listSnapshotOptions.CopiesRemoteRegionName = &copiesRemoteRegionNameFilter
-		}
-		if sourceSnapshotId, ok := d.GetOk(isSnapshotSourceSnapshotId); ok {
-			sourceSnapshotIdFilter := sourceSnapshotId.(string)
-			listSnapshotOptions.SourceSnapshotID = &sourceSnapshotIdFilter
-		}
-		if sourceSnapshotRemoteRegionName, ok := d.GetOk(isSnapshotSourceSnapshotRemoteRegionName); ok {
-			sourceSnapshotRemoteRegionNameFilter := sourceSnapshotRemoteRegionName.(string)
-			listSnapshotOptions.SourceSnapshotRemoteRegionName = &sourceSnapshotRemoteRegionNameFilter
-		}
-		if sourceVolumeRemoteRegionName, ok := d.GetOk(isSnapshotSourceVolumeRemoteRegionName); ok {
-			sourceVolumeRemoteRegionNameFilter := sourceVolumeRemoteRegionName.(string)
-			listSnapshotOptions.SourceVolumeRemoteRegionName = &sourceVolumeRemoteRegionNameFilter
-		}
+	listSnapshotOptions := &vpcv1.ListSnapshotsOptions{}
+	if rgFilterOk, ok := d.GetOk(isSnapshotResourceGroup); ok {
+		rgFilter := rgFilterOk.(string)
+		listSnapshotOptions.ResourceGroupID = &rgFilter
+	}
+	if nameFilterOk, ok := d.GetOk(isSnapshotName); ok {
+		nameFilter := nameFilterOk.(string)
+		listSnapshotOptions.Name = &nameFilter
+	}
+	if sourceImageFilterOk, ok := d.GetOk(isSnapshotSourceImage); ok {
+		sourceImageFilter := sourceImageFilterOk.(string)
+		listSnapshotOptions.SourceImageID = &sourceImageFilter
+	}
+	if sourceVolumeFilterOk, ok := d.GetOk(isSnapshotSourceVolume); ok {
+		sourceVolumeFilter := sourceVolumeFilterOk.(string)
+		listSnapshotOptions.SourceVolumeID = &sourceVolumeFilter
+	}
+	if backupPolicyPlanIdFilterOk, ok := d.GetOk("backup_policy_plan_id"); ok {
+		backupPolicyPlanIdFilter := backupPolicyPlanIdFilterOk.(string)
+		listSnapshotOptions.BackupPolicyPlanID = &backupPolicyPlanIdFilter
+	}
+	if tagFilterOk, ok := d.GetOk("tag"); ok {
+		tagFilter := tagFilterOk.(string)
+		listSnapshotOptions.Tag = &tagFilter
+	}
+	if copiesId, ok := d.GetOk(isSnapshotCopiesId); ok {
+		copiesIdFilter := copiesId.(string)
+		listSnapshotOptions.CopiesID = &copiesIdFilter
+	}
+	if copiesName, ok := d.GetOk(isSnapshotCopiesName); ok {
+		copiesNameFilter := copiesName.(string)
+		listSnapshotOptions.CopiesName = &copiesNameFilter
+	}
+	if copiesCRN, ok := d.GetOk(isSnapshotCopiesCRN); ok {
+		copiesCRNFilter := copiesCRN.(string)
+		listSnapshotOptions.CopiesCRN = &copiesCRNFilter
+	}
+	if copiesRemoteRegionName, ok := d.GetOk(isSnapshotCopiesRemoteRegionName); ok {
+		copiesRemoteRegionNameFilter := copiesRemoteRegionName.(string)
+		listSnapshotOptions.CopiesRemoteRegionName = &copiesRemoteRegionNameFilter
+	}
+	if sourceSnapshotId, ok := d.GetOk(isSnapshotSourceSnapshotId); ok {
+		sourceSnapshotIdFilter := sourceSnapshotId.(string)
+		listSnapshotOptions.SourceSnapshotID = &sourceSnapshotIdFilter
+	}
+	if sourceSnapshotRemoteRegionName, ok := d.GetOk(isSnapshotSourceSnapshotRemoteRegionName); ok {
+		sourceSnapshotRemoteRegionNameFilter := sourceSnapshotRemoteRegionName.(string)
+		listSnapshotOptions.SourceSnapshotRemoteRegionName = &sourceSnapshotRemoteRegionNameFilter
+	}
+	if sourceVolumeRemoteRegionName, ok := d.GetOk(isSnapshotSourceVolumeRemoteRegionName); ok {
+		sourceVolumeRemoteRegionNameFilter := sourceVolumeRemoteRegionName.(string)
+		listSnapshotOptions.SourceVolumeRemoteRegionName = &sourceVolumeRemoteRegionNameFilter
+	}
 
-		snapshots, response, err := sess.ListSnapshots(listSnapshotOptions)
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error fetching snapshots %s\n%s", err, response)
-		}
-		start = flex.GetNext(snapshots.Next)
-		allrecs = append(allrecs, snapshots.Snapshots...)
-		if start == "" {
-			break
-		}
+	pager, err := sess.NewSnapshotsPager(listSnapshotOptions)
+	if err != nil {
+		return err
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.Snapshot](context.Background(), pager)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error fetching snapshots %s", err)
 	}
 
 	snapshotsInfo := make([]map[string]interface{}, 0)
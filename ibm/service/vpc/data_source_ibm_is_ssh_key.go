@@ -41,6 +41,18 @@ func DataSourceIBMISSSHKey() *schema.Resource {
 				Description: "The ssh key Fingerprint",
 			},
 
+			isKeyFingerprintMD5: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The MD5 fingerprint of the public key, in the format of ssh-keygen -E md5",
+			},
+
+			isKeyFingerprintSHA256: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA256 fingerprint of the public key, in the format of ssh-keygen -E sha256",
+			},
+
 			isKeyPublicKey: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -149,6 +161,7 @@ func keyGetByName(d *schema.ResourceData, meta interface{}, name string) error {
 			}
 			if key.PublicKey != nil {
 				d.Set(isKeyPublicKey, *key.PublicKey)
+				setSSHKeyFingerprints(d, *key.PublicKey)
 			}
 			accesstags, err := flex.GetGlobalTagsUsingCRN(meta, *key.CRN, "", isKeyAccessTagType)
 			if err != nil {
@@ -129,28 +129,16 @@ func dataSourceIBMIsSshKeysRead(context context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	start := ""
-	allrecs := []vpcv1.Key{}
 	listKeysOptions := &vpcv1.ListKeysOptions{}
 
-	for {
-		if start != "" {
-			listKeysOptions.Start = &start
-		}
-
-		keyCollection, response, err := vpcClient.ListKeysWithContext(context, listKeysOptions)
-		if err != nil || keyCollection == nil {
-			log.Printf("[DEBUG] ListKeysWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListKeysWithContext failed %s\n%s", err, response))
-		}
-
-		start = flex.GetNext(keyCollection.Next)
-		allrecs = append(allrecs, keyCollection.Keys...)
-
-		if start == "" {
-			break
-		}
-
+	pager, err := vpcClient.NewKeysPager(listKeysOptions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.Key](context, pager)
+	if err != nil {
+		log.Printf("[DEBUG] ListKeysWithContext failed %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] ListKeysWithContext failed %s", err))
 	}
 
 	d.SetId(dataSourceIBMIsSshKeysID(d))
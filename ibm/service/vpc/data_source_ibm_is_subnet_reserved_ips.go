@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"time"
@@ -125,24 +126,14 @@ func dataSdataSourceIBMISReservedIPsRead(d *schema.ResourceData, meta interface{
 	subnetID := d.Get(isSubNetID).(string)
 
 	// Flatten all the reserved IPs
-	start := ""
-	allrecs := []vpcv1.ReservedIP{}
-	for {
-		options := &vpcv1.ListSubnetReservedIpsOptions{SubnetID: &subnetID}
-
-		if start != "" {
-			options.Start = &start
-		}
-
-		result, response, err := sess.ListSubnetReservedIps(options)
-		if err != nil || response == nil || result == nil {
-			return fmt.Errorf("[ERROR] Error fetching reserved ips %s\n%s", err, response)
-		}
-		start = flex.GetNext(result.Next)
-		allrecs = append(allrecs, result.ReservedIps...)
-		if start == "" {
-			break
-		}
+	options := &vpcv1.ListSubnetReservedIpsOptions{SubnetID: &subnetID}
+	pager, err := sess.NewSubnetReservedIpsPager(options)
+	if err != nil {
+		return err
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.ReservedIP](context.Background(), pager)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error fetching reserved ips %s", err)
 	}
 
 	// Now store all the reserved IP info with their response tags
@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"time"
@@ -159,9 +160,6 @@ func subnetList(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return err
 	}
-	start := ""
-	allrecs := []vpcv1.Subnet{}
-
 	var resourceGroup string
 	if v, ok := d.GetOk(isSubnetResourceGroupID); ok {
 		resourceGroup = v.(string)
@@ -188,19 +186,13 @@ func subnetList(d *schema.ResourceData, meta interface{}) error {
 		options.SetRoutingTableName(resourceTableName)
 	}
 
-	for {
-		if start != "" {
-			options.Start = &start
-		}
-		subnets, response, err := sess.ListSubnets(options)
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error Fetching subnets %s\n%s", err, response)
-		}
-		start = flex.GetNext(subnets.Next)
-		allrecs = append(allrecs, subnets.Subnets...)
-		if start == "" {
-			break
-		}
+	pager, err := sess.NewSubnetsPager(options)
+	if err != nil {
+		return err
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.Subnet](context.Background(), pager)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Fetching subnets %s", err)
 	}
 	subnetsInfo := make([]map[string]interface{}, 0)
 	for _, subnet := range allrecs {
@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -94,22 +95,14 @@ func dataSourceIBMISEndpointGatewayIPsRead(d *schema.ResourceData, meta interfac
 	}
 	gatewayID := d.Get(isVirtualEndpointGatewayID).(string)
 
-	start := ""
-	allrecs := []vpcv1.ReservedIP{}
-	for {
-		options := sess.NewListEndpointGatewayIpsOptions(gatewayID)
-		if start != "" {
-			options.Start = &start
-		}
-		result, response, err := sess.ListEndpointGatewayIps(options)
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error fetching endpoint gateway ips %s\n%s", err, response)
-		}
-		start = flex.GetNext(result.Next)
-		allrecs = append(allrecs, result.Ips...)
-		if start == "" {
-			break
-		}
+	options := sess.NewListEndpointGatewayIpsOptions(gatewayID)
+	pager, err := sess.NewEndpointGatewayIpsPager(options)
+	if err != nil {
+		return err
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.ReservedIP](context.Background(), pager)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error fetching endpoint gateway ips %s", err)
 	}
 	endpointGatewayIPs := []map[string]interface{}{}
 	for _, ip := range allrecs {
@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -178,8 +179,6 @@ func dataSourceIBMISEndpointGatewaysRead(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	start := ""
-	allrecs := []vpcv1.EndpointGateway{}
 	options := sess.NewListEndpointGatewaysOptions()
 	if resgroupintf, ok := d.GetOk("resource_group"); ok {
 		resGroup := resgroupintf.(string)
@@ -189,20 +188,14 @@ func dataSourceIBMISEndpointGatewaysRead(d *schema.ResourceData, meta interface{
 		name := nameintf.(string)
 		options.Name = &name
 	}
-	for {
 
-		if start != "" {
-			options.Start = &start
-		}
-		result, response, err := sess.ListEndpointGateways(options)
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error fetching endpoint gateways %s\n%s", err, response)
-		}
-		start = flex.GetNext(result.Next)
-		allrecs = append(allrecs, result.EndpointGateways...)
-		if start == "" {
-			break
-		}
+	pager, err := sess.NewEndpointGatewaysPager(options)
+	if err != nil {
+		return err
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.EndpointGateway](context.Background(), pager)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error fetching endpoint gateways %s", err)
 	}
 	endpointGateways := []map[string]interface{}{}
 	for _, endpointGateway := range allrecs {
@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -58,22 +59,14 @@ func volumeProfilesList(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	start := ""
-	allrecs := []vpcv1.VolumeProfile{}
-	for {
-		listVolumeProfilesOptions := &vpcv1.ListVolumeProfilesOptions{}
-		if start != "" {
-			listVolumeProfilesOptions.Start = &start
-		}
-		availableProfiles, response, err := sess.ListVolumeProfiles(listVolumeProfilesOptions)
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error Fetching Volume Profiles %s\n%s", err, response)
-		}
-		start = flex.GetNext(availableProfiles.Next)
-		allrecs = append(allrecs, availableProfiles.Profiles...)
-		if start == "" {
-			break
-		}
+	listVolumeProfilesOptions := &vpcv1.ListVolumeProfilesOptions{}
+	pager, err := sess.NewVolumeProfilesPager(listVolumeProfilesOptions)
+	if err != nil {
+		return err
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.VolumeProfile](context.Background(), pager)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Fetching Volume Profiles %s", err)
 	}
 
 	// listVolumeProfilesOptions := &vpcv1.ListVolumeProfilesOptions{}
@@ -611,12 +611,7 @@ func dataSourceIBMIsVolumesRead(context context.Context, d *schema.ResourceData,
 	operatingSystemFamily := d.Get(isVolumesOperatingSystemFamily).(string)
 	operatingSystemArch := d.Get(isVolumesOperatingSystemArch).(string)
 
-	start := ""
-	allrecs := []vpcv1.Volume{}
 	listVolumesOptions := &vpcv1.ListVolumesOptions{}
-	if start != "" {
-		listVolumesOptions.Start = &start
-	}
 	if volumeName != "" {
 		listVolumesOptions.Name = &volumeName
 	}
@@ -637,23 +632,14 @@ func dataSourceIBMIsVolumesRead(context context.Context, d *schema.ResourceData,
 	}
 
 	// list
-	for {
-		if start != "" {
-			listVolumesOptions.Start = &start
-		}
-		volumeCollection, response, err := vpcClient.ListVolumesWithContext(context, listVolumesOptions)
-		if err != nil {
-			log.Printf("[DEBUG] ListVolumesWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListVolumesWithContext failed %s\n%s", err, response))
-		}
-
-		start = flex.GetNext(volumeCollection.Next)
-		allrecs = append(allrecs, volumeCollection.Volumes...)
-
-		if start == "" {
-			break
-		}
-
+	pager, err := vpcClient.NewVolumesPager(listVolumesOptions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.Volume](context, pager)
+	if err != nil {
+		log.Printf("[DEBUG] ListVolumesWithContext failed %s", err)
+		return diag.FromErr(fmt.Errorf("ListVolumesWithContext failed %s", err))
 	}
 
 	d.SetId(dataSourceIBMIsVolumesID(d))
@@ -106,26 +106,18 @@ func dataSourceIbmIsVpcAddressPrefixRead(context context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 
-	start := ""
-	allrecs := []vpcv1.AddressPrefix{}
-	for {
-		listVpcAddressPrefixesOptions := &vpcv1.ListVPCAddressPrefixesOptions{}
+	listVpcAddressPrefixesOptions := &vpcv1.ListVPCAddressPrefixesOptions{}
 
-		listVpcAddressPrefixesOptions.SetVPCID(d.Get("vpc").(string))
+	listVpcAddressPrefixesOptions.SetVPCID(d.Get("vpc").(string))
 
-		if start != "" {
-			listVpcAddressPrefixesOptions.Start = &start
-		}
-		addressPrefixCollection, response, err := vpcClient.ListVPCAddressPrefixesWithContext(context, listVpcAddressPrefixesOptions)
-		if err != nil {
-			log.Printf("[DEBUG] ListVpcAddressPrefixesWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListVpcAddressPrefixesWithContext failed %s\n%s", err, response))
-		}
-		start = flex.GetNext(addressPrefixCollection.Next)
-		allrecs = append(allrecs, addressPrefixCollection.AddressPrefixes...)
-		if start == "" {
-			break
-		}
+	pager, err := vpcClient.NewVPCAddressPrefixesPager(listVpcAddressPrefixesOptions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.AddressPrefix](context, pager)
+	if err != nil {
+		log.Printf("[DEBUG] ListVpcAddressPrefixesWithContext failed %s", err)
+		return diag.FromErr(fmt.Errorf("ListVpcAddressPrefixesWithContext failed %s", err))
 	}
 
 	// Use the provided filter argument and construct a new list with only the requested resource(s)
@@ -254,23 +254,15 @@ func dataSourceIBMIsVPCDnsResolutionBindingsRead(context context.Context, d *sch
 	listVPCDnsResolutionBindingOptions := &vpcv1.ListVPCDnsResolutionBindingsOptions{}
 
 	listVPCDnsResolutionBindingOptions.SetVPCID(d.Get(isVPCDnsResolutionBindingVpcId).(string))
-	start := ""
-	allrecs := []vpcv1.VpcdnsResolutionBinding{}
 
-	for {
-		if start != "" {
-			listVPCDnsResolutionBindingOptions.Start = &start
-		}
-		vpcdnsResolutionBindingCollection, response, err := sess.ListVPCDnsResolutionBindingsWithContext(context, listVPCDnsResolutionBindingOptions)
-		if err != nil {
-			log.Printf("[DEBUG] ListVPCDnsResolutionBindingsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ListVPCDnsResolutionBindingsWithContext failed %s\n%s", err, response))
-		}
-		start = flex.GetNext(vpcdnsResolutionBindingCollection.Next)
-		allrecs = append(allrecs, vpcdnsResolutionBindingCollection.DnsResolutionBindings...)
-		if start == "" {
-			break
-		}
+	pager, err := sess.NewVPCDnsResolutionBindingsPager(listVPCDnsResolutionBindingOptions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.VpcdnsResolutionBinding](context, pager)
+	if err != nil {
+		log.Printf("[DEBUG] ListVPCDnsResolutionBindingsWithContext failed %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] ListVPCDnsResolutionBindingsWithContext failed %s", err))
 	}
 	vpcdnsResolutionBindingsInfo := make([]map[string]interface{}, 0)
 	if len(allrecs) != 0 {
@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -166,23 +167,15 @@ func dataSourceIBMISVPCRoutingTableRoutesList(d *schema.ResourceData, meta inter
 
 	vpcID := d.Get(isRoutingTableRouteVpcID).(string)
 	routingTableID := d.Get(isRouteTableID).(string)
-	start := ""
-	allrecs := []vpcv1.Route{}
-	for {
-		listVpcRoutingTablesRoutesOptions := sess.NewListVPCRoutingTableRoutesOptions(vpcID, routingTableID)
-		if start != "" {
-			listVpcRoutingTablesRoutesOptions.Start = &start
-		}
-		result, detail, err := sess.ListVPCRoutingTableRoutes(listVpcRoutingTablesRoutesOptions)
-		if err != nil {
-			log.Printf("Error reading list of VPC Routing Table Routes:%s\n%s", err, detail)
-			return err
-		}
-		start = flex.GetNext(result.Next)
-		allrecs = append(allrecs, result.Routes...)
-		if start == "" {
-			break
-		}
+	listVpcRoutingTablesRoutesOptions := sess.NewListVPCRoutingTableRoutesOptions(vpcID, routingTableID)
+	pager, err := sess.NewVPCRoutingTableRoutesPager(listVpcRoutingTablesRoutesOptions)
+	if err != nil {
+		return err
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.Route](context.Background(), pager)
+	if err != nil {
+		log.Printf("Error reading list of VPC Routing Table Routes:%s", err)
+		return err
 	}
 
 	vpcRoutingTableRoutes := make([]map[string]interface{}, 0)
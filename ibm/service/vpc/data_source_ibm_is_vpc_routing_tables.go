@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	//"encoding/json"
 
 	"log"
@@ -180,22 +181,14 @@ func dataSourceIBMISVPCRoutingTablesList(d *schema.ResourceData, meta interface{
 		isDefault := isDefaultIntf.(bool)
 		listOptions.IsDefault = &isDefault
 	}
-	start := ""
-	allrecs := []vpcv1.RoutingTable{}
-	for {
-		if start != "" {
-			listOptions.Start = &start
-		}
-		result, detail, err := sess.ListVPCRoutingTables(listOptions)
-		if err != nil {
-			log.Printf("Error reading list of VPC Routing Tables:%s\n%s", err, detail)
-			return err
-		}
-		start = flex.GetNext(result.Next)
-		allrecs = append(allrecs, result.RoutingTables...)
-		if start == "" {
-			break
-		}
+	pager, err := sess.NewVPCRoutingTablesPager(listOptions)
+	if err != nil {
+		return err
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.RoutingTable](context.Background(), pager)
+	if err != nil {
+		log.Printf("Error reading list of VPC Routing Tables:%s", err)
+		return err
 	}
 
 	vpcRoutingTables := make([]map[string]interface{}, 0)
@@ -513,8 +513,6 @@ func dataSourceIBMISVPCListRead(context context.Context, d *schema.ResourceData,
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	start := ""
-	allrecs := []vpcv1.VPC{}
 	listOptions := &vpcv1.ListVpcsOptions{}
 	if resgroupintf, ok := d.GetOk("resource_group"); ok {
 		resGroup := resgroupintf.(string)
@@ -524,21 +522,15 @@ func dataSourceIBMISVPCListRead(context context.Context, d *schema.ResourceData,
 		classicAccess := classicAccessIntf.(bool)
 		listOptions.ClassicAccess = &classicAccess
 	}
-	for {
 
-		if start != "" {
-			listOptions.Start = &start
-		}
-		result, detail, err := sess.ListVpcsWithContext(context, listOptions)
-		if err != nil {
-			log.Printf("Error reading list of VPCs:%s\n%s", err, detail)
-			return diag.FromErr(err)
-		}
-		start = flex.GetNext(result.Next)
-		allrecs = append(allrecs, result.Vpcs...)
-		if start == "" {
-			break
-		}
+	pager, err := sess.NewVpcsPager(listOptions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.VPC](context, pager)
+	if err != nil {
+		log.Printf("Error reading list of VPCs:%s", err)
+		return diag.FromErr(err)
 	}
 
 	vpcs := make([]map[string]interface{}, 0)
@@ -609,22 +601,14 @@ func dataSourceIBMISVPCListRead(context context.Context, d *schema.ResourceData,
 
 		// adding pagination support for subnets inside vpc
 
-		startSub := ""
-		allrecsSub := []vpcv1.Subnet{}
 		options := &vpcv1.ListSubnetsOptions{}
-		for {
-			if startSub != "" {
-				options.Start = &startSub
-			}
-			s, response, err := sess.ListSubnetsWithContext(context, options)
-			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error fetching subnets %s\n%s", err, response))
-			}
-			startSub = flex.GetNext(s.Next)
-			allrecsSub = append(allrecsSub, s.Subnets...)
-			if startSub == "" {
-				break
-			}
+		subnetsPager, err := sess.NewSubnetsPager(options)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		allrecsSub, err := flex.PaginateAll[vpcv1.Subnet](context, subnetsPager)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error fetching subnets %s", err))
 		}
 		if err == nil {
 			subnetsInfo := make([]map[string]interface{}, 0)
@@ -646,31 +630,16 @@ func dataSourceIBMISVPCListRead(context context.Context, d *schema.ResourceData,
 
 		// adding pagination support for sg inside vpc
 
-		startSg := ""
-		allrecsSg := []vpcv1.SecurityGroup{}
-
-		for {
-			vpcId := vpc.ID
-			listSgOptions := &vpcv1.ListSecurityGroupsOptions{
-				VPCID: vpcId,
-			}
-			if startSg != "" {
-				listSgOptions.Start = &start
-			}
-			sgs, response, err := sess.ListSecurityGroupsWithContext(context, listSgOptions)
-			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error fetching Security Groups %s\n%s", err, response))
-			}
-			if *sgs.TotalCount == int64(0) {
-				break
-			}
-			start = flex.GetNext(sgs.Next)
-			allrecsSg = append(allrecsSg, sgs.SecurityGroups...)
-
-			if startSg == "" {
-				break
-			}
-
+		listSgOptions := &vpcv1.ListSecurityGroupsOptions{
+			VPCID: vpc.ID,
+		}
+		sgPager, err := sess.NewSecurityGroupsPager(listSgOptions)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		allrecsSg, err := flex.PaginateAll[vpcv1.SecurityGroup](context, sgPager)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error fetching Security Groups %s", err))
 		}
 
 		securityGroupList := make([]map[string]interface{}, 0)
@@ -245,6 +245,103 @@ func DataSourceIBMISVPNGatewayConnection() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"distribute_traffic": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether the traffic is distributed between the `up` tunnels of the VPN gateway connection, in static route mode.",
+			},
+			"establish_mode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The establish mode of the VPN gateway connection.",
+			},
+			"local": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The local configuration of the VPN gateway connection.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidrs": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The local CIDRs for this resource.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"ike_identities": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The local IKE identities.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The IKE identity type.",
+									},
+									"value": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The IKE identity value.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"peer": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The peer configuration of the VPN gateway connection.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address of the peer VPN gateway.",
+						},
+						"fqdn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The FQDN of the peer VPN gateway.",
+						},
+						"cidrs": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The peer CIDRs for this resource.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"ike_identity": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The peer IKE identity.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The IKE identity type.",
+									},
+									"value": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The IKE identity value.",
+									},
+								},
+							},
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Indicates whether the peer is specified by `address` or by `fqdn`.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -259,8 +356,6 @@ func dataSourceIBMIsVPNGatewayConnectionRead(context context.Context, d *schema.
 	vpn_gateway_connection := d.Get("vpn_gateway_connection").(string)
 	vpn_gateway_connection_name := d.Get("vpn_gateway_connection_name").(string)
 
-	vpnGatewayConnection := &vpcv1.VPNGatewayConnection{}
-
 	if vpn_gateway_name != "" {
 		listvpnGWOptions := vpcClient.NewListVPNGatewaysOptions()
 
@@ -307,7 +402,7 @@ func dataSourceIBMIsVPNGatewayConnectionRead(context context.Context, d *schema.
 		for _, connectionItem := range availableVPNGatewayConnections.Connections {
 			connection := connectionItem.(*vpcv1.VPNGatewayConnection)
 			if *connection.Name == vpn_gateway_connection_name {
-				vpnGatewayConnection = connection
+				vpn_gateway_connection = *connection.ID
 				vpn_gateway_conn_found = true
 				break
 			}
@@ -315,18 +410,12 @@ func dataSourceIBMIsVPNGatewayConnectionRead(context context.Context, d *schema.
 		if !vpn_gateway_conn_found {
 			return diag.FromErr(fmt.Errorf("VPN gateway connection %s not found", vpn_gateway_connection_name))
 		}
-	} else if vpn_gateway_connection != "" {
-		getVPNGatewayConnectionOptions := &vpcv1.GetVPNGatewayConnectionOptions{}
-
-		getVPNGatewayConnectionOptions.SetVPNGatewayID(vpn_gateway_id)
-		getVPNGatewayConnectionOptions.SetID(vpn_gateway_connection)
-
-		vpnGatewayConnectionIntf, response, err := vpcClient.GetVPNGatewayConnectionWithContext(context, getVPNGatewayConnectionOptions)
-		if err != nil || vpnGatewayConnectionIntf.(*vpcv1.VPNGatewayConnection) == nil {
-			log.Printf("[DEBUG] GetVPNGatewayConnectionWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("GetVPNGatewayConnectionWithContext failed %s\n%s", err, response))
-		}
-		vpnGatewayConnection = vpnGatewayConnectionIntf.(*vpcv1.VPNGatewayConnection)
+	}
+	// The connection is read with its local and peer configurations, which aren't in the model of the VPC SDK
+	vpnGatewayConnection, connectionConfig, response, err := getVPNGatewayConnection(context, vpcClient, vpn_gateway_id, vpn_gateway_connection)
+	if err != nil {
+		log.Printf("[DEBUG] GetVPNGatewayConnectionWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetVPNGatewayConnectionWithContext failed %s\n%s", err, response))
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", vpn_gateway_id, *vpnGatewayConnection.ID))
@@ -406,6 +495,42 @@ func dataSourceIBMIsVPNGatewayConnectionRead(context context.Context, d *schema.
 			return diag.FromErr(fmt.Errorf("Error setting Peer CIDRs %s", err))
 		}
 	}
+	if err = d.Set("distribute_traffic", connectionConfig.DistributeTraffic); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting distribute_traffic: %s", err))
+	}
+	if err = d.Set("establish_mode", connectionConfig.EstablishMode); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting establish_mode: %s", err))
+	}
+	if connectionConfig.Local != nil {
+		local := map[string]interface{}{
+			"cidrs":          connectionConfig.Local.CIDRs,
+			"ike_identities": flattenVPNGatewayConnectionIkeIdentities(connectionConfig.Local.IkeIdentities),
+		}
+		if err = d.Set("local", []map[string]interface{}{local}); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting local: %s", err))
+		}
+	}
+	if connectionConfig.Peer != nil {
+		peer := map[string]interface{}{
+			"cidrs":        connectionConfig.Peer.CIDRs,
+			"ike_identity": []interface{}{},
+		}
+		if connectionConfig.Peer.Address != nil {
+			peer["address"] = *connectionConfig.Peer.Address
+		}
+		if connectionConfig.Peer.Fqdn != nil {
+			peer["fqdn"] = *connectionConfig.Peer.Fqdn
+		}
+		if connectionConfig.Peer.IkeIdentity != nil {
+			peer["ike_identity"] = flattenVPNGatewayConnectionIkeIdentities([]vpnGatewayConnectionIkeIdentity{*connectionConfig.Peer.IkeIdentity})
+		}
+		if connectionConfig.Peer.Type != nil {
+			peer["type"] = *connectionConfig.Peer.Type
+		}
+		if err = d.Set("peer", []map[string]interface{}{peer}); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting peer: %s", err))
+		}
+	}
 	return nil
 }
 
@@ -199,7 +199,10 @@ func dataSourceIBMVPNGatewayConnectionsRead(d *schema.ResourceData, meta interfa
 		}
 		gatewayconnection[isVPNGatewayConnectionMode] = *data.Mode
 		gatewayconnection[isVPNGatewayConnectionName] = *data.Name
-		gatewayconnection[isVPNGatewayConnectionPeerAddress] = *data.PeerAddress
+		// The connections with a peer FQDN don't have a peer address
+		if data.PeerAddress != nil {
+			gatewayconnection[isVPNGatewayConnectionPeerAddress] = *data.PeerAddress
+		}
 		gatewayconnection[isVPNGatewayConnectionResourcetype] = *data.ResourceType
 		gatewayconnection[isVPNGatewayConnectionStatus] = *data.Status
 		//if data.Tunnels != nil {
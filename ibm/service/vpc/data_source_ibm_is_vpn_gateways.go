@@ -4,6 +4,7 @@
 package vpc
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -194,21 +195,13 @@ func dataSourceIBMVPNGatewaysRead(d *schema.ResourceData, meta interface{}) erro
 		mode := modeIntf.(string)
 		listvpnGWOptions.Mode = &mode
 	}
-	start := ""
-	allrecs := []vpcv1.VPNGatewayIntf{}
-	for {
-		if start != "" {
-			listvpnGWOptions.Start = &start
-		}
-		availableVPNGateways, detail, err := sess.ListVPNGateways(listvpnGWOptions)
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error reading list of VPN Gateways:%s\n%s", err, detail)
-		}
-		start = flex.GetNext(availableVPNGateways.Next)
-		allrecs = append(allrecs, availableVPNGateways.VPNGateways...)
-		if start == "" {
-			break
-		}
+	pager, err := sess.NewVPNGatewaysPager(listvpnGWOptions)
+	if err != nil {
+		return err
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.VPNGatewayIntf](context.Background(), pager)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error reading list of VPN Gateways %s", err)
 	}
 
 	vpngateways := make([]map[string]interface{}, 0)
@@ -0,0 +1,210 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The algorithms of the IKE and IPsec policies, from the enums of the VPC API. The values that are not in these lists
+// are passed to the API with a warning instead of being rejected, so that newly supported algorithms can be used. The
+// values that don't have the format of their kind of algorithm, e.g. sha265, are typos and are rejected.
+var (
+	isVPNIKEAuthenticationAlgorithms = []string{
+		vpcv1.IkePolicyAuthenticationAlgorithmMd5Const,
+		vpcv1.IkePolicyAuthenticationAlgorithmSha1Const,
+		vpcv1.IkePolicyAuthenticationAlgorithmSha256Const,
+		vpcv1.IkePolicyAuthenticationAlgorithmSha384Const,
+		vpcv1.IkePolicyAuthenticationAlgorithmSha512Const,
+	}
+	isVPNIKEEncryptionAlgorithms = []string{
+		vpcv1.IkePolicyEncryptionAlgorithmAes128Const,
+		vpcv1.IkePolicyEncryptionAlgorithmAes192Const,
+		vpcv1.IkePolicyEncryptionAlgorithmAes256Const,
+		vpcv1.IkePolicyEncryptionAlgorithmTripleDesConst,
+	}
+	isVPNIKEDhGroups = []string{"2", "5", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "31"}
+
+	isVPNIPsecAuthenticationAlgorithms = []string{
+		vpcv1.IPsecPolicyAuthenticationAlgorithmDisabledConst,
+		vpcv1.IPsecPolicyAuthenticationAlgorithmMd5Const,
+		vpcv1.IPsecPolicyAuthenticationAlgorithmSha1Const,
+		vpcv1.IPsecPolicyAuthenticationAlgorithmSha256Const,
+		vpcv1.IPsecPolicyAuthenticationAlgorithmSha384Const,
+		vpcv1.IPsecPolicyAuthenticationAlgorithmSha512Const,
+	}
+	isVPNIPsecEncryptionAlgorithms = []string{
+		vpcv1.IPsecPolicyEncryptionAlgorithmAes128Const,
+		vpcv1.IPsecPolicyEncryptionAlgorithmAes128gcm16Const,
+		vpcv1.IPsecPolicyEncryptionAlgorithmAes192Const,
+		vpcv1.IPsecPolicyEncryptionAlgorithmAes192gcm16Const,
+		vpcv1.IPsecPolicyEncryptionAlgorithmAes256Const,
+		vpcv1.IPsecPolicyEncryptionAlgorithmAes256gcm16Const,
+		vpcv1.IPsecPolicyEncryptionAlgorithmTripleDesConst,
+	}
+	isVPNIPsecPfsGroups = []string{
+		vpcv1.IPsecPolicyPfsDisabledConst,
+		vpcv1.IPsecPolicyPfsGroup2Const,
+		vpcv1.IPsecPolicyPfsGroup5Const,
+		vpcv1.IPsecPolicyPfsGroup14Const,
+		vpcv1.IPsecPolicyPfsGroup15Const,
+		vpcv1.IPsecPolicyPfsGroup16Const,
+		vpcv1.IPsecPolicyPfsGroup17Const,
+		vpcv1.IPsecPolicyPfsGroup18Const,
+		vpcv1.IPsecPolicyPfsGroup19Const,
+		vpcv1.IPsecPolicyPfsGroup20Const,
+		vpcv1.IPsecPolicyPfsGroup21Const,
+		vpcv1.IPsecPolicyPfsGroup22Const,
+		vpcv1.IPsecPolicyPfsGroup23Const,
+		vpcv1.IPsecPolicyPfsGroup24Const,
+		vpcv1.IPsecPolicyPfsGroup31Const,
+	}
+
+	// The formats of the authentication algorithms, the encryption algorithms and the groups
+	isVPNAuthenticationAlgorithmFormat = regexp.MustCompile(`^(disabled|md5|sha1|sha(224|256|384|512)|sha3_(224|256|384|512))$`)
+	isVPNEncryptionAlgorithmFormat     = regexp.MustCompile(`^(triple_des|aes(128|192|256)(gcm(8|12|16))?)$`)
+	isVPNDhGroupFormat                 = regexp.MustCompile(`^[1-9][0-9]*$`)
+	isVPNPfsGroupFormat                = regexp.MustCompile(`^(disabled|group_[1-9][0-9]*)$`)
+
+	// isVPNDeprecatedAlgorithms are the algorithms and groups that the VPC API has deprecated
+	isVPNDeprecatedAlgorithms = []string{
+		vpcv1.IkePolicyAuthenticationAlgorithmMd5Const,
+		vpcv1.IkePolicyAuthenticationAlgorithmSha1Const,
+		vpcv1.IkePolicyEncryptionAlgorithmTripleDesConst,
+		"2",
+		"5",
+		vpcv1.IPsecPolicyPfsGroup2Const,
+		vpcv1.IPsecPolicyPfsGroup5Const,
+	}
+)
+
+// validateISVPNAlgorithm returns a validation function that rejects the values that don't have the format of their
+// kind of algorithm, and warns about the deprecated values and the values that are not in the known values, the API
+// validates them.
+func validateISVPNAlgorithm(known []string, format *regexp.Regexp) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (warnings []string, errors []error) {
+		var value string
+		switch v := i.(type) {
+		case int:
+			value = strconv.Itoa(v)
+		case string:
+			value = v
+		default:
+			errors = append(errors, fmt.Errorf("expected type of %s to be string or int", k))
+			return
+		}
+		if !format.MatchString(value) {
+			errors = append(errors, fmt.Errorf("%s %s is not a valid value, the known values are %v", k, value, known))
+			return
+		}
+		if isVPNAlgorithmIn(value, isVPNDeprecatedAlgorithms) {
+			warnings = append(warnings, fmt.Sprintf("%s %s is deprecated by the VPC API", k, value))
+		} else if !isVPNAlgorithmIn(value, known) {
+			warnings = append(warnings, fmt.Sprintf("%s %s is not one of the known values %v, the VPC API validates it", k, value, known))
+		}
+		return
+	}
+}
+
+func isVPNAlgorithmIn(value string, values []string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func DataSourceIBMIsVPNPolicyAlgorithms() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsVPNPolicyAlgorithmsRead,
+
+		Schema: map[string]*schema.Schema{
+			"ike_authentication_algorithms": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The authentication algorithms of the IKE policies.",
+			},
+			"ike_encryption_algorithms": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The encryption algorithms of the IKE policies.",
+			},
+			"ike_dh_groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The Diffie-Hellman groups of the IKE policies.",
+			},
+			"ipsec_authentication_algorithms": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The authentication algorithms of the IPsec policies.",
+			},
+			"ipsec_encryption_algorithms": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The encryption algorithms of the IPsec policies.",
+			},
+			"ipsec_pfs_groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The Perfect Forward Secrecy groups of the IPsec policies.",
+			},
+			"deprecated": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The algorithms and groups that are deprecated.",
+			},
+		},
+	}
+}
+
+// dataSourceIBMIsVPNPolicyAlgorithmsRead doesn't call the VPC API, the algorithms are the enums of the VPC SDK the
+// provider is built with.
+func dataSourceIBMIsVPNPolicyAlgorithmsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("vpn_policy_algorithms")
+
+	dhGroups := make([]int, 0, len(isVPNIKEDhGroups))
+	for _, group := range isVPNIKEDhGroups {
+		dhGroup, _ := strconv.Atoi(group)
+		dhGroups = append(dhGroups, dhGroup)
+	}
+
+	if err := d.Set("ike_authentication_algorithms", isVPNIKEAuthenticationAlgorithms); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting ike_authentication_algorithms %s", err))
+	}
+	if err := d.Set("ike_encryption_algorithms", isVPNIKEEncryptionAlgorithms); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting ike_encryption_algorithms %s", err))
+	}
+	if err := d.Set("ike_dh_groups", dhGroups); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting ike_dh_groups %s", err))
+	}
+	if err := d.Set("ipsec_authentication_algorithms", isVPNIPsecAuthenticationAlgorithms); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting ipsec_authentication_algorithms %s", err))
+	}
+	if err := d.Set("ipsec_encryption_algorithms", isVPNIPsecEncryptionAlgorithms); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting ipsec_encryption_algorithms %s", err))
+	}
+	if err := d.Set("ipsec_pfs_groups", isVPNIPsecPfsGroups); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting ipsec_pfs_groups %s", err))
+	}
+	if err := d.Set("deprecated", isVPNDeprecatedAlgorithms); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting deprecated %s", err))
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIsVPNPolicyAlgorithmsDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsVPNPolicyAlgorithmsDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.ibm_is_vpn_policy_algorithms.example", "ike_authentication_algorithms.*", "sha512"),
+					resource.TestCheckTypeSetElemAttr("data.ibm_is_vpn_policy_algorithms.example", "ike_dh_groups.*", "31"),
+					resource.TestCheckTypeSetElemAttr("data.ibm_is_vpn_policy_algorithms.example", "ipsec_encryption_algorithms.*", "aes256gcm16"),
+					resource.TestCheckTypeSetElemAttr("data.ibm_is_vpn_policy_algorithms.example", "ipsec_pfs_groups.*", "group_19"),
+					resource.TestCheckTypeSetElemAttr("data.ibm_is_vpn_policy_algorithms.example", "deprecated.*", "md5"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsVPNPolicyAlgorithmsDataSourceConfigBasic() string {
+	return `
+		data "ibm_is_vpn_policy_algorithms" "example" {
+		}
+	`
+}
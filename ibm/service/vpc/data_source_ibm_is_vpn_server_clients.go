@@ -118,25 +118,16 @@ func dataSourceIBMIsVPNServerClientsRead(context context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 
-	start := ""
-	allrecs := []vpcv1.VPNServerClient{}
-
-	for {
-		listVPNServerClientsOptions := &vpcv1.ListVPNServerClientsOptions{}
-		listVPNServerClientsOptions.SetVPNServerID(d.Get("vpn_server").(string))
-		if start != "" {
-			listVPNServerClientsOptions.Start = &start
-		}
-		vpnServerClientCollection, response, err := sess.ListVPNServerClientsWithContext(context, listVPNServerClientsOptions)
-		if err != nil {
-			log.Printf("[DEBUG] ListVPNServerClientsWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] ListVPNServerClientsWithContext failed %s\n%s", err, response))
-		}
-		start = flex.GetNext(vpnServerClientCollection.Next)
-		allrecs = append(allrecs, vpnServerClientCollection.Clients...)
-		if start == "" {
-			break
-		}
+	listVPNServerClientsOptions := &vpcv1.ListVPNServerClientsOptions{}
+	listVPNServerClientsOptions.SetVPNServerID(d.Get("vpn_server").(string))
+	pager, err := sess.NewVPNServerClientsPager(listVPNServerClientsOptions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.VPNServerClient](context, pager)
+	if err != nil {
+		log.Printf("[DEBUG] ListVPNServerClientsWithContext failed %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] ListVPNServerClientsWithContext failed %s", err))
 	}
 
 	d.SetId(dataSourceIBMIsVPNServerClientsID(d))
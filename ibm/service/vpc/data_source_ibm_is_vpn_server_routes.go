@@ -85,26 +85,17 @@ func dataSourceIBMIsVPNServerRoutesRead(context context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
-	start := ""
-	allrecs := []vpcv1.VPNServerRoute{}
+	listVPNServerRoutesOptions := &vpcv1.ListVPNServerRoutesOptions{}
+	listVPNServerRoutesOptions.SetVPNServerID(d.Get("vpn_server").(string))
 
-	for {
-		listVPNServerRoutesOptions := &vpcv1.ListVPNServerRoutesOptions{}
-		listVPNServerRoutesOptions.SetVPNServerID(d.Get("vpn_server").(string))
-
-		if start != "" {
-			listVPNServerRoutesOptions.Start = &start
-		}
-		vpnServerRouteCollection, response, err := sess.ListVPNServerRoutesWithContext(context, listVPNServerRoutesOptions)
-		if err != nil {
-			log.Printf("[DEBUG] ListVPNServerRoutesWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] ListVPNServerRoutesWithContext failed %s\n%s", err, response))
-		}
-		start = flex.GetNext(vpnServerRouteCollection.Next)
-		allrecs = append(allrecs, vpnServerRouteCollection.Routes...)
-		if start == "" {
-			break
-		}
+	pager, err := sess.NewVPNServerRoutesPager(listVPNServerRoutesOptions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.VPNServerRoute](context, pager)
+	if err != nil {
+		log.Printf("[DEBUG] ListVPNServerRoutesWithContext failed %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] ListVPNServerRoutesWithContext failed %s", err))
 	}
 
 	d.SetId(dataSourceIBMIsVPNServerRoutesID(d))
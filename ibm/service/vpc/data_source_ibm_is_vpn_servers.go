@@ -393,28 +393,19 @@ func dataSourceIBMIsVPNServersRead(context context.Context, d *schema.ResourceDa
 
 	resourceGrp := d.Get("resource_group_id").(string)
 
-	start := ""
-	allrecs := []vpcv1.VPNServer{}
-
-	for {
-		listVPNServersOptions := &vpcv1.ListVPNServersOptions{}
-		if resourceGrp != "" {
-			listVPNServersOptions.ResourceGroupID = &resourceGrp
-		}
+	listVPNServersOptions := &vpcv1.ListVPNServersOptions{}
+	if resourceGrp != "" {
+		listVPNServersOptions.ResourceGroupID = &resourceGrp
+	}
 
-		if start != "" {
-			listVPNServersOptions.Start = &start
-		}
-		vpnServerCollection, response, err := sess.ListVPNServersWithContext(context, listVPNServersOptions)
-		if err != nil {
-			log.Printf("[DEBUG] ListVPNServersWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("[ERROR] ListVPNServersWithContext failed %s\n%s", err, response))
-		}
-		start = flex.GetNext(vpnServerCollection.Next)
-		allrecs = append(allrecs, vpnServerCollection.VPNServers...)
-		if start == "" {
-			break
-		}
+	pager, err := sess.NewVPNServersPager(listVPNServersOptions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allrecs, err := flex.PaginateAll[vpcv1.VPNServer](context, pager)
+	if err != nil {
+		log.Printf("[DEBUG] ListVPNServersWithContext failed %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] ListVPNServersWithContext failed %s", err))
 	}
 
 	d.SetId(dataSourceIBMIsVPNServersID(d))
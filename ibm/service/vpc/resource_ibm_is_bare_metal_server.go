@@ -117,6 +117,11 @@ func ResourceIBMIsBareMetalServer() *schema.Resource {
 					return flex.ResourceValidateAccessTags(diff, v)
 				},
 			),
+			customdiff.Sequence(
+				func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMIsBareMetalServerProfileValidate(ctx, diff, v)
+				},
+			),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -2877,3 +2882,62 @@ func resourceIBMIsBareMetalServerBareMetalServerTrustedPlatformModulePrototypeTo
 	}
 	return modelMap, nil
 }
+
+// resourceIBMIsBareMetalServerProfileValidate checks the bare metal server against its profile at plan
+// time, as the s390x (z/Architecture) profiles only support a subset of the bare metal server features.
+func resourceIBMIsBareMetalServerProfileValidate(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if meta == nil || diff.Id() != "" {
+		return nil
+	}
+	profileName := diff.Get(isBareMetalServerProfile).(string)
+	if profileName == "" || !diff.NewValueKnown(isBareMetalServerProfile) {
+		return nil
+	}
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	profile, response, err := sess.GetBareMetalServerProfileWithContext(ctx, &vpcv1.GetBareMetalServerProfileOptions{
+		Name: &profileName,
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return fmt.Errorf("[ERROR] Bare metal server profile %s not found", profileName)
+		}
+		log.Printf("[WARN] Error getting bare metal server profile %s, skipping the profile validation: %s\n%s", profileName, err, response)
+		return nil
+	}
+
+	if tpmMode, ok := diff.GetOk("trusted_platform_module.0.mode"); ok && profile.SupportedTrustedPlatformModuleModes != nil {
+		supported := false
+		for _, mode := range profile.SupportedTrustedPlatformModuleModes.Values {
+			supported = supported || mode == tpmMode.(string)
+		}
+		if !supported {
+			return fmt.Errorf("[ERROR] Trusted platform module mode %s is not supported by the bare metal server profile %s (%s)",
+				tpmMode.(string), profileName, strings.Join(profile.SupportedTrustedPlatformModuleModes.Values, ", "))
+		}
+	}
+
+	if profile.CpuArchitecture == nil || profile.CpuArchitecture.Value == nil || *profile.CpuArchitecture.Value != "s390x" {
+		return nil
+	}
+	if diff.Get(isBareMetalServerEnableSecureBoot).(bool) {
+		return fmt.Errorf("[ERROR] Secure boot is not supported by the s390x bare metal server profile %s", profileName)
+	}
+	if interfaceType := diff.Get("primary_network_interface.0.interface_type").(string); interfaceType != "" && interfaceType != "hipersocket" {
+		return fmt.Errorf("[ERROR] The s390x bare metal server profile %s only supports hipersocket network interfaces, got %s for the primary network interface", profileName, interfaceType)
+	}
+	if nics, ok := diff.GetOk(isBareMetalServerNetworkInterfaces); ok {
+		for _, nicIntf := range nics.(*schema.Set).List() {
+			nic := nicIntf.(map[string]interface{})
+			if interfaceType, ok := nic[isBareMetalServerNicInterfaceType].(string); ok && interfaceType != "" && interfaceType != "hipersocket" {
+				return fmt.Errorf("[ERROR] The s390x bare metal server profile %s only supports hipersocket network interfaces, got %s for the network interface %s", profileName, interfaceType, nic[isBareMetalServerNicName])
+			}
+			if vlans, ok := nic[isBareMetalServerNicAllowedVlans].(*schema.Set); ok && vlans.Len() > 0 {
+				return fmt.Errorf("[ERROR] The s390x bare metal server profile %s does not support allowed_vlans, which are only available to pci network interfaces", profileName)
+			}
+		}
+	}
+	return nil
+}
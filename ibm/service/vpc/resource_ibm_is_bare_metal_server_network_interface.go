@@ -31,7 +31,7 @@ const (
 )
 
 func ResourceIBMIsBareMetalServerNetworkInterface() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithImportDefaults(&schema.Resource{
 		CreateContext: resourceIBMISBareMetalServerNetworkInterfaceCreate,
 		ReadContext:   resourceIBMISBareMetalServerNetworkInterfaceRead,
 		UpdateContext: resourceIBMISBareMetalServerNetworkInterfaceUpdate,
@@ -230,7 +230,7 @@ func ResourceIBMIsBareMetalServerNetworkInterface() *schema.Resource {
 				Description:   "Indicates the 802.1Q VLAN ID tag that must be used for all traffic on this interface",
 			},
 		},
-	}
+	})
 }
 
 func ResourceIBMIsBareMetalServerNetworkInterfaceValidator() *validate.ResourceValidator {
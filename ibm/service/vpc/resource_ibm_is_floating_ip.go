@@ -9,6 +9,7 @@ import (
 	"log"
 	"os"
 	"reflect"
+	"regexp"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -36,10 +37,16 @@ const (
 	isFloatingIPDeleted   = "done"
 
 	isFloatingIPAccessTags = "access_tags"
+	isFloatingIPAutoDelete = "auto_delete"
+)
+
+var (
+	isFloatingIPInstanceNicHrefRegexp        = regexp.MustCompile(`/instances/([^/]+)/network_interfaces/([^/]+)$`)
+	isFloatingIPBareMetalServerNicHrefRegexp = regexp.MustCompile(`/bare_metal_servers/([^/]+)/network_interfaces/([^/]+)$`)
 )
 
 func ResourceIBMISFloatingIP() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithImportDefaults(&schema.Resource{
 		Create:   resourceIBMISFloatingIPCreate,
 		Read:     resourceIBMISFloatingIPRead,
 		Update:   resourceIBMISFloatingIPUpdate,
@@ -66,11 +73,9 @@ func ResourceIBMISFloatingIP() *schema.Resource {
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 
-					if diff.HasChange(isFloatingIPTarget) {
-						if !diff.NewValueKnown(isFloatingIPTarget) {
-							diff.ForceNew(isFloatingIPTarget)
-							return nil
-						}
+					// A target that is not known yet, for example the network interface of a new instance, is retargeted
+					// in place, the update fails if the target is in another zone
+					if diff.HasChange(isFloatingIPTarget) && diff.NewValueKnown(isFloatingIPTarget) {
 						old, new := diff.GetChange(isFloatingIPTarget)
 						if old != "" || new != "" {
 							sess, err := vpcClient(v)
@@ -126,6 +131,12 @@ func ResourceIBMISFloatingIP() *schema.Resource {
 				ExactlyOneOf:  []string{isFloatingIPTarget, isFloatingIPZone},
 				Description:   "Target info",
 			},
+			isFloatingIPAutoDelete: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the floating IP is deleted when the resource is destroyed. When false, the floating IP is unbound from its network interface and kept in the account so that its address can be reused.",
+			},
 			floatingIPTargets: {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -271,7 +282,7 @@ func ResourceIBMISFloatingIP() *schema.Resource {
 				Description: "The resource group name in which resource is provisioned",
 			},
 		},
-	}
+	})
 }
 
 func vpcClient(meta interface{}) (*vpcv1.VpcV1, error) {
@@ -546,6 +557,9 @@ func fipUpdate(d *schema.ResourceData, meta interface{}, id string) error {
 	if hasChanged {
 		_, response, err := sess.UpdateFloatingIP(options)
 		if err != nil {
+			if d.HasChange(isFloatingIPTarget) {
+				return fmt.Errorf("[ERROR] Error updating vpc Floating IP, the target %s must be in the zone %s of the floating IP: %s\n%s", d.Get(isFloatingIPTarget).(string), d.Get(isFloatingIPZone).(string), err, response)
+			}
 			return fmt.Errorf("[ERROR] Error updating vpc Floating IP: %s\n%s", err, response)
 		}
 	}
@@ -578,6 +592,16 @@ func fipDelete(d *schema.ResourceData, meta interface{}, id string) error {
 		return fmt.Errorf("[ERROR] Error Getting Floating IP (%s): %s\n%s", id, err, response)
 	}
 
+	if !d.Get(isFloatingIPAutoDelete).(bool) {
+		err = fipUnbind(d, sess, id)
+		if err != nil {
+			return err
+		}
+		log.Printf("[INFO] Floating IP (%s) is kept in the account, auto_delete is false", id)
+		d.SetId("")
+		return nil
+	}
+
 	options := &vpcv1.DeleteFloatingIPOptions{
 		ID: &id,
 	}
@@ -593,6 +617,34 @@ func fipDelete(d *schema.ResourceData, meta interface{}, id string) error {
 	return nil
 }
 
+// fipUnbind unbinds the floating IP from the network interface of an instance or bare metal server it is bound to. The
+// floating IPs of public gateways stay bound.
+func fipUnbind(d *schema.ResourceData, sess *vpcv1.VpcV1, id string) error {
+	href := d.Get(floatingIPTargets + ".0." + floatingIPTargetsHref).(string)
+	if match := isFloatingIPInstanceNicHrefRegexp.FindStringSubmatch(href); match != nil {
+		removeOptions := &vpcv1.RemoveInstanceNetworkInterfaceFloatingIPOptions{
+			InstanceID:         &match[1],
+			NetworkInterfaceID: &match[2],
+			ID:                 &id,
+		}
+		response, err := sess.RemoveInstanceNetworkInterfaceFloatingIP(removeOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return fmt.Errorf("[ERROR] Error unbinding Floating IP (%s) from network interface %s: %s\n%s", id, match[2], err, response)
+		}
+	} else if match := isFloatingIPBareMetalServerNicHrefRegexp.FindStringSubmatch(href); match != nil {
+		removeOptions := &vpcv1.RemoveBareMetalServerNetworkInterfaceFloatingIPOptions{
+			BareMetalServerID:  &match[1],
+			NetworkInterfaceID: &match[2],
+			ID:                 &id,
+		}
+		response, err := sess.RemoveBareMetalServerNetworkInterfaceFloatingIP(removeOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return fmt.Errorf("[ERROR] Error unbinding Floating IP (%s) from bare metal server network interface %s: %s\n%s", id, match[2], err, response)
+		}
+	}
+	return nil
+}
+
 func resourceIBMISFloatingIPExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	id := d.Id()
 	exists, err := fipExists(d, meta, id)
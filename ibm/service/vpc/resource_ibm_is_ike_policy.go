@@ -49,21 +49,21 @@ func ResourceIBMISIKEPolicy() *schema.Resource {
 			isIKEAuthenticationAlg: {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validate.InvokeValidator("ibm_is_ike_policy", isIKEAuthenticationAlg),
+				ValidateFunc: validateISVPNAlgorithm(isVPNIKEAuthenticationAlgorithms, isVPNAuthenticationAlgorithmFormat),
 				Description:  "Authentication algorithm type",
 			},
 
 			isIKEEncryptionAlg: {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validate.InvokeValidator("ibm_is_ike_policy", isIKEEncryptionAlg),
+				ValidateFunc: validateISVPNAlgorithm(isVPNIKEEncryptionAlgorithms, isVPNEncryptionAlgorithmFormat),
 				Description:  "Encryption alogorithm type",
 			},
 
 			isIKEDhGroup: {
 				Type:         schema.TypeInt,
 				Required:     true,
-				ValidateFunc: validate.InvokeValidator("ibm_is_ike_policy", isIKEDhGroup),
+				ValidateFunc: validateISVPNAlgorithm(isVPNIKEDhGroups, isVPNDhGroupFormat),
 				Description:  "IKE DH group",
 			},
 
@@ -145,9 +145,6 @@ func ResourceIBMISIKEPolicy() *schema.Resource {
 func ResourceIBMISIKEValidator() *validate.ResourceValidator {
 
 	validateSchema := make([]validate.ValidateSchema, 0)
-	authentication_algorithm := "md5, sha1, sha256, sha512, sha384"
-	encryption_algorithm := "triple_des, aes128, aes192, aes256"
-	dh_group := "2, 5, 14, 19, 15, 16, 17, 18, 20, 21, 22, 23, 24, 31"
 	ike_version := "1, 2"
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
@@ -158,27 +155,6 @@ func ResourceIBMISIKEValidator() *validate.ResourceValidator {
 			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
 			MinValueLength:             1,
 			MaxValueLength:             63})
-	validateSchema = append(validateSchema,
-		validate.ValidateSchema{
-			Identifier:                 isIKEAuthenticationAlg,
-			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
-			Type:                       validate.TypeString,
-			Required:                   true,
-			AllowedValues:              authentication_algorithm})
-	validateSchema = append(validateSchema,
-		validate.ValidateSchema{
-			Identifier:                 isIKEEncryptionAlg,
-			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
-			Type:                       validate.TypeString,
-			Required:                   true,
-			AllowedValues:              encryption_algorithm})
-	validateSchema = append(validateSchema,
-		validate.ValidateSchema{
-			Identifier:                 isIKEDhGroup,
-			ValidateFunctionIdentifier: validate.ValidateAllowedIntValue,
-			Type:                       validate.TypeInt,
-			Required:                   true,
-			AllowedValues:              dh_group})
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
 			Identifier:                 isIKEVERSION,
@@ -6,6 +6,7 @@ package vpc_test
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -58,6 +59,20 @@ func TestAccIBMISIKEPolicy_basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMISIKEPolicy_invalidAlgorithm(t *testing.T) {
+	name := fmt.Sprintf("tfike-name-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMISIKEPolicyConfigInvalidAlgorithm(name),
+				ExpectError: regexp.MustCompile("sha265 is not a valid value"),
+			},
+		},
+	})
+}
+
 func checkIKEPolicyDestroy(s *terraform.State) error {
 
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
@@ -127,3 +142,15 @@ func testAccCheckIBMISIKEPolicyConfigUpdate(name string) string {
 		}
 	`, name)
 }
+
+func testAccCheckIBMISIKEPolicyConfigInvalidAlgorithm(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_is_ike_policy" "example" {
+			name = "%s"
+			authentication_algorithm = "sha265"
+			encryption_algorithm = "aes128"
+			dh_group = 14
+			ike_version = 2
+		}
+	`, name)
+}
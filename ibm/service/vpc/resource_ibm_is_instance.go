@@ -5,19 +5,23 @@ package vpc
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"log"
 	"os"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v3"
 )
 
 const (
@@ -39,6 +43,9 @@ const (
 	isInstanceNicFloatingIP           = "floating_ip"
 	isInstanceNicFloatingIPs          = "floating_ips"
 	isInstanceUserData                = "user_data"
+	isInstanceUserDataReplaceOnChange = "user_data_replace_on_change"
+	isInstanceValidateUserData        = "validate_user_data"
+	isInstanceUserDataMaxSize         = 64 * 1024
 	isInstanceVolumes                 = "volumes"
 	isInstanceVPC                     = "vpc"
 	isInstanceZone                    = "zone"
@@ -62,6 +69,9 @@ const (
 	isInstanceGpuManufacturer         = "manufacturer"
 	isInstanceGpuMemory               = "memory"
 	isInstanceGpuModel                = "model"
+
+	isInstanceConfidentialComputeMode = "confidential_compute_mode"
+	isInstanceEnableSecureBoot        = "enable_secure_boot"
 	isInstanceMemory                  = "memory"
 	isInstanceDisks                   = "disks"
 	isInstanceDedicatedHost           = "dedicated_host"
@@ -128,7 +138,7 @@ const (
 )
 
 func ResourceIBMISInstance() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithImportDefaults(&schema.Resource{
 		Create: resourceIBMisInstanceCreate,
 		Read:   resourceIBMisInstanceRead,
 		Update: resourceIBMisInstanceUpdate,
@@ -182,6 +192,14 @@ func ResourceIBMISInstance() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			customdiff.Sequence(
+				func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMISInstanceProfileValidate(ctx, diff, v)
+				}),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMISInstanceUserDataValidate(diff)
+				}),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -596,10 +614,21 @@ func ResourceIBMISInstance() *schema.Resource {
 
 			isInstanceUserData: {
 				Type:        schema.TypeString,
-				ForceNew:    true,
 				Optional:    true,
 				Description: "User data given for the instance",
 			},
+			isInstanceUserDataReplaceOnChange: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Replace the instance when the user data changes. When false, the change is kept in the state only, as the user data is run at the first boot of the instance only",
+			},
+			isInstanceValidateUserData: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Check the YAML syntax of cloud-config user data when the plan is created",
+			},
 
 			isInstanceImage: {
 				Type:          schema.TypeString,
@@ -755,6 +784,18 @@ func ResourceIBMISInstance() *schema.Resource {
 				},
 			},
 
+			isInstanceConfidentialComputeMode: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The confidential compute mode of the instance, secure_execution for the IBM Secure Execution workloads of s390x profiles",
+			},
+
+			isInstanceEnableSecureBoot: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether secure boot is enabled, so that the boot chain of the instance can be measured and attested",
+			},
+
 			isInstanceMemory: {
 				Type:        schema.TypeInt,
 				Computed:    true,
@@ -995,7 +1036,7 @@ func ResourceIBMISInstance() *schema.Resource {
 				},
 			},
 		},
-	}
+	})
 }
 
 func ResourceIBMISInstanceValidator() *validate.ResourceValidator {
@@ -3092,13 +3133,10 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 	if err != nil {
 		return err
 	}
-	getinsOptions := &vpcv1.GetInstanceOptions{
-		ID: &id,
-	}
 	getinsIniOptions := &vpcv1.GetInstanceInitializationOptions{
 		ID: &id,
 	}
-	instance, response, err := instanceC.GetInstance(getinsOptions)
+	instance, instanceConfidentialCompute, response, err := getInstanceConfidentialCompute(context.Background(), instanceC, id)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -3134,6 +3172,12 @@ func instanceGet(d *schema.ResourceData, meta interface{}, id string) error {
 	if instance.Profile != nil {
 		d.Set(isInstanceProfile, *instance.Profile.Name)
 	}
+	if instanceConfidentialCompute.ConfidentialComputeMode != nil {
+		d.Set(isInstanceConfidentialComputeMode, *instanceConfidentialCompute.ConfidentialComputeMode)
+	}
+	if instanceConfidentialCompute.EnableSecureBoot != nil {
+		d.Set(isInstanceEnableSecureBoot, *instanceConfidentialCompute.EnableSecureBoot)
+	}
 	cpuList := make([]map[string]interface{}, 0)
 	if instance.Vcpu != nil {
 		currentCPU := map[string]interface{}{}
@@ -4611,3 +4655,189 @@ func GetInstanceMetadataServiceOptions(d *schema.ResourceData) (metadataService
 	}
 	return nil
 }
+
+// resourceIBMISInstanceProfileValidate checks the instance against its profile at plan time, so that
+// an unsupported combination fails before the instance is created instead of during provisioning.
+func resourceIBMISInstanceProfileValidate(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if meta == nil || (diff.Id() != "" && !diff.HasChange(isInstanceProfile)) {
+		return nil
+	}
+	profileName := diff.Get(isInstanceProfile).(string)
+	if profileName == "" || !diff.NewValueKnown(isInstanceProfile) {
+		return nil
+	}
+	sess, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	profile, response, err := sess.GetInstanceProfileWithContext(ctx, &vpcv1.GetInstanceProfileOptions{
+		Name: &profileName,
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return fmt.Errorf("[ERROR] Instance profile %s not found", profileName)
+		}
+		log.Printf("[WARN] Error getting instance profile %s, skipping the profile validation: %s\n%s", profileName, err, response)
+		return nil
+	}
+	if err := resourceIBMISInstanceValidateProfileArchitecture(ctx, diff, sess, profile); err != nil {
+		return err
+	}
+	return resourceIBMISInstanceValidateProfileGpu(ctx, diff, sess, profile)
+}
+
+// resourceIBMISInstanceUserDataValidate checks the size of the user data, and its cloud-config syntax
+// when requested, and replaces the instance when the user data changes unless this is disabled.
+func resourceIBMISInstanceUserDataValidate(diff *schema.ResourceDiff) error {
+	if !diff.NewValueKnown(isInstanceUserData) {
+		return nil
+	}
+	userData := diff.Get(isInstanceUserData).(string)
+	if len(userData) > isInstanceUserDataMaxSize {
+		return fmt.Errorf("[ERROR] user_data is %d bytes, which is more than the limit of %d bytes", len(userData), isInstanceUserDataMaxSize)
+	}
+	if userData != "" && diff.Get(isInstanceValidateUserData).(bool) {
+		if err := validateCloudInitUserData(userData); err != nil {
+			return fmt.Errorf("[ERROR] Invalid user_data: %s", err)
+		}
+	}
+	if diff.Id() != "" && diff.HasChange(isInstanceUserData) && diff.Get(isInstanceUserDataReplaceOnChange).(bool) {
+		return diff.ForceNew(isInstanceUserData)
+	}
+	return nil
+}
+
+// validateCloudInitUserData parses cloud-config user data, base64 encoded or not, as YAML. Other user data, such as
+// shell scripts, MIME multi-part archives or gzip compressed data, is not checked.
+func validateCloudInitUserData(userData string) error {
+	if decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(userData)); err == nil && utf8.Valid(decoded) {
+		userData = string(decoded)
+	}
+	if !strings.HasPrefix(userData, "#cloud-config") {
+		return nil
+	}
+	var config map[string]interface{}
+	if err := yaml.Unmarshal([]byte(userData), &config); err != nil {
+		return fmt.Errorf("cloud-config is not valid YAML: %s", err)
+	}
+	return nil
+}
+
+// resourceIBMISInstanceValidateProfileArchitecture checks that the OS architecture of the image is
+// supported by the profile, as s390x images only run on s390x profiles and conversely.
+func resourceIBMISInstanceValidateProfileArchitecture(ctx context.Context, diff *schema.ResourceDiff, sess *vpcv1.VpcV1, profile *vpcv1.InstanceProfile) error {
+	imageID := diff.Get(isInstanceImage).(string)
+	if imageID == "" || !diff.NewValueKnown(isInstanceImage) || profile.OsArchitecture == nil || len(profile.OsArchitecture.Values) == 0 {
+		return nil
+	}
+	image, response, err := sess.GetImageWithContext(ctx, &vpcv1.GetImageOptions{
+		ID: &imageID,
+	})
+	if err != nil {
+		log.Printf("[WARN] Error getting image %s, skipping the architecture validation: %s\n%s", imageID, err, response)
+		return nil
+	}
+	if image.OperatingSystem == nil || image.OperatingSystem.Architecture == nil {
+		return nil
+	}
+	architecture := *image.OperatingSystem.Architecture
+	for _, supported := range profile.OsArchitecture.Values {
+		if supported == architecture {
+			return nil
+		}
+	}
+	return fmt.Errorf("[ERROR] Image %s has the %s architecture, which is not supported by the instance profile %s (%s)",
+		imageID, architecture, *profile.Name, strings.Join(profile.OsArchitecture.Values, ", "))
+}
+
+// resourceIBMISInstanceValidateProfileGpu checks that the zone of a GPU instance is available, and plans the
+// GPU inventory of the instance from the profile so that it is known before apply. The instance profiles API
+// does not list the zones of a profile, so a profile that is not offered in the zone still fails at create.
+func resourceIBMISInstanceValidateProfileGpu(ctx context.Context, diff *schema.ResourceDiff, sess *vpcv1.VpcV1, profile *vpcv1.InstanceProfile) error {
+	if profile.GpuCount == nil {
+		if diff.Id() != "" {
+			return diff.SetNew(isInstanceGpu, []interface{}{})
+		}
+		return nil
+	}
+	zoneName := diff.Get(isInstanceZone).(string)
+	if region := zoneRegion(zoneName); region != "" && diff.NewValueKnown(isInstanceZone) {
+		zone, response, err := sess.GetRegionZoneWithContext(ctx, &vpcv1.GetRegionZoneOptions{
+			RegionName: &region,
+			Name:       &zoneName,
+		})
+		if err != nil {
+			log.Printf("[WARN] Error getting zone %s, skipping the zone validation: %s\n%s", zoneName, err, response)
+		} else if err := ValidateInstanceProfileGpuZone(profile, zone); err != nil {
+			return err
+		}
+	}
+
+	gpu, fixed := InstanceProfileGpu(profile)
+	if !fixed {
+		// The GPU inventory depends on the placement of the instance
+		return diff.SetNewComputed(isInstanceGpu)
+	}
+	return diff.SetNew(isInstanceGpu, []interface{}{gpu})
+}
+
+// zoneRegion returns the region of a zone name such as us-south-1
+func zoneRegion(zone string) string {
+	i := strings.LastIndex(zone, "-")
+	if i <= 0 {
+		return ""
+	}
+	return zone[:i]
+}
+
+// ValidateInstanceProfileGpuZone fails when the zone of a GPU instance cannot provision instances
+func ValidateInstanceProfileGpuZone(profile *vpcv1.InstanceProfile, zone *vpcv1.Zone) error {
+	if zone.Status == nil || *zone.Status == vpcv1.ZoneStatusAvailableConst {
+		return nil
+	}
+	return fmt.Errorf("[ERROR] GPU instance profile %s cannot be provisioned in the zone %s, which is %s", *profile.Name, *zone.Name, *zone.Status)
+}
+
+// InstanceProfileGpu returns the GPU inventory of the instances of a GPU profile, and whether the profile fixes it.
+// The profiles with a range or a choice of GPUs leave the inventory to the placement of the instance.
+func InstanceProfileGpu(profile *vpcv1.InstanceProfile) (map[string]interface{}, bool) {
+	gpu := map[string]interface{}{}
+	if count, ok := profile.GpuCount.(*vpcv1.InstanceProfileGpu); ok && count.Value != nil {
+		gpu[isInstanceGpuCount] = int(*count.Value)
+	}
+	if memory, ok := profile.GpuMemory.(*vpcv1.InstanceProfileGpuMemory); ok && memory.Value != nil {
+		gpu[isInstanceGpuMemory] = int(*memory.Value)
+	}
+	if profile.GpuManufacturer != nil && len(profile.GpuManufacturer.Values) == 1 {
+		gpu[isInstanceGpuManufacturer] = profile.GpuManufacturer.Values[0]
+	}
+	if profile.GpuModel != nil && len(profile.GpuModel.Values) == 1 {
+		gpu[isInstanceGpuModel] = profile.GpuModel.Values[0]
+	}
+	return gpu, len(gpu) == 4
+}
+
+// instanceConfidentialCompute holds the confidential compute mode and the secure boot of an instance, which aren't
+// in the model of the VPC SDK yet.
+type instanceConfidentialCompute struct {
+	ConfidentialComputeMode *string `json:"confidential_compute_mode,omitempty"`
+	EnableSecureBoot        *bool   `json:"enable_secure_boot,omitempty"`
+}
+
+// getInstanceConfidentialCompute gets an instance with its confidential compute mode and secure boot.
+func getInstanceConfidentialCompute(ctx context.Context, sess *vpcv1.VpcV1, id string) (*vpcv1.Instance, *instanceConfidentialCompute, *core.DetailedResponse, error) {
+	var rawResponse map[string]json.RawMessage
+	response, err := vpcRequest(ctx, sess, core.GET, `/instances/{id}`, map[string]string{"id": id}, nil, nil, &rawResponse)
+	if err != nil {
+		return nil, nil, response, err
+	}
+	var instance *vpcv1.Instance
+	if err = core.UnmarshalModel(rawResponse, "", &instance, vpcv1.UnmarshalInstance); err != nil {
+		return nil, nil, response, err
+	}
+	confidentialCompute := &instanceConfidentialCompute{}
+	if err = vpcUnmarshalExtension(rawResponse, confidentialCompute); err != nil {
+		return nil, nil, response, err
+	}
+	return instance, confidentialCompute, response, nil
+}
@@ -29,7 +29,7 @@ const (
 )
 
 func ResourceIBMISInstanceGroupMembership() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithImportDefaults(&schema.Resource{
 		Create:   resourceIBMISInstanceGroupMembershipUpdate,
 		Read:     resourceIBMISInstanceGroupMembershipRead,
 		Update:   resourceIBMISInstanceGroupMembershipUpdate,
@@ -125,7 +125,7 @@ func ResourceIBMISInstanceGroupMembership() *schema.Resource {
 				Description: "The status of the instance group membership- `deleting`: Membership is deleting dependent resources- `failed`: Membership was unable to maintain dependent resources- `healthy`: Membership is active and serving in the group- `pending`: Membership is waiting for dependent resources- `unhealthy`: Membership has unhealthy dependent resources.",
 			},
 		},
-	}
+	})
 }
 
 func ResourceIBMISInstanceGroupMembershipValidator() *validate.ResourceValidator {
@@ -6,13 +6,16 @@ package vpc_test
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
 
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -73,6 +76,29 @@ ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVE
 		},
 	})
 }
+
+func TestAccIBMISInstance_invalidUserData(t *testing.T) {
+	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf-instnace-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tf-subnet-%d", acctest.RandIntRange(10, 100))
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+	sshname := fmt.Sprintf("tf-ssh-%d", acctest.RandIntRange(10, 100))
+	userData := `#cloud-config\nruncmd: [`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMISInstanceValidateUserDataConfig(vpcname, subnetname, sshname, publicKey, name, userData),
+				ExpectError: regexp.MustCompile("cloud-config is not valid YAML"),
+			},
+		},
+	})
+}
+
 func TestAccIBMISInstance_lifecycle(t *testing.T) {
 	var instance string
 	vpcname := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
@@ -1047,6 +1073,39 @@ func testAccCheckIBMISInstanceConfig(vpcname, subnetname, sshname, publicKey, na
 	  }`, vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, sshname, publicKey, name, acc.IsImage, acc.InstanceProfileName, userData, acc.ISZoneName)
 }
 
+func testAccCheckIBMISInstanceValidateUserDataConfig(vpcname, subnetname, sshname, publicKey, name, userData string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	  }
+	  
+	  resource "ibm_is_subnet" "testacc_subnet" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.testacc_vpc.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	  }
+	  
+	  resource "ibm_is_ssh_key" "testacc_sshkey" {
+		name       = "%s"
+		public_key = "%s"
+	  }
+	  
+	  resource "ibm_is_instance" "testacc_instance" {
+		name    = "%s"
+		image   = "%s"
+		profile = "%s"
+		primary_network_interface {
+		  subnet     = ibm_is_subnet.testacc_subnet.id
+		}
+		user_data          = "%s"
+		validate_user_data = true
+		vpc  = ibm_is_vpc.testacc_vpc.id
+		zone = "%s"
+		keys = [ibm_is_ssh_key.testacc_sshkey.id]
+	  }`, vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, sshname, publicKey, name, acc.IsImage, acc.InstanceProfileName, userData, acc.ISZoneName)
+}
+
 func testAccCheckIBMISInstanceRipConfig(vpcname, subnetname, subnetripname, sshname, publicKey, name, userData string) string {
 	return fmt.Sprintf(`
 	resource "ibm_is_vpc" "testacc_vpc" {
@@ -1959,3 +2018,56 @@ func testAccCheckIBMISInstanceCatalogImageConfig(vpcname, subnetname, sshname, p
 		}
 	  }`, vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, sshname, publicKey, name, acc.InstanceProfileName, userData, acc.ISZoneName)
 }
+
+func TestInstanceProfileGpu(t *testing.T) {
+	fixed := &vpcv1.InstanceProfile{
+		Name:            core.StringPtr("gx2-8x64x1v100"),
+		GpuCount:        &vpcv1.InstanceProfileGpu{Type: core.StringPtr("fixed"), Value: core.Int64Ptr(1)},
+		GpuMemory:       &vpcv1.InstanceProfileGpuMemory{Type: core.StringPtr("fixed"), Value: core.Int64Ptr(16)},
+		GpuManufacturer: &vpcv1.InstanceProfileGpuManufacturer{Type: core.StringPtr("enum"), Values: []string{"nvidia"}},
+		GpuModel:        &vpcv1.InstanceProfileGpuModel{Type: core.StringPtr("enum"), Values: []string{"Tesla V100"}},
+	}
+	gpu, ok := vpc.InstanceProfileGpu(fixed)
+	if !ok {
+		t.Fatalf("expected the GPU inventory of %s to be fixed", *fixed.Name)
+	}
+	expected := map[string]interface{}{"count": 1, "memory": 16, "manufacturer": "nvidia", "model": "Tesla V100"}
+	for key, value := range expected {
+		if gpu[key] != value {
+			t.Errorf("expected GPU %s %v, got %v", key, value, gpu[key])
+		}
+	}
+
+	ranged := &vpcv1.InstanceProfile{
+		Name:            core.StringPtr("gx3-custom"),
+		GpuCount:        &vpcv1.InstanceProfileGpu{Type: core.StringPtr("range"), Min: core.Int64Ptr(1), Max: core.Int64Ptr(4)},
+		GpuMemory:       &vpcv1.InstanceProfileGpuMemory{Type: core.StringPtr("fixed"), Value: core.Int64Ptr(24)},
+		GpuManufacturer: &vpcv1.InstanceProfileGpuManufacturer{Type: core.StringPtr("enum"), Values: []string{"nvidia"}},
+		GpuModel:        &vpcv1.InstanceProfileGpuModel{Type: core.StringPtr("enum"), Values: []string{"L4", "L40S"}},
+	}
+	if _, ok := vpc.InstanceProfileGpu(ranged); ok {
+		t.Fatalf("expected the GPU inventory of %s to depend on the placement", *ranged.Name)
+	}
+}
+
+func TestValidateInstanceProfileGpuZone(t *testing.T) {
+	profile := &vpcv1.InstanceProfile{Name: core.StringPtr("gx2-8x64x1v100")}
+	cases := []struct {
+		status string
+		valid  bool
+	}{
+		{vpcv1.ZoneStatusAvailableConst, true},
+		{vpcv1.ZoneStatusImpairedConst, false},
+		{vpcv1.ZoneStatusUnavailableConst, false},
+	}
+	for _, c := range cases {
+		zone := &vpcv1.Zone{Name: core.StringPtr("us-south-1"), Status: core.StringPtr(c.status)}
+		err := vpc.ValidateInstanceProfileGpuZone(profile, zone)
+		if c.valid && err != nil {
+			t.Errorf("expected a %s zone to be valid, got %s", c.status, err)
+		}
+		if !c.valid && err == nil {
+			t.Errorf("expected a %s zone to be invalid", c.status)
+		}
+	}
+}
@@ -37,7 +37,7 @@ const (
 )
 
 func ResourceIBMISInstanceVolumeAttachment() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithImportDefaults(&schema.Resource{
 		Create:   resourceIBMisInstanceVolumeAttachmentCreate,
 		Read:     resourceIBMisInstanceVolumeAttachmentRead,
 		Update:   resourceIBMisInstanceVolumeAttachmentUpdate,
@@ -209,7 +209,7 @@ func ResourceIBMISInstanceVolumeAttachment() *schema.Resource {
 				Computed: true,
 			},
 		},
-	}
+	})
 }
 
 func ResourceIBMISInstanceVolumeAttachmentValidator() *validate.ResourceValidator {
@@ -582,6 +582,11 @@ func instanceVolAttUpdate(d *schema.ResourceData, meta interface{}) error {
 			volumeProfilePatchModel.Profile = &vpcv1.VolumeProfileIdentity{
 				Name: &profile,
 			}
+			// converting a tiered volume to custom sets its iops in the same patch
+			if profile == "custom" {
+				iops := int64(d.Get(isInstanceVolIops).(int))
+				volumeProfilePatchModel.Iops = &iops
+			}
 		} else if d.HasChange(isVolumeIops) {
 			profile := d.Get(isInstanceVolProfile).(string)
 			volumeProfilePatchModel.Profile = &vpcv1.VolumeProfileIdentity{
@@ -57,21 +57,21 @@ func ResourceIBMISIPSecPolicy() *schema.Resource {
 			isIpSecAuthenticationAlg: {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validate.InvokeValidator("ibm_is_ipsec_policy", isIpSecAuthenticationAlg),
+				ValidateFunc: validateISVPNAlgorithm(isVPNIPsecAuthenticationAlgorithms, isVPNAuthenticationAlgorithmFormat),
 				Description:  "Authentication alorothm",
 			},
 
 			isIpSecEncryptionAlg: {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validate.InvokeValidator("ibm_is_ipsec_policy", isIpSecEncryptionAlg),
+				ValidateFunc: validateISVPNAlgorithm(isVPNIPsecEncryptionAlgorithms, isVPNEncryptionAlgorithmFormat),
 				Description:  "Encryption algorithm",
 			},
 
 			isIpSecPFS: {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: validate.InvokeValidator("ibm_is_ipsec_policy", isIpSecPFS),
+				ValidateFunc: validateISVPNAlgorithm(isVPNIPsecPfsGroups, isVPNPfsGroupFormat),
 				Description:  "PFS info",
 			},
 
@@ -153,9 +153,6 @@ func ResourceIBMISIPSecPolicy() *schema.Resource {
 func ResourceIBMISIPSECValidator() *validate.ResourceValidator {
 
 	validateSchema := make([]validate.ValidateSchema, 0)
-	authentication_algorithm := "md5, sha1, sha256, sha512, sha384, disabled"
-	encryption_algorithm := "triple_des, aes128, aes256, aes128gcm16, aes192gcm16, aes256gcm16"
-	pfs := "disabled, group_2, group_5, group_14, group_19, group_15, group_16, group_17, group_18, group_20, group_21, group_22, group_23, group_24, group_31"
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
 			Identifier:                 isIpSecName,
@@ -165,27 +162,6 @@ func ResourceIBMISIPSECValidator() *validate.ResourceValidator {
 			Regexp:                     `^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`,
 			MinValueLength:             1,
 			MaxValueLength:             63})
-	validateSchema = append(validateSchema,
-		validate.ValidateSchema{
-			Identifier:                 isIpSecAuthenticationAlg,
-			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
-			Type:                       validate.TypeString,
-			Required:                   true,
-			AllowedValues:              authentication_algorithm})
-	validateSchema = append(validateSchema,
-		validate.ValidateSchema{
-			Identifier:                 isIpSecEncryptionAlg,
-			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
-			Type:                       validate.TypeString,
-			Required:                   true,
-			AllowedValues:              encryption_algorithm})
-	validateSchema = append(validateSchema,
-		validate.ValidateSchema{
-			Identifier:                 isIpSecPFS,
-			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
-			Type:                       validate.TypeString,
-			Required:                   true,
-			AllowedValues:              pfs})
 
 	ibmISIPSECResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_ipsec_policy", Schema: validateSchema}
 	return &ibmISIPSECResourceValidator
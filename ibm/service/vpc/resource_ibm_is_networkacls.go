@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"os"
 	"reflect"
 	"strings"
@@ -46,8 +47,12 @@ const (
 	isNetworkACLTags              = "tags"
 	isNetworkACLAccessTags        = "access_tags"
 	isNetworkACLCRN               = "crn"
+	isNetworkACLRuleLint          = "rule_lint"
 )
 
+// networkACLEphemeralPortMin is the lowest port of the ephemeral range used by the clients
+const networkACLEphemeralPortMin = 1024
+
 func ResourceIBMISNetworkACL() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMISNetworkACLCreate,
@@ -72,6 +77,10 @@ func ResourceIBMISNetworkACL() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMISNetworkACLRulesLint(diff)
+				}),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -140,6 +149,13 @@ func ResourceIBMISNetworkACL() *schema.Resource {
 				Computed:    true,
 				Description: "The resource group name in which resource is provisioned",
 			},
+			isNetworkACLRuleLint: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "warn",
+				ValidateFunc: validate.InvokeValidator("ibm_is_network_acl", isNetworkACLRuleLint),
+				Description:  "How the findings of the rules lint are reported during plan, either warn to log them as warnings or error to fail the plan",
+			},
 			isNetworkACLRules: {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -306,6 +322,13 @@ func ResourceIBMISNetworkACLValidator() *validate.ResourceValidator {
 			Type:                       validate.TypeString,
 			Required:                   true,
 			AllowedValues:              direction})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isNetworkACLRuleLint,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "warn, error"})
 	validateSchema = append(validateSchema,
 		validate.ValidateSchema{
 			Identifier:                 isNetworkACLName,
@@ -618,6 +641,9 @@ func nwaclGet(d *schema.ResourceData, meta interface{}, id string) error {
 		}
 	}
 	d.Set(isNetworkACLRules, rules)
+	if _, ok := d.GetOk(isNetworkACLRuleLint); !ok {
+		d.Set(isNetworkACLRuleLint, "warn")
+	}
 	controller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
@@ -954,3 +980,198 @@ func createInlineRules(nwaclC *vpcv1.VpcV1, nwaclid string, rules []interface{})
 func isNil(i interface{}) bool {
 	return i == nil || reflect.ValueOf(i).IsNil()
 }
+
+// networkACLRuleMatch is the traffic matched by a rule, a port or an ICMP field of -1 matches any value
+type networkACLRuleMatch struct {
+	name          string
+	action        string
+	direction     string
+	protocol      string
+	source        *net.IPNet
+	destination   *net.IPNet
+	portMin       int
+	portMax       int
+	sourcePortMin int
+	sourcePortMax int
+	icmpType      int
+	icmpCode      int
+}
+
+// resourceIBMISNetworkACLRulesLint reports the shadowed rules and the rules missing the return rule
+// of their replies, the network ACLs being stateless.
+func resourceIBMISNetworkACLRulesLint(diff *schema.ResourceDiff) error {
+	if !diff.HasChange(isNetworkACLRules) {
+		return nil
+	}
+	findings := lintNetworkACLRules(diff.Get(isNetworkACLRules).([]interface{}))
+	if len(findings) == 0 {
+		return nil
+	}
+	if diff.Get(isNetworkACLRuleLint).(string) == "error" {
+		return fmt.Errorf("[ERROR] Network ACL rules lint found %d issues:\n%s", len(findings), strings.Join(findings, "\n"))
+	}
+	for _, finding := range findings {
+		log.Printf("[WARN] Network ACL %s: %s", diff.Get(isNetworkACLName).(string), finding)
+	}
+	return nil
+}
+
+func lintNetworkACLRules(rules []interface{}) []string {
+	matches := make([]networkACLRuleMatch, 0, len(rules))
+	for _, rule := range rules {
+		// Rules with values unknown until apply are left out
+		if match, ok := expandNetworkACLRuleMatch(rule); ok {
+			matches = append(matches, match)
+		}
+	}
+
+	findings := []string{}
+	for j, rule := range matches {
+		for _, earlier := range matches[:j] {
+			if networkACLRuleCovers(earlier, rule) {
+				findings = append(findings, fmt.Sprintf("rule %q is unreachable, rule %q before it matches all its traffic", rule.name, earlier.name))
+				break
+			}
+		}
+	}
+	for _, rule := range matches {
+		reply, ok := networkACLRuleReply(rule)
+		if !ok {
+			continue
+		}
+		var returnRule *networkACLRuleMatch
+		for i := range matches {
+			if networkACLRuleCovers(matches[i], reply) {
+				returnRule = &matches[i]
+				break
+			}
+		}
+		if returnRule == nil || returnRule.action != "allow" {
+			findings = append(findings, fmt.Sprintf("rule %q has no %s rule allowing its replies from ports %d-%d to ports %d-%d",
+				rule.name, reply.direction, reply.sourcePortMin, reply.sourcePortMax, reply.portMin, reply.portMax))
+		}
+	}
+	return findings
+}
+
+func expandNetworkACLRuleMatch(rule interface{}) (networkACLRuleMatch, bool) {
+	if isNil(rule) {
+		return networkACLRuleMatch{}, false
+	}
+	rulex := rule.(map[string]interface{})
+	match := networkACLRuleMatch{
+		name:          rulex[isNetworkACLRuleName].(string),
+		action:        rulex[isNetworkACLRuleAction].(string),
+		direction:     strings.ToLower(rulex[isNetworkACLRuleDirection].(string)),
+		protocol:      "all",
+		source:        parseNetworkACLRuleCIDR(rulex[isNetworkACLRuleSource].(string)),
+		destination:   parseNetworkACLRuleCIDR(rulex[isNetworkACLRuleDestination].(string)),
+		portMin:       -1,
+		portMax:       -1,
+		sourcePortMin: -1,
+		sourcePortMax: -1,
+		icmpType:      -1,
+		icmpCode:      -1,
+	}
+	if match.source == nil || match.destination == nil || match.action == "" || match.direction == "" {
+		return match, false
+	}
+
+	icmp := rulex[isNetworkACLRuleICMP].([]interface{})
+	tcp := rulex[isNetworkACLRuleTCP].([]interface{})
+	udp := rulex[isNetworkACLRuleUDP].([]interface{})
+	var ports []interface{}
+	if len(icmp) > 0 {
+		// The ICMP type and code are set like when the rules are created
+		match.protocol = "icmp"
+		if !isNil(icmp[0]) {
+			icmpval := icmp[0].(map[string]interface{})
+			match.icmpType = icmpval[isNetworkACLRuleICMPType].(int)
+			match.icmpCode = icmpval[isNetworkACLRuleICMPCode].(int)
+		}
+	} else if len(tcp) > 0 {
+		match.protocol = "tcp"
+		ports = tcp
+	} else if len(udp) > 0 {
+		match.protocol = "udp"
+		ports = udp
+	}
+	if len(ports) > 0 {
+		match.portMin, match.portMax, match.sourcePortMin, match.sourcePortMax = 1, 65535, 1, 65535
+		if !isNil(ports[0]) {
+			portval := ports[0].(map[string]interface{})
+			match.portMin = portval[isNetworkACLRulePortMin].(int)
+			match.portMax = portval[isNetworkACLRulePortMax].(int)
+			match.sourcePortMin = portval[isNetworkACLRuleSourcePortMin].(int)
+			match.sourcePortMax = portval[isNetworkACLRuleSourcePortMax].(int)
+		}
+	}
+	return match, true
+}
+
+// networkACLRuleReply returns the traffic of the replies to an allowed TCP or UDP rule, which reach
+// the ephemeral ports of the clients.
+func networkACLRuleReply(rule networkACLRuleMatch) (networkACLRuleMatch, bool) {
+	if rule.action != "allow" || (rule.protocol != "tcp" && rule.protocol != "udp") {
+		return rule, false
+	}
+	reply := rule
+	reply.name = ""
+	reply.direction = "inbound"
+	if rule.direction == "inbound" {
+		reply.direction = "outbound"
+	}
+	reply.source, reply.destination = rule.destination, rule.source
+	reply.sourcePortMin, reply.sourcePortMax = rule.portMin, rule.portMax
+	reply.portMin, reply.portMax = rule.sourcePortMin, rule.sourcePortMax
+	if reply.portMin < networkACLEphemeralPortMin {
+		reply.portMin = networkACLEphemeralPortMin
+	}
+	// The rule only matches requests coming from server ports
+	if reply.portMin > reply.portMax {
+		return rule, false
+	}
+	return reply, true
+}
+
+// networkACLRuleCovers reports whether the rule matches all the traffic matched by the other rule
+func networkACLRuleCovers(rule, other networkACLRuleMatch) bool {
+	if rule.direction != other.direction {
+		return false
+	}
+	if rule.protocol != "all" && rule.protocol != other.protocol {
+		return false
+	}
+	if !networkACLCIDRContains(rule.source, other.source) || !networkACLCIDRContains(rule.destination, other.destination) {
+		return false
+	}
+	switch rule.protocol {
+	case "tcp", "udp":
+		return rule.portMin <= other.portMin && other.portMax <= rule.portMax &&
+			rule.sourcePortMin <= other.sourcePortMin && other.sourcePortMax <= rule.sourcePortMax
+	case "icmp":
+		return (rule.icmpType == -1 || rule.icmpType == other.icmpType) &&
+			(rule.icmpCode == -1 || rule.icmpCode == other.icmpCode)
+	}
+	return true
+}
+
+func parseNetworkACLRuleCIDR(value string) *net.IPNet {
+	if _, cidr, err := net.ParseCIDR(value); err == nil {
+		return cidr
+	}
+	ip := net.ParseIP(value)
+	if ip == nil {
+		return nil
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}
+}
+
+func networkACLCIDRContains(outer, inner *net.IPNet) bool {
+	outerOnes, outerBits := outer.Mask.Size()
+	innerOnes, innerBits := inner.Mask.Size()
+	return outerBits == innerBits && outerOnes <= innerOnes && outer.Contains(inner.IP)
+}
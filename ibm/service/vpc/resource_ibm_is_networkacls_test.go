@@ -6,6 +6,7 @@ package vpc_test
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -108,6 +109,20 @@ func TestNetworkACLGen2(t *testing.T) {
 	})
 }
 
+func TestNetworkACLRuleLint(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMISNetworkACLRuleLintConfig(),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`(?s)"inbound-https" is unreachable.*"inbound-ssh" has no outbound rule`),
+			},
+		},
+	})
+}
+
 func checkNetworkACLDestroy(s *terraform.State) error {
 	sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
 	for _, rs := range s.RootModule().Resources {
@@ -280,3 +295,46 @@ func testAccCheckIBMISNetworkACLResourceGroupConfig(resourceGroupSelect bool) st
 	  }
 	`, acc.ISZoneName, resourceGroupSelect, acc.IsResourceGroupID)
 }
+
+func testAccCheckIBMISNetworkACLRuleLintConfig() string {
+	return `
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "tf-nwacl-lint-vpc"
+	}
+
+	resource "ibm_is_network_acl" "isExampleACL" {
+		name      = "is-example-lint-acl"
+		vpc       = ibm_is_vpc.testacc_vpc.id
+		rule_lint = "error"
+		rules {
+			name        = "inbound-ssh"
+			action      = "allow"
+			source      = "10.0.0.0/8"
+			destination = "0.0.0.0/0"
+			direction   = "inbound"
+			tcp {
+				port_min = 22
+				port_max = 22
+			}
+		}
+		rules {
+			name        = "inbound-deny"
+			action      = "deny"
+			source      = "0.0.0.0/0"
+			destination = "0.0.0.0/0"
+			direction   = "inbound"
+		}
+		rules {
+			name        = "inbound-https"
+			action      = "allow"
+			source      = "0.0.0.0/0"
+			destination = "0.0.0.0/0"
+			direction   = "inbound"
+			tcp {
+				port_min = 443
+				port_max = 443
+			}
+		}
+	}
+	`
+}
@@ -64,6 +64,8 @@ func ResourceIBMISPublicGateway() *schema.Resource {
 		),
 
 		Schema: map[string]*schema.Schema{
+			isVPCRegion: vpcRegionSchema(),
+
 			isPublicGatewayName: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -201,7 +203,7 @@ func ResourceIBMISPublicGatewayValidator() *validate.ResourceValidator {
 }
 
 func resourceIBMISPublicGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	sess, err := vpcClient(meta)
+	sess, err := vpcClientForRegion(d, meta)
 	if err != nil {
 		return err
 	}
@@ -309,7 +311,7 @@ func isPublicGatewayRefreshFunc(publicgwC *vpcv1.VpcV1, id string) resource.Stat
 }
 
 func resourceIBMISPublicGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	sess, err := vpcClient(meta)
+	sess, err := vpcClientForRegion(d, meta)
 	if err != nil {
 		return err
 	}
@@ -369,7 +371,7 @@ func resourceIBMISPublicGatewayRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceIBMISPublicGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
-	sess, err := vpcClient(meta)
+	sess, err := vpcClientForRegion(d, meta)
 	if err != nil {
 		return err
 	}
@@ -435,7 +437,7 @@ func resourceIBMISPublicGatewayUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceIBMISPublicGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	sess, err := vpcClient(meta)
+	sess, err := vpcClientForRegion(d, meta)
 	if err != nil {
 		return err
 	}
@@ -526,7 +528,7 @@ func isPublicGatewayDeleteRefreshFunc(pg *vpcv1.VpcV1, id string) resource.State
 }
 
 func resourceIBMISPublicGatewayExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	sess, err := vpcClient(meta)
+	sess, err := vpcClientForRegion(d, meta)
 	if err != nil {
 		return false, err
 	}
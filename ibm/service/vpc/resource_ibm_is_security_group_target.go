@@ -9,10 +9,8 @@ import (
 	"strings"
 	"time"
 
-	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
-	"github.com/IBM/vpc-beta-go-sdk/vpcbetav1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -65,10 +63,16 @@ func ResourceIBMISSecurityGroupTarget() *schema.Resource {
 				Description: "The CRN for this Security group target",
 			},
 
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this Security group target",
+			},
+
 			isSecurityGroupResourceType: {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "Resource Type",
+				Description: "The resource type of the Security group target: network_interface, bare_metal_server_network_interface, load_balancer, endpoint_gateway, vpn_server or virtual_network_interface",
 			},
 		},
 	}
@@ -119,20 +123,26 @@ func resourceIBMISSecurityGroupTargetCreate(d *schema.ResourceData, meta interfa
 	}
 	sgtarget := sg.(*vpcv1.SecurityGroupTargetReference)
 	d.SetId(fmt.Sprintf("%s/%s", securityGroupID, *sgtarget.ID))
-	crn := sgtarget.CRN
-	if crn != nil && *crn != "" && strings.Contains(*crn, "load-balancer") {
-		lbid := sgtarget.ID
-		_, errsgt := isWaitForLbSgTargetCreateAvailable(sess, *lbid, d.Timeout(schema.TimeoutCreate))
+
+	// The targets that have a lifecycle are updated asynchronously, wait for them to be available again
+	switch isSecurityGroupTargetType(sgtarget) {
+	case "load_balancer":
+		_, errsgt := isWaitForLbSgTargetCreateAvailable(sess, *sgtarget.ID, d.Timeout(schema.TimeoutCreate))
 		if errsgt != nil {
 			return errsgt
 		}
-	} else if crn != nil && *crn != "" && strings.Contains(*crn, "virtual_network_interfaces") {
-		vpcClient, err := meta.(conns.ClientSession).VpcV1BetaAPI()
-		if err != nil {
-			return err
+	case "virtual_network_interface":
+		_, errsgt := isWaitForVNISgTargetCreateAvailable(sess, *sgtarget.ID, d.Timeout(schema.TimeoutCreate))
+		if errsgt != nil {
+			return errsgt
+		}
+	case "endpoint_gateway":
+		_, errsgt := isWaitForEndpointGatewaySgTargetAvailable(sess, *sgtarget.ID, d.Timeout(schema.TimeoutCreate))
+		if errsgt != nil {
+			return errsgt
 		}
-		vniId := sgtarget.ID
-		_, errsgt := isWaitForVNISgTargetCreateAvailable(vpcClient, *vniId, d.Timeout(schema.TimeoutCreate))
+	case "vpn_server":
+		_, errsgt := isWaitForVPNServerSgTargetAvailable(sess, *sgtarget.ID, d.Timeout(schema.TimeoutCreate))
 		if errsgt != nil {
 			return errsgt
 		}
@@ -175,9 +185,8 @@ func resourceIBMISSecurityGroupTargetRead(d *schema.ResourceData, meta interface
 	target := data.(*vpcv1.SecurityGroupTargetReference)
 	d.Set("name", *target.Name)
 	d.Set("crn", target.CRN)
-	if target.ResourceType != nil && *target.ResourceType != "" {
-		d.Set(isSecurityGroupResourceType, *target.ResourceType)
-	}
+	d.Set("href", target.Href)
+	d.Set(isSecurityGroupResourceType, isSecurityGroupTargetType(target))
 
 	return nil
 }
@@ -214,10 +223,24 @@ func resourceIBMISSecurityGroupTargetDelete(d *schema.ResourceData, meta interfa
 		return fmt.Errorf("[ERROR] Error Deleting Security Group Targets : %s\n%s", err, response)
 	}
 	securityGroupTargetReference := sgt.(*vpcv1.SecurityGroupTargetReference)
-	crn := securityGroupTargetReference.CRN
-	if crn != nil && *crn != "" && strings.Contains(*crn, "load-balancer") {
-		lbid := securityGroupTargetReference.ID
-		_, errsgt := isWaitForLBRemoveAvailable(sess, sgt, *lbid, securityGroupID, securityGroupTargetID, d.Timeout(schema.TimeoutDelete))
+	switch isSecurityGroupTargetType(securityGroupTargetReference) {
+	case "load_balancer":
+		_, errsgt := isWaitForLBRemoveAvailable(sess, sgt, *securityGroupTargetReference.ID, securityGroupID, securityGroupTargetID, d.Timeout(schema.TimeoutDelete))
+		if errsgt != nil {
+			return errsgt
+		}
+	case "virtual_network_interface":
+		_, errsgt := isWaitForVNISgTargetCreateAvailable(sess, *securityGroupTargetReference.ID, d.Timeout(schema.TimeoutDelete))
+		if errsgt != nil {
+			return errsgt
+		}
+	case "endpoint_gateway":
+		_, errsgt := isWaitForEndpointGatewaySgTargetAvailable(sess, *securityGroupTargetReference.ID, d.Timeout(schema.TimeoutDelete))
+		if errsgt != nil {
+			return errsgt
+		}
+	case "vpn_server":
+		_, errsgt := isWaitForVPNServerSgTargetAvailable(sess, *securityGroupTargetReference.ID, d.Timeout(schema.TimeoutDelete))
 		if errsgt != nil {
 			return errsgt
 		}
@@ -338,12 +361,12 @@ func isLBSgTargetRefreshFunc(sess *vpcv1.VpcV1, lbId string) resource.StateRefre
 	}
 }
 
-func isWaitForVNISgTargetCreateAvailable(sess *vpcbetav1.VpcbetaV1, vniId string, timeout time.Duration) (interface{}, error) {
+func isWaitForVNISgTargetCreateAvailable(sess *vpcv1.VpcV1, vniId string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for virtual network interface (%s) to be available.", vniId)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"pending", "updating", "waiting"},
-		Target:     []string{isLBProvisioningDone, ""},
+		Target:     []string{"stable", ""},
 		Refresh:    isVNISgTargetRefreshFunc(sess, vniId),
 		Timeout:    timeout,
 		Delay:      10 * time.Second,
@@ -353,15 +376,15 @@ func isWaitForVNISgTargetCreateAvailable(sess *vpcbetav1.VpcbetaV1, vniId string
 	return stateConf.WaitForState()
 }
 
-func isVNISgTargetRefreshFunc(vpcClient *vpcbetav1.VpcbetaV1, vniId string) resource.StateRefreshFunc {
+func isVNISgTargetRefreshFunc(vpcClient *vpcv1.VpcV1, vniId string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 
-		getVNIOptions := &vpcbetav1.GetVirtualNetworkInterfaceOptions{
+		getVNIOptions := &vpcv1.GetVirtualNetworkInterfaceOptions{
 			ID: &vniId,
 		}
 		vni, response, err := vpcClient.GetVirtualNetworkInterface(getVNIOptions)
 		if err != nil {
-			return nil, "", fmt.Errorf("[ERROR] Error Getting Load Balancer : %s\n%s", err, response)
+			return nil, "", fmt.Errorf("[ERROR] Error Getting Virtual Network Interface : %s\n%s", err, response)
 		}
 
 		if *vni.LifecycleState == "failed" {
@@ -370,3 +393,63 @@ func isVNISgTargetRefreshFunc(vpcClient *vpcbetav1.VpcbetaV1, vniId string) reso
 		return vni, *vni.LifecycleState, nil
 	}
 }
+
+func isWaitForEndpointGatewaySgTargetAvailable(sess *vpcv1.VpcV1, endpointGatewayID string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for endpoint gateway (%s) to be available.", endpointGatewayID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending", "updating", "waiting"},
+		Target:  []string{"stable", ""},
+		Refresh: func() (interface{}, string, error) {
+			endpointGateway, response, err := sess.GetEndpointGateway(&vpcv1.GetEndpointGatewayOptions{ID: &endpointGatewayID})
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error Getting Endpoint Gateway : %s\n%s", err, response)
+			}
+			if *endpointGateway.LifecycleState == "failed" {
+				return endpointGateway, *endpointGateway.LifecycleState, fmt.Errorf("[ERROR] Endpoint Gateway update failed with status %s", *endpointGateway.LifecycleState)
+			}
+			return endpointGateway, *endpointGateway.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+func isWaitForVPNServerSgTargetAvailable(sess *vpcv1.VpcV1, vpnServerID string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for VPN server (%s) to be available.", vpnServerID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending", "updating", "waiting"},
+		Target:  []string{"stable", ""},
+		Refresh: func() (interface{}, string, error) {
+			vpnServer, response, err := sess.GetVPNServer(&vpcv1.GetVPNServerOptions{ID: &vpnServerID})
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error Getting VPN Server : %s\n%s", err, response)
+			}
+			if *vpnServer.LifecycleState == "failed" {
+				return vpnServer, *vpnServer.LifecycleState, fmt.Errorf("[ERROR] VPN Server update failed with status %s", *vpnServer.LifecycleState)
+			}
+			return vpnServer, *vpnServer.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+// isSecurityGroupTargetType returns the resource type of the target. When the resource type is not returned, the load
+// balancers are recognized by their CRN.
+func isSecurityGroupTargetType(target *vpcv1.SecurityGroupTargetReference) string {
+	if target.ResourceType != nil && *target.ResourceType != "" {
+		return *target.ResourceType
+	}
+	if target.CRN != nil && strings.Contains(*target.CRN, ":load-balancer:") {
+		return "load_balancer"
+	}
+	return ""
+}
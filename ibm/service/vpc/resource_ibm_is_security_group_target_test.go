@@ -143,3 +143,72 @@ resource "ibm_is_security_group_target" "testacc_security_group_target" {
   }`, vpcname, subnetname, zoneName, cidr, name, lbname)
 
 }
+
+func TestAccIBMISSecurityGroupTarget_vpe(t *testing.T) {
+	var securityGroup string
+
+	vpcname := fmt.Sprintf("tfsg-vpc-%d", acctest.RandIntRange(10, 100))
+	vpename := fmt.Sprintf("tfsg-vpe-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfsg-one-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISSecurityGroupTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISsecurityGroupTargetVPEConfig(vpcname, vpename, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISSecurityGroupTargetExists("ibm_is_security_group_target.testacc_security_group_target", &securityGroup),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group_target.testacc_security_group_target", "name", vpename),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group_target.testacc_security_group_target", "resource_type", "endpoint_gateway"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_is_security_group_target.testacc_security_group_target", "href"),
+					resource.TestCheckResourceAttr(
+						"data.ibm_is_security_group_targets.testacc_security_group_targets", "targets.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.ibm_is_security_group_targets.testacc_security_group_targets", "targets.0.resource_type", "endpoint_gateway"),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_security_group_target.testacc_security_group_target",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISsecurityGroupTargetVPEConfig(vpcname, vpename, name string) string {
+	return fmt.Sprintf(`
+resource "ibm_is_vpc" "testacc_vpc" {
+    name = "%s"
+}
+
+resource "ibm_is_security_group" "testacc_security_group_one" {
+    name = "%s"
+    vpc = ibm_is_vpc.testacc_vpc.id
+}
+
+resource "ibm_is_virtual_endpoint_gateway" "testacc_vpe" {
+    name = "%s"
+    vpc = ibm_is_vpc.testacc_vpc.id
+    target {
+        name          = "ibm-ntp-server"
+        resource_type = "provider_infrastructure_service"
+    }
+}
+
+resource "ibm_is_security_group_target" "testacc_security_group_target" {
+    security_group = ibm_is_security_group.testacc_security_group_one.id
+    target = ibm_is_virtual_endpoint_gateway.testacc_vpe.id
+}
+
+data "ibm_is_security_group_targets" "testacc_security_group_targets" {
+    security_group = ibm_is_security_group_target.testacc_security_group_target.security_group
+    resource_type = "endpoint_gateway"
+}`, vpcname, name, vpename)
+
+}
@@ -13,6 +13,7 @@ import (
 	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -22,17 +23,20 @@ import (
 )
 
 const (
-	isKeyName          = "name"
-	IsKeyCRN           = "crn"
-	isKeyPublicKey     = "public_key"
-	isKeyType          = "type"
-	isKeyFingerprint   = "fingerprint"
-	isKeyLength        = "length"
-	isKeyTags          = "tags"
-	isKeyResourceGroup = "resource_group"
-	isKeyAccessTags    = "access_tags"
-	isKeyUserTagType   = "user"
-	isKeyAccessTagType = "access"
+	isKeyName              = "name"
+	IsKeyCRN               = "crn"
+	isKeyPublicKey         = "public_key"
+	isKeyPublicKeyCRN      = "public_key_secret_crn"
+	isKeyType              = "type"
+	isKeyFingerprint       = "fingerprint"
+	isKeyFingerprintMD5    = "fingerprint_md5"
+	isKeyFingerprintSHA256 = "fingerprint_sha256"
+	isKeyLength            = "length"
+	isKeyTags              = "tags"
+	isKeyResourceGroup     = "resource_group"
+	isKeyAccessTags        = "access_tags"
+	isKeyUserTagType       = "user"
+	isKeyAccessTagType     = "access"
 )
 
 func ResourceIBMISSSHKey() *schema.Resource {
@@ -54,9 +58,15 @@ func ResourceIBMISSSHKey() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMISSSHKeyTypeValidate(diff)
+				}),
 		),
 
 		Schema: map[string]*schema.Schema{
+			isVPCRegion: vpcRegionSchema(),
+
 			isKeyName: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -67,12 +77,23 @@ func ResourceIBMISSSHKey() *schema.Resource {
 
 			isKeyPublicKey: {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
+				Computed:         true,
 				ForceNew:         true,
+				ExactlyOneOf:     []string{isKeyPublicKey, isKeyPublicKeyCRN},
 				DiffSuppressFunc: suppressPublicKeyDiff,
 				Description:      "SSH Public key data",
 			},
 
+			isKeyPublicKeyCRN: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{isKeyPublicKey, isKeyPublicKeyCRN},
+				ValidateFunc: validate.ValidateCRNService("secrets-manager"),
+				Description:  "The CRN of the Secrets Manager secret that holds the SSH public key data",
+			},
+
 			isKeyType: {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -87,6 +108,18 @@ func ResourceIBMISSSHKey() *schema.Resource {
 				Description: "SSH key Fingerprint info",
 			},
 
+			isKeyFingerprintMD5: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The MD5 fingerprint of the public key, in the format of ssh-keygen -E md5",
+			},
+
+			isKeyFingerprintSHA256: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA256 fingerprint of the public key, in the format of ssh-keygen -E sha256",
+			},
+
 			isKeyLength: {
 				Type:        schema.TypeInt,
 				Computed:    true,
@@ -192,6 +225,13 @@ func resourceIBMISSSHKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Key create")
 	name := d.Get(isKeyName).(string)
 	publickey := d.Get(isKeyPublicKey).(string)
+	if secretCRN, ok := d.GetOk(isKeyPublicKeyCRN); ok {
+		secret, err := secretsmanager.GetSecretValueByCRN(meta, secretCRN.(string))
+		if err != nil {
+			return err
+		}
+		publickey = strings.TrimSpace(secret)
+	}
 
 	err := keyCreate(d, meta, name, publickey)
 	if err != nil {
@@ -201,7 +241,7 @@ func resourceIBMISSSHKeyCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func keyCreate(d *schema.ResourceData, meta interface{}, name, publickey string) error {
-	sess, err := vpcClient(meta)
+	sess, err := vpcClientForRegion(d, meta)
 	if err != nil {
 		return err
 	}
@@ -220,6 +260,9 @@ func keyCreate(d *schema.ResourceData, meta interface{}, name, publickey string)
 	if keytype, ok := d.GetOk(isKeyType); ok {
 		kt := keytype.(string)
 		options.Type = &kt
+	} else if kt := sshKeyType(publickey); kt != "" {
+		// The API creates rsa keys by default, the type of an ed25519 key must be set
+		options.Type = &kt
 	}
 
 	key, response, err := sess.CreateKey(options)
@@ -262,7 +305,7 @@ func resourceIBMISSSHKeyRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func keyGet(d *schema.ResourceData, meta interface{}, id string) error {
-	sess, err := vpcClient(meta)
+	sess, err := vpcClientForRegion(d, meta)
 	if err != nil {
 		return err
 	}
@@ -281,6 +324,7 @@ func keyGet(d *schema.ResourceData, meta interface{}, id string) error {
 	d.Set(isKeyPublicKey, *key.PublicKey)
 	d.Set(isKeyType, *key.Type)
 	d.Set(isKeyFingerprint, *key.Fingerprint)
+	setSSHKeyFingerprints(d, *key.PublicKey)
 	d.Set(isKeyLength, *key.Length)
 	tags, err := flex.GetGlobalTagsUsingCRN(meta, *key.CRN, "", isKeyUserTagType)
 	if err != nil {
@@ -328,7 +372,7 @@ func resourceIBMISSSHKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func keyUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
-	sess, err := vpcClient(meta)
+	sess, err := vpcClientForRegion(d, meta)
 	if err != nil {
 		return err
 	}
@@ -393,7 +437,7 @@ func resourceIBMISSSHKeyDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func keyDelete(d *schema.ResourceData, meta interface{}, id string) error {
-	sess, err := vpcClient(meta)
+	sess, err := vpcClientForRegion(d, meta)
 	if err != nil {
 		return err
 	}
@@ -428,7 +472,7 @@ func resourceIBMISSSHKeyExists(d *schema.ResourceData, meta interface{}) (bool,
 }
 
 func keyExists(d *schema.ResourceData, meta interface{}, id string) (bool, error) {
-	sess, err := vpcClient(meta)
+	sess, err := vpcClientForRegion(d, meta)
 	if err != nil {
 		return false, err
 	}
@@ -499,3 +543,42 @@ func parseKey(s string) (ssh.PublicKey, error) {
 	}
 	return nil, e
 }
+
+// sshKeyType returns the type of the public key, or an empty string when the key can't be parsed
+func sshKeyType(publicKey string) string {
+	pk, err := parseKey(strings.TrimSpace(publicKey))
+	if err != nil {
+		return ""
+	}
+	switch pk.Type() {
+	case ssh.KeyAlgoED25519:
+		return "ed25519"
+	case ssh.KeyAlgoRSA:
+		return "rsa"
+	}
+	return ""
+}
+
+// setSSHKeyFingerprints sets the MD5 and SHA256 fingerprints of the public key, to match the keys with
+// the fingerprints printed by ssh-keygen
+func setSSHKeyFingerprints(d *schema.ResourceData, publicKey string) {
+	pk, err := parseKey(strings.TrimSpace(publicKey))
+	if err != nil {
+		log.Printf("[WARN] Error parsing the public key to compute its fingerprints: %s", err)
+		return
+	}
+	d.Set(isKeyFingerprintMD5, ssh.FingerprintLegacyMD5(pk))
+	d.Set(isKeyFingerprintSHA256, ssh.FingerprintSHA256(pk))
+}
+
+// resourceIBMISSSHKeyTypeValidate fails the plan when the type doesn't match the type of the public key
+func resourceIBMISSSHKeyTypeValidate(diff *schema.ResourceDiff) error {
+	keyType, ok := diff.GetOk(isKeyType)
+	if !ok || !diff.NewValueKnown(isKeyType) || !diff.NewValueKnown(isKeyPublicKey) || !diff.HasChange(isKeyPublicKey) {
+		return nil
+	}
+	if publicKeyType := sshKeyType(diff.Get(isKeyPublicKey).(string)); publicKeyType != "" && publicKeyType != keyType.(string) {
+		return fmt.Errorf("[ERROR] The %s is %q but the public_key is an %s key", isKeyType, keyType, publicKeyType)
+	}
+	return nil
+}
@@ -74,6 +74,8 @@ func ResourceIBMISSubnet() *schema.Resource {
 		),
 
 		Schema: map[string]*schema.Schema{
+			isVPCRegion: vpcRegionSchema(),
+
 			isSubnetIpv4CidrBlock: {
 				Type:          schema.TypeString,
 				ForceNew:      true,
@@ -319,7 +321,7 @@ func resourceIBMISSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 
 func subnetCreate(d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv4cidr, acl, gw, rtID string, ipv4addrcount64 int64) error {
 
-	sess, err := vpcClient(meta)
+	sess, err := vpcClientForRegion(d, meta)
 	if err != nil {
 		return err
 	}
@@ -444,7 +446,7 @@ func resourceIBMISSubnetRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func subnetGet(d *schema.ResourceData, meta interface{}, id string) error {
-	sess, err := vpcClient(meta)
+	sess, err := vpcClientForRegion(d, meta)
 	if err != nil {
 		return err
 	}
@@ -542,7 +544,7 @@ func resourceIBMISSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func subnetUpdate(d *schema.ResourceData, meta interface{}, id string) error {
-	sess, err := vpcClient(meta)
+	sess, err := vpcClientForRegion(d, meta)
 	if err != nil {
 		return err
 	}
@@ -641,7 +643,7 @@ func resourceIBMISSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func subnetDelete(d *schema.ResourceData, meta interface{}, id string) error {
-	sess, err := vpcClient(meta)
+	sess, err := vpcClientForRegion(d, meta)
 	if err != nil {
 		return err
 	}
@@ -761,7 +763,7 @@ func resourceIBMISSubnetExists(d *schema.ResourceData, meta interface{}) (bool,
 }
 
 func subnetExists(d *schema.ResourceData, meta interface{}, id string) (bool, error) {
-	sess, err := vpcClient(meta)
+	sess, err := vpcClientForRegion(d, meta)
 	if err != nil {
 		return false, err
 	}
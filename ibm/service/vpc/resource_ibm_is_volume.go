@@ -106,10 +106,11 @@ func ResourceIBMISVolume() *schema.Resource {
 			},
 
 			isVolumeEncryptionKey: {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Description: "Volume encryption key info",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateCRNService("kms", "hs-crypto"),
+				Description:  "Volume encryption key info",
 			},
 
 			isVolumeEncryptionType: {
@@ -761,6 +762,11 @@ func volUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasNam
 			volumeProfilePatchModel.Profile = &vpcv1.VolumeProfileIdentity{
 				Name: &profile,
 			}
+			// converting a tiered volume to custom sets its iops in the same patch
+			if profile == "custom" {
+				iops := int64(d.Get(isVolumeIops).(int))
+				volumeProfilePatchModel.Iops = &iops
+			}
 		} else if d.HasChange(isVolumeIops) {
 			profile := d.Get(isVolumeProfileName).(string)
 			volumeProfilePatchModel.Profile = &vpcv1.VolumeProfileIdentity{
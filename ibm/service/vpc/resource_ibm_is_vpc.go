@@ -86,11 +86,12 @@ const (
 	isVPCDnsResolverVpcRemote                 = "remote"
 	isVPCDnsResolverVpcRemoteAccount          = "account"
 	isVPCDnsResolverVpcRemoteRegion           = "region"
+	isVPCRegion                               = "region"
 	isVPCNoSgAclRules                         = "no_sg_acl_rules"
 )
 
 func ResourceIBMISVPC() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithImportDefaults(&schema.Resource{
 		Create:   resourceIBMISVPCCreate,
 		Read:     resourceIBMISVPCRead,
 		Update:   resourceIBMISVPCUpdate,
@@ -116,6 +117,8 @@ func ResourceIBMISVPC() *schema.Resource {
 		),
 
 		Schema: map[string]*schema.Schema{
+			isVPCRegion: vpcRegionSchema(),
+
 			isVPCAddressPrefixManagement: {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -297,7 +300,7 @@ func ResourceIBMISVPC() *schema.Resource {
 				Default:          false,
 				DiffSuppressFunc: flex.ApplyOnce,
 				Optional:         true,
-				Description:      "Delete all rules attached with default security group and default acl",
+				Description:      "Delete all rules attached with default security group and default acl when the VPC is created, so that they deny all the traffic until rules are added",
 			},
 
 			isVPCName: {
@@ -415,8 +418,9 @@ func ResourceIBMISVPC() *schema.Resource {
 			},
 
 			cseSourceAddresses: {
-				Type:     schema.TypeList,
-				Computed: true,
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The cloud service endpoint source IP addresses of the VPC, one per zone. Requests from the VPC to cloud service endpoints come from these addresses.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"address": {
@@ -557,7 +561,7 @@ func ResourceIBMISVPC() *schema.Resource {
 				},
 			},
 		},
-	}
+	})
 }
 
 func ResourceIBMISVPCValidator() *validate.ResourceValidator {
@@ -666,7 +670,7 @@ func resourceIBMISVPCCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, isClassic bool) error {
-	sess, err := vpcClient(meta)
+	sess, err := vpcClientForRegion(d, meta)
 	if err != nil {
 		return err
 	}
@@ -717,8 +721,12 @@ func vpcCreate(d *schema.ResourceData, meta interface{}, name, apm, rg string, i
 	if sgAclRules, ok := d.GetOk(isVPCNoSgAclRules); ok {
 		sgAclRules := sgAclRules.(bool)
 		if sgAclRules {
-			deleteDefaultNetworkACLRules(sess, *vpc.ID)
-			deleteDefaultSecurityGroupRules(sess, *vpc.ID)
+			if err = deleteDefaultNetworkACLRules(sess, *vpc.ID); err != nil {
+				return err
+			}
+			if err = deleteDefaultSecurityGroupRules(sess, *vpc.ID); err != nil {
+				return err
+			}
 		}
 	}
 	v := os.Getenv("IC_ENV_TAGS")
@@ -756,73 +764,81 @@ func isWaitForVPCAvailable(vpc *vpcv1.VpcV1, id string, timeout time.Duration) (
 	return stateConf.WaitForState()
 }
 
+// deleteDefaultNetworkACLRules deletes all the rules of the default network ACL of the VPC, so that the ACL denies
+// all the traffic until rules are added to it.
 func deleteDefaultNetworkACLRules(sess *vpcv1.VpcV1, vpcID string) error {
 	getVPCDefaultNetworkACLOptions := sess.NewGetVPCDefaultNetworkACLOptions(vpcID)
-	result, detail, err := sess.GetVPCDefaultNetworkACL(getVPCDefaultNetworkACLOptions)
+	result, response, err := sess.GetVPCDefaultNetworkACL(getVPCDefaultNetworkACLOptions)
 	if err != nil || result == nil {
-		log.Printf("Error reading details of VPC Default Network ACL:%s", detail)
-		return err
-	}
-
-	if result.Rules != nil {
-		for _, sourceRule := range result.Rules {
-			sourceRuleVal := sourceRule.(*vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll)
-			if sourceRuleVal.ID != nil {
-				getNetworkAclRuleOptions := &vpcv1.GetNetworkACLRuleOptions{
-					NetworkACLID: result.ID,
-					ID:           sourceRuleVal.ID,
-				}
-				_, response, err := sess.GetNetworkACLRule(getNetworkAclRuleOptions)
-
-				if err != nil {
-					return fmt.Errorf("[ERROR] Error Getting Network ACL Rule  (%s): %s\n%s", *sourceRuleVal.ID, err, response)
-				}
-
-				deleteNetworkAclRuleOptions := &vpcv1.DeleteNetworkACLRuleOptions{
-					NetworkACLID: result.ID,
-					ID:           sourceRuleVal.ID,
-				}
-				response, err = sess.DeleteNetworkACLRule(deleteNetworkAclRuleOptions)
-				if err != nil {
-					return fmt.Errorf("[ERROR] Error Deleting Network ACL Rule : %s\n%s", err, response)
-				}
+		return fmt.Errorf("[ERROR] Error getting the default network ACL of VPC (%s): %s\n%s", vpcID, err, response)
+	}
+
+	for _, rule := range result.Rules {
+		var ruleID *string
+		switch ruleVal := rule.(type) {
+		case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolAll:
+			ruleID = ruleVal.ID
+		case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolIcmp:
+			ruleID = ruleVal.ID
+		case *vpcv1.NetworkACLRuleItemNetworkACLRuleProtocolTcpudp:
+			ruleID = ruleVal.ID
+		case *vpcv1.NetworkACLRuleItem:
+			ruleID = ruleVal.ID
+		}
+		if ruleID == nil {
+			continue
+		}
+		deleteNetworkAclRuleOptions := &vpcv1.DeleteNetworkACLRuleOptions{
+			NetworkACLID: result.ID,
+			ID:           ruleID,
+		}
+		response, err = sess.DeleteNetworkACLRule(deleteNetworkAclRuleOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				// The rule is already gone
+				continue
 			}
+			return fmt.Errorf("[ERROR] Error Deleting Network ACL Rule (%s): %s\n%s", *ruleID, err, response)
 		}
 	}
 	return nil
 }
 
+// deleteDefaultSecurityGroupRules deletes all the rules of the default security group of the VPC, so that the group
+// denies all the traffic until rules are added to it.
 func deleteDefaultSecurityGroupRules(sess *vpcv1.VpcV1, vpcID string) error {
 	getVPCDefaultSecurityGroupOptions := sess.NewGetVPCDefaultSecurityGroupOptions(vpcID)
-	result, detail, err := sess.GetVPCDefaultSecurityGroup(getVPCDefaultSecurityGroupOptions)
+	result, response, err := sess.GetVPCDefaultSecurityGroup(getVPCDefaultSecurityGroupOptions)
 	if err != nil || result == nil {
-		log.Printf("Error reading details of VPC Default Security Group:%s", detail)
-		return err
-	}
-
-	if result.Rules != nil {
-		for _, sourceRule := range result.Rules {
-			sourceRuleVal := sourceRule.(*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll)
-			if sourceRuleVal.ID != nil {
-				getSecurityGroupRuleOptions := &vpcv1.GetSecurityGroupRuleOptions{
-					SecurityGroupID: result.ID,
-					ID:              sourceRuleVal.ID,
-				}
-				_, response, err := sess.GetSecurityGroupRule(getSecurityGroupRuleOptions)
-
-				if err != nil {
-					return fmt.Errorf("[ERROR] Error Getting Security Group Rule  (%s): %s\n%s", *sourceRuleVal.ID, err, response)
-				}
-
-				deleteSecurityGroupRuleOptions := &vpcv1.DeleteSecurityGroupRuleOptions{
-					SecurityGroupID: result.ID,
-					ID:              sourceRuleVal.ID,
-				}
-				response, err = sess.DeleteSecurityGroupRule(deleteSecurityGroupRuleOptions)
-				if err != nil {
-					return fmt.Errorf("[ERROR] Error Deleting Security Group Rule : %s\n%s", err, response)
-				}
+		return fmt.Errorf("[ERROR] Error getting the default security group of VPC (%s): %s\n%s", vpcID, err, response)
+	}
+
+	for _, rule := range result.Rules {
+		var ruleID *string
+		switch ruleVal := rule.(type) {
+		case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll:
+			ruleID = ruleVal.ID
+		case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp:
+			ruleID = ruleVal.ID
+		case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp:
+			ruleID = ruleVal.ID
+		case *vpcv1.SecurityGroupRule:
+			ruleID = ruleVal.ID
+		}
+		if ruleID == nil {
+			continue
+		}
+		deleteSecurityGroupRuleOptions := &vpcv1.DeleteSecurityGroupRuleOptions{
+			SecurityGroupID: result.ID,
+			ID:              ruleID,
+		}
+		response, err = sess.DeleteSecurityGroupRule(deleteSecurityGroupRuleOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				// The rule is already gone
+				continue
 			}
+			return fmt.Errorf("[ERROR] Error Deleting Security Group Rule (%s): %s\n%s", *ruleID, err, response)
 		}
 	}
 	return nil
@@ -856,7 +872,7 @@ func resourceIBMISVPCRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func vpcGet(d *schema.ResourceData, meta interface{}, id string) error {
-	sess, err := vpcClient(meta)
+	sess, err := vpcClientForRegion(d, meta)
 	if err != nil {
 		return err
 	}
@@ -1137,7 +1153,7 @@ func resourceIBMISVPCUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func vpcUpdate(d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) error {
-	sess, err := vpcClient(meta)
+	sess, err := vpcClientForRegion(d, meta)
 	if err != nil {
 		return err
 	}
@@ -1349,7 +1365,7 @@ func resourceIBMISVPCDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func vpcDelete(d *schema.ResourceData, meta interface{}, id string) error {
-	sess, err := vpcClient(meta)
+	sess, err := vpcClientForRegion(d, meta)
 	if err != nil {
 		return err
 	}
@@ -1421,7 +1437,7 @@ func resourceIBMISVPCExists(d *schema.ResourceData, meta interface{}) (bool, err
 }
 
 func vpcExists(d *schema.ResourceData, meta interface{}, id string) (bool, error) {
-	sess, err := vpcClient(meta)
+	sess, err := vpcClientForRegion(d, meta)
 	if err != nil {
 		return false, err
 	}
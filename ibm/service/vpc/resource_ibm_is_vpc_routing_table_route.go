@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -26,7 +27,7 @@ const (
 )
 
 func ResourceIBMISVPCRoutingTableRoute() *schema.Resource {
-	return &schema.Resource{
+	return flex.WithImportDefaults(&schema.Resource{
 		Create:   resourceIBMISVPCRoutingTableRouteCreate,
 		Read:     resourceIBMISVPCRoutingTableRouteRead,
 		Update:   resourceIBMISVPCRoutingTableRouteUpdate,
@@ -167,7 +168,7 @@ func ResourceIBMISVPCRoutingTableRoute() *schema.Resource {
 				Description: "The origin of this route.",
 			},
 		},
-	}
+	})
 }
 
 func ResourceIBMISVPCRoutingTableRouteValidator() *validate.ResourceValidator {
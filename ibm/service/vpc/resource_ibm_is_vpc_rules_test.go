@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// testDefaultRulesServer serves the default network ACL and security group of a VPC, each with the rules r1, r2
+// and r3. The deletion of a rule answers with the status in statuses, or 204 when the rule is not in statuses.
+func testDefaultRulesServer(t *testing.T, statuses map[string]int) (*vpcv1.VpcV1, *[]string) {
+	deleted := []string{}
+	rules := `[{"id":"r1","protocol":"all"},{"id":"r2","protocol":"tcp"},{"id":"r3","protocol":"icmp"}]`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/vpcs/vpc-1/default_network_acl":
+			fmt.Fprintf(w, `{"id":"acl-1","rules":%s}`, rules)
+		case r.Method == http.MethodGet && r.URL.Path == "/vpcs/vpc-1/default_security_group":
+			fmt.Fprintf(w, `{"id":"sg-1","rules":%s}`, rules)
+		case r.Method == http.MethodDelete:
+			ruleID := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+			if status, ok := statuses[ruleID]; ok {
+				w.WriteHeader(status)
+				fmt.Fprintf(w, `{"errors":[{"code":"rule_error","message":"rule %s failed"}]}`, ruleID)
+				return
+			}
+			deleted = append(deleted, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	sess, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		t.Fatalf("creating the VPC client failed: %s", err)
+	}
+	return sess, &deleted
+}
+
+func TestDeleteDefaultRules(t *testing.T) {
+	sess, deleted := testDefaultRulesServer(t, nil)
+	if err := deleteDefaultNetworkACLRules(sess, "vpc-1"); err != nil {
+		t.Fatalf("deleting the network ACL rules failed: %s", err)
+	}
+	if err := deleteDefaultSecurityGroupRules(sess, "vpc-1"); err != nil {
+		t.Fatalf("deleting the security group rules failed: %s", err)
+	}
+	expected := []string{
+		"/network_acls/acl-1/rules/r1", "/network_acls/acl-1/rules/r2", "/network_acls/acl-1/rules/r3",
+		"/security_groups/sg-1/rules/r1", "/security_groups/sg-1/rules/r2", "/security_groups/sg-1/rules/r3",
+	}
+	sort.Strings(*deleted)
+	if !reflect.DeepEqual(*deleted, expected) {
+		t.Fatalf("expected the rules %v to be deleted, got %v", expected, *deleted)
+	}
+}
+
+func TestDeleteDefaultRulesAlreadyDeleted(t *testing.T) {
+	sess, deleted := testDefaultRulesServer(t, map[string]int{"r2": http.StatusNotFound})
+	if err := deleteDefaultNetworkACLRules(sess, "vpc-1"); err != nil {
+		t.Fatalf("expected the missing network ACL rule to be ignored, got %s", err)
+	}
+	if err := deleteDefaultSecurityGroupRules(sess, "vpc-1"); err != nil {
+		t.Fatalf("expected the missing security group rule to be ignored, got %s", err)
+	}
+	if len(*deleted) != 4 {
+		t.Fatalf("expected the other 4 rules to be deleted, got %v", *deleted)
+	}
+}
+
+func TestDeleteDefaultRulesError(t *testing.T) {
+	sess, _ := testDefaultRulesServer(t, map[string]int{"r2": http.StatusInternalServerError})
+	err := deleteDefaultNetworkACLRules(sess, "vpc-1")
+	if err == nil || !strings.Contains(err.Error(), "Network ACL Rule (r2)") {
+		t.Fatalf("expected the error of the network ACL rule r2, got %v", err)
+	}
+	err = deleteDefaultSecurityGroupRules(sess, "vpc-1")
+	if err == nil || !strings.Contains(err.Error(), "Security Group Rule (r2)") {
+		t.Fatalf("expected the error of the security group rule r2, got %v", err)
+	}
+}
@@ -69,6 +69,36 @@ func TestAccIBMISVPC_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccIBMISVPC_import(t *testing.T) {
+	var vpc string
+	name1 := fmt.Sprintf("terraformvpcuat-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISVPCDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPCConfig(name1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPCExists("ibm_is_vpc.testacc_vpc", vpc),
+				),
+			},
+			{
+				ResourceName:       "ibm_is_vpc.testacc_vpc",
+				ImportState:        true,
+				ImportStateVerify:  true,
+				ImportStatePersist: true,
+			},
+			{
+				Config:   testAccCheckIBMISVPCConfig(name1),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccIBMISVPC_dns_manual(t *testing.T) {
 	var vpc string
 	name1 := fmt.Sprintf("terraformvpcuat-%d", acctest.RandIntRange(10, 100))
@@ -375,6 +405,7 @@ func TestAccIBMISVPC_noSGACLRules(t *testing.T) {
 						"ibm_is_vpc.testacc_vpc", "name", vpcname),
 					resource.TestCheckNoResourceAttr("ibm_is_vpc.testacc_vpc", "security_group.0.rules.#"),
 					resource.TestCheckNoResourceAttr("ibm_is_vpc.testacc_vpc", "security_group.0.rules.#"),
+					testAccCheckIBMISVPCDefaultRulesDeleted("ibm_is_vpc.testacc_vpc"),
 				),
 			},
 		},
@@ -424,6 +455,32 @@ func testAccCheckIBMISVPCExists(n, vpcID string) resource.TestCheckFunc {
 	}
 }
 
+// testAccCheckIBMISVPCDefaultRulesDeleted checks that the default network ACL and security group of the VPC have no rules
+func testAccCheckIBMISVPCDefaultRulesDeleted(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		sess, _ := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		acl, _, err := sess.GetVPCDefaultNetworkACL(sess.NewGetVPCDefaultNetworkACLOptions(rs.Primary.ID))
+		if err != nil {
+			return err
+		}
+		if len(acl.Rules) != 0 {
+			return fmt.Errorf("The default network ACL of VPC %s has %d rules", rs.Primary.ID, len(acl.Rules))
+		}
+		sg, _, err := sess.GetVPCDefaultSecurityGroup(sess.NewGetVPCDefaultSecurityGroupOptions(rs.Primary.ID))
+		if err != nil {
+			return err
+		}
+		if len(sg.Rules) != 0 {
+			return fmt.Errorf("The default security group of VPC %s has %d rules", rs.Primary.ID, len(sg.Rules))
+		}
+		return nil
+	}
+}
+
 func testAccCheckIBMISVPCConfig(name string) string {
 	return fmt.Sprintf(`
 	resource "ibm_is_vpc" "testacc_vpc" {
@@ -484,3 +484,76 @@ func testAccCheckIBMISVPNGatewayConnectionNullPatchConfig(vpc, subnet, vpnname,
 	`, vpc, subnet, acc.ISZoneName, acc.ISCIDR, vpnname, ikepolicyname, ipsecpolicyname, name, noNullPass, noNullPass)
 
 }
+
+func TestAccIBMISVPNGatewayConnection_localPeer(t *testing.T) {
+	var VPNGatewayConnection string
+	vpcname := fmt.Sprintf("tfvpngc-vpc-%d", acctest.RandIntRange(10, 100))
+	subnetname := fmt.Sprintf("tfvpngc-subnet-%d", acctest.RandIntRange(10, 100))
+	vpnname := fmt.Sprintf("tfvpngc-vpn-%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tfvpngc-createname-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISVPNGatewayConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPNGatewayConnectionLocalPeerConfig(vpcname, subnetname, vpnname, name, "bidirectional"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPNGatewayConnectionExists("ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection1", VPNGatewayConnection),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection1", "peer.0.fqdn", "peer.example.com"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection1", "peer.0.type", "fqdn"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection1", "peer.0.cidrs.#", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection1", "local.0.cidrs.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection1", "establish_mode", "bidirectional"),
+				),
+			},
+			{
+				Config: testAccCheckIBMISVPNGatewayConnectionLocalPeerConfig(vpcname, subnetname, vpnname, name, "peer_only"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPNGatewayConnectionExists("ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection1", VPNGatewayConnection),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpn_gateway_connection.testacc_VPNGatewayConnection1", "establish_mode", "peer_only"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMISVPNGatewayConnectionLocalPeerConfig(vpc, subnet, vpnname, name, establishMode string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc1" {
+		name = "%s"
+	}
+
+	resource "ibm_is_subnet" "testacc_subnet1" {
+		name = "%s"
+		vpc = ibm_is_vpc.testacc_vpc1.id
+		zone = "%s"
+		ipv4_cidr_block = "%s"
+	}
+	resource "ibm_is_vpn_gateway" "testacc_VPNGateway1" {
+		name = "%s"
+		subnet = ibm_is_subnet.testacc_subnet1.id
+		mode = "policy"
+	}
+	resource "ibm_is_vpn_gateway_connection" "testacc_VPNGatewayConnection1" {
+		name = "%s"
+		vpn_gateway = ibm_is_vpn_gateway.testacc_VPNGateway1.id
+		preshared_key = "VPNDemoPassword"
+		establish_mode = "%s"
+		local {
+			cidrs = [ibm_is_subnet.testacc_subnet1.ipv4_cidr_block]
+		}
+		peer {
+			fqdn = "peer.example.com"
+			cidrs = ["10.240.64.0/24", "10.240.65.0/24"]
+		}
+	}
+	`, vpc, subnet, acc.ISZoneName, acc.ISCIDR, vpnname, name, establishMode)
+}
@@ -4,12 +4,15 @@
 package vpc
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -39,6 +42,20 @@ const (
 	isVPNGatewayConnectionTunnels                   = "tunnels"
 	isVPNGatewayConnectionResourcetype              = "resource_type"
 	isVPNGatewayConnectionCreatedat                 = "created_at"
+	isVPNGatewayConnectionLocal                     = "local"
+	isVPNGatewayConnectionPeer                      = "peer"
+	isVPNGatewayConnectionCIDRs                     = "cidrs"
+	isVPNGatewayConnectionIkeIdentities             = "ike_identities"
+	isVPNGatewayConnectionIkeIdentity               = "ike_identity"
+	isVPNGatewayConnectionIkeIdentityType           = "type"
+	isVPNGatewayConnectionIkeIdentityValue          = "value"
+	isVPNGatewayConnectionPeerAddressByAddress      = "address"
+	isVPNGatewayConnectionPeerFqdn                  = "fqdn"
+	isVPNGatewayConnectionPeerType                  = "type"
+	isVPNGatewayConnectionDistributeTraffic         = "distribute_traffic"
+	isVPNGatewayConnectionEstablishMode             = "establish_mode"
+
+	isVPNGatewayConnectionPeerTypeAddress = "address"
 )
 
 func ResourceIBMISVPNGatewayConnection() *schema.Resource {
@@ -54,157 +71,338 @@ func ResourceIBMISVPNGatewayConnection() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		Schema: map[string]*schema.Schema{
-
-			isVPNGatewayConnectionName: {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validate.InvokeValidator("ibm_is_vpn_gateway_connection", isVPNGatewayConnectionName),
-				Description:  "VPN Gateway connection name",
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceIBMISVPNGatewayConnectionV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceIBMISVPNGatewayConnectionStateUpgradeV0,
+				Version: 0,
 			},
+		},
 
-			isVPNGatewayConnectionVPNGateway: {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "VPN Gateway info",
-			},
+		Schema: resourceIBMISVPNGatewayConnectionSchema(),
+	}
+}
 
-			isVPNGatewayConnectionPeerAddress: {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "VPN gateway connection peer address",
-			},
+func resourceIBMISVPNGatewayConnectionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
 
-			isVPNGatewayConnectionPreSharedKey: {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "vpn gateway",
-			},
+		isVPNGatewayConnectionName: {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validate.InvokeValidator("ibm_is_vpn_gateway_connection", isVPNGatewayConnectionName),
+			Description:  "VPN Gateway connection name",
+		},
 
-			isVPNGatewayConnectionAdminStateup: {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "VPN gateway connection admin state",
-			},
+		isVPNGatewayConnectionVPNGateway: {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "VPN Gateway info",
+		},
 
-			isVPNGatewayConnectionLocalCIDRS: {
-				Type:        schema.TypeSet,
-				Optional:    true,
-				ForceNew:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
-				Set:         schema.HashString,
-				Description: "VPN gateway connection local CIDRs",
-			},
+		isVPNGatewayConnectionPeerAddress: {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{isVPNGatewayConnectionPeer},
+			Deprecated:    "peer_address is deprecated, use peer.0.address instead",
+			Description:   "VPN gateway connection peer address",
+		},
 
-			isVPNGatewayConnectionPeerCIDRS: {
-				Type:        schema.TypeSet,
-				Optional:    true,
-				ForceNew:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
-				Set:         schema.HashString,
-				Description: "VPN gateway connection peer CIDRs",
+		isVPNGatewayConnectionPeer: {
+			Type:          schema.TypeList,
+			Optional:      true,
+			Computed:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{isVPNGatewayConnectionPeerAddress, isVPNGatewayConnectionPeerCIDRS},
+			Description:   "The peer configuration of the VPN gateway connection",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					isVPNGatewayConnectionPeerAddressByAddress: {
+						Type:          schema.TypeString,
+						Optional:      true,
+						Computed:      true,
+						ConflictsWith: []string{"peer.0.fqdn"},
+						Description:   "The IP address of the peer VPN gateway",
+					},
+					isVPNGatewayConnectionPeerFqdn: {
+						Type:          schema.TypeString,
+						Optional:      true,
+						Computed:      true,
+						ConflictsWith: []string{"peer.0.address"},
+						Description:   "The FQDN of the peer VPN gateway",
+					},
+					isVPNGatewayConnectionCIDRs: {
+						Type:        schema.TypeSet,
+						Optional:    true,
+						Computed:    true,
+						ForceNew:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Set:         schema.HashString,
+						Description: "The peer CIDRs for this resource, in policy mode",
+					},
+					isVPNGatewayConnectionIkeIdentity: {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Computed:    true,
+						MaxItems:    1,
+						Description: "The peer IKE identity, the peer address or FQDN by default",
+						Elem:        resourceIBMISVPNGatewayConnectionIkeIdentitySchema(),
+					},
+					isVPNGatewayConnectionPeerType: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Indicates whether the peer is specified by address or by fqdn",
+					},
+				},
 			},
+		},
 
-			isVPNGatewayConnectionDeadPeerDetectionAction: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "restart",
-				ValidateFunc: validate.InvokeValidator("ibm_is_vpn_gateway_connection", isVPNGatewayConnectionDeadPeerDetectionAction),
-				Description:  "Action detection for dead peer detection action",
-			},
-			isVPNGatewayConnectionDeadPeerDetectionInterval: {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Default:      2,
-				ValidateFunc: validate.InvokeValidator("ibm_is_vpn_gateway_connection", isVPNGatewayConnectionDeadPeerDetectionInterval),
-				Description:  "Interval for dead peer detection interval",
-			},
-			isVPNGatewayConnectionDeadPeerDetectionTimeout: {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Default:      10,
-				ValidateFunc: validate.InvokeValidator("ibm_is_vpn_gateway_connection", isVPNGatewayConnectionDeadPeerDetectionTimeout),
-				Description:  "Timeout for dead peer detection",
+		isVPNGatewayConnectionLocal: {
+			Type:          schema.TypeList,
+			Optional:      true,
+			Computed:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{isVPNGatewayConnectionLocalCIDRS},
+			Description:   "The local configuration of the VPN gateway connection",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					isVPNGatewayConnectionCIDRs: {
+						Type:        schema.TypeSet,
+						Optional:    true,
+						Computed:    true,
+						ForceNew:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Set:         schema.HashString,
+						Description: "The local CIDRs for this resource, in policy mode",
+					},
+					isVPNGatewayConnectionIkeIdentities: {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Computed:    true,
+						MaxItems:    2,
+						Description: "The local IKE identities, one for each member of the VPN gateway. The public IP addresses of the members by default",
+						Elem:        resourceIBMISVPNGatewayConnectionIkeIdentitySchema(),
+					},
+				},
 			},
+		},
 
-			isVPNGatewayConnectionIPSECPolicy: {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "IP security policy for vpn gateway connection",
-			},
+		isVPNGatewayConnectionDistributeTraffic: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Indicates whether the traffic is distributed between the up tunnels of the VPN gateway connection, in static route mode",
+		},
 
-			isVPNGatewayConnectionIKEPolicy: {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "VPN gateway connection IKE Policy",
-			},
+		isVPNGatewayConnectionEstablishMode: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validate.InvokeValidator("ibm_is_vpn_gateway_connection", isVPNGatewayConnectionEstablishMode),
+			Description:  "The establish mode of the VPN gateway connection: bidirectional, or peer_only if only the peer can initiate the IKE negotiations and rekeying",
+		},
 
-			isVPNGatewayConnection: {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "The unique identifier for this VPN gateway connection",
-			},
+		isVPNGatewayConnectionPreSharedKey: {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "vpn gateway",
+		},
 
-			isVPNGatewayConnectionStatus: {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "VPN gateway connection status",
-			},
+		isVPNGatewayConnectionAdminStateup: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "VPN gateway connection admin state",
+		},
 
-			flex.RelatedCRN: {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "The crn of the VPN Gateway resource",
-			},
+		isVPNGatewayConnectionLocalCIDRS: {
+			Type:          schema.TypeSet,
+			Optional:      true,
+			Computed:      true,
+			ForceNew:      true,
+			Elem:          &schema.Schema{Type: schema.TypeString},
+			Set:           schema.HashString,
+			ConflictsWith: []string{isVPNGatewayConnectionLocal},
+			Deprecated:    "local_cidrs is deprecated, use local.0.cidrs instead",
+			Description:   "VPN gateway connection local CIDRs",
+		},
 
-			isVPNGatewayConnectionAdminAuthenticationmode: {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "The authentication mode",
-			},
+		isVPNGatewayConnectionPeerCIDRS: {
+			Type:          schema.TypeSet,
+			Optional:      true,
+			Computed:      true,
+			ForceNew:      true,
+			Elem:          &schema.Schema{Type: schema.TypeString},
+			Set:           schema.HashString,
+			ConflictsWith: []string{isVPNGatewayConnectionPeer},
+			Deprecated:    "peer_cidrs is deprecated, use peer.0.cidrs instead",
+			Description:   "VPN gateway connection peer CIDRs",
+		},
 
-			isVPNGatewayConnectionResourcetype: {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "The resource type",
-			},
+		isVPNGatewayConnectionDeadPeerDetectionAction: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "restart",
+			ValidateFunc: validate.InvokeValidator("ibm_is_vpn_gateway_connection", isVPNGatewayConnectionDeadPeerDetectionAction),
+			Description:  "Action detection for dead peer detection action",
+		},
+		isVPNGatewayConnectionDeadPeerDetectionInterval: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      2,
+			ValidateFunc: validate.InvokeValidator("ibm_is_vpn_gateway_connection", isVPNGatewayConnectionDeadPeerDetectionInterval),
+			Description:  "Interval for dead peer detection interval",
+		},
+		isVPNGatewayConnectionDeadPeerDetectionTimeout: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      10,
+			ValidateFunc: validate.InvokeValidator("ibm_is_vpn_gateway_connection", isVPNGatewayConnectionDeadPeerDetectionTimeout),
+			Description:  "Timeout for dead peer detection",
+		},
 
-			isVPNGatewayConnectionCreatedat: {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "The date and time that this VPN gateway connection was created",
+		isVPNGatewayConnectionIPSECPolicy: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "IP security policy for vpn gateway connection",
+		},
+
+		isVPNGatewayConnectionIKEPolicy: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "VPN gateway connection IKE Policy",
+		},
+
+		isVPNGatewayConnection: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The unique identifier for this VPN gateway connection",
+		},
+
+		isVPNGatewayConnectionStatus: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "VPN gateway connection status",
+		},
+
+		flex.RelatedCRN: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The crn of the VPN Gateway resource",
+		},
+
+		isVPNGatewayConnectionAdminAuthenticationmode: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The authentication mode",
+		},
+
+		isVPNGatewayConnectionResourcetype: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The resource type",
+		},
+
+		isVPNGatewayConnectionCreatedat: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date and time that this VPN gateway connection was created",
+		},
+
+		isVPNGatewayConnectionMode: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The mode of the VPN gateway",
+		},
+
+		isVPNGatewayConnectionTunnels: {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The VPN tunnel configuration for this VPN gateway connection (in static route mode)",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"address": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The IP address of the VPN gateway member in which the tunnel resides",
+					},
+
+					"status": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The status of the VPN Tunnel",
+					},
+				},
 			},
+		},
+	}
+}
 
-			isVPNGatewayConnectionMode: {
+func resourceIBMISVPNGatewayConnectionIkeIdentitySchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			isVPNGatewayConnectionIkeIdentityType: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_vpn_gateway_connection", isVPNGatewayConnectionIkeIdentityType),
+				Description:  "The IKE identity type: fqdn, hostname, ipv4_address or key_id",
+			},
+			isVPNGatewayConnectionIkeIdentityValue: {
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
-				Description: "The mode of the VPN gateway",
+				Description: "The IKE identity value",
 			},
+		},
+	}
+}
 
-			isVPNGatewayConnectionTunnels: {
-				Type:        schema.TypeList,
-				Computed:    true,
-				Description: "The VPN tunnel configuration for this VPN gateway connection (in static route mode)",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"address": {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "The IP address of the VPN gateway member in which the tunnel resides",
-						},
-
-						"status": {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "The status of the VPN Tunnel",
-						},
-					},
-				},
-			},
+// resourceIBMISVPNGatewayConnectionV0 returns the schema of the connections created before the
+// local and peer configurations, where the peer was only set with peer_address.
+func resourceIBMISVPNGatewayConnectionV0() *schema.Resource {
+	s := resourceIBMISVPNGatewayConnectionSchema()
+	delete(s, isVPNGatewayConnectionLocal)
+	delete(s, isVPNGatewayConnectionPeer)
+	delete(s, isVPNGatewayConnectionDistributeTraffic)
+	delete(s, isVPNGatewayConnectionEstablishMode)
+	return &schema.Resource{
+		Schema: s,
+	}
+}
+
+// resourceIBMISVPNGatewayConnectionStateUpgradeV0 moves peer_address, peer_cidrs and local_cidrs
+// into the peer and local configurations, which are kept in sync with the deprecated attributes.
+func resourceIBMISVPNGatewayConnectionStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+	peerAddress, _ := rawState[isVPNGatewayConnectionPeerAddress].(string)
+	peerCIDRs, ok := rawState[isVPNGatewayConnectionPeerCIDRS].([]interface{})
+	if !ok {
+		peerCIDRs = []interface{}{}
+	}
+	localCIDRs, ok := rawState[isVPNGatewayConnectionLocalCIDRS].([]interface{})
+	if !ok {
+		localCIDRs = []interface{}{}
+	}
+	rawState[isVPNGatewayConnectionPeer] = []interface{}{
+		map[string]interface{}{
+			isVPNGatewayConnectionPeerAddressByAddress: peerAddress,
+			isVPNGatewayConnectionPeerFqdn:             "",
+			isVPNGatewayConnectionCIDRs:                peerCIDRs,
+			isVPNGatewayConnectionIkeIdentity:          []interface{}{},
+			isVPNGatewayConnectionPeerType:             isVPNGatewayConnectionPeerTypeAddress,
 		},
 	}
+	rawState[isVPNGatewayConnectionLocal] = []interface{}{
+		map[string]interface{}{
+			isVPNGatewayConnectionCIDRs:         localCIDRs,
+			isVPNGatewayConnectionIkeIdentities: []interface{}{},
+		},
+	}
+	return rawState, nil
 }
 
 func ResourceIBMISVPNGatewayConnectionValidator() *validate.ResourceValidator {
@@ -242,6 +440,20 @@ func ResourceIBMISVPNGatewayConnectionValidator() *validate.ResourceValidator {
 			Type:                       validate.TypeInt,
 			MinValue:                   "2",
 			MaxValue:                   "86399"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isVPNGatewayConnectionEstablishMode,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "bidirectional, peer_only"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 isVPNGatewayConnectionIkeIdentityType,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "fqdn, hostname, ipv4_address, key_id"})
 
 	ibmISVPNGatewayConnectionResourceValidator := validate.ResourceValidator{ResourceName: "ibm_is_vpn_gateway_connection", Schema: validateSchema}
 	return &ibmISVPNGatewayConnectionResourceValidator
@@ -292,7 +504,6 @@ func vpngwconCreate(d *schema.ResourceData, meta interface{}, name, gatewayID, p
 	}
 
 	vpnGatewayConnectionPrototypeModel := &vpcv1.VPNGatewayConnectionPrototype{
-		PeerAddress:  &peerAddress,
 		Psk:          &prephasedKey,
 		AdminStateUp: &stateUp,
 		DeadPeerDetection: &vpcv1.VPNGatewayConnectionDpdPrototype{
@@ -302,11 +513,11 @@ func vpngwconCreate(d *schema.ResourceData, meta interface{}, name, gatewayID, p
 		},
 		Name: &name,
 	}
-	options := &vpcv1.CreateVPNGatewayConnectionOptions{
-		VPNGatewayID:                  &gatewayID,
-		VPNGatewayConnectionPrototype: vpnGatewayConnectionPrototypeModel,
-	}
+	connectionConfig := &vpnGatewayConnectionConfig{}
 
+	if peerAddress != "" {
+		vpnGatewayConnectionPrototypeModel.PeerAddress = &peerAddress
+	}
 	if _, ok := d.GetOk(isVPNGatewayConnectionLocalCIDRS); ok {
 		localCidrs := flex.ExpandStringList((d.Get(isVPNGatewayConnectionLocalCIDRS).(*schema.Set)).List())
 		vpnGatewayConnectionPrototypeModel.LocalCIDRs = localCidrs
@@ -315,6 +526,44 @@ func vpngwconCreate(d *schema.ResourceData, meta interface{}, name, gatewayID, p
 		peerCidrs := flex.ExpandStringList((d.Get(isVPNGatewayConnectionPeerCIDRS).(*schema.Set)).List())
 		vpnGatewayConnectionPrototypeModel.PeerCIDRs = peerCidrs
 	}
+	if peerIntf, ok := d.GetOk(isVPNGatewayConnectionPeer); ok && len(peerIntf.([]interface{})) > 0 && peerIntf.([]interface{})[0] != nil {
+		peer := peerIntf.([]interface{})[0].(map[string]interface{})
+		peerPrototype := &vpnGatewayConnectionPeer{}
+		if address, ok := peer[isVPNGatewayConnectionPeerAddressByAddress].(string); ok && address != "" {
+			peerPrototype.Address = &address
+		}
+		if fqdn, ok := peer[isVPNGatewayConnectionPeerFqdn].(string); ok && fqdn != "" {
+			peerPrototype.Fqdn = &fqdn
+		}
+		if cidrs, ok := peer[isVPNGatewayConnectionCIDRs].(*schema.Set); ok && cidrs.Len() > 0 {
+			peerPrototype.CIDRs = flex.ExpandStringList(cidrs.List())
+		}
+		if identities := expandVPNGatewayConnectionIkeIdentities(peer[isVPNGatewayConnectionIkeIdentity]); len(identities) > 0 {
+			peerPrototype.IkeIdentity = &identities[0]
+		}
+		connectionConfig.Peer = peerPrototype
+	}
+	if vpnGatewayConnectionPrototypeModel.PeerAddress == nil && (connectionConfig.Peer == nil ||
+		(connectionConfig.Peer.Address == nil && connectionConfig.Peer.Fqdn == nil)) {
+		return fmt.Errorf("[ERROR] One of peer_address, peer.0.address or peer.0.fqdn must be set")
+	}
+	if localIntf, ok := d.GetOk(isVPNGatewayConnectionLocal); ok && len(localIntf.([]interface{})) > 0 && localIntf.([]interface{})[0] != nil {
+		local := localIntf.([]interface{})[0].(map[string]interface{})
+		localPrototype := &vpnGatewayConnectionLocal{}
+		if cidrs, ok := local[isVPNGatewayConnectionCIDRs].(*schema.Set); ok && cidrs.Len() > 0 {
+			localPrototype.CIDRs = flex.ExpandStringList(cidrs.List())
+		}
+		localPrototype.IkeIdentities = expandVPNGatewayConnectionIkeIdentities(local[isVPNGatewayConnectionIkeIdentities])
+		connectionConfig.Local = localPrototype
+	}
+	if distributeTraffic, ok := d.GetOkExists(isVPNGatewayConnectionDistributeTraffic); ok {
+		distribute := distributeTraffic.(bool)
+		connectionConfig.DistributeTraffic = &distribute
+	}
+	if establishMode, ok := d.GetOk(isVPNGatewayConnectionEstablishMode); ok {
+		mode := establishMode.(string)
+		connectionConfig.EstablishMode = &mode
+	}
 
 	var ikePolicyIdentity, ipsecPolicyIdentity string
 
@@ -335,11 +584,10 @@ func vpngwconCreate(d *schema.ResourceData, meta interface{}, name, gatewayID, p
 		vpnGatewayConnectionPrototypeModel.IpsecPolicy = nil
 	}
 
-	vpnGatewayConnectionIntf, response, err := sess.CreateVPNGatewayConnection(options)
+	vpnGatewayConnection, response, err := createVPNGatewayConnection(context.Background(), sess, gatewayID, vpnGatewayConnectionPrototypeModel, connectionConfig)
 	if err != nil {
 		return fmt.Errorf("[DEBUG] Create VPN Gateway Connection err %s\n%s", err, response)
 	}
-	vpnGatewayConnection := vpnGatewayConnectionIntf.(*vpcv1.VPNGatewayConnection)
 	d.SetId(fmt.Sprintf("%s/%s", gatewayID, *vpnGatewayConnection.ID))
 	log.Printf("[INFO] VPNGatewayConnection : %s/%s", gatewayID, *vpnGatewayConnection.ID)
 	return nil
@@ -367,11 +615,7 @@ func vpngwconGet(d *schema.ResourceData, meta interface{}, gID, gConnID string)
 	if err != nil {
 		return err
 	}
-	options := &vpcv1.GetVPNGatewayConnectionOptions{
-		VPNGatewayID: &gID,
-		ID:           &gConnID,
-	}
-	vpnGatewayConnectionIntf, response, err := sess.GetVPNGatewayConnection(options)
+	vpnGatewayConnection, connectionConfig, response, err := getVPNGatewayConnection(context.Background(), sess, gID, gConnID)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -380,18 +624,73 @@ func vpngwconGet(d *schema.ResourceData, meta interface{}, gID, gConnID string)
 		return fmt.Errorf("[ERROR] Error Getting Vpn Gateway Connection (%s): %s\n%s", gConnID, err, response)
 	}
 	d.Set(isVPNGatewayConnection, gConnID)
-	vpnGatewayConnection := vpnGatewayConnectionIntf.(*vpcv1.VPNGatewayConnection)
 	d.Set(isVPNGatewayConnectionName, *vpnGatewayConnection.Name)
 	d.Set(isVPNGatewayConnectionVPNGateway, gID)
 	d.Set(isVPNGatewayConnectionAdminStateup, *vpnGatewayConnection.AdminStateUp)
-	d.Set(isVPNGatewayConnectionPeerAddress, *vpnGatewayConnection.PeerAddress)
 	d.Set(isVPNGatewayConnectionPreSharedKey, *vpnGatewayConnection.Psk)
 
-	if vpnGatewayConnection.LocalCIDRs != nil {
-		d.Set(isVPNGatewayConnectionLocalCIDRS, flex.FlattenStringList(vpnGatewayConnection.LocalCIDRs))
+	// The deprecated attributes are kept in sync with the local and peer configurations
+	peerAddress := vpnGatewayConnection.PeerAddress
+	localCIDRs := vpnGatewayConnection.LocalCIDRs
+	peerCIDRs := vpnGatewayConnection.PeerCIDRs
+	if connectionConfig.Peer != nil {
+		if peerAddress == nil {
+			peerAddress = connectionConfig.Peer.Address
+		}
+		if peerCIDRs == nil {
+			peerCIDRs = connectionConfig.Peer.CIDRs
+		}
+	}
+	if connectionConfig.Local != nil && localCIDRs == nil {
+		localCIDRs = connectionConfig.Local.CIDRs
+	}
+	if peerAddress != nil {
+		d.Set(isVPNGatewayConnectionPeerAddress, *peerAddress)
+	}
+	if localCIDRs != nil {
+		d.Set(isVPNGatewayConnectionLocalCIDRS, flex.FlattenStringList(localCIDRs))
+	}
+	if peerCIDRs != nil {
+		d.Set(isVPNGatewayConnectionPeerCIDRS, flex.FlattenStringList(peerCIDRs))
+	}
+
+	peer := map[string]interface{}{
+		isVPNGatewayConnectionCIDRs:       flex.FlattenStringList(peerCIDRs),
+		isVPNGatewayConnectionIkeIdentity: []interface{}{},
+		isVPNGatewayConnectionPeerType:    isVPNGatewayConnectionPeerTypeAddress,
+	}
+	if peerAddress != nil {
+		peer[isVPNGatewayConnectionPeerAddressByAddress] = *peerAddress
+	}
+	if connectionConfig.Peer != nil {
+		if connectionConfig.Peer.Fqdn != nil {
+			peer[isVPNGatewayConnectionPeerFqdn] = *connectionConfig.Peer.Fqdn
+		}
+		if connectionConfig.Peer.IkeIdentity != nil {
+			peer[isVPNGatewayConnectionIkeIdentity] = flattenVPNGatewayConnectionIkeIdentities([]vpnGatewayConnectionIkeIdentity{*connectionConfig.Peer.IkeIdentity})
+		}
+		if connectionConfig.Peer.Type != nil {
+			peer[isVPNGatewayConnectionPeerType] = *connectionConfig.Peer.Type
+		}
 	}
-	if vpnGatewayConnection.PeerCIDRs != nil {
-		d.Set(isVPNGatewayConnectionPeerCIDRS, flex.FlattenStringList(vpnGatewayConnection.PeerCIDRs))
+	if err := d.Set(isVPNGatewayConnectionPeer, []interface{}{peer}); err != nil {
+		return fmt.Errorf("[ERROR] Error setting peer: %s", err)
+	}
+	local := map[string]interface{}{
+		isVPNGatewayConnectionCIDRs:         flex.FlattenStringList(localCIDRs),
+		isVPNGatewayConnectionIkeIdentities: []interface{}{},
+	}
+	if connectionConfig.Local != nil {
+		local[isVPNGatewayConnectionIkeIdentities] = flattenVPNGatewayConnectionIkeIdentities(connectionConfig.Local.IkeIdentities)
+	}
+	if err := d.Set(isVPNGatewayConnectionLocal, []interface{}{local}); err != nil {
+		return fmt.Errorf("[ERROR] Error setting local: %s", err)
+	}
+	if connectionConfig.DistributeTraffic != nil {
+		d.Set(isVPNGatewayConnectionDistributeTraffic, *connectionConfig.DistributeTraffic)
+	}
+	if connectionConfig.EstablishMode != nil {
+		d.Set(isVPNGatewayConnectionEstablishMode, *connectionConfig.EstablishMode)
 	}
 	if vpnGatewayConnection.IkePolicy != nil {
 		d.Set(isVPNGatewayConnectionIKEPolicy, *vpnGatewayConnection.IkePolicy.ID)
@@ -474,6 +773,7 @@ func vpngwconUpdate(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 		ID:           &gConnID,
 	}
 	vpnGatewayConnectionPatchModel := &vpcv1.VPNGatewayConnectionPatch{}
+	connectionConfig := &vpnGatewayConnectionConfig{}
 	if d.HasChange(isVPNGatewayConnectionName) {
 		name := d.Get(isVPNGatewayConnectionName).(string)
 		vpnGatewayConnectionPatchModel.Name = &name
@@ -486,6 +786,40 @@ func vpngwconUpdate(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 		hasChanged = true
 	}
 
+	if d.HasChange("peer.0.address") || d.HasChange("peer.0.fqdn") || d.HasChange("peer.0.ike_identity") {
+		peerPatch := &vpnGatewayConnectionPeer{}
+		if address := d.Get("peer.0.address").(string); address != "" && d.HasChange("peer.0.address") {
+			peerPatch.Address = &address
+		}
+		if fqdn := d.Get("peer.0.fqdn").(string); fqdn != "" && d.HasChange("peer.0.fqdn") {
+			peerPatch.Fqdn = &fqdn
+		}
+		if identities := expandVPNGatewayConnectionIkeIdentities(d.Get("peer.0.ike_identity")); len(identities) > 0 {
+			peerPatch.IkeIdentity = &identities[0]
+		}
+		connectionConfig.Peer = peerPatch
+		hasChanged = true
+	}
+
+	if d.HasChange("local.0.ike_identities") {
+		connectionConfig.Local = &vpnGatewayConnectionLocal{
+			IkeIdentities: expandVPNGatewayConnectionIkeIdentities(d.Get("local.0.ike_identities")),
+		}
+		hasChanged = true
+	}
+
+	if d.HasChange(isVPNGatewayConnectionDistributeTraffic) {
+		distributeTraffic := d.Get(isVPNGatewayConnectionDistributeTraffic).(bool)
+		connectionConfig.DistributeTraffic = &distributeTraffic
+		hasChanged = true
+	}
+
+	if d.HasChange(isVPNGatewayConnectionEstablishMode) {
+		establishMode := d.Get(isVPNGatewayConnectionEstablishMode).(string)
+		connectionConfig.EstablishMode = &establishMode
+		hasChanged = true
+	}
+
 	if d.HasChange(isVPNGatewayConnectionPreSharedKey) {
 		psk := d.Get(isVPNGatewayConnectionPreSharedKey).(string)
 		vpnGatewayConnectionPatchModel.Psk = &psk
@@ -547,6 +881,11 @@ func vpngwconUpdate(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error calling asPatch for VPNGatewayConnectionPatch: %s", err)
 		}
+		// The local and peer configurations, distribute_traffic and establish_mode aren't in the patch model of the VPC SDK
+		vpnGatewayConnectionPatch, err = vpcRequestBody(vpnGatewayConnectionPatch, connectionConfig)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error creating the patch of the VPN Gateway Connection: %s", err)
+		}
 		updateVpnGatewayConnectionOptions.VPNGatewayConnectionPatch = vpnGatewayConnectionPatch
 		_, response, err := sess.UpdateVPNGatewayConnection(updateVpnGatewayConnectionOptions)
 		if err != nil {
@@ -677,3 +1016,111 @@ func vpngwconExists(d *schema.ResourceData, meta interface{}, gID, gConnID strin
 	}
 	return true, nil
 }
+
+// vpnGatewayConnectionConfig holds the local and peer configurations, distribute_traffic and establish_mode of a
+// VPN gateway connection, which aren't in the models of the VPC SDK yet.
+type vpnGatewayConnectionConfig struct {
+	DistributeTraffic *bool                      `json:"distribute_traffic,omitempty"`
+	EstablishMode     *string                    `json:"establish_mode,omitempty"`
+	Local             *vpnGatewayConnectionLocal `json:"local,omitempty"`
+	Peer              *vpnGatewayConnectionPeer  `json:"peer,omitempty"`
+}
+
+type vpnGatewayConnectionLocal struct {
+	CIDRs         []string                          `json:"cidrs,omitempty"`
+	IkeIdentities []vpnGatewayConnectionIkeIdentity `json:"ike_identities,omitempty"`
+}
+
+type vpnGatewayConnectionPeer struct {
+	Address     *string                          `json:"address,omitempty"`
+	Fqdn        *string                          `json:"fqdn,omitempty"`
+	CIDRs       []string                         `json:"cidrs,omitempty"`
+	IkeIdentity *vpnGatewayConnectionIkeIdentity `json:"ike_identity,omitempty"`
+	Type        *string                          `json:"type,omitempty"`
+}
+
+type vpnGatewayConnectionIkeIdentity struct {
+	Type  *string `json:"type"`
+	Value *string `json:"value,omitempty"`
+}
+
+// createVPNGatewayConnection creates a VPN gateway connection from the prototype of the VPC SDK and the configuration
+// which isn't in the prototype.
+func createVPNGatewayConnection(ctx context.Context, sess *vpcv1.VpcV1, gatewayID string, prototype *vpcv1.VPNGatewayConnectionPrototype, config *vpnGatewayConnectionConfig) (*vpcv1.VPNGatewayConnection, *core.DetailedResponse, error) {
+	body, err := vpcRequestBody(prototype, config)
+	if err != nil {
+		return nil, nil, err
+	}
+	var rawResponse map[string]json.RawMessage
+	response, err := vpcRequest(ctx, sess, core.POST, `/vpn_gateways/{vpn_gateway_id}/connections`, map[string]string{"vpn_gateway_id": gatewayID}, nil, body, &rawResponse)
+	if err != nil {
+		return nil, response, err
+	}
+	connection, _, err := unmarshalVPNGatewayConnection(rawResponse)
+	return connection, response, err
+}
+
+// getVPNGatewayConnection gets a VPN gateway connection with the configuration which isn't in the model of the VPC SDK.
+func getVPNGatewayConnection(ctx context.Context, sess *vpcv1.VpcV1, gatewayID, connectionID string) (*vpcv1.VPNGatewayConnection, *vpnGatewayConnectionConfig, *core.DetailedResponse, error) {
+	var rawResponse map[string]json.RawMessage
+	pathParamsMap := map[string]string{
+		"vpn_gateway_id": gatewayID,
+		"id":             connectionID,
+	}
+	response, err := vpcRequest(ctx, sess, core.GET, `/vpn_gateways/{vpn_gateway_id}/connections/{id}`, pathParamsMap, nil, nil, &rawResponse)
+	if err != nil {
+		return nil, nil, response, err
+	}
+	connection, config, err := unmarshalVPNGatewayConnection(rawResponse)
+	return connection, config, response, err
+}
+
+func unmarshalVPNGatewayConnection(rawResponse map[string]json.RawMessage) (*vpcv1.VPNGatewayConnection, *vpnGatewayConnectionConfig, error) {
+	var connectionIntf vpcv1.VPNGatewayConnectionIntf
+	if err := core.UnmarshalModel(rawResponse, "", &connectionIntf, vpcv1.UnmarshalVPNGatewayConnection); err != nil {
+		return nil, nil, err
+	}
+	config := &vpnGatewayConnectionConfig{}
+	if err := vpcUnmarshalExtension(rawResponse, config); err != nil {
+		return nil, nil, err
+	}
+	return connectionIntf.(*vpcv1.VPNGatewayConnection), config, nil
+}
+
+func expandVPNGatewayConnectionIkeIdentities(identitiesIntf interface{}) []vpnGatewayConnectionIkeIdentity {
+	identities := []vpnGatewayConnectionIkeIdentity{}
+	identitiesList, ok := identitiesIntf.([]interface{})
+	if !ok {
+		return identities
+	}
+	for _, identityIntf := range identitiesList {
+		identity, ok := identityIntf.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		identityType := identity[isVPNGatewayConnectionIkeIdentityType].(string)
+		ikeIdentity := vpnGatewayConnectionIkeIdentity{
+			Type: &identityType,
+		}
+		if value, ok := identity[isVPNGatewayConnectionIkeIdentityValue].(string); ok && value != "" {
+			ikeIdentity.Value = &value
+		}
+		identities = append(identities, ikeIdentity)
+	}
+	return identities
+}
+
+func flattenVPNGatewayConnectionIkeIdentities(identities []vpnGatewayConnectionIkeIdentity) []interface{} {
+	identitiesList := []interface{}{}
+	for _, identity := range identities {
+		identityMap := map[string]interface{}{}
+		if identity.Type != nil {
+			identityMap[isVPNGatewayConnectionIkeIdentityType] = *identity.Type
+		}
+		if identity.Value != nil {
+			identityMap[isVPNGatewayConnectionIkeIdentityValue] = *identity.Value
+		}
+		identitiesList = append(identitiesList, identityMap)
+	}
+	return identitiesList
+}
@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2023 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("ibm_is_instance", &resource.Sweeper{
+		Name: "ibm_is_instance",
+		F:    testSweepIsInstances,
+	})
+}
+
+func testSweepIsInstances(region string) error {
+	sess, err := acc.SharedClientSession(region)
+	if err != nil {
+		return err
+	}
+	vpcClient, err := sess.VpcV1API()
+	if err != nil {
+		return err
+	}
+
+	listInstancesOptions := &vpcv1.ListInstancesOptions{}
+	var sweepErrs []string
+	start := ""
+	for {
+		if start != "" {
+			listInstancesOptions.Start = &start
+		}
+		instances, response, err := vpcClient.ListInstances(listInstancesOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing the instances: %s\n%s", err, response)
+		}
+		for _, instance := range instances.Instances {
+			if !acc.IsSweepable(*instance.Name) {
+				continue
+			}
+			log.Printf("[INFO] Deleting the instance %s (%s)", *instance.Name, *instance.ID)
+			response, err := vpcClient.DeleteInstance(&vpcv1.DeleteInstanceOptions{ID: instance.ID})
+			if err != nil && (response == nil || response.StatusCode != 404) {
+				sweepErrs = append(sweepErrs, fmt.Sprintf("%s: %s", *instance.Name, err))
+			}
+		}
+		start = flex.GetNext(instances.Next)
+		if start == "" {
+			break
+		}
+	}
+	return acc.SweepErrors(sweepErrs)
+}
@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// vpcClientForRegion returns the VPC client for the region argument of the resource, or for the region of the provider
+func vpcClientForRegion(d *schema.ResourceData, meta interface{}) (*vpcv1.VpcV1, error) {
+	return meta.(conns.ClientSession).VpcV1APIForRegion(d.Get(isVPCRegion).(string))
+}
+
+// vpcRegionSchema returns the region argument of the VPC resources that can override the region of the provider
+func vpcRegionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "The region of the resource, which overrides the region of the provider",
+	}
+}
+
+// vpcRequest sends a request to the VPC API with the URL and the version of the VPC client, for the properties
+// which are not part of the models of the VPC SDK yet. The body is sent as JSON when it isn't nil, and the
+// response is decoded in result.
+func vpcRequest(ctx context.Context, sess *vpcv1.VpcV1, method, path string, pathParamsMap, query map[string]string, body, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = sess.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(sess.Service.Options.URL, path, pathParamsMap)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	builder.AddQuery("version", fmt.Sprint(*sess.Version))
+	// The VPC client always uses the generation 2 of the infrastructure
+	builder.AddQuery("generation", "2")
+	for name, value := range query {
+		builder.AddQuery(name, value)
+	}
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		if _, err := builder.SetBodyContentJSON(body); err != nil {
+			return nil, err
+		}
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return sess.Service.Request(request, result)
+}
+
+// vpcRequestBody returns the JSON body of a model of the VPC SDK together with the properties of extension, which
+// are not part of the model yet. The properties which are not set are left out of the body.
+func vpcRequestBody(model, extension interface{}) (map[string]interface{}, error) {
+	body := map[string]interface{}{}
+	for _, part := range []interface{}{model, extension} {
+		partJSON, err := json.Marshal(part)
+		if err != nil {
+			return nil, err
+		}
+		properties := map[string]interface{}{}
+		if err = json.Unmarshal(partJSON, &properties); err != nil {
+			return nil, err
+		}
+		for name, value := range properties {
+			if value != nil {
+				body[name] = value
+			}
+		}
+	}
+	return body, nil
+}
+
+// vpcUnmarshalExtension decodes the properties of a response of the VPC API which are not part of the models of
+// the VPC SDK yet in extension.
+func vpcUnmarshalExtension(rawResponse map[string]json.RawMessage, extension interface{}) error {
+	responseJSON, err := json.Marshal(rawResponse)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(responseJSON, extension)
+}
@@ -0,0 +1,145 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package validate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const crnSegments = 10
+
+// CRN is a Cloud Resource Name, crn:version:cname:ctype:service-name:location:scope:service-instance:resource-type:resource
+type CRN struct {
+	Version         string
+	CName           string
+	CType           string
+	ServiceName     string
+	Location        string
+	Scope           string
+	ServiceInstance string
+	ResourceType    string
+	Resource        string
+}
+
+// CRNError is the error of a CRN that can't be parsed or that doesn't match the expected service, location or scope
+type CRNError struct {
+	CRN    string
+	Reason string
+}
+
+func (e *CRNError) Error() string {
+	return fmt.Sprintf("invalid CRN %q: %s", e.CRN, e.Reason)
+}
+
+// ParseCRN parses the segments of a CRN, the version, cname, ctype and service name are required
+func ParseCRN(s string) (CRN, error) {
+	segments := strings.Split(s, ":")
+	if len(segments) != crnSegments || segments[0] != "crn" {
+		return CRN{}, &CRNError{CRN: s, Reason: fmt.Sprintf("a CRN has %d segments separated by colons and starts with crn", crnSegments)}
+	}
+	crn := CRN{
+		Version:         segments[1],
+		CName:           segments[2],
+		CType:           segments[3],
+		ServiceName:     segments[4],
+		Location:        segments[5],
+		Scope:           segments[6],
+		ServiceInstance: segments[7],
+		ResourceType:    segments[8],
+		Resource:        segments[9],
+	}
+	if crn.Version == "" || crn.CName == "" || crn.CType == "" || crn.ServiceName == "" {
+		return CRN{}, &CRNError{CRN: s, Reason: "the version, cname, ctype and service name are required"}
+	}
+	return crn, nil
+}
+
+// String formats the CRN
+func (c CRN) String() string {
+	return strings.Join([]string{"crn", c.Version, c.CName, c.CType, c.ServiceName, c.Location, c.Scope, c.ServiceInstance, c.ResourceType, c.Resource}, ":")
+}
+
+// AccountID returns the account of the scope a/<account>, or an empty string when the scope is not an account
+func (c CRN) AccountID() string {
+	if strings.HasPrefix(c.Scope, "a/") {
+		return strings.TrimPrefix(c.Scope, "a/")
+	}
+	return ""
+}
+
+// ValidateCRN checks that the value is a CRN
+func ValidateCRN() schema.SchemaValidateFunc {
+	return validateCRNWith(nil)
+}
+
+// ValidateCRNService checks that the value is the CRN of one of the services
+func ValidateCRNService(services ...string) schema.SchemaValidateFunc {
+	return validateCRNWith(func(crn CRN) string {
+		if stringInSlice(crn.ServiceName, services) {
+			return ""
+		}
+		return fmt.Sprintf("the service %q is not one of %s", crn.ServiceName, strings.Join(services, ", "))
+	})
+}
+
+// ValidateCRNRegion checks that the value is the CRN of a resource in one of the regions
+func ValidateCRNRegion(regions ...string) schema.SchemaValidateFunc {
+	return validateCRNWith(func(crn CRN) string {
+		if stringInSlice(crn.Location, regions) {
+			return ""
+		}
+		return fmt.Sprintf("the location %q is not one of %s", crn.Location, strings.Join(regions, ", "))
+	})
+}
+
+// ValidateCRNAccountScope checks that the value is the CRN of a resource scoped to an account
+func ValidateCRNAccountScope() schema.SchemaValidateFunc {
+	return validateCRNWith(func(crn CRN) string {
+		if crn.AccountID() != "" {
+			return ""
+		}
+		return fmt.Sprintf("the scope %q is not an account scope a/<account_id>", crn.Scope)
+	})
+}
+
+func validateCRNWith(check func(crn CRN) string) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+		crn, err := ParseCRN(value)
+		if err != nil {
+			errors = append(errors, fmt.Errorf("%q: %s", k, err))
+			return
+		}
+		if check != nil {
+			if reason := check(crn); reason != "" {
+				errors = append(errors, fmt.Errorf("%q: %s", k, &CRNError{CRN: value, Reason: reason}))
+			}
+		}
+		return
+	}
+}
+
+// ValidateCRNSameRegion checks at plan time that the CRN of the attribute crnKey is in the region of
+// the attribute regionKey. The check is skipped while either value is unknown, and for the CRNs of
+// global resources, which have no location.
+func ValidateCRNSameRegion(diff *schema.ResourceDiff, crnKey, regionKey string) error {
+	if !diff.NewValueKnown(crnKey) || !diff.NewValueKnown(regionKey) {
+		return nil
+	}
+	value, region := diff.Get(crnKey).(string), diff.Get(regionKey).(string)
+	if value == "" || region == "" {
+		return nil
+	}
+	crn, err := ParseCRN(value)
+	if err != nil {
+		return fmt.Errorf("[ERROR] %q: %s", crnKey, err)
+	}
+	if crn.Location == "" || crn.Location == "global" || crn.Location == region {
+		return nil
+	}
+	return fmt.Errorf("[ERROR] %q: %s", crnKey, &CRNError{CRN: value, Reason: fmt.Sprintf("the location %q is not the %s %q", crn.Location, regionKey, region)})
+}
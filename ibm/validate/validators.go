@@ -893,8 +893,8 @@ func IsSecurityGroupCIDR(s string) bool {
 }
 
 func isSecurityGroupIdentityByCRN(s string) bool {
-	segments := strings.Split(s, ":")
-	return len(segments) == 10 && segments[0] == "crn"
+	_, err := ParseCRN(s)
+	return err == nil
 }
 
 func isSecurityGroupIdentityByHRef(s string) bool {